@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"time"
+
+	"twiggit/internal/domain"
+)
+
+// ANSI styles used by the age colorizer
+const (
+	ansiGreen  = "\033[32m"
+	ansiYellow = "\033[33m"
+	ansiRed    = "\033[31m"
+	ansiDim    = "\033[2m"
+	ansiReset  = "\033[0m"
+)
+
+// AgeColorizer styles text according to how long ago a worktree was active
+type AgeColorizer interface {
+	Colorize(age time.Duration, text string) string
+}
+
+// ansiAgeColorizer colors text green, yellow, red, or dim using the [theme] thresholds
+type ansiAgeColorizer struct {
+	young time.Duration
+	old   time.Duration
+	stale time.Duration
+}
+
+// NewAgeColorizer creates an AgeColorizer from the theme's age thresholds
+func NewAgeColorizer(theme domain.ThemeConfig) AgeColorizer {
+	day := 24 * time.Hour
+	return &ansiAgeColorizer{
+		young: time.Duration(theme.AgeColorYoungDays) * day,
+		old:   time.Duration(theme.AgeColorOldDays) * day,
+		stale: time.Duration(theme.AgeColorStaleDays) * day,
+	}
+}
+
+// Colorize wraps text in the ANSI style for the age bucket
+func (c *ansiAgeColorizer) Colorize(age time.Duration, text string) string {
+	var style string
+	switch {
+	case age < c.young:
+		style = ansiGreen
+	case age <= c.old:
+		style = ansiYellow
+	case age > c.stale:
+		style = ansiDim
+	default:
+		style = ansiRed
+	}
+	return style + text + ansiReset
+}
+
+// noopAgeColorizer leaves text unstyled, for NO_COLOR and non-terminal output
+type noopAgeColorizer struct{}
+
+// Colorize returns text unchanged
+func (noopAgeColorizer) Colorize(_ time.Duration, text string) string {
+	return text
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"twiggit/internal/domain"
+)
+
+func TestAgeColorizer_Colorize(t *testing.T) {
+	day := 24 * time.Hour
+	colorizer := NewAgeColorizer(domain.ThemeConfig{AgeColorYoungDays: 1, AgeColorOldDays: 7, AgeColorStaleDays: 30})
+
+	testCases := []struct {
+		name     string
+		age      time.Duration
+		expected string
+	}{
+		{name: "active today", age: time.Hour, expected: ansiGreen + "b" + ansiReset},
+		{name: "active this week", age: 3 * day, expected: ansiYellow + "b" + ansiReset},
+		{name: "exactly a week", age: 7 * day, expected: ansiYellow + "b" + ansiReset},
+		{name: "older than a week", age: 10 * day, expected: ansiRed + "b" + ansiReset},
+		{name: "exactly stale threshold", age: 30 * day, expected: ansiRed + "b" + ansiReset},
+		{name: "older than a month", age: 45 * day, expected: ansiDim + "b" + ansiReset},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, colorizer.Colorize(tc.age, "b"))
+		})
+	}
+}
+
+func TestNoopAgeColorizer_Colorize(t *testing.T) {
+	assert.Equal(t, "b", noopAgeColorizer{}.Colorize(90*24*time.Hour, "b"))
+}
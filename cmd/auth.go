@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// NewAuthCommand creates the auth command group for managing API tokens
+func NewAuthCommand(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth",
+		Short: "Manage API tokens for GitHub and GitLab",
+		Long: `Store API tokens used by the GitHub and GitLab integrations.
+
+Tokens are kept encrypted in tokens.json next to the configuration file,
+readable only by you. Full tokens are never printed.
+
+Examples:
+  twiggit auth add github ghp_xxxx   Validate and store a GitHub token
+  twiggit auth list                  Show stored tokens (masked)
+  twiggit auth remove gitlab         Remove the GitLab token`,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newAuthAddCmd(config))
+	cmd.AddCommand(newAuthListCmd(config))
+	cmd.AddCommand(newAuthRemoveCmd(config))
+
+	return cmd
+}
+
+// actionAuthHosts completes the host names accepted by auth subcommands
+func actionAuthHosts() carapace.Action {
+	return carapace.ActionValuesDescribed(
+		"github", domain.AuthHostGitHub,
+		"gitlab", domain.AuthHostGitLab,
+	)
+}
+
+func newAuthAddCmd(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <host> <token>",
+		Short: "Validate and store an API token",
+		Long: `Validate the token against the host's /user endpoint, then store it,
+replacing any token already stored for the host. Host is github or gitlab.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeAuthAdd(cmd, config, args[0], args[1])
+		},
+	}
+
+	carapace.Gen(cmd).PositionalCompletion(actionAuthHosts())
+
+	return cmd
+}
+
+func executeAuthAdd(cmd *cobra.Command, config *CommandConfig, hostName, token string) error {
+	host, err := domain.NormalizeAuthHost(hostName)
+	if err != nil {
+		return err
+	}
+	if token == "" {
+		return domain.NewValidationError("auth add", "token", "", "token cannot be empty")
+	}
+
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	logv(cmd, 1, "Validating token with %s", host)
+	username, err := config.Services.TokenValidator.Validate(context.Background(), host, token)
+	if err != nil {
+		return fmt.Errorf("failed to validate token: %w", err)
+	}
+
+	stored := domain.StoredToken{Host: host, Username: username, Token: token, AddedAt: time.Now()}
+	if err := config.Services.TokenStore.Save(stored); err != nil {
+		return fmt.Errorf("failed to store token: %w", err)
+	}
+
+	if !isQuiet(cmd) {
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Stored %s token for %s (%s)\n", host, username, domain.MaskToken(token))
+	}
+	return nil
+}
+
+func newAuthListCmd(config *CommandConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List stored API tokens",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			tokens, err := config.Services.TokenStore.List()
+			if err != nil {
+				return fmt.Errorf("failed to list tokens: %w", err)
+			}
+			displayStoredTokens(cmd.OutOrStdout(), tokens)
+			return nil
+		},
+	}
+}
+
+// displayStoredTokens prints a HOST/USER/TOKEN/ADDED table with masked tokens
+func displayStoredTokens(out io.Writer, tokens []domain.StoredToken) {
+	if len(tokens) == 0 {
+		_, _ = fmt.Fprintln(out, "No tokens stored")
+		return
+	}
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "HOST\tUSER\tTOKEN\tADDED")
+	for _, token := range tokens {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", token.Host, token.Username, domain.MaskToken(token.Token), token.AddedAt.Format("2006-01-02"))
+	}
+	_ = tw.Flush()
+}
+
+func newAuthRemoveCmd(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove <host>",
+		Short: "Remove a stored API token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			host, err := domain.NormalizeAuthHost(args[0])
+			if err != nil {
+				return err
+			}
+			if err := config.Services.TokenStore.Remove(host); err != nil {
+				return fmt.Errorf("failed to remove token: %w", err)
+			}
+			if !isQuiet(cmd) {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Removed %s token\n", host)
+			}
+			return nil
+		},
+	}
+
+	carapace.Gen(cmd).PositionalCompletion(actionAuthHosts())
+
+	return cmd
+}
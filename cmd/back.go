@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// NewBackCommand creates the back command
+func NewBackCommand(config *CommandConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "back",
+		Short: "Change directory to the previously visited worktree",
+		Long: `Change directory to the worktree visited before the current one.
+
+This is the second entry of 'twiggit history'. Going back is itself a visit,
+so running back twice returns to where you started. The command outputs the
+path to be used by shell integration.
+
+Examples:
+  twiggit cd api/feature
+  twiggit cd web/main
+  twiggit back           # Back in api/feature`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeBack(cmd, config)
+		},
+	}
+}
+
+// executeBack prints the previously visited worktree and records it as the newest visit
+func executeBack(cmd *cobra.Command, config *CommandConfig) error {
+	ctx := context.Background()
+
+	history := config.Services.NavigationService.GetHistory()
+	if len(history) < 2 {
+		return domain.NewValidationError("back", "history", "", "no previous worktree in history").
+			WithSuggestions([]string{"Visit worktrees with 'twiggit cd' to build the history"})
+	}
+	path := history[1]
+
+	logv(cmd, 1, "Going back to %s", path)
+	if err := config.Services.NavigationService.ValidatePath(ctx, path); err != nil {
+		return domain.NewNavigationServiceError(path, "", "Back", "previous worktree no longer exists", err)
+	}
+
+	recordVisit(cmd, config, path)
+	if _, err := fmt.Fprintln(cmd.OutOrStdout(), path); err != nil {
+		return fmt.Errorf("failed to output path: %w", err)
+	}
+	return nil
+}
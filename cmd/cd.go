@@ -16,11 +16,12 @@ func NewCDCommand(config *CommandConfig) *cobra.Command {
 		Short: "Change directory to a worktree",
 		Long: `Change directory to the specified worktree.
 If no target is provided, changes to the default worktree for the current project.
-The command outputs the path to be used by shell integration.
+The command outputs the path to be used by shell integration and records it
+in 'twiggit history'.
 
 Examples:
   twiggit cd                    # Change to default worktree for current project
-  twiggit cd myproject          # Change to main worktree of myproject
+  twiggit cd myproject          # Change to the default worktree of myproject (main unless set)
   twiggit cd myproject/feature  # Change to feature branch worktree
   twiggit cd feature            # Change to feature branch (relative to current project)`,
 		Args: cobra.MaximumNArgs(1),
@@ -50,6 +51,17 @@ func executeCD(cmd *cobra.Command, config *CommandConfig, target string) error {
 		return err
 	}
 
+	// A bare project name opens the project's default worktree, see worktrees set-default
+	if target != "" && target != "main" && result.Type == domain.PathTypeProject && result.ProjectName != "" {
+		result, err = config.Services.NavigationService.NavigateToProject(ctx, result.ProjectName)
+		if err != nil {
+			return err
+		}
+		if result.Warning != "" {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s\n", result.Warning)
+		}
+	}
+
 	logv(cmd, 1, "Navigating to worktree")
 	logv(cmd, 2, "  target: %s", target)
 	logv(cmd, 2, "  worktree path: %s", result.ResolvedPath)
@@ -65,6 +77,8 @@ func executeCD(cmd *cobra.Command, config *CommandConfig, target string) error {
 		return domain.NewNavigationServiceError(target, currentCtx.Path, "ResolvePath", "project not found", nil)
 	}
 
+	recordVisit(cmd, config, result.ResolvedPath)
+
 	// Output the resolved path for shell integration
 	_, err = fmt.Fprintln(cmd.OutOrStdout(), result.ResolvedPath)
 	if err != nil {
@@ -78,6 +78,7 @@ func TestCDCommand_Execute(t *testing.T) {
 			mockCS := mocks.NewMockContextService()
 
 			tc.setupMocks(mockNS, mockCS)
+			mockNS.On("RecordVisit", mock.Anything).Return(nil).Maybe()
 
 			config := &CommandConfig{
 				Services: &ServiceContainer{
@@ -108,3 +109,67 @@ func TestCDCommand_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestCDCommand_ProjectDefaultWorktree(t *testing.T) {
+	testCases := []struct {
+		name          string
+		navigated     *domain.ResolutionResult
+		expectedPath  string
+		expectWarning string
+	}{
+		{
+			name: "opens the default worktree",
+			navigated: &domain.ResolutionResult{
+				ResolvedPath: "/home/user/Worktrees/test-project/develop",
+				Type:         domain.PathTypeWorktree,
+				ProjectName:  "test-project",
+				BranchName:   "develop",
+			},
+			expectedPath: "/home/user/Worktrees/test-project/develop",
+		},
+		{
+			name: "missing default worktree falls back to main with a warning",
+			navigated: &domain.ResolutionResult{
+				ResolvedPath: "/home/user/Projects/test-project",
+				Type:         domain.PathTypeProject,
+				ProjectName:  "test-project",
+				Warning:      "default worktree 'develop' of test-project no longer exists; using the main branch",
+			},
+			expectedPath:  "/home/user/Projects/test-project",
+			expectWarning: "Warning: default worktree 'develop' of test-project no longer exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockNS := mocks.NewMockNavigationService()
+			mockCS := mocks.NewMockContextService()
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+			mockNS.On("ResolvePath", mock.Anything, mock.AnythingOfType("*domain.ResolvePathRequest")).Return(&domain.ResolutionResult{
+				ResolvedPath: "/home/user/Projects/test-project",
+				Type:         domain.PathTypeProject,
+				ProjectName:  "test-project",
+			}, nil)
+			mockNS.On("NavigateToProject", mock.Anything, "test-project").Return(tc.navigated, nil)
+			mockNS.On("ValidatePath", mock.Anything, tc.expectedPath).Return(nil)
+			mockNS.On("RecordVisit", tc.expectedPath).Return(nil)
+
+			cmd := NewCDCommand(&CommandConfig{
+				Services: &ServiceContainer{NavigationService: mockNS, ContextService: mockCS},
+			})
+			cmd.SetArgs([]string{"test-project"})
+			var out, errOut bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&errOut)
+
+			require.NoError(t, cmd.Execute())
+			assert.Equal(t, tc.expectedPath, strings.TrimSpace(out.String()))
+			if tc.expectWarning == "" {
+				assert.Empty(t, errOut.String())
+			} else {
+				assert.Contains(t, errOut.String(), tc.expectWarning)
+			}
+			mockNS.AssertExpectations(t)
+		})
+	}
+}
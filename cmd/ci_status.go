@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// ciPollInterval is how often --watch-ci asks the hosting provider for the CI status
+const ciPollInterval = 30 * time.Second
+
+// defaultCITimeout caps how long --watch-ci waits when --ci-timeout is not given
+const defaultCITimeout = 30 * time.Minute
+
+// spinnerFrames animate the pending CI status on terminals
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// watchWorktreeCI polls the CI status of the worktree's HEAD commit on the project's origin
+// remote and renders each change on stderr, returning an error unless CI succeeds
+func watchWorktreeCI(ctx context.Context, cmd *cobra.Command, config *CommandConfig, project *domain.ProjectInfo, worktree *domain.WorktreeInfo, timeout time.Duration) error {
+	remote := ciRemote(project)
+	if remote == nil {
+		return errors.New("worktree created but CI cannot be watched: the project has no remote")
+	}
+	host, owner, repo, ok := domain.ParseRemoteRepository(remote.FetchURL)
+	if !ok {
+		return fmt.Errorf("worktree created but CI cannot be watched: %s is not a hosted repository URL", remote.FetchURL)
+	}
+	authHost, err := domain.NormalizeAuthHost(host)
+	if err != nil {
+		return fmt.Errorf("worktree created but CI cannot be watched: %w", err)
+	}
+	watcher := config.Services.CIWatchers[authHost]
+	if watcher == nil {
+		return fmt.Errorf("worktree created but CI cannot be watched: no CI watcher for %s", authHost)
+	}
+
+	status, err := config.Services.WorktreeService.GetWorktreeStatus(ctx, worktree.Path)
+	if err != nil {
+		return fmt.Errorf("worktree created but failed to read its HEAD commit: %w", err)
+	}
+	if status.RepositoryStatus == nil || status.RepositoryStatus.Commit == "" {
+		return errors.New("worktree created but its HEAD commit is unknown")
+	}
+	sha := status.RepositoryStatus.Commit
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	logv(cmd, 1, "Watching CI of %s on %s/%s (%s)", worktree.Branch, owner, repo, authHost)
+	updates, err := watcher.Watch(ctx, owner, repo, sha, ciPollInterval)
+	if err != nil {
+		return fmt.Errorf("worktree created but failed to watch CI: %w", err)
+	}
+
+	last, err := renderCIUpdates(ctx, cmd.ErrOrStderr(), showsProgress(config, cmd.ErrOrStderr()), updates)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !last.Done() && errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return fmt.Errorf("worktree created but CI did not finish within %s", timeout)
+	case !last.Done():
+		return errors.New("worktree created; stopped watching CI before it finished")
+	case last.State != domain.CIStateSuccess:
+		return fmt.Errorf("worktree created but CI finished with %s", last.State)
+	}
+	return nil
+}
+
+// ciRemote returns the project's origin remote, or its first remote when there is no origin
+func ciRemote(project *domain.ProjectInfo) *domain.RemoteInfo {
+	for _, remote := range project.Remotes {
+		if remote.Name == "origin" {
+			return remote
+		}
+	}
+	if len(project.Remotes) > 0 {
+		return project.Remotes[0]
+	}
+	return nil
+}
+
+// renderCIUpdates prints a status box whenever the status changes and, with animate,
+// a spinner in between. It returns the last status received.
+func renderCIUpdates(ctx context.Context, out io.Writer, animate bool, updates <-chan domain.CIStatus) (domain.CIStatus, error) {
+	var last domain.CIStatus
+	var tick <-chan time.Time
+	if animate {
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	done := ctx.Done()
+	frame := 0
+	for {
+		select {
+		case status, ok := <-updates:
+			if !ok {
+				if animate {
+					_, _ = fmt.Fprint(out, "\r\033[K")
+				}
+				return last, nil
+			}
+			if status != last {
+				if tick != nil {
+					_, _ = fmt.Fprint(out, "\r\033[K")
+				}
+				_, _ = fmt.Fprint(out, renderCIStatusBox(status, spinnerFrames[frame%len(spinnerFrames)]))
+				last = status
+			}
+		case <-tick:
+			frame++
+			_, _ = fmt.Fprintf(out, "\r%s Waiting for CI...", spinnerFrames[frame%len(spinnerFrames)])
+		case <-done:
+			// The watcher closes updates once it sees the cancellation
+			done, tick = nil, nil
+		}
+	}
+}
+
+// renderCIStatusBox draws the status in a rounded box; pending states show the spinner frame
+func renderCIStatusBox(status domain.CIStatus, frame string) string {
+	icon := frame
+	switch status.State {
+	case domain.CIStateSuccess:
+		icon = "✓"
+	case domain.CIStateFailure:
+		icon = "✗"
+	case domain.CIStateError:
+		icon = "!"
+	}
+
+	lines := []string{icon + " CI " + status.State}
+	if status.Description != "" {
+		lines = append(lines, status.Description)
+	}
+	if status.URL != "" {
+		lines = append(lines, status.URL)
+	}
+
+	width := 0
+	for _, line := range lines {
+		width = max(width, utf8.RuneCountInString(line))
+	}
+
+	var b strings.Builder
+	b.WriteString("╭" + strings.Repeat("─", width+2) + "╮\n")
+	for _, line := range lines {
+		b.WriteString("│ " + line + strings.Repeat(" ", width-utf8.RuneCountInString(line)) + " │\n")
+	}
+	b.WriteString("╰" + strings.Repeat("─", width+2) + "╯\n")
+	return b.String()
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+// ciUpdates returns a closed channel holding the given statuses
+func ciUpdates(statuses ...domain.CIStatus) <-chan domain.CIStatus {
+	updates := make(chan domain.CIStatus, len(statuses))
+	for _, status := range statuses {
+		updates <- status
+	}
+	close(updates)
+	return updates
+}
+
+func TestCreateCommand_WatchCI(t *testing.T) {
+	pending := domain.CIStatus{State: domain.CIStatePending, Description: "1 of 2 checks passed", URL: "https://ci/2"}
+	success := domain.CIStatus{State: domain.CIStateSuccess, Description: "2 of 2 checks passed", URL: "https://ci/1"}
+	failure := domain.CIStatus{State: domain.CIStateFailure, Description: "pipeline failed"}
+	githubRemote := []*domain.RemoteInfo{
+		{Name: "upstream", FetchURL: "https://gitlab.com/other/api.git"},
+		{Name: "origin", FetchURL: "git@github.com:acme/api.git"},
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		remotes     []*domain.RemoteInfo
+		updates     <-chan domain.CIStatus
+		expectWatch bool
+		expectError string
+		expectErr   []string
+	}{
+		{
+			name:        "pending then success",
+			args:        []string{"feature", "--watch-ci"},
+			remotes:     githubRemote,
+			updates:     ciUpdates(pending, pending, success),
+			expectWatch: true,
+			expectErr:   []string{"CI pending", "1 of 2 checks passed", "✓ CI success", "https://ci/1"},
+		},
+		{
+			name:        "failure is an error",
+			args:        []string{"feature", "--watch-ci"},
+			remotes:     githubRemote,
+			updates:     ciUpdates(failure),
+			expectWatch: true,
+			expectError: "worktree created but CI finished with failure",
+			expectErr:   []string{"✗ CI failure"},
+		},
+		{
+			name:        "watch stopped before a final state",
+			args:        []string{"feature", "--watch-ci"},
+			remotes:     githubRemote,
+			updates:     ciUpdates(pending),
+			expectWatch: true,
+			expectError: "stopped watching CI before it finished",
+		},
+		{
+			name:        "project without remote",
+			args:        []string{"feature", "--watch-ci"},
+			expectError: "the project has no remote",
+		},
+		{
+			name:        "unsupported host",
+			args:        []string{"feature", "--watch-ci"},
+			remotes:     []*domain.RemoteInfo{{Name: "origin", FetchURL: "git@bitbucket.org:acme/api.git"}},
+			expectError: "unsupported host",
+		},
+		{
+			name:        "worktree-only",
+			args:        []string{"feature", "--watch-ci", "--worktree-only"},
+			expectError: "--watch-ci cannot be combined with --worktree-only",
+		},
+		{
+			name:        "non-positive timeout",
+			args:        []string{"feature", "--watch-ci", "--ci-timeout", "0s"},
+			expectError: "--ci-timeout must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+			watcher := mocks.NewMockCIWatcher()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).
+				Return(&domain.ProjectInfo{Name: "proj", GitRepoPath: "/repos/proj", Remotes: tc.remotes}, nil)
+			mockWS.On("BranchExists", mock.Anything, mock.Anything, "main").Return(true, nil)
+			mockWS.On("CreateWorktree", mock.Anything, mock.AnythingOfType("*domain.CreateWorktreeRequest")).Return(&domain.CreateWorktreeResult{
+				Worktree: &domain.WorktreeInfo{Path: "/wt/proj/feature", Branch: "feature"},
+			}, nil)
+			mockWS.On("GetWorktreeStatus", mock.Anything, "/wt/proj/feature").Return(&domain.WorktreeStatus{
+				RepositoryStatus: &domain.RepositoryStatus{Branch: "feature", Commit: "abc123"},
+			}, nil)
+			if tc.expectWatch {
+				watcher.On("Watch", mock.Anything, "acme", "api", "abc123", ciPollInterval).Return(tc.updates, nil)
+			}
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{
+					WorktreeService: mockWS,
+					ContextService:  mockCS,
+					ProjectService:  mockPS,
+					CIWatchers:      map[string]application.CIWatcher{domain.AuthHostGitHub: watcher},
+				},
+				Config: domain.DefaultConfig(),
+			}
+
+			cmd := NewCreateCommand(config)
+			var errOut bytes.Buffer
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&errOut)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, expected := range tc.expectErr {
+				assert.Contains(t, errOut.String(), expected)
+			}
+			watcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRenderCIUpdates_SkipsRepeatedStatuses(t *testing.T) {
+	pending := domain.CIStatus{State: domain.CIStatePending, Description: "no status reported yet"}
+	var out bytes.Buffer
+
+	last, err := renderCIUpdates(context.Background(), &out, false, ciUpdates(pending, pending, pending))
+	require.NoError(t, err)
+	assert.Equal(t, pending, last)
+	assert.Equal(t, 1, bytes.Count(out.Bytes(), []byte("╭")), "an unchanged status is drawn once")
+}
+
+func TestRenderCIUpdates_StopsWhenWatcherCloses(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	updates := make(chan domain.CIStatus)
+	go func() {
+		<-ctx.Done()
+		close(updates)
+	}()
+
+	last, err := renderCIUpdates(ctx, &bytes.Buffer{}, false, updates)
+	require.NoError(t, err)
+	assert.False(t, last.Done())
+}
+
+func TestRenderCIStatusBox(t *testing.T) {
+	box := renderCIStatusBox(domain.CIStatus{State: domain.CIStatePending, Description: "pipeline running", URL: "https://ci/7"}, "⠙")
+	assert.Equal(t, ""+
+		"╭──────────────────╮\n"+
+		"│ ⠙ CI pending     │\n"+
+		"│ pipeline running │\n"+
+		"│ https://ci/7     │\n"+
+		"╰──────────────────╯\n", box)
+
+	assert.Contains(t, renderCIStatusBox(domain.CIStatus{State: domain.CIStateError}, "⠙"), "│ ! CI error │")
+}
+
+func TestCIRemote(t *testing.T) {
+	origin := &domain.RemoteInfo{Name: "origin"}
+	upstream := &domain.RemoteInfo{Name: "upstream"}
+
+	assert.Same(t, origin, ciRemote(&domain.ProjectInfo{Remotes: []*domain.RemoteInfo{upstream, origin}}))
+	assert.Same(t, upstream, ciRemote(&domain.ProjectInfo{Remotes: []*domain.RemoteInfo{upstream}}))
+	assert.Nil(t, ciRemote(&domain.ProjectInfo{}))
+}
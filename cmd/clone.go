@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// NewCloneCommand creates the clone command
+func NewCloneCommand(config *CommandConfig) *cobra.Command {
+	var projectName string
+
+	cmd := &cobra.Command{
+		Use:   "clone <url> <branch>",
+		Short: "Clone a repository into the projects directory and create its first worktree",
+		Long: `Clone a repository into the projects directory, then create a worktree on a
+new branch started at the repository's default branch.
+
+The URL must use http(s) or ssh (including git@host:owner/repo.git). The
+project is named after the last element of the URL without .git unless
+--name is given; an existing directory of that name is an error. When the
+worktree cannot be created the clone is kept, so 'twiggit create' can be
+used to retry.
+
+Examples:
+  twiggit clone https://github.com/me/api.git feature-login
+  twiggit clone git@github.com:me/api.git spike --name api-spike`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeClone(cmd, config, args[0], args[1], projectName)
+		},
+	}
+
+	cmd.Flags().StringVar(&projectName, "name", "", "Project name (defaults to the repository name in the URL)")
+
+	return cmd
+}
+
+// executeClone clones the repository and reports the clone and the new worktree
+func executeClone(cmd *cobra.Command, config *CommandConfig, remoteURL, branchName, projectName string) error {
+	ctx := context.Background()
+
+	logv(cmd, 1, "Cloning %s", remoteURL)
+	result, err := config.Services.WorktreeService.CloneAndCreate(ctx, &domain.CloneCreateRequest{
+		RemoteURL:   remoteURL,
+		ProjectName: projectName,
+		BranchName:  branchName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to clone: %w", err)
+	}
+	logv(cmd, 2, "  default branch: %s", result.DefaultBranch)
+
+	if isQuiet(cmd) {
+		return nil
+	}
+	out := cmd.OutOrStdout()
+	_, _ = fmt.Fprintf(out, "Cloned %s -> %s\n", result.ProjectName, result.ProjectPath)
+	if err := displayCreateSuccess(out, result.Created); err != nil {
+		return err
+	}
+	if hook := result.Created.HookResult; hook != nil && !hook.Success {
+		displayHookFailures(cmd.ErrOrStderr(), hook)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestCloneCommand_Execute(t *testing.T) {
+	result := &domain.CloneCreateResult{
+		ProjectName:   "api",
+		ProjectPath:   "/projects/api",
+		DefaultBranch: "main",
+		Created: &domain.CreateWorktreeResult{
+			Worktree: &domain.WorktreeInfo{Path: "/wt/api/feature", Branch: "feature"},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectReq   *domain.CloneCreateRequest
+		serviceErr  error
+		expectError string
+		expectOut   []string
+	}{
+		{
+			name:      "clones and creates the worktree",
+			args:      []string{"https://github.com/me/api.git", "feature"},
+			expectReq: &domain.CloneCreateRequest{RemoteURL: "https://github.com/me/api.git", BranchName: "feature"},
+			expectOut: []string{"Cloned api -> /projects/api", "Created worktree: feature -> /wt/api/feature"},
+		},
+		{
+			name:      "project name",
+			args:      []string{"git@github.com:me/api.git", "feature", "--name", "api-spike"},
+			expectReq: &domain.CloneCreateRequest{RemoteURL: "git@github.com:me/api.git", ProjectName: "api-spike", BranchName: "feature"},
+		},
+		{
+			name:        "service error",
+			args:        []string{"file:///srv/api.git", "feature"},
+			expectReq:   &domain.CloneCreateRequest{RemoteURL: "file:///srv/api.git", BranchName: "feature"},
+			serviceErr:  errors.New("unsupported scheme file"),
+			expectError: "failed to clone: unsupported scheme file",
+		},
+		{
+			name:        "branch is required",
+			args:        []string{"https://github.com/me/api.git"},
+			expectError: "accepts 2 arg(s)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			if tc.expectReq != nil {
+				ret := result
+				if tc.serviceErr != nil {
+					ret = nil
+				}
+				ws.On("CloneAndCreate", mock.Anything, tc.expectReq).Return(ret, tc.serviceErr).Once()
+			}
+
+			cmd := NewCloneCommand(&CommandConfig{Services: &ServiceContainer{WorktreeService: ws}})
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+			ws.AssertExpectations(t)
+		})
+	}
+}
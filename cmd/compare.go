@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// NewCompareCommand creates a new compare command
+func NewCompareCommand(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compare <branch1> [<branch2>]",
+		Short: "Compare two branches of the current project",
+		Long: `Show how different two branches are without a full diff.
+
+Displays the changed files, insertion/deletion totals and the commits
+unique to each branch. When branch2 is omitted the project's main
+branch is used.
+
+Examples:
+  twiggit compare feature-a              Compare feature-a with the main branch
+  twiggit compare feature-a feature-b    Compare two feature branches`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var branch2 string
+			if len(args) > 1 {
+				branch2 = args[1]
+			}
+			return executeCompare(cmd, config, args[0], branch2)
+		},
+	}
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionBranches(config),
+		actionBranches(config),
+	)
+
+	return cmd
+}
+
+// executeCompare executes the compare command with the given configuration
+func executeCompare(cmd *cobra.Command, config *CommandConfig, branch1, branch2 string) error {
+	ctx := context.Background()
+
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil {
+		return fmt.Errorf("context detection failed: %w", err)
+	}
+
+	project, err := config.Services.ProjectService.DiscoverProject(ctx, "", currentCtx)
+	if err != nil {
+		return fmt.Errorf("failed to discover project: %w", err)
+	}
+
+	if branch2 == "" {
+		branch2 = defaultCompareBranch(config, project)
+	}
+
+	logv(cmd, 1, "Comparing %s..%s in %s", branch1, branch2, project.Name)
+
+	comparison, err := config.Services.WorktreeService.CompareBranches(ctx, project.GitRepoPath, branch1, branch2)
+	if err != nil {
+		return fmt.Errorf("compare failed: %w", err)
+	}
+
+	displayComparison(cmd.OutOrStdout(), comparison)
+	return nil
+}
+
+// defaultCompareBranch returns the project's main branch, falling back to the configured default
+func defaultCompareBranch(config *CommandConfig, project *domain.ProjectInfo) string {
+	if project.DefaultBranch != "" {
+		return project.DefaultBranch
+	}
+	if config.Config != nil && config.Config.DefaultSourceBranch != "" {
+		return config.Config.DefaultSourceBranch
+	}
+	return "main"
+}
+
+// displayComparison renders a comparison as a table followed by unique commits
+func displayComparison(out io.Writer, comparison *domain.WorktreeComparison) {
+	_, _ = fmt.Fprintf(out, "Comparing %s..%s\n\n", comparison.Base, comparison.Target)
+
+	if len(comparison.ChangedFiles) == 0 {
+		_, _ = fmt.Fprintln(out, "No file differences")
+	} else {
+		tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(tw, "FILE\tCHANGE")
+		for _, file := range comparison.ChangedFiles {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\n", file.Path, file.Mode)
+		}
+		_ = tw.Flush()
+		_, _ = fmt.Fprintf(out, "\n%d file(s) changed, %d insertion(s), %d deletion(s)\n",
+			comparison.FilesChanged, comparison.Insertions, comparison.Deletions)
+	}
+
+	displayUniqueCommits(out, comparison.Base, comparison.OnlyInBase)
+	displayUniqueCommits(out, comparison.Target, comparison.OnlyInTarget)
+
+	if !comparison.HasCommonHistory {
+		_, _ = fmt.Fprintf(out, "\nNote: %s and %s share no common history\n", comparison.Base, comparison.Target)
+	}
+}
+
+// displayUniqueCommits lists the commits only reachable from the given branch
+func displayUniqueCommits(out io.Writer, branch string, commits []domain.CommitInfo) {
+	_, _ = fmt.Fprintf(out, "\nCommits only in %s (%d):\n", branch, len(commits))
+	for _, commit := range commits {
+		_, _ = fmt.Fprintf(out, "  %s %s\n", commit.ShortHash, commit.Message)
+	}
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestCompareCommand_Execute(t *testing.T) {
+	project := &domain.ProjectInfo{Name: "proj", GitRepoPath: "/repos/proj", DefaultBranch: "trunk"}
+	comparison := &domain.WorktreeComparison{
+		Base:         "feature-a",
+		Target:       "trunk",
+		FilesChanged: 2,
+		Insertions:   10,
+		Deletions:    3,
+		ChangedFiles: []domain.FileStat{
+			{Path: "internal/app.go", Mode: "modified"},
+			{Path: "docs/new.md", Mode: "created"},
+		},
+		HasCommonHistory: true,
+		OnlyInBase:       []domain.CommitInfo{{ShortHash: "abc1234", Message: "Add feature"}},
+		OnlyInTarget:     []domain.CommitInfo{},
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		setupMocks  func(*mocks.MockWorktreeService)
+		expectError string
+		expectOut   []string
+	}{
+		{
+			name: "defaults second branch to main branch",
+			args: []string{"feature-a"},
+			setupMocks: func(ws *mocks.MockWorktreeService) {
+				ws.On("CompareBranches", mock.Anything, "/repos/proj", "feature-a", "trunk").Return(comparison, nil)
+			},
+			expectOut: []string{
+				"Comparing feature-a..trunk",
+				"internal/app.go",
+				"created",
+				"2 file(s) changed, 10 insertion(s), 3 deletion(s)",
+				"Commits only in feature-a (1):",
+				"abc1234 Add feature",
+				"Commits only in trunk (0):",
+			},
+		},
+		{
+			name: "no common history",
+			args: []string{"orphan", "trunk"},
+			setupMocks: func(ws *mocks.MockWorktreeService) {
+				ws.On("CompareBranches", mock.Anything, "/repos/proj", "orphan", "trunk").Return(&domain.WorktreeComparison{
+					Base:   "orphan",
+					Target: "trunk",
+				}, nil)
+			},
+			expectOut: []string{"No file differences", "share no common history"},
+		},
+		{
+			name: "service error",
+			args: []string{"feature-a", "missing"},
+			setupMocks: func(ws *mocks.MockWorktreeService) {
+				ws.On("CompareBranches", mock.Anything, "/repos/proj", "feature-a", "missing").Return(nil, errors.New("unknown revision"))
+			},
+			expectError: "compare failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			ctx := &domain.Context{Type: domain.ContextProject, ProjectName: "proj", Path: "/repos/proj"}
+			cs.On("GetCurrentContext").Return(ctx, nil)
+			ps.On("DiscoverProject", mock.Anything, "", ctx).Return(project, nil)
+			tc.setupMocks(ws)
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps},
+				Config:   &domain.Config{DefaultSourceBranch: "main"},
+			}
+
+			cmd := NewCompareCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+		})
+	}
+}
+
+func TestDefaultCompareBranch(t *testing.T) {
+	config := &CommandConfig{Config: &domain.Config{DefaultSourceBranch: "develop"}}
+
+	assert.Equal(t, "trunk", defaultCompareBranch(config, &domain.ProjectInfo{DefaultBranch: "trunk"}))
+	assert.Equal(t, "develop", defaultCompareBranch(config, &domain.ProjectInfo{}))
+	assert.Equal(t, "main", defaultCompareBranch(&CommandConfig{}, &domain.ProjectInfo{}))
+}
@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"sort"
 
 	"github.com/carapace-sh/carapace"
 	"github.com/spf13/cobra"
@@ -49,6 +51,63 @@ To load completions:
 	}
 }
 
+// newCompleteWorktreesInternalCmd creates the hidden __complete-worktrees command, which prints
+// the project/branch of every worktree for shell functions and pickers built on twiggit
+func newCompleteWorktreesInternalCmd(config *CommandConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:    "__complete-worktrees",
+		Short:  "List project/branch pairs of all worktrees",
+		Hidden: true,
+		Args:   cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			pairs, err := completeWorktreePairs(config, func(err error) {
+				logv(cmd, 1, "Completion cache not saved: %v", err)
+			})
+			if err != nil {
+				return err
+			}
+			for _, pair := range pairs {
+				_, _ = fmt.Fprintln(cmd.OutOrStdout(), pair)
+			}
+			return nil
+		},
+	}
+}
+
+// completeWorktreePairs lists the sorted project/branch pairs of every project's branch
+// worktrees, reusing the CompletionCache while it is fresh. Failing to save the cache only
+// costs the next call a rescan, so it goes to saveFailed (when set) instead of failing.
+func completeWorktreePairs(config *CommandConfig, saveFailed func(error)) ([]string, error) {
+	cache := config.Services.CompletionCache
+	if cache != nil {
+		if pairs, ok := cache.Load(); ok {
+			return pairs, nil
+		}
+	}
+
+	projects, err := config.Services.ProjectService.ListProjects(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	pairs := []string{}
+	for _, project := range projects {
+		for _, wt := range project.Worktrees {
+			if wt.IsBare || wt.IsDetached || wt.Branch == "" {
+				continue
+			}
+			pairs = append(pairs, project.Name+"/"+wt.Branch)
+		}
+	}
+	sort.Strings(pairs)
+
+	if cache != nil {
+		if err := cache.Save(pairs); err != nil && saveFailed != nil {
+			saveFailed(err)
+		}
+	}
+	return pairs, nil
+}
+
 func getShellInstructions(shell string) string {
 	switch shell {
 	case "bash":
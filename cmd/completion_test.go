@@ -1,12 +1,17 @@
 package cmd
 
 import (
+	"bytes"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"twiggit/internal/domain"
+	"twiggit/test/mocks"
 )
 
 func TestCompletion_GetCompletionTimeout(t *testing.T) {
@@ -137,6 +142,25 @@ func TestCompletion_ActionWorktreeTarget_WithExistingOnly(t *testing.T) {
 	assert.NotNil(t, action)
 }
 
+func TestCompletion_ActionCreateTarget_ReturnsAction(t *testing.T) {
+	config := &CommandConfig{
+		Services: &ServiceContainer{},
+		Config:   &domain.Config{},
+	}
+	action := actionCreateTarget(config)
+
+	assert.NotNil(t, action)
+}
+
+func TestCompletion_NextPairParts(t *testing.T) {
+	pairs := []string{"api/feature", "api/fix/login", "api/fix/logout", "web/main"}
+
+	assert.Equal(t, []string{"feature", "fix/"}, nextPairParts(pairs, []string{"api"}))
+	assert.Equal(t, []string{"login", "logout"}, nextPairParts(pairs, []string{"api", "fix"}))
+	assert.Equal(t, []string{"main"}, nextPairParts(pairs, []string{"web"}))
+	assert.Empty(t, nextPairParts(pairs, []string{"ap"}), "project names match whole")
+}
+
 func TestCompletion_ActionWorktreeTarget_WithNilConfig(t *testing.T) {
 	nilConfig := &CommandConfig{
 		Services: &ServiceContainer{},
@@ -153,3 +177,96 @@ func TestCompletion_GetCompletionTimeout_NilConfigSafety(t *testing.T) {
 
 	assert.Equal(t, 500*time.Millisecond, result)
 }
+
+func TestCompleteWorktreesCommand(t *testing.T) {
+	projects := []*domain.ProjectInfo{
+		{Name: "web", Worktrees: []*domain.WorktreeInfo{
+			{Path: "/repos/web", Branch: "main"},
+			{Path: "/wt/web/detached", IsDetached: true},
+		}},
+		{Name: "api", Worktrees: []*domain.WorktreeInfo{
+			{Path: "/repos/api", IsBare: true},
+			{Path: "/wt/api/feature", Branch: "feature"},
+			{Path: "/wt/api/fix", Branch: "fix/login"},
+		}},
+	}
+
+	testCases := []struct {
+		name       string
+		cache      *mocks.MockCompletionCache
+		listErr    error
+		expectList bool
+		expectErr  string
+		expectOut  string
+	}{
+		{
+			name: "fresh cache is printed without scanning",
+			cache: func() *mocks.MockCompletionCache {
+				cache := mocks.NewMockCompletionCache()
+				cache.On("Load").Return([]string{"cached/main"}, true)
+				return cache
+			}(),
+			expectOut: "cached/main\n",
+		},
+		{
+			name: "miss scans the workspace and saves",
+			cache: func() *mocks.MockCompletionCache {
+				cache := mocks.NewMockCompletionCache()
+				cache.On("Load").Return(nil, false)
+				cache.On("Save", []string{"api/feature", "api/fix/login", "web/main"}).Return(errors.New("read-only"))
+				return cache
+			}(),
+			expectList: true,
+			expectOut:  "api/feature\napi/fix/login\nweb/main\n",
+		},
+		{
+			name:       "no cache",
+			expectList: true,
+			expectOut:  "api/feature\napi/fix/login\nweb/main\n",
+		},
+		{
+			name:       "listing failure",
+			listErr:    errors.New("projects dir missing"),
+			expectList: true,
+			expectErr:  "failed to list projects",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ps := mocks.NewMockProjectService()
+			if tc.listErr != nil {
+				ps.On("ListProjects", mock.Anything).Return(nil, tc.listErr)
+			} else {
+				ps.On("ListProjects", mock.Anything).Return(projects, nil)
+			}
+			services := &ServiceContainer{ProjectService: ps}
+			if tc.cache != nil {
+				services.CompletionCache = tc.cache
+			}
+
+			cmd := newCompleteWorktreesInternalCmd(&CommandConfig{Services: services})
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs([]string{})
+
+			err := cmd.Execute()
+			if tc.expectErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectOut, out.String())
+			}
+			if tc.expectList {
+				ps.AssertCalled(t, "ListProjects", mock.Anything)
+			} else {
+				ps.AssertNotCalled(t, "ListProjects", mock.Anything)
+			}
+			if tc.cache != nil {
+				tc.cache.AssertExpectations(t)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+	"twiggit/internal/infrastructure"
+)
+
+// NewConfigCommand creates the config command group for managing the configuration file
+func NewConfigCommand(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the configuration file",
+		Long: `Commands that operate on twiggit's configuration file.
+
+Examples:
+  twiggit config init --sample            Write a sample config.toml documenting every option
+  twiggit config get git.cli_timeout      Print the current value of a key
+  twiggit config set default_sort branch  Change a key in config.toml`,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newConfigInitCmd(config))
+	cmd.AddCommand(newConfigGetCmd(config))
+	cmd.AddCommand(newConfigSetCmd(config))
+
+	return cmd
+}
+
+func newConfigInitCmd(config *CommandConfig) *cobra.Command {
+	var sample, force bool
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Write a configuration file",
+		Long: `Write a configuration file to the XDG config path
+($XDG_CONFIG_HOME/twiggit/config.toml).
+
+With --sample, every option is written with its default value and a comment
+explaining it. An existing configuration file is kept unless --force is given.
+
+Examples:
+  twiggit config init --sample           # Write the commented sample
+  twiggit config init --sample --force   # Replace the existing config.toml`,
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{allowInvalidConfig: "true"},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeConfigInit(cmd, config, sample, force)
+		},
+	}
+
+	cmd.Flags().BoolVar(&sample, "sample", false, "write every option with its default value and a comment")
+	cmd.Flags().BoolVar(&force, "force", false, "replace an existing configuration file")
+
+	return cmd
+}
+
+func executeConfigInit(cmd *cobra.Command, config *CommandConfig, sample, force bool) error {
+	if !sample {
+		return domain.NewValidationError("config init", "sample", "false",
+			"only --sample is supported; use 'twiggit init workspace' for a minimal configuration")
+	}
+
+	path := config.Services.Initializer.ConfigPath()
+	if _, err := os.Stat(path); err == nil && !force {
+		return fmt.Errorf("%w (use --force to replace it)", domain.NewAlreadyInitializedError(path))
+	}
+
+	logv(cmd, 1, "Writing sample configuration to %s", path)
+	if err := infrastructure.WriteSampleConfig(path); err != nil {
+		return fmt.Errorf("failed to write sample configuration: %w", err)
+	}
+
+	if !isQuiet(cmd) {
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Wrote sample configuration to %s\n", path)
+	}
+	return nil
+}
+
+func newConfigGetCmd(config *CommandConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get <key>",
+		Short: "Print the current value of a configuration key",
+		Long: `Print the value twiggit uses for a dotted configuration key, after defaults,
+config.toml and TWIGGIT_* environment variables. Lists are printed
+comma-separated and durations in Go syntax, the forms 'config set' accepts.
+
+Examples:
+  twiggit config get default_source_branch
+  twiggit config get validation.protected_branches`,
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{allowInvalidConfig: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.ConfigErr != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Warning: showing the default, the configuration file failed to load: %v\n", config.ConfigErr)
+			}
+			value, err := infrastructure.GetConfigValue(config.Config, args[0])
+			if err != nil {
+				return domain.NewValidationError("config get", "key", args[0], err.Error())
+			}
+			_, _ = fmt.Fprintln(cmd.OutOrStdout(), value)
+			return nil
+		},
+	}
+}
+
+func newConfigSetCmd(config *CommandConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set <key> <value>",
+		Short: "Set a configuration key in the configuration file",
+		Long: `Set a dotted configuration key in config.toml. The value is converted to the
+key's type: booleans accept true/false and yes/no, lists are comma-separated
+and durations use Go syntax (e.g. 5m). The edited file is validated before it
+replaces the old one; comments and other keys are kept. An empty value
+removes the key, restoring its default.
+
+Examples:
+  twiggit config set git.cli_timeout 60
+  twiggit config set validation.protected_branches "main,release/*"
+  twiggit config set default_sort ""`,
+		Args:        cobra.ExactArgs(2),
+		Annotations: map[string]string{allowInvalidConfig: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, value := args[0], args[1]
+			logv(cmd, 1, "Setting %s to %q", key, value)
+			if err := config.Services.ConfigManager.SetValue(key, value); err != nil {
+				return fmt.Errorf("failed to set %s: %w", key, err)
+			}
+			if !isQuiet(cmd) {
+				if value == "" {
+					_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Removed %s\n", key)
+				} else {
+					_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Set %s to %s\n", key, value)
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/internal/infrastructure"
+	"twiggit/test/mocks"
+)
+
+func TestConfigInitCmd(t *testing.T) {
+	testCases := []struct {
+		name          string
+		args          []string
+		existing      string
+		expectError   string
+		expectOutput  string
+		expectContent string
+	}{
+		{
+			name:          "writes sample",
+			args:          []string{"init", "--sample"},
+			expectOutput:  "Wrote sample configuration to",
+			expectContent: "# twiggit configuration",
+		},
+		{
+			name:        "keeps existing config",
+			args:        []string{"init", "--sample"},
+			existing:    "projects_dir = \"/mine\"\n",
+			expectError: "already initialized",
+		},
+		{
+			name:          "force replaces existing config",
+			args:          []string{"init", "--sample", "--force"},
+			existing:      "projects_dir = \"/mine\"\n",
+			expectOutput:  "Wrote sample configuration to",
+			expectContent: "[shell.wrapper]",
+		},
+		{
+			name:        "requires sample",
+			args:        []string{"init"},
+			expectError: "only --sample is supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "twiggit", "config.toml")
+			if tc.existing != "" {
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+				require.NoError(t, os.WriteFile(path, []byte(tc.existing), 0644))
+			}
+			initializer := mocks.NewMockInitializer()
+			initializer.On("ConfigPath").Return(path).Maybe()
+
+			cmd := NewConfigCommand(&CommandConfig{
+				Services: &ServiceContainer{Initializer: initializer},
+				Config:   domain.DefaultConfig(),
+			})
+			buf := &bytes.Buffer{}
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				if tc.existing != "" {
+					data, readErr := os.ReadFile(path)
+					require.NoError(t, readErr)
+					assert.Equal(t, tc.existing, string(data))
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Contains(t, buf.String(), tc.expectOutput)
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			assert.Contains(t, string(data), tc.expectContent)
+		})
+	}
+}
+
+func TestConfigSetGetCmd_RoundTrip(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	manager := infrastructure.NewConfigManager()
+	_, err := manager.Load()
+	require.NoError(t, err)
+
+	run := func(args ...string) (string, error) {
+		cmd := NewConfigCommand(&CommandConfig{
+			Services: &ServiceContainer{ConfigManager: manager},
+			Config:   manager.GetConfig(),
+		})
+		buf := &bytes.Buffer{}
+		cmd.SetOut(buf)
+		cmd.SetErr(buf)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return buf.String(), err
+	}
+
+	testCases := []struct {
+		key, value, expected string
+	}{
+		{key: "default_source_branch", value: "develop", expected: "develop"},
+		{key: "git.cli_timeout", value: "60", expected: "60"},
+		{key: "git.cache_enabled", value: "no", expected: "false"},
+		{key: "services.cache_ttl", value: "2m", expected: "2m0s"},
+		{key: "validation.protected_branches", value: "main, release/*", expected: "main,release/*"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.key, func(t *testing.T) {
+			out, err := run("set", tc.key, tc.value)
+			require.NoError(t, err)
+			assert.Equal(t, "Set "+tc.key+" to "+tc.value+"\n", out)
+
+			out, err = run("get", tc.key)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected+"\n", out)
+		})
+	}
+
+	t.Run("reloaded from disk", func(t *testing.T) {
+		config, err := infrastructure.NewConfigManager().Load()
+		require.NoError(t, err)
+		assert.Equal(t, "develop", config.DefaultSourceBranch)
+		assert.Equal(t, 60, config.Git.CLITimeout)
+		assert.Equal(t, []string{"main", "release/*"}, config.Validation.ProtectedBranches)
+	})
+
+	t.Run("empty value removes the key", func(t *testing.T) {
+		out, err := run("set", "default_source_branch", "")
+		require.NoError(t, err)
+		assert.Equal(t, "Removed default_source_branch\n", out)
+		out, err = run("get", "default_source_branch")
+		require.NoError(t, err)
+		assert.Equal(t, domain.DefaultConfig().DefaultSourceBranch+"\n", out)
+	})
+
+	t.Run("invalid value is rejected", func(t *testing.T) {
+		_, err := run("set", "git.cli_timeout", "soon")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be a whole number")
+		out, err := run("get", "git.cli_timeout")
+		require.NoError(t, err)
+		assert.Equal(t, "60\n", out)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := run("get", "git.nope")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown configuration key")
+		_, err = run("set", "git.nope", "1")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown configuration key")
+	})
+}
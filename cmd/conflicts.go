@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+	"twiggit/internal/infrastructure"
+)
+
+// NewConflictsCommand creates a new conflicts command
+func NewConflictsCommand(config *CommandConfig) *cobra.Command {
+	var openIn string
+
+	cmd := &cobra.Command{
+		Use:   "conflicts [project/branch|branch]",
+		Short: "List files with merge conflicts in a worktree",
+		Long: `List the unmerged files of a worktree that is in the middle of a
+merge, rebase or cherry-pick.
+
+Defaults to the current worktree. With --open-in, the conflicting files
+are passed to the given editor command, which runs in the worktree.
+
+Examples:
+  twiggit conflicts                       List conflicts in the current worktree
+  twiggit conflicts myproject/feature     List conflicts in a specific worktree
+  twiggit conflicts --open-in "code -w"   Open every conflicting file in VS Code`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var target string
+			if len(args) > 0 {
+				target = args[0]
+			}
+			return executeConflicts(cmd, config, target, openIn)
+		},
+	}
+
+	cmd.Flags().StringVar(&openIn, "open-in", "", "Open conflicting files with this editor command")
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionWorktreeTarget(config, infrastructure.WithExistingOnly()),
+	)
+	carapace.Gen(cmd).FlagCompletion(map[string]carapace.Action{
+		"open-in": carapace.ActionExecutables(),
+	})
+
+	return cmd
+}
+
+// executeConflicts executes the conflicts command with the given configuration
+func executeConflicts(cmd *cobra.Command, config *CommandConfig, target, openIn string) error {
+	ctx := context.Background()
+
+	_, result, err := resolveNavigationTarget(ctx, config, target)
+	if err != nil {
+		return err
+	}
+	worktreePath := result.ResolvedPath
+
+	logv(cmd, 1, "Listing conflicts in %s", worktreePath)
+
+	conflicts, err := config.Services.WorktreeService.GetConflictingFiles(ctx, worktreePath)
+	if err != nil {
+		return fmt.Errorf("failed to list conflicts: %w", err)
+	}
+
+	out := cmd.OutOrStdout()
+	if len(conflicts) == 0 {
+		_, _ = fmt.Fprintf(out, "No conflicts in %s\n", worktreePath)
+		return nil
+	}
+
+	displayConflicts(out, conflicts)
+
+	if openIn == "" {
+		return nil
+	}
+
+	files := make([]string, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		files = append(files, conflict.Path)
+	}
+	if err := config.Services.EditorLauncher.Open(ctx, worktreePath, openIn, files); err != nil {
+		return fmt.Errorf("failed to open conflicting files: %w", err)
+	}
+	return nil
+}
+
+// displayConflicts renders conflicting files as a FILE/CONFLICT table
+func displayConflicts(out io.Writer, conflicts []domain.ConflictFile) {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "FILE\tCONFLICT")
+	for _, conflict := range conflicts {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\n", conflict.Path, describeConflict(conflict))
+	}
+	_ = tw.Flush()
+}
+
+// describeConflict summarizes both sides of a conflict, e.g. "both modified"
+func describeConflict(conflict domain.ConflictFile) string {
+	if conflict.OurStatus == conflict.TheirStatus {
+		return "both " + conflict.OurStatus
+	}
+	return fmt.Sprintf("%s by us, %s by them", conflict.OurStatus, conflict.TheirStatus)
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestConflictsCommand_Execute(t *testing.T) {
+	conflicts := []domain.ConflictFile{
+		{Path: "main.go", OurStatus: "modified", TheirStatus: "modified"},
+		{Path: "docs/old.md", OurStatus: "deleted", TheirStatus: "modified"},
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		conflicts   []domain.ConflictFile
+		listErr     error
+		openErr     error
+		expectOpen  bool
+		expectError string
+		expectOut   []string
+	}{
+		{
+			name:      "lists conflicts",
+			args:      []string{"proj/feature"},
+			conflicts: conflicts,
+			expectOut: []string{"FILE", "main.go", "both modified", "docs/old.md", "deleted by us, modified by them"},
+		},
+		{
+			name:      "no conflicts",
+			args:      []string{"proj/feature"},
+			conflicts: []domain.ConflictFile{},
+			expectOut: []string{"No conflicts in /wt/proj/feature"},
+		},
+		{
+			name:       "opens conflicting files",
+			args:       []string{"proj/feature", "--open-in", "code -w"},
+			conflicts:  conflicts,
+			expectOpen: true,
+			expectOut:  []string{"main.go"},
+		},
+		{
+			name:        "editor failure",
+			args:        []string{"proj/feature", "--open-in", "vim"},
+			conflicts:   conflicts,
+			openErr:     errors.New("exit status 1"),
+			expectOpen:  true,
+			expectError: "failed to open conflicting files",
+		},
+		{
+			name:        "service error",
+			args:        []string{"proj/feature"},
+			listErr:     errors.New("not a git repository"),
+			expectError: "failed to list conflicts",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := mocks.NewMockContextService()
+			ns := mocks.NewMockNavigationService()
+			ws := mocks.NewMockWorktreeService()
+			el := mocks.NewMockEditorLauncher()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+			ns.On("ResolvePath", mock.Anything, mock.MatchedBy(func(req *domain.ResolvePathRequest) bool {
+				return req.Target == "proj/feature"
+			})).Return(&domain.ResolutionResult{ResolvedPath: "/wt/proj/feature", Type: domain.PathTypeWorktree}, nil)
+			ws.On("GetConflictingFiles", mock.Anything, "/wt/proj/feature").Return(tc.conflicts, tc.listErr)
+			if tc.expectOpen {
+				el.On("Open", mock.Anything, "/wt/proj/feature", tc.args[2], []string{"main.go", "docs/old.md"}).Return(tc.openErr)
+			}
+
+			config := &CommandConfig{Services: &ServiceContainer{
+				ContextService:    cs,
+				NavigationService: ns,
+				WorktreeService:   ws,
+				EditorLauncher:    el,
+			}}
+			cmd := NewConflictsCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+			el.AssertExpectations(t)
+			if !tc.expectOpen {
+				el.AssertNotCalled(t, "Open", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
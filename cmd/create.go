@@ -4,17 +4,58 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/carapace-sh/carapace"
 	"github.com/spf13/cobra"
 	"twiggit/internal/domain"
 )
 
+// autoNameEnvVars lists the environment variables consulted by --auto-name, in priority order
+var autoNameEnvVars = []string{"JIRA_CURRENT_ISSUE", "LINEAR_ISSUE", "TODO"}
+
+// createOptions holds the flag values for the create command
+type createOptions struct {
+	source   string
+	cdFlag   bool
+	noCd     bool
+	autoName bool
+	link     string
+	preset   string
+
+	copyBranchConfig   bool
+	gpgSign            bool
+	squashOnMerge      bool
+	worktreeOnly       bool
+	useLocalBranch     bool
+	setDescription     string
+	ephemeral          bool
+	fromWorktree       string
+	fromTag            string
+	fromRef            string
+	fromStash          int
+	dropStash          bool
+	autoStash          bool
+	checkoutOnConflict bool
+	reusePath          string
+	failIfBehind       int
+	force              bool
+	watchCI            bool
+	watch              bool
+	ciTimeout          time.Duration
+	inheritEnv         []string
+}
+
+// cdOnCreateEnvVar is set by the shell wrapper when it runs create and changes into the printed path
+const cdOnCreateEnvVar = "TWIGGIT_CD_ON_CREATE"
+
 // NewCreateCommand creates a new create command
 func NewCreateCommand(config *CommandConfig) *cobra.Command {
-	var source string
-	var cdFlag bool
+	var opts createOptions
 
 	cmd := &cobra.Command{
 		Use:   "create <project>/<branch> | <branch>",
@@ -26,10 +67,48 @@ Examples:
   twiggit create feature/my-feature              Create from current project
   twiggit create myproject/feature/my-feature    Create for specific project
   twiggit create feature --source develop       Create from specific source branch
-  twiggit create feature -C                     Create and output path for shell`,
-		Args: cobra.ExactArgs(1),
+  twiggit create --preset hotfix critical-bug-fix  Create hotfix-critical-bug-fix from the hotfix preset's source branch
+  twiggit create feature -C                     Create and output path for shell
+  twiggit create feature --no-cd                Stay in the current directory (overrides cd_on_create)
+  twiggit create --auto-name                    Name the branch from $JIRA_CURRENT_ISSUE, $LINEAR_ISSUE or $TODO
+  twiggit create myproject --auto-name          Same, for a specific project
+  twiggit create feature-ui --link feature-api  Record that feature-ui depends on feature-api
+  twiggit create feature --copy-branch-config   Copy [branch "<source>"] git config (rebase, merge options)
+  twiggit create feature --gpg-sign             Sign commits made in the new worktree
+  twiggit create feature --squash-on-merge      Make git pull squash in the new worktree
+  twiggit create feature --set-description "OAuth2 login flow"  Set the git branch description
+  twiggit create feature-x-alt --from-worktree feature-x  Fork from feature-x's worktree HEAD
+  twiggit create hotfix-1.2.1 --from-tag v1.2.0  Fetch tags from origin, then branch from v1.2.0
+  twiggit create release-1.2 --from v1.2.0      Branch from a local tag, commit SHA or other revision
+  twiggit create feature --from-stash 0 --drop-stash  Move stash@{0} into the new worktree
+  twiggit create hotfix --auto-stash            Stash the current worktree's changes first
+  twiggit create hotfix --checkout-on-conflict  Check out hotfix here if its worktree cannot be created
+  twiggit create feature --reuse-path /mnt/ssd/feature  Use a pre-created empty directory as the worktree
+  twiggit create feature --source develop --fail-if-behind 10  Refuse if develop is 10+ commits behind main
+  twiggit create scratch --worktree-only        Register an empty, detached worktree (run git checkout yourself)
+  twiggit create feature --watch-ci             Follow the CI status of the new branch's HEAD commit
+  twiggit create feature --watch                Run the post-change hook after file changes until Ctrl-C
+  twiggit create feature --inherit-env DATABASE_URL,API_KEY  Append these variables to the worktree's .env
+  twiggit create spike --ephemeral              Delete the worktree when the shell session exits (needs the shell wrapper)`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if opts.autoName {
+				return cobra.MaximumNArgs(1)(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return executeCreate(cmd, config, args[0], source, cdFlag)
+			var spec string
+			if len(args) > 0 {
+				spec = args[0]
+			}
+			if opts.autoName {
+				autoSpec, err := buildAutoNameSpec(spec, os.Getenv)
+				if err != nil {
+					return err
+				}
+				spec = autoSpec
+			}
+			return executeCreate(cmd, config, spec, opts)
 		},
 	}
 
@@ -38,27 +117,70 @@ Examples:
 	if config != nil && config.Config != nil && config.Config.DefaultSourceBranch != "" {
 		defaultSource = config.Config.DefaultSourceBranch
 	}
-	cmd.Flags().StringVar(&source, "source", defaultSource, "Source branch to create from")
-	cmd.Flags().BoolVarP(&cdFlag, "cd", "C", false, "Output worktree path to stdout (for shell wrapper)")
+	cmd.Flags().StringVar(&opts.source, "source", defaultSource, "Source branch to create from")
+	cmd.Flags().BoolVarP(&opts.cdFlag, "cd", "C", false, "Output worktree path to stdout (deprecated: the shell wrapper changes directory when cd_on_create is set)")
+	cmd.Flags().BoolVar(&opts.noCd, "no-cd", false, "Do not change into the new worktree through the shell wrapper (overrides cd_on_create)")
+	cmd.Flags().BoolVar(&opts.autoName, "auto-name", false, "Generate the branch name from "+strings.Join(autoNameEnvVars, ", "))
+	cmd.Flags().StringVar(&opts.link, "link", "", "Record that the new worktree depends on another branch")
+	cmd.Flags().StringVar(&opts.preset, "preset", "", "Name the branch and pick its source, hooks and protection from [presets.<name>] (feature, hotfix, release, experiment or your own)")
+	cmd.Flags().BoolVar(&opts.copyBranchConfig, "copy-branch-config", false, "Copy the source branch's git config settings to the new branch")
+	cmd.Flags().BoolVar(&opts.gpgSign, "gpg-sign", false, "GPG-sign commits in the new worktree (default from git.gpg_sign_commits)")
+	cmd.Flags().BoolVar(&opts.squashOnMerge, "squash-on-merge", false, "Make git pull squash changes in the new worktree (overrides git.default_merge_strategy)")
+	cmd.Flags().BoolVar(&opts.worktreeOnly, "worktree-only", false, "Register the worktree without checking out a branch or files")
+	cmd.Flags().BoolVar(&opts.useLocalBranch, "use-local-branch", false, "Check out an existing local branch even though --source or the preset source is then ignored (default from git.use_local_branch_if_exists)")
+	cmd.Flags().StringVar(&opts.setDescription, "set-description", "", "Set the branch description (default from branch_description_template)")
+	cmd.Flags().StringVar(&opts.fromWorktree, "from-worktree", "", "Start the new branch at the HEAD commit of this branch's worktree")
+	cmd.Flags().StringVar(&opts.fromTag, "from-tag", "", "Fetch the tags of origin, then start the new branch at this tag")
+	cmd.Flags().StringVar(&opts.fromRef, "from", "", "Start the new branch at this commit SHA, tag or other git revision")
+	cmd.Flags().IntVar(&opts.fromStash, "from-stash", 0, "Apply this stash entry (stash@{n}) to the new worktree")
+	cmd.Flags().BoolVar(&opts.dropStash, "drop-stash", false, "With --from-stash, drop the stash entry once it applied without conflicts")
+	cmd.Flags().BoolVar(&opts.autoStash, "auto-stash", false, "Stash uncommitted changes of the current worktree before creating (restore them there with git stash pop)")
+	cmd.Flags().BoolVar(&opts.checkoutOnConflict, "checkout-on-conflict", false, "If the worktree path cannot be created, check out the branch in the current (clean) worktree instead")
+	cmd.Flags().IntVar(&opts.failIfBehind, "fail-if-behind", 0, "Refuse when the start point is n or more commits behind the default source branch (0 disables; default from max_behind_commits)")
+	cmd.Flags().StringVar(&opts.reusePath, "reuse-path", "", "Create the worktree in this existing directory (empty or holding only .git) instead of the worktrees directory")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "With --from-worktree, fork even if that worktree has uncommitted changes")
+	cmd.Flags().BoolVar(&opts.watchCI, "watch-ci", false, "Poll GitHub/GitLab every 30s and show the CI status of the new branch's HEAD commit")
+	cmd.Flags().BoolVar(&opts.watch, "watch", false, "Keep running and run the post-change hook of .twiggit.toml after file changes (Ctrl-C stops)")
+	cmd.Flags().DurationVar(&opts.ciTimeout, "ci-timeout", defaultCITimeout, "Stop --watch-ci after this long (e.g. 10m)")
+	cmd.Flags().StringSliceVar(&opts.inheritEnv, "inherit-env", nil, "Append these environment variables to the new worktree's .env file (comma-separated)")
+	cmd.Flags().BoolVar(&opts.ephemeral, "ephemeral", false, "Delete the worktree when the shell session exits (prints a trap for the shell wrapper)")
 
 	// Silence usage to prevent double error printing
 	cmd.SilenceUsage = true
 	cmd.SilenceErrors = true
 
 	carapace.Gen(cmd).PositionalCompletion(
-		actionWorktreeTarget(config),
+		actionCreateTarget(config),
 	)
 
 	carapace.Gen(cmd).FlagCompletion(map[string]carapace.Action{
-		"source": actionBranches(config),
+		"source":        actionBranches(config),
+		"link":          actionBranches(config),
+		"from-worktree": actionBranches(config),
+		"from":          actionBranches(config),
+		"reuse-path":    carapace.ActionDirectories(),
+		"preset":        actionPresets(config),
 	})
 
 	return cmd
 }
 
 // executeCreate executes the create command with the given configuration
-func executeCreate(cmd *cobra.Command, config *CommandConfig, spec, source string, cdFlag bool) error {
+func executeCreate(cmd *cobra.Command, config *CommandConfig, spec string, opts createOptions) error {
 	ctx := context.Background()
+	source := opts.source
+
+	var preset *domain.ResolvedPreset
+	if opts.preset != "" {
+		var err error
+		preset, spec, err = applyPreset(cmd, config, spec, opts)
+		if err != nil {
+			return err
+		}
+		if !cmd.Flags().Changed("source") {
+			source = preset.SourceBranch
+		}
+	}
 
 	// Extract branch name for validation first (before any context detection)
 	branchName := extractBranchNameForValidation(spec)
@@ -69,6 +191,75 @@ func executeCreate(cmd *cobra.Command, config *CommandConfig, spec, source strin
 		return branchValidation.Error
 	}
 
+	if opts.worktreeOnly && opts.copyBranchConfig {
+		return domain.NewValidationError("CreateWorktreeRequest", "worktree-only", "", "--worktree-only cannot be combined with --copy-branch-config: no branch is created")
+	}
+	if opts.worktreeOnly && opts.setDescription != "" {
+		return domain.NewValidationError("CreateWorktreeRequest", "worktree-only", "", "--worktree-only cannot be combined with --set-description: no branch is created")
+	}
+
+	if opts.fromWorktree != "" {
+		if cmd.Flags().Changed("source") {
+			return domain.NewValidationError("CreateWorktreeRequest", "from-worktree", opts.fromWorktree, "--from-worktree cannot be combined with --source")
+		}
+		if opts.worktreeOnly {
+			return domain.NewValidationError("CreateWorktreeRequest", "from-worktree", opts.fromWorktree, "--from-worktree cannot be combined with --worktree-only")
+		}
+		source = opts.fromWorktree
+	} else if opts.force {
+		return domain.NewValidationError("CreateWorktreeRequest", "force", "", "--force only applies to --from-worktree")
+	}
+
+	if opts.fromTag != "" {
+		if err := validateFromTag(cmd, opts); err != nil {
+			return err
+		}
+		source = "refs/tags/" + opts.fromTag
+	}
+
+	if opts.fromRef != "" {
+		if err := validateFromRef(cmd, opts); err != nil {
+			return err
+		}
+		source = opts.fromRef
+	}
+
+	fromStash := cmd.Flags().Changed("from-stash")
+	if err := validateFromStash(fromStash, opts); err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("fail-if-behind") && opts.worktreeOnly {
+		return domain.NewValidationError("CreateWorktreeRequest", "fail-if-behind", "", "--fail-if-behind cannot be combined with --worktree-only: nothing is checked out")
+	}
+
+	if opts.watchCI && opts.worktreeOnly {
+		return domain.NewValidationError("CreateWorktreeRequest", "watch-ci", "", "--watch-ci cannot be combined with --worktree-only: nothing is checked out")
+	}
+	if opts.watch && opts.watchCI {
+		return domain.NewValidationError("CreateWorktreeRequest", "watch", "", "--watch cannot be combined with --watch-ci")
+	}
+	if opts.watch && opts.worktreeOnly {
+		return domain.NewValidationError("CreateWorktreeRequest", "watch", "", "--watch cannot be combined with --worktree-only: nothing is checked out")
+	}
+	if opts.ciTimeout <= 0 {
+		return domain.NewValidationError("CreateWorktreeRequest", "ci-timeout", opts.ciTimeout.String(), "--ci-timeout must be positive")
+	}
+
+	if opts.checkoutOnConflict && opts.ephemeral {
+		return domain.NewValidationError("CreateWorktreeRequest", "checkout-on-conflict", "", "--checkout-on-conflict cannot be combined with --ephemeral: the fallback would delete the current worktree")
+	}
+
+	if opts.cdFlag && opts.noCd {
+		return domain.NewValidationError("CreateWorktreeRequest", "no-cd", "", "--no-cd cannot be combined with --cd")
+	}
+
+	if opts.link != "" {
+		if linkValidation := domain.ValidateBranchName(opts.link); linkValidation.IsError() {
+			return linkValidation.Error
+		}
+	}
+
 	// Now detect current context (after branch validation passes)
 	currentCtx, err := config.Services.ContextService.GetCurrentContext()
 	if err != nil {
@@ -87,22 +278,85 @@ func executeCreate(cmd *cobra.Command, config *CommandConfig, spec, source strin
 		return fmt.Errorf("failed to discover project %s: %w", projectName, err)
 	}
 
-	// Validate source branch exists before creating worktree
-	sourceBranchExists, err := config.Services.WorktreeService.BranchExists(ctx, project.Path, source)
-	if err != nil {
-		return domain.NewValidationError("CreateWorktreeRequest", "source", source, "failed to check if source branch exists: "+err.Error())
+	// The target project's .twiggit.toml decides the default source branch, not the --source
+	// default taken from the configuration loaded when the command was built
+	applyTargetProjectConfig(cmd, config, project.Name)
+	sourceFromConfig := !cmd.Flags().Changed("source") && preset == nil && opts.fromWorktree == "" && opts.fromTag == "" && opts.fromRef == ""
+	if sourceFromConfig && config.Config != nil && config.Config.DefaultSourceBranch != "" {
+		source = config.Config.DefaultSourceBranch
 	}
-	if !sourceBranchExists {
-		return domain.NewValidationError("CreateWorktreeRequest", "source", source, fmt.Sprintf("source branch '%s' does not exist", source))
+
+	// Refresh tags so the tag resolves to what the remote has now; git reports a missing tag
+	if opts.fromTag != "" {
+		if err := checkRemoteReachable(cmd, config, project, defaultFetchRemote); err != nil {
+			return err
+		}
+		logv(cmd, 2, "  fetching tags from: %s", defaultFetchRemote)
+		if err := config.Services.WorktreeService.FetchTagsOnly(ctx, project.GitRepoPath, defaultFetchRemote); err != nil {
+			return err
+		}
+	}
+
+	// auto_fetch starts from the remote-tracking branch, which is fresher than the local one
+	startPoint := source
+	if preset != nil && preset.AutoFetch {
+		if err := checkRemoteReachable(cmd, config, project, defaultFetchRemote); err != nil {
+			return err
+		}
+		logv(cmd, 2, "  fetching %s from: %s", source, defaultFetchRemote)
+		if err := config.Services.WorktreeService.FetchBranch(ctx, project.GitRepoPath, defaultFetchRemote, source); err != nil {
+			return err
+		}
+		startPoint = "refs/remotes/" + defaultFetchRemote + "/" + source
+	}
+
+	// Validate source branch exists before creating worktree (nothing is checked out with --worktree-only;
+	// --from-worktree is resolved to the worktree's HEAD commit by the service; a fetched branch exists on the remote)
+	if !opts.worktreeOnly && opts.fromWorktree == "" && opts.fromTag == "" && opts.fromRef == "" && startPoint == source {
+		sourceBranchExists, err := config.Services.WorktreeService.BranchExists(ctx, project.Path, source)
+		if err != nil {
+			return domain.NewValidationError("CreateWorktreeRequest", "source", source, "failed to check if source branch exists: "+err.Error())
+		}
+		if !sourceBranchExists {
+			return domain.NewValidationError("CreateWorktreeRequest", "source", source, fmt.Sprintf("source branch '%s' does not exist", source))
+		}
 	}
 
 	// Create worktree request
 	req := &domain.CreateWorktreeRequest{
 		ProjectName:  project.Name,
 		BranchName:   branchName,
-		SourceBranch: source,
+		SourceBranch: startPoint,
 		Context:      currentCtx,
-		Force:        false,
+		Force:        opts.force,
+		WorktreeOnly: opts.worktreeOnly,
+		FromWorktree: opts.fromWorktree,
+		SourceRef:    opts.fromRef,
+		DropStash:    opts.dropStash,
+		AutoStash:    opts.autoStash,
+
+		CheckoutOnConflict: opts.checkoutOnConflict,
+		ExplicitSource:     !sourceFromConfig,
+		UseLocalBranch: opts.useLocalBranch ||
+			(config.Config != nil && config.Config.Git.UseLocalBranchIfExists),
+	}
+
+	if fromStash {
+		req.FromStash = &opts.fromStash
+	}
+	if preset != nil {
+		req.PostCreateCommands = preset.Hooks
+	}
+	if cmd.Flags().Changed("fail-if-behind") {
+		req.MaxBehindCommits = &opts.failIfBehind
+	}
+	if opts.reusePath != "" {
+		reusePath, err := filepath.Abs(opts.reusePath)
+		if err != nil {
+			return fmt.Errorf("failed to resolve --reuse-path %s: %w", opts.reusePath, err)
+		}
+		req.WorktreePath = reusePath
+		req.ReuseExistingPath = true
 	}
 
 	logv(cmd, 1, "Creating worktree for %s/%s", project.Name, branchName)
@@ -117,27 +371,288 @@ func executeCreate(cmd *cobra.Command, config *CommandConfig, spec, source strin
 		return fmt.Errorf("failed to create worktree: %w", err)
 	}
 
+	if result.CheckoutFallback != nil {
+		logv(cmd, 1, "Could not create the worktree (%v); checked out %s in the current worktree instead", result.CheckoutFallback, branchName)
+	}
 	logv(cmd, 2, "  created worktree at: %s", result.Worktree.Path)
 
-	// Display output based on cdFlag and quiet mode
-	if cdFlag {
+	if preset != nil && preset.ProtectedOnDelete && result.CheckoutFallback == nil {
+		reason := "protected by preset " + preset.Preset
+		if err := config.Services.WorktreeService.LockWorktree(ctx, project.GitRepoPath, result.Worktree.Path, reason); err != nil {
+			return fmt.Errorf("worktree created but failed to lock it: %w", err)
+		}
+		logv(cmd, 2, "  locked: %s", reason)
+	}
+
+	if opts.copyBranchConfig {
+		if err := config.Services.WorktreeService.CopyBranchConfig(ctx, project.GitRepoPath, source, branchName); err != nil {
+			return fmt.Errorf("worktree created but failed to copy branch config from %s: %w", source, err)
+		}
+		logv(cmd, 2, "  copied branch config from: %s", source)
+	}
+
+	if opts.gpgSign || (config.Config != nil && config.Config.Git.GPGSignCommits) {
+		if err := config.Services.WorktreeService.EnableCommitSigning(ctx, result.Worktree.Path); err != nil {
+			return fmt.Errorf("worktree created but failed to enable commit signing: %w", err)
+		}
+		logv(cmd, 2, "  enabled commit signing")
+	}
+
+	if !opts.worktreeOnly {
+		if err := applyMergeStrategy(ctx, cmd, config, result.Worktree, opts.squashOnMerge); err != nil {
+			return err
+		}
+		if err := applyBranchDescription(ctx, cmd, config, project, result.Worktree, opts.setDescription); err != nil {
+			return err
+		}
+	}
+
+	if opts.link != "" {
+		if err := config.Services.LinkRegistry.AddLink(result.Worktree.Path, opts.link); err != nil {
+			return fmt.Errorf("worktree created but failed to record link to %s: %w", opts.link, err)
+		}
+		logv(cmd, 2, "  linked to: %s", opts.link)
+	}
+
+	if len(opts.inheritEnv) > 0 {
+		if err := config.Services.EnvInheritor.Inherit(opts.inheritEnv, result.Worktree.Path); err != nil {
+			return fmt.Errorf("worktree created but failed to write inherited environment: %w", err)
+		}
+		logv(cmd, 2, "  inherited environment: %s", strings.Join(opts.inheritEnv, ", "))
+	}
+
+	// Under the shell wrapper stdout is reserved for the path to change into
+	cdOnCreate := config.Config != nil && config.Config.CdOnCreate
+	wrapped := os.Getenv(cdOnCreateEnvVar) != ""
+	changeDir := opts.cdFlag || (wrapped && cdOnCreate && !opts.noCd)
+	if opts.cdFlag && cdOnCreate && !isQuiet(cmd) {
+		_, _ = fmt.Fprintln(cmd.ErrOrStderr(), "Warning: --cd is deprecated: cd_on_create is enabled, so the shell wrapper changes into new worktrees by default")
+	}
+
+	// Display output based on ephemeral, cdFlag, shell wrapper and quiet mode
+	if opts.ephemeral {
+		sessionID := currentSessionID(os.Getenv)
+		if err := config.Services.EphemeralRegistry.Register(result.Worktree.Path, sessionID); err != nil {
+			return fmt.Errorf("worktree created but failed to register it for cleanup: %w", err)
+		}
+		logv(cmd, 2, "  registered for cleanup in session: %s", sessionID)
+
+		// stdout is evaluated by the shell wrapper, so messages go to stderr
+		_, _ = fmt.Fprint(cmd.OutOrStdout(), ephemeralCleanupScript(sessionID, result.Worktree.Path, changeDir))
+		if !isQuiet(cmd) {
+			if err := displayCreateSuccess(cmd.ErrOrStderr(), result); err != nil {
+				return err
+			}
+		}
+	} else if opts.cdFlag {
 		// Always output path for -C flag (even in quiet mode) - task 3.6
 		_, _ = fmt.Fprintln(cmd.OutOrStdout(), result.Worktree.Path)
+		if result.CheckoutFallback != nil && !isQuiet(cmd) {
+			if err := displayCreateSuccess(cmd.ErrOrStderr(), result); err != nil {
+				return err
+			}
+		}
+	} else if wrapped {
+		if !isQuiet(cmd) {
+			if err := displayCreateSuccess(cmd.ErrOrStderr(), result); err != nil {
+				return err
+			}
+		}
+		if changeDir {
+			_, _ = fmt.Fprintln(cmd.OutOrStdout(), result.Worktree.Path)
+		}
 	} else if !isQuiet(cmd) {
 		// Suppress success message in quiet mode - task 3.4
-		if err := displayCreateSuccess(cmd.OutOrStdout(), result.Worktree); err != nil {
+		if err := displayCreateSuccess(cmd.OutOrStdout(), result); err != nil {
 			return err
 		}
 	}
 
+	if result.AutoStashPath != "" && !isQuiet(cmd) {
+		displayAutoStashReminder(cmd.ErrOrStderr(), result.AutoStashPath, branchName)
+	}
+
+	if len(result.StashConflicts) > 0 {
+		displayStashConflicts(cmd.ErrOrStderr(), domain.StashRef(opts.fromStash), result.StashConflicts)
+	}
+
 	// Display hook failure warnings
 	if result.HookResult != nil && !result.HookResult.Success {
 		displayHookFailures(cmd.ErrOrStderr(), result.HookResult)
 	}
 
+	if opts.watchCI {
+		return watchWorktreeCI(ctx, cmd, config, project, result.Worktree, opts.ciTimeout)
+	}
+	if opts.watch {
+		return watchWorktreeChanges(ctx, cmd, config, project, result.Worktree, source)
+	}
+
+	return nil
+}
+
+// applyPreset rejects flags that conflict with --preset and returns the resolved preset with
+// spec rewritten to the preset's branch name; a <project>/ prefix is kept
+func applyPreset(cmd *cobra.Command, config *CommandConfig, spec string, opts createOptions) (*domain.ResolvedPreset, string, error) {
+	switch {
+	case opts.fromWorktree != "":
+		return nil, "", domain.NewValidationError("CreateWorktreeRequest", "preset", opts.preset, "--preset cannot be combined with --from-worktree")
+	case opts.fromTag != "":
+		return nil, "", domain.NewValidationError("CreateWorktreeRequest", "preset", opts.preset, "--preset cannot be combined with --from-tag")
+	case opts.fromRef != "":
+		return nil, "", domain.NewValidationError("CreateWorktreeRequest", "preset", opts.preset, "--preset cannot be combined with --from")
+	case opts.worktreeOnly:
+		return nil, "", domain.NewValidationError("CreateWorktreeRequest", "preset", opts.preset, "--preset cannot be combined with --worktree-only: no branch is created")
+	}
+
+	cfg := domain.DefaultConfig()
+	if config.Config != nil {
+		cfg = config.Config
+	}
+
+	projectPrefix, name := "", spec
+	if project, branch, ok := strings.Cut(spec, "/"); ok {
+		projectPrefix, name = project+"/", branch
+	}
+	preset, err := domain.PresetResolver{}.Resolve(opts.preset, name, cfg)
+	if err != nil {
+		return nil, "", err
+	}
+
+	logv(cmd, 2, "  preset %s: branch %s from %s", preset.Preset, preset.BranchName, preset.SourceBranch)
+	return preset, projectPrefix + preset.BranchName, nil
+}
+
+// validateFromTag rejects flags that conflict with --from-tag
+func validateFromTag(cmd *cobra.Command, opts createOptions) error {
+	switch {
+	case cmd.Flags().Changed("source"):
+		return domain.NewValidationError("CreateWorktreeRequest", "from-tag", opts.fromTag, "--from-tag cannot be combined with --source")
+	case opts.fromWorktree != "":
+		return domain.NewValidationError("CreateWorktreeRequest", "from-tag", opts.fromTag, "--from-tag cannot be combined with --from-worktree")
+	case opts.worktreeOnly:
+		return domain.NewValidationError("CreateWorktreeRequest", "from-tag", opts.fromTag, "--from-tag cannot be combined with --worktree-only")
+	case opts.copyBranchConfig:
+		return domain.NewValidationError("CreateWorktreeRequest", "from-tag", opts.fromTag, "--from-tag cannot be combined with --copy-branch-config: a tag has no branch config")
+	}
+	return nil
+}
+
+// validateFromRef rejects flags that conflict with --from
+func validateFromRef(cmd *cobra.Command, opts createOptions) error {
+	switch {
+	case cmd.Flags().Changed("source"):
+		return domain.NewValidationError("CreateWorktreeRequest", "from", opts.fromRef, "--from cannot be combined with --source")
+	case opts.fromWorktree != "":
+		return domain.NewValidationError("CreateWorktreeRequest", "from", opts.fromRef, "--from cannot be combined with --from-worktree")
+	case opts.fromTag != "":
+		return domain.NewValidationError("CreateWorktreeRequest", "from", opts.fromRef, "--from cannot be combined with --from-tag")
+	case opts.worktreeOnly:
+		return domain.NewValidationError("CreateWorktreeRequest", "from", opts.fromRef, "--from cannot be combined with --worktree-only")
+	case opts.copyBranchConfig:
+		return domain.NewValidationError("CreateWorktreeRequest", "from", opts.fromRef, "--from cannot be combined with --copy-branch-config: a revision has no branch config")
+	}
+	return nil
+}
+
+// validateFromStash rejects an invalid --from-stash and --drop-stash without it
+func validateFromStash(fromStash bool, opts createOptions) error {
+	if !fromStash {
+		if opts.dropStash {
+			return domain.NewValidationError("CreateWorktreeRequest", "drop-stash", "", "--drop-stash only applies to --from-stash")
+		}
+		return nil
+	}
+	if opts.fromStash < 0 {
+		return domain.NewValidationError("CreateWorktreeRequest", "from-stash", strconv.Itoa(opts.fromStash), "--from-stash must be a stash index (0 or more)")
+	}
+	if opts.worktreeOnly {
+		return domain.NewValidationError("CreateWorktreeRequest", "from-stash", strconv.Itoa(opts.fromStash), "--from-stash cannot be combined with --worktree-only: nothing is checked out")
+	}
+	if opts.autoStash {
+		return domain.NewValidationError("CreateWorktreeRequest", "from-stash", strconv.Itoa(opts.fromStash), "--from-stash cannot be combined with --auto-stash: the new stash would shift the stash indexes")
+	}
+	return nil
+}
+
+// applyMergeStrategy configures git pull for the new branch: squash with --squash-on-merge,
+// otherwise git.default_merge_strategy
+func applyMergeStrategy(ctx context.Context, cmd *cobra.Command, config *CommandConfig, worktree *domain.WorktreeInfo, squashOnMerge bool) error {
+	strategy := domain.MergeStrategySquash
+	if !squashOnMerge {
+		var value string
+		if config.Config != nil {
+			value = config.Config.Git.DefaultMergeStrategy
+		}
+		parsed, err := domain.ParseMergeStrategy(value)
+		if err != nil {
+			return err
+		}
+		strategy = parsed
+	}
+	if strategy == domain.MergeStrategyMerge {
+		return nil
+	}
+
+	if err := config.Services.WorktreeService.SetMergeStrategy(ctx, worktree.Path, worktree.Branch, strategy); err != nil {
+		return fmt.Errorf("worktree created but failed to set merge strategy: %w", err)
+	}
+	logv(cmd, 2, "  merge strategy: %s", strategy)
+	return nil
+}
+
+// applyBranchDescription sets the description of the new worktree's branch from
+// --set-description, or from branch_description_template when the branch has none yet
+func applyBranchDescription(ctx context.Context, cmd *cobra.Command, config *CommandConfig, project *domain.ProjectInfo, worktree *domain.WorktreeInfo, explicit string) error {
+	description := explicit
+	if description == "" && worktree.Description == "" && config.Config != nil && config.Config.BranchDescriptionTemplate != "" {
+		rendered, err := domain.RenderBranchDescription(config.Config.BranchDescriptionTemplate,
+			domain.NewBranchDescriptionData(project.Name, worktree.Branch))
+		if err != nil {
+			return fmt.Errorf("worktree created but failed to render branch description: %w", err)
+		}
+		description = rendered
+	}
+	if description == "" {
+		return nil
+	}
+
+	if err := config.Services.WorktreeService.SetBranchDescription(ctx, project.GitRepoPath, worktree.Branch, description); err != nil {
+		return fmt.Errorf("worktree created but failed to set branch description: %w", err)
+	}
+	worktree.Description = description
+	logv(cmd, 2, "  set branch description: %s", description)
 	return nil
 }
 
+// buildAutoNameSpec builds a create spec from the first set --auto-name environment
+// variable. A non-empty project argument is used as the project prefix.
+func buildAutoNameSpec(project string, getenv func(string) string) (string, error) {
+	if strings.Contains(project, "/") {
+		return "", domain.NewValidationError("CreateWorktreeRequest", "spec", project, "--auto-name accepts only a project name, not <project>/<branch>")
+	}
+
+	for _, envVar := range autoNameEnvVars {
+		value := getenv(envVar)
+		if value == "" {
+			continue
+		}
+
+		branchName := domain.Slugify(value)
+		if branchName == "" {
+			return "", domain.NewValidationError("CreateWorktreeRequest", envVar, value, "value does not produce a usable branch name")
+		}
+
+		if project != "" {
+			return project + "/" + branchName, nil
+		}
+		return branchName, nil
+	}
+
+	return "", domain.NewValidationError("CreateWorktreeRequest", "auto-name", "", "no ticket context found").
+		WithSuggestions([]string{"Set one of: " + strings.Join(autoNameEnvVars, ", ")})
+}
+
 // parseProjectBranch parses the project/branch specification
 func parseProjectBranch(spec string, ctx *domain.Context) (string, string, error) {
 	if strings.Contains(spec, "/") {
@@ -174,15 +689,39 @@ func extractBranchNameForValidation(spec string) string {
 	return spec
 }
 
-// displayCreateSuccess displays the success message for worktree creation
-func displayCreateSuccess(out io.Writer, worktree *domain.WorktreeInfo) error {
-	_, err := fmt.Fprintf(out, "Created worktree: %s -> %s\n", worktree.Branch, worktree.Path)
+// displayCreateSuccess displays the success message for worktree creation, or for the
+// --checkout-on-conflict fallback with the reason the worktree was not created
+func displayCreateSuccess(out io.Writer, result *domain.CreateWorktreeResult) error {
+	worktree := result.Worktree
+	var err error
+	if result.CheckoutFallback != nil {
+		_, err = fmt.Fprintf(out, "Could not create the worktree: %v\nChecked out in the current worktree instead: %s -> %s\n", result.CheckoutFallback, worktree.Branch, worktree.Path)
+	} else {
+		_, err = fmt.Fprintf(out, "Created worktree: %s -> %s\n", worktree.Branch, worktree.Path)
+	}
+	if err == nil && worktree.Description != "" {
+		_, err = fmt.Fprintf(out, "Description: %s\n", worktree.Description)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to display success message: %w", err)
 	}
 	return nil
 }
 
+// displayStashConflicts warns that the stash left unmerged files in the new worktree
+func displayStashConflicts(out io.Writer, ref string, conflicts []domain.ConflictFile) {
+	_, _ = fmt.Fprintf(out, "\nWarning: %s applied with conflicts in %d file(s); %s was kept. Resolve them in the new worktree:\n", ref, len(conflicts), ref)
+	for _, conflict := range conflicts {
+		_, _ = fmt.Fprintf(out, "  %s/%s: %s\n", conflict.OurStatus, conflict.TheirStatus, conflict.Path)
+	}
+}
+
+// displayAutoStashReminder tells where --auto-stash left the changes of the previous worktree
+func displayAutoStashReminder(out io.Writer, worktreePath, branchName string) {
+	_, _ = fmt.Fprintf(out, "\nNote: the uncommitted changes of %s are in %s (\"auto-stash before creating %s\").\n", worktreePath, domain.StashRef(0), branchName)
+	_, _ = fmt.Fprintf(out, "  Restore them there with: git stash pop\n")
+}
+
 // displayHookFailures displays hook failure warnings to stderr
 func displayHookFailures(out io.Writer, result *domain.HookResult) {
 	_, _ = fmt.Fprintf(out, "\nWarning: %d post-create hook(s) failed. Worktree created but setup may be incomplete.\n", len(result.Failures))
@@ -2,6 +2,9 @@ package cmd
 
 import (
 	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -169,3 +172,1350 @@ func TestCreateCommand_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildAutoNameSpec(t *testing.T) {
+	testCases := []struct {
+		name         string
+		project      string
+		env          map[string]string
+		expected     string
+		errorMessage string
+	}{
+		{
+			name:     "jira takes priority",
+			env:      map[string]string{"JIRA_CURRENT_ISSUE": "PROJ-123 Fix login", "LINEAR_ISSUE": "LIN-1", "TODO": "todo"},
+			expected: "proj-123-fix-login",
+		},
+		{
+			name:     "linear used when jira unset",
+			env:      map[string]string{"LINEAR_ISSUE": "LIN-42", "TODO": "todo"},
+			expected: "lin-42",
+		},
+		{
+			name:     "todo used last",
+			env:      map[string]string{"TODO": "Write the docs"},
+			expected: "write-the-docs",
+		},
+		{
+			name:     "project prefix",
+			project:  "myproject",
+			env:      map[string]string{"TODO": "cleanup"},
+			expected: "myproject/cleanup",
+		},
+		{
+			name:         "no env vars set",
+			env:          map[string]string{},
+			errorMessage: "no ticket context found",
+		},
+		{
+			name:         "value without usable characters",
+			env:          map[string]string{"JIRA_CURRENT_ISSUE": "!!!"},
+			errorMessage: "does not produce a usable branch name",
+		},
+		{
+			name:         "project/branch spec rejected",
+			project:      "myproject/feature",
+			env:          map[string]string{"TODO": "x"},
+			errorMessage: "accepts only a project name",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			getenv := func(key string) string { return tc.env[key] }
+
+			spec, err := buildAutoNameSpec(tc.project, getenv)
+			if tc.errorMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMessage)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, spec)
+		})
+	}
+}
+
+func TestCreateCommand_AutoName(t *testing.T) {
+	t.Setenv("JIRA_CURRENT_ISSUE", "")
+	t.Setenv("LINEAR_ISSUE", "LIN-7 Add search")
+	t.Setenv("TODO", "")
+
+	mockWS := mocks.NewMockWorktreeService()
+	mockCS := mocks.NewMockContextService()
+	mockPS := mocks.NewMockProjectService()
+
+	mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "current-project"}, nil)
+	mockPS.On("DiscoverProject", mock.Anything, "current-project", mock.AnythingOfType("*domain.Context")).Return(&domain.ProjectInfo{
+		Name: "current-project",
+	}, nil)
+	mockWS.On("BranchExists", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	mockWS.On("CreateWorktree", mock.Anything, mock.MatchedBy(func(req *domain.CreateWorktreeRequest) bool {
+		return req.BranchName == "lin-7-add-search"
+	})).Return(&domain.CreateWorktreeResult{
+		Worktree: &domain.WorktreeInfo{Path: "/wt/current-project/lin-7-add-search", Branch: "lin-7-add-search"},
+	}, nil)
+
+	config := &CommandConfig{
+		Services: &ServiceContainer{
+			WorktreeService: mockWS,
+			ContextService:  mockCS,
+			ProjectService:  mockPS,
+		},
+	}
+
+	cmd := NewCreateCommand(config)
+	cmd.SetArgs([]string{"--auto-name"})
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), "lin-7-add-search")
+	mockWS.AssertExpectations(t)
+}
+
+func TestCreateCommand_RequiresSpecWithoutAutoName(t *testing.T) {
+	cmd := NewCreateCommand(&CommandConfig{Services: &ServiceContainer{}})
+	cmd.SetArgs([]string{})
+	cmd.SetOut(&bytes.Buffer{})
+
+	require.Error(t, cmd.Execute())
+}
+
+func TestCreateCommand_Link(t *testing.T) {
+	mockWS := mocks.NewMockWorktreeService()
+	mockCS := mocks.NewMockContextService()
+	mockPS := mocks.NewMockProjectService()
+	mockLR := mocks.NewMockLinkRegistry()
+
+	mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+	mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).Return(&domain.ProjectInfo{Name: "proj"}, nil)
+	mockWS.On("BranchExists", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	mockWS.On("CreateWorktree", mock.Anything, mock.AnythingOfType("*domain.CreateWorktreeRequest")).Return(&domain.CreateWorktreeResult{
+		Worktree: &domain.WorktreeInfo{Path: "/wt/proj/feature-ui", Branch: "feature-ui"},
+	}, nil)
+	mockLR.On("AddLink", "/wt/proj/feature-ui", "feature-api").Return(nil)
+
+	config := &CommandConfig{
+		Services: &ServiceContainer{
+			WorktreeService: mockWS,
+			ContextService:  mockCS,
+			ProjectService:  mockPS,
+			LinkRegistry:    mockLR,
+		},
+	}
+
+	cmd := NewCreateCommand(config)
+	cmd.SetArgs([]string{"feature-ui", "--link", "feature-api"})
+	cmd.SetOut(&bytes.Buffer{})
+
+	require.NoError(t, cmd.Execute())
+	mockLR.AssertExpectations(t)
+}
+
+func TestCreateCommand_LinkInvalidBranch(t *testing.T) {
+	cmd := NewCreateCommand(&CommandConfig{Services: &ServiceContainer{}})
+	cmd.SetArgs([]string{"feature-ui", "--link", "bad branch"})
+	cmd.SetOut(&bytes.Buffer{})
+
+	require.Error(t, cmd.Execute())
+}
+
+func TestCreateCommand_InheritEnv(t *testing.T) {
+	testCases := []struct {
+		name        string
+		inheritErr  error
+		expectError string
+	}{
+		{name: "writes requested variables"},
+		{name: "inherit failure", inheritErr: errors.New("permission denied"), expectError: "worktree created but failed to write inherited environment"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+			mockEI := mocks.NewMockEnvInheritor()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).Return(&domain.ProjectInfo{Name: "proj"}, nil)
+			mockWS.On("BranchExists", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+			mockWS.On("CreateWorktree", mock.Anything, mock.AnythingOfType("*domain.CreateWorktreeRequest")).Return(&domain.CreateWorktreeResult{
+				Worktree: &domain.WorktreeInfo{Path: "/wt/proj/feature-ui", Branch: "feature-ui"},
+			}, nil)
+			mockEI.On("Inherit", []string{"DATABASE_URL", "API_KEY"}, "/wt/proj/feature-ui").Return(tc.inheritErr)
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{
+					WorktreeService: mockWS,
+					ContextService:  mockCS,
+					ProjectService:  mockPS,
+					EnvInheritor:    mockEI,
+				},
+			}
+
+			cmd := NewCreateCommand(config)
+			cmd.SetArgs([]string{"feature-ui", "--inherit-env", "DATABASE_URL,API_KEY"})
+			cmd.SetOut(&bytes.Buffer{})
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			mockEI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateCommand_CopyBranchConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		copyErr     error
+		expectError string
+	}{
+		{name: "copies source branch config"},
+		{name: "copy failure is reported", copyErr: errors.New("config locked"), expectError: "worktree created but failed to copy branch config from develop"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).
+				Return(&domain.ProjectInfo{Name: "proj", GitRepoPath: "/repos/proj"}, nil)
+			mockWS.On("BranchExists", mock.Anything, mock.Anything, "develop").Return(true, nil)
+			mockWS.On("CreateWorktree", mock.Anything, mock.AnythingOfType("*domain.CreateWorktreeRequest")).Return(&domain.CreateWorktreeResult{
+				Worktree: &domain.WorktreeInfo{Path: "/wt/proj/feature", Branch: "feature"},
+			}, nil)
+			mockWS.On("CopyBranchConfig", mock.Anything, "/repos/proj", "develop", "feature").Return(tc.copyErr)
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+			}
+
+			cmd := NewCreateCommand(config)
+			cmd.SetArgs([]string{"feature", "--source", "develop", "--copy-branch-config"})
+			cmd.SetOut(&bytes.Buffer{})
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			mockWS.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateCommand_GPGSign(t *testing.T) {
+	testCases := []struct {
+		name        string
+		args        []string
+		configSign  bool
+		signErr     error
+		expectSign  bool
+		expectError string
+	}{
+		{name: "flag enables signing", args: []string{"feature", "--gpg-sign"}, expectSign: true},
+		{name: "config enables signing", args: []string{"feature"}, configSign: true, expectSign: true},
+		{name: "signing disabled by default", args: []string{"feature"}},
+		{
+			name:        "signing failure is reported",
+			args:        []string{"feature", "--gpg-sign"},
+			signErr:     errors.New("config locked"),
+			expectSign:  true,
+			expectError: "worktree created but failed to enable commit signing",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).
+				Return(&domain.ProjectInfo{Name: "proj", GitRepoPath: "/repos/proj"}, nil)
+			mockWS.On("BranchExists", mock.Anything, mock.Anything, "main").Return(true, nil)
+			mockWS.On("CreateWorktree", mock.Anything, mock.AnythingOfType("*domain.CreateWorktreeRequest")).Return(&domain.CreateWorktreeResult{
+				Worktree: &domain.WorktreeInfo{Path: "/wt/proj/feature", Branch: "feature"},
+			}, nil)
+			if tc.expectSign {
+				mockWS.On("EnableCommitSigning", mock.Anything, "/wt/proj/feature").Return(tc.signErr)
+			}
+
+			appConfig := domain.DefaultConfig()
+			appConfig.Git.GPGSignCommits = tc.configSign
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+				Config:   appConfig,
+			}
+
+			cmd := NewCreateCommand(config)
+			cmd.SetArgs(tc.args)
+			cmd.SetOut(&bytes.Buffer{})
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			mockWS.AssertExpectations(t)
+			if !tc.expectSign {
+				mockWS.AssertNotCalled(t, "EnableCommitSigning", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestCreateCommand_MergeStrategy(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		configStrategy string
+		expectStrategy domain.MergeStrategy
+		setErr         error
+		expectError    string
+	}{
+		{name: "flag squashes", args: []string{"feature", "--squash-on-merge"}, expectStrategy: domain.MergeStrategySquash},
+		{name: "flag overrides configured rebase", args: []string{"feature", "--squash-on-merge"}, configStrategy: "rebase", expectStrategy: domain.MergeStrategySquash},
+		{name: "configured squash", args: []string{"feature"}, configStrategy: "squash", expectStrategy: domain.MergeStrategySquash},
+		{name: "configured rebase", args: []string{"feature"}, configStrategy: "rebase", expectStrategy: domain.MergeStrategyRebase},
+		{name: "merge needs no config", args: []string{"feature"}, configStrategy: "merge"},
+		{
+			name:           "failure is reported",
+			args:           []string{"feature", "--squash-on-merge"},
+			expectStrategy: domain.MergeStrategySquash,
+			setErr:         errors.New("config locked"),
+			expectError:    "worktree created but failed to set merge strategy",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).
+				Return(&domain.ProjectInfo{Name: "proj", GitRepoPath: "/repos/proj"}, nil)
+			mockWS.On("BranchExists", mock.Anything, mock.Anything, "main").Return(true, nil)
+			mockWS.On("CreateWorktree", mock.Anything, mock.AnythingOfType("*domain.CreateWorktreeRequest")).Return(&domain.CreateWorktreeResult{
+				Worktree: &domain.WorktreeInfo{Path: "/wt/proj/feature", Branch: "feature"},
+			}, nil)
+			if tc.expectStrategy != "" {
+				mockWS.On("SetMergeStrategy", mock.Anything, "/wt/proj/feature", "feature", tc.expectStrategy).Return(tc.setErr)
+			}
+
+			appConfig := domain.DefaultConfig()
+			appConfig.Git.DefaultMergeStrategy = tc.configStrategy
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+				Config:   appConfig,
+			}
+
+			cmd := NewCreateCommand(config)
+			cmd.SetArgs(tc.args)
+			cmd.SetOut(&bytes.Buffer{})
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			mockWS.AssertExpectations(t)
+			if tc.expectStrategy == "" {
+				mockWS.AssertNotCalled(t, "SetMergeStrategy", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestCreateCommand_WorktreeOnly(t *testing.T) {
+	t.Run("registers worktree without checking the source branch", func(t *testing.T) {
+		mockWS := mocks.NewMockWorktreeService()
+		mockCS := mocks.NewMockContextService()
+		mockPS := mocks.NewMockProjectService()
+
+		mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+		mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).
+			Return(&domain.ProjectInfo{Name: "proj", GitRepoPath: "/repos/proj"}, nil)
+		mockWS.On("CreateWorktree", mock.Anything, mock.MatchedBy(func(req *domain.CreateWorktreeRequest) bool {
+			return req.WorktreeOnly && req.BranchName == "scratch"
+		})).Return(&domain.CreateWorktreeResult{
+			Worktree: &domain.WorktreeInfo{Path: "/wt/proj/scratch", Branch: "(detached)", IsDetached: true},
+		}, nil)
+
+		config := &CommandConfig{
+			Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+			Config:   domain.DefaultConfig(),
+		}
+		cmd := NewCreateCommand(config)
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetArgs([]string{"scratch", "--worktree-only"})
+
+		require.NoError(t, cmd.Execute())
+		assert.Contains(t, out.String(), "(detached) -> /wt/proj/scratch")
+		mockWS.AssertExpectations(t)
+		mockWS.AssertNotCalled(t, "BranchExists", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects --copy-branch-config", func(t *testing.T) {
+		config := &CommandConfig{Services: &ServiceContainer{}, Config: domain.DefaultConfig()}
+		cmd := NewCreateCommand(config)
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetArgs([]string{"scratch", "--worktree-only", "--copy-branch-config"})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be combined with --copy-branch-config")
+	})
+}
+
+func TestCreateCommand_UseLocalBranch(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		fromConf       bool
+		expected       bool
+		explicitSource bool
+	}{
+		{name: "off by default", args: []string{"feature"}, expected: false},
+		{name: "flag", args: []string{"feature", "--use-local-branch"}, expected: true},
+		{name: "config default", args: []string{"feature"}, fromConf: true, expected: true},
+		{name: "explicit source", args: []string{"feature", "--source", "main"}, expected: false, explicitSource: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).
+				Return(&domain.ProjectInfo{Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj"}, nil)
+			mockWS.On("BranchExists", mock.Anything, "/repos/proj", "main").Return(true, nil)
+			mockWS.On("CreateWorktree", mock.Anything, mock.MatchedBy(func(req *domain.CreateWorktreeRequest) bool {
+				return req.UseLocalBranch == tc.expected && req.ExplicitSource == tc.explicitSource
+			})).Return(&domain.CreateWorktreeResult{
+				Worktree: &domain.WorktreeInfo{Path: "/wt/proj/feature", Branch: "feature"},
+			}, nil)
+
+			cfg := domain.DefaultConfig()
+			cfg.Git.UseLocalBranchIfExists = tc.fromConf
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+				Config:   cfg,
+			}
+			cmd := NewCreateCommand(config)
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			require.NoError(t, cmd.Execute())
+			mockWS.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateCommand_ShowsBranchDescription(t *testing.T) {
+	mockWS := mocks.NewMockWorktreeService()
+	mockCS := mocks.NewMockContextService()
+	mockPS := mocks.NewMockProjectService()
+
+	mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+	mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).
+		Return(&domain.ProjectInfo{Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj"}, nil)
+	mockWS.On("BranchExists", mock.Anything, "/repos/proj", "main").Return(true, nil)
+	mockWS.On("CreateWorktree", mock.Anything, mock.AnythingOfType("*domain.CreateWorktreeRequest")).Return(&domain.CreateWorktreeResult{
+		Worktree: &domain.WorktreeInfo{Path: "/wt/proj/feature", Branch: "feature", Description: "Retry failed payments"},
+	}, nil)
+
+	config := &CommandConfig{
+		Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+		Config:   domain.DefaultConfig(),
+	}
+	cmd := NewCreateCommand(config)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"feature", "--use-local-branch"})
+
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "Created worktree: feature -> /wt/proj/feature\nDescription: Retry failed payments\n", out.String())
+}
+
+func TestCreateCommand_SetDescription(t *testing.T) {
+	testCases := []struct {
+		name         string
+		args         []string
+		template     string
+		existing     string
+		setErr       error
+		expectSet    string
+		expectError  string
+		expectOutput string
+	}{
+		{
+			name:         "flag",
+			args:         []string{"feature", "--set-description", "OAuth2 login flow"},
+			expectSet:    "OAuth2 login flow",
+			expectOutput: "Description: OAuth2 login flow\n",
+		},
+		{
+			name:         "template",
+			args:         []string{"proj-42-login"},
+			template:     "{{.JiraKey}} {{.Project}}/{{.Branch}}",
+			expectSet:    "PROJ-42 proj/proj-42-login",
+			expectOutput: "Description: PROJ-42 proj/proj-42-login\n",
+		},
+		{
+			name:      "flag wins over template",
+			args:      []string{"feature", "--set-description", "Explicit"},
+			template:  "{{.Branch}}",
+			expectSet: "Explicit",
+		},
+		{
+			name:         "template keeps an existing description",
+			args:         []string{"feature", "--use-local-branch"},
+			template:     "{{.Branch}}",
+			existing:     "Already described",
+			expectOutput: "Description: Already described\n",
+		},
+		{
+			name:        "set failure",
+			args:        []string{"feature", "--set-description", "OAuth2 login flow"},
+			setErr:      errors.New("locked"),
+			expectSet:   "OAuth2 login flow",
+			expectError: "worktree created but failed to set branch description",
+		},
+		{
+			name:        "rejected with --worktree-only",
+			args:        []string{"feature", "--worktree-only", "--set-description", "x"},
+			expectError: "cannot be combined with --set-description",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil).Maybe()
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).
+				Return(&domain.ProjectInfo{Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj"}, nil).Maybe()
+			mockWS.On("BranchExists", mock.Anything, "/repos/proj", "main").Return(true, nil).Maybe()
+			branch := tc.args[0]
+			mockWS.On("CreateWorktree", mock.Anything, mock.AnythingOfType("*domain.CreateWorktreeRequest")).Return(&domain.CreateWorktreeResult{
+				Worktree: &domain.WorktreeInfo{Path: "/wt/proj/" + branch, Branch: branch, Description: tc.existing},
+			}, nil).Maybe()
+			if tc.expectSet != "" {
+				mockWS.On("SetBranchDescription", mock.Anything, "/repos/proj", branch, tc.expectSet).Return(tc.setErr).Once()
+			}
+
+			cfg := domain.DefaultConfig()
+			cfg.BranchDescriptionTemplate = tc.template
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+				Config:   cfg,
+			}
+			cmd := NewCreateCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, out.String(), tc.expectOutput)
+			mockWS.AssertExpectations(t)
+			if tc.expectSet == "" {
+				mockWS.AssertNotCalled(t, "SetBranchDescription", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestCreateCommand_TargetProjectConfig(t *testing.T) {
+	mockWS := mocks.NewMockWorktreeService()
+	mockCS := mocks.NewMockContextService()
+	mockPS := mocks.NewMockProjectService()
+	mockCM := mocks.NewMockConfigManager()
+
+	global := domain.DefaultConfig()
+	global.ProjectsDirectory = "/projects"
+	otherConfig := *global
+	otherConfig.DefaultSourceBranch = "develop"
+
+	// Run from outside the target project: only the argument names it
+	mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit, Path: "/tmp"}, nil)
+	mockPS.On("DiscoverProject", mock.Anything, "other", mock.AnythingOfType("*domain.Context")).
+		Return(&domain.ProjectInfo{Name: "other", Path: "/projects/other", GitRepoPath: "/projects/other"}, nil)
+	mockCM.On("LoadForProject", "/projects/other").Return(&otherConfig, nil)
+	mockWS.On("BranchExists", mock.Anything, "/projects/other", "develop").Return(true, nil)
+	mockWS.On("CreateWorktree", mock.Anything, mock.MatchedBy(func(req *domain.CreateWorktreeRequest) bool {
+		return req.ProjectName == "other" && req.SourceBranch == "develop"
+	})).Return(&domain.CreateWorktreeResult{Worktree: &domain.WorktreeInfo{Path: "/wt/other/feat", Branch: "feat"}}, nil)
+
+	config := &CommandConfig{
+		Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS, ConfigManager: mockCM},
+		Config:   global,
+	}
+	cmd := NewCreateCommand(config)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"other/feat"})
+
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "develop", config.Config.DefaultSourceBranch)
+	mockCM.AssertExpectations(t)
+	mockWS.AssertExpectations(t)
+}
+
+func TestCreateCommand_Ephemeral(t *testing.T) {
+	t.Setenv(sessionIDEnvVar, "4242")
+
+	mockWS := mocks.NewMockWorktreeService()
+	mockCS := mocks.NewMockContextService()
+	mockPS := mocks.NewMockProjectService()
+	mockReg := mocks.NewMockEphemeralRegistry()
+
+	mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+	mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).
+		Return(&domain.ProjectInfo{Name: "proj", GitRepoPath: "/repos/proj"}, nil)
+	mockWS.On("BranchExists", mock.Anything, mock.Anything, "main").Return(true, nil)
+	mockWS.On("CreateWorktree", mock.Anything, mock.AnythingOfType("*domain.CreateWorktreeRequest")).Return(&domain.CreateWorktreeResult{
+		Worktree: &domain.WorktreeInfo{Path: "/wt/proj/spike", Branch: "spike"},
+	}, nil)
+	mockReg.On("Register", "/wt/proj/spike", "4242").Return(nil)
+
+	config := &CommandConfig{
+		Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS, EphemeralRegistry: mockReg},
+		Config:   domain.DefaultConfig(),
+	}
+	cmd := NewCreateCommand(config)
+	var out, errOut bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+	cmd.SetArgs([]string{"spike", "--ephemeral", "-C"})
+
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, ephemeralCleanupScript("4242", "/wt/proj/spike", true), out.String())
+	assert.True(t, strings.HasPrefix(out.String(), "builtin cd '/wt/proj/spike'\n"))
+	assert.Contains(t, errOut.String(), "Created worktree: spike -> /wt/proj/spike")
+	mockReg.AssertExpectations(t)
+}
+
+func TestCreateCommand_CdOnCreate(t *testing.T) {
+	testCases := []struct {
+		name         string
+		args         []string
+		wrapped      bool
+		cdOnCreate   bool
+		expectError  string
+		expectOut    string
+		expectErrOut string
+	}{
+		{
+			name:         "wrapper changes into the worktree by default",
+			args:         []string{"feature"},
+			wrapped:      true,
+			cdOnCreate:   true,
+			expectOut:    "/wt/proj/feature\n",
+			expectErrOut: "Created worktree: feature -> /wt/proj/feature",
+		},
+		{
+			name:         "--no-cd keeps stdout empty under the wrapper",
+			args:         []string{"feature", "--no-cd"},
+			wrapped:      true,
+			cdOnCreate:   true,
+			expectErrOut: "Created worktree: feature -> /wt/proj/feature",
+		},
+		{
+			name:         "cd_on_create disabled",
+			args:         []string{"feature"},
+			wrapped:      true,
+			expectErrOut: "Created worktree: feature -> /wt/proj/feature",
+		},
+		{
+			name:       "without the wrapper the success message goes to stdout",
+			args:       []string{"feature"},
+			cdOnCreate: true,
+			expectOut:  "Created worktree: feature -> /wt/proj/feature",
+		},
+		{
+			name:         "--cd is deprecated when cd_on_create is enabled",
+			args:         []string{"feature", "--cd"},
+			cdOnCreate:   true,
+			expectOut:    "/wt/proj/feature\n",
+			expectErrOut: "--cd is deprecated",
+		},
+		{
+			name:        "--cd and --no-cd are exclusive",
+			args:        []string{"feature", "--cd", "--no-cd"},
+			cdOnCreate:  true,
+			expectError: "--no-cd cannot be combined with --cd",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.wrapped {
+				t.Setenv(cdOnCreateEnvVar, "1")
+			} else {
+				t.Setenv(cdOnCreateEnvVar, "")
+			}
+
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil).Maybe()
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).
+				Return(&domain.ProjectInfo{Name: "proj", GitRepoPath: "/repos/proj"}, nil).Maybe()
+			mockWS.On("BranchExists", mock.Anything, mock.Anything, "main").Return(true, nil).Maybe()
+			mockWS.On("CreateWorktree", mock.Anything, mock.AnythingOfType("*domain.CreateWorktreeRequest")).Return(&domain.CreateWorktreeResult{
+				Worktree: &domain.WorktreeInfo{Path: "/wt/proj/feature", Branch: "feature"},
+			}, nil).Maybe()
+
+			cfg := domain.DefaultConfig()
+			cfg.CdOnCreate = tc.cdOnCreate
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+				Config:   cfg,
+			}
+			cmd := NewCreateCommand(config)
+			var out, errOut bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&errOut)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expectOut == "" {
+				assert.Empty(t, out.String())
+			} else {
+				assert.Contains(t, out.String(), tc.expectOut)
+			}
+			if tc.expectErrOut == "" {
+				assert.Empty(t, errOut.String())
+			} else {
+				assert.Contains(t, errOut.String(), tc.expectErrOut)
+			}
+		})
+	}
+}
+
+func TestCreateCommand_FromWorktree(t *testing.T) {
+	testCases := []struct {
+		name        string
+		args        []string
+		expectForce bool
+		expectError string
+	}{
+		{name: "forks from a worktree", args: []string{"feature-alt", "--from-worktree", "feature"}},
+		{name: "force passed through", args: []string{"feature-alt", "--from-worktree", "feature", "--force"}, expectForce: true},
+		{name: "rejected with --source", args: []string{"feature-alt", "--from-worktree", "feature", "--source", "develop"}, expectError: "cannot be combined with --source"},
+		{name: "rejected with --worktree-only", args: []string{"feature-alt", "--from-worktree", "feature", "--worktree-only"}, expectError: "cannot be combined with --worktree-only"},
+		{name: "--force needs --from-worktree", args: []string{"feature-alt", "--force"}, expectError: "--force only applies to --from-worktree"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil).Maybe()
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).
+				Return(&domain.ProjectInfo{Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj"}, nil).Maybe()
+			mockWS.On("CreateWorktree", mock.Anything, mock.MatchedBy(func(req *domain.CreateWorktreeRequest) bool {
+				return req.FromWorktree == "feature" && req.SourceBranch == "feature" && req.Force == tc.expectForce
+			})).Return(&domain.CreateWorktreeResult{
+				Worktree: &domain.WorktreeInfo{Path: "/wt/proj/feature-alt", Branch: "feature-alt", Description: "Forked from feature"},
+			}, nil).Maybe()
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+				Config:   domain.DefaultConfig(),
+			}
+			cmd := NewCreateCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				mockWS.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, out.String(), "Description: Forked from feature")
+			mockWS.AssertNotCalled(t, "BranchExists", mock.Anything, mock.Anything, mock.Anything)
+			mockWS.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateCommand_FromTag(t *testing.T) {
+	project := &domain.ProjectInfo{
+		Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj",
+		Remotes: []*domain.RemoteInfo{{Name: "origin", FetchURL: "git@github.com:me/proj.git"}},
+	}
+
+	testCases := []struct {
+		name          string
+		args          []string
+		network       *mocks.MockNetworkChecker
+		skipCheck     bool
+		expectError   string
+		expectNoFetch bool
+	}{
+		{name: "fetches tags then branches from the tag", args: []string{"hotfix", "--from-tag", "v1.2.0"}, network: mocks.NewReachableNetworkChecker()},
+		{name: "skip_network_check skips the dial", args: []string{"hotfix", "--from-tag", "v1.2.0"}, network: mocks.NewMockNetworkChecker(), skipCheck: true},
+		{name: "unreachable remote", args: []string{"hotfix", "--from-tag", "v1.2.0"}, network: mocks.NewUnreachableNetworkChecker(), expectError: "cannot reach", expectNoFetch: true},
+		{name: "rejected with --source", args: []string{"hotfix", "--from-tag", "v1.2.0", "--source", "develop"}, expectError: "--from-tag cannot be combined with --source", expectNoFetch: true},
+		{name: "rejected with --copy-branch-config", args: []string{"hotfix", "--from-tag", "v1.2.0", "--copy-branch-config"}, expectError: "a tag has no branch config", expectNoFetch: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil).Maybe()
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).Return(project, nil).Maybe()
+			mockWS.On("FetchTagsOnly", mock.Anything, "/repos/proj", "origin").Return(nil).Maybe()
+			mockWS.On("CreateWorktree", mock.Anything, mock.MatchedBy(func(req *domain.CreateWorktreeRequest) bool {
+				return req.SourceBranch == "refs/tags/v1.2.0"
+			})).Return(&domain.CreateWorktreeResult{
+				Worktree: &domain.WorktreeInfo{Path: "/wt/proj/hotfix", Branch: "hotfix"},
+			}, nil).Maybe()
+
+			cfg := domain.DefaultConfig()
+			cfg.Git.SkipNetworkCheck = tc.skipCheck
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS, NetworkChecker: tc.network},
+				Config:   cfg,
+			}
+			cmd := NewCreateCommand(config)
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectNoFetch {
+				mockWS.AssertNotCalled(t, "FetchTagsOnly", mock.Anything, mock.Anything, mock.Anything)
+			}
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				mockWS.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			mockWS.AssertNotCalled(t, "BranchExists", mock.Anything, mock.Anything, mock.Anything)
+			mockWS.AssertExpectations(t)
+			if tc.skipCheck {
+				tc.network.AssertNotCalled(t, "IsReachable", mock.Anything)
+			} else {
+				tc.network.AssertCalled(t, "IsReachable", "github.com:22")
+			}
+		})
+	}
+}
+
+func TestCreateCommand_FromRef(t *testing.T) {
+	testCases := []struct {
+		name        string
+		args        []string
+		expectError string
+	}{
+		{name: "branches from a tag", args: []string{"release", "--from", "v1.2.0"}},
+		{name: "branches from a commit", args: []string{"release", "--from", "0123abc"}},
+		{name: "branch name still validated", args: []string{".hidden", "--from", "v1.2.0"}, expectError: "branch name format is invalid"},
+		{name: "rejected with --source", args: []string{"release", "--from", "v1.2.0", "--source", "develop"}, expectError: "--from cannot be combined with --source"},
+		{name: "rejected with --from-tag", args: []string{"release", "--from", "v1.2.0", "--from-tag", "v1.2.0"}, expectError: "--from cannot be combined with --from-tag"},
+		{name: "rejected with --worktree-only", args: []string{"release", "--from", "v1.2.0", "--worktree-only"}, expectError: "--from cannot be combined with --worktree-only"},
+		{name: "rejected with --preset", args: []string{"--preset", "hotfix", "x", "--from", "v1.2.0"}, expectError: "--preset cannot be combined with --from"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil).Maybe()
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).
+				Return(&domain.ProjectInfo{Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj"}, nil).Maybe()
+			mockWS.On("CreateWorktree", mock.Anything, mock.MatchedBy(func(req *domain.CreateWorktreeRequest) bool {
+				return req.SourceRef != "" && req.SourceBranch == req.SourceRef && req.BranchName == "release"
+			})).Return(&domain.CreateWorktreeResult{
+				Worktree: &domain.WorktreeInfo{Path: "/wt/proj/release", Branch: "release"},
+			}, nil).Maybe()
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+				Config:   domain.DefaultConfig(),
+			}
+			cmd := NewCreateCommand(config)
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				mockWS.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			mockWS.AssertNotCalled(t, "BranchExists", mock.Anything, mock.Anything, mock.Anything)
+			mockWS.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateCommand_FromStash(t *testing.T) {
+	project := &domain.ProjectInfo{Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj"}
+
+	testCases := []struct {
+		name          string
+		args          []string
+		conflicts     []domain.ConflictFile
+		expectError   string
+		expectIndex   int
+		expectDrop    bool
+		expectWarning string
+	}{
+		{name: "applies stash@{0}", args: []string{"spike", "--from-stash", "0"}, expectIndex: 0},
+		{name: "drops the stash", args: []string{"spike", "--from-stash", "2", "--drop-stash"}, expectIndex: 2, expectDrop: true},
+		{
+			name:          "conflicts are warned about",
+			args:          []string{"spike", "--from-stash", "1"},
+			conflicts:     []domain.ConflictFile{{Path: "main.go", OurStatus: "modified", TheirStatus: "modified"}},
+			expectIndex:   1,
+			expectWarning: "stash@{1} applied with conflicts in 1 file(s)",
+		},
+		{name: "negative index", args: []string{"spike", "--from-stash", "-1"}, expectError: "--from-stash must be a stash index"},
+		{name: "drop without stash", args: []string{"spike", "--drop-stash"}, expectError: "--drop-stash only applies to --from-stash"},
+		{name: "rejected with --worktree-only", args: []string{"spike", "--from-stash", "0", "--worktree-only"}, expectError: "--from-stash cannot be combined with --worktree-only"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil).Maybe()
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).Return(project, nil).Maybe()
+			mockWS.On("BranchExists", mock.Anything, "/repos/proj", "main").Return(true, nil).Maybe()
+			mockWS.On("CreateWorktree", mock.Anything, mock.MatchedBy(func(req *domain.CreateWorktreeRequest) bool {
+				return req.FromStash != nil && *req.FromStash == tc.expectIndex && req.DropStash == tc.expectDrop
+			})).Return(&domain.CreateWorktreeResult{
+				Worktree:       &domain.WorktreeInfo{Path: "/wt/proj/spike", Branch: "spike"},
+				StashConflicts: tc.conflicts,
+			}, nil).Maybe()
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+				Config:   domain.DefaultConfig(),
+			}
+			cmd := NewCreateCommand(config)
+			stderr := &bytes.Buffer{}
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(stderr)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				mockWS.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			mockWS.AssertExpectations(t)
+			if tc.expectWarning != "" {
+				assert.Contains(t, stderr.String(), tc.expectWarning)
+				assert.Contains(t, stderr.String(), "modified/modified: main.go")
+			} else {
+				assert.Empty(t, stderr.String())
+			}
+		})
+	}
+}
+
+func TestCreateCommand_AutoStash(t *testing.T) {
+	project := &domain.ProjectInfo{Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj"}
+
+	testCases := []struct {
+		name           string
+		args           []string
+		autoStashPath  string
+		expectError    string
+		expectReminder bool
+	}{
+		{name: "reminds of the stash", args: []string{"hotfix", "--auto-stash"}, autoStashPath: "/wt/proj/feature", expectReminder: true},
+		{name: "clean worktree", args: []string{"hotfix", "--auto-stash"}},
+		{name: "rejected with --from-stash", args: []string{"hotfix", "--auto-stash", "--from-stash", "0"}, expectError: "--from-stash cannot be combined with --auto-stash"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextWorktree, ProjectName: "proj", Path: "/wt/proj/feature"}, nil).Maybe()
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).Return(project, nil).Maybe()
+			mockWS.On("BranchExists", mock.Anything, "/repos/proj", "main").Return(true, nil).Maybe()
+			mockWS.On("CreateWorktree", mock.Anything, mock.MatchedBy(func(req *domain.CreateWorktreeRequest) bool {
+				return req.AutoStash
+			})).Return(&domain.CreateWorktreeResult{
+				Worktree:      &domain.WorktreeInfo{Path: "/wt/proj/hotfix", Branch: "hotfix"},
+				AutoStashPath: tc.autoStashPath,
+			}, nil).Maybe()
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+				Config:   domain.DefaultConfig(),
+			}
+			cmd := NewCreateCommand(config)
+			stderr := &bytes.Buffer{}
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(stderr)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				mockWS.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			mockWS.AssertExpectations(t)
+			if tc.expectReminder {
+				assert.Contains(t, stderr.String(), "the uncommitted changes of /wt/proj/feature are in stash@{0}")
+				assert.Contains(t, stderr.String(), "git stash pop")
+			} else {
+				assert.Empty(t, stderr.String())
+			}
+		})
+	}
+}
+
+func TestCreateCommand_CheckoutOnConflict(t *testing.T) {
+	project := &domain.ProjectInfo{Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj"}
+	fallback := &domain.CreateWorktreeResult{
+		Worktree:         &domain.WorktreeInfo{Path: "/wt/proj/feature", Branch: "hotfix"},
+		CheckoutFallback: errors.New("failed to create worktree parent directory: permission denied"),
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		wrapped      bool
+		result       *domain.CreateWorktreeResult
+		createErr    error
+		expectError  string
+		expectStdout string
+		expectStderr string
+	}{
+		{
+			name:         "fallback is reported",
+			args:         []string{"hotfix", "--checkout-on-conflict"},
+			result:       fallback,
+			expectStdout: "Checked out in the current worktree instead: hotfix -> /wt/proj/feature",
+		},
+		{
+			name:         "shell wrapper changes into the current worktree",
+			args:         []string{"hotfix", "--checkout-on-conflict"},
+			wrapped:      true,
+			result:       fallback,
+			expectStdout: "/wt/proj/feature\n",
+			expectStderr: "Could not create the worktree: failed to create worktree parent directory: permission denied",
+		},
+		{
+			name:        "failed fallback returns the creation error",
+			args:        []string{"hotfix", "--checkout-on-conflict"},
+			createErr:   errors.New("failed to create worktree; checking out the branch in the current worktree also failed: /wt/proj/feature has uncommitted changes"),
+			expectError: "has uncommitted changes",
+		},
+		{
+			name:        "rejected with --ephemeral",
+			args:        []string{"hotfix", "--checkout-on-conflict", "--ephemeral"},
+			expectError: "--checkout-on-conflict cannot be combined with --ephemeral",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.wrapped {
+				t.Setenv(cdOnCreateEnvVar, "1")
+			} else {
+				t.Setenv(cdOnCreateEnvVar, "")
+			}
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextWorktree, ProjectName: "proj", Path: "/wt/proj/feature"}, nil).Maybe()
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).Return(project, nil).Maybe()
+			mockWS.On("BranchExists", mock.Anything, "/repos/proj", "main").Return(true, nil).Maybe()
+			mockWS.On("CreateWorktree", mock.Anything, mock.MatchedBy(func(req *domain.CreateWorktreeRequest) bool {
+				return req.CheckoutOnConflict
+			})).Return(tc.result, tc.createErr).Maybe()
+
+			cfg := domain.DefaultConfig()
+			cfg.CdOnCreate = true
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+				Config:   cfg,
+			}
+			cmd := NewCreateCommand(config)
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			cmd.SetOut(stdout)
+			cmd.SetErr(stderr)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, stdout.String(), tc.expectStdout)
+			assert.Contains(t, stderr.String(), tc.expectStderr)
+		})
+	}
+}
+
+func TestCreateCommand_ReusePath(t *testing.T) {
+	project := &domain.ProjectInfo{Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj"}
+	t.Chdir(t.TempDir())
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		reusePath  string
+		expectPath string
+	}{
+		{name: "absolute path", reusePath: "/mnt/ssd/feature", expectPath: "/mnt/ssd/feature"},
+		{name: "relative path is resolved", reusePath: "dirs/feature", expectPath: filepath.Join(cwd, "dirs", "feature")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(cdOnCreateEnvVar, "")
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj", Path: "/repos/proj"}, nil)
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).Return(project, nil)
+			mockWS.On("BranchExists", mock.Anything, "/repos/proj", "main").Return(true, nil).Maybe()
+			mockWS.On("CreateWorktree", mock.Anything, mock.MatchedBy(func(req *domain.CreateWorktreeRequest) bool {
+				return req.ReuseExistingPath && req.WorktreePath == tc.expectPath
+			})).Return(&domain.CreateWorktreeResult{Worktree: &domain.WorktreeInfo{Path: tc.expectPath, Branch: "feature"}}, nil)
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+				Config:   domain.DefaultConfig(),
+			}
+			cmd := NewCreateCommand(config)
+			stdout := &bytes.Buffer{}
+			cmd.SetOut(stdout)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs([]string{"feature", "--reuse-path", tc.reusePath})
+
+			require.NoError(t, cmd.Execute())
+			assert.Contains(t, stdout.String(), tc.expectPath)
+			mockWS.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateCommand_FailIfBehind(t *testing.T) {
+	project := &domain.ProjectInfo{Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj"}
+	ten := 10
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectLimit *int
+		expectError string
+	}{
+		{name: "limit is passed on", args: []string{"feature", "--fail-if-behind", "10"}, expectLimit: &ten},
+		{name: "explicit zero overrides the config default", args: []string{"feature", "--fail-if-behind", "0"}, expectLimit: new(int)},
+		{name: "without the flag the config default applies", args: []string{"feature"}},
+		{name: "rejected with --worktree-only", args: []string{"feature", "--fail-if-behind", "10", "--worktree-only"}, expectError: "--fail-if-behind cannot be combined with --worktree-only"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(cdOnCreateEnvVar, "")
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj", Path: "/repos/proj"}, nil).Maybe()
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).Return(project, nil).Maybe()
+			mockWS.On("BranchExists", mock.Anything, "/repos/proj", "main").Return(true, nil).Maybe()
+			mockWS.On("CreateWorktree", mock.Anything, mock.MatchedBy(func(req *domain.CreateWorktreeRequest) bool {
+				if tc.expectLimit == nil {
+					return req.MaxBehindCommits == nil
+				}
+				return req.MaxBehindCommits != nil && *req.MaxBehindCommits == *tc.expectLimit
+			})).Return(&domain.CreateWorktreeResult{Worktree: &domain.WorktreeInfo{Path: "/wt/proj/feature", Branch: "feature"}}, nil).Maybe()
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+				Config:   domain.DefaultConfig(),
+			}
+			cmd := NewCreateCommand(config)
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			mockWS.AssertCalled(t, "CreateWorktree", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCreateCommand_Preset(t *testing.T) {
+	project := &domain.ProjectInfo{
+		Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj",
+		Remotes: []*domain.RemoteInfo{{Name: "origin", FetchURL: "git@github.com:me/proj.git"}},
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectBranch string
+		expectSource string
+		expectHooks  []string
+		expectFetch  bool
+		expectLock   bool
+		expectError  string
+	}{
+		{
+			name:         "configured preset names the branch, fetches and locks",
+			args:         []string{"--preset", "hotfix", "critical-bug-fix"},
+			expectBranch: "hotfix-critical-bug-fix",
+			expectSource: "refs/remotes/origin/production",
+			expectHooks:  []string{"make deps"},
+			expectFetch:  true,
+			expectLock:   true,
+		},
+		{
+			name:         "built-in preset with a project prefix",
+			args:         []string{"--preset", "experiment", "proj/new-cache"},
+			expectBranch: "experiment-new-cache",
+			expectSource: "main",
+		},
+		{
+			name:         "--source overrides the preset's source branch",
+			args:         []string{"--preset", "experiment", "new-cache", "--source", "develop"},
+			expectBranch: "experiment-new-cache",
+			expectSource: "develop",
+		},
+		{name: "unknown preset", args: []string{"--preset", "chore", "x"}, expectError: "unknown preset"},
+		{name: "rejected with --from-tag", args: []string{"--preset", "hotfix", "x", "--from-tag", "v1.0.0"}, expectError: "--preset cannot be combined with --from-tag"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(cdOnCreateEnvVar, "")
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+			worktreePath := "/wt/proj/" + tc.expectBranch
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil).Maybe()
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).Return(project, nil).Maybe()
+			mockWS.On("BranchExists", mock.Anything, "/repos/proj", tc.expectSource).Return(true, nil).Maybe()
+			mockWS.On("FetchBranch", mock.Anything, "/repos/proj", "origin", "production").Return(nil).Maybe()
+			mockWS.On("LockWorktree", mock.Anything, "/repos/proj", worktreePath, "protected by preset hotfix").Return(nil).Maybe()
+			mockWS.On("CreateWorktree", mock.Anything, mock.MatchedBy(func(req *domain.CreateWorktreeRequest) bool {
+				return req.BranchName == tc.expectBranch && req.SourceBranch == tc.expectSource &&
+					assert.ObjectsAreEqual(tc.expectHooks, req.PostCreateCommands)
+			})).Return(&domain.CreateWorktreeResult{
+				Worktree: &domain.WorktreeInfo{Path: worktreePath, Branch: tc.expectBranch},
+			}, nil).Maybe()
+
+			cfg := domain.DefaultConfig()
+			cfg.Presets = map[string]domain.PresetConfig{
+				"hotfix": {SourceBranch: "production", ProtectedOnDelete: true, Hooks: []string{"make deps"}, AutoFetch: true},
+			}
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS, NetworkChecker: mocks.NewReachableNetworkChecker()},
+				Config:   cfg,
+			}
+			cmd := NewCreateCommand(config)
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				mockWS.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			mockWS.AssertCalled(t, "CreateWorktree", mock.Anything, mock.Anything)
+			if tc.expectFetch {
+				mockWS.AssertCalled(t, "FetchBranch", mock.Anything, "/repos/proj", "origin", "production")
+				mockWS.AssertNotCalled(t, "BranchExists", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				mockWS.AssertNotCalled(t, "FetchBranch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+			if tc.expectLock {
+				mockWS.AssertCalled(t, "LockWorktree", mock.Anything, "/repos/proj", worktreePath, "protected by preset hotfix")
+			} else {
+				mockWS.AssertNotCalled(t, "LockWorktree", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
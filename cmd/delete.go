@@ -1,9 +1,12 @@
 package cmd
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/carapace-sh/carapace"
 	"github.com/spf13/cobra"
@@ -11,9 +14,21 @@ import (
 	"twiggit/internal/infrastructure"
 )
 
+// deleteRemoteName is the remote whose branch --prune-remote deletes
+const deleteRemoteName = "origin"
+
+// deleteOptions holds the flags of the delete command
+type deleteOptions struct {
+	force       bool
+	mergedOnly  bool
+	changeDir   bool
+	pruneRemote bool
+	yes         bool
+}
+
 // NewDeleteCommand creates a new delete command
 func NewDeleteCommand(config *CommandConfig) *cobra.Command {
-	var force, mergedOnly, changeDir bool
+	var opts deleteOptions
 
 	cmd := &cobra.Command{
 		Use:     "delete <project>/<branch> | <worktree-path>",
@@ -22,21 +37,28 @@ func NewDeleteCommand(config *CommandConfig) *cobra.Command {
 		Long: `Delete a worktree with safety checks.
 By default, prevents deletion of worktrees with uncommitted changes.
 
+With --prune-remote the branch is also deleted on origin after the worktree
+is removed. The branch must be merged unless --force is given, and the
+remote deletion is confirmed unless --yes is given.
+
 Examples:
   twiggit delete feature/my-feature         Delete specific worktree
   twiggit rm feature/my-feature            Same as delete (alias)
   twiggit delete feature --force           Delete even with uncommitted changes
   twiggit delete feature --merged-only      Only delete if branch is merged
+  twiggit delete feature --prune-remote     Also delete origin/feature
   twiggit delete feature -C                 Delete and output navigation path`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(c *cobra.Command, args []string) error {
-			return executeDelete(c, config, args[0], force, mergedOnly, changeDir)
+			return executeDelete(c, config, args[0], opts)
 		},
 	}
 
-	cmd.Flags().BoolVarP(&force, "force", "f", false, "Force deletion even with uncommitted changes")
-	cmd.Flags().BoolVarP(&mergedOnly, "merged-only", "m", false, "Only delete if branch is merged")
-	cmd.Flags().BoolVarP(&changeDir, "cd", "C", false, "Change directory after deletion (outputs path to stdout)")
+	cmd.Flags().BoolVarP(&opts.force, "force", "f", false, "Force deletion even with uncommitted changes")
+	cmd.Flags().BoolVarP(&opts.mergedOnly, "merged-only", "m", false, "Only delete if branch is merged")
+	cmd.Flags().BoolVarP(&opts.changeDir, "cd", "C", false, "Change directory after deletion (outputs path to stdout)")
+	cmd.Flags().BoolVar(&opts.pruneRemote, "prune-remote", false, "Also delete the branch on origin (requires --force if not merged)")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Do not ask before deleting the remote branch")
 
 	carapace.Gen(cmd).PositionalCompletion(
 		actionWorktreeTarget(config, infrastructure.WithExistingOnly()),
@@ -45,48 +67,133 @@ Examples:
 	return cmd
 }
 
-func executeDelete(c *cobra.Command, config *CommandConfig, target string, force, mergedOnly, changeDir bool) error {
+func executeDelete(c *cobra.Command, config *CommandConfig, target string, opts deleteOptions) error {
 	ctx := context.Background()
 
-	currentCtx, worktreePath, err := resolveWorktreeTarget(config, target)
+	currentCtx, resolution, err := resolveWorktreeTarget(config, target)
 	if err != nil {
 		return err
 	}
+	applyTargetProjectConfig(c, config, resolution.ProjectName)
+	worktreePath := resolution.ResolvedPath
 
-	err = validateWorktreeStatus(ctx, config, c, worktreePath, force, changeDir, currentCtx)
+	err = validateWorktreeStatus(ctx, config, c, worktreePath, opts.force, opts.changeDir, currentCtx)
 	if err != nil {
 		return err
 	}
 
-	err = validateMergedOnly(ctx, config, worktreePath, mergedOnly, currentCtx)
+	err = validateMergedOnly(ctx, config, worktreePath, opts.mergedOnly, currentCtx)
 	if err != nil {
 		return err
 	}
 
-	return deleteWorktree(ctx, config, c, worktreePath, force, changeDir, currentCtx)
+	var remoteBranch string
+	if opts.pruneRemote {
+		branch, confirmed, err := confirmRemotePrune(ctx, c, config, worktreePath, opts, currentCtx)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			_, _ = fmt.Fprintln(c.ErrOrStderr(), "Delete cancelled.")
+			return nil
+		}
+		remoteBranch = branch
+	}
+
+	if err := deleteWorktree(ctx, config, c, worktreePath, opts.force, opts.changeDir, currentCtx); err != nil {
+		return err
+	}
+
+	if remoteBranch != "" {
+		if err := deleteRemoteBranch(ctx, c, config, resolution.ProjectName, remoteBranch, opts.changeDir, currentCtx); err != nil {
+			return err
+		}
+	}
+
+	cleanStaleLinksAfterDelete(ctx, c, config, resolution.ProjectName, currentCtx)
+	return nil
 }
 
-func resolveWorktreeTarget(config *CommandConfig, target string) (*domain.Context, string, error) {
+// confirmRemotePrune returns the branch --prune-remote will delete after checking that it is
+// merged (unless --force) and asking for confirmation (unless --yes)
+func confirmRemotePrune(ctx context.Context, c *cobra.Command, config *CommandConfig, worktreePath string, opts deleteOptions, currentCtx *domain.Context) (string, bool, error) {
+	worktreeInfo, err := config.Services.WorktreeService.GetWorktreeByPath(ctx, currentCtx.Path, worktreePath)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get worktree info: %w", err)
+	}
+	if worktreeInfo.IsDetached || worktreeInfo.Branch == "" {
+		return "", false, domain.NewValidationError("delete", "prune-remote", worktreePath, "worktree has no branch to delete on "+deleteRemoteName)
+	}
+	branch := worktreeInfo.Branch
+
+	if !opts.force && !opts.mergedOnly {
+		merged, err := config.Services.WorktreeService.IsBranchMerged(ctx, worktreePath, branch)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to check if branch '%s' is merged: %w", branch, err)
+		}
+		if !merged {
+			return "", false, fmt.Errorf("branch '%s' is not merged (use --force to delete %s/%s anyway)", branch, deleteRemoteName, branch)
+		}
+	}
+
+	if opts.yes {
+		return branch, true, nil
+	}
+
+	_, _ = fmt.Fprintf(c.ErrOrStderr(), "This will delete %s/%s. Continue? (y/n): ", deleteRemoteName, branch)
+	response, err := bufio.NewReader(c.InOrStdin()).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", false, fmt.Errorf("failed to read confirmation: %w", err)
+	}
+	response = strings.TrimSpace(strings.ToLower(response))
+	return branch, response == "y" || response == "yes", nil
+}
+
+// deleteRemoteBranch deletes branch on origin once its worktree is gone; a branch that is
+// already missing on the remote only warns
+func deleteRemoteBranch(ctx context.Context, c *cobra.Command, config *CommandConfig, projectName, branch string, changeDir bool, currentCtx *domain.Context) error {
+	project, err := config.Services.ProjectService.DiscoverProject(ctx, projectName, currentCtx)
+	if err != nil {
+		return fmt.Errorf("worktree deleted but failed to find project for %s/%s: %w", deleteRemoteName, branch, err)
+	}
+
+	logv(c, 1, "Deleting remote branch %s/%s", deleteRemoteName, branch)
+	err = config.Services.WorktreeService.DeleteRemoteBranch(ctx, project.GitRepoPath, deleteRemoteName, branch)
+	if errors.Is(err, domain.ErrRemoteBranchNotFound) {
+		_, _ = fmt.Fprintf(c.ErrOrStderr(), "Warning: %s/%s does not exist, nothing to delete on the remote\n", deleteRemoteName, branch)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("worktree deleted but failed to delete %s/%s: %w", deleteRemoteName, branch, err)
+	}
+
+	if !isQuiet(c) && !changeDir {
+		_, _ = fmt.Fprintf(c.OutOrStdout(), "Deleted remote branch: %s/%s\n", deleteRemoteName, branch)
+	}
+	return nil
+}
+
+func resolveWorktreeTarget(config *CommandConfig, target string) (*domain.Context, *domain.ResolutionResult, error) {
 	currentCtx, err := config.Services.ContextService.GetCurrentContext()
 	if err != nil {
-		return nil, "", fmt.Errorf("context detection failed: %w", err)
+		return nil, nil, fmt.Errorf("context detection failed: %w", err)
 	}
 
 	resolution, err := config.Services.ContextService.ResolveIdentifier(target)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to resolve target %s: %w", target, err)
+		return nil, nil, fmt.Errorf("failed to resolve target %s: %w", target, err)
 	}
 
 	if resolution.Type == domain.PathTypeInvalid {
-		return nil, "", fmt.Errorf("invalid target format: %s", resolution.Explanation)
+		return nil, nil, fmt.Errorf("invalid target format: %s", resolution.Explanation)
 	}
 
 	// Validate ResolvedPath is non-empty when Type indicates a worktree path was resolved
 	if resolution.Type == domain.PathTypeWorktree && resolution.ResolvedPath == "" {
-		return nil, "", domain.NewValidationError("resolveWorktreeTarget", "ResolvedPath", "", "resolved path cannot be empty")
+		return nil, nil, domain.NewValidationError("resolveWorktreeTarget", "ResolvedPath", "", "resolved path cannot be empty")
 	}
 
-	return currentCtx, resolution.ResolvedPath, nil
+	return currentCtx, resolution, nil
 }
 
 func validateWorktreeStatus(ctx context.Context, config *CommandConfig, c *cobra.Command, worktreePath string, force, changeDir bool, currentCtx *domain.Context) error {
@@ -96,12 +203,7 @@ func validateWorktreeStatus(ctx context.Context, config *CommandConfig, c *cobra
 
 	status, err := config.Services.WorktreeService.GetWorktreeStatus(ctx, worktreePath)
 	if err != nil {
-		var worktreeErr *domain.WorktreeServiceError
-		var gitRepoErr *domain.GitRepositoryError
-		var gitWorktreeErr *domain.GitWorktreeError
-		if errors.As(err, &worktreeErr) && worktreeErr.IsNotFound() ||
-			errors.As(err, &gitRepoErr) && gitRepoErr.IsNotFound() ||
-			errors.As(err, &gitWorktreeErr) && gitWorktreeErr.IsNotFound() {
+		if isWorktreeNotFound(err) {
 			if changeDir {
 				navigationTarget := getDeleteNavigationTarget(ctx, config, worktreePath, currentCtx)
 				if navigationTarget != "" {
@@ -122,6 +224,16 @@ func validateWorktreeStatus(ctx context.Context, config *CommandConfig, c *cobra
 	return nil
 }
 
+// isWorktreeNotFound reports whether err means the worktree no longer exists
+func isWorktreeNotFound(err error) bool {
+	var worktreeErr *domain.WorktreeServiceError
+	var gitRepoErr *domain.GitRepositoryError
+	var gitWorktreeErr *domain.GitWorktreeError
+	return errors.As(err, &worktreeErr) && worktreeErr.IsNotFound() ||
+		errors.As(err, &gitRepoErr) && gitRepoErr.IsNotFound() ||
+		errors.As(err, &gitWorktreeErr) && gitWorktreeErr.IsNotFound()
+}
+
 func validateMergedOnly(ctx context.Context, config *CommandConfig, worktreePath string, mergedOnly bool, currentCtx *domain.Context) error {
 	if !mergedOnly {
 		return nil
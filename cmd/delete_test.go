@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"errors"
 	"strings"
 	"testing"
 
@@ -202,3 +203,187 @@ func TestDeleteCommand_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteCommand_CleansStaleLinks(t *testing.T) {
+	project := &domain.ProjectInfo{Name: "test-project", GitRepoPath: "/home/user/Projects/test-project"}
+	worktreePath := "/home/user/Worktrees/test-project/feature-api"
+
+	testCases := []struct {
+		name         string
+		cleanResult  []string
+		cleanErr     error
+		expectStderr string
+	}{
+		{name: "reports removed links", cleanResult: []string{"feature-ui -> feature-api"}, expectStderr: "Removed stale link: feature-ui -> feature-api"},
+		{name: "cleanup failure is a warning", cleanErr: errors.New("not a repository"), expectStderr: "Warning: failed to clean stale links of test-project"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+			mockLR := mocks.NewMockLinkRegistry()
+
+			currentCtx := &domain.Context{}
+			mockCS.On("GetCurrentContext").Return(currentCtx, nil)
+			mockCS.On("ResolveIdentifier", "test-project/feature-api").Return(&domain.ResolutionResult{
+				ResolvedPath: worktreePath,
+				ProjectName:  "test-project",
+			}, nil)
+			mockWS.On("GetWorktreeByPath", mock.Anything, mock.Anything, mock.Anything).Return(&domain.WorktreeInfo{Path: worktreePath, Branch: "feature-api"}, nil)
+			mockWS.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("*domain.DeleteWorktreeRequest")).Return(nil)
+			mockPS.On("DiscoverProject", mock.Anything, "test-project", currentCtx).Return(project, nil)
+			mockLR.On("CleanStaleLinks", mock.Anything, project.GitRepoPath).Return(tc.cleanResult, tc.cleanErr)
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{
+					WorktreeService: mockWS,
+					ContextService:  mockCS,
+					ProjectService:  mockPS,
+					LinkRegistry:    mockLR,
+				},
+			}
+
+			cmd := NewDeleteCommand(config)
+			cmd.SetArgs([]string{"--force", "test-project/feature-api"})
+			var out, errOut bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&errOut)
+
+			require.NoError(t, cmd.Execute())
+			assert.Contains(t, out.String(), "Deleted worktree: "+worktreePath)
+			assert.Contains(t, errOut.String(), tc.expectStderr)
+			mockLR.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteCommand_PruneRemote(t *testing.T) {
+	project := &domain.ProjectInfo{Name: "test-project", GitRepoPath: "/home/user/Projects/test-project"}
+	worktreePath := "/home/user/Worktrees/test-project/feature-api"
+
+	testCases := []struct {
+		name          string
+		args          []string
+		stdin         string
+		merged        bool
+		remoteErr     error
+		expectRemote  bool
+		expectDeleted bool
+		expectError   string
+		expectOut     string
+		expectStderr  string
+	}{
+		{
+			name:          "merged branch with yes",
+			args:          []string{"--prune-remote", "--yes"},
+			merged:        true,
+			expectRemote:  true,
+			expectDeleted: true,
+			expectOut:     "Deleted remote branch: origin/feature-api",
+		},
+		{
+			name:          "confirmed at the prompt",
+			args:          []string{"--prune-remote"},
+			stdin:         "y\n",
+			merged:        true,
+			expectRemote:  true,
+			expectDeleted: true,
+			expectStderr:  "This will delete origin/feature-api. Continue? (y/n): ",
+		},
+		{
+			name:         "declined at the prompt",
+			args:         []string{"--prune-remote"},
+			stdin:        "n\n",
+			merged:       true,
+			expectStderr: "Delete cancelled.",
+		},
+		{
+			name:        "unmerged branch requires force",
+			args:        []string{"--prune-remote", "--yes"},
+			expectError: "branch 'feature-api' is not merged (use --force to delete origin/feature-api anyway)",
+		},
+		{
+			name:          "force skips the merge check",
+			args:          []string{"--prune-remote", "--yes", "--force"},
+			expectRemote:  true,
+			expectDeleted: true,
+		},
+		{
+			name:          "missing remote branch warns",
+			args:          []string{"--prune-remote", "--yes"},
+			merged:        true,
+			remoteErr:     domain.NewWorktreeServiceError(project.GitRepoPath, "feature-api", "DeleteRemoteBranch", "failed", domain.ErrRemoteBranchNotFound),
+			expectRemote:  true,
+			expectDeleted: true,
+			expectStderr:  "Warning: origin/feature-api does not exist",
+		},
+		{
+			name:          "remote failure",
+			args:          []string{"--prune-remote", "--yes"},
+			merged:        true,
+			remoteErr:     errors.New("permission denied"),
+			expectRemote:  true,
+			expectDeleted: true,
+			expectError:   "worktree deleted but failed to delete origin/feature-api",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+
+			currentCtx := &domain.Context{}
+			mockCS.On("GetCurrentContext").Return(currentCtx, nil)
+			mockCS.On("ResolveIdentifier", "test-project/feature-api").Return(&domain.ResolutionResult{
+				ResolvedPath: worktreePath,
+				ProjectName:  "test-project",
+			}, nil)
+			mockWS.On("GetWorktreeStatus", mock.Anything, worktreePath).Return(&domain.WorktreeStatus{IsClean: true}, nil).Maybe()
+			mockWS.On("GetWorktreeByPath", mock.Anything, mock.Anything, mock.Anything).Return(&domain.WorktreeInfo{Path: worktreePath, Branch: "feature-api"}, nil)
+			mockWS.On("IsBranchMerged", mock.Anything, worktreePath, "feature-api").Return(tc.merged, nil).Maybe()
+			if tc.expectDeleted {
+				mockWS.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("*domain.DeleteWorktreeRequest")).Return(nil)
+			}
+			if tc.expectRemote {
+				mockWS.On("DeleteRemoteBranch", mock.Anything, project.GitRepoPath, "origin", "feature-api").Return(tc.remoteErr)
+			}
+			mockPS.On("DiscoverProject", mock.Anything, "test-project", currentCtx).Return(project, nil).Maybe()
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{
+					WorktreeService: mockWS,
+					ContextService:  mockCS,
+					ProjectService:  mockPS,
+				},
+			}
+
+			cmd := NewDeleteCommand(config)
+			cmd.SetArgs(append(tc.args, "test-project/feature-api"))
+			cmd.SetIn(strings.NewReader(tc.stdin))
+			var out, errOut bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&errOut)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Contains(t, out.String(), tc.expectOut)
+			assert.Contains(t, errOut.String(), tc.expectStderr)
+			mockWS.AssertExpectations(t)
+			if !tc.expectDeleted {
+				mockWS.AssertNotCalled(t, "DeleteWorktree", mock.Anything, mock.Anything)
+			}
+			if !tc.expectRemote {
+				mockWS.AssertNotCalled(t, "DeleteRemoteBranch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// NewDescribeCommand creates a new describe command
+func NewDescribeCommand(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe [<branch>] <message>",
+		Short: "Set the description of a branch",
+		Long: `Set a branch description, as git branch --edit-description does.
+
+The description is stored in the repository config as
+branch.<branch>.description, so it is shared by every worktree of the
+project. When the branch is omitted the branch of the current worktree
+is described. An empty message removes the description.
+
+Descriptions are shown by 'twiggit status', 'twiggit list --descriptions'
+and when 'twiggit create' checks out an existing branch.
+
+Examples:
+  twiggit describe "Retry failed payments"           Describe the current branch
+  twiggit describe feature-x "Retry failed payments" Describe another branch
+  twiggit describe feature-x ""                      Remove the description`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			branch, message := "", args[0]
+			if len(args) == 2 {
+				branch, message = args[0], args[1]
+			}
+			return executeDescribe(cmd, config, branch, message)
+		},
+	}
+
+	// Silence usage to prevent double error printing
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionBranches(config),
+	)
+
+	return cmd
+}
+
+// executeDescribe executes the describe command with the given configuration
+func executeDescribe(cmd *cobra.Command, config *CommandConfig, branch, message string) error {
+	ctx := context.Background()
+
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil {
+		return fmt.Errorf("context detection failed: %w", err)
+	}
+
+	if currentCtx.Type == domain.ContextOutsideGit {
+		return domain.NewValidationError("describe", "context", currentCtx.Type.String(), "not inside a project or worktree")
+	}
+
+	if branch == "" {
+		if currentCtx.Type != domain.ContextWorktree || currentCtx.BranchName == "" {
+			return domain.NewValidationError("describe", "branch", "", "branch name required outside a worktree")
+		}
+		branch = currentCtx.BranchName
+	}
+
+	project, err := config.Services.ProjectService.DiscoverProject(ctx, currentCtx.ProjectName, currentCtx)
+	if err != nil {
+		return fmt.Errorf("failed to discover project: %w", err)
+	}
+
+	logv(cmd, 1, "Describing %s in %s", branch, project.Name)
+
+	exists, err := config.Services.WorktreeService.BranchExists(ctx, project.GitRepoPath, branch)
+	if err != nil {
+		return fmt.Errorf("failed to check branch %s: %w", branch, err)
+	}
+	if !exists {
+		return domain.NewValidationError("describe", "branch", branch, "branch does not exist")
+	}
+
+	if err := config.Services.WorktreeService.SetBranchDescription(ctx, project.GitRepoPath, branch, message); err != nil {
+		return fmt.Errorf("failed to describe %s: %w", branch, err)
+	}
+
+	if isQuiet(cmd) {
+		return nil
+	}
+	if message == "" {
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Removed description of %s\n", branch)
+		return nil
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Described %s: %s\n", branch, message)
+	return nil
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestDescribeCommand_Execute(t *testing.T) {
+	worktreeCtx := &domain.Context{Type: domain.ContextWorktree, ProjectName: "proj", BranchName: "feature-ui", Path: "/wt/proj/feature-ui"}
+	projectCtx := &domain.Context{Type: domain.ContextProject, ProjectName: "proj", Path: "/repos/proj"}
+	project := &domain.ProjectInfo{Name: "proj", GitRepoPath: "/repos/proj"}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		setupMocks  func(*mocks.MockWorktreeService, *mocks.MockContextService, *mocks.MockProjectService)
+		expectError string
+		expectOut   string
+	}{
+		{
+			name: "current worktree branch",
+			args: []string{"New checkout screens"},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService, ps *mocks.MockProjectService) {
+				cs.On("GetCurrentContext").Return(worktreeCtx, nil)
+				ps.On("DiscoverProject", mock.Anything, "proj", worktreeCtx).Return(project, nil)
+				ws.On("BranchExists", mock.Anything, "/repos/proj", "feature-ui").Return(true, nil)
+				ws.On("SetBranchDescription", mock.Anything, "/repos/proj", "feature-ui", "New checkout screens").Return(nil)
+			},
+			expectOut: "Described feature-ui: New checkout screens\n",
+		},
+		{
+			name: "named branch",
+			args: []string{"feature-api", "Payments API"},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService, ps *mocks.MockProjectService) {
+				cs.On("GetCurrentContext").Return(projectCtx, nil)
+				ps.On("DiscoverProject", mock.Anything, "proj", projectCtx).Return(project, nil)
+				ws.On("BranchExists", mock.Anything, "/repos/proj", "feature-api").Return(true, nil)
+				ws.On("SetBranchDescription", mock.Anything, "/repos/proj", "feature-api", "Payments API").Return(nil)
+			},
+			expectOut: "Described feature-api: Payments API\n",
+		},
+		{
+			name: "empty message removes the description",
+			args: []string{"feature-api", ""},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService, ps *mocks.MockProjectService) {
+				cs.On("GetCurrentContext").Return(projectCtx, nil)
+				ps.On("DiscoverProject", mock.Anything, "proj", projectCtx).Return(project, nil)
+				ws.On("BranchExists", mock.Anything, "/repos/proj", "feature-api").Return(true, nil)
+				ws.On("SetBranchDescription", mock.Anything, "/repos/proj", "feature-api", "").Return(nil)
+			},
+			expectOut: "Removed description of feature-api\n",
+		},
+		{
+			name: "branch required outside a worktree",
+			args: []string{"Payments API"},
+			setupMocks: func(_ *mocks.MockWorktreeService, cs *mocks.MockContextService, _ *mocks.MockProjectService) {
+				cs.On("GetCurrentContext").Return(projectCtx, nil)
+			},
+			expectError: "branch name required outside a worktree",
+		},
+		{
+			name: "unknown branch",
+			args: []string{"missing", "Payments API"},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService, ps *mocks.MockProjectService) {
+				cs.On("GetCurrentContext").Return(projectCtx, nil)
+				ps.On("DiscoverProject", mock.Anything, "proj", projectCtx).Return(project, nil)
+				ws.On("BranchExists", mock.Anything, "/repos/proj", "missing").Return(false, nil)
+			},
+			expectError: "branch does not exist",
+		},
+		{
+			name: "outside git",
+			args: []string{"feature-api", "Payments API"},
+			setupMocks: func(_ *mocks.MockWorktreeService, cs *mocks.MockContextService, _ *mocks.MockProjectService) {
+				cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+			},
+			expectError: "not inside a project or worktree",
+		},
+		{
+			name: "write failure",
+			args: []string{"feature-api", "Payments API"},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService, ps *mocks.MockProjectService) {
+				cs.On("GetCurrentContext").Return(projectCtx, nil)
+				ps.On("DiscoverProject", mock.Anything, "proj", projectCtx).Return(project, nil)
+				ws.On("BranchExists", mock.Anything, "/repos/proj", "feature-api").Return(true, nil)
+				ws.On("SetBranchDescription", mock.Anything, "/repos/proj", "feature-api", "Payments API").Return(errors.New("locked"))
+			},
+			expectError: "failed to describe feature-api",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			tc.setupMocks(ws, cs, ps)
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps},
+			}
+			cmd := NewDescribeCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectOut, out.String())
+			ws.AssertExpectations(t)
+		})
+	}
+}
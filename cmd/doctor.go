@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// NewDoctorCommand creates the doctor command
+func NewDoctorCommand(config *CommandConfig) *cobra.Command {
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "doctor [project]",
+		Short: "Check the workspace setup for problems",
+		Long: `Check the workspace for setup problems that cause confusing failures later.
+
+Workspace checks:
+  - the config file is valid TOML and passes validation
+  - projects_dir and worktrees_dir exist and are readable
+
+Project checks:
+  - every worktree's directory and .git file are intact
+  - no two worktrees have the same branch checked out
+  - no orphaned directories in the project's worktrees directory (warning)
+  - no worktree left without commits for age_color_stale_days (warning)
+  - git fsck --no-dangling succeeds on the repository
+  - every git hook is executable and its #! interpreter exists
+
+Each problem is reported as ERROR or WARNING with a suggested fix. Exits with
+an error when any ERROR is found.
+
+Examples:
+  twiggit doctor            Check the workspace and the current project
+  twiggit doctor myproject  Check the workspace and a specific project
+  twiggit doctor --all      Check the workspace and every project`,
+		Args:        cobra.MaximumNArgs(1),
+		Annotations: map[string]string{allowInvalidConfig: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			}
+			return executeDoctor(cmd, config, projectName, all)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&all, "all", "a", false, "Check all projects")
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionProjects(config),
+	)
+
+	return cmd
+}
+
+// executeDoctor runs the workspace and project checks and fails when any ERROR is found
+func executeDoctor(cmd *cobra.Command, config *CommandConfig, projectName string, all bool) error {
+	ctx := context.Background()
+
+	if all && projectName != "" {
+		return domain.NewValidationError("doctor", "project", projectName, "cannot combine a project with --all")
+	}
+
+	report := &domain.DoctorReport{}
+	logv(cmd, 1, "Checking workspace")
+	checkDoctorWorkspace(config, report)
+
+	projects, err := resolveVerifyProjects(ctx, config, projectName, all)
+	if err != nil {
+		if len(report.Findings) > 0 {
+			displayDoctorReport(cmd.OutOrStdout(), report)
+		}
+		return err
+	}
+
+	for _, project := range projects {
+		logv(cmd, 1, "Checking %s", project.Name)
+		if err := checkDoctorProject(ctx, config, project, report); err != nil {
+			return err
+		}
+	}
+
+	displayDoctorReport(cmd.OutOrStdout(), report)
+
+	if errs := report.Count(domain.DoctorError); errs > 0 {
+		return fmt.Errorf("found %d error(s)", errs)
+	}
+	return nil
+}
+
+// checkDoctorWorkspace checks the config file and the projects and worktrees directories
+func checkDoctorWorkspace(config *CommandConfig, report *domain.DoctorReport) {
+	if config.Services.ConfigManager != nil {
+		if _, err := config.Services.ConfigManager.Load(); err != nil {
+			report.Add(domain.DoctorError, "config", err.Error(),
+				"fix the reported key in config.toml, or recreate it with 'twiggit config init --force'")
+			// The directories would be the defaults, not the ones the broken file configures
+			return
+		}
+	}
+
+	if config.Config == nil {
+		return
+	}
+	checkDoctorDirectory(report, "projects_dir", config.Config.ProjectsDirectory)
+	checkDoctorDirectory(report, "worktrees_dir", config.Config.WorktreesDirectory)
+}
+
+// checkDoctorDirectory reports a configured directory that is missing or unreadable
+func checkDoctorDirectory(report *domain.DoctorReport, key, dir string) {
+	info, err := os.Stat(dir)
+	switch {
+	case os.IsNotExist(err):
+		report.Add(domain.DoctorError, dir, key+" does not exist",
+			fmt.Sprintf("create it with 'mkdir -p %s' or point %s at an existing directory", dir, key))
+		return
+	case err != nil:
+		report.Add(domain.DoctorError, dir, "cannot access "+key+": "+err.Error(), "check the permissions of "+dir)
+		return
+	case !info.IsDir():
+		report.Add(domain.DoctorError, dir, key+" is not a directory", "point "+key+" at a directory")
+		return
+	}
+	if _, err := os.ReadDir(dir); err != nil {
+		report.Add(domain.DoctorError, dir, key+" is not readable: "+err.Error(), "check the permissions of "+dir)
+	}
+}
+
+// checkDoctorProject checks a project's worktrees, branches, objects and hooks
+func checkDoctorProject(ctx context.Context, config *CommandConfig, project *domain.ProjectInfo, report *domain.DoctorReport) error {
+	ws := config.Services.WorktreeService
+
+	worktrees, err := ws.ListWorktrees(ctx, &domain.ListWorktreesRequest{ProjectName: project.Name, IncludeMain: true, IncludeLastUpdated: true})
+	if err != nil {
+		return fmt.Errorf("failed to list worktrees of %s: %w", project.Name, err)
+	}
+	for _, wt := range worktrees {
+		health, err := ws.GetWorktreeHealth(ctx, wt.Path)
+		if err != nil {
+			return fmt.Errorf("failed to check %s: %w", wt.Path, err)
+		}
+		for _, issue := range health.Issues {
+			report.Add(domain.DoctorError, wt.Path, issue.Issue,
+				"run 'git worktree repair "+wt.Path+"' from "+project.GitRepoPath+", or 'git worktree prune' if the worktree is gone")
+		}
+	}
+	checkDoctorSharedBranches(project, worktrees, report)
+	checkDoctorStaleWorktrees(project, worktrees, doctorStaleThreshold(config.Config), report)
+
+	verification, err := ws.VerifyWorktrees(ctx, project)
+	if err != nil {
+		return fmt.Errorf("failed to verify worktrees of %s: %w", project.Name, err)
+	}
+	for _, path := range verification.DiscoveredOnly {
+		report.Add(domain.DoctorWarning, path, "orphaned directory not known to git",
+			"run 'git worktree repair "+path+"' from "+project.GitRepoPath+", or remove the directory")
+	}
+
+	if err := ws.CheckRepositoryIntegrity(ctx, project.GitRepoPath); err != nil {
+		problem := err.Error()
+		// Show git's own report rather than the wrapping service message
+		var gitErr *domain.GitRepositoryError
+		if errors.As(err, &gitErr) {
+			problem = gitErr.Message
+		}
+		report.Add(domain.DoctorError, project.GitRepoPath, problem,
+			"run 'git fsck --full' for details; 'git fetch' can restore missing objects, otherwise re-clone")
+	}
+
+	dir, hookIssues, err := ws.ValidateHooks(ctx, project.GitRepoPath)
+	if err != nil {
+		return fmt.Errorf("failed to check hooks of %s: %w", project.Name, err)
+	}
+	for _, issue := range hookIssues {
+		subject := dir
+		if issue.HookName != "" {
+			subject = filepath.Join(dir, issue.HookName)
+		}
+		report.Add(domain.DoctorError, subject, issue.Issue,
+			"make the hook executable with a valid #! line, or remove it")
+	}
+	return nil
+}
+
+// checkDoctorSharedBranches reports branches checked out in more than one worktree
+func checkDoctorSharedBranches(project *domain.ProjectInfo, worktrees []*domain.WorktreeInfo, report *domain.DoctorReport) {
+	paths := make(map[string][]string)
+	for _, wt := range worktrees {
+		if wt.IsBare || wt.IsDetached || wt.Branch == "" {
+			continue
+		}
+		paths[wt.Branch] = append(paths[wt.Branch], wt.Path)
+	}
+
+	branches := make([]string, 0, len(paths))
+	for branch, shared := range paths {
+		if len(shared) > 1 {
+			branches = append(branches, branch)
+		}
+	}
+	slices.Sort(branches)
+
+	for _, branch := range branches {
+		report.Add(domain.DoctorError, project.Name,
+			fmt.Sprintf("branch %s is checked out in %d worktrees: %v", branch, len(paths[branch]), paths[branch]),
+			"remove the extra worktrees with 'twiggit delete', or check out another branch in them")
+	}
+}
+
+// doctorStaleThreshold is the [theme] age_color_stale_days, the age list colors as stale,
+// or domain.DefaultActiveThreshold when unset
+func doctorStaleThreshold(config *domain.Config) time.Duration {
+	if config != nil && config.Theme.AgeColorStaleDays > 0 {
+		return time.Duration(config.Theme.AgeColorStaleDays) * 24 * time.Hour
+	}
+	return domain.DefaultActiveThreshold
+}
+
+// checkDoctorStaleWorktrees warns about the listed worktrees whose HEAD commit is older than
+// threshold (ProjectInfo.StaleWorktrees). The main worktree is skipped: it is the project itself.
+func checkDoctorStaleWorktrees(project *domain.ProjectInfo, worktrees []*domain.WorktreeInfo, threshold time.Duration, report *domain.DoctorReport) {
+	listed := &domain.ProjectInfo{Name: project.Name, GitRepoPath: project.GitRepoPath, Worktrees: worktrees}
+	for _, wt := range listed.StaleWorktrees(threshold) {
+		if wt.IsBare || wt.Path == project.GitRepoPath {
+			continue
+		}
+		fix := "delete it with 'twiggit delete " + project.Name + "/" + wt.Branch + "' if the work is done"
+		if wt.Branch == "" {
+			fix = "remove it with 'git worktree remove " + wt.Path + "' if the work is done"
+		}
+		report.Add(domain.DoctorWarning, wt.Path, "stale worktree: no commit for "+formatStatsAge(wt.Age()), fix)
+	}
+}
+
+// displayDoctorReport prints one "<SEVERITY> <subject>: <problem>" line per finding with its fix
+func displayDoctorReport(out io.Writer, report *domain.DoctorReport) {
+	if len(report.Findings) == 0 {
+		_, _ = fmt.Fprintln(out, "No issues found")
+		return
+	}
+
+	for _, finding := range report.Findings {
+		_, _ = fmt.Fprintf(out, "%-7s %s: %s\n", finding.Severity, finding.Subject, finding.Problem)
+		_, _ = fmt.Fprintf(out, "        Fix: %s\n", finding.Fix)
+	}
+	_, _ = fmt.Fprintf(out, "\n%d error(s), %d warning(s)\n", report.Count(domain.DoctorError), report.Count(domain.DoctorWarning))
+}
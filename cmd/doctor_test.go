@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+// expectHealthyDoctorProject sets up a project with one healthy worktree and no other findings
+func expectHealthyDoctorProject(ws *mocks.MockWorktreeService, project *domain.ProjectInfo) {
+	wtPath := "/wt/" + project.Name + "/feature"
+	ws.On("ListWorktrees", mock.Anything, &domain.ListWorktreesRequest{ProjectName: project.Name, IncludeMain: true, IncludeLastUpdated: true}).
+		Return([]*domain.WorktreeInfo{{Path: project.GitRepoPath, Branch: "main"}, {Path: wtPath, Branch: "feature"}}, nil)
+	ws.On("GetWorktreeHealth", mock.Anything, project.GitRepoPath).Return(&domain.WorktreeHealth{WorktreePath: project.GitRepoPath}, nil)
+	ws.On("GetWorktreeHealth", mock.Anything, wtPath).Return(&domain.WorktreeHealth{WorktreePath: wtPath}, nil)
+	ws.On("VerifyWorktrees", mock.Anything, project).Return(&domain.WorktreeVerification{ProjectName: project.Name}, nil)
+	ws.On("CheckRepositoryIntegrity", mock.Anything, project.GitRepoPath).Return(nil)
+	ws.On("ValidateHooks", mock.Anything, project.GitRepoPath).Return(project.GitRepoPath+"/.git/hooks", []domain.HookIssue(nil), nil)
+}
+
+func TestDoctorCommand_Execute(t *testing.T) {
+	projA := &domain.ProjectInfo{Name: "proj-a", GitRepoPath: "/repos/proj-a"}
+	projB := &domain.ProjectInfo{Name: "proj-b", GitRepoPath: "/repos/proj-b"}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		missingDirs bool
+		setupMocks  func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService, cm *mocks.MockConfigManager)
+		expectError string
+		expectOut   []string
+		rejectOut   []string
+	}{
+		{
+			name: "no issues",
+			args: []string{},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService, _ *mocks.MockConfigManager) {
+				ps.On("DiscoverProject", mock.Anything, "", mock.Anything).Return(projA, nil)
+				expectHealthyDoctorProject(ws, projA)
+			},
+			expectOut: []string{"No issues found"},
+		},
+		{
+			name:        "invalid config file skips the directory checks",
+			args:        []string{"proj-a"},
+			missingDirs: true,
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService, cm *mocks.MockConfigManager) {
+				cm.ExpectedCalls = nil
+				cm.On("Load").Return(nil, domain.NewConfigError("/cfg/config.toml", "failed to parse config file", errors.New("expected '='")))
+				ps.On("DiscoverProject", mock.Anything, "proj-a", mock.Anything).Return(projA, nil)
+				expectHealthyDoctorProject(ws, projA)
+			},
+			expectError: "found 1 error(s)",
+			expectOut: []string{
+				"ERROR   config: ",
+				"failed to parse config file",
+				"        Fix: fix the reported key in config.toml",
+			},
+			rejectOut: []string{"projects_dir"},
+		},
+		{
+			name:        "missing workspace directories",
+			args:        []string{"proj-a"},
+			missingDirs: true,
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService, _ *mocks.MockConfigManager) {
+				ps.On("DiscoverProject", mock.Anything, "proj-a", mock.Anything).Return(projA, nil)
+				expectHealthyDoctorProject(ws, projA)
+			},
+			expectError: "found 2 error(s)",
+			expectOut:   []string{"projects_dir does not exist", "worktrees_dir does not exist", "Fix: create it with 'mkdir -p "},
+		},
+		{
+			name: "broken worktree and shared branch",
+			args: []string{"proj-a"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService, _ *mocks.MockConfigManager) {
+				ps.On("DiscoverProject", mock.Anything, "proj-a", mock.Anything).Return(projA, nil)
+				ws.On("ListWorktrees", mock.Anything, mock.Anything).Return([]*domain.WorktreeInfo{
+					{Path: "/repos/proj-a", Branch: "main"},
+					{Path: "/wt/proj-a/one", Branch: "feature"},
+					{Path: "/wt/proj-a/two", Branch: "feature"},
+					{Path: "/wt/proj-a/detached", IsDetached: true},
+				}, nil)
+				ws.On("GetWorktreeHealth", mock.Anything, "/wt/proj-a/one").Return(&domain.WorktreeHealth{WorktreePath: "/wt/proj-a/one", Issues: []domain.HealthIssue{
+					{WorktreePath: "/wt/proj-a/one", Issue: "no .git file: the worktree is disconnected from its repository (git worktree repair)"},
+				}}, nil)
+				ws.On("GetWorktreeHealth", mock.Anything, mock.Anything).Return(&domain.WorktreeHealth{}, nil)
+				ws.On("VerifyWorktrees", mock.Anything, projA).Return(&domain.WorktreeVerification{DiscoveredOnly: []string{"/wt/proj-a/stale"}}, nil)
+				ws.On("CheckRepositoryIntegrity", mock.Anything, "/repos/proj-a").Return(nil)
+				ws.On("ValidateHooks", mock.Anything, "/repos/proj-a").Return("/repos/proj-a/.git/hooks", []domain.HookIssue(nil), nil)
+			},
+			expectError: "found 2 error(s)",
+			expectOut: []string{
+				"ERROR   /wt/proj-a/one: no .git file",
+				"ERROR   proj-a: branch feature is checked out in 2 worktrees: [/wt/proj-a/one /wt/proj-a/two]",
+				"WARNING /wt/proj-a/stale: orphaned directory not known to git",
+				"2 error(s), 1 warning(s)",
+			},
+		},
+		{
+			name: "warnings alone succeed",
+			args: []string{"proj-a"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService, _ *mocks.MockConfigManager) {
+				ps.On("DiscoverProject", mock.Anything, "proj-a", mock.Anything).Return(projA, nil)
+				ws.On("ListWorktrees", mock.Anything, mock.Anything).Return([]*domain.WorktreeInfo(nil), nil)
+				ws.On("VerifyWorktrees", mock.Anything, projA).Return(&domain.WorktreeVerification{DiscoveredOnly: []string{"/wt/proj-a/stale"}}, nil)
+				ws.On("CheckRepositoryIntegrity", mock.Anything, "/repos/proj-a").Return(nil)
+				ws.On("ValidateHooks", mock.Anything, "/repos/proj-a").Return("/repos/proj-a/.git/hooks", []domain.HookIssue(nil), nil)
+			},
+			expectOut: []string{"0 error(s), 1 warning(s)"},
+		},
+		{
+			name: "stale worktrees are warnings",
+			args: []string{"proj-a"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService, _ *mocks.MockConfigManager) {
+				old := time.Now().Add(-45 * 24 * time.Hour)
+				ps.On("DiscoverProject", mock.Anything, "proj-a", mock.Anything).Return(projA, nil)
+				ws.On("ListWorktrees", mock.Anything, &domain.ListWorktreesRequest{ProjectName: "proj-a", IncludeMain: true, IncludeLastUpdated: true}).
+					Return([]*domain.WorktreeInfo{
+						{Path: "/repos/proj-a", Branch: "main", LastUpdated: old},
+						{Path: "/wt/proj-a/old", Branch: "old", LastUpdated: old},
+						{Path: "/wt/proj-a/recent", Branch: "recent", LastUpdated: time.Now().Add(-time.Hour)},
+						{Path: "/wt/proj-a/unknown", Branch: "unknown"},
+						{Path: "/wt/proj-a/detached", IsDetached: true, LastUpdated: old},
+					}, nil)
+				ws.On("GetWorktreeHealth", mock.Anything, mock.Anything).Return(&domain.WorktreeHealth{}, nil)
+				ws.On("VerifyWorktrees", mock.Anything, projA).Return(&domain.WorktreeVerification{}, nil)
+				ws.On("CheckRepositoryIntegrity", mock.Anything, "/repos/proj-a").Return(nil)
+				ws.On("ValidateHooks", mock.Anything, "/repos/proj-a").Return("/repos/proj-a/.git/hooks", []domain.HookIssue(nil), nil)
+			},
+			expectOut: []string{
+				"WARNING /wt/proj-a/old: stale worktree: no commit for 45d",
+				"        Fix: delete it with 'twiggit delete proj-a/old' if the work is done",
+				"WARNING /wt/proj-a/detached: stale worktree: no commit for 45d",
+				"        Fix: remove it with 'git worktree remove /wt/proj-a/detached' if the work is done",
+				"0 error(s), 2 warning(s)",
+			},
+			rejectOut: []string{"/repos/proj-a:", "/wt/proj-a/recent", "/wt/proj-a/unknown"},
+		},
+		{
+			name: "fsck failure shows git's report",
+			args: []string{"proj-a"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService, _ *mocks.MockConfigManager) {
+				ps.On("DiscoverProject", mock.Anything, "proj-a", mock.Anything).Return(projA, nil)
+				ws.On("ListWorktrees", mock.Anything, mock.Anything).Return([]*domain.WorktreeInfo(nil), nil)
+				ws.On("VerifyWorktrees", mock.Anything, projA).Return(&domain.WorktreeVerification{}, nil)
+				ws.On("CheckRepositoryIntegrity", mock.Anything, "/repos/proj-a").Return(domain.NewWorktreeServiceError("/repos/proj-a", "", "CheckRepositoryIntegrity",
+					"repository objects are corrupt or missing", domain.NewGitRepositoryError("/repos/proj-a", "git fsck failed: missing blob 1234", nil)))
+				ws.On("ValidateHooks", mock.Anything, "/repos/proj-a").Return("/repos/proj-a/.git/hooks", []domain.HookIssue(nil), nil)
+			},
+			expectError: "found 1 error(s)",
+			expectOut:   []string{"ERROR   /repos/proj-a: git fsck failed: missing blob 1234", "Fix: run 'git fsck --full'"},
+		},
+		{
+			name: "hook issues",
+			args: []string{"proj-a"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService, _ *mocks.MockConfigManager) {
+				ps.On("DiscoverProject", mock.Anything, "proj-a", mock.Anything).Return(projA, nil)
+				ws.On("ListWorktrees", mock.Anything, mock.Anything).Return([]*domain.WorktreeInfo(nil), nil)
+				ws.On("VerifyWorktrees", mock.Anything, projA).Return(&domain.WorktreeVerification{}, nil)
+				ws.On("CheckRepositoryIntegrity", mock.Anything, "/repos/proj-a").Return(nil)
+				ws.On("ValidateHooks", mock.Anything, "/repos/proj-a").Return("/repos/proj-a/.githooks", []domain.HookIssue{
+					{HookName: "pre-commit", Issue: "not executable (run chmod +x /repos/proj-a/.githooks/pre-commit)"},
+					{Issue: "cannot read hooks directory: permission denied"},
+				}, nil)
+			},
+			expectError: "found 2 error(s)",
+			expectOut: []string{
+				"ERROR   " + filepath.Join("/repos/proj-a/.githooks", "pre-commit") + ": not executable",
+				"ERROR   /repos/proj-a/.githooks: cannot read hooks directory",
+			},
+		},
+		{
+			name: "all projects",
+			args: []string{"--all"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService, _ *mocks.MockConfigManager) {
+				ps.On("ListProjects", mock.Anything).Return([]*domain.ProjectInfo{projA, projB}, nil)
+				expectHealthyDoctorProject(ws, projA)
+				expectHealthyDoctorProject(ws, projB)
+			},
+			expectOut: []string{"No issues found"},
+		},
+		{
+			name:        "project with --all",
+			args:        []string{"proj-a", "--all"},
+			setupMocks:  func(_ *mocks.MockWorktreeService, _ *mocks.MockProjectService, _ *mocks.MockConfigManager) {},
+			expectError: "cannot combine a project with --all",
+		},
+		{
+			name: "workspace findings are shown when the project cannot be found",
+			args: []string{"missing"},
+			setupMocks: func(_ *mocks.MockWorktreeService, ps *mocks.MockProjectService, cm *mocks.MockConfigManager) {
+				cm.ExpectedCalls = nil
+				cm.On("Load").Return(nil, errors.New("invalid worktrees_dir"))
+				ps.On("DiscoverProject", mock.Anything, "missing", mock.Anything).Return(nil, errors.New("project not found"))
+			},
+			expectError: "failed to discover project",
+			expectOut:   []string{"ERROR   config: invalid worktrees_dir"},
+			rejectOut:   []string{"No issues found"},
+		},
+		{
+			name: "service error",
+			args: []string{},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService, _ *mocks.MockConfigManager) {
+				ps.On("DiscoverProject", mock.Anything, "", mock.Anything).Return(projA, nil)
+				ws.On("ListWorktrees", mock.Anything, mock.Anything).Return(nil, errors.New("not a repository"))
+			},
+			expectError: "failed to list worktrees of proj-a",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			cm := mocks.NewMockConfigManager()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj-a"}, nil)
+			cm.On("Load").Return(&domain.Config{}, nil)
+			tc.setupMocks(ws, ps, cm)
+
+			root := t.TempDir()
+			cfg := &domain.Config{ProjectsDirectory: root, WorktreesDirectory: root}
+			if tc.missingDirs {
+				cfg.ProjectsDirectory = filepath.Join(root, "projects")
+				cfg.WorktreesDirectory = filepath.Join(root, "worktrees")
+			}
+
+			config := &CommandConfig{
+				Config:   cfg,
+				Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps, ConfigManager: cm},
+			}
+			cmd := NewDoctorCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+			for _, rejected := range tc.rejectOut {
+				assert.NotContains(t, out.String(), rejected)
+			}
+		})
+	}
+}
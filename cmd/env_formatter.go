@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"twiggit/internal/domain"
+)
+
+// EnvStyle selects the assignment syntax of EnvFormatter
+type EnvStyle string
+
+const (
+	// EnvStyleShell writes NAME=value lines for sh, eval and .env files
+	EnvStyleShell EnvStyle = "shell"
+	// EnvStyleMakefile writes NAME := value lines for make
+	EnvStyleMakefile EnvStyle = "makefile"
+)
+
+// envVarPrefix starts every variable written by EnvFormatter
+const envVarPrefix = "WORKTREE_"
+
+// EnvFormatter renders worktrees as variable assignments: WORKTREE_<n>_PATH,
+// WORKTREE_<n>_BRANCH, WORKTREE_<n>_PROJECT (when known) and WORKTREE_COUNT
+type EnvFormatter struct{}
+
+// FormatWorktrees returns one assignment per line, numbering worktrees from 0 in the given order
+func (f *EnvFormatter) FormatWorktrees(worktrees []*domain.WorktreeInfo, style EnvStyle) string {
+	var result strings.Builder
+	assign := func(name, value string) {
+		if style == EnvStyleMakefile {
+			result.WriteString(strings.TrimRight(name+" := "+makefileEscape(value), " ") + "\n")
+			return
+		}
+		result.WriteString(name + "=" + envValue(value) + "\n")
+	}
+
+	for i, wt := range worktrees {
+		prefix := fmt.Sprintf("%s%d_", envVarPrefix, i)
+		assign(prefix+"PATH", wt.Path)
+		assign(prefix+"BRANCH", wt.Branch)
+		if wt.Project != "" {
+			assign(prefix+"PROJECT", wt.Project)
+		}
+	}
+	assign(envVarPrefix+"COUNT", fmt.Sprint(len(worktrees)))
+	return result.String()
+}
+
+// makefileEscape protects the characters make expands or treats as comments in a := value
+func makefileEscape(value string) string {
+	value = strings.ReplaceAll(value, "$", "$$")
+	return strings.ReplaceAll(value, "#", `\#`)
+}
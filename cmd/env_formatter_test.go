@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+func newTestEnvWorktrees() []*domain.WorktreeInfo {
+	return []*domain.WorktreeInfo{
+		{Path: "/wt/api/feature-x", Branch: "feature-x", Project: "api"},
+		{Path: "/wt/my api/fix-$HOME's #1", Branch: "fix-1", Project: "my-api"},
+		{Path: "/wt/api/detached"},
+	}
+}
+
+func TestEnvFormatter_FormatWorktrees(t *testing.T) {
+	testCases := []struct {
+		name      string
+		worktrees []*domain.WorktreeInfo
+		style     EnvStyle
+		expected  string
+	}{
+		{
+			name:      "shell",
+			worktrees: newTestEnvWorktrees(),
+			style:     EnvStyleShell,
+			expected: "WORKTREE_0_PATH=/wt/api/feature-x\n" +
+				"WORKTREE_0_BRANCH=feature-x\n" +
+				"WORKTREE_0_PROJECT=api\n" +
+				"WORKTREE_1_PATH='/wt/my api/fix-$HOME'\\''s #1'\n" +
+				"WORKTREE_1_BRANCH=fix-1\n" +
+				"WORKTREE_1_PROJECT=my-api\n" +
+				"WORKTREE_2_PATH=/wt/api/detached\n" +
+				"WORKTREE_2_BRANCH=''\n" +
+				"WORKTREE_COUNT=3\n",
+		},
+		{
+			name:      "makefile",
+			worktrees: newTestEnvWorktrees(),
+			style:     EnvStyleMakefile,
+			expected: "WORKTREE_0_PATH := /wt/api/feature-x\n" +
+				"WORKTREE_0_BRANCH := feature-x\n" +
+				"WORKTREE_0_PROJECT := api\n" +
+				"WORKTREE_1_PATH := /wt/my api/fix-$$HOME's \\#1\n" +
+				"WORKTREE_1_BRANCH := fix-1\n" +
+				"WORKTREE_1_PROJECT := my-api\n" +
+				"WORKTREE_2_PATH := /wt/api/detached\n" +
+				"WORKTREE_2_BRANCH :=\n" +
+				"WORKTREE_COUNT := 3\n",
+		},
+		{
+			name:     "no worktrees",
+			style:    EnvStyleShell,
+			expected: "WORKTREE_COUNT=0\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, (&EnvFormatter{}).FormatWorktrees(tc.worktrees, tc.style))
+		})
+	}
+}
+
+func TestEnvFormatter_FormatWorktrees_EvaluatesInShell(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not available")
+	}
+
+	cmd := exec.Command(sh, "-c", `eval "$ENV_SCRIPT" && printf '%s|%s|%s|%s|%s' "$WORKTREE_COUNT" "$WORKTREE_0_BRANCH" "$WORKTREE_1_PATH" "$WORKTREE_1_PROJECT" "$WORKTREE_2_BRANCH"`)
+	cmd.Env = append(os.Environ(), "ENV_SCRIPT="+(&EnvFormatter{}).FormatWorktrees(newTestEnvWorktrees(), EnvStyleShell))
+	out, err := cmd.Output()
+	require.NoError(t, err)
+	assert.Equal(t, "3|feature-x|/wt/my api/fix-$HOME's #1|my-api|", string(out))
+}
+
+func TestEnvFormatter_FormatWorktrees_EvaluatesInMake(t *testing.T) {
+	makeBin, err := exec.LookPath("make")
+	if err != nil {
+		t.Skip("make not available")
+	}
+
+	makefile := filepath.Join(t.TempDir(), "Makefile")
+	content := (&EnvFormatter{}).FormatWorktrees(newTestEnvWorktrees(), EnvStyleMakefile) +
+		"show:\n\t@printf '%s|%s|%s|%s\\n' '$(WORKTREE_COUNT)' '$(WORKTREE_0_BRANCH)' '$(WORKTREE_1_PROJECT)' '$(WORKTREE_2_BRANCH)'\n"
+	require.NoError(t, os.WriteFile(makefile, []byte(content), 0600))
+
+	out, err := exec.Command(makeBin, "-s", "-f", makefile, "show").Output()
+	require.NoError(t, err)
+	assert.Equal(t, "3|feature-x|my-api|\n", string(out))
+}
@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"twiggit/internal/domain"
+)
+
+// sessionIDEnvVar is set by the shell wrapper to the shell's PID
+const sessionIDEnvVar = "TWIGGIT_SESSION_ID"
+
+// ephemeralCleanOptions holds the flag values for the ephemeral clean command
+type ephemeralCleanOptions struct {
+	session string
+	force   bool
+}
+
+// NewEphemeralCommand creates the ephemeral command group for managing session-scoped worktrees
+func NewEphemeralCommand(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ephemeral",
+		Short: "Manage worktrees created with create --ephemeral",
+		Long: `Worktrees created with --ephemeral are registered for deletion when the shell
+session that created them exits. These commands inspect and clean up registrations
+left behind by sessions that ended without running their cleanup.
+
+Examples:
+  twiggit ephemeral list                 Show registered worktrees per session
+  twiggit ephemeral clean                Delete worktrees of sessions that have ended
+  twiggit ephemeral clean --session 4242 Delete the worktrees of one session`,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newEphemeralListCmd(config))
+	cmd.AddCommand(newEphemeralCleanCmd(config))
+
+	return cmd
+}
+
+func newEphemeralListCmd(config *CommandConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List ephemeral worktree registrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			sessions, err := config.Services.EphemeralRegistry.ListSessions()
+			if err != nil {
+				return fmt.Errorf("failed to list ephemeral worktrees: %w", err)
+			}
+			displayEphemeralSessions(cmd.OutOrStdout(), sessions)
+			return nil
+		},
+	}
+}
+
+func newEphemeralCleanCmd(config *CommandConfig) *cobra.Command {
+	var opts ephemeralCleanOptions
+
+	cmd := &cobra.Command{
+		Use:   "clean",
+		Short: "Delete ephemeral worktrees of ended sessions",
+		Long: `Delete the worktrees registered by sessions whose shell is no longer running.
+With --session, delete the worktrees of that session whether or not it is still running;
+this is what the EXIT trap installed by create --ephemeral runs.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeEphemeralClean(cmd, config, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.session, "session", "", "Clean only this session")
+	cmd.Flags().BoolVarP(&opts.force, "force", "f", false, "Delete worktrees even if they have uncommitted changes")
+
+	return cmd
+}
+
+// executeEphemeralClean deletes registered worktrees, keeping registrations that fail so they can be retried
+func executeEphemeralClean(cmd *cobra.Command, config *CommandConfig, opts ephemeralCleanOptions) error {
+	ctx := context.Background()
+	registry := config.Services.EphemeralRegistry
+
+	var sessions []domain.EphemeralSession
+	if opts.session != "" {
+		paths, err := registry.GetRegistered(opts.session)
+		if err != nil {
+			return fmt.Errorf("failed to read session %s: %w", opts.session, err)
+		}
+		sessions = []domain.EphemeralSession{{SessionID: opts.session, WorktreePaths: paths}}
+	} else {
+		all, err := registry.ListSessions()
+		if err != nil {
+			return fmt.Errorf("failed to list ephemeral worktrees: %w", err)
+		}
+		for _, session := range all {
+			if session.IsStranded() {
+				sessions = append(sessions, session)
+			}
+		}
+	}
+
+	var failures []string
+	for _, session := range sessions {
+		for _, path := range session.WorktreePaths {
+			if err := deleteEphemeralWorktree(ctx, config, path, opts.force); err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %v", path, err))
+				continue
+			}
+			if err := registry.Unregister(path, session.SessionID); err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %v", path, err))
+				continue
+			}
+			if !isQuiet(cmd) {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Deleted ephemeral worktree: %s\n", path)
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to clean %d ephemeral worktree(s):\n  %s", len(failures), strings.Join(failures, "\n  "))
+	}
+	return nil
+}
+
+// deleteEphemeralWorktree deletes the worktree; one already removed counts as cleaned
+func deleteEphemeralWorktree(ctx context.Context, config *CommandConfig, path string, force bool) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+	return config.Services.WorktreeService.DeleteWorktree(ctx, &domain.DeleteWorktreeRequest{
+		WorktreePath: path,
+		Force:        force,
+	})
+}
+
+// displayEphemeralSessions prints each session and its registered worktrees
+func displayEphemeralSessions(out io.Writer, sessions []domain.EphemeralSession) {
+	if len(sessions) == 0 {
+		_, _ = fmt.Fprintln(out, "No ephemeral worktrees registered")
+		return
+	}
+
+	for _, session := range sessions {
+		state := "active"
+		if session.IsStranded() {
+			state = "ended"
+		}
+		_, _ = fmt.Fprintf(out, "Session %s (%s):\n", session.SessionID, state)
+		for _, path := range session.WorktreePaths {
+			_, _ = fmt.Fprintf(out, "  %s\n", path)
+		}
+	}
+}
+
+// currentSessionID returns the session ID set by the shell wrapper, falling back to the parent process ID
+func currentSessionID(getenv func(string) string) string {
+	if sessionID := getenv(sessionIDEnvVar); sessionID != "" {
+		return sessionID
+	}
+	return strconv.Itoa(os.Getppid())
+}
+
+// ephemeralCleanupScript returns the shell code evaluated by the wrapper after create --ephemeral.
+// bash gets the clean command appended to its existing EXIT trap (read with trap -p) instead of
+// replacing it. zsh runs an EXIT trap set inside a function when that function returns, so it
+// gets a zshexit hook instead. The command cleans the whole session, so repeated ephemeral
+// creates add it only once.
+func ephemeralCleanupScript(sessionID, worktreePath string, changeDir bool) string {
+	cleanCmd := "command twiggit ephemeral clean --quiet --session " + sessionID
+	var script strings.Builder
+	if changeDir {
+		script.WriteString("builtin cd " + shellQuote(worktreePath) + "\n")
+	}
+	script.WriteString(`if [ -n "${ZSH_VERSION-}" ]; then` + "\n")
+	script.WriteString("twiggit_ephemeral_exit() { " + cleanCmd + "; }\n")
+	script.WriteString("autoload -Uz add-zsh-hook\n")
+	script.WriteString("add-zsh-hook zshexit twiggit_ephemeral_exit\n")
+	script.WriteString("else\n")
+	script.WriteString("twiggit_clean=" + shellQuote(cleanCmd) + "\n")
+	script.WriteString(`eval "set -- $(trap -p EXIT)"` + "\n")
+	script.WriteString(`case "$3" in` + "\n")
+	script.WriteString(`*"$twiggit_clean"*) ;;` + "\n")
+	script.WriteString(`'') trap -- "$twiggit_clean" EXIT ;;` + "\n")
+	script.WriteString(`*) trap -- "$3` + "\n" + `$twiggit_clean" EXIT ;;` + "\n")
+	script.WriteString("esac\n")
+	script.WriteString("unset twiggit_clean\n")
+	script.WriteString("fi\n")
+	return script.String()
+}
+
+// shellQuote quotes s for POSIX shells using single quotes
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
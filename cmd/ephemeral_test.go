@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestEphemeralCommand_Execute(t *testing.T) {
+	existing := t.TempDir()
+	missing := filepath.Join(t.TempDir(), "already-gone")
+
+	testCases := []struct {
+		name        string
+		args        []string
+		setupMocks  func(reg *mocks.MockEphemeralRegistry, ws *mocks.MockWorktreeService)
+		expectError string
+		expectOut   []string
+	}{
+		{
+			name: "list sessions",
+			args: []string{"list"},
+			setupMocks: func(reg *mocks.MockEphemeralRegistry, _ *mocks.MockWorktreeService) {
+				reg.On("ListSessions").Return([]domain.EphemeralSession{
+					{SessionID: "100", WorktreePaths: []string{"/wt/proj/spike"}, Active: true},
+					{SessionID: "200", WorktreePaths: []string{"/wt/proj/old"}},
+				}, nil)
+			},
+			expectOut: []string{"Session 100 (active):\n  /wt/proj/spike", "Session 200 (ended):\n  /wt/proj/old"},
+		},
+		{
+			name: "list without registrations",
+			args: []string{"list"},
+			setupMocks: func(reg *mocks.MockEphemeralRegistry, _ *mocks.MockWorktreeService) {
+				reg.On("ListSessions").Return([]domain.EphemeralSession{}, nil)
+			},
+			expectOut: []string{"No ephemeral worktrees registered"},
+		},
+		{
+			name: "clean deletes only ended sessions",
+			args: []string{"clean"},
+			setupMocks: func(reg *mocks.MockEphemeralRegistry, ws *mocks.MockWorktreeService) {
+				reg.On("ListSessions").Return([]domain.EphemeralSession{
+					{SessionID: "100", WorktreePaths: []string{"/wt/active"}, Active: true},
+					{SessionID: "200", WorktreePaths: []string{existing, missing}},
+				}, nil)
+				ws.On("DeleteWorktree", mock.Anything, &domain.DeleteWorktreeRequest{WorktreePath: existing}).Return(nil)
+				reg.On("Unregister", existing, "200").Return(nil)
+				reg.On("Unregister", missing, "200").Return(nil)
+			},
+			expectOut: []string{"Deleted ephemeral worktree: " + existing, "Deleted ephemeral worktree: " + missing},
+		},
+		{
+			name: "clean one session with force",
+			args: []string{"clean", "--session", "100", "--force"},
+			setupMocks: func(reg *mocks.MockEphemeralRegistry, ws *mocks.MockWorktreeService) {
+				reg.On("GetRegistered", "100").Return([]string{existing}, nil)
+				ws.On("DeleteWorktree", mock.Anything, &domain.DeleteWorktreeRequest{WorktreePath: existing, Force: true}).Return(nil)
+				reg.On("Unregister", existing, "100").Return(nil)
+			},
+			expectOut: []string{"Deleted ephemeral worktree: " + existing},
+		},
+		{
+			name: "failed delete keeps the registration",
+			args: []string{"clean", "--session", "100"},
+			setupMocks: func(reg *mocks.MockEphemeralRegistry, ws *mocks.MockWorktreeService) {
+				reg.On("GetRegistered", "100").Return([]string{existing}, nil)
+				ws.On("DeleteWorktree", mock.Anything, mock.Anything).Return(errors.New("worktree has uncommitted changes"))
+			},
+			expectError: "failed to clean 1 ephemeral worktree(s)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reg := mocks.NewMockEphemeralRegistry()
+			ws := mocks.NewMockWorktreeService()
+			tc.setupMocks(reg, ws)
+
+			config := &CommandConfig{Services: &ServiceContainer{WorktreeService: ws, EphemeralRegistry: reg}}
+			cmd := NewEphemeralCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				reg.AssertNotCalled(t, "Unregister", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+			reg.AssertExpectations(t)
+			ws.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCurrentSessionID(t *testing.T) {
+	assert.Equal(t, "4242", currentSessionID(func(string) string { return "4242" }))
+	assert.Equal(t, strconv.Itoa(os.Getppid()), currentSessionID(func(string) string { return "" }))
+}
+
+func TestEphemeralCleanupScript(t *testing.T) {
+	script := ephemeralCleanupScript("4242", "/wt/proj/spike", false)
+	assert.Contains(t, script, "twiggit_clean='command twiggit ephemeral clean --quiet --session 4242'\n")
+	assert.Contains(t, script, "twiggit_ephemeral_exit() { command twiggit ephemeral clean --quiet --session 4242; }\n")
+	assert.Equal(t, "builtin cd '/wt/it'\\''s here'\n"+script, ephemeralCleanupScript("4242", "/wt/it's here", true))
+}
+
+func TestEphemeralCleanupScript_EvaluatesInShell(t *testing.T) {
+	// A fake twiggit on PATH records the clean command in the log
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "exit.log")
+	fake := "#!/bin/sh\necho \"twiggit $*\" >> " + shellQuote(logPath) + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "twiggit"), []byte(fake), 0700)) // #nosec G306 -- test executable
+
+	testCases := []struct {
+		name     string
+		shell    string
+		userTrap string
+		expected string
+	}{
+		{
+			name:     "bash without existing trap",
+			shell:    "bash",
+			expected: "function returned\ntwiggit ephemeral clean --quiet --session 4242\n",
+		},
+		{
+			name:     "bash keeps existing trap",
+			shell:    "bash",
+			userTrap: `trap 'echo "user trap" >> "$EXIT_LOG"' EXIT` + "\n",
+			expected: "function returned\nuser trap\ntwiggit ephemeral clean --quiet --session 4242\n",
+		},
+		{
+			name:     "zsh runs the hook at shell exit",
+			shell:    "zsh",
+			expected: "function returned\ntwiggit ephemeral clean --quiet --session 4242\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			shell, err := exec.LookPath(tc.shell)
+			if err != nil {
+				t.Skip(tc.shell + " not available")
+			}
+			require.NoError(t, os.RemoveAll(logPath))
+			script := ephemeralCleanupScript("4242", "/wt/proj/spike", false)
+			// Evaluated inside a function as the wrapper does, twice as after two ephemeral
+			// creates in the same shell; nothing may run before the shell exits
+			cmd := exec.Command(shell, "-c", tc.userTrap+`setup() { eval "$SETUP"; }
+setup; setup
+echo "function returned" >> "$EXIT_LOG"`)
+			cmd.Env = append(os.Environ(), "PATH="+dir+string(os.PathListSeparator)+os.Getenv("PATH"), "SETUP="+script, "EXIT_LOG="+logPath)
+			out, err := cmd.CombinedOutput()
+			require.NoError(t, err, string(out))
+
+			log, err := os.ReadFile(logPath)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(log))
+		})
+	}
+}
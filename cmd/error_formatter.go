@@ -38,6 +38,11 @@ func isServiceError(err error) bool {
 	return errors.As(err, &target)
 }
 
+// isNetworkUnreachableError checks if error comes from a failed connectivity check
+func isNetworkUnreachableError(err error) bool {
+	return errors.Is(err, domain.ErrNetworkUnreachable)
+}
+
 // ErrorFormatter is a composable error formatter using explicit strategy pattern
 type ErrorFormatter struct {
 	matchers []struct {
@@ -60,6 +65,7 @@ func NewErrorFormatterWithOptions(quiet bool) *ErrorFormatter {
 
 	// Register formatters using explicit strategy pattern
 	// Order matters: more specific matchers should come first
+	formatter.register(isNetworkUnreachableError, formatNetworkUnreachableError)
 	formatter.register(isValidationError, formatValidationError)
 	formatter.register(isWorktreeError, formatWorktreeError)
 	formatter.register(isProjectError, formatProjectError)
@@ -168,11 +174,23 @@ func formatProjectError(err error) string {
 	output.WriteString(fmt.Sprintf("Error: %s\n", projectErr.Error()))
 
 	// Add helpful suggestion (quiet mode is handled by wrapper)
-	output.WriteString("Hint: Use 'twiggit list --all' to see available projects\n")
+	if errors.Is(err, domain.ErrProjectNotFound) {
+		output.WriteString("Hint: Use 'twiggit project list' to see available projects\n")
+	} else {
+		output.WriteString("Hint: Use 'twiggit list --all' to see available projects\n")
+	}
 
 	return output.String()
 }
 
+// formatNetworkUnreachableError formats a failed connectivity check with a way around it
+func formatNetworkUnreachableError(err error) string {
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("Error: %s\n", err.Error()))
+	output.WriteString("Hint: Check your network connection, or skip the check with --no-network-check\n")
+	return output.String()
+}
+
 // formatServiceError formats ServiceError with actionable hints
 func formatServiceError(err error) string {
 	serviceErr := func() *domain.ServiceError {
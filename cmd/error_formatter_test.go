@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -435,3 +436,21 @@ func TestErrorFormatter_RegistrationOrder(t *testing.T) {
 	// This tests the order of matchers
 	assert.GreaterOrEqual(t, len(formatter.matchers), 4, "should have at least 4 matchers registered")
 }
+
+func TestErrorFormatter_FormatProjectNotFoundSuggestsProjectList(t *testing.T) {
+	projectErr := domain.NewProjectServiceError("missing", "", "ProjectInfo", "project not found", domain.ErrProjectNotFound)
+
+	output := NewErrorFormatter().Format(projectErr)
+
+	assert.Contains(t, output, "Hint: Use 'twiggit project list' to see available projects")
+	assert.NotContains(t, output, "twiggit list --all")
+}
+
+func TestErrorFormatter_FormatNetworkUnreachableError(t *testing.T) {
+	err := fmt.Errorf("fetch failed: %w", domain.NewNetworkUnreachableError("github.com:22", errors.New("i/o timeout")))
+
+	output := NewErrorFormatter().Format(err)
+
+	assert.Contains(t, output, "Error: fetch failed: cannot reach github.com:22: i/o timeout")
+	assert.Contains(t, output, "Hint: Check your network connection, or skip the check with --no-network-check")
+}
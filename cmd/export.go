@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+	"twiggit/internal/infrastructure"
+)
+
+// NewExportCommand creates the export command
+func NewExportCommand(config *CommandConfig) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "export [project/branch|branch]",
+		Short: "Archive a worktree's files as a .tar.gz",
+		Long: `Write the files of a worktree, without .git, as a .tar.gz archive.
+
+The archive holds the working tree as it is on disk, uncommitted and
+untracked files included, with a twiggit-manifest.json at its root that
+records the project, branch and HEAD commit. Symlinks are stored as links.
+Entries have no owner and a fixed time, so the same files always give the
+same archive.
+
+Defaults to the current worktree. Without --output, or with --output -,
+the archive is written to stdout.
+
+Examples:
+  twiggit export -o feature.tar.gz                  Export the current worktree
+  twiggit export myproject/feature -o build.tar.gz  Export a specific worktree
+  twiggit export feature | ssh host tar -xzf - -C /srv/app`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var target string
+			if len(args) > 0 {
+				target = args[0]
+			}
+			return executeExport(cmd, config, target, output)
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Archive file to write (default stdout)")
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionWorktreeTarget(config, infrastructure.WithExistingOnly()),
+	)
+	carapace.Gen(cmd).FlagCompletion(map[string]carapace.Action{
+		"output": carapace.ActionFiles(".tar.gz", ".tgz"),
+	})
+
+	return cmd
+}
+
+// executeExport archives the resolved worktree to the output file or stdout
+func executeExport(cmd *cobra.Command, config *CommandConfig, target, output string) error {
+	ctx := context.Background()
+
+	_, result, err := resolveNavigationTarget(ctx, config, target)
+	if err != nil {
+		return err
+	}
+	worktreePath := result.ResolvedPath
+
+	toStdout := output == "" || output == "-"
+	if !toStdout {
+		if err := validateExportOutput(worktreePath, output); err != nil {
+			return err
+		}
+	}
+
+	status, err := config.Services.WorktreeService.GetWorktreeStatus(ctx, worktreePath)
+	if err != nil {
+		return fmt.Errorf("failed to read HEAD of %s: %w", worktreePath, err)
+	}
+	manifest := &domain.ExportManifest{Project: result.ProjectName, Branch: result.BranchName}
+	if status.RepositoryStatus != nil {
+		manifest.Branch = status.RepositoryStatus.Branch
+		manifest.Commit = status.RepositoryStatus.Commit
+	}
+
+	logv(cmd, 1, "Exporting %s at %s", worktreePath, manifest.Commit)
+
+	if toStdout {
+		if _, err := config.Services.WorktreeArchiver.Archive(cmd.OutOrStdout(), worktreePath, manifest); err != nil {
+			return fmt.Errorf("failed to export: %w", err)
+		}
+		return nil
+	}
+
+	exported, err := writeExportFile(config, output, worktreePath, manifest)
+	if err != nil {
+		return err
+	}
+	if !isQuiet(cmd) {
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Exported %s to %s (%d files, %d symlinks)\n",
+			worktreePath, output, exported.Files, exported.Symlinks)
+	}
+	return nil
+}
+
+// validateExportOutput rejects an output file inside the worktree, which the archive would include
+func validateExportOutput(worktreePath, output string) error {
+	absOutput, err := filepath.Abs(output)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", output, err)
+	}
+	rel, err := filepath.Rel(worktreePath, absOutput)
+	if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return domain.NewValidationError("export", "output", output, "the archive cannot be written inside the worktree it exports")
+	}
+	return nil
+}
+
+// writeExportFile writes the archive to output, removing the partial file when archiving fails
+func writeExportFile(config *CommandConfig, output, worktreePath string, manifest *domain.ExportManifest) (*domain.ExportResult, error) {
+	file, err := os.Create(output) // #nosec G304 -- output is the user's --output path
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", output, err)
+	}
+
+	exported, err := config.Services.WorktreeArchiver.Archive(file, worktreePath, manifest)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to write %s: %w", output, closeErr)
+	}
+	if err != nil {
+		_ = os.Remove(output)
+		return nil, fmt.Errorf("failed to export: %w", err)
+	}
+	return exported, nil
+}
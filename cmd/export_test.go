@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestExportCommand_Execute(t *testing.T) {
+	outDir := t.TempDir()
+	manifest := &domain.ExportManifest{Project: "proj", Branch: "feature", Commit: "abc123"}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		archiveErr   error
+		expectFile   string
+		expectStdout bool
+		expectError  string
+		expectOut    []string
+	}{
+		{
+			name:       "writes the output file",
+			args:       []string{"proj/feature", "--output", filepath.Join(outDir, "feature.tar.gz")},
+			expectFile: filepath.Join(outDir, "feature.tar.gz"),
+			expectOut:  []string{"Exported /wt/proj/feature to", "(3 files, 1 symlinks)"},
+		},
+		{
+			name:         "writes to stdout without --output",
+			args:         []string{"proj/feature"},
+			expectStdout: true,
+		},
+		{
+			name:         "writes to stdout with --output -",
+			args:         []string{"proj/feature", "-o", "-"},
+			expectStdout: true,
+		},
+		{
+			name:        "rejects output inside the worktree",
+			args:        []string{"proj/feature", "-o", "/wt/proj/feature/out.tar.gz"},
+			expectError: "cannot be written inside the worktree",
+		},
+		{
+			name:        "removes the partial file on failure",
+			args:        []string{"proj/feature", "-o", filepath.Join(outDir, "broken.tar.gz")},
+			archiveErr:  errors.New("permission denied"),
+			expectError: "failed to export",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := mocks.NewMockContextService()
+			ns := mocks.NewMockNavigationService()
+			ws := mocks.NewMockWorktreeService()
+			archiver := mocks.NewMockWorktreeArchiver()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+			ns.On("ResolvePath", mock.Anything, mock.Anything).Return(&domain.ResolutionResult{
+				ResolvedPath: "/wt/proj/feature", Type: domain.PathTypeWorktree, ProjectName: "proj", BranchName: "feature",
+			}, nil)
+			ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj/feature").Return(&domain.WorktreeStatus{
+				RepositoryStatus: &domain.RepositoryStatus{Branch: "feature", Commit: "abc123"},
+			}, nil)
+			archiver.On("Archive", mock.Anything, "/wt/proj/feature", manifest).
+				Run(func(args mock.Arguments) {
+					_, _ = args.Get(0).(io.Writer).Write([]byte("ARCHIVE"))
+				}).
+				Return(&domain.ExportResult{Files: 3, Symlinks: 1}, tc.archiveErr).Maybe()
+
+			config := &CommandConfig{Services: &ServiceContainer{
+				ContextService:    cs,
+				NavigationService: ns,
+				WorktreeService:   ws,
+				WorktreeArchiver:  archiver,
+			}}
+			cmd := NewExportCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				assert.NoFileExists(t, filepath.Join(outDir, "broken.tar.gz"))
+				return
+			}
+			require.NoError(t, err)
+			if tc.expectStdout {
+				assert.Equal(t, "ARCHIVE", out.String())
+			}
+			if tc.expectFile != "" {
+				content, err := os.ReadFile(tc.expectFile)
+				require.NoError(t, err)
+				assert.Equal(t, "ARCHIVE", string(content))
+			}
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+		})
+	}
+}
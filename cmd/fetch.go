@@ -0,0 +1,228 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// defaultFetchRemote is the remote fetch uses when --remote is not given
+const defaultFetchRemote = "origin"
+
+// fetchConcurrency caps the projects fetch --all fetches at once
+const fetchConcurrency = 4
+
+// fetchOptions holds the flag values for the fetch command
+type fetchOptions struct {
+	remote    string
+	tagsOnly  bool
+	pruneTags bool
+	all       bool
+}
+
+// fetchResult is the outcome of fetching one project
+type fetchResult struct {
+	project *domain.ProjectInfo
+	err     error
+}
+
+// NewFetchCommand creates the fetch command
+func NewFetchCommand(config *CommandConfig) *cobra.Command {
+	var opts fetchOptions
+
+	cmd := &cobra.Command{
+		Use:   "fetch [project]",
+		Short: "Fetch the remotes of a project",
+		Long: `Fetch every remote of a project (git fetch --all). The project defaults to
+the one of the current directory. Linked worktrees share their remote-tracking
+branches with the main repository, so this updates all of the project's
+worktrees at once. --all fetches every project, several at a time, and
+reports which ones failed.
+
+--tags-only refreshes only the tags of --remote, which is much faster than
+git fetch --tags on large repositories. --prune-tags also removes local tags
+that were deleted on the remote.
+
+The host of --remote is checked for connectivity first; --no-network-check or
+[git] skip_network_check = true skips the check.
+
+Examples:
+  twiggit fetch
+  twiggit fetch --all
+  twiggit fetch --tags-only
+  twiggit fetch myproject --tags-only --prune-tags
+  twiggit fetch --tags-only --remote upstream`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			}
+			return executeFetch(cmd, config, projectName, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.remote, "remote", defaultFetchRemote, "Remote to check for connectivity and, with --tags-only, to fetch tags from")
+	cmd.Flags().BoolVar(&opts.tagsOnly, "tags-only", false, "Fetch only the tags of --remote, not branches")
+	cmd.Flags().BoolVar(&opts.pruneTags, "prune-tags", false, "Remove local tags that were deleted on the remote (requires --tags-only)")
+	cmd.Flags().BoolVarP(&opts.all, "all", "a", false, "Fetch all projects")
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionProjects(config),
+	)
+
+	return cmd
+}
+
+// newFetchTagsInternalCmd creates the hidden __fetch-tags command for scripts
+func newFetchTagsInternalCmd(config *CommandConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:    "__fetch-tags <project> [remote]",
+		Short:  "Fetch the tags of a project's remote",
+		Hidden: true,
+		Args:   cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := fetchOptions{remote: defaultFetchRemote, tagsOnly: true}
+			if len(args) > 1 {
+				opts.remote = args[1]
+			}
+			return executeFetchTags(cmd, config, args[0], opts)
+		},
+	}
+}
+
+// newPruneTagsInternalCmd creates the hidden __prune-tags command
+func newPruneTagsInternalCmd(config *CommandConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:    "__prune-tags <project>",
+		Short:  "Remove local tags deleted on the remote",
+		Hidden: true,
+		Args:   cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			project, err := resolveFetchProject(ctx, cmd, config, args[0], defaultFetchRemote)
+			if err != nil {
+				return err
+			}
+			return config.Services.WorktreeService.PruneTags(ctx, project.GitRepoPath)
+		},
+	}
+}
+
+// executeFetch fetches the named or current project, or every project with --all
+func executeFetch(cmd *cobra.Command, config *CommandConfig, projectName string, opts fetchOptions) error {
+	ctx := context.Background()
+
+	if opts.all && projectName != "" {
+		return domain.NewValidationError("fetch", "project", projectName, "cannot combine a project with --all")
+	}
+	if opts.pruneTags && !opts.tagsOnly {
+		return domain.NewValidationError("fetch", "prune-tags", "true", "--prune-tags requires --tags-only")
+	}
+
+	projects, err := resolveVerifyProjects(ctx, config, projectName, opts.all)
+	if err != nil {
+		return err
+	}
+	if len(projects) > 1 {
+		logv(cmd, 1, "Fetching %d projects", len(projects))
+	}
+	return reportFetchResults(cmd, fetchProjects(ctx, cmd, config, projects, opts), opts)
+}
+
+// executeFetchTags refreshes the tags of one project
+func executeFetchTags(cmd *cobra.Command, config *CommandConfig, projectName string, opts fetchOptions) error {
+	ctx := context.Background()
+
+	projects, err := resolveVerifyProjects(ctx, config, projectName, false)
+	if err != nil {
+		return err
+	}
+	return reportFetchResults(cmd, fetchProjects(ctx, cmd, config, projects, opts), opts)
+}
+
+// fetchProjects fetches several projects at once; the results keep the order of projects
+func fetchProjects(ctx context.Context, cmd *cobra.Command, config *CommandConfig, projects []*domain.ProjectInfo, opts fetchOptions) []fetchResult {
+	results := make([]fetchResult, len(projects))
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, fetchConcurrency)
+	for i, project := range projects {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, project *domain.ProjectInfo) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			results[i] = fetchResult{project: project, err: fetchProject(ctx, cmd, config, project, opts)}
+		}(i, project)
+	}
+	wg.Wait()
+	return results
+}
+
+// fetchProject checks that the remote is reachable, then fetches all remotes or, with
+// --tags-only, the tags of the remote (pruning deleted ones first with --prune-tags)
+func fetchProject(ctx context.Context, cmd *cobra.Command, config *CommandConfig, project *domain.ProjectInfo, opts fetchOptions) error {
+	if err := checkRemoteReachable(cmd, config, project, opts.remote); err != nil {
+		return err
+	}
+
+	if !opts.tagsOnly {
+		return config.Services.WorktreeService.FetchAllRemotes(ctx, project.GitRepoPath)
+	}
+	if opts.pruneTags {
+		if err := config.Services.WorktreeService.PruneTags(ctx, project.GitRepoPath); err != nil {
+			return err
+		}
+	}
+	return config.Services.WorktreeService.FetchTagsOnly(ctx, project.GitRepoPath, opts.remote)
+}
+
+// reportFetchResults prints a line per fetched project and one per failure to stderr. A
+// single project's error is returned as is; several projects fail with a count.
+func reportFetchResults(cmd *cobra.Command, results []fetchResult, opts fetchOptions) error {
+	if len(results) == 1 && results[0].err != nil {
+		return results[0].err
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.err != nil {
+			failed++
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Failed to fetch %s: %v\n", result.project.Name, result.err)
+			continue
+		}
+		if isQuiet(cmd) {
+			continue
+		}
+		if opts.tagsOnly {
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Fetched tags of %s from %s\n", result.project.Name, opts.remote)
+		} else {
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Fetched %s\n", result.project.Name)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("fetch failed for %d of %d projects", failed, len(results))
+	}
+	return nil
+}
+
+// resolveFetchProject discovers the project and checks that its remote is reachable
+func resolveFetchProject(ctx context.Context, cmd *cobra.Command, config *CommandConfig, projectName, remote string) (*domain.ProjectInfo, error) {
+	projects, err := resolveVerifyProjects(ctx, config, projectName, false)
+	if err != nil {
+		return nil, err
+	}
+	project := projects[0]
+	if err := checkRemoteReachable(cmd, config, project, remote); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
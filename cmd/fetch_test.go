@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestFetchCommand_TagsOnly(t *testing.T) {
+	project := &domain.ProjectInfo{
+		Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj",
+		Remotes: []*domain.RemoteInfo{
+			{Name: "origin", FetchURL: "https://github.com/me/proj.git"},
+			{Name: "local", FetchURL: "/srv/git/proj.git"},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		network     *mocks.MockNetworkChecker
+		expectPrune bool
+		expectFetch string // Remote fetched from; "" when nothing is fetched
+		expectError string
+		expectOut   string
+	}{
+		{
+			name:        "fetches tags of origin",
+			args:        []string{"--tags-only"},
+			network:     mocks.NewReachableNetworkChecker(),
+			expectFetch: "origin",
+			expectOut:   "Fetched tags of proj from origin\n",
+		},
+		{
+			name:        "prunes before fetching",
+			args:        []string{"proj", "--tags-only", "--prune-tags"},
+			network:     mocks.NewReachableNetworkChecker(),
+			expectPrune: true,
+			expectFetch: "origin",
+		},
+		{
+			name:        "local remote is not dialed",
+			args:        []string{"--tags-only", "--remote", "local"},
+			network:     mocks.NewMockNetworkChecker(),
+			expectFetch: "local",
+		},
+		{
+			name:        "no-network-check skips the dial",
+			args:        []string{"--tags-only", "--no-network-check"},
+			network:     mocks.NewMockNetworkChecker(),
+			expectFetch: "origin",
+		},
+		{
+			name:        "unreachable remote",
+			args:        []string{"--tags-only"},
+			network:     mocks.NewUnreachableNetworkChecker(),
+			expectError: "cannot reach",
+		},
+		{
+			name:        "prune-tags requires --tags-only",
+			args:        []string{"--prune-tags"},
+			network:     mocks.NewMockNetworkChecker(),
+			expectError: "--prune-tags requires --tags-only",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+			ps.On("DiscoverProject", mock.Anything, mock.Anything, mock.Anything).Return(project, nil)
+			ws.On("PruneTags", mock.Anything, "/repos/proj").Return(nil)
+			ws.On("FetchTagsOnly", mock.Anything, "/repos/proj", mock.Anything).Return(nil)
+
+			config := &CommandConfig{
+				Config:   domain.DefaultConfig(),
+				Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps, NetworkChecker: tc.network},
+			}
+			cmd := NewFetchCommand(config)
+			cmd.Flags().Bool("no-network-check", false, "")
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				ws.AssertNotCalled(t, "FetchTagsOnly", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			ws.AssertCalled(t, "FetchTagsOnly", mock.Anything, "/repos/proj", tc.expectFetch)
+			if tc.expectPrune {
+				ws.AssertCalled(t, "PruneTags", mock.Anything, "/repos/proj")
+			} else {
+				ws.AssertNotCalled(t, "PruneTags", mock.Anything, mock.Anything)
+			}
+			if tc.expectOut != "" {
+				assert.Equal(t, tc.expectOut, out.String())
+			}
+			tc.network.AssertExpectations(t)
+		})
+	}
+}
+
+func TestFetchCommand_AllRemotes(t *testing.T) {
+	projects := []*domain.ProjectInfo{
+		{Name: "api", GitRepoPath: "/repos/api"},
+		{Name: "offline", GitRepoPath: "/repos/offline"},
+		{Name: "web", GitRepoPath: "/repos/web"},
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectFetch []string
+		expectError string
+		expectOut   string
+		expectErr   string
+	}{
+		{
+			name:        "fetches the current project",
+			args:        []string{},
+			expectFetch: []string{"/repos/api"},
+			expectOut:   "Fetched api\n",
+		},
+		{
+			name:        "fetches every project and reports failures",
+			args:        []string{"--all"},
+			expectFetch: []string{"/repos/api", "/repos/offline", "/repos/web"},
+			expectError: "fetch failed for 1 of 3 projects",
+			expectOut:   "Fetched api\nFetched web\n",
+			expectErr:   "Failed to fetch offline: network down\n",
+		},
+		{
+			name:        "project and --all",
+			args:        []string{"api", "--all"},
+			expectError: "cannot combine a project with --all",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "api"}, nil)
+			ps.On("DiscoverProject", mock.Anything, mock.Anything, mock.Anything).Return(projects[0], nil)
+			ps.On("ListProjects", mock.Anything).Return(projects, nil)
+			ws.On("FetchAllRemotes", mock.Anything, "/repos/offline").Return(errors.New("network down"))
+			ws.On("FetchAllRemotes", mock.Anything, mock.Anything).Return(nil)
+
+			config := &CommandConfig{
+				Config:   domain.DefaultConfig(),
+				Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps, NetworkChecker: mocks.NewMockNetworkChecker()},
+			}
+			cmd := NewFetchCommand(config)
+			cmd.Flags().Bool("no-network-check", false, "")
+			var out, errOut bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&errOut)
+			cmd.SilenceUsage = true
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, path := range tc.expectFetch {
+				ws.AssertCalled(t, "FetchAllRemotes", mock.Anything, path)
+			}
+			ws.AssertNumberOfCalls(t, "FetchAllRemotes", len(tc.expectFetch))
+			ws.AssertNotCalled(t, "FetchTagsOnly", mock.Anything, mock.Anything, mock.Anything)
+			if tc.expectOut != "" {
+				assert.Equal(t, tc.expectOut, out.String())
+			}
+			if tc.expectErr != "" {
+				assert.Contains(t, errOut.String(), tc.expectErr)
+			}
+		})
+	}
+}
+
+func TestFetchInternalCommands(t *testing.T) {
+	ws := mocks.NewMockWorktreeService()
+	cs := mocks.NewMockContextService()
+	ps := mocks.NewMockProjectService()
+	cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+	ps.On("DiscoverProject", mock.Anything, "proj", mock.Anything).
+		Return(&domain.ProjectInfo{Name: "proj", GitRepoPath: "/repos/proj"}, nil)
+	ws.On("FetchTagsOnly", mock.Anything, "/repos/proj", "upstream").Return(nil).Once()
+	ws.On("PruneTags", mock.Anything, "/repos/proj").Return(nil).Once()
+
+	config := &CommandConfig{
+		Config:   domain.DefaultConfig(),
+		Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps, NetworkChecker: mocks.NewMockNetworkChecker()},
+	}
+	root := NewRootCommand(config)
+	root.SetOut(&bytes.Buffer{})
+
+	root.SetArgs([]string{"__fetch-tags", "proj", "upstream"})
+	require.NoError(t, root.Execute())
+	root.SetArgs([]string{"__prune-tags", "proj"})
+	require.NoError(t, root.Execute())
+
+	ws.AssertExpectations(t)
+	fetchTags, _, err := root.Find([]string{"__fetch-tags"})
+	require.NoError(t, err)
+	assert.True(t, fetchTags.Hidden)
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"twiggit/internal/domain"
+)
+
+// FileOutputCollector writes the command output of each worktree to its own log file
+type FileOutputCollector struct {
+	projectName string
+	branches    map[string]string // worktree path -> branch
+}
+
+// NewFileOutputCollector creates a collector naming log files after projectName and the worktrees' branches
+func NewFileOutputCollector(projectName string, worktrees []*domain.WorktreeInfo) *FileOutputCollector {
+	branches := make(map[string]string, len(worktrees))
+	for _, wt := range worktrees {
+		if wt.Branch != "" && !wt.IsDetached {
+			branches[wt.Path] = wt.Branch
+		}
+	}
+	return &FileOutputCollector{projectName: projectName, branches: branches}
+}
+
+// LogPath returns the log file of a worktree: <outputDir>/<project>-<branch>.log, with the
+// slashes of the branch replaced by hyphens. Detached worktrees use their directory name.
+func (c *FileOutputCollector) LogPath(worktreePath, outputDir string) string {
+	name, ok := c.branches[worktreePath]
+	if !ok {
+		name = filepath.Base(worktreePath)
+	}
+	name = strings.ReplaceAll(name, "/", "-")
+	return filepath.Join(outputDir, c.projectName+"-"+name+".log")
+}
+
+// Collect creates (or truncates) the log file of worktreePath in outputDir and returns a
+// buffered writer for it. The returned function flushes and closes the file; it also
+// reports a failure to create the file, in which case the writer discards everything.
+func (c *FileOutputCollector) Collect(worktreePath, outputDir string) (io.Writer, func() error) {
+	file, err := os.Create(c.LogPath(worktreePath, outputDir))
+	if err != nil {
+		return io.Discard, func() error { return err }
+	}
+
+	w := bufio.NewWriter(file)
+	return w, func() error {
+		return errors.Join(w.Flush(), file.Close())
+	}
+}
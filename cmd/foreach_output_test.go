@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+func TestFileOutputCollector_LogPath(t *testing.T) {
+	collector := NewFileOutputCollector("proj", []*domain.WorktreeInfo{
+		{Path: "/wt/proj/feature-x", Branch: "feature/x"},
+		{Path: "/wt/proj/spike", Branch: "spike", IsDetached: true},
+	})
+
+	assert.Equal(t, filepath.Join("/out", "proj-feature-x.log"), collector.LogPath("/wt/proj/feature-x", "/out"))
+	assert.Equal(t, filepath.Join("/out", "proj-spike.log"), collector.LogPath("/wt/proj/spike", "/out"))
+	assert.Equal(t, filepath.Join("/out", "proj-other.log"), collector.LogPath("/elsewhere/other", "/out"))
+}
+
+func TestFileOutputCollector_Collect(t *testing.T) {
+	dir := t.TempDir()
+	collector := NewFileOutputCollector("proj", []*domain.WorktreeInfo{{Path: "/wt/proj/main", Branch: "main"}})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "proj-main.log"), []byte("previous run\n"), 0644))
+
+	w, closeLog := collector.Collect("/wt/proj/main", dir)
+	_, err := fmt.Fprint(w, "ok\n")
+	require.NoError(t, err)
+	require.NoError(t, closeLog())
+
+	content, err := os.ReadFile(filepath.Join(dir, "proj-main.log"))
+	require.NoError(t, err)
+	assert.Equal(t, "ok\n", string(content))
+}
+
+func TestFileOutputCollector_CollectMissingDirectory(t *testing.T) {
+	collector := NewFileOutputCollector("proj", nil)
+
+	w, closeLog := collector.Collect("/wt/proj/main", filepath.Join(t.TempDir(), "missing"))
+	_, err := fmt.Fprint(w, "lost")
+	require.NoError(t, err)
+	require.Error(t, closeLog())
+}
+
+func TestFileOutputCollector_ConcurrentWrites(t *testing.T) {
+	dir := t.TempDir()
+	worktrees := make([]*domain.WorktreeInfo, 8)
+	for i := range worktrees {
+		worktrees[i] = &domain.WorktreeInfo{Path: fmt.Sprintf("/wt/proj/b%d", i), Branch: fmt.Sprintf("b%d", i)}
+	}
+	collector := NewFileOutputCollector("proj", worktrees)
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(worktrees))
+	for i, wt := range worktrees {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w, closeLog := collector.Collect(wt.Path, dir)
+			for line := range 1000 {
+				_, _ = fmt.Fprintf(w, "%s line %d\n", wt.Branch, line)
+			}
+			errs[i] = closeLog()
+		}()
+	}
+	wg.Wait()
+
+	for i, wt := range worktrees {
+		require.NoError(t, errs[i])
+		content, err := os.ReadFile(collector.LogPath(wt.Path, dir))
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+		require.Len(t, lines, 1000)
+		for n, line := range lines {
+			assert.Equal(t, fmt.Sprintf("%s line %d", wt.Branch, n), line)
+		}
+	}
+}
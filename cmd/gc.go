@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+type gcOptions struct {
+	all     bool
+	analyze bool
+}
+
+// NewGCCommand creates a new gc command
+func NewGCCommand(config *CommandConfig) *cobra.Command {
+	var opts gcOptions
+
+	cmd := &cobra.Command{
+		Use:   "gc [project]",
+		Short: "Run git garbage collection on projects",
+		Long: `Run 'git gc' on the current project, a named project or all projects.
+
+With --analyze nothing is collected. Instead, object statistics from
+'git count-objects' are shown for each project, largest first, with an
+estimate of the space 'git gc' would free. Projects that cannot be
+analyzed are marked and do not cause a failure.
+
+Examples:
+  twiggit gc                       Collect garbage in the current project
+  twiggit gc myproject             Collect garbage in a specific project
+  twiggit gc --all                 Collect garbage in all projects
+  twiggit gc --all --analyze       Show which projects would benefit most`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			}
+			return executeGC(cmd, config, projectName, opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.all, "all", "a", false, "Run on all projects")
+	cmd.Flags().BoolVar(&opts.analyze, "analyze", false, "Report object statistics instead of collecting garbage")
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionProjects(config),
+	)
+
+	return cmd
+}
+
+// executeGC executes the gc command with the given configuration
+func executeGC(cmd *cobra.Command, config *CommandConfig, projectName string, opts gcOptions) error {
+	ctx := context.Background()
+
+	if opts.all && projectName != "" {
+		return domain.NewValidationError("gc", "project", projectName, "cannot combine a project with --all")
+	}
+
+	projects, err := resolveGCProjects(ctx, config, projectName, opts.all)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if opts.analyze {
+		displayObjectStats(out, config.Services.ProjectService.AnalyzeObjectStats(ctx, projects))
+		return nil
+	}
+
+	reporter := NewProgressReporter(isQuiet(cmd), cmd.ErrOrStderr())
+	for i, project := range projects {
+		if len(projects) > 1 {
+			reporter.ReportProgress(i+1, len(projects), project.Name)
+		}
+		if err := config.Services.ProjectService.GarbageCollect(ctx, project); err != nil {
+			return fmt.Errorf("gc failed for %s: %w", project.Name, err)
+		}
+		_, _ = fmt.Fprintf(out, "Collected garbage in %s\n", project.Name)
+	}
+	return nil
+}
+
+// resolveGCProjects returns every project with all, otherwise the named or current project
+func resolveGCProjects(ctx context.Context, config *CommandConfig, projectName string, all bool) ([]*domain.ProjectSummary, error) {
+	if all {
+		summaries, err := config.Services.ProjectService.ListProjectSummaries(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list projects: %w", err)
+		}
+		return summaries, nil
+	}
+
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil {
+		return nil, fmt.Errorf("context detection failed: %w", err)
+	}
+
+	project, err := config.Services.ProjectService.DiscoverProject(ctx, projectName, currentCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover project: %w", err)
+	}
+	return []*domain.ProjectSummary{{Name: project.Name, Path: project.Path, GitRepoPath: project.GitRepoPath}}, nil
+}
+
+// displayObjectStats renders analyzed projects as a table; failed projects are marked with "!"
+func displayObjectStats(out io.Writer, results []domain.ProjectObjectStats) {
+	if len(results) == 0 {
+		_, _ = fmt.Fprintln(out, "No projects found")
+		return
+	}
+
+	var failed []domain.ProjectObjectStats
+	var totalSavings int64
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "PROJECT\tPACKED\tGARBAGE\tLOOSE\tEST. FREED")
+	for _, result := range results {
+		if result.Stats == nil {
+			failed = append(failed, result)
+			_, _ = fmt.Fprintf(tw, "%s\t!\t!\t!\t!\n", result.ProjectName)
+			continue
+		}
+		stats := result.Stats
+		totalSavings += stats.EstimatedSavings()
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s (%d)\t%s\n", result.ProjectName,
+			formatBytes(stats.SizePack), formatBytes(stats.SizeGarbage),
+			formatBytes(stats.Size), stats.Count, formatBytes(stats.EstimatedSavings()))
+	}
+	_ = tw.Flush()
+
+	_, _ = fmt.Fprintf(out, "\nEstimated space freed by git gc: %s\n", formatBytes(totalSavings))
+
+	if len(failed) > 0 {
+		_, _ = fmt.Fprintln(out, "\n! Could not analyze:")
+		for _, result := range failed {
+			_, _ = fmt.Fprintf(out, "  %s: %v\n", result.ProjectName, result.Err)
+		}
+	}
+}
+
+// formatBytes renders a byte count with binary units, e.g. "1.5 MiB"
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
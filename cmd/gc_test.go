@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestGCCommand_Execute(t *testing.T) {
+	current := &domain.ProjectInfo{Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj"}
+	currentSummary := []*domain.ProjectSummary{{Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj"}}
+	allSummaries := []*domain.ProjectSummary{
+		{Name: "proj", GitRepoPath: "/repos/proj"},
+		{Name: "big", GitRepoPath: "/repos/big"},
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		setupMocks  func(ps *mocks.MockProjectService)
+		expectError string
+		expectOut   []string
+	}{
+		{
+			name: "collects garbage in current project",
+			args: []string{},
+			setupMocks: func(ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "", mock.Anything).Return(current, nil)
+				ps.On("GarbageCollect", mock.Anything, currentSummary[0]).Return(nil)
+			},
+			expectOut: []string{"Collected garbage in proj"},
+		},
+		{
+			name: "gc failure",
+			args: []string{},
+			setupMocks: func(ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "", mock.Anything).Return(current, nil)
+				ps.On("GarbageCollect", mock.Anything, currentSummary[0]).Return(errors.New("gc.pid exists"))
+			},
+			expectError: "gc failed for proj",
+		},
+		{
+			name: "analyze all projects",
+			args: []string{"--all", "--analyze"},
+			setupMocks: func(ps *mocks.MockProjectService) {
+				ps.On("ListProjectSummaries", mock.Anything).Return(allSummaries, nil)
+				ps.On("AnalyzeObjectStats", mock.Anything, allSummaries).Return([]domain.ProjectObjectStats{
+					{ProjectName: "big", Stats: &domain.ObjectStats{SizePack: 3 * 1024 * 1024, SizeGarbage: 2048}},
+					{ProjectName: "proj", Stats: &domain.ObjectStats{SizePack: 512}},
+					{ProjectName: "broken", Err: errors.New("not a git repository")},
+				})
+			},
+			expectOut: []string{
+				"PROJECT", "EST. FREED",
+				"big", "3.0 MiB", "2.0 KiB",
+				"Estimated space freed by git gc: 2.0 KiB",
+				"broken   !", "Could not analyze:", "broken: not a git repository",
+			},
+		},
+		{
+			name:        "project with --all",
+			args:        []string{"proj", "--all"},
+			setupMocks:  func(_ *mocks.MockProjectService) {},
+			expectError: "cannot combine a project with --all",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+			tc.setupMocks(ps)
+
+			config := &CommandConfig{Services: &ServiceContainer{ContextService: cs, ProjectService: ps}}
+			cmd := NewGCCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+		})
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	testCases := []struct {
+		input    int64
+		expected string
+	}{
+		{input: 0, expected: "0 B"},
+		{input: 1023, expected: "1023 B"},
+		{input: 1536, expected: "1.5 KiB"},
+		{input: 5 * 1024 * 1024 * 1024, expected: "5.0 GiB"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			assert.Equal(t, tc.expected, formatBytes(tc.input))
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"encoding/xml"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"twiggit/internal/domain"
+)
+
+// junitSuitesName names the report in CI test views
+const junitSuitesName = "twiggit worktrees health-check"
+
+// junitTimestampLayout is the ISO 8601 form JUnit timestamps use, without a time zone
+const junitTimestampLayout = "2006-01-02T15:04:05"
+
+// JUnitFormatter renders a health report as JUnit XML: a test suite per project and a
+// test case per worktree, failed when the worktree has issues
+type JUnitFormatter struct {
+	Timestamp time.Time // When the checks ran (zero omits it)
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// Format returns the XML document, with its declaration
+func (f *JUnitFormatter) Format(report *domain.HealthReport) []byte {
+	suites := junitTestSuites{Name: junitSuitesName}
+	index := map[string]int{}
+	for _, health := range report.Worktrees {
+		project := health.ProjectName
+		i, ok := index[project]
+		if !ok {
+			i = len(suites.Suites)
+			index[project] = i
+			suite := junitTestSuite{Name: project}
+			if !f.Timestamp.IsZero() {
+				suite.Timestamp = f.Timestamp.UTC().Format(junitTimestampLayout)
+			}
+			suites.Suites = append(suites.Suites, suite)
+		}
+		suite := &suites.Suites[i]
+
+		testCase := junitTestCase{Name: healthWorktreeName(health), ClassName: project, SystemOut: health.WorktreePath}
+		if !health.Healthy() {
+			issues := make([]string, 0, len(health.Issues))
+			for _, issue := range health.Issues {
+				issues = append(issues, issue.Issue)
+			}
+			testCase.Failure = &junitFailure{
+				Message: fmt.Sprintf("%d issue(s)", len(health.Issues)),
+				Type:    "unhealthy",
+				Text:    strings.Join(issues, "\n"),
+			}
+			suite.Failures++
+			suites.Failures++
+		}
+		suite.Cases = append(suite.Cases, testCase)
+		suite.Tests++
+		suites.Tests++
+	}
+
+	// Marshalling these types cannot fail
+	body, _ := xml.MarshalIndent(suites, "", "  ")
+	return append([]byte(xml.Header), append(body, '\n')...)
+}
+
+// healthWorktreeName names a checked worktree by its branch, or its directory when unknown
+func healthWorktreeName(health *domain.WorktreeHealth) string {
+	if health.Branch != "" {
+		return health.Branch
+	}
+	return filepath.Base(health.WorktreePath)
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+func newTestHealthReport() *domain.HealthReport {
+	report := &domain.HealthReport{}
+	report.Add(&domain.WorktreeHealth{ProjectName: "api", Branch: "feature", WorktreePath: "/wt/api/feature"})
+	report.Add(&domain.WorktreeHealth{
+		ProjectName:  "api",
+		WorktreePath: "/wt/api/gone",
+		Issues: []domain.HealthIssue{
+			{WorktreePath: "/wt/api/gone", Issue: "directory is missing"},
+			{WorktreePath: "/wt/api/gone", Issue: "a <b> & \"c\""},
+		},
+	})
+	report.Add(&domain.WorktreeHealth{ProjectName: "web", Branch: "fix", WorktreePath: "/wt/web/fix"})
+	return report
+}
+
+func TestJUnitFormatter_Format(t *testing.T) {
+	formatter := &JUnitFormatter{Timestamp: time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)}
+	data := formatter.Format(newTestHealthReport())
+
+	assert.True(t, strings.HasPrefix(string(data), xml.Header), "document starts with the XML declaration")
+
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(data, &suites))
+	assert.Equal(t, junitSuitesName, suites.Name)
+	assert.Equal(t, 3, suites.Tests)
+	assert.Equal(t, 1, suites.Failures)
+	assert.Equal(t, 0, suites.Errors)
+
+	require.Len(t, suites.Suites, 2)
+	api, web := suites.Suites[0], suites.Suites[1]
+	assert.Equal(t, "api", api.Name)
+	assert.Equal(t, 2, api.Tests)
+	assert.Equal(t, 1, api.Failures)
+	assert.Equal(t, "2026-03-04T05:06:07", api.Timestamp)
+	assert.Equal(t, "web", web.Name)
+	assert.Equal(t, 1, web.Tests)
+	assert.Equal(t, 0, web.Failures)
+
+	require.Len(t, api.Cases, 2)
+	assert.Equal(t, "feature", api.Cases[0].Name)
+	assert.Equal(t, "api", api.Cases[0].ClassName)
+	assert.Nil(t, api.Cases[0].Failure)
+	assert.Equal(t, "/wt/api/feature", api.Cases[0].SystemOut)
+
+	gone := api.Cases[1]
+	assert.Equal(t, "gone", gone.Name, "worktrees without a branch are named by their directory")
+	require.NotNil(t, gone.Failure)
+	assert.Equal(t, "2 issue(s)", gone.Failure.Message)
+	assert.Equal(t, "unhealthy", gone.Failure.Type)
+	assert.Equal(t, "directory is missing\na <b> & \"c\"", gone.Failure.Text, "issues are escaped and round-trip")
+}
+
+func TestJUnitFormatter_Format_Schema(t *testing.T) {
+	data := (&JUnitFormatter{}).Format(newTestHealthReport())
+
+	// Walk the document: the JUnit schema allows testsuites > testsuite > testcase,
+	// with failure and system-out inside a testcase
+	allowed := map[string][]string{
+		"":           {"testsuites"},
+		"testsuites": {"testsuite"},
+		"testsuite":  {"testcase"},
+		"testcase":   {"failure", "system-out"},
+		"failure":    {},
+		"system-out": {},
+	}
+	required := map[string][]string{
+		"testsuites": {"tests", "failures"},
+		"testsuite":  {"name", "tests", "failures", "errors"},
+		"testcase":   {"name", "classname"},
+		"failure":    {"message", "type"},
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(string(data)))
+	var stack []string
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		switch el := token.(type) {
+		case xml.StartElement:
+			parent := ""
+			if len(stack) > 0 {
+				parent = stack[len(stack)-1]
+			}
+			assert.Contains(t, allowed[parent], el.Name.Local, "<%s> inside <%s>", el.Name.Local, parent)
+			attrs := map[string]bool{}
+			for _, attr := range el.Attr {
+				attrs[attr.Name.Local] = true
+			}
+			for _, name := range required[el.Name.Local] {
+				assert.True(t, attrs[name], "<%s> has the %s attribute", el.Name.Local, name)
+			}
+			if el.Name.Local == "testsuite" {
+				assert.False(t, attrs["timestamp"], "zero timestamp is omitted")
+			}
+			stack = append(stack, el.Name.Local)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+	assert.Empty(t, stack, "every element is closed")
+}
+
+func TestJUnitFormatter_Format_Empty(t *testing.T) {
+	data := (&JUnitFormatter{}).Format(&domain.HealthReport{})
+
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(data, &suites))
+	assert.Equal(t, 0, suites.Tests)
+	assert.Empty(t, suites.Suites)
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// NewHistoryCommand creates the history command
+func NewHistoryCommand(config *CommandConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "history",
+		Short: "Show recently visited worktrees",
+		Long: `Print the worktrees visited with cd, switch and back, newest first.
+
+The history keeps the last navigation.history_size worktrees (10 by
+default) and is shared by every shell, in
+$XDG_STATE_HOME/twiggit/history.json. 'twiggit back' returns to the
+second entry.
+
+Examples:
+  twiggit history        List visited worktrees
+  twiggit history | fzf  Pick one with a fuzzy finder`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			for _, path := range config.Services.NavigationService.GetHistory() {
+				_, _ = fmt.Fprintln(cmd.OutOrStdout(), path)
+			}
+			return nil
+		},
+	}
+}
+
+// recordVisit adds a worktree navigated to to the history. Failing to save the history never
+// fails the navigation; it is only reported with -v.
+func recordVisit(cmd *cobra.Command, config *CommandConfig, path string) {
+	if err := config.Services.NavigationService.RecordVisit(path); err != nil {
+		logv(cmd, 1, "Not recorded in history: %v", err)
+	}
+}
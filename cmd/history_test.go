@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/test/mocks"
+)
+
+func TestHistoryCommand(t *testing.T) {
+	ns := mocks.NewMockNavigationService()
+	ns.On("GetHistory").Return([]string{"/wt/web/main", "/wt/api/feature"})
+
+	cmd := NewHistoryCommand(&CommandConfig{Services: &ServiceContainer{NavigationService: ns}})
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{})
+
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "/wt/web/main\n/wt/api/feature\n", out.String())
+}
+
+func TestBackCommand(t *testing.T) {
+	testCases := []struct {
+		name        string
+		history     []string
+		validateErr error
+		expectError string
+		expectPath  string
+	}{
+		{
+			name:       "returns to the previous worktree",
+			history:    []string{"/wt/web/main", "/wt/api/feature", "/wt/api/main"},
+			expectPath: "/wt/api/feature",
+		},
+		{
+			name:        "empty history",
+			expectError: "no previous worktree in history",
+		},
+		{
+			name:        "only the current worktree",
+			history:     []string{"/wt/web/main"},
+			expectError: "no previous worktree in history",
+		},
+		{
+			name:        "previous worktree was deleted",
+			history:     []string{"/wt/web/main", "/wt/api/gone"},
+			validateErr: errors.New("path does not exist"),
+			expectError: "previous worktree no longer exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ns := mocks.NewMockNavigationService()
+			ns.On("GetHistory").Return(tc.history)
+			ns.On("ValidatePath", mock.Anything, mock.Anything).Return(tc.validateErr).Maybe()
+			ns.On("RecordVisit", mock.Anything).Return(nil).Maybe()
+
+			cmd := NewBackCommand(&CommandConfig{Services: &ServiceContainer{NavigationService: ns}})
+			cmd.SilenceUsage = true
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetArgs([]string{})
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				assert.Empty(t, out.String())
+				ns.AssertNotCalled(t, "RecordVisit", mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectPath+"\n", out.String())
+			ns.AssertCalled(t, "RecordVisit", tc.expectPath)
+		})
+	}
+}
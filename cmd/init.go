@@ -35,7 +35,9 @@ Examples:
   eval "$(twiggit init)"                  # Add to your shell config for instant activation
   twiggit init bash                       # Print bash wrapper to stdout
   twiggit init --install                  # Install to auto-detected config file
-  twiggit init bash --install -c ~/.bashrc  # Install to specific config file`,
+  twiggit init bash --install -c ~/.bashrc  # Install to specific config file
+
+First-time setup of directories and config: twiggit init workspace`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Validate flag combinations
@@ -63,6 +65,8 @@ Examples:
 	cmd.Flags().StringVarP(&configFile, "config", "c", "", "custom config file path (requires --install)")
 	cmd.Flags().BoolVarP(&force, "force", "f", false, "force reinstall even if already installed (requires --install)")
 
+	cmd.AddCommand(newInitWorkspaceCmd(config))
+
 	// Shell completion for positional [shell] argument
 	carapace.Gen(cmd).PositionalCompletion(
 		carapace.ActionValues("bash", "zsh", "fish"),
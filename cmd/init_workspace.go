@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// newInitWorkspaceCmd creates the init workspace subcommand
+func newInitWorkspaceCmd(config *CommandConfig) *cobra.Command {
+	var projectsDir string
+
+	cmd := &cobra.Command{
+		Use:   "workspace [workspace-dir]",
+		Short: "Create the workspace directories and a starter config",
+		Long: `Set up twiggit for first use.
+
+Creates the workspace (worktrees) directory and the projects directory,
+then writes a starter config.toml to the XDG config path with both
+paths pre-filled. Prompts for the workspace path when none is given.
+
+If a configuration file already exists, its location is printed and
+nothing is changed.
+
+Examples:
+  twiggit init workspace                      # Prompt for the workspace path
+  twiggit init workspace ~/Worktrees          # Use ~/Worktrees for worktrees
+  twiggit init workspace ~/wt --projects-dir ~/src`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var workspaceDir string
+			if len(args) > 0 {
+				workspaceDir = args[0]
+			}
+			return executeInitWorkspace(cmd, config, workspaceDir, projectsDir)
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectsDir, "projects-dir", "p", "", "directory containing project repositories")
+
+	return cmd
+}
+
+func executeInitWorkspace(cmd *cobra.Command, config *CommandConfig, workspaceDir, projectsDir string) error {
+	initializer := config.Services.Initializer
+	out := cmd.OutOrStdout()
+
+	// Check before prompting: an existing config leaves nothing to set up
+	if _, err := os.Stat(initializer.ConfigPath()); err == nil {
+		printAlreadyInitialized(out, initializer.ConfigPath())
+		return nil
+	}
+
+	// CI runs cannot answer the prompt, so they get the default
+	if workspaceDir == "" && allowsPrompts(config) {
+		prompted, err := promptWorkspaceDir(cmd, config.Config.WorktreesDirectory)
+		if err != nil {
+			return err
+		}
+		workspaceDir = prompted
+	} else if workspaceDir == "" {
+		workspaceDir = config.Config.WorktreesDirectory
+	}
+
+	if projectsDir == "" {
+		projectsDir = config.Config.ProjectsDirectory
+	}
+
+	opts := domain.InitOptions{
+		WorkspaceDir: workspaceDir,
+		ProjectsDir:  projectsDir,
+	}
+
+	logv(cmd, 1, "Initializing workspace")
+	logv(cmd, 2, "  workspace: %s", workspaceDir)
+	logv(cmd, 2, "  projects: %s", projectsDir)
+
+	err := initializer.Initialize(context.Background(), opts)
+	var initErr *domain.AlreadyInitializedError
+	if errors.As(err, &initErr) {
+		printAlreadyInitialized(out, initErr.ConfigPath)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("workspace initialization failed: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(out, "Workspace initialized\n")
+	_, _ = fmt.Fprintf(out, "Config file: %s\n", initializer.ConfigPath())
+	_, _ = fmt.Fprintf(out, "\nNext steps:\n")
+	_, _ = fmt.Fprintf(out, "  1. Add the shell wrapper to your rc file:\n")
+	_, _ = fmt.Fprintf(out, "       eval \"$(twiggit init)\"\n")
+	_, _ = fmt.Fprintf(out, "     or install it directly with: twiggit init --install\n")
+	_, _ = fmt.Fprintf(out, "  2. Clone or move repositories into your projects directory\n")
+	_, _ = fmt.Fprintf(out, "  3. Create a worktree: twiggit create <project>/<branch>\n")
+
+	return nil
+}
+
+// printAlreadyInitialized reports the existing configuration file
+func printAlreadyInitialized(out io.Writer, configPath string) {
+	_, _ = fmt.Fprintf(out, "twiggit is already initialized\n")
+	_, _ = fmt.Fprintf(out, "Config file: %s\n", configPath)
+}
+
+// promptWorkspaceDir asks for the workspace path, returning defaultDir on empty input or end of input
+func promptWorkspaceDir(cmd *cobra.Command, defaultDir string) (string, error) {
+	_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Workspace directory [%s]: ", defaultDir)
+	reader := bufio.NewReader(cmd.InOrStdin())
+	response, err := reader.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read workspace directory: %w", err)
+	}
+
+	response = strings.TrimSpace(response)
+	if response == "" {
+		return defaultDir, nil
+	}
+	return response, nil
+}
@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func newInitWorkspaceTestConfig(initializer *mocks.MockInitializer) *CommandConfig {
+	return &CommandConfig{
+		Services: &ServiceContainer{
+			Initializer: initializer,
+		},
+		Config: &domain.Config{
+			ProjectsDirectory:  "/home/user/Projects",
+			WorktreesDirectory: "/home/user/Worktrees",
+		},
+		Terminal: mocks.NewInteractiveTerminalDetector(),
+	}
+}
+
+func TestInitWorkspaceCmd_WithArgument(t *testing.T) {
+	initializer := mocks.NewMockInitializer()
+	initializer.On("Initialize", context.Background(), domain.InitOptions{
+		WorkspaceDir: "/tmp/ws",
+		ProjectsDir:  "/home/user/Projects",
+	}).Return(nil)
+	initializer.On("ConfigPath").Return("/home/user/.config/twiggit/config.toml")
+
+	cmd := NewInitCmd(newInitWorkspaceTestConfig(initializer))
+	buf := &bytes.Buffer{}
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetArgs([]string{"workspace", "/tmp/ws"})
+
+	require.NoError(t, cmd.Execute())
+
+	output := buf.String()
+	assert.Contains(t, output, "Workspace initialized")
+	assert.Contains(t, output, "/home/user/.config/twiggit/config.toml")
+	assert.Contains(t, output, `eval "$(twiggit init)"`)
+	initializer.AssertExpectations(t)
+}
+
+func TestInitWorkspaceCmd_PromptsForWorkspace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "entered path", input: "/tmp/entered\n", expected: "/tmp/entered"},
+		{name: "empty input uses default", input: "\n", expected: "/home/user/Worktrees"},
+		{name: "end of input uses default", input: "", expected: "/home/user/Worktrees"},
+		{name: "last line without newline", input: "/tmp/entered", expected: "/tmp/entered"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			initializer := mocks.NewMockInitializer()
+			initializer.On("Initialize", context.Background(), domain.InitOptions{
+				WorkspaceDir: tc.expected,
+				ProjectsDir:  "/custom/projects",
+			}).Return(nil)
+			initializer.On("ConfigPath").Return("/cfg/config.toml")
+
+			cmd := NewInitCmd(newInitWorkspaceTestConfig(initializer))
+			buf := &bytes.Buffer{}
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetIn(strings.NewReader(tc.input))
+			cmd.SetArgs([]string{"workspace", "--projects-dir", "/custom/projects"})
+
+			require.NoError(t, cmd.Execute())
+			assert.Contains(t, buf.String(), "Workspace directory [/home/user/Worktrees]")
+			initializer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestInitWorkspaceCmd_NoPromptInCI(t *testing.T) {
+	initializer := mocks.NewMockInitializer()
+	initializer.On("Initialize", context.Background(), domain.InitOptions{
+		WorkspaceDir: "/home/user/Worktrees",
+		ProjectsDir:  "/home/user/Projects",
+	}).Return(nil)
+	initializer.On("ConfigPath").Return("/cfg/config.toml")
+
+	config := newInitWorkspaceTestConfig(initializer)
+	config.Terminal = mocks.NewCITerminalDetector()
+	cmd := NewInitCmd(config)
+	buf := &bytes.Buffer{}
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetIn(strings.NewReader("/tmp/ignored\n"))
+	cmd.SetArgs([]string{"workspace"})
+
+	require.NoError(t, cmd.Execute())
+	assert.NotContains(t, buf.String(), "Workspace directory [")
+	initializer.AssertExpectations(t)
+}
+
+func TestInitWorkspaceCmd_AlreadyInitialized(t *testing.T) {
+	initializer := mocks.NewMockInitializer()
+	initializer.On("Initialize", context.Background(), domain.InitOptions{
+		WorkspaceDir: "/tmp/ws",
+		ProjectsDir:  "/home/user/Projects",
+	}).Return(domain.NewAlreadyInitializedError("/cfg/config.toml"))
+	initializer.On("ConfigPath").Return("/cfg/missing.toml")
+
+	cmd := NewInitCmd(newInitWorkspaceTestConfig(initializer))
+	buf := &bytes.Buffer{}
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{"workspace", "/tmp/ws"})
+
+	require.NoError(t, cmd.Execute())
+	assert.Contains(t, buf.String(), "already initialized")
+	assert.Contains(t, buf.String(), "/cfg/config.toml")
+}
+
+func TestInitWorkspaceCmd_ExistingConfigSkipsPrompt(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(configPath, []byte("projects_dir = \"/src\"\n"), 0600))
+	initializer := mocks.NewMockInitializer()
+	initializer.On("ConfigPath").Return(configPath)
+
+	cmd := NewInitCmd(newInitWorkspaceTestConfig(initializer))
+	buf := &bytes.Buffer{}
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetIn(strings.NewReader("/tmp/ignored\n"))
+	cmd.SetArgs([]string{"workspace"})
+
+	require.NoError(t, cmd.Execute())
+	assert.NotContains(t, buf.String(), "Workspace directory [")
+	assert.Contains(t, buf.String(), "already initialized")
+	assert.Contains(t, buf.String(), configPath)
+	initializer.AssertNotCalled(t, "Initialize", mock.Anything, mock.Anything)
+}
+
+func TestInitWorkspaceCmd_InitializeError(t *testing.T) {
+	initializer := mocks.NewMockInitializer()
+	initializer.On("Initialize", context.Background(), domain.InitOptions{
+		WorkspaceDir: "/tmp/ws",
+		ProjectsDir:  "/home/user/Projects",
+	}).Return(errors.New("permission denied"))
+	initializer.On("ConfigPath").Return("/cfg/missing.toml")
+
+	cmd := NewInitCmd(newInitWorkspaceTestConfig(initializer))
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"workspace", "/tmp/ws"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "workspace initialization failed")
+}
+
+func TestInitCmd_ShellArgStillRoutesToWrapper(t *testing.T) {
+	cmd := NewInitCmd(newInitWorkspaceTestConfig(mocks.NewMockInitializer()))
+
+	found, args, err := cmd.Find([]string{"bash"})
+	require.NoError(t, err)
+	assert.Equal(t, cmd, found)
+	assert.Equal(t, []string{"bash"}, args)
+}
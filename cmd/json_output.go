@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// Output formats of the root --output flag
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// applyOutputFlag validates the root --output flag and records it in config.Output.
+// Commands with their own --output flag (list, project info, worktrees health-check)
+// shadow the root flag, which then keeps its default.
+func applyOutputFlag(cmd *cobra.Command, config *CommandConfig) error {
+	flag := cmd.Root().PersistentFlags().Lookup("output")
+	if flag == nil {
+		return nil
+	}
+	output := flag.Value.String()
+	if output != outputText && output != outputJSON {
+		return domain.NewValidationError("twiggit", "output", output, "must be 'text' or 'json'")
+	}
+	config.Output = output
+	return nil
+}
+
+// isJSONOutput reports whether the root --output flag asked for JSON
+func isJSONOutput(config *CommandConfig) bool {
+	return config.Output == outputJSON
+}
+
+// writeJSON writes v to out as one line of compact JSON
+func writeJSON(out io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON output: %w", err)
+	}
+	_, _ = fmt.Fprintln(out, string(data))
+	return nil
+}
+
+// statusJSON is the schema of `status --output json`
+type statusJSON struct {
+	Project     string             `json:"project"`
+	Path        string             `json:"path"`
+	Branch      string             `json:"branch"`
+	Detached    bool               `json:"detached"`
+	Commit      string             `json:"commit,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Clean       bool               `json:"clean"`
+	Modified    []string           `json:"modified"`
+	Added       []string           `json:"added"`
+	Deleted     []string           `json:"deleted"`
+	Untracked   []string           `json:"untracked"`
+	Conflicts   []conflictJSON     `json:"conflicts"`
+	Links       []linkedBranchJSON `json:"links"`
+}
+
+// conflictJSON represents a file with merge conflicts
+type conflictJSON struct {
+	Path        string `json:"path"`
+	OurStatus   string `json:"our_status"`
+	TheirStatus string `json:"their_status"`
+}
+
+// linkedBranchJSON represents a linked worktree and its commits not yet in the current branch.
+// Error is set, and NewCommits left at 0, when the branches could not be compared.
+type linkedBranchJSON struct {
+	Branch     string `json:"branch"`
+	NewCommits int    `json:"new_commits"`
+	Error      string `json:"error,omitempty"`
+}
+
+// MarshalJSON renders the status with empty lists instead of null, so the schema stays stable
+func (r *StatusResult) MarshalJSON() ([]byte, error) {
+	result := statusJSON{
+		Project:     r.Project,
+		Description: r.Description,
+		Modified:    []string{},
+		Added:       []string{},
+		Deleted:     []string{},
+		Untracked:   []string{},
+		Conflicts:   []conflictJSON{},
+		Links:       []linkedBranchJSON{},
+	}
+	if r.Status != nil {
+		if wt := r.Status.WorktreeInfo; wt != nil {
+			result.Path = wt.Path
+			result.Branch = wt.Branch
+			result.Detached = wt.IsDetached
+			result.Commit = wt.Commit
+		}
+		result.Clean = true
+		if repo := r.Status.RepositoryStatus; repo != nil {
+			result.Clean = repo.IsClean
+			if result.Commit == "" {
+				result.Commit = repo.Commit
+			}
+			result.Modified = append(result.Modified, repo.Modified...)
+			result.Added = append(result.Added, repo.Added...)
+			result.Deleted = append(result.Deleted, repo.Deleted...)
+			result.Untracked = append(result.Untracked, repo.Untracked...)
+		}
+		for _, conflict := range r.Status.ConflictFiles {
+			result.Conflicts = append(result.Conflicts, conflictJSON(conflict))
+		}
+	}
+	for _, link := range r.Links {
+		linked := linkedBranchJSON{Branch: link.Branch, NewCommits: link.NewCommits}
+		if link.Err != nil {
+			linked.Error = link.Err.Error()
+		}
+		result.Links = append(result.Links, linked)
+	}
+	return json.Marshal(result)
+}
+
+// ProjectStatusResult is the summary of one project printed by status --summary
+type ProjectStatusResult struct {
+	Project string
+	Summary *domain.ProjectStatusSummary
+	Err     error // Set when the project could not be summarized (status --all)
+}
+
+// projectStatusJSON is the schema of one project of `status --summary --output json`
+type projectStatusJSON struct {
+	Project     string `json:"project"`
+	Worktrees   int    `json:"worktrees"`
+	Dirty       int    `json:"dirty"`
+	Conflicts   int    `json:"conflicts"`
+	Unavailable int    `json:"unavailable"`
+	Error       string `json:"error,omitempty"`
+}
+
+// MarshalJSON renders the project summary, with an error instead of counts when unavailable
+func (r *ProjectStatusResult) MarshalJSON() ([]byte, error) {
+	result := projectStatusJSON{Project: r.Project}
+	if r.Summary != nil {
+		result.Worktrees = r.Summary.Worktrees
+		result.Dirty = r.Summary.Dirty
+		result.Conflicts = r.Summary.Conflicts
+		result.Unavailable = r.Summary.Errors
+	}
+	if r.Err != nil {
+		result.Error = r.Err.Error()
+	}
+	return json.Marshal(result)
+}
+
+// PruneResult is the outcome of a prune run as reported by `prune --dry-run --output json`
+type PruneResult struct {
+	Result *domain.PruneWorktreesResult
+	DryRun bool
+}
+
+// pruneJSON is the schema of `prune --dry-run --output json`
+type pruneJSON struct {
+	DryRun           bool                 `json:"dry_run"`
+	Deleted          []prunedWorktreeJSON `json:"deleted"`
+	Skipped          []prunedWorktreeJSON `json:"skipped"`
+	ProtectedSkipped []prunedWorktreeJSON `json:"protected_skipped"`
+	UnmergedSkipped  []prunedWorktreeJSON `json:"unmerged_skipped"`
+	CurrentSkipped   []prunedWorktreeJSON `json:"current_skipped"`
+	Totals           pruneTotalsJSON      `json:"totals"`
+}
+
+// prunedWorktreeJSON represents one worktree considered by prune
+type prunedWorktreeJSON struct {
+	Project       string `json:"project"`
+	Branch        string `json:"branch"`
+	Path          string `json:"path"`
+	BranchDeleted bool   `json:"branch_deleted"`
+	SkipReason    string `json:"skip_reason,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+// pruneTotalsJSON holds the counts of the prune summary line
+type pruneTotalsJSON struct {
+	Deleted         int `json:"deleted"`
+	Skipped         int `json:"skipped"`
+	BranchesDeleted int `json:"branches_deleted"`
+}
+
+// MarshalJSON renders every category as a list, empty rather than null
+func (r *PruneResult) MarshalJSON() ([]byte, error) {
+	result := pruneJSON{DryRun: r.DryRun}
+	var res domain.PruneWorktreesResult
+	if r.Result != nil {
+		res = *r.Result
+	}
+	result.Deleted = newPrunedWorktreesJSON(res.DeletedWorktrees)
+	result.Skipped = newPrunedWorktreesJSON(res.SkippedWorktrees)
+	result.ProtectedSkipped = newPrunedWorktreesJSON(res.ProtectedSkipped)
+	result.UnmergedSkipped = newPrunedWorktreesJSON(res.UnmergedSkipped)
+	result.CurrentSkipped = newPrunedWorktreesJSON(res.CurrentWorktreeSkipped)
+	result.Totals = pruneTotalsJSON{Deleted: res.TotalDeleted, Skipped: res.TotalSkipped, BranchesDeleted: res.TotalBranchesDeleted}
+	return json.Marshal(result)
+}
+
+// newPrunedWorktreesJSON converts prune results, returning an empty list for none
+func newPrunedWorktreesJSON(worktrees []*domain.PruneWorktreeResult) []prunedWorktreeJSON {
+	result := make([]prunedWorktreeJSON, 0, len(worktrees))
+	for _, wt := range worktrees {
+		entry := prunedWorktreeJSON{
+			Project:       wt.ProjectName,
+			Branch:        wt.BranchName,
+			Path:          wt.WorktreePath,
+			BranchDeleted: wt.BranchDeleted,
+			SkipReason:    wt.SkipReason,
+		}
+		if wt.Error != nil {
+			entry.Error = wt.Error.Error()
+		}
+		result = append(result, entry)
+	}
+	return result
+}
+
+// StashListResult is the stash of the current project as printed by `stash list`
+type StashListResult struct {
+	Entries []domain.StashEntry
+}
+
+// stashEntryJSON is the schema of one entry of `stash list --output json`
+type stashEntryJSON struct {
+	Index   int    `json:"index"`
+	Ref     string `json:"ref"`
+	Branch  string `json:"branch,omitempty"`
+	Message string `json:"message"`
+}
+
+// MarshalJSON renders the entries as a list, empty rather than null
+func (r *StashListResult) MarshalJSON() ([]byte, error) {
+	entries := make([]stashEntryJSON, 0, len(r.Entries))
+	for _, entry := range r.Entries {
+		entries = append(entries, stashEntryJSON{
+			Index:   entry.Index,
+			Ref:     entry.Ref(),
+			Branch:  entry.Branch(),
+			Message: entry.Subject(),
+		})
+	}
+	return json.Marshal(struct {
+		Entries []stashEntryJSON `json:"entries"`
+	}{entries})
+}
+
+// ProjectListResult is the list of projects printed by `project list`
+type ProjectListResult struct {
+	Projects []*domain.ProjectSummary
+}
+
+// projectSummaryJSON is the schema of one project of `project list --output json`
+type projectSummaryJSON struct {
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	GitRepoPath string `json:"git_repo_path"`
+}
+
+// MarshalJSON renders the projects as a list, empty rather than null
+func (r *ProjectListResult) MarshalJSON() ([]byte, error) {
+	projects := make([]projectSummaryJSON, 0, len(r.Projects))
+	for _, project := range r.Projects {
+		projects = append(projects, projectSummaryJSON{Name: project.Name, Path: project.Path, GitRepoPath: project.GitRepoPath})
+	}
+	return json.Marshal(struct {
+		Projects []projectSummaryJSON `json:"projects"`
+	}{projects})
+}
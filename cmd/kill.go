@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/infrastructure"
+)
+
+// NewKillCommand creates a new kill command
+func NewKillCommand(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "kill <project/branch|branch>",
+		Short: "Stop background processes started for a worktree",
+		Long: `Stop background processes that twiggit started for a worktree,
+such as long-running [hooks.post-create] background commands.
+
+Processes receive SIGTERM and are sent SIGKILL if they are still
+running after 5 seconds.
+
+Examples:
+  twiggit kill myproject/feature   Stop processes of a specific worktree
+  twiggit kill feature             Stop processes of a worktree in the current project`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeKill(cmd, config, args[0])
+		},
+	}
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionWorktreeTarget(config, infrastructure.WithExistingOnly()),
+	)
+
+	return cmd
+}
+
+// executeKill executes the kill command with the given configuration
+func executeKill(cmd *cobra.Command, config *CommandConfig, target string) error {
+	ctx := context.Background()
+
+	_, result, err := resolveNavigationTarget(ctx, config, target)
+	if err != nil {
+		return err
+	}
+
+	logv(cmd, 1, "Stopping processes for %s", result.ResolvedPath)
+
+	killed, err := config.Services.ProcessManager.Kill(ctx, result.ResolvedPath)
+	if err != nil {
+		return fmt.Errorf("failed to stop processes for %s: %w", target, err)
+	}
+
+	out := cmd.OutOrStdout()
+	if len(killed) == 0 {
+		_, _ = fmt.Fprintf(out, "No running processes for %s\n", target)
+		return nil
+	}
+
+	for _, proc := range killed {
+		_, _ = fmt.Fprintf(out, "Stopped %d: %s\n", proc.PID, proc.Command)
+	}
+	return nil
+}
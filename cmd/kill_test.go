@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestKillCommand_Execute(t *testing.T) {
+	testCases := []struct {
+		name        string
+		killed      []domain.ManagedProcess
+		killErr     error
+		expectError string
+		expectOut   string
+	}{
+		{
+			name:      "stops running processes",
+			killed:    []domain.ManagedProcess{{PID: 4242, Command: "npm run dev"}},
+			expectOut: "Stopped 4242: npm run dev",
+		},
+		{
+			name:      "nothing running",
+			killed:    []domain.ManagedProcess{},
+			expectOut: "No running processes for proj/feature",
+		},
+		{
+			name:        "kill failure",
+			killErr:     errors.New("permission denied"),
+			expectError: "failed to stop processes for proj/feature",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := mocks.NewMockContextService()
+			ns := mocks.NewMockNavigationService()
+			pm := mocks.NewMockProcessManager()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+			ns.On("ResolvePath", mock.Anything, mock.MatchedBy(func(req *domain.ResolvePathRequest) bool {
+				return req.Target == "proj/feature"
+			})).Return(&domain.ResolutionResult{ResolvedPath: "/wt/proj/feature", Type: domain.PathTypeWorktree}, nil)
+			pm.On("Kill", mock.Anything, "/wt/proj/feature").Return(tc.killed, tc.killErr)
+
+			config := &CommandConfig{Services: &ServiceContainer{ContextService: cs, NavigationService: ns, ProcessManager: pm}}
+			cmd := NewKillCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs([]string{"proj/feature"})
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, out.String(), tc.expectOut)
+		})
+	}
+}
+
+func TestKillCommand_RequiresTarget(t *testing.T) {
+	cmd := NewKillCommand(&CommandConfig{Services: &ServiceContainer{}})
+	cmd.SetArgs([]string{})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	require.Error(t, cmd.Execute())
+}
@@ -4,15 +4,39 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strconv"
+	"time"
 
+	"github.com/carapace-sh/carapace"
 	"github.com/spf13/cobra"
 	"twiggit/internal/domain"
 )
 
+// listOptions holds the flag values for the list command
+type listOptions struct {
+	all     bool
+	output  string
+	stale   time.Duration
+	groupBy string
+	sort    string
+
+	sinceCommit  string
+	colorByAge   bool
+	descriptions bool
+	mine         bool
+	remote       bool
+	filter       string
+	count        bool
+	withPR       bool
+
+	max      int
+	offset   int
+	noHeader bool
+}
+
 // NewListCommand creates a new list command
 func NewListCommand(config *CommandConfig) *cobra.Command {
-	var all bool
-	var output string
+	var opts listOptions
 
 	cmd := &cobra.Command{
 		Use:     "list",
@@ -24,27 +48,89 @@ By default, lists worktrees for the detected project context.
 Examples:
   twiggit list              List worktrees for current project
   twiggit list -a           List worktrees from all projects
-  twiggit list --output json  Output in JSON format for scripts`,
+  twiggit list --output json  Output in JSON format for scripts
+  twiggit list --stale 336h   Mark worktrees without commits for two weeks
+  twiggit list -a --group-by project  Group worktrees under a header per project
+  twiggit list -a --since-commit v1.2.3  Only worktrees with commits after the v1.2.3 tag
+  twiggit list --color-by-age  Color branch names by how recently they were active
+  twiggit list --descriptions  Show branch descriptions set with 'twiggit describe'
+  twiggit list -a --mine       Only worktrees whose last commit is yours
+  twiggit list --remote        Also show remote branches without a worktree (marked +)
+  twiggit list --remote --filter 'feature/*'  Only branches matching the glob
+  twiggit list -a --mine --count  Print how many worktrees are yours
+  twiggit list --with-pr       Show the pull request of each branch (cached for 5 minutes)
+  twiggit list --sort age      Most recently updated first ('twiggit worktrees sort' sets the default)
+  twiggit list --max 10 --offset 10  The second page of 10 worktrees, after sorting
+  twiggit list --verbose       Also show upstream, ahead/behind, stashes and the last commit`,
 		Args: cobra.NoArgs, // Reject any positional arguments
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			// Validate output format
-			if output != "" && output != "text" && output != "json" {
-				return fmt.Errorf("invalid output format '%s': must be 'text' or 'json'", output)
+			if opts.output != "" && opts.output != "text" && opts.output != "json" {
+				return fmt.Errorf("invalid output format '%s': must be 'text' or 'json'", opts.output)
 			}
-			return executeList(cmd, config, all, output)
+			return executeList(cmd, config, opts)
 		},
 	}
 
-	cmd.Flags().BoolVarP(&all, "all", "a", false, "List worktrees from all projects")
-	cmd.Flags().StringVarP(&output, "output", "o", "text", "Output format (text or json)")
+	cmd.Flags().BoolVarP(&opts.all, "all", "a", false, "List worktrees from all projects")
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "text", "Output format (text or json)")
+	cmd.Flags().DurationVar(&opts.stale, "stale", 0, "Mark worktrees not updated within this duration as stale (e.g. 336h)")
+	cmd.Flags().StringVar(&opts.groupBy, "group-by", "", "Group worktrees by project, status, or age")
+	cmd.Flags().StringVar(&opts.sort, "sort", "", "Sort worktrees by branch, project, path or age (default from default_sort)")
+	cmd.Flags().StringVar(&opts.sinceCommit, "since-commit", "", "Only show worktrees with commits after this ref (tag, branch or commit)")
+	cmd.Flags().BoolVar(&opts.colorByAge, "color-by-age", false, "Color branch names by last activity (green, yellow, red, dim; thresholds in [theme])")
+	cmd.Flags().BoolVar(&opts.descriptions, "descriptions", false, "Show branch descriptions (git branch --edit-description)")
+	cmd.Flags().BoolVar(&opts.remote, "remote", false, "Also show remote branches that have no local worktree (read-only)")
+	cmd.Flags().StringVar(&opts.filter, "filter", "", "Only show branches matching this glob (e.g. 'feature/*')")
+	cmd.Flags().BoolVar(&opts.mine, "mine", false, "Only show worktrees whose last commit author matches git config user.email")
+	cmd.Flags().BoolVar(&opts.count, "count", false, "Print only the number of matching worktrees")
+	cmd.Flags().BoolVar(&opts.withPR, "with-pr", false, "Show each branch's open (or merged) pull request from GitHub/GitLab")
+	cmd.Flags().IntVar(&opts.max, "max", 0, "Show at most this many worktrees (0 shows all)")
+	cmd.Flags().IntVar(&opts.offset, "offset", 0, "Skip this many worktrees before --max, for paging")
+	cmd.Flags().BoolVar(&opts.noHeader, "no-header", false, "Omit the \"(showing n-m of total)\" footer of paged text output")
+
+	carapace.Gen(cmd).FlagCompletion(map[string]carapace.Action{
+		"group-by":     carapace.ActionValues(string(domain.GroupByProject), string(domain.GroupByStatus), string(domain.GroupByAge)),
+		"sort":         carapace.ActionValues(sortModeValues...),
+		"since-commit": actionBranches(config),
+	})
 
 	return cmd
 }
 
 // executeList executes the list command with the given configuration
-func executeList(cmd *cobra.Command, config *CommandConfig, all bool, output string) error {
+func executeList(cmd *cobra.Command, config *CommandConfig, opts listOptions) error {
 	ctx := context.Background()
 
+	groupBy, err := domain.ParseGroupByMode(opts.groupBy)
+	if err != nil {
+		return err
+	}
+	if groupBy != domain.GroupByNone && opts.output == "json" {
+		return domain.NewValidationError("list", "group-by", opts.groupBy, "--group-by is only supported with text output")
+	}
+	if opts.max < 0 {
+		return domain.NewValidationError("list", "max", strconv.Itoa(opts.max), "--max cannot be negative")
+	}
+	if opts.offset < 0 {
+		return domain.NewValidationError("list", "offset", strconv.Itoa(opts.offset), "--offset cannot be negative")
+	}
+	if opts.count {
+		if err := validateListCount(cmd, opts); err != nil {
+			return err
+		}
+	}
+
+	// --sort falls back to the default_sort preference
+	sortValue := opts.sort
+	if !cmd.Flags().Changed("sort") && config.Config != nil {
+		sortValue = config.Config.DefaultSort
+	}
+	sortBy, err := domain.ParseSortMode(sortValue)
+	if err != nil {
+		return err
+	}
+
 	// Detect current context
 	currentCtx, err := config.Services.ContextService.GetCurrentContext()
 	if err != nil {
@@ -54,22 +140,35 @@ func executeList(cmd *cobra.Command, config *CommandConfig, all bool, output str
 	// Build list request
 	req := &domain.ListWorktreesRequest{
 		Context:         currentCtx,
-		IncludeMain:     false, // By default, don't include main worktree
-		ListAllProjects: all,   // Use --all flag to list worktrees from all projects
+		IncludeMain:     false,    // By default, don't include main worktree
+		ListAllProjects: opts.all, // Use --all flag to list worktrees from all projects
+
+		IncludeLastUpdated: !opts.count && (opts.stale > 0 || groupBy == domain.GroupByAge || opts.colorByAge || sortBy == domain.SortByAge),
+		SinceCommit:        opts.sinceCommit,
+
+		IncludeDescriptions: !opts.count && opts.descriptions,
+		OnlyMine:            opts.mine,
+		BranchFilter:        opts.filter,
 	}
 
 	// If not listing all, use project name from context
-	if !all && currentCtx.ProjectName != "" {
+	if !opts.all && currentCtx.ProjectName != "" {
 		req.ProjectName = currentCtx.ProjectName
 	}
 
 	logv(cmd, 1, "Listing worktrees")
-	if all {
+	if opts.all {
 		logv(cmd, 2, "  repository: all projects")
 	} else if currentCtx.ProjectName != "" {
 		logv(cmd, 2, "  project: %s", currentCtx.ProjectName)
 	}
 	logv(cmd, 2, "  including main worktree: %t", req.IncludeMain)
+	if opts.sinceCommit != "" {
+		logv(cmd, 2, "  since commit: %s", opts.sinceCommit)
+	}
+	if opts.mine {
+		logv(cmd, 2, "  only worktrees last committed by you")
+	}
 
 	// List worktrees
 	worktrees, err := config.Services.WorktreeService.ListWorktrees(ctx, req)
@@ -77,12 +176,64 @@ func executeList(cmd *cobra.Command, config *CommandConfig, all bool, output str
 		return fmt.Errorf("failed to list worktrees: %w", err)
 	}
 
+	// --count skips formatting entirely
+	if opts.count {
+		_, _ = fmt.Fprintln(cmd.OutOrStdout(), len(worktrees))
+		return nil
+	}
+
+	if sortBy != domain.SortNone {
+		logv(cmd, 2, "  sorting by: %s", sortBy)
+		domain.SortWorktrees(worktrees, sortBy)
+	}
+
+	// Page after sorting and filtering, so pages are stable slices of one ordered list
+	total := len(worktrees)
+	paged := cmd.Flags().Changed("max") || cmd.Flags().Changed("offset")
+	if paged {
+		worktrees = domain.PageWorktrees(worktrees, opts.offset, opts.max)
+		logv(cmd, 2, "  showing %d of %d worktrees from offset %d", len(worktrees), total, opts.offset)
+	}
+
+	if opts.withPR {
+		logv(cmd, 2, "  looking up pull requests")
+		annotatePullRequests(ctx, cmd, config, currentCtx, worktrees)
+	}
+
+	// --verbose (-v) is the persistent verbosity flag; list also shows more per worktree
+	if verbosity, _ := cmd.Flags().GetCount("verbose"); verbosity > 0 {
+		logv(cmd, 2, "  looking up upstream, stash and last commit details")
+		annotateWorktreeDetails(ctx, cmd, config, worktrees)
+	}
+
+	var remoteBranches []*domain.RemoteWorktreeCandidate
+	if opts.remote {
+		logv(cmd, 2, "  including remote branches without a worktree")
+		remoteBranches, err = config.Services.WorktreeService.ListRemoteCandidates(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to list remote branches: %w", err)
+		}
+	}
+
 	// Select formatter based on output flag
 	var formatter OutputFormatter
-	if output == "json" {
-		formatter = &JSONFormatter{}
+	if opts.output == "json" {
+		formatter = &JSONFormatter{StaleThreshold: opts.stale, RemoteBranches: remoteBranches, Total: total}
 	} else {
-		formatter = &TextFormatter{}
+		footer := ""
+		if paged && !opts.noHeader {
+			footer = pageFooter(opts.offset, len(worktrees), total)
+		}
+		formatter = &TextFormatter{
+			StaleThreshold: opts.stale,
+			SinceCommit:    opts.sinceCommit,
+			GroupBy:        groupBy,
+			Bold:           supportsColor(config, cmd.OutOrStdout()),
+			AgeColorizer:   listAgeColorizer(cmd.OutOrStdout(), config, opts.colorByAge),
+			RemoteBranches: remoteBranches,
+			WithPR:         opts.withPR,
+			Footer:         footer,
+		}
 	}
 
 	// Display results
@@ -93,6 +244,46 @@ func executeList(cmd *cobra.Command, config *CommandConfig, all bool, output str
 	return nil
 }
 
+// validateListCount rejects flags that only change how the list is rendered, since --count renders no list
+func validateListCount(cmd *cobra.Command, opts listOptions) error {
+	switch {
+	case cmd.Flags().Changed("output"):
+		return domain.NewValidationError("list", "count", opts.output, "--count cannot be combined with --output")
+	case opts.groupBy != "":
+		return domain.NewValidationError("list", "count", opts.groupBy, "--count cannot be combined with --group-by")
+	case opts.remote:
+		return domain.NewValidationError("list", "count", "remote", "--count only counts worktrees and cannot be combined with --remote")
+	case opts.withPR:
+		return domain.NewValidationError("list", "count", "with-pr", "--count cannot be combined with --with-pr")
+	case cmd.Flags().Changed("max") || cmd.Flags().Changed("offset"):
+		return domain.NewValidationError("list", "count", "max", "--count always counts every worktree and cannot be combined with --max or --offset")
+	}
+	return nil
+}
+
+// pageFooter describes a page of list output, e.g. "(showing 11-20 of 42)"
+func pageFooter(offset, shown, total int) string {
+	if shown == 0 {
+		return fmt.Sprintf("(showing 0 of %d)", total)
+	}
+	return fmt.Sprintf("(showing %d-%d of %d)", offset+1, offset+shown, total)
+}
+
+// listAgeColorizer returns the colorizer for --color-by-age, unstyled when out does not support color
+func listAgeColorizer(out io.Writer, config *CommandConfig, colorByAge bool) AgeColorizer {
+	if !colorByAge {
+		return nil
+	}
+	if !supportsColor(config, out) {
+		return noopAgeColorizer{}
+	}
+	theme := domain.DefaultConfig().Theme
+	if config.Config != nil {
+		theme = config.Config.Theme
+	}
+	return NewAgeColorizer(theme)
+}
+
 // displayWorktrees displays the worktrees using the specified formatter
 func displayWorktrees(out io.Writer, worktrees []*domain.WorktreeInfo, formatter OutputFormatter) error {
 	formatted := formatter.FormatWorktrees(worktrees)
@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -46,6 +48,246 @@ func TestListCommand_Execute(t *testing.T) {
 				return strings.Contains(output, "main") && strings.Contains(output, "feature")
 			},
 		},
+		{
+			name: "mark stale worktrees with --stale",
+			args: []string{"--stale", "168h"},
+			setupMocks: func(mockWS *mocks.MockWorktreeService, mockCS *mocks.MockContextService) {
+				mockCS.On("GetCurrentContext").Return(&domain.Context{
+					Type:        domain.ContextProject,
+					ProjectName: "test-project",
+				}, nil)
+				mockWS.On("ListWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+					return req.IncludeLastUpdated
+				})).Return([]*domain.WorktreeInfo{
+					{Path: "/home/user/Worktrees/test-project/old", Branch: "old", LastUpdated: time.Now().Add(-30 * 24 * time.Hour)},
+					{Path: "/home/user/Worktrees/test-project/fresh", Branch: "fresh", LastUpdated: time.Now()},
+				}, nil)
+			},
+			expectError: false,
+			validateOut: func(output string) bool {
+				return strings.Contains(output, "old -> /home/user/Worktrees/test-project/old (stale)") &&
+					!strings.Contains(output, "fresh (stale)") &&
+					!strings.Contains(output, "test-project/fresh (stale)")
+			},
+		},
+		{
+			name: "group worktrees by project",
+			args: []string{"--all", "--group-by", "project"},
+			setupMocks: func(mockWS *mocks.MockWorktreeService, mockCS *mocks.MockContextService) {
+				mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+				mockWS.On("ListWorktrees", mock.Anything, mock.AnythingOfType("*domain.ListWorktreesRequest")).Return([]*domain.WorktreeInfo{
+					{Path: "/wt/zeta/feature", Branch: "feature", Project: "zeta"},
+					{Path: "/wt/alpha/fix", Branch: "fix", Project: "alpha"},
+				}, nil)
+			},
+			expectError: false,
+			validateOut: func(output string) bool {
+				return strings.Index(output, "alpha (1)\n  fix -> /wt/alpha/fix") >= 0 &&
+					strings.Index(output, "alpha (1)") < strings.Index(output, "zeta (1)")
+			},
+		},
+		{
+			name: "group by age requests last updated",
+			args: []string{"--group-by", "age"},
+			setupMocks: func(mockWS *mocks.MockWorktreeService, mockCS *mocks.MockContextService) {
+				mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "test-project"}, nil)
+				mockWS.On("ListWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+					return req.IncludeLastUpdated
+				})).Return([]*domain.WorktreeInfo{
+					{Path: "/wt/test-project/old", Branch: "old", LastUpdated: time.Now().Add(-60 * 24 * time.Hour)},
+					{Path: "/wt/test-project/new", Branch: "new", LastUpdated: time.Now()},
+				}, nil)
+			},
+			expectError: false,
+			validateOut: func(output string) bool {
+				return strings.Index(output, "< 1d (1)") < strings.Index(output, "> 1m (1)")
+			},
+		},
+		{
+			name: "filter worktrees with --since-commit",
+			args: []string{"--since-commit", "v1.2.3"},
+			setupMocks: func(mockWS *mocks.MockWorktreeService, mockCS *mocks.MockContextService) {
+				mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "test-project"}, nil)
+				mockWS.On("ListWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+					return req.SinceCommit == "v1.2.3"
+				})).Return([]*domain.WorktreeInfo{
+					{Path: "/wt/test-project/feature", Branch: "feature", AheadCount: 3},
+				}, nil)
+			},
+			expectError: false,
+			validateOut: func(output string) bool {
+				return strings.Contains(output, "feature -> /wt/test-project/feature (+3 since v1.2.3)")
+			},
+		},
+		{
+			name: "color by age requests last updated and stays plain off a terminal",
+			args: []string{"--color-by-age"},
+			setupMocks: func(mockWS *mocks.MockWorktreeService, mockCS *mocks.MockContextService) {
+				mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "test-project"}, nil)
+				mockWS.On("ListWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+					return req.IncludeLastUpdated
+				})).Return([]*domain.WorktreeInfo{
+					{Path: "/wt/test-project/old", Branch: "old", LastUpdated: time.Now().Add(-10 * 24 * time.Hour)},
+				}, nil)
+			},
+			expectError: false,
+			validateOut: func(output string) bool {
+				return output == "old -> /wt/test-project/old\n"
+			},
+		},
+		{
+			name:         "invalid group-by mode",
+			args:         []string{"--group-by", "branch"},
+			setupMocks:   func(_ *mocks.MockWorktreeService, _ *mocks.MockContextService) {},
+			expectError:  true,
+			errorMessage: "unsupported group-by mode",
+		},
+		{
+			name:         "group-by with json output",
+			args:         []string{"--group-by", "project", "--output", "json"},
+			setupMocks:   func(_ *mocks.MockWorktreeService, _ *mocks.MockContextService) {},
+			expectError:  true,
+			errorMessage: "only supported with text output",
+		},
+		{
+			name: "show branch descriptions with --descriptions",
+			args: []string{"--descriptions"},
+			setupMocks: func(mockWS *mocks.MockWorktreeService, mockCS *mocks.MockContextService) {
+				mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "test-project"}, nil)
+				mockWS.On("ListWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+					return req.IncludeDescriptions
+				})).Return([]*domain.WorktreeInfo{
+					{Path: "/wt/test-project/feature", Branch: "feature", Description: "Retry payments\n\nLonger notes"},
+					{Path: "/wt/test-project/plain", Branch: "plain"},
+				}, nil)
+			},
+			validateOut: func(output string) bool {
+				return output == "feature -> /wt/test-project/feature - Retry payments\nplain -> /wt/test-project/plain\n"
+			},
+		},
+		{
+			name: "descriptions in JSON output",
+			args: []string{"--descriptions", "--output", "json"},
+			setupMocks: func(mockWS *mocks.MockWorktreeService, mockCS *mocks.MockContextService) {
+				mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "test-project"}, nil)
+				mockWS.On("ListWorktrees", mock.Anything, mock.AnythingOfType("*domain.ListWorktreesRequest")).Return([]*domain.WorktreeInfo{
+					{Path: "/wt/test-project/feature", Branch: "feature", Description: "Retry payments"},
+					{Path: "/wt/test-project/plain", Branch: "plain"},
+				}, nil)
+			},
+			validateOut: func(output string) bool {
+				return strings.Contains(output, `"branch":"feature","path":"/wt/test-project/feature","status":"clean","description":"Retry payments"`) &&
+					strings.Contains(output, `"branch":"plain","path":"/wt/test-project/plain","status":"clean"}`)
+			},
+		},
+		{
+			name: "only my worktrees with --mine",
+			args: []string{"--all", "--mine"},
+			setupMocks: func(mockWS *mocks.MockWorktreeService, mockCS *mocks.MockContextService) {
+				mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+				mockWS.On("ListWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+					return req.OnlyMine && req.ListAllProjects
+				})).Return([]*domain.WorktreeInfo{
+					{Path: "/wt/test-project/feature", Branch: "feature", CommitAuthorEmail: "me@example.com"},
+				}, nil)
+			},
+			validateOut: func(output string) bool {
+				return output == "feature -> /wt/test-project/feature\n"
+			},
+		},
+		{
+			name: "remote branches without a worktree with --remote",
+			args: []string{"--remote", "--filter", "feature/*"},
+			setupMocks: func(mockWS *mocks.MockWorktreeService, mockCS *mocks.MockContextService) {
+				mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "test-project"}, nil)
+				withFilter := mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+					return req.BranchFilter == "feature/*"
+				})
+				mockWS.On("ListWorktrees", mock.Anything, withFilter).Return([]*domain.WorktreeInfo{
+					{Path: "/wt/test-project/feature/a", Branch: "feature/a"},
+				}, nil)
+				mockWS.On("ListRemoteCandidates", mock.Anything, withFilter).Return([]*domain.RemoteWorktreeCandidate{
+					{Project: "test-project", Branch: "feature/b", Remote: "origin/feature/b", Author: "Bob", CommitTime: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+					{Project: "test-project", Branch: "feature/c", Remote: "origin/feature/c"},
+				}, nil)
+			},
+			validateOut: func(output string) bool {
+				return output == "feature/a -> /wt/test-project/feature/a\n"+
+					"+ feature/b -> origin/feature/b (Bob, 2025-03-01)\n"+
+					"+ feature/c -> origin/feature/c\n"
+			},
+		},
+		{
+			name: "remote branches in JSON output",
+			args: []string{"--remote", "--output", "json"},
+			setupMocks: func(mockWS *mocks.MockWorktreeService, mockCS *mocks.MockContextService) {
+				mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "test-project"}, nil)
+				mockWS.On("ListWorktrees", mock.Anything, mock.AnythingOfType("*domain.ListWorktreesRequest")).Return([]*domain.WorktreeInfo{}, nil)
+				mockWS.On("ListRemoteCandidates", mock.Anything, mock.AnythingOfType("*domain.ListWorktreesRequest")).Return([]*domain.RemoteWorktreeCandidate{
+					{Project: "test-project", Branch: "feature/b", Remote: "origin/feature/b", Commit: "abc123"},
+				}, nil)
+			},
+			validateOut: func(output string) bool {
+				return output == `{"worktrees":[],"total":0,"remote_branches":[{"project":"test-project","branch":"feature/b","remote":"origin/feature/b","commit":"abc123"}]}`
+			},
+		},
+		{
+			name: "count prints only the number of matching worktrees",
+			args: []string{"--mine", "--count", "--stale", "168h"},
+			setupMocks: func(mockWS *mocks.MockWorktreeService, mockCS *mocks.MockContextService) {
+				mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "test-project"}, nil)
+				mockWS.On("ListWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+					return req.OnlyMine && !req.IncludeLastUpdated
+				})).Return([]*domain.WorktreeInfo{
+					{Path: "/wt/test-project/a", Branch: "a"},
+					{Path: "/wt/test-project/b", Branch: "b"},
+				}, nil)
+			},
+			validateOut: func(output string) bool {
+				return output == "2\n"
+			},
+		},
+		{
+			name: "count with no matching worktrees",
+			args: []string{"--count", "--filter", "release/*"},
+			setupMocks: func(mockWS *mocks.MockWorktreeService, mockCS *mocks.MockContextService) {
+				mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "test-project"}, nil)
+				mockWS.On("ListWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+					return req.BranchFilter == "release/*"
+				})).Return([]*domain.WorktreeInfo{}, nil)
+			},
+			validateOut: func(output string) bool {
+				return output == "0\n"
+			},
+		},
+		{
+			name:         "count with json output",
+			args:         []string{"--count", "--output", "json"},
+			setupMocks:   func(_ *mocks.MockWorktreeService, _ *mocks.MockContextService) {},
+			expectError:  true,
+			errorMessage: "--count cannot be combined with --output",
+		},
+		{
+			name:         "count with explicit text output",
+			args:         []string{"--count", "-o", "text"},
+			setupMocks:   func(_ *mocks.MockWorktreeService, _ *mocks.MockContextService) {},
+			expectError:  true,
+			errorMessage: "--count cannot be combined with --output",
+		},
+		{
+			name:         "count with group-by",
+			args:         []string{"--count", "--group-by", "status"},
+			setupMocks:   func(_ *mocks.MockWorktreeService, _ *mocks.MockContextService) {},
+			expectError:  true,
+			errorMessage: "--count cannot be combined with --group-by",
+		},
+		{
+			name:         "count with remote",
+			args:         []string{"--count", "--remote"},
+			setupMocks:   func(_ *mocks.MockWorktreeService, _ *mocks.MockContextService) {},
+			expectError:  true,
+			errorMessage: "cannot be combined with --remote",
+		},
 		{
 			name: "list all worktrees with --all flag",
 			args: []string{"--all"},
@@ -104,3 +346,171 @@ func TestListCommand_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestTextFormatter_GroupHeaders(t *testing.T) {
+	worktrees := []*domain.WorktreeInfo{
+		{Path: "/wt/proj/a", Branch: "a", Project: "proj"},
+		{Path: "/wt/proj/b", Branch: "b", Project: "proj", Modified: true},
+	}
+
+	plain := (&TextFormatter{GroupBy: domain.GroupByStatus}).FormatWorktrees(worktrees)
+	assert.Equal(t, "clean (1)\n  a -> /wt/proj/a\n\ndirty (1)\n  b -> /wt/proj/b (modified)\n", plain)
+
+	bold := (&TextFormatter{GroupBy: domain.GroupByProject, Bold: true}).FormatWorktrees(worktrees)
+	assert.True(t, strings.HasPrefix(bold, "\033[1mproj (2)\033[0m\n"))
+}
+
+func TestTextFormatter_AgeColorizer(t *testing.T) {
+	worktrees := []*domain.WorktreeInfo{
+		{Path: "/wt/proj/new", Branch: "new", LastUpdated: time.Now()},
+		{Path: "/wt/proj/old", Branch: "old", LastUpdated: time.Now().Add(-10 * 24 * time.Hour)},
+	}
+
+	colored := (&TextFormatter{AgeColorizer: NewAgeColorizer(domain.DefaultConfig().Theme)}).FormatWorktrees(worktrees)
+	assert.Equal(t, "\033[32mnew\033[0m -> /wt/proj/new\n\033[31mold\033[0m -> /wt/proj/old\n", colored)
+
+	plain := (&TextFormatter{AgeColorizer: noopAgeColorizer{}}).FormatWorktrees(worktrees)
+	assert.Equal(t, "new -> /wt/proj/new\nold -> /wt/proj/old\n", plain)
+}
+
+func TestListCommand_Sort(t *testing.T) {
+	worktrees := func() []*domain.WorktreeInfo {
+		return []*domain.WorktreeInfo{
+			{Path: "/wt/proj/zeta", Branch: "zeta", LastUpdated: time.Now().Add(-48 * time.Hour)},
+			{Path: "/wt/proj/alpha", Branch: "alpha", LastUpdated: time.Now().Add(-72 * time.Hour)},
+			{Path: "/wt/proj/mid", Branch: "mid", LastUpdated: time.Now()},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		defaultSort string
+		expected    []string
+		expectError string
+	}{
+		{name: "git order without a preference", expected: []string{"zeta", "alpha", "mid"}},
+		{name: "--sort branch", args: []string{"--sort", "branch"}, expected: []string{"alpha", "mid", "zeta"}},
+		{name: "default_sort is the fallback", defaultSort: "age", expected: []string{"mid", "zeta", "alpha"}},
+		{name: "--sort overrides default_sort", args: []string{"--sort", "branch"}, defaultSort: "age", expected: []string{"alpha", "mid", "zeta"}},
+		{name: "unsupported order", args: []string{"--sort", "size"}, expectError: "unsupported sort order"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+			mockWS.On("ListWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+				return req.IncludeLastUpdated == (tc.defaultSort == "age" && len(tc.args) == 0)
+			})).Return(worktrees(), nil)
+
+			cfg := domain.DefaultConfig()
+			cfg.DefaultSort = tc.defaultSort
+			cmd := NewListCommand(&CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS},
+				Config:   cfg,
+			})
+			var buf bytes.Buffer
+			cmd.SetOut(&buf)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+
+			output := buf.String()
+			last := -1
+			for _, branch := range tc.expected {
+				index := strings.Index(output, branch+" -> ")
+				require.GreaterOrEqual(t, index, 0, "missing %s in %q", branch, output)
+				assert.Greater(t, index, last, "%s out of order in %q", branch, output)
+				last = index
+			}
+		})
+	}
+}
+
+func TestListCommand_Paging(t *testing.T) {
+	worktrees := func() []*domain.WorktreeInfo {
+		list := make([]*domain.WorktreeInfo, 0, 25)
+		for i := 24; i >= 0; i-- {
+			branch := fmt.Sprintf("b%02d", i)
+			list = append(list, &domain.WorktreeInfo{Path: "/wt/proj/" + branch, Branch: branch})
+		}
+		return list
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expected    string
+		expectError string
+	}{
+		{
+			name:     "first page after sorting",
+			args:     []string{"--sort", "branch", "--max", "2"},
+			expected: "b00 -> /wt/proj/b00\nb01 -> /wt/proj/b01\n(showing 1-2 of 25)\n",
+		},
+		{
+			name:     "second page",
+			args:     []string{"--sort", "branch", "--max", "2", "--offset", "2"},
+			expected: "b02 -> /wt/proj/b02\nb03 -> /wt/proj/b03\n(showing 3-4 of 25)\n",
+		},
+		{
+			name:     "last partial page",
+			args:     []string{"--sort", "branch", "--max", "10", "--offset", "20"},
+			expected: "b20 -> /wt/proj/b20\nb21 -> /wt/proj/b21\nb22 -> /wt/proj/b22\nb23 -> /wt/proj/b23\nb24 -> /wt/proj/b24\n(showing 21-25 of 25)\n",
+		},
+		{
+			name:     "offset past the end",
+			args:     []string{"--max", "10", "--offset", "30"},
+			expected: "No worktrees found\n(showing 0 of 25)\n",
+		},
+		{
+			name:     "no-header omits the footer",
+			args:     []string{"--sort", "branch", "--max", "1", "--no-header"},
+			expected: "b00 -> /wt/proj/b00\n",
+		},
+		{
+			name:     "json reports the total",
+			args:     []string{"--sort", "branch", "--max", "1", "--output", "json"},
+			expected: `{"worktrees":[{"branch":"b00","path":"/wt/proj/b00","status":"clean"}],"total":25}`,
+		},
+		{name: "negative max", args: []string{"--max", "-1"}, expectError: "--max cannot be negative"},
+		{name: "negative offset", args: []string{"--offset", "-1"}, expectError: "--offset cannot be negative"},
+		{name: "max with count", args: []string{"--max", "5", "--count"}, expectError: "cannot be combined with --max or --offset"},
+		{name: "offset with count", args: []string{"--offset", "5", "--count"}, expectError: "cannot be combined with --max or --offset"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+			mockWS.On("ListWorktrees", mock.Anything, mock.AnythingOfType("*domain.ListWorktreesRequest")).Return(worktrees(), nil)
+
+			cmd := NewListCommand(&CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS},
+				Config:   domain.DefaultConfig(),
+			})
+			var buf bytes.Buffer
+			cmd.SetOut(&buf)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
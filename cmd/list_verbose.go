@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// listDetailsConcurrency caps the worktrees whose details list --verbose looks up at once;
+// each lookup runs up to four git commands
+const listDetailsConcurrency = 8
+
+// listMessageWidth is the number of characters of the last commit subject list --verbose shows
+const listMessageWidth = 50
+
+// annotateWorktreeDetails sets the Details of every worktree, looking up several at once
+func annotateWorktreeDetails(ctx context.Context, cmd *cobra.Command, config *CommandConfig, worktrees []*domain.WorktreeInfo) {
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, listDetailsConcurrency)
+	for _, wt := range worktrees {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(wt *domain.WorktreeInfo) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			wt.Details = config.Services.WorktreeService.GetWorktreeDetails(ctx, wt)
+		}(wt)
+	}
+	wg.Wait()
+
+	for _, wt := range worktrees {
+		if wt.Details == nil {
+			continue
+		}
+		for _, err := range []error{wt.Details.UpstreamErr, wt.Details.StashErr, wt.Details.ActivityErr} {
+			if err != nil {
+				logv(cmd, 2, "  details of %s: %v", wt.Path, err)
+			}
+		}
+	}
+}
+
+// formatWorktreeDetails formats the details of list --verbose as one line:
+// "upstream: origin/x (+2/-1)  stashes: 1  last: Jane Doe "Fix login"". Lookups that
+// failed show "(timeout)" or "(error)".
+func formatWorktreeDetails(details *domain.WorktreeDetails) string {
+	upstream := "none"
+	switch {
+	case details.UpstreamErr != nil:
+		upstream = unavailableDetail(details.UpstreamErr)
+	case details.Upstream != "":
+		upstream = fmt.Sprintf("%s (+%d/-%d)", details.Upstream, details.AheadCount, details.BehindCount)
+	}
+
+	stashes := fmt.Sprint(details.StashCount)
+	if details.StashErr != nil {
+		stashes = unavailableDetail(details.StashErr)
+	}
+
+	last := fmt.Sprintf("%s %q", details.LastAuthor, truncateText(details.LastMessage, listMessageWidth))
+	if details.ActivityErr != nil {
+		last = unavailableDetail(details.ActivityErr)
+	}
+
+	return fmt.Sprintf("upstream: %s  stashes: %s  last: %s", upstream, stashes, last)
+}
+
+// unavailableDetail describes a failed detail lookup
+func unavailableDetail(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "(timeout)"
+	}
+	return "(error)"
+}
+
+// truncateText shortens text to width characters, ending it with "..." when cut
+func truncateText(text string, width int) string {
+	text = firstLine(text)
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	return strings.TrimSpace(string(runes[:width-3])) + "..."
+}
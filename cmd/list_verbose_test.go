@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestListCommand_Verbose(t *testing.T) {
+	feature := &domain.WorktreeInfo{Path: "/wt/test-project/feature", Branch: "feature"}
+	hung := &domain.WorktreeInfo{Path: "/wt/test-project/hung", Branch: "hung"}
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name: "text",
+			args: []string{"-v"},
+			expected: []string{
+				"feature -> /wt/test-project/feature\n    upstream: origin/feature (+2/-1)  stashes: 1  last: Jane Doe \"Fix login redirect\"\n",
+				"hung -> /wt/test-project/hung\n    upstream: (timeout)  stashes: 0  last: (timeout)\n",
+			},
+		},
+		{
+			name: "json",
+			args: []string{"--verbose", "--output", "json"},
+			expected: []string{
+				`"details":{"upstream":"origin/feature","ahead":2,"behind":1,"stash_count":1,"last_author":"Jane Doe","last_message":"Fix login redirect"}`,
+				`"details":{"ahead":0,"behind":0,"stash_count":0,"errors":{"last_commit":"timeout","upstream":"timeout"}}`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "test-project"}, nil)
+			mockWS.On("ListWorktrees", mock.Anything, mock.AnythingOfType("*domain.ListWorktreesRequest")).
+				Return([]*domain.WorktreeInfo{{Path: feature.Path, Branch: feature.Branch}, {Path: hung.Path, Branch: hung.Branch}}, nil)
+			mockWS.On("GetWorktreeDetails", mock.Anything, mock.MatchedBy(func(wt *domain.WorktreeInfo) bool { return wt.Path == feature.Path })).
+				Return(&domain.WorktreeDetails{
+					Upstream: "origin/feature", AheadCount: 2, BehindCount: 1, StashCount: 1,
+					LastAuthor: "Jane Doe", LastMessage: "Fix login redirect",
+				})
+			mockWS.On("GetWorktreeDetails", mock.Anything, mock.MatchedBy(func(wt *domain.WorktreeInfo) bool { return wt.Path == hung.Path })).
+				Return(&domain.WorktreeDetails{UpstreamErr: context.DeadlineExceeded, ActivityErr: fmt.Errorf("git log: %w", context.DeadlineExceeded)})
+
+			cmd := NewListCommand(&CommandConfig{Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS}})
+			// Normally inherited from the root command
+			cmd.Flags().CountP("verbose", "v", "Increase verbosity")
+			cmd.SetArgs(tc.args)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+
+			require.NoError(t, cmd.Execute())
+			for _, expected := range tc.expected {
+				assert.Contains(t, out.String(), expected)
+			}
+			mockWS.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListCommand_DetailsOnlyWithVerbose(t *testing.T) {
+	mockWS := mocks.NewMockWorktreeService()
+	mockCS := mocks.NewMockContextService()
+	mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "test-project"}, nil)
+	mockWS.On("ListWorktrees", mock.Anything, mock.AnythingOfType("*domain.ListWorktreesRequest")).
+		Return([]*domain.WorktreeInfo{{Path: "/wt/test-project/feature", Branch: "feature"}}, nil)
+
+	cmd := NewListCommand(&CommandConfig{Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS}})
+	cmd.Flags().CountP("verbose", "v", "Increase verbosity")
+	cmd.SetArgs([]string{})
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, "feature -> /wt/test-project/feature\n", out.String())
+	mockWS.AssertNotCalled(t, "GetWorktreeDetails", mock.Anything, mock.Anything)
+}
+
+func TestFormatWorktreeDetails(t *testing.T) {
+	testCases := []struct {
+		name     string
+		details  *domain.WorktreeDetails
+		expected string
+	}{
+		{
+			name:     "no upstream",
+			details:  &domain.WorktreeDetails{StashCount: 3, LastAuthor: "Bob", LastMessage: "WIP"},
+			expected: `upstream: none  stashes: 3  last: Bob "WIP"`,
+		},
+		{
+			name:     "failed lookups",
+			details:  &domain.WorktreeDetails{UpstreamErr: assert.AnError, StashErr: context.DeadlineExceeded, ActivityErr: assert.AnError},
+			expected: "upstream: (error)  stashes: (timeout)  last: (error)",
+		},
+		{
+			name:     "long subject is truncated",
+			details:  &domain.WorktreeDetails{Upstream: "origin/x", LastAuthor: "Bob", LastMessage: strings.Repeat("word ", 20)},
+			expected: `upstream: origin/x (+0/-0)  stashes: 0  last: Bob "` + strings.TrimSpace(strings.Repeat("word ", 10)[:47]) + `..."`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, formatWorktreeDetails(tc.details))
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// skipNetworkCheck reports whether --no-network-check or [git] skip_network_check is set
+func skipNetworkCheck(cmd *cobra.Command, config *CommandConfig) bool {
+	if skip, _ := cmd.Flags().GetBool("no-network-check"); skip {
+		return true
+	}
+	return config.Config != nil && config.Config.Git.SkipNetworkCheck
+}
+
+// checkRemoteReachable dials the host of the project's remote before a git remote operation,
+// so an offline machine fails fast instead of waiting for git's own timeout. Local remotes
+// and remotes the project does not have are left for git to report.
+func checkRemoteReachable(cmd *cobra.Command, config *CommandConfig, project *domain.ProjectInfo, remote string) error {
+	if skipNetworkCheck(cmd, config) {
+		return nil
+	}
+	for _, r := range project.Remotes {
+		if r.Name != remote {
+			continue
+		}
+		address, ok := domain.RemoteAddress(r.FetchURL)
+		if !ok {
+			return nil
+		}
+		logv(cmd, 2, "  checking connectivity to %s", address)
+		_, err := config.Services.NetworkChecker.IsReachable(address)
+		return err
+	}
+	return nil
+}
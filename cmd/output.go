@@ -1,9 +1,12 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"twiggit/internal/domain"
 )
@@ -14,37 +17,149 @@ type OutputFormatter interface {
 }
 
 // TextFormatter implements text-based output formatting
-type TextFormatter struct{}
+type TextFormatter struct {
+	StaleThreshold time.Duration      // Mark worktrees older than this as stale (0 disables)
+	GroupBy        domain.GroupByMode // Render worktrees under a header per group (GroupByNone disables)
+	Bold           bool               // Render group headers in bold (ANSI)
+	SinceCommit    string             // Show AheadCount relative to this ref ("" disables)
+	AgeColorizer   AgeColorizer       // Style branch names by age (nil disables)
+	WithPR         bool               // Show each worktree's PRInfo, "(no PR)" when nil
+	Footer         string             // Line printed after the worktrees, such as the list --max page ("" disables)
+
+	RemoteBranches []*domain.RemoteWorktreeCandidate // Potential worktrees from list --remote, marked with "+"
+}
 
 // FormatWorktrees formats worktrees as human-readable text
 func (f *TextFormatter) FormatWorktrees(worktrees []*domain.WorktreeInfo) string {
-	if len(worktrees) == 0 {
+	if f.Footer != "" {
+		body := f.formatWorktrees(worktrees)
+		if !strings.HasSuffix(body, "\n") {
+			body += "\n"
+		}
+		return body + f.Footer + "\n"
+	}
+	return f.formatWorktrees(worktrees)
+}
+
+func (f *TextFormatter) formatWorktrees(worktrees []*domain.WorktreeInfo) string {
+	if len(worktrees) == 0 && len(f.RemoteBranches) == 0 {
 		return "No worktrees found"
 	}
 
 	var result strings.Builder
-	for _, wt := range worktrees {
-		status := ""
-		if wt.Modified {
-			status += " (modified)"
+	if f.GroupBy == domain.GroupByNone {
+		for _, wt := range worktrees {
+			result.WriteString(f.formatWorktreeLine(wt))
 		}
-		if wt.IsDetached {
-			status += " (detached)"
+		for _, candidate := range f.RemoteBranches {
+			result.WriteString(formatRemoteCandidateLine(candidate))
 		}
+		return result.String()
+	}
 
-		result.WriteString(fmt.Sprintf("%s -> %s%s\n", wt.Branch, wt.Path, status))
+	groups := domain.GroupWorktrees(worktrees, f.GroupBy)
+	for i, name := range domain.SortedGroupNames(groups, f.GroupBy) {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		result.WriteString(f.formatHeader(fmt.Sprintf("%s (%d)", name, len(groups[name]))))
+		for _, wt := range groups[name] {
+			result.WriteString("  " + f.formatWorktreeLine(wt))
+		}
+	}
+	if len(f.RemoteBranches) > 0 {
+		if len(worktrees) > 0 {
+			result.WriteString("\n")
+		}
+		result.WriteString(f.formatHeader(fmt.Sprintf("remote (%d)", len(f.RemoteBranches))))
+		for _, candidate := range f.RemoteBranches {
+			result.WriteString("  " + formatRemoteCandidateLine(candidate))
+		}
 	}
 	return result.String()
 }
 
+// formatRemoteCandidateLine formats a remote branch without a worktree as "+ branch -> remote (author, date)"
+func formatRemoteCandidateLine(candidate *domain.RemoteWorktreeCandidate) string {
+	var details []string
+	if candidate.Author != "" {
+		details = append(details, candidate.Author)
+	}
+	if !candidate.CommitTime.IsZero() {
+		details = append(details, candidate.CommitTime.Format("2006-01-02"))
+	}
+
+	info := ""
+	if len(details) > 0 {
+		info = " (" + strings.Join(details, ", ") + ")"
+	}
+	return fmt.Sprintf("+ %s -> %s%s\n", candidate.Branch, candidate.Remote, info)
+}
+
+// formatWorktreeLine formats a single worktree as "branch -> path (status...)"
+func (f *TextFormatter) formatWorktreeLine(wt *domain.WorktreeInfo) string {
+	status := ""
+	if wt.Modified {
+		status += " (modified)"
+	}
+	if wt.IsDetached {
+		status += " (detached)"
+	}
+	if f.StaleThreshold > 0 && wt.IsStale(f.StaleThreshold) {
+		status += " (stale)"
+	}
+	if f.SinceCommit != "" {
+		status += fmt.Sprintf(" (+%d since %s)", wt.AheadCount, f.SinceCommit)
+	}
+
+	branch := wt.Branch
+	if f.AgeColorizer != nil {
+		branch = f.AgeColorizer.Colorize(wt.Age(), branch)
+	}
+
+	if f.WithPR && !wt.IsDetached {
+		status += " [" + formatPullRequest(wt.PRInfo) + "]"
+	}
+
+	if wt.Description != "" {
+		status += " - " + firstLine(wt.Description)
+	}
+
+	line := fmt.Sprintf("%s -> %s%s\n", branch, wt.Path, status)
+	if wt.Details != nil {
+		line += "    " + formatWorktreeDetails(wt.Details) + "\n"
+	}
+	return line
+}
+
+// firstLine returns text up to the first newline; descriptions may span several lines
+func firstLine(text string) string {
+	line, _, _ := strings.Cut(text, "\n")
+	return line
+}
+
+// formatHeader formats a group header, bold when enabled
+func (f *TextFormatter) formatHeader(header string) string {
+	if f.Bold {
+		return "\033[1m" + header + "\033[0m\n"
+	}
+	return header + "\n"
+}
+
 // JSONFormatter implements JSON output formatting
-type JSONFormatter struct{}
+type JSONFormatter struct {
+	StaleThreshold time.Duration // Set the stale field for worktrees older than this (0 disables)
+	Total          int           // Matching worktrees before list --max/--offset paging
+
+	RemoteBranches []*domain.RemoteWorktreeCandidate // Potential worktrees from list --remote
+}
 
 // FormatWorktrees formats worktrees as compact JSON
 func (f *JSONFormatter) FormatWorktrees(worktrees []*domain.WorktreeInfo) string {
 	// Convert domain types to JSON-serializable types
 	worktreeList := WorktreeListJSON{
 		Worktrees: make([]WorktreeJSON, len(worktrees)),
+		Total:     max(f.Total, len(worktrees)), // Unpaged callers leave Total unset
 	}
 
 	for i, wt := range worktrees {
@@ -52,7 +167,36 @@ func (f *JSONFormatter) FormatWorktrees(worktrees []*domain.WorktreeInfo) string
 			Branch: wt.Branch,
 			Path:   wt.Path,
 			Status: getStatus(wt),
+			Stale:  f.StaleThreshold > 0 && wt.IsStale(f.StaleThreshold),
+
+			AheadCount:  wt.AheadCount,
+			Description: wt.Description,
+		}
+		if wt.PRInfo != nil {
+			worktreeList.Worktrees[i].PullRequest = &PullRequestJSON{
+				Number: wt.PRInfo.Number,
+				Title:  wt.PRInfo.Title,
+				State:  wt.PRInfo.State,
+				URL:    wt.PRInfo.URL,
+			}
+		}
+		if wt.Details != nil {
+			worktreeList.Worktrees[i].Details = newWorktreeDetailsJSON(wt.Details)
+		}
+	}
+
+	for _, candidate := range f.RemoteBranches {
+		remote := RemoteBranchJSON{
+			Project: candidate.Project,
+			Branch:  candidate.Branch,
+			Remote:  candidate.Remote,
+			Commit:  candidate.Commit,
+			Author:  candidate.Author,
+		}
+		if !candidate.CommitTime.IsZero() {
+			remote.CommitTime = candidate.CommitTime.Format(time.RFC3339)
 		}
+		worktreeList.RemoteBranches = append(worktreeList.RemoteBranches, remote)
 	}
 
 	// Marshal to JSON with compact formatting
@@ -70,11 +214,77 @@ type WorktreeJSON struct {
 	Branch string `json:"branch"`
 	Path   string `json:"path"`
 	Status string `json:"status"`
+	Stale  bool   `json:"stale,omitempty"`
+
+	AheadCount  int    `json:"ahead_count,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	PullRequest *PullRequestJSON     `json:"pull_request,omitempty"`
+	Details     *WorktreeDetailsJSON `json:"details,omitempty"`
+}
+
+// WorktreeDetailsJSON represents the list --verbose details of a worktree for JSON serialization.
+// Errors maps the lookups that failed (upstream, stash, last_commit) to "timeout" or their error.
+type WorktreeDetailsJSON struct {
+	Upstream    string `json:"upstream,omitempty"`
+	AheadCount  int    `json:"ahead"`
+	BehindCount int    `json:"behind"`
+	StashCount  int    `json:"stash_count"`
+	LastAuthor  string `json:"last_author,omitempty"`
+	LastMessage string `json:"last_message,omitempty"`
+
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// newWorktreeDetailsJSON converts the details of a worktree, keeping failed lookups in Errors
+func newWorktreeDetailsJSON(details *domain.WorktreeDetails) *WorktreeDetailsJSON {
+	result := &WorktreeDetailsJSON{
+		Upstream:    details.Upstream,
+		AheadCount:  details.AheadCount,
+		BehindCount: details.BehindCount,
+		StashCount:  details.StashCount,
+		LastAuthor:  details.LastAuthor,
+		LastMessage: details.LastMessage,
+	}
+	for name, err := range map[string]error{"upstream": details.UpstreamErr, "stash": details.StashErr, "last_commit": details.ActivityErr} {
+		if err == nil {
+			continue
+		}
+		if result.Errors == nil {
+			result.Errors = map[string]string{}
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			result.Errors[name] = "timeout"
+		} else {
+			result.Errors[name] = err.Error()
+		}
+	}
+	return result
+}
+
+// PullRequestJSON represents a worktree's pull request (list --with-pr) for JSON serialization
+type PullRequestJSON struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+	URL    string `json:"url"`
+}
+
+// RemoteBranchJSON represents a remote branch without a local worktree for JSON serialization
+type RemoteBranchJSON struct {
+	Project    string `json:"project"`
+	Branch     string `json:"branch"`
+	Remote     string `json:"remote"`
+	Commit     string `json:"commit"`
+	Author     string `json:"author,omitempty"`
+	CommitTime string `json:"commit_time,omitempty"`
 }
 
 // WorktreeListJSON is the wrapper struct for JSON output
 type WorktreeListJSON struct {
-	Worktrees []WorktreeJSON `json:"worktrees"`
+	Worktrees      []WorktreeJSON     `json:"worktrees"`
+	Total          int                `json:"total"` // Matching worktrees; more than len(Worktrees) when paged
+	RemoteBranches []RemoteBranchJSON `json:"remote_branches,omitempty"`
 }
 
 // getStatus converts WorktreeInfo to a status string
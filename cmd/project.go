@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+)
+
+// NewProjectCommand creates the project command group for managing projects in the workspace
+func NewProjectCommand(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project",
+		Short: "Manage projects",
+		Long: `Commands that operate on a whole project in the workspace.
+
+Examples:
+  twiggit project list                               List the projects in the projects directory
+  twiggit project info my-project                    Show remotes, branches and hooks of a project
+  twiggit project rename my-project my-new-project   Rename a project and its worktrees directory`,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newProjectListCmd(config))
+	cmd.AddCommand(newProjectInfoCmd(config))
+	cmd.AddCommand(newProjectRenameCmd(config))
+
+	return cmd
+}
+
+func newProjectRenameCmd(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rename <old-name> <new-name>",
+		Short: "Rename a project and move its worktrees",
+		Long: `Rename the project directory in the projects directory and its directory in the
+worktrees directory, then repair git's links so existing worktrees keep working.
+Symlinks in either directory that point at the old locations are updated.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			if err := config.Services.ProjectService.RenameProject(ctx, args[0], args[1]); err != nil {
+				return fmt.Errorf("failed to rename project %s: %w", args[0], err)
+			}
+			if !isQuiet(cmd) {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Renamed project %s to %s\n", args[0], args[1])
+			}
+			return nil
+		},
+	}
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionProjects(config),
+	)
+
+	return cmd
+}
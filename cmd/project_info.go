@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// ProjectInfoJSON represents a project for `project info --output json`
+type ProjectInfoJSON struct {
+	Name          string              `json:"name"`
+	GitRepoPath   string              `json:"git_repo_path"`
+	DefaultBranch string              `json:"default_branch,omitempty"`
+	Remotes       []ProjectRemoteJSON `json:"remotes"`
+	WorktreeCount int                 `json:"worktree_count"`
+	Branches      []string            `json:"branches"`
+	LastCommit    string              `json:"last_commit,omitempty"`
+	Hooks         map[string]int      `json:"hooks"`
+}
+
+// ProjectRemoteJSON represents a remote of a project
+type ProjectRemoteJSON struct {
+	Name     string `json:"name"`
+	FetchURL string `json:"fetch_url"`
+	PushURL  string `json:"push_url,omitempty"`
+}
+
+// newProjectInfoCmd creates the project info subcommand
+func newProjectInfoCmd(config *CommandConfig) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "info <project>",
+		Short: "Show details of a project",
+		Long: `Show the repository path, remotes, worktree count, branches, last commit
+and configured .twiggit.toml hooks of a project, from anywhere.
+
+Examples:
+  twiggit project info my-project
+  twiggit project info my-project --output json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != "text" && output != "json" {
+				return fmt.Errorf("invalid output format '%s': must be 'text' or 'json'", output)
+			}
+			return executeProjectInfo(cmd, config, args[0], output)
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "Output format (text or json)")
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionProjects(config),
+	)
+
+	return cmd
+}
+
+// newProjectListCmd creates the project list subcommand
+func newProjectListCmd(config *CommandConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the projects in the projects directory",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			summaries, err := config.Services.ProjectService.ListProjectSummaries(context.Background())
+			if err != nil {
+				return fmt.Errorf("failed to list projects: %w", err)
+			}
+			out := cmd.OutOrStdout()
+			if isJSONOutput(config) {
+				return writeJSON(out, &ProjectListResult{Projects: summaries})
+			}
+			if len(summaries) == 0 {
+				_, _ = fmt.Fprintln(out, "No projects found")
+				return nil
+			}
+			tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintln(tw, "PROJECT\tPATH")
+			for _, summary := range summaries {
+				_, _ = fmt.Fprintf(tw, "%s\t%s\n", summary.Name, summary.GitRepoPath)
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+// executeProjectInfo resolves the project by name and prints its details
+func executeProjectInfo(cmd *cobra.Command, config *CommandConfig, projectName, output string) error {
+	ctx := context.Background()
+
+	summaries, err := config.Services.ProjectService.ListProjectSummaries(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+	var summary *domain.ProjectSummary
+	for _, s := range summaries {
+		if s.Name == projectName {
+			summary = s
+			break
+		}
+	}
+	if summary == nil {
+		return domain.NewProjectServiceError(projectName, "", "ProjectInfo", "project not found", domain.ErrProjectNotFound)
+	}
+
+	project, err := config.Services.ProjectService.GetProjectInfo(ctx, summary.Path)
+	if err != nil {
+		return fmt.Errorf("failed to get project info for %s: %w", projectName, err)
+	}
+
+	logv(cmd, 1, "Reading hooks of %s", project.Name)
+	hooks, err := config.Services.HookRunner.LoadConfig(filepath.Join(project.GitRepoPath, ".twiggit.toml"))
+	if err != nil {
+		return fmt.Errorf("failed to read hooks of %s: %w", projectName, err)
+	}
+
+	info := buildProjectInfoJSON(project, hooks)
+	if output == "json" {
+		data, err := json.Marshal(info)
+		if err != nil {
+			return fmt.Errorf("failed to marshal project info: %w", err)
+		}
+		_, _ = fmt.Fprintln(cmd.OutOrStdout(), string(data))
+		return nil
+	}
+
+	displayProjectInfo(cmd.OutOrStdout(), info, project.LastCommitTime())
+	return nil
+}
+
+// buildProjectInfoJSON collects the displayed details; hooks map each configured hook to
+// its number of commands
+func buildProjectInfoJSON(project *domain.ProjectInfo, hooks *domain.HookConfig) ProjectInfoJSON {
+	info := ProjectInfoJSON{
+		Name:          project.Name,
+		GitRepoPath:   project.GitRepoPath,
+		DefaultBranch: project.DefaultBranch,
+		Remotes:       make([]ProjectRemoteJSON, 0, len(project.Remotes)),
+		WorktreeCount: len(project.Worktrees),
+		Branches:      make([]string, 0, len(project.Branches)),
+		Hooks:         map[string]int{},
+	}
+	for _, remote := range project.Remotes {
+		info.Remotes = append(info.Remotes, ProjectRemoteJSON{Name: remote.Name, FetchURL: remote.FetchURL, PushURL: remote.PushURL})
+	}
+	for _, branch := range project.Branches {
+		info.Branches = append(info.Branches, branch.Name)
+	}
+	if last := project.LastCommitTime(); !last.IsZero() {
+		info.LastCommit = last.Format(time.RFC3339)
+	}
+	if hooks != nil {
+		countHookCommands(info.Hooks, domain.HookPostCreate, hooks.PostCreate)
+		countHookCommands(info.Hooks, domain.HookPostChange, hooks.PostChange)
+	}
+	return info
+}
+
+// countHookCommands records the number of commands of a configured hook
+func countHookCommands(counts map[string]int, hookType domain.HookType, definition *domain.HookDefinition) {
+	if definition == nil {
+		return
+	}
+	if n := len(definition.Commands) + len(definition.Background); n > 0 {
+		counts[string(hookType)] = n
+	}
+}
+
+// displayProjectInfo prints the project details as aligned key/value lines
+func displayProjectInfo(out io.Writer, info ProjectInfoJSON, lastCommit time.Time) {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(tw, "Project:\t%s\n", info.Name)
+	_, _ = fmt.Fprintf(tw, "Repository:\t%s\n", info.GitRepoPath)
+	if info.DefaultBranch != "" {
+		_, _ = fmt.Fprintf(tw, "Default branch:\t%s\n", info.DefaultBranch)
+	}
+	_, _ = fmt.Fprintf(tw, "Worktrees:\t%d\n", info.WorktreeCount)
+	if lastCommit.IsZero() {
+		_, _ = fmt.Fprintf(tw, "Last commit:\tunknown\n")
+	} else {
+		_, _ = fmt.Fprintf(tw, "Last commit:\t%s\n", lastCommit.Format(time.DateTime))
+	}
+
+	hooks := "none"
+	if len(info.Hooks) > 0 {
+		var parts []string
+		for _, hookType := range []domain.HookType{domain.HookPostCreate, domain.HookPostChange} {
+			if n, ok := info.Hooks[string(hookType)]; ok {
+				parts = append(parts, fmt.Sprintf("%s (%d command(s))", hookType, n))
+			}
+		}
+		hooks = strings.Join(parts, ", ")
+	}
+	_, _ = fmt.Fprintf(tw, "Hooks:\t%s\n", hooks)
+	_ = tw.Flush()
+
+	_, _ = fmt.Fprintln(out, "Remotes:")
+	if len(info.Remotes) == 0 {
+		_, _ = fmt.Fprintln(out, "  (none)")
+	}
+	for _, remote := range info.Remotes {
+		_, _ = fmt.Fprintf(out, "  %s  %s\n", remote.Name, remote.FetchURL)
+	}
+
+	_, _ = fmt.Fprintf(out, "Branches (%d):\n", len(info.Branches))
+	for _, branch := range info.Branches {
+		_, _ = fmt.Fprintf(out, "  %s\n", branch)
+	}
+}
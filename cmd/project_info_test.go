@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func newProjectInfoTestProject() *domain.ProjectInfo {
+	return &domain.ProjectInfo{
+		Name:          "myproject",
+		Path:          "/projects/myproject",
+		GitRepoPath:   "/projects/myproject",
+		DefaultBranch: "main",
+		Worktrees: []*domain.WorktreeInfo{
+			{Path: "/worktrees/myproject/feature-a", Branch: "feature-a"},
+			{Path: "/worktrees/myproject/feature-b", Branch: "feature-b"},
+		},
+		Branches: []*domain.BranchInfo{
+			{Name: "main", Date: time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)},
+			{Name: "feature-a", Date: time.Date(2024, 3, 4, 12, 30, 0, 0, time.UTC)},
+		},
+		Remotes: []*domain.RemoteInfo{
+			{Name: "origin", FetchURL: "git@github.com:me/myproject.git", PushURL: "git@github.com:me/myproject.git"},
+		},
+	}
+}
+
+func TestProjectInfoCommand_Execute(t *testing.T) {
+	summaries := []*domain.ProjectSummary{
+		{Name: "myproject", Path: "/projects/myproject", GitRepoPath: "/projects/myproject"},
+	}
+	hooks := &domain.HookConfig{
+		PostCreate: &domain.HookDefinition{Commands: []string{"npm install", "make setup"}},
+	}
+
+	testCases := []struct {
+		name          string
+		args          []string
+		expectError   string
+		expectOut     []string
+		expectNoCalls bool
+	}{
+		{
+			name: "text output",
+			args: []string{"info", "myproject"},
+			expectOut: []string{
+				"Project:         myproject",
+				"Repository:      /projects/myproject",
+				"Default branch:  main",
+				"Worktrees:       2",
+				"Last commit:     2024-03-04 12:30:00",
+				"Hooks:           post-create (2 command(s))",
+				"  origin  git@github.com:me/myproject.git",
+				"Branches (2):\n  main\n  feature-a\n",
+			},
+		},
+		{
+			name:        "unknown project",
+			args:        []string{"info", "missing"},
+			expectError: "project not found",
+		},
+		{
+			name:          "invalid output format",
+			args:          []string{"info", "myproject", "--output", "yaml"},
+			expectError:   "invalid output format 'yaml'",
+			expectNoCalls: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ps := mocks.NewMockProjectService()
+			ps.On("ListProjectSummaries", mock.Anything).Return(summaries, nil)
+			ps.On("GetProjectInfo", mock.Anything, "/projects/myproject").Return(newProjectInfoTestProject(), nil)
+			hr := mocks.NewMockHookRunner()
+			hr.On("LoadConfig", "/projects/myproject/.twiggit.toml").Return(hooks, nil)
+
+			config := &CommandConfig{Services: &ServiceContainer{ProjectService: ps, HookRunner: hr}}
+			cmd := NewProjectCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectNoCalls {
+				ps.AssertNotCalled(t, "ListProjectSummaries", mock.Anything)
+			}
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+		})
+	}
+}
+
+func TestProjectInfoCommand_NotFound(t *testing.T) {
+	ps := mocks.NewMockProjectService()
+	ps.On("ListProjectSummaries", mock.Anything).Return([]*domain.ProjectSummary{}, nil)
+
+	config := &CommandConfig{Services: &ServiceContainer{ProjectService: ps}}
+	cmd := NewProjectCommand(config)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"info", "missing"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	require.ErrorIs(t, err, domain.ErrProjectNotFound)
+	assert.Contains(t, NewErrorFormatter().Format(err), "twiggit project list")
+	ps.AssertNotCalled(t, "GetProjectInfo", mock.Anything, mock.Anything)
+}
+
+func TestProjectInfoCommand_JSON(t *testing.T) {
+	ps := mocks.NewMockProjectService()
+	ps.On("ListProjectSummaries", mock.Anything).Return([]*domain.ProjectSummary{
+		{Name: "myproject", Path: "/projects/myproject", GitRepoPath: "/projects/myproject"},
+	}, nil)
+	ps.On("GetProjectInfo", mock.Anything, "/projects/myproject").Return(newProjectInfoTestProject(), nil)
+	hr := mocks.NewMockHookRunner()
+	hr.On("LoadConfig", "/projects/myproject/.twiggit.toml").Return(nil, nil)
+
+	config := &CommandConfig{Services: &ServiceContainer{ProjectService: ps, HookRunner: hr}}
+	cmd := NewProjectCommand(config)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"info", "myproject", "-o", "json"})
+
+	require.NoError(t, cmd.Execute())
+
+	var info ProjectInfoJSON
+	require.NoError(t, json.Unmarshal(out.Bytes(), &info))
+	assert.Equal(t, "myproject", info.Name)
+	assert.Equal(t, "/projects/myproject", info.GitRepoPath)
+	assert.Equal(t, 2, info.WorktreeCount)
+	assert.Equal(t, []string{"main", "feature-a"}, info.Branches)
+	assert.Equal(t, "2024-03-04T12:30:00Z", info.LastCommit)
+	require.Len(t, info.Remotes, 1)
+	assert.Equal(t, "origin", info.Remotes[0].Name)
+	assert.Empty(t, info.Hooks)
+}
+
+func TestProjectInfoCommand_HookConfigError(t *testing.T) {
+	ps := mocks.NewMockProjectService()
+	ps.On("ListProjectSummaries", mock.Anything).Return([]*domain.ProjectSummary{
+		{Name: "myproject", Path: "/projects/myproject", GitRepoPath: "/projects/myproject"},
+	}, nil)
+	ps.On("GetProjectInfo", mock.Anything, "/projects/myproject").Return(newProjectInfoTestProject(), nil)
+	hr := mocks.NewMockHookRunner()
+	hr.On("LoadConfig", mock.Anything).Return(nil, errors.New("bad toml"))
+
+	config := &CommandConfig{Services: &ServiceContainer{ProjectService: ps, HookRunner: hr}}
+	cmd := NewProjectCommand(config)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"info", "myproject"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read hooks of myproject")
+}
+
+func TestProjectListCommand_Execute(t *testing.T) {
+	testCases := []struct {
+		name      string
+		summaries []*domain.ProjectSummary
+		expectOut string
+	}{
+		{
+			name: "lists projects",
+			summaries: []*domain.ProjectSummary{
+				{Name: "alpha", GitRepoPath: "/projects/alpha"},
+				{Name: "beta-project", GitRepoPath: "/projects/beta-project"},
+			},
+			expectOut: "PROJECT       PATH\nalpha         /projects/alpha\nbeta-project  /projects/beta-project\n",
+		},
+		{
+			name:      "no projects",
+			summaries: []*domain.ProjectSummary{},
+			expectOut: "No projects found\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ps := mocks.NewMockProjectService()
+			ps.On("ListProjectSummaries", mock.Anything).Return(tc.summaries, nil)
+
+			config := &CommandConfig{Services: &ServiceContainer{ProjectService: ps}}
+			cmd := NewProjectCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs([]string{"list"})
+
+			require.NoError(t, cmd.Execute())
+			assert.Equal(t, tc.expectOut, out.String())
+		})
+	}
+}
+
+func TestProjectListCommand_JSON(t *testing.T) {
+	for name, tc := range map[string]struct {
+		summaries  []*domain.ProjectSummary
+		expectJSON string
+	}{
+		"lists projects": {
+			summaries:  []*domain.ProjectSummary{{Name: "alpha", Path: "/projects/alpha", GitRepoPath: "/projects/alpha"}},
+			expectJSON: `{"projects":[{"name":"alpha","path":"/projects/alpha","git_repo_path":"/projects/alpha"}]}`,
+		},
+		"no projects": {
+			summaries:  nil,
+			expectJSON: `{"projects":[]}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ps := mocks.NewMockProjectService()
+			ps.On("ListProjectSummaries", mock.Anything).Return(tc.summaries, nil)
+
+			config := &CommandConfig{Services: &ServiceContainer{ProjectService: ps}, Output: outputJSON}
+			cmd := NewProjectCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs([]string{"list"})
+
+			require.NoError(t, cmd.Execute())
+			require.True(t, json.Valid(out.Bytes()), "invalid JSON: %s", out.String())
+			assert.JSONEq(t, tc.expectJSON, out.String())
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestProjectRenameCommand_Execute(t *testing.T) {
+	testCases := []struct {
+		name        string
+		args        []string
+		renameErr   error
+		expectError string
+		expectOut   string
+	}{
+		{
+			name:      "renames project",
+			args:      []string{"rename", "old-name", "new-name"},
+			expectOut: "Renamed project old-name to new-name\n",
+		},
+		{
+			name:        "name conflict",
+			args:        []string{"rename", "old-name", "taken"},
+			renameErr:   domain.NewProjectServiceError("taken", "/projects/taken", "RenameProject", "a project with this name already exists", domain.ErrProjectNameConflict),
+			expectError: "a project with this name already exists",
+		},
+		{
+			name:        "missing new name",
+			args:        []string{"rename", "old-name"},
+			expectError: "accepts 2 arg(s)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ps := mocks.NewMockProjectService()
+			ps.On("RenameProject", mock.Anything, "old-name", mock.AnythingOfType("string")).Return(tc.renameErr)
+
+			config := &CommandConfig{Services: &ServiceContainer{ProjectService: ps}}
+			cmd := NewProjectCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectOut, out.String())
+		})
+	}
+}
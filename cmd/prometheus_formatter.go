@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"twiggit/internal/domain"
+)
+
+// prometheusMetric describes one metric family of the worktrees stats exposition
+type prometheusMetric struct {
+	name, help string
+}
+
+var (
+	metricWorktreesTotal = prometheusMetric{"twiggit_worktrees_total", "Number of worktrees of the project, main worktree excluded."}
+	metricWorktreesDirty = prometheusMetric{"twiggit_worktrees_dirty", "Number of worktrees of the project with uncommitted changes."}
+	metricWorktreeAge    = prometheusMetric{"twiggit_worktrees_age_seconds", "Seconds since the HEAD commit of the worktree."}
+)
+
+// prometheusLabelEscaper escapes label values as the text exposition format requires
+var prometheusLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// PrometheusFormatter renders worktree stats in the Prometheus text exposition format
+// (version 0.0.4), for node_exporter's textfile collector or a scrape of --serve
+type PrometheusFormatter struct{}
+
+// Format returns every metric family with its HELP and TYPE lines; projects and branches
+// are sorted so consecutive outputs only differ in values
+func (f *PrometheusFormatter) Format(stats map[string]*domain.ProjectStats) []byte {
+	projects := make([]string, 0, len(stats))
+	for name := range stats {
+		projects = append(projects, name)
+	}
+	slices.Sort(projects)
+
+	var out strings.Builder
+	writeFamily := func(metric prometheusMetric, samples func()) {
+		_, _ = fmt.Fprintf(&out, "# HELP %s %s\n# TYPE %s gauge\n", metric.name, metric.help, metric.name)
+		samples()
+	}
+
+	writeFamily(metricWorktreesTotal, func() {
+		for _, project := range projects {
+			writePrometheusSample(&out, metricWorktreesTotal.name, int64(stats[project].Total), "project", project)
+		}
+	})
+	writeFamily(metricWorktreesDirty, func() {
+		for _, project := range projects {
+			writePrometheusSample(&out, metricWorktreesDirty.name, int64(stats[project].Dirty), "project", project)
+		}
+	})
+	writeFamily(metricWorktreeAge, func() {
+		for _, project := range projects {
+			ages := slices.Clone(stats[project].Ages)
+			slices.SortStableFunc(ages, func(a, b domain.WorktreeAge) int { return strings.Compare(a.Branch, b.Branch) })
+			for _, age := range ages {
+				writePrometheusSample(&out, metricWorktreeAge.name, int64(age.Age.Seconds()), "project", project, "branch", age.Branch)
+			}
+		}
+	})
+	return []byte(out.String())
+}
+
+// writePrometheusSample writes `name{label="value",...} value`; labels are name/value pairs
+func writePrometheusSample(out *strings.Builder, name string, value int64, labels ...string) {
+	pairs := make([]string, 0, len(labels)/2)
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, labels[i]+`="`+prometheusLabelEscaper.Replace(labels[i+1])+`"`)
+	}
+	_, _ = fmt.Fprintf(out, "%s{%s} %d\n", name, strings.Join(pairs, ","), value)
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"twiggit/internal/domain"
+)
+
+func newTestProjectStats() map[string]*domain.ProjectStats {
+	return map[string]*domain.ProjectStats{
+		"web": {ProjectName: "web", Total: 1, Ages: []domain.WorktreeAge{{Branch: "fix", Age: 90 * time.Minute}}},
+		"api": {ProjectName: "api", Total: 2, Dirty: 1, Ages: []domain.WorktreeAge{
+			{Branch: "feature/x", Age: 24 * time.Hour},
+			{Branch: `odd"name\`, Age: 1500 * time.Millisecond},
+		}},
+	}
+}
+
+func TestPrometheusFormatter_Format(t *testing.T) {
+	expected := `# HELP twiggit_worktrees_total Number of worktrees of the project, main worktree excluded.
+# TYPE twiggit_worktrees_total gauge
+twiggit_worktrees_total{project="api"} 2
+twiggit_worktrees_total{project="web"} 1
+# HELP twiggit_worktrees_dirty Number of worktrees of the project with uncommitted changes.
+# TYPE twiggit_worktrees_dirty gauge
+twiggit_worktrees_dirty{project="api"} 1
+twiggit_worktrees_dirty{project="web"} 0
+# HELP twiggit_worktrees_age_seconds Seconds since the HEAD commit of the worktree.
+# TYPE twiggit_worktrees_age_seconds gauge
+twiggit_worktrees_age_seconds{project="api",branch="feature/x"} 86400
+twiggit_worktrees_age_seconds{project="api",branch="odd\"name\\"} 1
+twiggit_worktrees_age_seconds{project="web",branch="fix"} 5400
+`
+	assert.Equal(t, expected, string((&PrometheusFormatter{}).Format(newTestProjectStats())))
+}
+
+func TestPrometheusFormatter_Format_ValidExposition(t *testing.T) {
+	// Each line is a HELP or TYPE comment, or a sample of a declared family:
+	// name{label="escaped value",...} value
+	comment := regexp.MustCompile(`^# (HELP [a-zA-Z_:][a-zA-Z0-9_:]* .+|TYPE [a-zA-Z_:][a-zA-Z0-9_:]* gauge)$`)
+	sample := regexp.MustCompile(`^([a-zA-Z_:][a-zA-Z0-9_:]*)\{([a-zA-Z_][a-zA-Z0-9_]*="(?:[^"\\\n]|\\[\\"n])*"(?:,[a-zA-Z_][a-zA-Z0-9_]*="(?:[^"\\\n]|\\[\\"n])*")*)\} -?[0-9]+$`)
+
+	output := string((&PrometheusFormatter{}).Format(newTestProjectStats()))
+	assert.True(t, strings.HasSuffix(output, "\n"), "the exposition ends with a line feed")
+
+	declared := map[string]bool{}
+	seen := map[string]bool{}
+	for _, line := range strings.Split(strings.TrimSuffix(output, "\n"), "\n") {
+		if strings.HasPrefix(line, "# TYPE ") {
+			declared[strings.Fields(line)[2]] = true
+		}
+		if strings.HasPrefix(line, "#") {
+			assert.Regexp(t, comment, line)
+			continue
+		}
+		match := sample.FindStringSubmatch(line)
+		if assert.NotNil(t, match, "invalid sample %q", line) {
+			assert.True(t, declared[match[1]], "%s sampled before its TYPE line", match[1])
+			series := match[1] + "{" + match[2] + "}"
+			assert.False(t, seen[series], "duplicate series %s", series)
+			seen[series] = true
+		}
+	}
+}
+
+func TestPrometheusFormatter_Format_Empty(t *testing.T) {
+	output := string((&PrometheusFormatter{}).Format(nil))
+	assert.Contains(t, output, "# TYPE twiggit_worktrees_total gauge\n")
+	assert.NotContains(t, output, "{")
+}
@@ -3,9 +3,11 @@ package cmd
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
-	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/carapace-sh/carapace"
 	"github.com/spf13/cobra"
@@ -13,9 +15,23 @@ import (
 	"twiggit/internal/infrastructure"
 )
 
+// pruneOptions holds the flag values for the prune command
+type pruneOptions struct {
+	force           bool
+	yes             bool
+	deleteBranches  bool
+	allProjects     bool
+	dryRun          bool
+	protectPatterns []string
+	olderThan       time.Duration
+	maxDelete       int
+
+	confirmIndividually bool
+}
+
 // NewPruneCommand creates a new prune command for deleting merged worktrees.
 func NewPruneCommand(config *CommandConfig) *cobra.Command {
-	var force, yes, deleteBranches, allProjects, dryRun bool
+	var opts pruneOptions
 
 	cmd := &cobra.Command{
 		Use:   "prune [project/branch]",
@@ -30,29 +46,48 @@ Use flags to customize behavior:
   --yes, -y          Auto-confirm prompts (keeps safety checks)
   --delete-branches  Also delete the corresponding git branches
   --all              Prune across all projects (requires confirmation unless --yes or --force)
+  --protect-pattern  Protect branches matching a glob for this run (repeatable)
+  --older-than       Only prune worktrees whose HEAD commit is older than a duration
+  --max-delete       Abort without deleting if more worktrees than this would be pruned
+  --output json      With --dry-run, print the preview as JSON on stdout
+  --confirm-individually
+                     Ask y/N on stdout before deleting each worktree and read one
+                     character per answer from stdin, so answers can be piped
 
 Examples:
   twiggit prune                       Prune merged worktrees in current project
   twiggit prune --dry-run             Preview what would be deleted
+  twiggit prune --dry-run --output json  Preview as JSON for scripts
   twiggit prune --all                 Prune across all projects
   twiggit prune --all --yes           Prune across all projects without confirmation
   twiggit prune myproject/feature     Prune a specific worktree
-  twiggit prune --delete-branches     Prune and delete branches`,
+  twiggit prune --delete-branches     Prune and delete branches
+  twiggit prune --protect-pattern "release/*"  Keep release worktrees
+  twiggit prune --older-than 720h     Prune only worktrees idle for 30 days
+  twiggit prune --all --max-delete 5  Refuse to prune more than 5 worktrees
+  echo "yny" | twiggit prune --confirm-individually  Delete the first and third candidates`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(c *cobra.Command, args []string) error {
 			var specificWorktree string
 			if len(args) > 0 {
 				specificWorktree = args[0]
 			}
-			return executePrune(c, config, force, yes, deleteBranches, allProjects, dryRun, specificWorktree)
+			if isJSONOutput(config) && !opts.dryRun {
+				return domain.NewValidationError("prune", "output", outputJSON, "--output json requires --dry-run")
+			}
+			return executePrune(c, config, specificWorktree, opts)
 		},
 	}
 
-	cmd.Flags().BoolVarP(&force, "force", "f", false, "Force deletion even with uncommitted changes")
-	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Auto-confirm prompts (keeps safety checks)")
-	cmd.Flags().BoolVarP(&deleteBranches, "delete-branches", "d", false, "Delete branches after worktree removal")
-	cmd.Flags().BoolVarP(&allProjects, "all", "a", false, "Prune across all projects")
-	cmd.Flags().BoolVarP(&dryRun, "dry-run", "n", false, "Preview only, no actual deletion")
+	cmd.Flags().BoolVarP(&opts.force, "force", "f", false, "Force deletion even with uncommitted changes")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Auto-confirm prompts (keeps safety checks)")
+	cmd.Flags().BoolVarP(&opts.deleteBranches, "delete-branches", "d", false, "Delete branches after worktree removal")
+	cmd.Flags().BoolVarP(&opts.allProjects, "all", "a", false, "Prune across all projects")
+	cmd.Flags().BoolVarP(&opts.dryRun, "dry-run", "n", false, "Preview only, no actual deletion")
+	cmd.Flags().StringArrayVar(&opts.protectPatterns, "protect-pattern", nil, "Protect branches matching glob pattern (repeatable)")
+	cmd.Flags().DurationVar(&opts.olderThan, "older-than", 0, "Only prune worktrees not updated within this duration (e.g. 720h)")
+	cmd.Flags().IntVar(&opts.maxDelete, "max-delete", 0, "Abort if more than n worktrees are candidates for pruning (overrides max_delete_default)")
+	cmd.Flags().BoolVar(&opts.confirmIndividually, "confirm-individually", false, "Ask before deleting each worktree, reading y/N answers from stdin")
 
 	carapace.Gen(cmd).PositionalCompletion(
 		actionWorktreeTarget(config, infrastructure.WithExistingOnly()),
@@ -61,7 +96,7 @@ Examples:
 	return cmd
 }
 
-func executePrune(c *cobra.Command, config *CommandConfig, force, yes, deleteBranches, allProjects, dryRun bool, specificWorktree string) error {
+func executePrune(c *cobra.Command, config *CommandConfig, specificWorktree string, opts pruneOptions) error {
 	ctx := context.Background()
 
 	currentCtx, err := config.Services.ContextService.GetCurrentContext()
@@ -69,21 +104,36 @@ func executePrune(c *cobra.Command, config *CommandConfig, force, yes, deleteBra
 		return fmt.Errorf("context detection failed: %w", err)
 	}
 
+	// A project/branch argument is pruned with that project's protected branches
+	if specificWorktree != "" && !opts.allProjects {
+		applyTargetProjectConfig(c, config, strings.Split(specificWorktree, "/")[0])
+	}
+
 	// Build the base request
 	req := &domain.PruneWorktreesRequest{
 		Context:          currentCtx,
-		Force:            force,
-		DeleteBranches:   deleteBranches,
-		AllProjects:      allProjects,
+		Force:            opts.force,
+		DeleteBranches:   opts.deleteBranches,
+		AllProjects:      opts.allProjects,
 		SpecificWorktree: specificWorktree,
+		OlderThan:        opts.olderThan,
+
+		AdditionalProtectedPatterns: opts.protectPatterns,
+	}
+	if c.Flags().Changed("max-delete") {
+		req.MaxDelete = &opts.maxDelete
+	}
+	if opts.confirmIndividually {
+		req.ConfirmIndividually = true
+		req.Confirm = newIndividualConfirmer(terminalOf(config), c.InOrStdin(), c.OutOrStdout())
 	}
 
 	// Create progress reporter for bulk operations
 	quiet := isQuiet(c)
 	reporter := NewProgressReporter(quiet, c.ErrOrStderr())
 
-	// If confirmation needed, show preview first then ask
-	if allProjects && !force && !yes && !dryRun {
+	// If confirmation needed, show preview first then ask; individual confirmations replace it
+	if opts.allProjects && !opts.force && !opts.yes && !opts.dryRun && !opts.confirmIndividually {
 		// Do dry-run first to show preview
 		previewReq := *req
 		previewReq.DryRun = true
@@ -94,7 +144,10 @@ func executePrune(c *cobra.Command, config *CommandConfig, force, yes, deleteBra
 		}
 		outputPruneResults(c, previewResult, true)
 
-		// Now ask for confirmation
+		// Now ask for confirmation; CI runs cannot answer
+		if !allowsPrompts(config) {
+			return errors.New("prune across all projects needs confirmation, which CI cannot give; rerun with --yes")
+		}
 		confirmed, err := confirmBulkPrune(c)
 		if err != nil {
 			return err
@@ -106,20 +159,29 @@ func executePrune(c *cobra.Command, config *CommandConfig, force, yes, deleteBra
 	}
 
 	// Report start of bulk operation
-	if allProjects || specificWorktree == "" {
+	if opts.allProjects || specificWorktree == "" {
 		reporter.Report("Pruning merged worktrees...")
 	}
 
-	req.DryRun = dryRun
+	req.DryRun = opts.dryRun
 	result, err := config.Services.WorktreeService.PruneMergedWorktrees(ctx, req)
 	if err != nil {
 		return fmt.Errorf("prune failed: %w", err)
 	}
 
-	outputPruneResults(c, result, dryRun)
+	if isJSONOutput(config) {
+		return writeJSON(c.OutOrStdout(), &PruneResult{Result: result, DryRun: opts.dryRun})
+	}
+	outputPruneResults(c, result, opts.dryRun)
+
+	if !opts.dryRun {
+		for _, projectName := range prunedProjectNames(result) {
+			cleanStaleLinksAfterDelete(ctx, c, config, projectName, currentCtx)
+		}
+	}
 
 	// Report completion of bulk operation
-	if allProjects || specificWorktree == "" {
+	if opts.allProjects || specificWorktree == "" {
 		reporter.Report("Prune complete")
 	}
 
@@ -130,9 +192,20 @@ func executePrune(c *cobra.Command, config *CommandConfig, force, yes, deleteBra
 	return nil
 }
 
+// prunedProjectNames returns the distinct projects that had worktrees deleted, in result order
+func prunedProjectNames(result *domain.PruneWorktreesResult) []string {
+	var names []string
+	for _, wt := range result.DeletedWorktrees {
+		if wt.Deleted && !slices.Contains(names, wt.ProjectName) {
+			names = append(names, wt.ProjectName)
+		}
+	}
+	return names
+}
+
 func confirmBulkPrune(c *cobra.Command) (bool, error) {
 	_, _ = fmt.Fprint(c.ErrOrStderr(), "This will prune merged worktrees across all projects. Continue? (y/n): ")
-	reader := bufio.NewReader(os.Stdin)
+	reader := bufio.NewReader(c.InOrStdin())
 	response, err := reader.ReadString('\n')
 	if err != nil {
 		return false, fmt.Errorf("failed to read confirmation: %w", err)
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/term"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+// ctrlC is the byte a terminal in raw mode sends for Ctrl-C
+const ctrlC = 0x03
+
+// newIndividualConfirmer returns the confirmation function of prune --confirm-individually.
+// Each call prints a y/N prompt to out and reads one answer character from in. Once in is
+// exhausted or the user presses Ctrl-C, every remaining worktree is declined without asking.
+func newIndividualConfirmer(terminal application.TerminalDetector, in io.Reader, out io.Writer) func(*domain.PruneWorktreeResult) bool {
+	done := false
+	return func(wt *domain.PruneWorktreeResult) bool {
+		if done {
+			return false
+		}
+		_, _ = fmt.Fprintf(out, "Delete %s/%s (%s)? [y/N] ", wt.ProjectName, wt.BranchName, wt.WorktreePath)
+
+		answer, err := readAnswer(terminal, in)
+		if err != nil || answer == ctrlC {
+			done = true
+			_, _ = fmt.Fprintln(out)
+			return false
+		}
+		_, _ = fmt.Fprintf(out, "%c\n", answer)
+		return answer == 'y' || answer == 'Y'
+	}
+}
+
+// readAnswer reads bytes one at a time, without buffering ahead, until it gets one that is
+// not whitespace, so "yny" and "y\nn\ny\n" give the same answers. A terminal is switched to
+// raw mode for the read so the answer does not need Enter; pipes are read as they are.
+func readAnswer(terminal application.TerminalDetector, in io.Reader) (byte, error) {
+	if f, ok := in.(*os.File); ok && terminal.IsTerminal(f.Fd()) {
+		state, err := term.MakeRaw(int(f.Fd()))
+		if err != nil {
+			return 0, fmt.Errorf("failed to read confirmation: %w", err)
+		}
+		defer func() { _ = term.Restore(int(f.Fd()), state) }()
+	}
+
+	buf := make([]byte, 1)
+	for {
+		if _, err := io.ReadFull(in, buf); err != nil {
+			return 0, err
+		}
+		switch buf[0] {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return buf[0], nil
+	}
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestIndividualConfirmer(t *testing.T) {
+	worktrees := []*domain.PruneWorktreeResult{
+		{ProjectName: "proj", BranchName: "feature-a", WorktreePath: "/wt/proj/feature-a"},
+		{ProjectName: "proj", BranchName: "feature-b", WorktreePath: "/wt/proj/feature-b"},
+		{ProjectName: "proj", BranchName: "feature-c", WorktreePath: "/wt/proj/feature-c"},
+	}
+
+	testCases := []struct {
+		name    string
+		input   string
+		answers []bool
+	}{
+		{name: "single characters", input: "yny", answers: []bool{true, false, true}},
+		{name: "one answer per line", input: "y\nn\nY\n", answers: []bool{true, false, true}},
+		{name: "anything but y declines", input: "yxq", answers: []bool{true, false, false}},
+		{name: "exhausted input declines the rest", input: "y\n", answers: []bool{true, false, false}},
+		{name: "ctrl-c declines the rest", input: "\x03yy", answers: []bool{false, false, false}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			confirm := newIndividualConfirmer(mocks.NewCITerminalDetector(), strings.NewReader(tc.input), &out)
+
+			answers := make([]bool, 0, len(worktrees))
+			for _, wt := range worktrees {
+				answers = append(answers, confirm(wt))
+			}
+			assert.Equal(t, tc.answers, answers)
+			assert.Contains(t, out.String(), "Delete proj/feature-a (/wt/proj/feature-a)? [y/N] ")
+		})
+	}
+}
+
+func TestIndividualConfirmer_StopsAskingAfterEOF(t *testing.T) {
+	var out bytes.Buffer
+	confirm := newIndividualConfirmer(mocks.NewCITerminalDetector(), strings.NewReader(""), &out)
+
+	assert.False(t, confirm(&domain.PruneWorktreeResult{ProjectName: "proj", BranchName: "a"}))
+	assert.False(t, confirm(&domain.PruneWorktreeResult{ProjectName: "proj", BranchName: "b"}))
+	assert.Equal(t, 1, strings.Count(out.String(), "[y/N]"))
+}
+
+func TestPruneCommand_ConfirmIndividually(t *testing.T) {
+	ctxSvc := mocks.NewMockContextService()
+	ctxSvc.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj", Path: "/projects/proj"}, nil)
+
+	ws := mocks.NewMockWorktreeService()
+	var answers []bool
+	ws.On("PruneMergedWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.PruneWorktreesRequest) bool {
+		return req.ConfirmIndividually && req.Confirm != nil && !req.DryRun
+	})).Run(func(args mock.Arguments) {
+		req := args.Get(1).(*domain.PruneWorktreesRequest)
+		answers = append(answers,
+			req.Confirm(&domain.PruneWorktreeResult{ProjectName: "proj", BranchName: "feature-a"}),
+			req.Confirm(&domain.PruneWorktreeResult{ProjectName: "proj", BranchName: "feature-b"}))
+	}).Return(&domain.PruneWorktreesResult{}, nil).Once()
+
+	config := &CommandConfig{Services: &ServiceContainer{ContextService: ctxSvc, WorktreeService: ws}}
+	cmd := NewPruneCommand(config)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetIn(strings.NewReader("y\nn\n"))
+	cmd.SetArgs([]string{"--all", "--confirm-individually"})
+
+	require.NoError(t, cmd.Execute())
+	assert.Equal(t, []bool{true, false}, answers)
+	assert.Contains(t, out.String(), "Delete proj/feature-a ()? [y/N] y\n")
+	assert.Contains(t, out.String(), "Delete proj/feature-b ()? [y/N] n\n")
+	ws.AssertExpectations(t)
+}
+
+func TestPruneCommand_BulkConfirmation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		terminal    *mocks.MockTerminalDetector
+		input       string
+		expectError string
+		expectPrune bool
+	}{
+		{name: "confirmed", terminal: mocks.NewInteractiveTerminalDetector(), input: "y\n", expectPrune: true},
+		{name: "declined", terminal: mocks.NewInteractiveTerminalDetector(), input: "n\n"},
+		{name: "no prompt in CI", terminal: mocks.NewCITerminalDetector(), expectError: "rerun with --yes"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctxSvc := mocks.NewMockContextService()
+			ctxSvc.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+			ws := mocks.NewMockWorktreeService()
+			ws.On("PruneMergedWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.PruneWorktreesRequest) bool {
+				return req.DryRun
+			})).Return(&domain.PruneWorktreesResult{}, nil).Once()
+			ws.On("PruneMergedWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.PruneWorktreesRequest) bool {
+				return !req.DryRun
+			})).Return(&domain.PruneWorktreesResult{}, nil).Maybe()
+
+			config := &CommandConfig{Services: &ServiceContainer{ContextService: ctxSvc, WorktreeService: ws}, Terminal: tc.terminal}
+			cmd := NewPruneCommand(config)
+			var stderr bytes.Buffer
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&stderr)
+			cmd.SetIn(strings.NewReader(tc.input))
+			cmd.SetArgs([]string{"--all"})
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				assert.NotContains(t, stderr.String(), "Continue? (y/n)")
+			} else {
+				require.NoError(t, err)
+				assert.Contains(t, stderr.String(), "Continue? (y/n)")
+			}
+			pruned := 0
+			for _, call := range ws.Calls {
+				if !call.Arguments.Get(1).(*domain.PruneWorktreesRequest).DryRun {
+					pruned++
+				}
+			}
+			assert.Equal(t, tc.expectPrune, pruned == 1)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestPrunedProjectNames(t *testing.T) {
+	result := &domain.PruneWorktreesResult{
+		DeletedWorktrees: []*domain.PruneWorktreeResult{
+			{ProjectName: "proj-b", Deleted: true},
+			{ProjectName: "proj-a", Deleted: true},
+			{ProjectName: "proj-b", Deleted: true},
+			{ProjectName: "proj-c"},
+		},
+	}
+
+	assert.Equal(t, []string{"proj-b", "proj-a"}, prunedProjectNames(result))
+	assert.Empty(t, prunedProjectNames(&domain.PruneWorktreesResult{}))
+}
+
+func TestPruneCommand_JSON(t *testing.T) {
+	projectCtx := &domain.Context{Type: domain.ContextProject, ProjectName: "proj", Path: "/repos/proj"}
+
+	t.Run("dry run", func(t *testing.T) {
+		ws := mocks.NewMockWorktreeService()
+		cs := mocks.NewMockContextService()
+		cs.On("GetCurrentContext").Return(projectCtx, nil)
+		ws.On("PruneMergedWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.PruneWorktreesRequest) bool {
+			return req.DryRun
+		})).Return(&domain.PruneWorktreesResult{
+			DeletedWorktrees: []*domain.PruneWorktreeResult{{ProjectName: "proj", BranchName: "feature-a", WorktreePath: "/wt/proj/feature-a"}},
+			ProtectedSkipped: []*domain.PruneWorktreeResult{{ProjectName: "proj", BranchName: "main", SkipReason: "protected branch"}},
+			TotalDeleted:     1,
+			TotalSkipped:     1,
+		}, nil).Once()
+
+		config := &CommandConfig{
+			Config:   domain.DefaultConfig(),
+			Services: &ServiceContainer{WorktreeService: ws, ContextService: cs},
+			Output:   outputJSON,
+		}
+		cmd := NewPruneCommand(config)
+		var out, errOut bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetErr(&errOut)
+		cmd.SetArgs([]string{"--dry-run"})
+
+		require.NoError(t, cmd.Execute())
+		require.True(t, json.Valid(out.Bytes()), "invalid JSON: %s", out.String())
+		assert.JSONEq(t, `{"dry_run":true,
+			"deleted":[{"project":"proj","branch":"feature-a","path":"/wt/proj/feature-a","branch_deleted":false}],
+			"skipped":[],
+			"protected_skipped":[{"project":"proj","branch":"main","path":"","branch_deleted":false,"skip_reason":"protected branch"}],
+			"unmerged_skipped":[],"current_skipped":[],
+			"totals":{"deleted":1,"skipped":1,"branches_deleted":0}}`, out.String())
+		assert.NotContains(t, errOut.String(), "Would delete")
+		ws.AssertExpectations(t)
+	})
+
+	t.Run("requires dry run", func(t *testing.T) {
+		ws := mocks.NewMockWorktreeService()
+		config := &CommandConfig{
+			Config:   domain.DefaultConfig(),
+			Services: &ServiceContainer{WorktreeService: ws, ContextService: mocks.NewMockContextService()},
+			Output:   outputJSON,
+		}
+		cmd := NewPruneCommand(config)
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs([]string{})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--output json requires --dry-run")
+		ws.AssertNotCalled(t, "PruneMergedWorktrees", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// NewPSCommand creates a new ps command
+func NewPSCommand(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ps",
+		Short: "List background processes started for worktrees",
+		Long: `List running background processes that twiggit started for worktrees,
+with the worktree each process belongs to. Stop them with 'twiggit kill'.
+
+Examples:
+  twiggit ps`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executePS(cmd, config)
+		},
+	}
+
+	return cmd
+}
+
+// executePS executes the ps command with the given configuration
+func executePS(cmd *cobra.Command, config *CommandConfig) error {
+	processes, err := config.Services.ProcessManager.List()
+	if err != nil {
+		return fmt.Errorf("failed to list processes: %w", err)
+	}
+
+	out := cmd.OutOrStdout()
+	if len(processes) == 0 {
+		_, _ = fmt.Fprintln(out, "No running processes")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "PID\tWORKTREE\tSTARTED\tCOMMAND")
+	for _, proc := range processes {
+		_, _ = fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", proc.PID, proc.WorktreePath, proc.StartedAt.Format(time.DateTime), proc.Command)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestPSCommand_Execute(t *testing.T) {
+	started := time.Date(2025, 3, 1, 9, 30, 0, 0, time.Local)
+
+	testCases := []struct {
+		name        string
+		processes   []domain.ManagedProcess
+		listErr     error
+		expectError string
+		expectOut   []string
+	}{
+		{
+			name: "lists processes with worktrees",
+			processes: []domain.ManagedProcess{
+				{PID: 101, Command: "npm run dev", WorktreePath: "/wt/proj/feature", StartedAt: started},
+			},
+			expectOut: []string{"PID", "WORKTREE", "101", "/wt/proj/feature", "2025-03-01 09:30:00", "npm run dev"},
+		},
+		{
+			name:      "no processes",
+			processes: []domain.ManagedProcess{},
+			expectOut: []string{"No running processes"},
+		},
+		{
+			name:        "list failure",
+			listErr:     errors.New("corrupt"),
+			expectError: "failed to list processes",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pm := mocks.NewMockProcessManager()
+			pm.On("List").Return(tc.processes, tc.listErr)
+
+			cmd := NewPSCommand(&CommandConfig{Services: &ServiceContainer{ProcessManager: pm}})
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetArgs([]string{})
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+		})
+	}
+}
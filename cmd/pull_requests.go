@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// annotatePullRequests sets PRInfo on each worktree from the hosting provider of its
+// project's origin remote. Lookups are best-effort: a worktree whose project has no
+// supported remote, or whose lookup fails, keeps a nil PRInfo.
+func annotatePullRequests(ctx context.Context, cmd *cobra.Command, config *CommandConfig, currentCtx *domain.Context, worktrees []*domain.WorktreeInfo) {
+	projects := map[string]*domain.ProjectInfo{}
+	for _, wt := range worktrees {
+		if wt.IsDetached || wt.Branch == "" {
+			continue
+		}
+
+		projectName := wt.Project
+		if projectName == "" {
+			projectName = currentCtx.ProjectName
+		}
+		project, seen := projects[projectName]
+		if !seen {
+			discovered, err := config.Services.ProjectService.DiscoverProject(ctx, projectName, currentCtx)
+			if err != nil {
+				logv(cmd, 1, "Skipping pull requests of %s: %v", projectName, err)
+			}
+			project, projects[projectName] = discovered, discovered
+		}
+		if project == nil {
+			continue
+		}
+
+		pr, err := findPullRequest(ctx, config, project, wt.Branch)
+		if err != nil {
+			logv(cmd, 1, "No pull request for %s: %v", wt.Branch, err)
+			continue
+		}
+		wt.PRInfo = pr
+	}
+}
+
+// findPullRequest looks up the pull request of branch on the project's origin remote
+func findPullRequest(ctx context.Context, config *CommandConfig, project *domain.ProjectInfo, branch string) (*domain.OpenPR, error) {
+	remote := ciRemote(project)
+	if remote == nil {
+		return nil, fmt.Errorf("project %s has no remote", project.Name)
+	}
+	host, owner, repo, ok := domain.ParseRemoteRepository(remote.FetchURL)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a hosted repository URL", remote.FetchURL)
+	}
+	authHost, err := domain.NormalizeAuthHost(host)
+	if err != nil {
+		return nil, err
+	}
+	finder := config.Services.PullRequestFinders[authHost]
+	if finder == nil {
+		return nil, fmt.Errorf("no pull request finder for %s", authHost)
+	}
+	return finder.FindPullRequest(ctx, owner, repo, branch)
+}
+
+// formatPullRequest renders PRInfo for the list table, "(no PR)" when there is none
+func formatPullRequest(pr *domain.OpenPR) string {
+	if pr == nil {
+		return "(no PR)"
+	}
+	return pr.Summary()
+}
@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestListCommand_WithPR(t *testing.T) {
+	githubRemote := []*domain.RemoteInfo{{Name: "origin", FetchURL: "git@github.com:acme/api.git"}}
+	worktrees := func() []*domain.WorktreeInfo {
+		return []*domain.WorktreeInfo{
+			{Path: "/wt/proj/feature-a", Branch: "feature-a", Project: "proj"},
+			{Path: "/wt/proj/feature-b", Branch: "feature-b", Project: "proj"},
+			{Path: "/wt/proj/broken", Branch: "broken", Project: "proj"},
+			{Path: "/wt/proj/detached", Branch: "(detached)", Project: "proj", IsDetached: true},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		args       []string
+		remotes    []*domain.RemoteInfo
+		setupPRs   func(*mocks.MockPullRequestFinder)
+		expectOut  []string
+		expectNone []string
+	}{
+		{
+			name:    "annotates each branch",
+			args:    []string{"--with-pr"},
+			remotes: githubRemote,
+			setupPRs: func(f *mocks.MockPullRequestFinder) {
+				f.On("FindPullRequest", mock.Anything, "acme", "api", "feature-a").
+					Return(&domain.OpenPR{Number: 42, Title: "Add the new login flow with single sign-on support", State: domain.PRStateDraft}, nil)
+				f.On("FindPullRequest", mock.Anything, "acme", "api", "feature-b").Return(nil, nil)
+				f.On("FindPullRequest", mock.Anything, "acme", "api", "broken").Return(nil, errors.New("rate limited"))
+			},
+			expectOut: []string{
+				"feature-a -> /wt/proj/feature-a [#42 Add the new login flow with single sign… (draft)]",
+				"feature-b -> /wt/proj/feature-b [(no PR)]",
+				"broken -> /wt/proj/broken [(no PR)]",
+				"(detached) -> /wt/proj/detached (detached)\n",
+			},
+		},
+		{
+			name:      "project without a hosted remote",
+			args:      []string{"--with-pr"},
+			remotes:   []*domain.RemoteInfo{{Name: "origin", FetchURL: "/srv/git/api.git"}},
+			setupPRs:  func(_ *mocks.MockPullRequestFinder) {},
+			expectOut: []string{"feature-a -> /wt/proj/feature-a [(no PR)]"},
+		},
+		{
+			name:       "without the flag",
+			args:       []string{},
+			remotes:    githubRemote,
+			setupPRs:   func(_ *mocks.MockPullRequestFinder) {},
+			expectNone: []string{"no PR"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+			finder := mocks.NewMockPullRequestFinder()
+			tc.setupPRs(finder)
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).
+				Return(&domain.ProjectInfo{Name: "proj", GitRepoPath: "/repos/proj", Remotes: tc.remotes}, nil).Maybe()
+			mockWS.On("ListWorktrees", mock.Anything, mock.AnythingOfType("*domain.ListWorktreesRequest")).Return(worktrees(), nil)
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{
+					WorktreeService:    mockWS,
+					ContextService:     mockCS,
+					ProjectService:     mockPS,
+					PullRequestFinders: map[string]application.PullRequestFinder{domain.AuthHostGitHub: finder},
+				},
+				Config: domain.DefaultConfig(),
+			}
+
+			cmd := NewListCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			require.NoError(t, cmd.Execute())
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+			for _, unexpected := range tc.expectNone {
+				assert.NotContains(t, out.String(), unexpected)
+			}
+			finder.AssertExpectations(t)
+			if len(tc.args) > 0 {
+				mockPS.AssertNumberOfCalls(t, "DiscoverProject", 1) // Once per project, not per worktree
+			} else {
+				mockPS.AssertNotCalled(t, "DiscoverProject", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestJSONFormatter_PullRequest(t *testing.T) {
+	output := (&JSONFormatter{}).FormatWorktrees([]*domain.WorktreeInfo{
+		{Path: "/wt/a", Branch: "a", PRInfo: &domain.OpenPR{Number: 7, Title: "A long title that JSON keeps in full", State: domain.PRStateOpen, URL: "https://github.com/acme/api/pull/7"}},
+		{Path: "/wt/b", Branch: "b"},
+	})
+
+	var list WorktreeListJSON
+	require.NoError(t, json.Unmarshal([]byte(output), &list))
+	assert.Equal(t, &PullRequestJSON{Number: 7, Title: "A long title that JSON keeps in full", State: "open", URL: "https://github.com/acme/api/pull/7"}, list.Worktrees[0].PullRequest)
+	assert.Nil(t, list.Worktrees[1].PullRequest)
+}
+
+func TestListCommand_WithPRRejectsCount(t *testing.T) {
+	cmd := NewListCommand(&CommandConfig{Services: &ServiceContainer{}})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--count", "--with-pr"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--count cannot be combined with --with-pr")
+}
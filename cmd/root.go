@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
+	"path/filepath"
 
 	"github.com/carapace-sh/carapace"
 	"github.com/spf13/cobra"
@@ -14,15 +16,47 @@ import (
 type CommandConfig struct {
 	Services *ServiceContainer
 	Config   *domain.Config
+	Terminal application.TerminalDetector // TTY, color and CI detection; nil reads the process environment
+	Output   string                       // Output format from the root --output flag: "text" or "json"
+
+	// ConfigErr is the failure to load the config file; Config then holds the defaults and only
+	// commands annotated with allowInvalidConfig run, the others fail with ConfigErr
+	ConfigErr error
+
+	projectConfigPath string         // Repository whose .twiggit.toml was last applied to Config
+	globalConfig      *domain.Config // Config before any project settings were applied
 }
 
+// allowInvalidConfig is the annotation of commands that run when the config file fails to load,
+// so doctor can report the problem and config init --force can replace the file
+const allowInvalidConfig = "twiggit:allow-invalid-config"
+
 // ServiceContainer holds all service dependencies for commands
 type ServiceContainer struct {
-	WorktreeService   application.WorktreeService
-	ProjectService    application.ProjectService
-	NavigationService application.NavigationService
-	ContextService    application.ContextService
-	ShellService      application.ShellService
+	WorktreeService    application.WorktreeService
+	ProjectService     application.ProjectService
+	NavigationService  application.NavigationService
+	ContextService     application.ContextService
+	ShellService       application.ShellService
+	Initializer        application.Initializer
+	LinkRegistry       application.LinkRegistry
+	ProcessManager     application.ProcessManager
+	EditorLauncher     application.EditorLauncher
+	EnvInheritor       application.EnvInheritor
+	CommandRunner      application.CommandRunner
+	EphemeralRegistry  application.EphemeralRegistry
+	TokenStore         application.TokenStore
+	TokenValidator     application.TokenValidator
+	CIWatchers         map[string]application.CIWatcher         // Keyed by auth host (domain.AuthHostGitHub, domain.AuthHostGitLab)
+	PullRequestFinders map[string]application.PullRequestFinder // Keyed by auth host, like CIWatchers
+	HookRunner         application.HookRunner
+	ChangeWatcher      application.ChangeWatcher
+	NetworkChecker     application.NetworkChecker
+	ConfigManager      application.ConfigManager
+	HookCopier         application.HookCopier
+	WorktreeArchiver   application.WorktreeArchiver
+	ProjectSettings    application.ProjectSettingsStore
+	CompletionCache    application.CompletionCache // Project/branch pairs of __complete-worktrees; nil disables caching
 }
 
 // NewRootCommand creates a new root command with the given configuration
@@ -33,10 +67,21 @@ func NewRootCommand(config *CommandConfig) *cobra.Command {
 		Long: `twiggit is a pragmatic tool for managing git worktrees with a focus on rebase workflows.
 It provides context-aware operations for creating, listing, navigating, and deleting worktrees
 across multiple projects.`,
-		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
 			if config == nil || config.Config == nil {
 				return errors.New("cmd: configuration not loaded")
 			}
+			if config.ConfigErr != nil {
+				if cmd.Annotations[allowInvalidConfig] == "" {
+					cmd.SilenceUsage = true
+					return config.ConfigErr
+				}
+				return applyOutputFlag(cmd, config)
+			}
+			if err := applyOutputFlag(cmd, config); err != nil {
+				return err
+			}
+			applyProjectConfig(cmd, config)
 			return nil
 		},
 	}
@@ -47,14 +92,47 @@ across multiple projects.`,
 	// Add persistent quiet flag
 	cmd.PersistentFlags().BoolP("quiet", "q", false, "Suppress non-essential output")
 
+	// Add persistent output format flag, read by commands through CommandConfig.Output
+	cmd.PersistentFlags().StringP("output", "o", outputText, "Output format of list and status commands (text or json)")
+
+	// Add persistent flag skipping the connectivity check before remote operations
+	cmd.PersistentFlags().Bool("no-network-check", false, "Skip the connectivity check before fetching from remotes")
+
 	// Add subcommands
 	cmd.AddCommand(NewListCommand(config))
 	cmd.AddCommand(NewCreateCommand(config))
 	cmd.AddCommand(NewDeleteCommand(config))
 	cmd.AddCommand(NewPruneCommand(config))
 	cmd.AddCommand(NewCDCommand(config))
+	cmd.AddCommand(NewSwitchCommand(config))
+	cmd.AddCommand(NewBackCommand(config))
+	cmd.AddCommand(NewHistoryCommand(config))
+	cmd.AddCommand(NewCloneCommand(config))
+	cmd.AddCommand(NewExportCommand(config))
+	cmd.AddCommand(NewStatusCommand(config))
+	cmd.AddCommand(NewStatsCommand(config))
+	cmd.AddCommand(NewCompareCommand(config))
+	cmd.AddCommand(NewTimelineCommand(config))
+	cmd.AddCommand(NewDescribeCommand(config))
+	cmd.AddCommand(NewConflictsCommand(config))
+	cmd.AddCommand(NewStashCommand(config))
+	cmd.AddCommand(NewGCCommand(config))
+	cmd.AddCommand(NewKillCommand(config))
+	cmd.AddCommand(NewPSCommand(config))
+	cmd.AddCommand(NewWorktreesCommand(config))
+	cmd.AddCommand(NewDoctorCommand(config))
+	cmd.AddCommand(NewEphemeralCommand(config))
+	cmd.AddCommand(NewAuthCommand(config))
+	cmd.AddCommand(NewProjectCommand(config))
+	cmd.AddCommand(NewConfigCommand(config))
+	cmd.AddCommand(NewFetchCommand(config))
+	cmd.AddCommand(NewSyncCommand(config))
+	cmd.AddCommand(newFetchTagsInternalCmd(config))
+	cmd.AddCommand(newPruneTagsInternalCmd(config))
+	cmd.AddCommand(newCompleteWorktreesInternalCmd(config))
 	cmd.AddCommand(NewInitCmd(config))
 	cmd.AddCommand(NewVersionCommand(config))
+	cmd.AddCommand(NewSchemaCommand(config))
 
 	carapace.Gen(cmd)
 
@@ -66,3 +144,66 @@ across multiple projects.`,
 
 	return cmd
 }
+
+// applyProjectConfig replaces the configuration with the one of the project the command runs
+// in, so that the settings of its .twiggit.toml apply to every service sharing config.Config.
+// An unusable .twiggit.toml is reported on stderr and the global configuration kept.
+func applyProjectConfig(cmd *cobra.Command, config *CommandConfig) {
+	if config.Services == nil || config.Services.ConfigManager == nil || config.Services.ContextService == nil {
+		return
+	}
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil || currentCtx.ProjectName == "" {
+		return
+	}
+
+	var repoPath string
+	switch currentCtx.Type {
+	case domain.ContextProject:
+		repoPath = currentCtx.Path
+	case domain.ContextWorktree:
+		repoPath = filepath.Join(config.Config.ProjectsDirectory, currentCtx.ProjectName)
+	default:
+		return
+	}
+	loadProjectConfig(cmd, config, currentCtx.ProjectName, repoPath)
+}
+
+// applyTargetProjectConfig applies the .twiggit.toml of the project a command targets by argument,
+// such as 'create other/feature' run outside other, in place of the current project's settings.
+// Nothing changes when it is the project whose settings are already applied.
+func applyTargetProjectConfig(cmd *cobra.Command, config *CommandConfig, projectName string) {
+	if config.Services == nil || config.Services.ConfigManager == nil || config.Config == nil || projectName == "" {
+		return
+	}
+	repoPath := filepath.Join(config.Config.ProjectsDirectory, projectName)
+	if repoPath == config.projectConfigPath {
+		return
+	}
+	loadProjectConfig(cmd, config, projectName, repoPath)
+}
+
+// loadProjectConfig replaces config.Config with the global configuration layered with the
+// .twiggit.toml of repoPath. On error it warns and restores the global configuration.
+func loadProjectConfig(cmd *cobra.Command, config *CommandConfig, projectName, repoPath string) {
+	if config.globalConfig == nil {
+		global := *config.Config
+		config.globalConfig = &global
+	}
+	config.projectConfigPath = repoPath
+
+	projectConfig, err := config.Services.ConfigManager.LoadForProject(repoPath)
+	if err != nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Warning: ignoring the settings of %s's .twiggit.toml: %v\n", projectName, err)
+		var validationErr *domain.ValidationError
+		if errors.As(err, &validationErr) {
+			for _, problem := range validationErr.Suggestions() {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "  %s\n", problem)
+			}
+		}
+		*config.Config = *config.globalConfig
+		return
+	}
+	logv(cmd, 2, "Applied project settings from %s", repoPath)
+	*config.Config = *projectConfig
+}
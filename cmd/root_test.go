@@ -1,10 +1,19 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
 )
 
 func TestRootCommand_BasicProperties(t *testing.T) {
@@ -28,3 +37,250 @@ func TestRootCommand_CarapaceValidation(t *testing.T) {
 
 	carapace.Test(t)
 }
+
+func TestRootCommand_OutputFlag(t *testing.T) {
+	t.Run("propagates json to the command", func(t *testing.T) {
+		ps := mocks.NewMockProjectService()
+		ps.On("ListProjectSummaries", mock.Anything).Return([]*domain.ProjectSummary{{Name: "alpha", GitRepoPath: "/projects/alpha"}}, nil)
+		config := &CommandConfig{Config: domain.DefaultConfig(), Services: &ServiceContainer{ProjectService: ps}}
+		rootCmd := NewRootCommand(config)
+		var out bytes.Buffer
+		rootCmd.SetOut(&out)
+		rootCmd.SetErr(&bytes.Buffer{})
+		rootCmd.SetArgs([]string{"project", "list", "--output", "json"})
+
+		require.NoError(t, rootCmd.Execute())
+		assert.Equal(t, outputJSON, config.Output)
+		assert.True(t, json.Valid(out.Bytes()), "invalid JSON: %s", out.String())
+	})
+
+	t.Run("rejects unknown formats", func(t *testing.T) {
+		config := &CommandConfig{Config: domain.DefaultConfig(), Services: &ServiceContainer{}}
+		rootCmd := NewRootCommand(config)
+		rootCmd.SetOut(&bytes.Buffer{})
+		rootCmd.SetErr(&bytes.Buffer{})
+		rootCmd.SetArgs([]string{"status", "-o", "yaml"})
+
+		err := rootCmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be 'text' or 'json'")
+	})
+}
+
+func TestRootCommand_ProjectConfig(t *testing.T) {
+	testCases := []struct {
+		name          string
+		context       *domain.Context
+		repoPath      string // Expected LoadForProject argument, "" when it must not be called
+		loadErr       error
+		expectSource  string
+		expectWarning string
+	}{
+		{
+			name:         "worktree context applies the project settings",
+			context:      &domain.Context{Type: domain.ContextWorktree, ProjectName: "api", BranchName: "feature", Path: "/worktrees/api/feature"},
+			repoPath:     "/projects/api",
+			expectSource: "develop",
+		},
+		{
+			name:         "project context reads the project root",
+			context:      &domain.Context{Type: domain.ContextProject, ProjectName: "api", Path: "/projects/api"},
+			repoPath:     "/projects/api",
+			expectSource: "develop",
+		},
+		{
+			name:         "outside git keeps the global config",
+			context:      &domain.Context{Type: domain.ContextOutsideGit, Path: "/tmp"},
+			expectSource: "main",
+		},
+		{
+			name:     "invalid project settings are a warning",
+			context:  &domain.Context{Type: domain.ContextProject, ProjectName: "api", Path: "/projects/api"},
+			repoPath: "/projects/api",
+			loadErr: domain.NewConfigError("/projects/api/.twiggit.toml", "validation failed",
+				domain.NewValidationError("Config.Validate", "validation", "", "config validation failed").
+					WithSuggestions([]string{"max_behind_commits cannot be negative"})),
+			expectSource:  "main",
+			expectWarning: "Warning: ignoring the settings of api's .twiggit.toml: config error for /projects/api/.twiggit.toml: validation failed\n  max_behind_commits cannot be negative\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			global := domain.DefaultConfig()
+			global.ProjectsDirectory = "/projects"
+			cs := mocks.NewMockContextService()
+			cs.On("GetCurrentContext").Return(tc.context, nil)
+			cm := mocks.NewMockConfigManager()
+			if tc.repoPath != "" {
+				projectConfig := *global
+				projectConfig.DefaultSourceBranch = "develop"
+				if tc.loadErr != nil {
+					cm.On("LoadForProject", tc.repoPath).Return(nil, tc.loadErr)
+				} else {
+					cm.On("LoadForProject", tc.repoPath).Return(&projectConfig, nil)
+				}
+			}
+
+			config := &CommandConfig{Config: global, Services: &ServiceContainer{ContextService: cs, ConfigManager: cm}}
+			rootCmd := NewRootCommand(config)
+			var errOut bytes.Buffer
+			rootCmd.SetOut(&bytes.Buffer{})
+			rootCmd.SetErr(&errOut)
+			rootCmd.SetArgs([]string{"version"})
+
+			require.NoError(t, rootCmd.Execute())
+			assert.Equal(t, tc.expectSource, config.Config.DefaultSourceBranch)
+			assert.Same(t, global, config.Config, "services share the config pointer")
+			assert.Equal(t, tc.expectWarning, errOut.String())
+			cm.AssertExpectations(t)
+		})
+	}
+}
+
+func TestApplyTargetProjectConfig(t *testing.T) {
+	// LoadForProject keeps projects_dir global
+	apiConfig := domain.DefaultConfig()
+	apiConfig.ProjectsDirectory = "/projects"
+	apiConfig.DefaultSourceBranch = "develop"
+	webConfig := domain.DefaultConfig()
+	webConfig.ProjectsDirectory = "/projects"
+	webConfig.DefaultSourceBranch = "trunk"
+	webConfig.Validation.ProtectedBranches = []string{"main", "release/*"}
+
+	testCases := []struct {
+		name          string
+		target        string
+		loadErr       error
+		expectSource  string
+		expectLoad    bool
+		expectWarning string
+	}{
+		{
+			name:         "another project replaces the current one's settings",
+			target:       "web",
+			expectSource: "trunk",
+			expectLoad:   true,
+		},
+		{
+			name:         "the current project is not loaded again",
+			target:       "api",
+			expectSource: "develop",
+		},
+		{
+			name:          "invalid target settings fall back to the global config",
+			target:        "web",
+			loadErr:       domain.NewConfigError("/projects/web/.twiggit.toml", "failed to parse config file", errors.New("expected '='")),
+			expectSource:  "main",
+			expectLoad:    true,
+			expectWarning: "Warning: ignoring the settings of web's .twiggit.toml: ",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			global := domain.DefaultConfig()
+			global.ProjectsDirectory = "/projects"
+			cs := mocks.NewMockContextService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "api", Path: "/projects/api"}, nil)
+			cm := mocks.NewMockConfigManager()
+			cm.On("LoadForProject", "/projects/api").Return(apiConfig, nil).Once()
+			if tc.loadErr != nil {
+				cm.On("LoadForProject", "/projects/web").Return(nil, tc.loadErr).Once()
+			} else {
+				cm.On("LoadForProject", "/projects/web").Return(webConfig, nil).Once()
+			}
+
+			config := &CommandConfig{Config: global, Services: &ServiceContainer{ContextService: cs, ConfigManager: cm}}
+			cmd := &cobra.Command{}
+			var errOut bytes.Buffer
+			cmd.SetErr(&errOut)
+			applyProjectConfig(cmd, config)
+			require.Equal(t, "develop", config.Config.DefaultSourceBranch)
+
+			applyTargetProjectConfig(cmd, config, tc.target)
+			assert.Equal(t, tc.expectSource, config.Config.DefaultSourceBranch)
+			assert.Equal(t, tc.expectSource == "trunk", config.Config.IsProtectedBranch("release/1.0"), "protected branches follow the applied project")
+			assert.Same(t, global, config.Config, "services share the config pointer")
+			if tc.expectLoad {
+				cm.AssertCalled(t, "LoadForProject", "/projects/web")
+			} else {
+				cm.AssertNotCalled(t, "LoadForProject", "/projects/web")
+			}
+			if tc.expectWarning != "" {
+				assert.Contains(t, errOut.String(), tc.expectWarning)
+			} else {
+				assert.Empty(t, errOut.String())
+			}
+		})
+	}
+}
+
+func TestRootCommand_InvalidConfig(t *testing.T) {
+	configErr := domain.NewConfigError("/cfg/config.toml", "failed to parse config file", errors.New("expected '='"))
+	project := &domain.ProjectInfo{Name: "api", GitRepoPath: "/projects/api"}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectError string
+		expectOut   string
+	}{
+		{
+			name:        "other commands fail with the load error",
+			args:        []string{"version"},
+			expectError: "failed to parse config file",
+		},
+		{
+			name:        "doctor runs and reports the load error",
+			args:        []string{"doctor", "api"},
+			expectError: "found 1 error(s)",
+			expectOut:   "ERROR   config: ",
+		},
+		{
+			name:      "config set can repair the file",
+			args:      []string{"config", "set", "default_sort", ""},
+			expectOut: "Removed default_sort",
+		},
+		{
+			name:      "config get shows the default",
+			args:      []string{"config", "get", "default_source_branch"},
+			expectOut: "main\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := mocks.NewMockContextService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "api", Path: "/projects/api"}, nil)
+			ps := mocks.NewMockProjectService()
+			ps.On("DiscoverProject", mock.Anything, "api", mock.Anything).Return(project, nil)
+			ws := mocks.NewMockWorktreeService()
+			expectHealthyDoctorProject(ws, project)
+			cm := mocks.NewMockConfigManager()
+			cm.On("Load").Return(nil, configErr)
+			cm.On("SetValue", "default_sort", "").Return(nil)
+
+			config := &CommandConfig{
+				Config:    domain.DefaultConfig(),
+				ConfigErr: configErr,
+				Services:  &ServiceContainer{ContextService: cs, ProjectService: ps, WorktreeService: ws, ConfigManager: cm},
+			}
+			rootCmd := NewRootCommand(config)
+			var out bytes.Buffer
+			rootCmd.SetOut(&out)
+			rootCmd.SetErr(&bytes.Buffer{})
+			rootCmd.SetArgs(tc.args)
+
+			err := rootCmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Contains(t, out.String(), tc.expectOut)
+			cm.AssertNotCalled(t, "LoadForProject", mock.Anything)
+		})
+	}
+}
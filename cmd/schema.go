@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/infrastructure"
+)
+
+// NewSchemaCommand creates the hidden schema command that prints the JSON Schema of config.toml
+func NewSchemaCommand(_ *CommandConfig) *cobra.Command {
+	var project bool
+
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of config.toml",
+		Long: `Print a JSON Schema describing every key of config.toml, for editors that
+validate and complete TOML files (e.g. taplo, Even Better TOML).
+
+With --project, print the schema of a project's .twiggit.toml instead: the
+keys of config.toml a project can override, default_worktree and the hooks.
+
+Examples:
+  twiggit schema > ~/.config/twiggit/schema.json
+  twiggit schema --project > ~/.config/twiggit/project-schema.json`,
+		Hidden: true,
+		Args:   cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			generate := infrastructure.GenerateJSONSchema
+			if project {
+				generate = infrastructure.GenerateProjectJSONSchema
+			}
+			schema, err := generate()
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprint(cmd.OutOrStdout(), string(schema))
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&project, "project", false, "Print the schema of a project's .twiggit.toml")
+
+	return cmd
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaCommand(t *testing.T) {
+	cmd := NewSchemaCommand(&CommandConfig{})
+	cmd.SetArgs([]string{})
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	require.NoError(t, cmd.Execute())
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &schema))
+	assert.Equal(t, "object", schema["type"])
+	assert.Contains(t, schema["properties"], "default_source_branch")
+	assert.True(t, cmd.Hidden)
+}
+
+func TestSchemaCommand_Project(t *testing.T) {
+	cmd := NewSchemaCommand(&CommandConfig{})
+	cmd.SetArgs([]string{"--project"})
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+
+	require.NoError(t, cmd.Execute())
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &schema))
+	properties := schema["properties"].(map[string]any)
+	assert.Contains(t, properties, "default_source_branch")
+	assert.Contains(t, properties, "default_worktree")
+	assert.Contains(t, properties, "hooks")
+	assert.NotContains(t, properties, "projects_dir")
+	assert.NotContains(t, properties, "presets")
+}
@@ -0,0 +1,197 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// NewStashCommand creates the stash command group, which runs git stash in the current worktree
+func NewStashCommand(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stash",
+		Short: "Stash changes of the current worktree",
+		Long: `Run git stash in the worktree or project of the current directory, from
+anywhere below it.
+
+All worktrees of a project share one stash: an entry pushed in one worktree
+can be popped in another. 'stash list' shows the branch each entry was
+stashed on. Entries are given as n or stash@{n} and default to the newest.
+
+Examples:
+  twiggit stash push -m "half-done refactor"  Stash changes, untracked files included
+  twiggit stash list                          List entries with their branch
+  twiggit stash list --output json            The same as JSON
+  twiggit stash pop                           Apply stash@{0} here and drop it
+  twiggit stash drop 2                        Drop stash@{2}`,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newStashPushCmd(config))
+	cmd.AddCommand(newStashPopCmd(config))
+	cmd.AddCommand(newStashListCmd(config))
+	cmd.AddCommand(newStashDropCmd(config))
+
+	return cmd
+}
+
+func newStashPushCmd(config *CommandConfig) *cobra.Command {
+	var message string
+
+	cmd := &cobra.Command{
+		Use:   "push",
+		Short: "Stash the uncommitted changes of the current worktree",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := context.Background()
+			worktreePath, err := currentStashWorktree(config)
+			if err != nil {
+				return err
+			}
+			if err := config.Services.WorktreeService.PushStash(ctx, worktreePath, message); err != nil {
+				return err
+			}
+			if !isQuiet(cmd) {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Stashed changes of %s as %s\n", worktreePath, domain.StashRef(0))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&message, "message", "m", "", "Stash message (default: git's \"WIP on <branch>\")")
+
+	return cmd
+}
+
+func newStashPopCmd(config *CommandConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pop [n|stash@{n}]",
+		Short: "Apply a stash entry to the current worktree and drop it",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			index, err := parseStashArg(args)
+			if err != nil {
+				return err
+			}
+			worktreePath, err := currentStashWorktree(config)
+			if err != nil {
+				return err
+			}
+
+			ref := domain.StashRef(index)
+			conflicts, err := config.Services.WorktreeService.PopStash(ctx, worktreePath, index)
+			if err != nil {
+				return err
+			}
+			if len(conflicts) > 0 {
+				displayConflicts(cmd.OutOrStdout(), conflicts)
+				return fmt.Errorf("%s applied with conflicts and was kept; resolve them, then run 'twiggit stash drop %d'", ref, index)
+			}
+			if !isQuiet(cmd) {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Applied and dropped %s\n", ref)
+			}
+			return nil
+		},
+	}
+}
+
+func newStashListCmd(config *CommandConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List stash entries with the branch they were stashed on",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			worktreePath, err := currentStashWorktree(config)
+			if err != nil {
+				return err
+			}
+			entries, err := config.Services.WorktreeService.ListStashes(context.Background(), worktreePath)
+			if err != nil {
+				return err
+			}
+			if isJSONOutput(config) {
+				return writeJSON(cmd.OutOrStdout(), &StashListResult{Entries: entries})
+			}
+			displayStashEntries(cmd.OutOrStdout(), entries)
+			return nil
+		},
+	}
+}
+
+func newStashDropCmd(config *CommandConfig) *cobra.Command {
+	return &cobra.Command{
+		Use:   "drop [n|stash@{n}]",
+		Short: "Drop a stash entry",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			index, err := parseStashArg(args)
+			if err != nil {
+				return err
+			}
+			worktreePath, err := currentStashWorktree(config)
+			if err != nil {
+				return err
+			}
+			if err := config.Services.WorktreeService.DropStash(context.Background(), worktreePath, index); err != nil {
+				return err
+			}
+			if !isQuiet(cmd) {
+				_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Dropped %s\n", domain.StashRef(index))
+			}
+			return nil
+		},
+	}
+}
+
+// currentStashWorktree returns the root of the worktree or project of the current directory
+func currentStashWorktree(config *CommandConfig) (string, error) {
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil {
+		return "", fmt.Errorf("context detection failed: %w", err)
+	}
+	if currentCtx.Type == domain.ContextOutsideGit {
+		return "", domain.NewValidationError("stash", "context", currentCtx.Type.String(), "not inside a project or worktree")
+	}
+	return currentCtx.Path, nil
+}
+
+// parseStashArg parses an optional n or stash@{n} argument; no argument is stash@{0}
+func parseStashArg(args []string) (int, error) {
+	if len(args) == 0 {
+		return 0, nil
+	}
+	value := strings.TrimSuffix(strings.TrimPrefix(args[0], "stash@{"), "}")
+	index, err := strconv.Atoi(value)
+	if err != nil || index < 0 {
+		return 0, domain.NewValidationError("stash", "entry", args[0], "expected n or stash@{n}").
+			WithSuggestions([]string{"Run 'twiggit stash list' to see the available entries"})
+	}
+	return index, nil
+}
+
+// displayStashEntries renders stash entries as a BRANCH/STASH/MESSAGE table
+func displayStashEntries(out io.Writer, entries []domain.StashEntry) {
+	if len(entries) == 0 {
+		_, _ = fmt.Fprintln(out, "No stash entries")
+		return
+	}
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "BRANCH\tSTASH\tMESSAGE")
+	for _, entry := range entries {
+		branch := entry.Branch()
+		if branch == "" {
+			branch = "-"
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", branch, entry.Ref(), entry.Subject())
+	}
+	_ = tw.Flush()
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestStashCommand(t *testing.T) {
+	worktreeCtx := &domain.Context{Type: domain.ContextWorktree, ProjectName: "proj", BranchName: "feature", Path: "/wt/proj/feature"}
+	conflicts := []domain.ConflictFile{{Path: "main.go", OurStatus: "modified", TheirStatus: "modified"}}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		context     *domain.Context
+		setup       func(ws *mocks.MockWorktreeService)
+		expectError string
+		expectOut   []string
+	}{
+		{
+			name: "push with message",
+			args: []string{"push", "-m", "half-done"},
+			setup: func(ws *mocks.MockWorktreeService) {
+				ws.On("PushStash", mock.Anything, "/wt/proj/feature", "half-done").Return(nil).Once()
+			},
+			expectOut: []string{"Stashed changes of /wt/proj/feature as stash@{0}"},
+		},
+		{
+			name: "list prepends the branch",
+			args: []string{"list"},
+			setup: func(ws *mocks.MockWorktreeService) {
+				ws.On("ListStashes", mock.Anything, "/wt/proj/feature").Return([]domain.StashEntry{
+					{Index: 0, Message: "On feature: half-done"},
+					{Index: 1, Message: "WIP on main: abc1234 Fix typo"},
+				}, nil).Once()
+			},
+			expectOut: []string{"BRANCH", "feature  stash@{0}  half-done", "main     stash@{1}  abc1234 Fix typo"},
+		},
+		{
+			name: "list without entries",
+			args: []string{"list"},
+			setup: func(ws *mocks.MockWorktreeService) {
+				ws.On("ListStashes", mock.Anything, "/wt/proj/feature").Return([]domain.StashEntry{}, nil).Once()
+			},
+			expectOut: []string{"No stash entries"},
+		},
+		{
+			name: "pop defaults to the newest entry",
+			args: []string{"pop"},
+			setup: func(ws *mocks.MockWorktreeService) {
+				ws.On("PopStash", mock.Anything, "/wt/proj/feature", 0).Return(nil, nil).Once()
+			},
+			expectOut: []string{"Applied and dropped stash@{0}"},
+		},
+		{
+			name: "pop with conflicts keeps the entry",
+			args: []string{"pop", "stash@{2}"},
+			setup: func(ws *mocks.MockWorktreeService) {
+				ws.On("PopStash", mock.Anything, "/wt/proj/feature", 2).Return(conflicts, nil).Once()
+			},
+			expectError: "stash@{2} applied with conflicts and was kept",
+			expectOut:   []string{"main.go", "both modified"},
+		},
+		{
+			name: "drop by index",
+			args: []string{"drop", "1"},
+			setup: func(ws *mocks.MockWorktreeService) {
+				ws.On("DropStash", mock.Anything, "/wt/proj/feature", 1).Return(nil).Once()
+			},
+			expectOut: []string{"Dropped stash@{1}"},
+		},
+		{
+			name:        "invalid entry",
+			args:        []string{"drop", "latest"},
+			expectError: "expected n or stash@{n}",
+		},
+		{
+			name:        "outside a project",
+			args:        []string{"list"},
+			context:     &domain.Context{Type: domain.ContextOutsideGit},
+			expectError: "not inside a project or worktree",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			currentCtx := worktreeCtx
+			if tc.context != nil {
+				currentCtx = tc.context
+			}
+			cs.On("GetCurrentContext").Return(currentCtx, nil)
+			if tc.setup != nil {
+				tc.setup(ws)
+			}
+
+			config := &CommandConfig{
+				Config:   domain.DefaultConfig(),
+				Services: &ServiceContainer{WorktreeService: ws, ContextService: cs},
+			}
+			cmd := NewStashCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+			ws.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStashListCommand_JSON(t *testing.T) {
+	ws := mocks.NewMockWorktreeService()
+	cs := mocks.NewMockContextService()
+	cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextWorktree, ProjectName: "proj", BranchName: "feature", Path: "/wt/proj/feature"}, nil)
+	ws.On("ListStashes", mock.Anything, "/wt/proj/feature").Return([]domain.StashEntry{
+		{Index: 0, Message: "On feature: half-done"},
+	}, nil).Once()
+
+	config := &CommandConfig{
+		Config:   domain.DefaultConfig(),
+		Services: &ServiceContainer{WorktreeService: ws, ContextService: cs},
+		Output:   outputJSON,
+	}
+	cmd := NewStashCommand(config)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"list"})
+
+	require.NoError(t, cmd.Execute())
+	require.True(t, json.Valid(out.Bytes()), "invalid JSON: %s", out.String())
+	assert.JSONEq(t, `{"entries":[{"index":0,"ref":"stash@{0}","branch":"feature","message":"half-done"}]}`, out.String())
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// NewStatsCommand creates the stats command
+func NewStatsCommand(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats [project]",
+		Short: "Summarize the state of a project's worktrees",
+		Long: `Show a snapshot of a project's worktrees: how many there are, how many are
+clean or have uncommitted changes, how many are ahead of or behind their
+upstream branch, and the dates of the oldest and newest HEAD commits. The
+project defaults to the one of the current directory; the main worktree is
+not counted.
+
+Worktrees are read several at a time (services.max_concurrent). Worktrees
+whose status cannot be read are counted under ERRORS.
+
+See 'twiggit worktrees stats' for counts across all projects and Prometheus
+metrics.
+
+Examples:
+  twiggit stats
+  twiggit stats myproject`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			}
+			return executeStats(cmd, config, projectName)
+		},
+	}
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionProjects(config),
+	)
+
+	return cmd
+}
+
+// executeStats prints the aggregated worktree status of the named or current project
+func executeStats(cmd *cobra.Command, config *CommandConfig, projectName string) error {
+	ctx := context.Background()
+
+	projects, err := resolveVerifyProjects(ctx, config, projectName, false)
+	if err != nil {
+		return err
+	}
+	project := projects[0]
+
+	logv(cmd, 1, "Reading the worktrees of %s", project.Name)
+	stats, err := config.Services.WorktreeService.GetWorktreeStats(ctx, project.GitRepoPath)
+	if err != nil {
+		return fmt.Errorf("failed to get worktree stats: %w", err)
+	}
+
+	displayProjectWorktreeStats(cmd.OutOrStdout(), project.Name, stats)
+	return nil
+}
+
+// displayProjectWorktreeStats prints a one-row table of the project's worktree stats
+func displayProjectWorktreeStats(out io.Writer, projectName string, stats *domain.WorktreeStats) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PROJECT\tWORKTREES\tCLEAN\tDIRTY\tAHEAD\tBEHIND\tERRORS\tOLDEST COMMIT\tNEWEST COMMIT")
+	_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t%s\t%s\n", projectName,
+		stats.Total, stats.Clean, stats.Dirty, stats.Ahead, stats.Behind, stats.Errors,
+		formatStatsDate(stats.OldestCommit), formatStatsDate(stats.NewestCommit))
+	_ = w.Flush()
+}
+
+// formatStatsDate prints a commit date, or "-" when it is unknown
+func formatStatsDate(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Format("2006-01-02")
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestStatsCommand(t *testing.T) {
+	project := &domain.ProjectInfo{Name: "api", GitRepoPath: "/repos/api"}
+
+	testCases := []struct {
+		name        string
+		stats       *domain.WorktreeStats
+		statsErr    error
+		expectError string
+		expectOut   string
+	}{
+		{
+			name: "prints the aggregated stats",
+			stats: &domain.WorktreeStats{
+				ProjectPath: "/repos/api", Total: 4, Clean: 2, Dirty: 1, Ahead: 1, Behind: 2, Errors: 1,
+				OldestCommit: time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+				NewestCommit: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+			},
+			expectOut: "PROJECT  WORKTREES  CLEAN  DIRTY  AHEAD  BEHIND  ERRORS  OLDEST COMMIT  NEWEST COMMIT\n" +
+				"api      4          2      1      1      2       1       2026-01-05     2026-03-01\n",
+		},
+		{
+			name:  "unknown commit dates",
+			stats: &domain.WorktreeStats{ProjectPath: "/repos/api"},
+			expectOut: "PROJECT  WORKTREES  CLEAN  DIRTY  AHEAD  BEHIND  ERRORS  OLDEST COMMIT  NEWEST COMMIT\n" +
+				"api      0          0      0      0      0       0       -              -\n",
+		},
+		{
+			name:        "service failure",
+			statsErr:    errors.New("not a repository"),
+			expectError: "failed to get worktree stats",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "api"}, nil)
+			ps.On("DiscoverProject", mock.Anything, "", mock.Anything).Return(project, nil)
+			ws.On("GetWorktreeStats", mock.Anything, "/repos/api").Return(tc.stats, tc.statsErr)
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps},
+			}
+			cmd := NewStatsCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs([]string{})
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,345 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// statusOptions holds the flags of the status command
+type statusOptions struct {
+	summary   bool
+	all       bool
+	exportEnv bool
+	prefix    string
+	worktrees bool
+	short     bool
+}
+
+// listsWorktrees reports whether status prints every worktree of a project instead of the
+// current one; a project argument, --worktrees and --short all ask for it
+func (o statusOptions) listsWorktrees(args []string) bool {
+	return len(args) > 0 || o.worktrees || o.short
+}
+
+// NewStatusCommand creates a new status command
+func NewStatusCommand(config *CommandConfig) *cobra.Command {
+	var opts statusOptions
+
+	cmd := &cobra.Command{
+		Use:   "status [project]",
+		Short: "Show the status of the current worktree or of a project's worktrees",
+		Long: `Show the status of the worktree containing the current directory.
+
+Includes the branch, working tree cleanliness and any linked worktrees
+recorded with 'twiggit create --link'. A warning is printed when a linked
+branch has commits that are not yet in the current branch.
+
+With --summary a single line is printed for the current project instead:
+the number of worktrees, how many have uncommitted changes and how many
+have merge conflicts. Add --all to print that line for every project.
+
+With --output json the same details are printed as one JSON object, or a
+list of project summaries with --summary.
+
+With --export-env shell export statements are printed for the project,
+branch, short commit and path of the current worktree, ready for eval.
+--prefix replaces the default TWIGGIT_ prefix of the variable names.
+
+With a project argument, --worktrees or --short, every worktree of the
+project (the current one when no project is given) is listed in a table:
+path relative to the workspace, branch, short commit, clean or dirty,
+commits ahead of and behind the upstream branch, and the HEAD commit date.
+--short prints one tab-separated line per worktree instead, for scripts.
+
+Examples:
+  twiggit status
+  twiggit status --summary
+  twiggit status --all --summary
+  twiggit status --output json
+  eval "$(twiggit status --export-env)"
+  twiggit status --export-env --prefix BUILD_
+  twiggit status --worktrees
+  twiggit status myproject
+  twiggit status --short | grep dirty`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.listsWorktrees(args) {
+				if err := validateStatusWorktrees(config, opts); err != nil {
+					return err
+				}
+				var projectName string
+				if len(args) > 0 {
+					projectName = args[0]
+				}
+				return executeStatusWorktrees(cmd, config, projectName, opts.short)
+			}
+			if opts.all && !opts.summary {
+				return domain.NewValidationError("status", "all", "true", "--all requires --summary")
+			}
+			if err := validateStatusExportEnv(cmd, config, opts); err != nil {
+				return err
+			}
+			if opts.exportEnv {
+				return executeStatusExportEnv(cmd, config, opts.prefix)
+			}
+			if opts.all {
+				return executeStatusSummaryAll(cmd, config)
+			}
+			if opts.summary {
+				return executeStatusSummary(cmd, config)
+			}
+			return executeStatus(cmd, config)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.summary, "summary", false, "Print one line per project with worktree, dirty and conflict counts")
+	cmd.Flags().BoolVar(&opts.all, "all", false, "Summarize every project in the workspace (requires --summary)")
+	cmd.Flags().BoolVar(&opts.exportEnv, "export-env", false, "Print shell export statements for the current worktree")
+	cmd.Flags().StringVar(&opts.prefix, "prefix", defaultEnvPrefix, "Variable name prefix for --export-env")
+	cmd.Flags().BoolVarP(&opts.worktrees, "worktrees", "w", false, "List every worktree of the project with its state")
+	cmd.Flags().BoolVar(&opts.short, "short", false, "List every worktree of the project, one tab-separated line each")
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionProjects(config),
+	)
+
+	return cmd
+}
+
+// validateStatusExportEnv checks --export-env and --prefix combinations
+func validateStatusExportEnv(cmd *cobra.Command, config *CommandConfig, opts statusOptions) error {
+	if !opts.exportEnv {
+		if cmd.Flags().Changed("prefix") {
+			return domain.NewValidationError("status", "prefix", opts.prefix, "--prefix requires --export-env")
+		}
+		return nil
+	}
+	if opts.summary {
+		return domain.NewValidationError("status", "export-env", "true", "--export-env cannot be combined with --summary")
+	}
+	if isJSONOutput(config) {
+		return domain.NewValidationError("status", "export-env", "true", "--export-env cannot be combined with --output json")
+	}
+	return validateEnvPrefix(opts.prefix)
+}
+
+// executeStatusExportEnv prints export statements for the current worktree
+func executeStatusExportEnv(cmd *cobra.Command, config *CommandConfig, prefix string) error {
+	ctx := context.Background()
+
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil {
+		return fmt.Errorf("context detection failed: %w", err)
+	}
+
+	if currentCtx.Type == domain.ContextOutsideGit {
+		return domain.NewValidationError("status", "context", currentCtx.Type.String(), "not inside a project or worktree")
+	}
+
+	status, err := config.Services.WorktreeService.GetWorktreeStatus(ctx, currentCtx.Path)
+	if err != nil {
+		return fmt.Errorf("failed to get worktree status: %w", err)
+	}
+
+	formatter := &StatusFormatter{Prefix: prefix}
+	_, _ = fmt.Fprint(cmd.OutOrStdout(), formatter.FormatAsEnv(&StatusResult{Project: currentCtx.ProjectName, Status: status}))
+	return nil
+}
+
+// executeStatusSummary prints the summary line of the current project
+func executeStatusSummary(cmd *cobra.Command, config *CommandConfig) error {
+	ctx := context.Background()
+
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil {
+		return fmt.Errorf("context detection failed: %w", err)
+	}
+
+	if currentCtx.Type == domain.ContextOutsideGit {
+		return domain.NewValidationError("status", "context", currentCtx.Type.String(), "not inside a project or worktree (use --all)")
+	}
+
+	project, err := config.Services.ProjectService.DiscoverProject(ctx, currentCtx.ProjectName, currentCtx)
+	if err != nil {
+		return fmt.Errorf("failed to discover project: %w", err)
+	}
+
+	summary, err := config.Services.WorktreeService.GetProjectSummary(ctx, project.GitRepoPath)
+	if err != nil {
+		return fmt.Errorf("failed to summarize project %s: %w", project.Name, err)
+	}
+
+	if isJSONOutput(config) {
+		return writeJSON(cmd.OutOrStdout(), &ProjectStatusResult{Project: project.Name, Summary: summary})
+	}
+	_, _ = fmt.Fprintln(cmd.OutOrStdout(), formatProjectStatusSummary(project.Name, summary))
+	return nil
+}
+
+// executeStatusSummaryAll prints a summary line for every project in the workspace
+func executeStatusSummaryAll(cmd *cobra.Command, config *CommandConfig) error {
+	ctx := context.Background()
+
+	projects, err := config.Services.ProjectService.ListProjectSummaries(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	results := make([]*ProjectStatusResult, 0, len(projects))
+	for _, project := range projects {
+		summary, err := config.Services.WorktreeService.GetProjectSummary(ctx, project.GitRepoPath)
+		results = append(results, &ProjectStatusResult{Project: project.Name, Summary: summary, Err: err})
+	}
+
+	out := cmd.OutOrStdout()
+	if isJSONOutput(config) {
+		return writeJSON(out, struct {
+			Projects []*ProjectStatusResult `json:"projects"`
+		}{results})
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			_, _ = fmt.Fprintf(out, "%s: unavailable (%v)\n", result.Project, result.Err)
+			continue
+		}
+		_, _ = fmt.Fprintln(out, formatProjectStatusSummary(result.Project, result.Summary))
+	}
+
+	return nil
+}
+
+// formatProjectStatusSummary renders "name: N worktrees, N dirty, N conflicts"
+func formatProjectStatusSummary(name string, summary *domain.ProjectStatusSummary) string {
+	line := fmt.Sprintf("%s: %d worktrees, %d dirty, %d conflicts", name, summary.Worktrees, summary.Dirty, summary.Conflicts)
+	if summary.Errors > 0 {
+		line += fmt.Sprintf(", %d unavailable", summary.Errors)
+	}
+	return line
+}
+
+// executeStatus executes the status command with the given configuration
+func executeStatus(cmd *cobra.Command, config *CommandConfig) error {
+	ctx := context.Background()
+
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil {
+		return fmt.Errorf("context detection failed: %w", err)
+	}
+
+	if currentCtx.Type == domain.ContextOutsideGit {
+		return domain.NewValidationError("status", "context", currentCtx.Type.String(), "not inside a project or worktree")
+	}
+
+	status, err := config.Services.WorktreeService.GetWorktreeStatus(ctx, currentCtx.Path)
+	if err != nil {
+		return fmt.Errorf("failed to get worktree status: %w", err)
+	}
+
+	branch := status.WorktreeInfo.Branch
+	links, err := collectStatusLinks(ctx, config, currentCtx.Path, branch)
+	if err != nil {
+		return err
+	}
+	result := &StatusResult{
+		Project:     currentCtx.ProjectName,
+		Status:      status,
+		Description: statusBranchDescription(ctx, config, currentCtx.Path, status.WorktreeInfo),
+		Links:       links,
+	}
+	if isJSONOutput(config) {
+		return writeJSON(cmd.OutOrStdout(), result)
+	}
+
+	out := cmd.OutOrStdout()
+	_, _ = fmt.Fprintf(out, "Worktree: %s\n", status.WorktreeInfo.Path)
+	_, _ = fmt.Fprintf(out, "Branch:   %s\n", branch)
+	if result.Description != "" {
+		_, _ = fmt.Fprintf(out, "Description: %s\n", result.Description)
+	}
+	_, _ = fmt.Fprintf(out, "Status:   %s\n", formatRepositoryStatus(status.RepositoryStatus))
+
+	if len(status.ConflictFiles) > 0 {
+		_, _ = fmt.Fprintf(out, "\nConflicts (%d):\n", len(status.ConflictFiles))
+		for _, conflict := range status.ConflictFiles {
+			_, _ = fmt.Fprintf(out, "  %s (%s)\n", conflict.Path, describeConflict(conflict))
+		}
+	}
+
+	displayLinkedWorktrees(cmd, branch, links)
+	return nil
+}
+
+// statusBranchDescription returns the branch description, "" when detached or unreadable
+func statusBranchDescription(ctx context.Context, config *CommandConfig, worktreePath string, wt *domain.WorktreeInfo) string {
+	if wt.IsDetached || wt.Branch == "" {
+		return ""
+	}
+	description, err := config.Services.WorktreeService.GetBranchDescription(ctx, worktreePath, wt.Branch)
+	if err != nil {
+		return ""
+	}
+	return description
+}
+
+// collectStatusLinks compares the current branch with each dependency link recorded for the worktree
+func collectStatusLinks(ctx context.Context, config *CommandConfig, worktreePath, branch string) ([]StatusLink, error) {
+	if config.Services.LinkRegistry == nil {
+		return nil, nil
+	}
+
+	links, err := config.Services.LinkRegistry.GetLinks(worktreePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read worktree links: %w", err)
+	}
+
+	result := make([]StatusLink, 0, len(links))
+	for _, link := range links {
+		commits, err := config.Services.WorktreeService.LogBetween(ctx, worktreePath, branch, link)
+		result = append(result, StatusLink{Branch: link, NewCommits: len(commits), Err: err})
+	}
+	return result, nil
+}
+
+// displayLinkedWorktrees shows dependency links and warns when a dependency has new commits
+func displayLinkedWorktrees(cmd *cobra.Command, branch string, links []StatusLink) {
+	if len(links) == 0 {
+		return
+	}
+
+	out := cmd.OutOrStdout()
+	_, _ = fmt.Fprintf(out, "\nLinked worktrees:\n")
+	for _, link := range links {
+		if link.Err != nil {
+			_, _ = fmt.Fprintf(out, "  %s (unable to compare: %v)\n", link.Branch, link.Err)
+			continue
+		}
+
+		if link.NewCommits == 0 {
+			_, _ = fmt.Fprintf(out, "  %s (up to date)\n", link.Branch)
+			continue
+		}
+
+		_, _ = fmt.Fprintf(out, "  %s (%d new commit(s))\n", link.Branch, link.NewCommits)
+		displayLinkWarning(cmd.ErrOrStderr(), link.Branch, branch, link.NewCommits)
+	}
+}
+
+// displayLinkWarning warns that a linked branch is ahead of the current branch
+func displayLinkWarning(out io.Writer, link, branch string, count int) {
+	_, _ = fmt.Fprintf(out, "Warning: linked branch %s has %d commit(s) not in %s\n", link, count, branch)
+}
+
+// formatRepositoryStatus summarizes working tree changes on one line
+func formatRepositoryStatus(status *domain.RepositoryStatus) string {
+	if status == nil || status.IsClean {
+		return "clean"
+	}
+	return fmt.Sprintf("%d modified, %d added, %d deleted, %d untracked",
+		len(status.Modified), len(status.Added), len(status.Deleted), len(status.Untracked))
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"twiggit/internal/domain"
+)
+
+// defaultEnvPrefix prefixes the variable names printed by status --export-env
+const defaultEnvPrefix = "TWIGGIT_"
+
+// shortCommitLength is the number of hash characters exported as the commit
+const shortCommitLength = 7
+
+var (
+	envPrefixPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	shellSafePattern = regexp.MustCompile(`^[A-Za-z0-9_./:@%+=,-]+$`)
+)
+
+// StatusResult is the worktree status rendered by StatusFormatter and status --output json
+type StatusResult struct {
+	Project     string
+	Status      *domain.WorktreeStatus
+	Description string       // Branch description, "" when unset
+	Links       []StatusLink // Linked worktrees (twiggit create --link)
+}
+
+// StatusLink is a linked branch and its commits that are not yet in the current branch
+type StatusLink struct {
+	Branch     string
+	NewCommits int
+	Err        error // Set when the branches could not be compared
+}
+
+// StatusFormatter renders status results for scripts
+type StatusFormatter struct {
+	Prefix string // Variable name prefix, e.g. "TWIGGIT_"
+}
+
+// FormatAsEnv renders the project, branch, short commit and worktree path as
+// POSIX shell export statements, one per line, safe to eval
+func (f *StatusFormatter) FormatAsEnv(result *StatusResult) string {
+	var info domain.WorktreeInfo
+	if result.Status != nil && result.Status.WorktreeInfo != nil {
+		info = *result.Status.WorktreeInfo
+	}
+	commit := info.Commit
+	if commit == "" && result.Status != nil && result.Status.RepositoryStatus != nil {
+		commit = result.Status.RepositoryStatus.Commit
+	}
+	if len(commit) > shortCommitLength {
+		commit = commit[:shortCommitLength]
+	}
+
+	var out strings.Builder
+	for _, v := range []struct{ name, value string }{
+		{"PROJECT", result.Project},
+		{"BRANCH", info.Branch},
+		{"COMMIT", commit},
+		{"WORKTREE_PATH", info.Path},
+	} {
+		_, _ = fmt.Fprintf(&out, "export %s%s=%s\n", f.Prefix, v.name, envValue(v.value))
+	}
+	return out.String()
+}
+
+// validateEnvPrefix rejects prefixes that would not form shell variable names
+func validateEnvPrefix(prefix string) error {
+	if prefix != "" && !envPrefixPattern.MatchString(prefix) {
+		return domain.NewValidationError("status", "prefix", prefix, "prefix must start with a letter or underscore and contain only letters, digits and underscores")
+	}
+	return nil
+}
+
+// envValue leaves values that need no quoting bare and quotes the rest
+func envValue(value string) string {
+	if shellSafePattern.MatchString(value) {
+		return value
+	}
+	return shellQuote(value)
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+func TestStatusFormatter_FormatAsEnv(t *testing.T) {
+	testCases := []struct {
+		name     string
+		prefix   string
+		result   *StatusResult
+		expected string
+	}{
+		{
+			name:   "plain values stay bare",
+			prefix: defaultEnvPrefix,
+			result: &StatusResult{Project: "my-project", Status: &domain.WorktreeStatus{
+				WorktreeInfo: &domain.WorktreeInfo{Path: "/wt/my-project/feature-x", Branch: "feature-x", Commit: "abc1234def5678"},
+			}},
+			expected: "export TWIGGIT_PROJECT=my-project\n" +
+				"export TWIGGIT_BRANCH=feature-x\n" +
+				"export TWIGGIT_COMMIT=abc1234\n" +
+				"export TWIGGIT_WORKTREE_PATH=/wt/my-project/feature-x\n",
+		},
+		{
+			name:   "spaces and quotes are single-quoted",
+			prefix: "BUILD_",
+			result: &StatusResult{Project: "it's", Status: &domain.WorktreeStatus{
+				WorktreeInfo: &domain.WorktreeInfo{Path: "/my worktrees/x", Branch: "feature/a-b"},
+			}},
+			expected: "export BUILD_PROJECT='it'\\''s'\n" +
+				"export BUILD_BRANCH=feature/a-b\n" +
+				"export BUILD_COMMIT=''\n" +
+				"export BUILD_WORKTREE_PATH='/my worktrees/x'\n",
+		},
+		{
+			name:   "commit falls back to repository status",
+			prefix: "",
+			result: &StatusResult{Project: "p", Status: &domain.WorktreeStatus{
+				WorktreeInfo:     &domain.WorktreeInfo{Path: "/p", Branch: "main"},
+				RepositoryStatus: &domain.RepositoryStatus{Commit: "0123456789"},
+			}},
+			expected: "export PROJECT=p\nexport BRANCH=main\nexport COMMIT=0123456\nexport WORKTREE_PATH=/p\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			formatter := &StatusFormatter{Prefix: tc.prefix}
+			assert.Equal(t, tc.expected, formatter.FormatAsEnv(tc.result))
+		})
+	}
+}
+
+func TestStatusFormatter_FormatAsEnv_EvaluatesInShell(t *testing.T) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh not available")
+	}
+
+	formatter := &StatusFormatter{Prefix: defaultEnvPrefix}
+	script := formatter.FormatAsEnv(&StatusResult{Project: "my-project", Status: &domain.WorktreeStatus{
+		WorktreeInfo: &domain.WorktreeInfo{Path: "/tmp/my work's $HOME tree", Branch: "feature-x-y", Commit: "abc1234"},
+	}})
+	script += `printf '%s|%s|%s|%s' "$TWIGGIT_PROJECT" "$TWIGGIT_BRANCH" "$TWIGGIT_COMMIT" "$TWIGGIT_WORKTREE_PATH"`
+
+	out, err := exec.Command(sh, "-c", script).Output()
+	require.NoError(t, err)
+	assert.Equal(t, "my-project|feature-x-y|abc1234|/tmp/my work's $HOME tree", string(out))
+}
+
+func TestValidateEnvPrefix(t *testing.T) {
+	for _, prefix := range []string{"", "TWIGGIT_", "_x", "Build2_"} {
+		require.NoError(t, validateEnvPrefix(prefix), prefix)
+	}
+	for _, prefix := range []string{"2X", "MY-", "A B", "$X"} {
+		err := validateEnvPrefix(prefix)
+		require.Error(t, err, prefix)
+		assert.True(t, strings.Contains(err.Error(), "prefix"))
+	}
+}
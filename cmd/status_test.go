@@ -0,0 +1,534 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestStatusCommand_Execute(t *testing.T) {
+	worktreeCtx := &domain.Context{
+		Type:        domain.ContextWorktree,
+		ProjectName: "proj",
+		BranchName:  "feature-ui",
+		Path:        "/wt/proj/feature-ui",
+	}
+	worktreeStatus := &domain.WorktreeStatus{
+		WorktreeInfo:     &domain.WorktreeInfo{Path: "/wt/proj/feature-ui", Branch: "feature-ui"},
+		RepositoryStatus: &domain.RepositoryStatus{IsClean: true},
+	}
+
+	testCases := []struct {
+		name         string
+		setupMocks   func(*mocks.MockWorktreeService, *mocks.MockContextService, *mocks.MockLinkRegistry)
+		expectError  string
+		expectOut    []string
+		expectErrOut []string
+	}{
+		{
+			name: "clean worktree without links",
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService, lr *mocks.MockLinkRegistry) {
+				cs.On("GetCurrentContext").Return(worktreeCtx, nil)
+				ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj/feature-ui").Return(worktreeStatus, nil)
+				lr.On("GetLinks", "/wt/proj/feature-ui").Return([]string{}, nil)
+			},
+			expectOut: []string{"Branch:   feature-ui\nStatus:   clean"},
+		},
+		{
+			name: "linked worktree ahead shows warning",
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService, lr *mocks.MockLinkRegistry) {
+				cs.On("GetCurrentContext").Return(worktreeCtx, nil)
+				ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj/feature-ui").Return(worktreeStatus, nil)
+				lr.On("GetLinks", "/wt/proj/feature-ui").Return([]string{"feature-api", "feature-db"}, nil)
+				ws.On("LogBetween", mock.Anything, "/wt/proj/feature-ui", "feature-ui", "feature-api").
+					Return([]domain.CommitInfo{{Hash: "a"}, {Hash: "b"}}, nil)
+				ws.On("LogBetween", mock.Anything, "/wt/proj/feature-ui", "feature-ui", "feature-db").
+					Return([]domain.CommitInfo{}, nil)
+			},
+			expectOut:    []string{"Linked worktrees:", "feature-api (2 new commit(s))", "feature-db (up to date)"},
+			expectErrOut: []string{"Warning: linked branch feature-api has 2 commit(s) not in feature-ui"},
+		},
+		{
+			name: "dirty worktree",
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService, lr *mocks.MockLinkRegistry) {
+				cs.On("GetCurrentContext").Return(worktreeCtx, nil)
+				ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj/feature-ui").Return(&domain.WorktreeStatus{
+					WorktreeInfo: &domain.WorktreeInfo{Path: "/wt/proj/feature-ui", Branch: "feature-ui"},
+					RepositoryStatus: &domain.RepositoryStatus{
+						Modified:  []string{"a.go"},
+						Untracked: []string{"b.go", "c.go"},
+					},
+				}, nil)
+				lr.On("GetLinks", "/wt/proj/feature-ui").Return([]string{}, nil)
+			},
+			expectOut: []string{"Status:   1 modified, 0 added, 0 deleted, 2 untracked"},
+		},
+		{
+			name: "worktree with conflicts",
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService, lr *mocks.MockLinkRegistry) {
+				cs.On("GetCurrentContext").Return(worktreeCtx, nil)
+				ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj/feature-ui").Return(&domain.WorktreeStatus{
+					WorktreeInfo:     &domain.WorktreeInfo{Path: "/wt/proj/feature-ui", Branch: "feature-ui"},
+					RepositoryStatus: &domain.RepositoryStatus{Modified: []string{"a.go"}},
+					ConflictFiles: []domain.ConflictFile{
+						{Path: "a.go", OurStatus: "modified", TheirStatus: "modified"},
+						{Path: "b.go", OurStatus: "modified", TheirStatus: "deleted"},
+					},
+				}, nil)
+				lr.On("GetLinks", "/wt/proj/feature-ui").Return([]string{}, nil)
+			},
+			expectOut: []string{"Conflicts (2):", "a.go (both modified)", "b.go (modified by us, deleted by them)"},
+		},
+		{
+			name: "described branch",
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService, lr *mocks.MockLinkRegistry) {
+				cs.On("GetCurrentContext").Return(worktreeCtx, nil)
+				ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj/feature-ui").Return(worktreeStatus, nil)
+				ws.On("GetBranchDescription", mock.Anything, "/wt/proj/feature-ui", "feature-ui").Return("New checkout screens", nil)
+				lr.On("GetLinks", "/wt/proj/feature-ui").Return([]string{}, nil)
+			},
+			expectOut: []string{"Branch:   feature-ui\nDescription: New checkout screens\n"},
+		},
+		{
+			name: "outside git",
+			setupMocks: func(_ *mocks.MockWorktreeService, cs *mocks.MockContextService, _ *mocks.MockLinkRegistry) {
+				cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+			},
+			expectError: "not inside a project or worktree",
+		},
+		{
+			name: "status error",
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService, _ *mocks.MockLinkRegistry) {
+				cs.On("GetCurrentContext").Return(worktreeCtx, nil)
+				ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj/feature-ui").Return(nil, errors.New("boom"))
+			},
+			expectError: "failed to get worktree status",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			lr := mocks.NewMockLinkRegistry()
+			tc.setupMocks(ws, cs, lr)
+			ws.On("GetBranchDescription", mock.Anything, mock.Anything, mock.Anything).Return("", nil).Maybe()
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{
+					WorktreeService: ws,
+					ContextService:  cs,
+					LinkRegistry:    lr,
+				},
+			}
+
+			cmd := NewStatusCommand(config)
+			var out, errOut bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&errOut)
+			cmd.SetArgs([]string{})
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+
+			require.NoError(t, err)
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+			for _, expected := range tc.expectErrOut {
+				assert.Contains(t, errOut.String(), expected)
+			}
+		})
+	}
+}
+
+func TestStatusCommand_Summary(t *testing.T) {
+	projectCtx := &domain.Context{Type: domain.ContextProject, ProjectName: "proj", Path: "/repos/proj"}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		setupMocks  func(*mocks.MockWorktreeService, *mocks.MockContextService, *mocks.MockProjectService)
+		expectError string
+		expectOut   []string
+	}{
+		{
+			name: "current project",
+			args: []string{"--summary"},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService, ps *mocks.MockProjectService) {
+				cs.On("GetCurrentContext").Return(projectCtx, nil)
+				ps.On("DiscoverProject", mock.Anything, "proj", projectCtx).
+					Return(&domain.ProjectInfo{Name: "proj", GitRepoPath: "/repos/proj"}, nil)
+				ws.On("GetProjectSummary", mock.Anything, "/repos/proj").
+					Return(&domain.ProjectStatusSummary{Worktrees: 3, Dirty: 1}, nil)
+			},
+			expectOut: []string{"proj: 3 worktrees, 1 dirty, 0 conflicts\n"},
+		},
+		{
+			name: "all projects",
+			args: []string{"--all", "--summary"},
+			setupMocks: func(ws *mocks.MockWorktreeService, _ *mocks.MockContextService, ps *mocks.MockProjectService) {
+				ps.On("ListProjectSummaries", mock.Anything).Return([]*domain.ProjectSummary{
+					{Name: "api", GitRepoPath: "/repos/api"},
+					{Name: "web", GitRepoPath: "/repos/web"},
+					{Name: "broken", GitRepoPath: "/repos/broken"},
+				}, nil)
+				ws.On("GetProjectSummary", mock.Anything, "/repos/api").
+					Return(&domain.ProjectStatusSummary{Worktrees: 2, Conflicts: 1, Dirty: 1}, nil)
+				ws.On("GetProjectSummary", mock.Anything, "/repos/web").
+					Return(&domain.ProjectStatusSummary{Worktrees: 4, Errors: 1}, nil)
+				ws.On("GetProjectSummary", mock.Anything, "/repos/broken").Return(nil, errors.New("not a repository"))
+			},
+			expectOut: []string{
+				"api: 2 worktrees, 1 dirty, 1 conflicts\n",
+				"web: 4 worktrees, 0 dirty, 0 conflicts, 1 unavailable\n",
+				"broken: unavailable (not a repository)\n",
+			},
+		},
+		{
+			name: "outside git without all",
+			args: []string{"--summary"},
+			setupMocks: func(_ *mocks.MockWorktreeService, cs *mocks.MockContextService, _ *mocks.MockProjectService) {
+				cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+			},
+			expectError: "use --all",
+		},
+		{
+			name:        "all requires summary",
+			args:        []string{"--all"},
+			setupMocks:  func(*mocks.MockWorktreeService, *mocks.MockContextService, *mocks.MockProjectService) {},
+			expectError: "--all requires --summary",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+			tc.setupMocks(mockWS, mockCS, mockPS)
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS},
+			}
+			cmd := NewStatusCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+			mockWS.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStatusCommand_ExportEnv(t *testing.T) {
+	worktreeCtx := &domain.Context{
+		Type:        domain.ContextWorktree,
+		ProjectName: "proj",
+		BranchName:  "feature-ui",
+		Path:        "/wt/proj/feature ui",
+	}
+	worktreeStatus := &domain.WorktreeStatus{
+		WorktreeInfo:     &domain.WorktreeInfo{Path: "/wt/proj/feature ui", Branch: "feature-ui", Commit: "1234567890abcdef"},
+		RepositoryStatus: &domain.RepositoryStatus{IsClean: true},
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		setupMocks  func(*mocks.MockWorktreeService, *mocks.MockContextService)
+		expectError string
+		expectOut   string
+	}{
+		{
+			name: "default prefix",
+			args: []string{"--export-env"},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService) {
+				cs.On("GetCurrentContext").Return(worktreeCtx, nil)
+				ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj/feature ui").Return(worktreeStatus, nil)
+			},
+			expectOut: "export TWIGGIT_PROJECT=proj\n" +
+				"export TWIGGIT_BRANCH=feature-ui\n" +
+				"export TWIGGIT_COMMIT=1234567\n" +
+				"export TWIGGIT_WORKTREE_PATH='/wt/proj/feature ui'\n",
+		},
+		{
+			name: "custom prefix",
+			args: []string{"--export-env", "--prefix", "CI_"},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService) {
+				cs.On("GetCurrentContext").Return(worktreeCtx, nil)
+				ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj/feature ui").Return(worktreeStatus, nil)
+			},
+			expectOut: "export CI_PROJECT=proj\n" +
+				"export CI_BRANCH=feature-ui\n" +
+				"export CI_COMMIT=1234567\n" +
+				"export CI_WORKTREE_PATH='/wt/proj/feature ui'\n",
+		},
+		{
+			name:        "invalid prefix",
+			args:        []string{"--export-env", "--prefix", "my-"},
+			setupMocks:  func(*mocks.MockWorktreeService, *mocks.MockContextService) {},
+			expectError: "prefix must start with a letter or underscore",
+		},
+		{
+			name:        "prefix requires export-env",
+			args:        []string{"--prefix", "CI_"},
+			setupMocks:  func(*mocks.MockWorktreeService, *mocks.MockContextService) {},
+			expectError: "--prefix requires --export-env",
+		},
+		{
+			name:        "summary conflicts",
+			args:        []string{"--export-env", "--summary"},
+			setupMocks:  func(*mocks.MockWorktreeService, *mocks.MockContextService) {},
+			expectError: "cannot be combined with --summary",
+		},
+		{
+			name: "outside git",
+			args: []string{"--export-env"},
+			setupMocks: func(_ *mocks.MockWorktreeService, cs *mocks.MockContextService) {
+				cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+			},
+			expectError: "not inside a project or worktree",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			tc.setupMocks(mockWS, mockCS)
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS},
+			}
+			cmd := NewStatusCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectOut, out.String())
+		})
+	}
+}
+
+func TestStatusCommand_JSON(t *testing.T) {
+	worktreeCtx := &domain.Context{Type: domain.ContextWorktree, ProjectName: "proj", BranchName: "feature-ui", Path: "/wt/proj/feature-ui"}
+	projectCtx := &domain.Context{Type: domain.ContextProject, ProjectName: "proj", Path: "/repos/proj"}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		setupMocks  func(*mocks.MockWorktreeService, *mocks.MockContextService, *mocks.MockProjectService, *mocks.MockLinkRegistry)
+		expectError string
+		expectJSON  string
+	}{
+		{
+			name: "worktree status",
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService, _ *mocks.MockProjectService, lr *mocks.MockLinkRegistry) {
+				cs.On("GetCurrentContext").Return(worktreeCtx, nil)
+				ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj/feature-ui").Return(&domain.WorktreeStatus{
+					WorktreeInfo:     &domain.WorktreeInfo{Path: "/wt/proj/feature-ui", Branch: "feature-ui", Commit: "abc1234"},
+					RepositoryStatus: &domain.RepositoryStatus{Modified: []string{"main.go"}},
+					ConflictFiles:    []domain.ConflictFile{{Path: "go.mod", OurStatus: "modified", TheirStatus: "deleted"}},
+				}, nil)
+				ws.On("GetBranchDescription", mock.Anything, "/wt/proj/feature-ui", "feature-ui").Return("New login page", nil)
+				lr.On("GetLinks", "/wt/proj/feature-ui").Return([]string{"feature-api"}, nil)
+				ws.On("LogBetween", mock.Anything, "/wt/proj/feature-ui", "feature-ui", "feature-api").
+					Return([]domain.CommitInfo{{Hash: "a"}}, nil)
+			},
+			expectJSON: `{"project":"proj","path":"/wt/proj/feature-ui","branch":"feature-ui","detached":false,"commit":"abc1234",
+				"description":"New login page","clean":false,"modified":["main.go"],"added":[],"deleted":[],"untracked":[],
+				"conflicts":[{"path":"go.mod","our_status":"modified","their_status":"deleted"}],
+				"links":[{"branch":"feature-api","new_commits":1}]}`,
+		},
+		{
+			name: "project summary",
+			args: []string{"--summary"},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService, ps *mocks.MockProjectService, _ *mocks.MockLinkRegistry) {
+				cs.On("GetCurrentContext").Return(projectCtx, nil)
+				ps.On("DiscoverProject", mock.Anything, "proj", projectCtx).
+					Return(&domain.ProjectInfo{Name: "proj", GitRepoPath: "/repos/proj"}, nil)
+				ws.On("GetProjectSummary", mock.Anything, "/repos/proj").
+					Return(&domain.ProjectStatusSummary{Worktrees: 3, Dirty: 1}, nil)
+			},
+			expectJSON: `{"project":"proj","worktrees":3,"dirty":1,"conflicts":0,"unavailable":0}`,
+		},
+		{
+			name: "all projects keep unavailable ones",
+			args: []string{"--all", "--summary"},
+			setupMocks: func(ws *mocks.MockWorktreeService, _ *mocks.MockContextService, ps *mocks.MockProjectService, _ *mocks.MockLinkRegistry) {
+				ps.On("ListProjectSummaries", mock.Anything).Return([]*domain.ProjectSummary{
+					{Name: "api", GitRepoPath: "/repos/api"},
+					{Name: "broken", GitRepoPath: "/repos/broken"},
+				}, nil)
+				ws.On("GetProjectSummary", mock.Anything, "/repos/api").
+					Return(&domain.ProjectStatusSummary{Worktrees: 2, Conflicts: 1}, nil)
+				ws.On("GetProjectSummary", mock.Anything, "/repos/broken").Return(nil, errors.New("not a repository"))
+			},
+			expectJSON: `{"projects":[
+				{"project":"api","worktrees":2,"dirty":0,"conflicts":1,"unavailable":0},
+				{"project":"broken","worktrees":0,"dirty":0,"conflicts":0,"unavailable":0,"error":"not a repository"}]}`,
+		},
+		{
+			name: "export-env cannot be JSON",
+			args: []string{"--export-env"},
+			setupMocks: func(*mocks.MockWorktreeService, *mocks.MockContextService, *mocks.MockProjectService, *mocks.MockLinkRegistry) {
+			},
+			expectError: "--export-env cannot be combined with --output json",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+			mockLR := mocks.NewMockLinkRegistry()
+			tc.setupMocks(mockWS, mockCS, mockPS, mockLR)
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS, ProjectService: mockPS, LinkRegistry: mockLR},
+				Output:   outputJSON,
+			}
+			cmd := NewStatusCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, json.Valid(out.Bytes()), "invalid JSON: %s", out.String())
+			assert.JSONEq(t, tc.expectJSON, out.String())
+			mockWS.AssertExpectations(t)
+		})
+	}
+}
+
+func TestStatusCommand_Worktrees(t *testing.T) {
+	project := &domain.ProjectInfo{Name: "api", GitRepoPath: "/projects/api"}
+	lastCommit := time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC)
+	main := &domain.WorktreeInfo{Path: "/projects/api", Branch: "main", Commit: "3f2a9c1d0e", LastUpdated: lastCommit}
+	feature := &domain.WorktreeInfo{Path: "/worktrees/api/feature", Branch: "feature", Commit: "b71c0aa99f"}
+	broken := &domain.WorktreeInfo{Path: "/worktrees/api/broken", IsDetached: true, Commit: "0000000aaa"}
+
+	setup := func(ws *mocks.MockWorktreeService) {
+		ws.On("ListWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+			return req.ProjectName == "api" && req.IncludeMain && req.IncludeLastUpdated
+		})).Return([]*domain.WorktreeInfo{main, feature, broken}, nil)
+		ws.On("GetWorktreeStatus", mock.Anything, main.Path).Return(&domain.WorktreeStatus{WorktreeInfo: main, IsClean: true}, nil)
+		ws.On("GetWorktreeStatus", mock.Anything, feature.Path).
+			Return(&domain.WorktreeStatus{WorktreeInfo: feature, HasUncommittedChanges: true}, nil)
+		ws.On("GetWorktreeStatus", mock.Anything, broken.Path).Return(nil, errors.New("index corrupt"))
+		ws.On("GetWorktreeDetails", mock.Anything, main).Return(&domain.WorktreeDetails{Upstream: "origin/main", BehindCount: 3})
+		ws.On("GetWorktreeDetails", mock.Anything, feature).Return(&domain.WorktreeDetails{Upstream: "origin/feature", AheadCount: 2})
+		ws.On("GetWorktreeDetails", mock.Anything, broken).Return(&domain.WorktreeDetails{})
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		output      string
+		expectError string
+		expectOut   string
+	}{
+		{
+			name: "project argument prints the table",
+			args: []string{"api"},
+			expectOut: "PATH         BRANCH      COMMIT   STATE    AHEAD/BEHIND  LAST COMMIT\n" +
+				"api          main        3f2a9c1  clean    +0/-3         2026-03-01 12:30\n" +
+				"api/feature  feature     b71c0aa  dirty    +2/-0         -\n" +
+				"api/broken   (detached)  0000000  unknown  -             -\n",
+		},
+		{
+			name: "short infers the project from the context",
+			args: []string{"--short"},
+			expectOut: "api\tmain\t3f2a9c1\tclean\t+0/-3\t2026-03-01T12:30:00Z\n" +
+				"api/feature\tfeature\tb71c0aa\tdirty\t+2/-0\t-\n" +
+				"api/broken\t(detached)\t0000000\tunknown\t-\t-\n",
+		},
+		{
+			name:        "summary cannot be combined",
+			args:        []string{"--worktrees", "--summary"},
+			expectError: "cannot be combined with --summary",
+		},
+		{
+			name:        "json is not supported",
+			args:        []string{"api"},
+			output:      outputJSON,
+			expectError: "--output json cannot be combined",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextWorktree, ProjectName: "api", Path: feature.Path}, nil)
+			ps.On("DiscoverProject", mock.Anything, mock.Anything, mock.Anything).Return(project, nil)
+			setup(ws)
+
+			cfg := domain.DefaultConfig()
+			cfg.ProjectsDirectory = "/projects"
+			cfg.WorktreesDirectory = "/worktrees"
+			config := &CommandConfig{
+				Config:   cfg,
+				Output:   tc.output,
+				Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps},
+			}
+			cmd := NewStatusCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				ws.AssertNotCalled(t, "ListWorktrees", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectOut, out.String())
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// defaultStatusConcurrency caps the worktrees status reads at once when services.max_concurrent is unset
+const defaultStatusConcurrency = 4
+
+// worktreeStatusRow is one worktree of status --worktrees
+type worktreeStatusRow struct {
+	worktree *domain.WorktreeInfo
+	status   *domain.WorktreeStatus // nil when the status could not be read
+	details  *domain.WorktreeDetails
+}
+
+// validateStatusWorktrees rejects the flags of the single-worktree and summary modes
+func validateStatusWorktrees(config *CommandConfig, opts statusOptions) error {
+	switch {
+	case opts.summary:
+		return domain.NewValidationError("status", "worktrees", "true", "listing worktrees cannot be combined with --summary")
+	case opts.all:
+		return domain.NewValidationError("status", "all", "true", "--all requires --summary")
+	case opts.exportEnv:
+		return domain.NewValidationError("status", "export-env", "true", "--export-env cannot be combined with listing worktrees")
+	case isJSONOutput(config):
+		return domain.NewValidationError("status", "output", outputJSON, "--output json cannot be combined with listing worktrees")
+	}
+	return nil
+}
+
+// executeStatusWorktrees prints the state of every worktree of the named or current project
+func executeStatusWorktrees(cmd *cobra.Command, config *CommandConfig, projectName string, short bool) error {
+	ctx := context.Background()
+
+	projects, err := resolveVerifyProjects(ctx, config, projectName, false)
+	if err != nil {
+		return err
+	}
+	project := projects[0]
+
+	worktrees, err := config.Services.WorktreeService.ListWorktrees(ctx, &domain.ListWorktreesRequest{
+		ProjectName:        project.Name,
+		IncludeMain:        true,
+		IncludeLastUpdated: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list worktrees of %s: %w", project.Name, err)
+	}
+
+	var rows []worktreeStatusRow
+	for _, wt := range worktrees {
+		if !wt.IsBare {
+			rows = append(rows, worktreeStatusRow{worktree: wt})
+		}
+	}
+	if len(rows) == 0 {
+		_, _ = fmt.Fprintln(cmd.OutOrStdout(), "No worktrees found")
+		return nil
+	}
+
+	logv(cmd, 1, "Reading the status of %d worktree(s) of %s", len(rows), project.Name)
+	collectWorktreeStatusRows(ctx, cmd, config, rows)
+
+	if short {
+		displayWorktreeStatusShort(cmd.OutOrStdout(), config, rows)
+	} else {
+		displayWorktreeStatusTable(cmd.OutOrStdout(), config, rows)
+	}
+	return nil
+}
+
+// collectWorktreeStatusRows reads the status and upstream of up to services.max_concurrent
+// worktrees at once; a status that cannot be read leaves the row's status nil
+func collectWorktreeStatusRows(ctx context.Context, cmd *cobra.Command, config *CommandConfig, rows []worktreeStatusRow) {
+	concurrency := defaultStatusConcurrency
+	if config.Config != nil && config.Config.Services.MaxConcurrent > 0 {
+		concurrency = config.Config.Services.MaxConcurrent
+	}
+
+	errs := make([]error, len(rows))
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+	for i := range rows {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(row *worktreeStatusRow, err *error) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			row.status, *err = config.Services.WorktreeService.GetWorktreeStatus(ctx, row.worktree.Path)
+			row.details = config.Services.WorktreeService.GetWorktreeDetails(ctx, row.worktree)
+		}(&rows[i], &errs[i])
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			logv(cmd, 1, "Status of %s unavailable: %v", rows[i].worktree.Path, err)
+		}
+	}
+}
+
+// displayWorktreeStatusTable prints a PATH/BRANCH/COMMIT/STATE/AHEAD/BEHIND/LAST COMMIT table
+func displayWorktreeStatusTable(out io.Writer, config *CommandConfig, rows []worktreeStatusRow) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PATH\tBRANCH\tCOMMIT\tSTATE\tAHEAD/BEHIND\tLAST COMMIT")
+	for _, row := range rows {
+		lastCommit := "-"
+		if !row.worktree.LastUpdated.IsZero() {
+			lastCommit = row.worktree.LastUpdated.Format("2006-01-02 15:04")
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", workspaceRelativePath(config, row.worktree.Path),
+			statusRowBranch(row.worktree), shortCommit(row.worktree.Commit), row.state(), row.aheadBehind(), lastCommit)
+	}
+	_ = w.Flush()
+}
+
+// displayWorktreeStatusShort prints one tab-separated line per worktree: path, branch, commit,
+// state, ahead/behind and the HEAD commit time in RFC 3339 ("-" when unknown)
+func displayWorktreeStatusShort(out io.Writer, config *CommandConfig, rows []worktreeStatusRow) {
+	for _, row := range rows {
+		lastCommit := "-"
+		if !row.worktree.LastUpdated.IsZero() {
+			lastCommit = row.worktree.LastUpdated.Format(time.RFC3339)
+		}
+		_, _ = fmt.Fprintln(out, strings.Join([]string{
+			workspaceRelativePath(config, row.worktree.Path), statusRowBranch(row.worktree),
+			shortCommit(row.worktree.Commit), row.state(), row.aheadBehind(), lastCommit,
+		}, "\t"))
+	}
+}
+
+// state is "clean", "dirty", or "unknown" when the status could not be read
+func (r worktreeStatusRow) state() string {
+	switch {
+	case r.status == nil:
+		return "unknown"
+	case r.status.HasUncommittedChanges:
+		return "dirty"
+	default:
+		return "clean"
+	}
+}
+
+// aheadBehind is "+ahead/-behind" against the upstream branch, "-" without one
+func (r worktreeStatusRow) aheadBehind() string {
+	if r.details == nil || r.details.Upstream == "" {
+		if r.details != nil && r.details.UpstreamErr != nil {
+			return unavailableDetail(r.details.UpstreamErr)
+		}
+		return "-"
+	}
+	return fmt.Sprintf("+%d/-%d", r.details.AheadCount, r.details.BehindCount)
+}
+
+// statusRowBranch is the branch of a worktree, "(detached)" for a detached HEAD
+func statusRowBranch(wt *domain.WorktreeInfo) string {
+	if wt.IsDetached || wt.Branch == "" {
+		return "(detached)"
+	}
+	return wt.Branch
+}
+
+// shortCommit abbreviates a commit hash to 7 characters
+func shortCommit(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+	if commit == "" {
+		return "-"
+	}
+	return commit
+}
+
+// workspaceRelativePath shortens a worktree path relative to the worktrees directory, or the
+// projects directory for main worktrees; other paths are returned unchanged
+func workspaceRelativePath(config *CommandConfig, path string) string {
+	if config.Config == nil {
+		return path
+	}
+	for _, dir := range []string{config.Config.WorktreesDirectory, config.Config.ProjectsDirectory} {
+		if dir == "" {
+			continue
+		}
+		if rel, err := filepath.Rel(dir, path); err == nil && rel != "." && !strings.HasPrefix(rel, "..") {
+			return rel
+		}
+	}
+	return path
+}
@@ -1,11 +1,15 @@
 package cmd
 
 import (
+	"context"
 	"path/filepath"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/carapace-sh/carapace"
+	"github.com/carapace-sh/carapace/pkg/cache/key"
 
 	"twiggit/internal/domain"
 )
@@ -24,24 +28,73 @@ func getCompletionTimeout(config *domain.Config) time.Duration {
 }
 
 // actionWorktreeTarget provides completion for worktree targets (project/branch)
-// Supports progressive completion via ActionMultiParts("/")
+// Supports progressive completion via ActionMultiParts("/"); after "<project>/" it completes
+// from the __complete-worktrees list, which the CompletionCache keeps between TABs
 func actionWorktreeTarget(config *CommandConfig, opts ...domain.SuggestionOption) carapace.Action {
+	return actionTarget(config, opts, func(parts []string) carapace.Action {
+		return actionWorktreePairs(parts, config)
+	})
+}
+
+// actionCreateTarget provides completion for the create target: after "<project>/" it offers
+// the project's branches, which need not have a worktree yet
+func actionCreateTarget(config *CommandConfig) carapace.Action {
+	return actionTarget(config, nil, func(parts []string) carapace.Action {
+		if len(parts) > 1 {
+			return carapace.ActionValues()
+		}
+		return actionBranchesForProject(parts[0], config)
+	})
+}
+
+// actionTarget completes the current context's suggestions for the first part of a
+// project/branch target and hands the parts typed so far to afterProject. It is not cached
+// as a whole: carapace keys that cache on the call site only, so every command and every
+// typed project would share one entry.
+func actionTarget(config *CommandConfig, opts []domain.SuggestionOption, afterProject func(parts []string) carapace.Action) carapace.Action {
 	return carapace.ActionMultiParts("/", func(c carapace.Context) carapace.Action {
 		timeout := getCompletionTimeout(config.Config)
 
-		switch len(c.Parts) {
-		case 0:
+		if len(c.Parts) == 0 {
 			return carapace.ActionCallback(func(c carapace.Context) carapace.Action {
 				return actionProjectsOrBranches(c, config, opts)
 			}).Timeout(timeout, carapace.ActionValues())
-		case 1:
-			return carapace.ActionCallback(func(c carapace.Context) carapace.Action {
-				return actionBranchesForProject(c.Parts[0], config)
-			}).Timeout(timeout, carapace.ActionValues())
-		default:
+		}
+		return afterProject(c.Parts)
+	})
+}
+
+// actionWorktreePairs suggests the next part of the worktree pairs starting with parts
+func actionWorktreePairs(parts []string, config *CommandConfig) carapace.Action {
+	timeout := getCompletionTimeout(config.Config)
+
+	return carapace.ActionCallback(func(_ carapace.Context) carapace.Action {
+		pairs, err := completeWorktreePairs(config, nil)
+		if err != nil {
 			return carapace.ActionValues()
 		}
-	}).Cache(5 * time.Second)
+		return carapace.ActionValues(nextPairParts(pairs, parts)...).NoSpace('/')
+	}).Timeout(timeout, carapace.ActionValues())
+}
+
+// nextPairParts returns the part following parts in each project/branch pair that starts
+// with them; a part with more to come keeps its trailing "/" (branch names may contain "/")
+func nextPairParts(pairs, parts []string) []string {
+	prefix := strings.Join(parts, "/") + "/"
+	next := []string{}
+	for _, pair := range pairs {
+		rest, ok := strings.CutPrefix(pair, prefix)
+		if !ok || rest == "" {
+			continue
+		}
+		if i := strings.Index(rest, "/"); i >= 0 {
+			rest = rest[:i+1]
+		}
+		if !slices.Contains(next, rest) {
+			next = append(next, rest)
+		}
+	}
+	return next
 }
 
 // actionBranches provides completion for branch names (--source flag)
@@ -63,6 +116,35 @@ func actionBranches(config *CommandConfig) carapace.Action {
 	}).Timeout(timeout, carapace.ActionValues()).Cache(5 * time.Second)
 }
 
+// actionProjects provides completion for project names
+func actionProjects(config *CommandConfig) carapace.Action {
+	timeout := getCompletionTimeout(config.Config)
+
+	return carapace.ActionCallback(func(_ carapace.Context) carapace.Action {
+		summaries, err := config.Services.ProjectService.ListProjectSummaries(context.Background())
+		if err != nil {
+			return carapace.ActionValues()
+		}
+
+		names := make([]string, 0, len(summaries))
+		for _, summary := range summaries {
+			names = append(names, summary.Name)
+		}
+		return carapace.ActionValues(names...)
+	}).Timeout(timeout, carapace.ActionValues()).Cache(5 * time.Second)
+}
+
+// actionPresets suggests the presets of create --preset
+func actionPresets(config *CommandConfig) carapace.Action {
+	return carapace.ActionCallback(func(_ carapace.Context) carapace.Action {
+		cfg := domain.DefaultConfig()
+		if config.Config != nil {
+			cfg = config.Config
+		}
+		return carapace.ActionValues(domain.PresetNames(cfg)...)
+	})
+}
+
 // actionProjectsOrBranches suggests projects or branches based on current context
 func actionProjectsOrBranches(c carapace.Context, config *CommandConfig, opts []domain.SuggestionOption) carapace.Action {
 	ctx, err := config.Services.ContextService.GetCurrentContext()
@@ -107,7 +189,7 @@ func actionBranchesForProject(projectName string, config *CommandConfig) carapac
 		}
 
 		return suggestionsToCarapaceAction(filtered, config.Config.DefaultSourceBranch)
-	}).Timeout(timeout, carapace.ActionValues()).Cache(5 * time.Second)
+	}).Timeout(timeout, carapace.ActionValues()).Cache(5*time.Second, key.String(projectName))
 }
 
 // sortSuggestions implements smart sorting:
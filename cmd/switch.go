@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// NewSwitchCommand creates the switch command, which prints the path of a worktree matched by name
+func NewSwitchCommand(config *CommandConfig) *cobra.Command {
+	var noInteractive bool
+
+	cmd := &cobra.Command{
+		Use:   "switch <project/branch|pattern>",
+		Short: "Switch to a worktree by exact or partial name",
+		Long: `Print the path of a worktree for the shell wrapper to change into.
+
+The target is first resolved like 'twiggit cd'. When that finds no worktree,
+every worktree of every project whose project/branch name contains the target
+(case-insensitive) is a candidate. A single candidate is switched to directly;
+with several, a numbered list is shown on stderr and the choice is read from
+stdin. --no-interactive, or running in CI, fails with the candidates instead.
+
+Examples:
+  twiggit switch myproject/feature-login   Exact project/branch
+  twiggit switch login                     Any worktree containing "login"
+  twiggit switch api/fix --no-interactive  Fail instead of asking when ambiguous`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeSwitch(cmd, config, args[0], noInteractive)
+		},
+	}
+
+	cmd.Flags().BoolVar(&noInteractive, "no-interactive", false, "Fail instead of asking when several worktrees match")
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionWorktreeTarget(config),
+	)
+
+	return cmd
+}
+
+// executeSwitch prints the path of the worktree target resolves or matches
+func executeSwitch(cmd *cobra.Command, config *CommandConfig, target string, noInteractive bool) error {
+	ctx := context.Background()
+
+	if path, ok := resolveSwitchExact(ctx, config, target); ok {
+		logv(cmd, 1, "Switching to %s", path)
+		recordVisit(cmd, config, path)
+		_, _ = fmt.Fprintln(cmd.OutOrStdout(), path)
+		return nil
+	}
+
+	logv(cmd, 1, "No exact match for %s, searching all worktrees", target)
+	worktrees, err := config.Services.WorktreeService.ListWorktrees(ctx, &domain.ListWorktreesRequest{
+		Context:         &domain.Context{Type: domain.ContextOutsideGit},
+		IncludeMain:     true,
+		ListAllProjects: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list worktrees: %w", err)
+	}
+
+	candidates := matchSwitchCandidates(worktrees, target)
+	var chosen *domain.WorktreeInfo
+	switch {
+	case len(candidates) == 0:
+		return domain.NewNavigationServiceError(target, "", "Switch", "no worktree matches", nil)
+	case len(candidates) == 1:
+		chosen = candidates[0]
+	case noInteractive || !allowsPrompts(config):
+		labels := make([]string, 0, len(candidates))
+		for _, wt := range candidates {
+			labels = append(labels, switchLabel(wt))
+		}
+		return domain.NewValidationError("switch", "target", target, fmt.Sprintf("%d worktrees match", len(candidates))).
+			WithSuggestions([]string{"Use one of: " + strings.Join(labels, ", ")})
+	default:
+		chosen, err = pickSwitchCandidate(cmd.InOrStdin(), cmd.ErrOrStderr(), candidates)
+		if err != nil {
+			return err
+		}
+	}
+
+	logv(cmd, 1, "Switching to %s", switchLabel(chosen))
+	recordVisit(cmd, config, chosen.Path)
+	_, _ = fmt.Fprintln(cmd.OutOrStdout(), chosen.Path)
+	return nil
+}
+
+// resolveSwitchExact resolves target like cd and reports whether it names an existing worktree
+func resolveSwitchExact(ctx context.Context, config *CommandConfig, target string) (string, bool) {
+	_, result, err := resolveNavigationTarget(ctx, config, target)
+	if err != nil || result.Type != domain.PathTypeWorktree {
+		return "", false
+	}
+	if err := config.Services.NavigationService.ValidatePath(ctx, result.ResolvedPath); err != nil {
+		return "", false
+	}
+	return result.ResolvedPath, true
+}
+
+// matchSwitchCandidates keeps worktrees whose project/branch label contains target, ignoring case
+func matchSwitchCandidates(worktrees []*domain.WorktreeInfo, target string) []*domain.WorktreeInfo {
+	needle := strings.ToLower(target)
+	var candidates []*domain.WorktreeInfo
+	for _, wt := range worktrees {
+		if strings.Contains(strings.ToLower(switchLabel(wt)), needle) {
+			candidates = append(candidates, wt)
+		}
+	}
+	return candidates
+}
+
+// switchLabel names a worktree as project/branch
+func switchLabel(wt *domain.WorktreeInfo) string {
+	return wt.Project + "/" + wt.Branch
+}
+
+// pickSwitchCandidate lists the candidates on out and reads the number of the choice from in
+func pickSwitchCandidate(in io.Reader, out io.Writer, candidates []*domain.WorktreeInfo) (*domain.WorktreeInfo, error) {
+	for i, wt := range candidates {
+		_, _ = fmt.Fprintf(out, "%d) %s  %s\n", i+1, switchLabel(wt), wt.Path)
+	}
+	_, _ = fmt.Fprintf(out, "Switch to [1-%d]: ", len(candidates))
+
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && line == "" {
+		_, _ = fmt.Fprintln(out)
+		return nil, fmt.Errorf("switch cancelled: %w", err)
+	}
+	choice, err := strconv.Atoi(strings.TrimSpace(line))
+	if err != nil || choice < 1 || choice > len(candidates) {
+		return nil, domain.NewValidationError("switch", "choice", strings.TrimSpace(line), fmt.Sprintf("expected a number from 1 to %d", len(candidates)))
+	}
+	return candidates[choice-1], nil
+}
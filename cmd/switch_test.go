@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestSwitchCommand_Execute(t *testing.T) {
+	projectCtx := &domain.Context{Type: domain.ContextProject, ProjectName: "api", Path: "/projects/api"}
+	worktrees := []*domain.WorktreeInfo{
+		{Project: "api", Branch: "main", Path: "/projects/api"},
+		{Project: "api", Branch: "feature-login", Path: "/wt/api/feature-login"},
+		{Project: "web", Branch: "feature-login-page", Path: "/wt/web/feature-login-page"},
+		{Project: "web", Branch: "fix-typo", Path: "/wt/web/fix-typo"},
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		stdin        string
+		terminal     application.TerminalDetector
+		exact        string // Resolved path that passes ValidatePath, "" when the target is not a worktree
+		expectError  string
+		expectPath   string
+		expectErrOut string
+	}{
+		{
+			name:       "exact match skips the search",
+			args:       []string{"api/feature-login"},
+			exact:      "/wt/api/feature-login",
+			expectPath: "/wt/api/feature-login",
+		},
+		{
+			name:       "single partial match",
+			args:       []string{"TYPO"},
+			expectPath: "/wt/web/fix-typo",
+		},
+		{
+			name:         "several matches ask for a choice",
+			args:         []string{"login"},
+			stdin:        "2\n",
+			expectPath:   "/wt/web/feature-login-page",
+			expectErrOut: "1) api/feature-login  /wt/api/feature-login\n2) web/feature-login-page  /wt/web/feature-login-page\nSwitch to [1-2]: ",
+		},
+		{
+			name:        "invalid choice",
+			args:        []string{"login"},
+			stdin:       "3\n",
+			expectError: "expected a number from 1 to 2",
+		},
+		{
+			name:        "no-interactive fails on several matches",
+			args:        []string{"login", "--no-interactive"},
+			expectError: "2 worktrees match",
+		},
+		{
+			name:        "CI fails on several matches",
+			args:        []string{"login"},
+			terminal:    mocks.NewCITerminalDetector(),
+			expectError: "2 worktrees match",
+		},
+		{
+			name:        "no match",
+			args:        []string{"nothing"},
+			expectError: "no worktree matches",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ns := mocks.NewMockNavigationService()
+			cs := mocks.NewMockContextService()
+			ws := mocks.NewMockWorktreeService()
+			cs.On("GetCurrentContext").Return(projectCtx, nil)
+			resolved := tc.exact
+			if resolved == "" {
+				resolved = "/wt/api/" + tc.args[0]
+			}
+			ns.On("ResolvePath", mock.Anything, mock.AnythingOfType("*domain.ResolvePathRequest")).
+				Return(&domain.ResolutionResult{ResolvedPath: resolved, Type: domain.PathTypeWorktree}, nil)
+			ns.On("RecordVisit", mock.Anything).Return(nil).Maybe()
+			if tc.exact != "" {
+				ns.On("ValidatePath", mock.Anything, tc.exact).Return(nil)
+			} else {
+				ns.On("ValidatePath", mock.Anything, resolved).Return(errors.New("path does not exist"))
+				ws.On("ListWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+					return req.ListAllProjects && req.IncludeMain
+				})).Return(worktrees, nil).Once()
+			}
+
+			terminal := tc.terminal
+			if terminal == nil {
+				terminal = mocks.NewInteractiveTerminalDetector()
+			}
+			config := &CommandConfig{
+				Config:   domain.DefaultConfig(),
+				Services: &ServiceContainer{NavigationService: ns, ContextService: cs, WorktreeService: ws},
+				Terminal: terminal,
+			}
+			cmd := NewSwitchCommand(config)
+			cmd.SilenceUsage = true
+			var out, errOut bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&errOut)
+			cmd.SetIn(strings.NewReader(tc.stdin))
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				assert.Empty(t, out.String())
+				ns.AssertNotCalled(t, "RecordVisit", mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectPath+"\n", out.String())
+			ns.AssertCalled(t, "RecordVisit", tc.expectPath)
+			if tc.expectErrOut != "" {
+				assert.Contains(t, errOut.String(), tc.expectErrOut)
+			}
+			ws.AssertExpectations(t)
+		})
+	}
+}
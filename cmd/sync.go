@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// defaultSyncConcurrency caps the worktrees pulled at once when services.max_concurrent is unset
+const defaultSyncConcurrency = 4
+
+// syncOptions holds the flag values for the sync command
+type syncOptions struct {
+	noRebase bool
+}
+
+// NewSyncCommand creates the sync command
+func NewSyncCommand(config *CommandConfig) *cobra.Command {
+	var opts syncOptions
+
+	cmd := &cobra.Command{
+		Use:   "sync [project]",
+		Short: "Pull the upstream of every worktree of a project",
+		Long: `Run git pull --rebase in every worktree of a project, several at a time
+(services.max_concurrent), and print a table of what happened to each:
+updated with the number of new commits, up-to-date, conflict, skipped or
+failed. The project defaults to the one of the current directory.
+
+Worktrees with uncommitted changes or a detached HEAD are skipped with a
+warning. A pull that conflicts is aborted, leaving the worktree as it was.
+--no-rebase merges the upstream instead of rebasing onto it.
+
+Ctrl-C stops the pulls in progress; the table then reports the worktrees
+that were already synced and marks the others cancelled.
+
+Examples:
+  twiggit sync
+  twiggit sync myproject
+  twiggit sync --no-rebase`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			}
+			return executeSync(cmd, config, projectName, opts)
+		},
+	}
+
+	// Worktrees that could not be synced are not a usage error
+	cmd.SilenceUsage = true
+
+	cmd.Flags().BoolVar(&opts.noRebase, "no-rebase", false, "Merge the upstream instead of rebasing onto it")
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionProjects(config),
+	)
+
+	return cmd
+}
+
+// executeSync pulls every non-bare worktree of the named or current project
+func executeSync(cmd *cobra.Command, config *CommandConfig, projectName string, opts syncOptions) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	projects, err := resolveVerifyProjects(ctx, config, projectName, false)
+	if err != nil {
+		return err
+	}
+	project := projects[0]
+
+	var worktrees []*domain.WorktreeInfo
+	for _, wt := range project.Worktrees {
+		if !wt.IsBare {
+			worktrees = append(worktrees, wt)
+		}
+	}
+	if len(worktrees) == 0 {
+		_, _ = fmt.Fprintln(cmd.OutOrStdout(), "No worktrees found")
+		return nil
+	}
+
+	logv(cmd, 1, "Pulling %d worktree(s) of %s", len(worktrees), project.Name)
+	results := syncWorktrees(ctx, config, worktrees, !opts.noRebase)
+	return reportSyncResults(cmd, results)
+}
+
+// syncWorktrees pulls up to services.max_concurrent worktrees at once; the results keep the
+// order of worktrees. Once ctx is cancelled, worktrees not yet started are reported cancelled.
+func syncWorktrees(ctx context.Context, config *CommandConfig, worktrees []*domain.WorktreeInfo, rebase bool) []*domain.SyncResult {
+	concurrency := defaultSyncConcurrency
+	if config.Config != nil && config.Config.Services.MaxConcurrent > 0 {
+		concurrency = config.Config.Services.MaxConcurrent
+	}
+
+	results := make([]*domain.SyncResult, len(worktrees))
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+	for i, wt := range worktrees {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, wt *domain.WorktreeInfo) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			results[i] = config.Services.WorktreeService.SyncWorktree(ctx, wt, rebase)
+		}(i, wt)
+	}
+	wg.Wait()
+	return results
+}
+
+// reportSyncResults warns about skipped worktrees on stderr, prints the result table and
+// fails when any worktree conflicted, failed, was interrupted or was cancelled
+func reportSyncResults(cmd *cobra.Command, results []*domain.SyncResult) error {
+	unsynced := 0
+	for _, result := range results {
+		switch result.Outcome {
+		case domain.SyncSkipped:
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Warning: skipped %s: %s\n", foreachName(result.Worktree), syncReason(result.Err))
+		case domain.SyncConflict, domain.SyncFailed, domain.SyncInterrupted, domain.SyncCancelled:
+			unsynced++
+		}
+	}
+
+	if !isQuiet(cmd) || unsynced > 0 {
+		displaySyncResults(cmd.OutOrStdout(), results)
+	}
+
+	if unsynced > 0 {
+		return fmt.Errorf("sync failed for %d of %d worktrees", unsynced, len(results))
+	}
+	return nil
+}
+
+// displaySyncResults prints a WORKTREE/RESULT/DETAILS table
+func displaySyncResults(out io.Writer, results []*domain.SyncResult) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "WORKTREE\tRESULT\tDETAILS")
+	for _, result := range results {
+		details := "-"
+		switch result.Outcome {
+		case domain.SyncUpdated:
+			details = fmt.Sprintf("%d new commit(s)", result.Commits)
+		case domain.SyncUpToDate:
+		case domain.SyncConflict:
+			details = "pull aborted, worktree unchanged"
+		case domain.SyncInterrupted:
+			details = "interrupted during pull, rebase or merge aborted"
+		case domain.SyncCancelled:
+			details = "not started"
+		default:
+			if result.Err != nil {
+				details = syncReason(result.Err)
+			}
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", foreachName(result.Worktree), result.Outcome, details)
+	}
+	_ = w.Flush()
+}
+
+// syncReason is the message of the innermost service error, without the worktree path the
+// table already shows
+func syncReason(err error) string {
+	var serviceErr *domain.WorktreeServiceError
+	if errors.As(err, &serviceErr) && serviceErr.Cause == nil {
+		return serviceErr.Message
+	}
+	return err.Error()
+}
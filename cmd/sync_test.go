@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestSyncCommand(t *testing.T) {
+	bare := &domain.WorktreeInfo{Path: "/repos/api", IsBare: true}
+	main := &domain.WorktreeInfo{Path: "/worktrees/api/main", Branch: "main"}
+	feature := &domain.WorktreeInfo{Path: "/worktrees/api/feature", Branch: "feature"}
+	wip := &domain.WorktreeInfo{Path: "/worktrees/api/wip", Branch: "wip"}
+	project := &domain.ProjectInfo{
+		Name: "api", GitRepoPath: "/repos/api",
+		Worktrees: []*domain.WorktreeInfo{bare, main, feature, wip},
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		results      map[*domain.WorktreeInfo]*domain.SyncResult
+		expectRebase bool
+		expectError  string
+		expectOut    []string
+		expectStderr string
+	}{
+		{
+			name: "pulls every worktree with rebase",
+			results: map[*domain.WorktreeInfo]*domain.SyncResult{
+				main:    {Worktree: main, Outcome: domain.SyncUpdated, Commits: 3},
+				feature: {Worktree: feature, Outcome: domain.SyncUpToDate},
+				wip: {Worktree: wip, Outcome: domain.SyncSkipped,
+					Err: domain.NewWorktreeServiceError(wip.Path, "wip", "SyncWorktree", "worktree has uncommitted changes", nil)},
+			},
+			expectRebase: true,
+			expectOut:    []string{"WORKTREE", "main      updated     3 new commit(s)", "feature   up-to-date  -", "wip       skipped     worktree has uncommitted changes"},
+			expectStderr: "Warning: skipped wip: worktree has uncommitted changes\n",
+		},
+		{
+			name: "no-rebase merges and failures fail the command",
+			args: []string{"api", "--no-rebase"},
+			results: map[*domain.WorktreeInfo]*domain.SyncResult{
+				main: {Worktree: main, Outcome: domain.SyncConflict,
+					Err: domain.NewWorktreeServiceError(main.Path, "main", "SyncWorktree", "pull conflicts", domain.ErrConflict)},
+				feature: {Worktree: feature, Outcome: domain.SyncUpToDate},
+				wip:     {Worktree: wip, Outcome: domain.SyncCancelled, Err: context.Canceled},
+			},
+			expectError: "sync failed for 2 of 3 worktrees",
+			expectOut:   []string{"main      conflict    pull aborted, worktree unchanged", "wip       cancelled   not started"},
+		},
+		{
+			name: "interrupted pull is told apart from one never started",
+			results: map[*domain.WorktreeInfo]*domain.SyncResult{
+				main:    {Worktree: main, Outcome: domain.SyncUpdated, Commits: 1},
+				feature: {Worktree: feature, Outcome: domain.SyncInterrupted, Err: context.Canceled},
+				wip:     {Worktree: wip, Outcome: domain.SyncCancelled, Err: context.Canceled},
+			},
+			expectRebase: true,
+			expectError:  "sync failed for 2 of 3 worktrees",
+			expectOut:    []string{"feature   interrupted  interrupted during pull, rebase or merge aborted", "wip       cancelled    not started"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextWorktree, ProjectName: "api"}, nil)
+			ps.On("DiscoverProject", mock.Anything, mock.Anything, mock.Anything).Return(project, nil)
+			for wt, result := range tc.results {
+				ws.On("SyncWorktree", mock.Anything, wt, tc.expectRebase).Return(result).Once()
+			}
+
+			config := &CommandConfig{
+				Config:   domain.DefaultConfig(),
+				Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps},
+			}
+			cmd := NewSyncCommand(config)
+			var out, stderr bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&stderr)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, line := range tc.expectOut {
+				assert.Contains(t, out.String(), line)
+			}
+			if tc.expectStderr != "" {
+				assert.Equal(t, tc.expectStderr, stderr.String())
+			}
+			ws.AssertExpectations(t)
+			ws.AssertNotCalled(t, "SyncWorktree", mock.Anything, bare, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"twiggit/internal/application"
+	"twiggit/internal/infrastructure"
+)
+
+// terminalOf returns the detector of config, or one reading the process environment
+// when none was injected
+func terminalOf(config *CommandConfig) application.TerminalDetector {
+	if config != nil && config.Terminal != nil {
+		return config.Terminal
+	}
+	return infrastructure.NewTerminalDetector()
+}
+
+// isTerminalWriter reports whether out is a file open on a terminal
+func isTerminalWriter(terminal application.TerminalDetector, out io.Writer) bool {
+	f, ok := out.(*os.File)
+	return ok && terminal.IsTerminal(f.Fd())
+}
+
+// supportsColor reports whether ANSI styling should be written to out: only to terminals,
+// and not with NO_COLOR or in CI
+func supportsColor(config *CommandConfig, out io.Writer) bool {
+	terminal := terminalOf(config)
+	return isTerminalWriter(terminal, out) && terminal.SupportsColor()
+}
+
+// showsProgress reports whether spinners and other redrawn progress may be written to out
+func showsProgress(config *CommandConfig, out io.Writer) bool {
+	terminal := terminalOf(config)
+	return isTerminalWriter(terminal, out) && !terminal.IsCI()
+}
+
+// allowsPrompts reports whether twiggit may ask questions by default; CI runs cannot answer
+func allowsPrompts(config *CommandConfig) bool {
+	return !terminalOf(config).IsCI()
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/test/mocks"
+)
+
+func TestTerminalOutputChecks(t *testing.T) {
+	file, err := os.CreateTemp(t.TempDir(), "out")
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+
+	interactive := &CommandConfig{Terminal: mocks.NewInteractiveTerminalDetector()}
+	ci := &CommandConfig{Terminal: mocks.NewCITerminalDetector()}
+
+	assert.True(t, supportsColor(interactive, file))
+	assert.True(t, showsProgress(interactive, file))
+	assert.True(t, allowsPrompts(interactive))
+
+	assert.False(t, supportsColor(interactive, &bytes.Buffer{}), "only files can be terminals")
+	assert.False(t, showsProgress(interactive, &bytes.Buffer{}))
+
+	assert.False(t, supportsColor(ci, file))
+	assert.False(t, showsProgress(ci, file))
+	assert.False(t, allowsPrompts(ci))
+}
+
+func TestTerminalOf_DefaultsToEnvironment(t *testing.T) {
+	t.Setenv("CI", "true")
+	assert.True(t, terminalOf(&CommandConfig{}).IsCI())
+	assert.True(t, terminalOf(nil).IsCI())
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+	"twiggit/internal/infrastructure"
+)
+
+// defaultTimelineLimit is the number of commits shown when --limit is not given
+const defaultTimelineLimit = 10
+
+// NewTimelineCommand creates a new timeline command
+func NewTimelineCommand(config *CommandConfig) *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "timeline [<project>/<branch>]",
+		Short: "Show the recent commits of a worktree with the changes each made",
+		Long: `Show how a worktree has evolved: its most recent commits, newest first,
+with the files and lines each commit changed. Commits that changed nothing,
+such as empty commits or clean merges, are marked "no changes". Uncommitted
+changes in the working tree are shown above the first commit.
+
+When no target is given the current worktree is used.
+
+Examples:
+  twiggit timeline                       Timeline of the current worktree
+  twiggit timeline feature-x --limit 20  Last 20 commits of feature-x
+  twiggit timeline myproject/feature-x   Timeline of a worktree in another project`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var target string
+			if len(args) > 0 {
+				target = args[0]
+			}
+			return executeTimeline(cmd, config, target, limit)
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "n", defaultTimelineLimit, "Number of commits to show")
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionWorktreeTarget(config, infrastructure.WithExistingOnly()),
+	)
+
+	return cmd
+}
+
+// executeTimeline executes the timeline command with the given configuration
+func executeTimeline(cmd *cobra.Command, config *CommandConfig, target string, limit int) error {
+	if limit <= 0 {
+		return domain.NewValidationError("timeline", "limit", fmt.Sprint(limit), "limit must be positive")
+	}
+
+	worktreePath, err := resolveTimelinePath(config, target)
+	if err != nil {
+		return err
+	}
+
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	logv(cmd, 1, "Reading the last %d commits of %s", limit, worktreePath)
+
+	timeline, err := config.Services.WorktreeService.GetWorktreeTimeline(context.Background(), worktreePath, limit)
+	if err != nil {
+		return fmt.Errorf("failed to read timeline: %w", err)
+	}
+
+	displayTimeline(cmd.OutOrStdout(), timeline)
+	return nil
+}
+
+// resolveTimelinePath returns the worktree named by target, or the current worktree when target is empty
+func resolveTimelinePath(config *CommandConfig, target string) (string, error) {
+	if target != "" {
+		_, resolution, err := resolveWorktreeTarget(config, target)
+		if err != nil {
+			return "", err
+		}
+		return resolution.ResolvedPath, nil
+	}
+
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil {
+		return "", fmt.Errorf("context detection failed: %w", err)
+	}
+	if currentCtx.Type != domain.ContextWorktree && currentCtx.Type != domain.ContextProject {
+		return "", domain.NewValidationError("timeline", "target", "", "target required outside a project or worktree")
+	}
+	return currentCtx.Path, nil
+}
+
+// displayTimeline renders the timeline as a COMMIT/DATE/AUTHOR/CHANGES/MESSAGE table
+func displayTimeline(out io.Writer, timeline *domain.WorktreeTimeline) {
+	name := timeline.Branch
+	if name == "" {
+		name = "detached HEAD"
+	}
+	_, _ = fmt.Fprintf(out, "Timeline of %s (%s)\n\n", name, timeline.Path)
+
+	if len(timeline.Commits) == 0 && !timeline.Dirty {
+		_, _ = fmt.Fprintln(out, "No commits")
+		return
+	}
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "COMMIT\tDATE\tAUTHOR\tCHANGES\tMESSAGE")
+	if timeline.Dirty {
+		_, _ = fmt.Fprintln(tw, "(working tree)\t\t\tuncommitted changes\t")
+	}
+	for _, entry := range timeline.Commits {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+			entry.ShortHash, entry.Date.Format("2006-01-02"), entry.Author, entry.Summary(), entry.Message)
+	}
+	_ = tw.Flush()
+}
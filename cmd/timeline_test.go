@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestTimelineCommand_Execute(t *testing.T) {
+	worktreeCtx := &domain.Context{Type: domain.ContextWorktree, ProjectName: "proj", BranchName: "feature-x", Path: "/wt/proj/feature-x"}
+	date := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	timeline := &domain.WorktreeTimeline{
+		Path:   "/wt/proj/feature-x",
+		Branch: "feature-x",
+		Dirty:  true,
+		Commits: []domain.TimelineEntry{
+			{
+				CommitInfo:     domain.CommitInfo{ShortHash: "c2", Author: "Alice", Date: date, Message: "Merge main"},
+				StatusSnapshot: domain.StatusSnapshot{},
+			},
+			{
+				CommitInfo:     domain.CommitInfo{ShortHash: "c1", Author: "Bob", Date: date, Message: "Add login"},
+				StatusSnapshot: domain.StatusSnapshot{FilesChanged: 2, Insertions: 10, Deletions: 1},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		setupMocks  func(*mocks.MockWorktreeService, *mocks.MockContextService)
+		expectError string
+		expectOut   []string
+	}{
+		{
+			name: "current worktree",
+			args: []string{},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService) {
+				cs.On("GetCurrentContext").Return(worktreeCtx, nil)
+				ws.On("GetWorktreeTimeline", mock.Anything, "/wt/proj/feature-x", defaultTimelineLimit).Return(timeline, nil)
+			},
+			expectOut: []string{
+				"Timeline of feature-x (/wt/proj/feature-x)",
+				"COMMIT",
+				"uncommitted changes",
+				"no changes",
+				"2026-03-01",
+				"2 file(s), +10 -1",
+				"Add login",
+			},
+		},
+		{
+			name: "named target with limit",
+			args: []string{"proj/feature-x", "--limit", "2"},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService) {
+				cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+				cs.On("ResolveIdentifier", "proj/feature-x").Return(&domain.ResolutionResult{
+					Type: domain.PathTypeWorktree, ProjectName: "proj", BranchName: "feature-x", ResolvedPath: "/wt/proj/feature-x",
+				}, nil)
+				ws.On("GetWorktreeTimeline", mock.Anything, "/wt/proj/feature-x", 2).Return(timeline, nil)
+			},
+			expectOut: []string{"Merge main"},
+		},
+		{
+			name: "no commits",
+			args: []string{},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService) {
+				cs.On("GetCurrentContext").Return(worktreeCtx, nil)
+				ws.On("GetWorktreeTimeline", mock.Anything, "/wt/proj/feature-x", defaultTimelineLimit).
+					Return(&domain.WorktreeTimeline{Path: "/wt/proj/feature-x", Branch: "feature-x"}, nil)
+			},
+			expectOut: []string{"No commits"},
+		},
+		{
+			name: "outside git without target",
+			args: []string{},
+			setupMocks: func(_ *mocks.MockWorktreeService, cs *mocks.MockContextService) {
+				cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+			},
+			expectError: "target required outside a project or worktree",
+		},
+		{
+			name:        "invalid limit",
+			args:        []string{"--limit", "0"},
+			setupMocks:  func(_ *mocks.MockWorktreeService, _ *mocks.MockContextService) {},
+			expectError: "limit must be positive",
+		},
+		{
+			name: "service failure",
+			args: []string{},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService) {
+				cs.On("GetCurrentContext").Return(worktreeCtx, nil)
+				ws.On("GetWorktreeTimeline", mock.Anything, "/wt/proj/feature-x", defaultTimelineLimit).Return(nil, errors.New("bad object"))
+			},
+			expectError: "failed to read timeline",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			tc.setupMocks(ws, cs)
+
+			config := &CommandConfig{Services: &ServiceContainer{WorktreeService: ws, ContextService: cs}}
+			cmd := NewTimelineCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+			ws.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+// watchWorktreeChanges runs the post-change hook of the project's .twiggit.toml after each
+// burst of file changes in the new worktree, until Ctrl-C. The worktree is kept.
+func watchWorktreeChanges(ctx context.Context, cmd *cobra.Command, config *CommandConfig, project *domain.ProjectInfo, worktree *domain.WorktreeInfo, source string) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	req := &application.HookRunRequest{
+		HookType:       domain.HookPostChange,
+		WorktreePath:   worktree.Path,
+		ProjectName:    project.Name,
+		BranchName:     worktree.Branch,
+		SourceBranch:   source,
+		MainRepoPath:   project.GitRepoPath,
+		ConfigFilePath: filepath.Join(project.GitRepoPath, ".twiggit.toml"),
+	}
+
+	// stdout may be read by the shell wrapper, so watch output goes to stderr
+	errOut := cmd.ErrOrStderr()
+	_, _ = fmt.Fprintf(errOut, "Watching %s for changes (Ctrl-C to stop)\n", worktree.Path)
+
+	err := config.Services.ChangeWatcher.Watch(ctx, req, config.Services.HookRunner, func(run domain.HookRun) {
+		displayHookRun(errOut, worktree.Path, run)
+	})
+	if err != nil {
+		return fmt.Errorf("worktree created but failed to watch for changes: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(errOut, "Stopped watching; worktree kept at %s\n", worktree.Path)
+	return nil
+}
+
+// displayHookRun prints "<file>: exit <code> (<duration>)" for one post-change run,
+// followed by the output of failed commands
+func displayHookRun(out io.Writer, worktreePath string, run domain.HookRun) {
+	file := run.ChangedFile
+	if rel, err := filepath.Rel(worktreePath, run.ChangedFile); err == nil {
+		file = rel
+	}
+
+	switch {
+	case run.Err != nil:
+		_, _ = fmt.Fprintf(out, "%s: failed to run post-change hook: %v\n", file, run.Err)
+		return
+	case run.Result == nil || !run.Result.Executed:
+		_, _ = fmt.Fprintf(out, "%s: no post-change hook in .twiggit.toml\n", file)
+		return
+	}
+
+	_, _ = fmt.Fprintf(out, "%s: exit %d (%s)\n", file, run.ExitCode(), run.Duration.Round(time.Millisecond))
+	for _, failure := range run.Result.Failures {
+		_, _ = fmt.Fprintf(out, "  %s: exit %d\n", failure.Command, failure.ExitCode)
+		if failure.Output == "" {
+			continue
+		}
+		for _, line := range strings.Split(failure.Output, "\n") {
+			_, _ = fmt.Fprintf(out, "    %s\n", line)
+		}
+	}
+}
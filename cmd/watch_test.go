@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestCreateCommand_Watch(t *testing.T) {
+	testCases := []struct {
+		name        string
+		runs        []domain.HookRun
+		watchErr    error
+		expectError string
+		expectErr   []string
+	}{
+		{
+			name: "reports each hook run",
+			runs: []domain.HookRun{
+				{ChangedFile: "/wt/proj/feature/main.go", Result: &domain.HookResult{Executed: true, Success: true}, Duration: 1200 * time.Millisecond},
+				{ChangedFile: "/wt/proj/feature/pkg/a.go", Result: &domain.HookResult{Executed: true, Failures: []domain.HookFailure{
+					{Command: "go test ./...", ExitCode: 1, Output: "FAIL pkg\nexit status 1"},
+				}}, Duration: 300 * time.Millisecond},
+				{ChangedFile: "/wt/proj/feature/README.md", Result: &domain.HookResult{}},
+				{ChangedFile: "/wt/proj/feature/x", Err: errors.New("config unreadable")},
+			},
+			expectErr: []string{
+				"Watching /wt/proj/feature for changes (Ctrl-C to stop)",
+				"main.go: exit 0 (1.2s)",
+				"pkg/a.go: exit 1 (300ms)",
+				"  go test ./...: exit 1\n    FAIL pkg\n    exit status 1",
+				"README.md: no post-change hook in .twiggit.toml",
+				"x: failed to run post-change hook: config unreadable",
+				"Stopped watching; worktree kept at /wt/proj/feature",
+			},
+		},
+		{
+			name:        "watch failure",
+			watchErr:    errors.New("too many open files"),
+			expectError: "worktree created but failed to watch for changes: too many open files",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockPS := mocks.NewMockProjectService()
+			watcher := mocks.NewMockChangeWatcher()
+
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+			mockPS.On("DiscoverProject", mock.Anything, "proj", mock.AnythingOfType("*domain.Context")).
+				Return(&domain.ProjectInfo{Name: "proj", Path: "/repos/proj", GitRepoPath: "/repos/proj"}, nil)
+			mockWS.On("BranchExists", mock.Anything, mock.Anything, "main").Return(true, nil)
+			mockWS.On("CreateWorktree", mock.Anything, mock.AnythingOfType("*domain.CreateWorktreeRequest")).Return(&domain.CreateWorktreeResult{
+				Worktree: &domain.WorktreeInfo{Path: "/wt/proj/feature", Branch: "feature"},
+			}, nil)
+			watcher.On("Watch", mock.Anything, mock.MatchedBy(func(req *application.HookRunRequest) bool {
+				return req.HookType == domain.HookPostChange && req.WorktreePath == "/wt/proj/feature" &&
+					req.BranchName == "feature" && req.SourceBranch == "main" && req.ConfigFilePath == "/repos/proj/.twiggit.toml"
+			}), mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				report := args.Get(3).(func(domain.HookRun))
+				for _, run := range tc.runs {
+					report(run)
+				}
+			}).Return(tc.watchErr)
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{
+					WorktreeService: mockWS,
+					ContextService:  mockCS,
+					ProjectService:  mockPS,
+					ChangeWatcher:   watcher,
+				},
+				Config: domain.DefaultConfig(),
+			}
+
+			cmd := NewCreateCommand(config)
+			var errOut bytes.Buffer
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&errOut)
+			cmd.SetArgs([]string{"feature", "--watch"})
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, expected := range tc.expectErr {
+				assert.Contains(t, errOut.String(), expected)
+			}
+			watcher.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateCommand_WatchValidation(t *testing.T) {
+	testCases := []struct {
+		name        string
+		args        []string
+		expectError string
+	}{
+		{name: "with --watch-ci", args: []string{"feature", "--watch", "--watch-ci"}, expectError: "--watch cannot be combined with --watch-ci"},
+		{name: "with --worktree-only", args: []string{"feature", "--watch", "--worktree-only"}, expectError: "--watch cannot be combined with --worktree-only"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := NewCreateCommand(&CommandConfig{Services: &ServiceContainer{}, Config: domain.DefaultConfig()})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectError)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewWorktreesCommand creates the worktrees command group for maintenance subcommands
+func NewWorktreesCommand(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "worktrees",
+		Short: "Inspect and maintain worktrees",
+		Long: `Maintenance commands that operate on a project's worktrees.
+
+Examples:
+  twiggit worktrees verify            Check the current project for worktree discrepancies
+  twiggit worktrees verify --all      Check every project
+  twiggit worktrees foreach 'go build ./...'  Build every worktree of the current project
+  twiggit worktrees export-gitconfig  Print per-worktree git config as a ~/.gitconfig snippet
+  twiggit worktrees clean-links       Remove links to worktrees that were deleted
+  twiggit worktrees batch-delete worktrees.yaml  Delete the worktrees listed in a YAML file
+  twiggit worktrees lock-all --reason "maintenance"  Lock every worktree of the current project
+  twiggit worktrees unlock-all        Unlock them again
+  twiggit worktrees list-locked       Show which worktrees are locked
+  twiggit worktrees sort branch       Make twiggit list sort by branch by default
+  twiggit worktrees copy-hooks api web  Copy the git hooks of api to web
+  twiggit worktrees health-check      Check every worktree of every project (--output junit for CI)
+  twiggit worktrees print-env         Print worktrees as shell (or --format makefile) variables
+  twiggit worktrees stats --prometheus  Export worktree metrics for Prometheus
+  twiggit worktrees set-default develop  Make twiggit cd <project> open develop
+  twiggit worktrees unset-default     Open the main branch again`,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newWorktreesVerifyCmd(config))
+	cmd.AddCommand(newWorktreesForeachCmd(config))
+	cmd.AddCommand(newWorktreesExportGitConfigCmd(config))
+	cmd.AddCommand(newWorktreesCleanLinksCmd(config))
+	cmd.AddCommand(newWorktreesBatchDeleteCmd(config))
+	cmd.AddCommand(newWorktreesLockAllCmd(config))
+	cmd.AddCommand(newWorktreesUnlockAllCmd(config))
+	cmd.AddCommand(newWorktreesListLockedCmd(config))
+	cmd.AddCommand(newWorktreesSortCmd(config))
+	cmd.AddCommand(newWorktreesCopyHooksCmd(config))
+	cmd.AddCommand(newWorktreesHealthCheckCmd(config))
+	cmd.AddCommand(newWorktreesPrintEnvCmd(config))
+	cmd.AddCommand(newWorktreesStatsCmd(config))
+	cmd.AddCommand(newWorktreesSetDefaultCmd(config))
+	cmd.AddCommand(newWorktreesUnsetDefaultCmd(config))
+
+	return cmd
+}
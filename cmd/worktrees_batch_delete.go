@@ -0,0 +1,249 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"twiggit/internal/domain"
+)
+
+// batchDeleteOptions holds the flag values for the worktrees batch-delete command
+type batchDeleteOptions struct {
+	dryRun         bool
+	force          bool
+	deleteBranches bool
+}
+
+// Results of one batch-delete entry
+const (
+	batchResultDeleted     = "deleted"
+	batchResultWouldDelete = "would delete"
+	batchResultSkipped     = "skipped"
+	batchResultError       = "error"
+)
+
+// batchDeleteOutcome is what happened to one entry of the batch file
+type batchDeleteOutcome struct {
+	entry    string
+	result   string
+	reason   string // Why the entry was skipped
+	err      error
+	duration time.Duration
+}
+
+// newWorktreesBatchDeleteCmd creates the worktrees batch-delete subcommand
+func newWorktreesBatchDeleteCmd(config *CommandConfig) *cobra.Command {
+	var opts batchDeleteOptions
+
+	cmd := &cobra.Command{
+		Use:   "batch-delete <file>",
+		Short: "Delete the worktrees listed in a YAML file",
+		Long: `Delete every worktree listed in a YAML file containing a list of
+<project>/<branch> strings:
+
+  - myproject/feature-a
+  - myproject/feature-b
+  - otherproject/release-1.2
+
+Entries are deleted one after another; a failing entry does not stop the
+batch. Worktrees with uncommitted changes and entries that no longer have a
+worktree are skipped (use --force to delete dirty worktrees). A table with
+the result and duration of each entry is printed at the end, and the
+command fails when any entry failed.
+
+Examples:
+  twiggit worktrees batch-delete worktrees.yaml
+  twiggit worktrees batch-delete worktrees.yaml --dry-run
+  twiggit worktrees batch-delete worktrees.yaml --force --delete-branches`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeWorktreesBatchDelete(cmd, config, args[0], opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.dryRun, "dry-run", "n", false, "Show what would be deleted without deleting anything")
+	cmd.Flags().BoolVarP(&opts.force, "force", "f", false, "Delete worktrees even with uncommitted changes")
+	cmd.Flags().BoolVar(&opts.deleteBranches, "delete-branches", false, "Also delete the branch of each deleted worktree")
+
+	// Failed entries are reported in the table; main reports the error
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	carapace.Gen(cmd).PositionalCompletion(
+		carapace.ActionFiles(".yaml", ".yml"),
+	)
+
+	return cmd
+}
+
+// executeWorktreesBatchDelete deletes each entry of the batch file and prints a summary table
+func executeWorktreesBatchDelete(cmd *cobra.Command, config *CommandConfig, path string, opts batchDeleteOptions) error {
+	ctx := context.Background()
+
+	data, err := os.ReadFile(path) // #nosec G304 -- batch file chosen by the user on the command line
+	if err != nil {
+		return fmt.Errorf("failed to read batch file: %w", err)
+	}
+	entries, err := parseBatchDeleteFile(data)
+	if err != nil {
+		return fmt.Errorf("invalid batch file %s: %w", path, err)
+	}
+	if len(entries) == 0 {
+		_, _ = fmt.Fprintln(cmd.OutOrStdout(), "No worktrees listed")
+		return nil
+	}
+
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil {
+		return fmt.Errorf("context detection failed: %w", err)
+	}
+
+	logv(cmd, 1, "Deleting %d worktree(s) from %s", len(entries), path)
+
+	outcomes := make([]batchDeleteOutcome, 0, len(entries))
+	var projects []string
+	for i, entry := range entries {
+		if slices.Contains(entries[:i], entry) {
+			outcomes = append(outcomes, batchDeleteOutcome{entry: entry, result: batchResultSkipped, reason: "duplicate"})
+			continue
+		}
+
+		start := time.Now()
+		outcome, projectName := deleteBatchEntry(ctx, config, currentCtx, entry, opts)
+		outcome.duration = time.Since(start)
+		outcomes = append(outcomes, outcome)
+		logv(cmd, 2, "  %s: %s", entry, outcome.result)
+
+		if outcome.result == batchResultDeleted && !slices.Contains(projects, projectName) {
+			projects = append(projects, projectName)
+		}
+	}
+
+	for _, projectName := range projects {
+		cleanStaleLinksAfterDelete(ctx, cmd, config, projectName, currentCtx)
+	}
+
+	failed := displayBatchDeleteOutcomes(cmd.OutOrStdout(), cmd.ErrOrStderr(), outcomes, opts.dryRun)
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %d of %d worktree(s)", failed, len(entries))
+	}
+	return nil
+}
+
+// parseBatchDeleteFile reads a YAML list of <project>/<branch> strings
+func parseBatchDeleteFile(data []byte) ([]string, error) {
+	var entries []string
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("expected a YAML list of <project>/<branch> strings: %w", err)
+	}
+
+	for i, entry := range entries {
+		entries[i] = strings.TrimSpace(entry)
+		if entries[i] == "" {
+			return nil, domain.NewValidationError("worktrees batch-delete", "entry", fmt.Sprint(i+1), "entry cannot be empty")
+		}
+	}
+	return entries, nil
+}
+
+// deleteBatchEntry resolves and deletes one entry, returning its outcome and project name
+func deleteBatchEntry(ctx context.Context, config *CommandConfig, currentCtx *domain.Context, entry string, opts batchDeleteOptions) (batchDeleteOutcome, string) {
+	outcome := batchDeleteOutcome{entry: entry}
+
+	resolution, err := config.Services.ContextService.ResolveIdentifier(entry)
+	if err == nil && resolution.Type == domain.PathTypeInvalid {
+		err = errors.New(resolution.Explanation)
+	}
+	if err == nil && resolution.ResolvedPath == "" {
+		err = errors.New("no worktree path resolved")
+	}
+	if err != nil {
+		outcome.result, outcome.err = batchResultError, fmt.Errorf("failed to resolve %s: %w", entry, err)
+		return outcome, ""
+	}
+	worktreePath := resolution.ResolvedPath
+
+	if !opts.force {
+		status, err := config.Services.WorktreeService.GetWorktreeStatus(ctx, worktreePath)
+		switch {
+		case isWorktreeNotFound(err):
+			outcome.result, outcome.reason = batchResultSkipped, "not found"
+			return outcome, resolution.ProjectName
+		case err != nil:
+			outcome.result, outcome.err = batchResultError, fmt.Errorf("failed to check worktree status: %w", err)
+			return outcome, resolution.ProjectName
+		case !status.IsClean:
+			outcome.result, outcome.reason = batchResultSkipped, "uncommitted changes"
+			return outcome, resolution.ProjectName
+		}
+	}
+
+	if opts.dryRun {
+		outcome.result = batchResultWouldDelete
+		return outcome, resolution.ProjectName
+	}
+
+	err = config.Services.WorktreeService.DeleteWorktree(ctx, &domain.DeleteWorktreeRequest{
+		WorktreePath: worktreePath,
+		Force:        opts.force,
+		DeleteBranch: opts.deleteBranches,
+		Context:      currentCtx,
+	})
+	if err != nil {
+		outcome.result, outcome.err = batchResultError, err
+		return outcome, resolution.ProjectName
+	}
+
+	outcome.result = batchResultDeleted
+	return outcome, resolution.ProjectName
+}
+
+// displayBatchDeleteOutcomes prints the BRANCH/RESULT/DURATION table and a totals line,
+// lists errors on errOut and returns the number of failed entries
+func displayBatchDeleteOutcomes(out, errOut io.Writer, outcomes []batchDeleteOutcome, dryRun bool) int {
+	if dryRun {
+		_, _ = fmt.Fprintln(out, "Dry run - no changes made:")
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "BRANCH\tRESULT\tDURATION")
+	deleted, skipped, failed := 0, 0, 0
+	for _, outcome := range outcomes {
+		result := outcome.result
+		switch outcome.result {
+		case batchResultDeleted, batchResultWouldDelete:
+			deleted++
+		case batchResultSkipped:
+			skipped++
+			result += " (" + outcome.reason + ")"
+		case batchResultError:
+			failed++
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", outcome.entry, result, outcome.duration.Round(time.Millisecond))
+	}
+	_ = w.Flush()
+
+	verb := "deleted"
+	if dryRun {
+		verb = "would be deleted"
+	}
+	_, _ = fmt.Fprintf(out, "\n%d %s, %d skipped, %d failed\n", deleted, verb, skipped, failed)
+
+	for _, outcome := range outcomes {
+		if outcome.err != nil {
+			_, _ = fmt.Fprintf(errOut, "Error: %s: %v\n", outcome.entry, outcome.err)
+		}
+	}
+	return failed
+}
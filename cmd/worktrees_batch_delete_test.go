@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestParseBatchDeleteFile(t *testing.T) {
+	testCases := []struct {
+		name        string
+		data        string
+		expected    []string
+		expectError string
+	}{
+		{name: "list of entries", data: "- proj/feature-a\n- \"proj/feature-b \"\n", expected: []string{"proj/feature-a", "proj/feature-b"}},
+		{name: "empty file", data: "", expected: nil},
+		{name: "mapping is rejected", data: "worktrees: [proj/a]\n", expectError: "expected a YAML list"},
+		{name: "empty entry", data: "- proj/a\n- \"\"\n", expectError: "entry cannot be empty"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := parseBatchDeleteFile([]byte(tc.data))
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, entries)
+		})
+	}
+}
+
+func TestWorktreesBatchDeleteCommand_Execute(t *testing.T) {
+	const batchFile = "- proj/feature-a\n- proj/feature-b\n- proj/feature-a\n- proj/dirty\n- proj/gone\n"
+	clean := &domain.WorktreeStatus{IsClean: true}
+
+	resolve := func(cs *mocks.MockContextService) {
+		for _, branch := range []string{"feature-a", "feature-b", "dirty", "gone"} {
+			cs.On("ResolveIdentifier", "proj/"+branch).Return(&domain.ResolutionResult{
+				Type:         domain.PathTypeWorktree,
+				ProjectName:  "proj",
+				ResolvedPath: "/wt/proj/" + branch,
+			}, nil)
+		}
+	}
+	statuses := func(ws *mocks.MockWorktreeService) {
+		ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj/feature-a").Return(clean, nil)
+		ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj/feature-b").Return(clean, nil)
+		ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj/dirty").Return(&domain.WorktreeStatus{IsClean: false}, nil)
+		ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj/gone").
+			Return(nil, domain.NewWorktreeServiceError("/wt/proj/gone", "", "GetWorktreeStatus", "worktree not found", nil))
+	}
+	deleteRequest := func(path string, force, deleteBranch bool) interface{} {
+		return mock.MatchedBy(func(req *domain.DeleteWorktreeRequest) bool {
+			return req.WorktreePath == path && req.Force == force && req.DeleteBranch == deleteBranch
+		})
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		setupMocks   func(*mocks.MockWorktreeService, *mocks.MockContextService)
+		expectError  string
+		expectOut    []string
+		expectErrOut []string
+	}{
+		{
+			name: "deletes clean worktrees and skips the rest",
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService) {
+				resolve(cs)
+				statuses(ws)
+				ws.On("DeleteWorktree", mock.Anything, deleteRequest("/wt/proj/feature-a", false, false)).Return(nil).Once()
+				ws.On("DeleteWorktree", mock.Anything, deleteRequest("/wt/proj/feature-b", false, false)).Return(nil).Once()
+			},
+			expectOut: []string{
+				"BRANCH",
+				"proj/feature-a  deleted",
+				"proj/feature-a  skipped (duplicate)",
+				"proj/dirty      skipped (uncommitted changes)",
+				"proj/gone       skipped (not found)",
+				"2 deleted, 3 skipped, 0 failed",
+			},
+		},
+		{
+			name: "failures continue and fail the command",
+			args: []string{"--force", "--delete-branches"},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService) {
+				resolve(cs)
+				ws.On("DeleteWorktree", mock.Anything, deleteRequest("/wt/proj/feature-a", true, true)).Return(errors.New("locked")).Once()
+				ws.On("DeleteWorktree", mock.Anything, deleteRequest("/wt/proj/feature-b", true, true)).Return(nil).Once()
+				ws.On("DeleteWorktree", mock.Anything, deleteRequest("/wt/proj/dirty", true, true)).Return(nil).Once()
+				ws.On("DeleteWorktree", mock.Anything, deleteRequest("/wt/proj/gone", true, true)).Return(nil).Once()
+			},
+			expectError:  "failed to delete 1 of 5 worktree(s)",
+			expectOut:    []string{"proj/feature-a  error", "proj/feature-b  deleted", "3 deleted, 1 skipped, 1 failed"},
+			expectErrOut: []string{"Error: proj/feature-a: locked"},
+		},
+		{
+			name: "dry run deletes nothing",
+			args: []string{"--dry-run"},
+			setupMocks: func(ws *mocks.MockWorktreeService, cs *mocks.MockContextService) {
+				resolve(cs)
+				statuses(ws)
+			},
+			expectOut: []string{"Dry run - no changes made:", "proj/feature-a  would delete", "2 would be deleted, 3 skipped, 0 failed"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "worktrees.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(batchFile), 0644))
+
+			mockWS := mocks.NewMockWorktreeService()
+			mockCS := mocks.NewMockContextService()
+			mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+			tc.setupMocks(mockWS, mockCS)
+
+			config := &CommandConfig{
+				Services: &ServiceContainer{WorktreeService: mockWS, ContextService: mockCS},
+			}
+			cmd := NewWorktreesCommand(config)
+			var out, errOut bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&errOut)
+			cmd.SetArgs(append([]string{"batch-delete", path}, tc.args...))
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+			for _, expected := range tc.expectErrOut {
+				assert.Contains(t, errOut.String(), expected)
+			}
+			mockWS.AssertExpectations(t)
+			if tc.name == "dry run deletes nothing" {
+				mockWS.AssertNotCalled(t, "DeleteWorktree", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestWorktreesBatchDeleteCommand_Errors(t *testing.T) {
+	t.Run("missing file", func(t *testing.T) {
+		cmd := NewWorktreesCommand(&CommandConfig{Services: &ServiceContainer{}})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetArgs([]string{"batch-delete", filepath.Join(t.TempDir(), "missing.yaml")})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read batch file")
+	})
+
+	t.Run("unresolvable entry", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "worktrees.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("- nope\n"), 0644))
+
+		mockCS := mocks.NewMockContextService()
+		mockCS.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+		mockCS.On("ResolveIdentifier", "nope").Return(&domain.ResolutionResult{Type: domain.PathTypeInvalid, Explanation: "unknown project"}, nil)
+
+		cmd := NewWorktreesCommand(&CommandConfig{Services: &ServiceContainer{ContextService: mockCS, WorktreeService: mocks.NewMockWorktreeService()}})
+		var out, errOut bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetErr(&errOut)
+		cmd.SetArgs([]string{"batch-delete", path})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, out.String(), "nope    error")
+		assert.Contains(t, errOut.String(), "failed to resolve nope: unknown project")
+	})
+}
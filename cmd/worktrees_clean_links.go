@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// newWorktreesCleanLinksCmd creates the worktrees clean-links subcommand
+func newWorktreesCleanLinksCmd(config *CommandConfig) *cobra.Command {
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "clean-links [project]",
+		Short: "Remove dependency links to worktrees that no longer exist",
+		Long: `Scan the .twiggit-links files of a project's worktrees and remove links
+(recorded with create --link) to branches that no longer have a worktree.
+
+delete and prune run this automatically after removing worktrees.
+
+Examples:
+  twiggit worktrees clean-links            Clean the current project
+  twiggit worktrees clean-links myproject  Clean a specific project
+  twiggit worktrees clean-links --all      Clean every project`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			}
+			return executeWorktreesCleanLinks(cmd, config, projectName, all)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&all, "all", "a", false, "Clean all projects")
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionProjects(config),
+	)
+
+	return cmd
+}
+
+// executeWorktreesCleanLinks cleans stale links in the selected projects and reports each removed link
+func executeWorktreesCleanLinks(cmd *cobra.Command, config *CommandConfig, projectName string, all bool) error {
+	ctx := context.Background()
+
+	if all && projectName != "" {
+		return domain.NewValidationError("worktrees clean-links", "project", projectName, "cannot combine a project with --all")
+	}
+
+	projects, err := resolveVerifyProjects(ctx, config, projectName, all)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	total := 0
+	for _, project := range projects {
+		logv(cmd, 1, "Cleaning stale links of %s", project.Name)
+
+		removed, err := config.Services.LinkRegistry.CleanStaleLinks(ctx, project.GitRepoPath)
+		if err != nil {
+			return fmt.Errorf("failed to clean links of %s: %w", project.Name, err)
+		}
+		for _, link := range removed {
+			_, _ = fmt.Fprintf(out, "Removed stale link: %s (%s)\n", link, project.Name)
+		}
+		total += len(removed)
+	}
+
+	if total == 0 && !isQuiet(cmd) {
+		_, _ = fmt.Fprintln(out, "No stale links found")
+	}
+	return nil
+}
+
+// cleanStaleLinksAfterDelete removes links to deleted worktrees of the project.
+// It runs after a successful delete, so failures are reported as warnings on stderr.
+func cleanStaleLinksAfterDelete(ctx context.Context, cmd *cobra.Command, config *CommandConfig, projectName string, currentCtx *domain.Context) {
+	if config.Services.LinkRegistry == nil {
+		return
+	}
+
+	errOut := cmd.ErrOrStderr()
+	project, err := config.Services.ProjectService.DiscoverProject(ctx, projectName, currentCtx)
+	if err != nil {
+		logv(cmd, 1, "Skipping stale link cleanup: %v", err)
+		return
+	}
+
+	removed, err := config.Services.LinkRegistry.CleanStaleLinks(ctx, project.GitRepoPath)
+	if err != nil {
+		_, _ = fmt.Fprintf(errOut, "Warning: failed to clean stale links of %s: %v\n", project.Name, err)
+		return
+	}
+	if isQuiet(cmd) {
+		return
+	}
+	for _, link := range removed {
+		_, _ = fmt.Fprintf(errOut, "Removed stale link: %s\n", link)
+	}
+}
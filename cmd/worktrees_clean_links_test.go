@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestWorktreesCleanLinksCommand_Execute(t *testing.T) {
+	projA := &domain.ProjectInfo{Name: "proj-a", GitRepoPath: "/repos/proj-a"}
+	projB := &domain.ProjectInfo{Name: "proj-b", GitRepoPath: "/repos/proj-b"}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		setupMocks  func(lr *mocks.MockLinkRegistry, ps *mocks.MockProjectService)
+		expectError string
+		expectOut   []string
+	}{
+		{
+			name: "reports removed links",
+			args: []string{},
+			setupMocks: func(lr *mocks.MockLinkRegistry, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "", mock.Anything).Return(projA, nil)
+				lr.On("CleanStaleLinks", mock.Anything, "/repos/proj-a").Return([]string{"feature-ui -> feature-api"}, nil)
+			},
+			expectOut: []string{"Removed stale link: feature-ui -> feature-api (proj-a)"},
+		},
+		{
+			name: "all projects without stale links",
+			args: []string{"--all"},
+			setupMocks: func(lr *mocks.MockLinkRegistry, ps *mocks.MockProjectService) {
+				ps.On("ListProjects", mock.Anything).Return([]*domain.ProjectInfo{projA, projB}, nil)
+				lr.On("CleanStaleLinks", mock.Anything, "/repos/proj-a").Return([]string{}, nil)
+				lr.On("CleanStaleLinks", mock.Anything, "/repos/proj-b").Return([]string{}, nil)
+			},
+			expectOut: []string{"No stale links found"},
+		},
+		{
+			name:        "project with --all",
+			args:        []string{"proj-a", "--all"},
+			setupMocks:  func(_ *mocks.MockLinkRegistry, _ *mocks.MockProjectService) {},
+			expectError: "cannot combine a project with --all",
+		},
+		{
+			name: "registry error",
+			args: []string{"proj-a"},
+			setupMocks: func(lr *mocks.MockLinkRegistry, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "proj-a", mock.Anything).Return(projA, nil)
+				lr.On("CleanStaleLinks", mock.Anything, "/repos/proj-a").Return(nil, errors.New("not a repository"))
+			},
+			expectError: "failed to clean links of proj-a",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lr := mocks.NewMockLinkRegistry()
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj-a"}, nil)
+			tc.setupMocks(lr, ps)
+
+			config := &CommandConfig{Services: &ServiceContainer{LinkRegistry: lr, ContextService: cs, ProjectService: ps}}
+			cmd := NewWorktreesCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(append([]string{"clean-links"}, tc.args...))
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+			lr.AssertExpectations(t)
+		})
+	}
+}
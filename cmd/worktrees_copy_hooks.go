@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// gitHookNames lists the client-side hooks git runs, for --hooks completion
+var gitHookNames = []string{
+	"applypatch-msg", "pre-applypatch", "post-applypatch", "pre-commit", "pre-merge-commit",
+	"prepare-commit-msg", "commit-msg", "post-commit", "pre-rebase", "post-checkout",
+	"post-merge", "pre-push", "post-rewrite", "pre-auto-gc", "reference-transaction",
+}
+
+// newWorktreesCopyHooksCmd creates the worktrees copy-hooks subcommand
+func newWorktreesCopyHooksCmd(config *CommandConfig) *cobra.Command {
+	var hooks []string
+	var overwrite bool
+
+	cmd := &cobra.Command{
+		Use:   "copy-hooks <src-project> <dst-project>",
+		Short: "Copy git hooks from one project to another",
+		Long: `Copy the executable git hook scripts of one project to another, so projects
+share the same commit-msg, pre-push and other hooks. Hooks directories are
+resolved like git does, including core.hooksPath; *.sample files are ignored.
+
+Hooks the destination already has are kept unless --overwrite is given.
+Prints a HOOK/RESULT table and fails when any hook could not be copied.
+
+Examples:
+  twiggit worktrees copy-hooks api web
+  twiggit worktrees copy-hooks api web --hooks commit-msg,pre-push
+  twiggit worktrees copy-hooks api web --overwrite`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeWorktreesCopyHooks(cmd, config, args[0], args[1], hooks, overwrite)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&hooks, "hooks", nil, "Only copy these hooks (comma-separated, e.g. commit-msg,pre-push)")
+	cmd.Flags().BoolVar(&overwrite, "overwrite", false, "Replace hooks the destination project already has")
+
+	// Failed hooks are reported in the table; main reports the error
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionProjects(config),
+		actionProjects(config),
+	)
+	carapace.Gen(cmd).FlagCompletion(map[string]carapace.Action{
+		"hooks": carapace.ActionValues(gitHookNames...).UniqueList(","),
+	})
+
+	return cmd
+}
+
+// executeWorktreesCopyHooks resolves both projects, copies the hooks and prints the outcome
+func executeWorktreesCopyHooks(cmd *cobra.Command, config *CommandConfig, srcName, dstName string, hooks []string, overwrite bool) error {
+	ctx := context.Background()
+
+	if srcName == dstName {
+		return domain.NewValidationError("worktrees copy-hooks", "dst-project", dstName, "source and destination are the same project")
+	}
+
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil {
+		return fmt.Errorf("context detection failed: %w", err)
+	}
+	src, err := config.Services.ProjectService.DiscoverProject(ctx, srcName, currentCtx)
+	if err != nil {
+		return fmt.Errorf("failed to discover project %s: %w", srcName, err)
+	}
+	dst, err := config.Services.ProjectService.DiscoverProject(ctx, dstName, currentCtx)
+	if err != nil {
+		return fmt.Errorf("failed to discover project %s: %w", dstName, err)
+	}
+
+	logv(cmd, 1, "Copying hooks from %s to %s", src.Name, dst.Name)
+	result, err := config.Services.HookCopier.Copy(src.GitRepoPath, dst.GitRepoPath, hooks, overwrite)
+	if err != nil {
+		return fmt.Errorf("failed to copy hooks from %s to %s: %w", src.Name, dst.Name, err)
+	}
+	logv(cmd, 2, "  from: %s", result.SrcDir)
+	logv(cmd, 2, "  to: %s", result.DstDir)
+
+	displayCopyHooksResult(cmd.OutOrStdout(), cmd.ErrOrStderr(), result)
+	if len(result.Failed) > 0 {
+		return fmt.Errorf("failed to copy %d hook(s) from %s to %s", len(result.Failed), src.Name, dst.Name)
+	}
+	return nil
+}
+
+// displayCopyHooksResult prints the HOOK/RESULT table and a totals line, and lists errors on errOut
+func displayCopyHooksResult(out, errOut io.Writer, result *domain.CopyHooksResult) {
+	if len(result.Copied)+len(result.Skipped)+len(result.Failed) == 0 {
+		_, _ = fmt.Fprintf(out, "No executable hooks in %s\n", result.SrcDir)
+		return
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "HOOK\tRESULT")
+	for _, name := range result.Copied {
+		_, _ = fmt.Fprintf(w, "%s\tcopied\n", name)
+	}
+	for _, name := range result.Skipped {
+		_, _ = fmt.Fprintf(w, "%s\tskipped (already exists)\n", name)
+	}
+	for _, failure := range result.Failed {
+		_, _ = fmt.Fprintf(w, "%s\terror\n", failure.HookName)
+	}
+	_ = w.Flush()
+
+	_, _ = fmt.Fprintf(out, "\n%d copied, %d skipped, %d failed\n", len(result.Copied), len(result.Skipped), len(result.Failed))
+	for _, failure := range result.Failed {
+		_, _ = fmt.Fprintf(errOut, "Error: %s: %v\n", failure.HookName, failure.Err)
+	}
+}
@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestWorktreesCopyHooksCommand(t *testing.T) {
+	api := &domain.ProjectInfo{Name: "api", GitRepoPath: "/repos/api"}
+	web := &domain.ProjectInfo{Name: "web", GitRepoPath: "/repos/web"}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		setupMocks   func(hc *mocks.MockHookCopier, ps *mocks.MockProjectService)
+		expectError  string
+		expectOut    []string
+		expectStderr []string
+	}{
+		{
+			name: "copies all hooks",
+			args: []string{"api", "web"},
+			setupMocks: func(hc *mocks.MockHookCopier, ps *mocks.MockProjectService) {
+				hc.On("Copy", "/repos/api", "/repos/web", []string(nil), false).Return(&domain.CopyHooksResult{
+					Copied:  []string{"commit-msg", "pre-push"},
+					Skipped: []string{"pre-commit"},
+				}, nil)
+			},
+			expectOut: []string{"HOOK", "commit-msg", "copied", "pre-commit", "skipped (already exists)", "2 copied, 1 skipped, 0 failed"},
+		},
+		{
+			name: "passes selected hooks and overwrite",
+			args: []string{"api", "web", "--hooks", "commit-msg,pre-push", "--overwrite"},
+			setupMocks: func(hc *mocks.MockHookCopier, ps *mocks.MockProjectService) {
+				hc.On("Copy", "/repos/api", "/repos/web", []string{"commit-msg", "pre-push"}, true).Return(&domain.CopyHooksResult{
+					Copied: []string{"commit-msg", "pre-push"},
+				}, nil)
+			},
+			expectOut: []string{"2 copied, 0 skipped, 0 failed"},
+		},
+		{
+			name: "reports failures",
+			args: []string{"api", "web", "--hooks", "pre-push,post-merge"},
+			setupMocks: func(hc *mocks.MockHookCopier, ps *mocks.MockProjectService) {
+				hc.On("Copy", "/repos/api", "/repos/web", []string{"pre-push", "post-merge"}, false).Return(&domain.CopyHooksResult{
+					Copied: []string{"pre-push"},
+					Failed: []domain.HookCopyError{{HookName: "post-merge", Err: errors.New("not executable")}},
+				}, nil)
+			},
+			expectError:  "failed to copy 1 hook(s) from api to web",
+			expectOut:    []string{"post-merge", "error", "1 copied, 0 skipped, 1 failed"},
+			expectStderr: []string{"Error: post-merge: not executable"},
+		},
+		{
+			name: "source without hooks",
+			args: []string{"api", "web"},
+			setupMocks: func(hc *mocks.MockHookCopier, ps *mocks.MockProjectService) {
+				hc.On("Copy", "/repos/api", "/repos/web", []string(nil), false).Return(&domain.CopyHooksResult{SrcDir: "/repos/api/.git/hooks"}, nil)
+			},
+			expectOut: []string{"No executable hooks in /repos/api/.git/hooks"},
+		},
+		{
+			name: "copier error",
+			args: []string{"api", "web"},
+			setupMocks: func(hc *mocks.MockHookCopier, ps *mocks.MockProjectService) {
+				hc.On("Copy", "/repos/api", "/repos/web", []string(nil), false).Return(nil, errors.New("both projects use the hooks directory /hooks"))
+			},
+			expectError: "failed to copy hooks from api to web: both projects use the hooks directory /hooks",
+		},
+		{
+			name:        "same project",
+			args:        []string{"api", "api"},
+			setupMocks:  func(hc *mocks.MockHookCopier, ps *mocks.MockProjectService) {},
+			expectError: "source and destination are the same project",
+		},
+		{
+			name: "unknown project",
+			args: []string{"api", "missing"},
+			setupMocks: func(hc *mocks.MockHookCopier, ps *mocks.MockProjectService) {
+				ps.ExpectedCalls = nil
+				ps.On("DiscoverProject", mock.Anything, "api", mock.Anything).Return(api, nil)
+				ps.On("DiscoverProject", mock.Anything, "missing", mock.Anything).Return(nil, errors.New("project not found"))
+			},
+			expectError: "failed to discover project missing: project not found",
+		},
+		{
+			name:        "two projects required",
+			args:        []string{"api"},
+			setupMocks:  func(hc *mocks.MockHookCopier, ps *mocks.MockProjectService) {},
+			expectError: "accepts 2 arg(s)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hc := mocks.NewMockHookCopier()
+			ps := mocks.NewMockProjectService()
+			cs := mocks.NewMockContextService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+			ps.On("DiscoverProject", mock.Anything, "api", mock.Anything).Return(api, nil)
+			ps.On("DiscoverProject", mock.Anything, "web", mock.Anything).Return(web, nil)
+			tc.setupMocks(hc, ps)
+
+			cmd := newWorktreesCopyHooksCmd(&CommandConfig{
+				Services: &ServiceContainer{HookCopier: hc, ProjectService: ps, ContextService: cs},
+				Config:   domain.DefaultConfig(),
+			})
+			var out, stderr bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&stderr)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, s := range tc.expectOut {
+				assert.Contains(t, out.String(), s)
+			}
+			for _, s := range tc.expectStderr {
+				assert.Contains(t, stderr.String(), s)
+			}
+			hc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// newWorktreesSetDefaultCmd creates the worktrees set-default subcommand
+func newWorktreesSetDefaultCmd(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-default <project/branch|branch>",
+		Short: "Make twiggit cd <project> open a worktree",
+		Long: `Save the worktree twiggit cd <project> opens instead of the main branch, as
+default_worktree in the project's .twiggit.toml. The branch must have a
+worktree; if it is deleted later, cd falls back to the main branch with a
+warning.
+
+Examples:
+  twiggit worktrees set-default myproject/develop  cd myproject now opens develop
+  twiggit worktrees set-default develop            Same, for the current project`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeWorktreesSetDefault(cmd, config, args[0])
+		},
+	}
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionWorktreeTarget(config),
+	)
+
+	return cmd
+}
+
+// newWorktreesUnsetDefaultCmd creates the worktrees unset-default subcommand
+func newWorktreesUnsetDefaultCmd(config *CommandConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unset-default [project]",
+		Short: "Make twiggit cd <project> open the main branch again",
+		Long: `Remove default_worktree from the project's .twiggit.toml, so twiggit cd
+<project> opens the main branch again. Without a project, the current
+project is used.
+
+Examples:
+  twiggit worktrees unset-default            For the current project
+  twiggit worktrees unset-default myproject`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			}
+			return executeWorktreesUnsetDefault(cmd, config, projectName)
+		},
+	}
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionProjects(config),
+	)
+
+	return cmd
+}
+
+// executeWorktreesSetDefault saves branch as the default worktree of its project
+func executeWorktreesSetDefault(cmd *cobra.Command, config *CommandConfig, spec string) error {
+	ctx := context.Background()
+
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil {
+		return fmt.Errorf("context detection failed: %w", err)
+	}
+
+	projectName, branch, err := parseProjectBranch(spec, currentCtx)
+	if err != nil {
+		return err
+	}
+
+	project, err := config.Services.ProjectService.DiscoverProject(ctx, projectName, currentCtx)
+	if err != nil {
+		return fmt.Errorf("failed to discover project: %w", err)
+	}
+
+	worktree := findBranchWorktree(project, branch)
+	if worktree == nil {
+		return domain.NewValidationError("worktrees set-default", "branch", branch,
+			fmt.Sprintf("%s has no worktree for branch %s (create it with twiggit create %s/%s)", project.Name, branch, project.Name, branch))
+	}
+
+	logv(cmd, 1, "Setting the default worktree of %s to %s", project.Name, branch)
+	if err := setProjectDefaultWorktree(config, project.GitRepoPath, branch); err != nil {
+		return err
+	}
+
+	if !isQuiet(cmd) {
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "twiggit cd %s now opens %s (%s)\n", project.Name, branch, worktree.Path)
+	}
+	return nil
+}
+
+// executeWorktreesUnsetDefault removes the default worktree of a project
+func executeWorktreesUnsetDefault(cmd *cobra.Command, config *CommandConfig, projectName string) error {
+	projects, err := resolveVerifyProjects(context.Background(), config, projectName, false)
+	if err != nil {
+		return err
+	}
+	project := projects[0]
+
+	logv(cmd, 1, "Removing the default worktree of %s", project.Name)
+	if err := setProjectDefaultWorktree(config, project.GitRepoPath, ""); err != nil {
+		return err
+	}
+
+	if !isQuiet(cmd) {
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "twiggit cd %s now opens the main branch\n", project.Name)
+	}
+	return nil
+}
+
+// setProjectDefaultWorktree writes default_worktree to the settings of the repository at repoPath
+func setProjectDefaultWorktree(config *CommandConfig, repoPath, branch string) error {
+	if config.Services.ProjectSettings == nil {
+		return errors.New("project settings are not available")
+	}
+	if err := config.Services.ProjectSettings.SetDefaultWorktree(repoPath, branch); err != nil {
+		return fmt.Errorf("failed to save the default worktree: %w", err)
+	}
+	return nil
+}
+
+// findBranchWorktree returns the worktree of project that has branch checked out, or nil
+func findBranchWorktree(project *domain.ProjectInfo, branch string) *domain.WorktreeInfo {
+	for _, wt := range project.Worktrees {
+		if wt.Branch == branch && !wt.IsDetached {
+			return wt
+		}
+	}
+	return nil
+}
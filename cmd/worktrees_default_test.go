@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+// defaultTestProject is a project with a main and a develop worktree
+func defaultTestProject() *domain.ProjectInfo {
+	return &domain.ProjectInfo{
+		Name:        "myproject",
+		Path:        "/projects/myproject",
+		GitRepoPath: "/projects/myproject",
+		Worktrees: []*domain.WorktreeInfo{
+			{Path: "/projects/myproject", Branch: "main"},
+			{Path: "/worktrees/myproject/develop", Branch: "develop"},
+		},
+	}
+}
+
+func TestWorktreesSetDefaultCommand(t *testing.T) {
+	testCases := []struct {
+		name           string
+		args           []string
+		ctx            *domain.Context
+		expectProject  string
+		setErr         error
+		expectBranch   string
+		expectOutput   string
+		expectError    string
+		expectNoWrite  bool
+		expectNoLookup bool
+	}{
+		{
+			name:          "project and branch",
+			args:          []string{"myproject/develop"},
+			ctx:           &domain.Context{Type: domain.ContextOutsideGit},
+			expectProject: "myproject",
+			expectBranch:  "develop",
+			expectOutput:  "twiggit cd myproject now opens develop (/worktrees/myproject/develop)",
+		},
+		{
+			name:          "branch of the current project",
+			args:          []string{"develop"},
+			ctx:           &domain.Context{Type: domain.ContextProject, ProjectName: "myproject"},
+			expectProject: "myproject",
+			expectBranch:  "develop",
+			expectOutput:  "twiggit cd myproject now opens develop",
+		},
+		{
+			name:          "branch without worktree",
+			args:          []string{"myproject/feature"},
+			ctx:           &domain.Context{Type: domain.ContextOutsideGit},
+			expectProject: "myproject",
+			expectError:   "myproject has no worktree for branch feature",
+			expectNoWrite: true,
+		},
+		{
+			name:           "no project outside a project",
+			args:           []string{"develop"},
+			ctx:            &domain.Context{Type: domain.ContextOutsideGit},
+			expectError:    "cannot infer project",
+			expectNoWrite:  true,
+			expectNoLookup: true,
+		},
+		{
+			name:          "write failure",
+			args:          []string{"myproject/develop"},
+			ctx:           &domain.Context{Type: domain.ContextOutsideGit},
+			expectProject: "myproject",
+			expectBranch:  "develop",
+			setErr:        errors.New("permission denied"),
+			expectError:   "failed to save the default worktree",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			contextService := mocks.NewMockContextService()
+			contextService.On("GetCurrentContext").Return(tc.ctx, nil)
+			projectService := mocks.NewMockProjectService()
+			projectService.On("DiscoverProject", mock.Anything, tc.expectProject, tc.ctx).Return(defaultTestProject(), nil).Maybe()
+			store := mocks.NewMockProjectSettingsStore()
+			store.On("SetDefaultWorktree", "/projects/myproject", tc.expectBranch).Return(tc.setErr).Maybe()
+
+			cmd := newWorktreesSetDefaultCmd(&CommandConfig{
+				Services: &ServiceContainer{ContextService: contextService, ProjectService: projectService, ProjectSettings: store},
+				Config:   domain.DefaultConfig(),
+			})
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectNoWrite {
+				store.AssertNotCalled(t, "SetDefaultWorktree", mock.Anything, mock.Anything)
+			}
+			if tc.expectNoLookup {
+				projectService.AssertNotCalled(t, "DiscoverProject", mock.Anything, mock.Anything, mock.Anything)
+			}
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			store.AssertExpectations(t)
+			assert.Contains(t, out.String(), tc.expectOutput)
+		})
+	}
+}
+
+func TestWorktreesUnsetDefaultCommand(t *testing.T) {
+	testCases := []struct {
+		name          string
+		args          []string
+		expectProject string
+		setErr        error
+		expectOutput  string
+		expectError   string
+	}{
+		{name: "named project", args: []string{"myproject"}, expectProject: "myproject", expectOutput: "twiggit cd myproject now opens the main branch"},
+		{name: "current project", args: []string{}, expectProject: "", expectOutput: "twiggit cd myproject now opens the main branch"},
+		{name: "write failure", args: []string{"myproject"}, expectProject: "myproject", setErr: errors.New("permission denied"), expectError: "failed to save the default worktree"},
+		{name: "too many arguments", args: []string{"a", "b"}, expectError: "accepts at most 1 arg(s)"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := &domain.Context{Type: domain.ContextProject, ProjectName: "myproject"}
+			contextService := mocks.NewMockContextService()
+			contextService.On("GetCurrentContext").Return(ctx, nil)
+			projectService := mocks.NewMockProjectService()
+			projectService.On("DiscoverProject", mock.Anything, tc.expectProject, ctx).Return(defaultTestProject(), nil).Maybe()
+			store := mocks.NewMockProjectSettingsStore()
+			store.On("SetDefaultWorktree", "/projects/myproject", "").Return(tc.setErr).Maybe()
+
+			cmd := newWorktreesUnsetDefaultCmd(&CommandConfig{
+				Services: &ServiceContainer{ContextService: contextService, ProjectService: projectService, ProjectSettings: store},
+				Config:   domain.DefaultConfig(),
+			})
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			store.AssertExpectations(t)
+			assert.Contains(t, out.String(), tc.expectOutput)
+		})
+	}
+}
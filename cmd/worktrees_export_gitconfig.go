@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// defaultGitConfigIncludeDir is where the exported snippet suggests saving per-worktree config files
+const defaultGitConfigIncludeDir = "~/.config/git/twiggit"
+
+// newWorktreesExportGitConfigCmd creates the worktrees export-gitconfig subcommand
+func newWorktreesExportGitConfigCmd(config *CommandConfig) *cobra.Command {
+	var includeDir string
+
+	cmd := &cobra.Command{
+		Use:   "export-gitconfig [project]",
+		Short: "Export per-worktree git config as an includeIf snippet",
+		Long: `Read the local git config of every worktree in a project and print a
+~/.gitconfig snippet with one [includeIf "gitdir:..."] section per worktree.
+
+Each section points at a file under --include-dir; the settings to save in
+that file follow it as comments. Settings that describe the repository
+itself (core, extensions, remote, branch, submodule) are left out.
+
+Examples:
+  twiggit worktrees export-gitconfig            Export the current project
+  twiggit worktrees export-gitconfig myproject  Export a specific project`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			}
+			return executeWorktreesExportGitConfig(cmd, config, projectName, includeDir)
+		},
+	}
+
+	cmd.Flags().StringVar(&includeDir, "include-dir", defaultGitConfigIncludeDir, "Directory the snippet's include paths point into")
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionProjects(config),
+	)
+
+	return cmd
+}
+
+// executeWorktreesExportGitConfig prints the includeIf snippet for the named or current project
+func executeWorktreesExportGitConfig(cmd *cobra.Command, config *CommandConfig, projectName, includeDir string) error {
+	ctx := context.Background()
+
+	projects, err := resolveVerifyProjects(ctx, config, projectName, false)
+	if err != nil {
+		return err
+	}
+	project := projects[0]
+
+	logv(cmd, 1, "Reading git config of %s worktrees", project.Name)
+
+	configs, err := config.Services.WorktreeService.ExportGitConfig(ctx, project)
+	if err != nil {
+		return fmt.Errorf("failed to export git config of %s: %w", project.Name, err)
+	}
+
+	snippet := domain.RenderGitConfigSnippet(project.Name, includeDir+"/"+project.Name, configs)
+	_, _ = fmt.Fprint(cmd.OutOrStdout(), snippet)
+	return nil
+}
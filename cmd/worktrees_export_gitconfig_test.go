@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestWorktreesExportGitConfigCommand_Execute(t *testing.T) {
+	project := &domain.ProjectInfo{Name: "proj", GitRepoPath: "/repos/proj"}
+	configs := []*domain.WorktreeGitConfig{
+		{
+			Path:   "/wt/proj/feature",
+			Branch: "feature",
+			GitDir: "/repos/proj/.git/worktrees/feature",
+			Config: map[string]string{"user.signingkey": "ABC", "core.bare": "false"},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		setupMocks  func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService)
+		expectError string
+		expectOut   []string
+	}{
+		{
+			name: "current project",
+			args: []string{},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "", mock.Anything).Return(project, nil)
+				ws.On("ExportGitConfig", mock.Anything, project).Return(configs, nil)
+			},
+			expectOut: []string{
+				`[includeIf "gitdir:/repos/proj/.git/worktrees/feature"]`,
+				"path = ~/.config/git/twiggit/proj/feature.gitconfig",
+				"# 	signingkey = ABC",
+			},
+		},
+		{
+			name: "custom include dir",
+			args: []string{"proj", "--include-dir", "~/gitconfigs"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "proj", mock.Anything).Return(project, nil)
+				ws.On("ExportGitConfig", mock.Anything, project).Return(configs, nil)
+			},
+			expectOut: []string{"path = ~/gitconfigs/proj/feature.gitconfig"},
+		},
+		{
+			name: "service error",
+			args: []string{},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "", mock.Anything).Return(project, nil)
+				ws.On("ExportGitConfig", mock.Anything, project).Return(nil, errors.New("bad config line"))
+			},
+			expectError: "failed to export git config of proj",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+			tc.setupMocks(ws, ps)
+
+			config := &CommandConfig{Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps}}
+			cmd := NewWorktreesCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(append([]string{"export-gitconfig"}, tc.args...))
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+// worktreesForeachOptions holds the flag values for the worktrees foreach command
+type worktreesForeachOptions struct {
+	concurrency   int
+	parallel      bool
+	stopOnFailure bool
+	outputDir     string
+}
+
+// foreachOutcome is what happened in one worktree
+type foreachOutcome struct {
+	result  *domain.CommandRunResult
+	err     error
+	skipped bool
+	logPath string // Log file holding the output with --output-dir
+	logErr  error  // Failure to write the log file
+}
+
+// failed reports whether the command could not run, exited non-zero or its output was lost
+func (o foreachOutcome) failed() bool {
+	return o.err != nil || o.logErr != nil || (o.result != nil && !o.result.Succeeded())
+}
+
+// newWorktreesForeachCmd creates the worktrees foreach subcommand
+func newWorktreesForeachCmd(config *CommandConfig) *cobra.Command {
+	var opts worktreesForeachOptions
+
+	cmd := &cobra.Command{
+		Use:   "foreach <command>",
+		Short: "Run a shell command in every worktree of the current project",
+		Long: `Run a shell command in each worktree of the current project, one after another
+or up to --concurrency at a time. The command is passed to 'sh -c'
+('powershell -Command' on Windows) with the worktree as working directory.
+
+Output is printed per worktree in worktree order, followed by its result.
+With --output-dir, each worktree's output goes to <dir>/<project>-<branch>.log
+instead, and a table of exit codes and log files is printed once all are done.
+Exits with an error when the command fails in any worktree.
+
+Examples:
+  twiggit worktrees foreach 'go build ./...'
+  twiggit worktrees foreach --concurrency 4 'npm test'
+  twiggit worktrees foreach --parallel --output-dir /tmp/wt-output 'go test ./...'
+  twiggit worktrees foreach --stop-on-failure 'make lint'`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return executeWorktreesForeach(cmd, config, args[0], opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.concurrency, "concurrency", "j", 1, "Number of worktrees to run the command in at once")
+	cmd.Flags().BoolVar(&opts.parallel, "parallel", false, "Run the command in all worktrees at once")
+	cmd.Flags().BoolVar(&opts.stopOnFailure, "stop-on-failure", false, "Do not start the command in further worktrees after a failure")
+	cmd.Flags().StringVar(&opts.outputDir, "output-dir", "", "Write each worktree's output to <dir>/<project>-<branch>.log and print a summary table")
+
+	// A failing command in a worktree is not a usage error; main reports the error
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	return cmd
+}
+
+// executeWorktreesForeach runs the command across the current project's worktrees
+func executeWorktreesForeach(cmd *cobra.Command, config *CommandConfig, command string, opts worktreesForeachOptions) error {
+	ctx := context.Background()
+
+	if opts.concurrency < 1 {
+		return domain.NewValidationError("worktrees foreach", "concurrency", fmt.Sprint(opts.concurrency), "concurrency must be at least 1")
+	}
+	if opts.parallel && cmd.Flags().Changed("concurrency") {
+		return domain.NewValidationError("worktrees foreach", "parallel", "", "--parallel cannot be combined with --concurrency")
+	}
+
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil {
+		return fmt.Errorf("context detection failed: %w", err)
+	}
+	if currentCtx.ProjectName == "" {
+		return domain.NewValidationError("worktrees foreach", "context", currentCtx.Type.String(), "run foreach from inside a project or one of its worktrees")
+	}
+
+	worktrees, err := config.Services.WorktreeService.ListWorktrees(ctx, &domain.ListWorktreesRequest{
+		Context:     currentCtx,
+		ProjectName: currentCtx.ProjectName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list worktrees: %w", err)
+	}
+
+	out := cmd.OutOrStdout()
+	if len(worktrees) == 0 {
+		_, _ = fmt.Fprintln(out, "No worktrees found")
+		return nil
+	}
+
+	if opts.parallel {
+		opts.concurrency = len(worktrees)
+	}
+
+	var collector *FileOutputCollector
+	if opts.outputDir != "" {
+		if err := os.MkdirAll(opts.outputDir, 0755); err != nil {
+			return fmt.Errorf("failed to create output directory %s: %w", opts.outputDir, err)
+		}
+		collector = NewFileOutputCollector(currentCtx.ProjectName, worktrees)
+	}
+
+	logv(cmd, 1, "Running %q in %d worktree(s)", command, len(worktrees))
+
+	failed, skipped := 0, 0
+	outcomes := make([]foreachOutcome, 0, len(worktrees))
+	runForeach(ctx, config.Services.CommandRunner, worktrees, command, opts, collector, func(wt *domain.WorktreeInfo, outcome foreachOutcome) {
+		switch {
+		case outcome.skipped:
+			skipped++
+		case outcome.failed():
+			failed++
+		}
+		if collector != nil {
+			outcomes = append(outcomes, outcome)
+			return
+		}
+		displayForeachOutcome(out, wt, outcome, isQuiet(cmd))
+	})
+	if collector != nil {
+		displayForeachSummary(out, worktrees, outcomes)
+	}
+
+	if failed > 0 {
+		if skipped > 0 {
+			return fmt.Errorf("command failed in %d of %d worktrees (%d skipped)", failed, len(worktrees), skipped)
+		}
+		return fmt.Errorf("command failed in %d of %d worktrees", failed, len(worktrees))
+	}
+	return nil
+}
+
+// runForeach runs command in each worktree with at most opts.concurrency running at once.
+// With a collector, each output is written to its log file in opts.outputDir as soon as
+// the command ends. report is called once per worktree, in worktree order, as soon as
+// that worktree is done.
+func runForeach(
+	ctx context.Context,
+	runner application.CommandRunner,
+	worktrees []*domain.WorktreeInfo,
+	command string,
+	opts worktreesForeachOptions,
+	collector *FileOutputCollector,
+	report func(*domain.WorktreeInfo, foreachOutcome),
+) {
+	outcomes := make([]foreachOutcome, len(worktrees))
+	done := make([]chan struct{}, len(worktrees))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	var stopped atomic.Bool
+	slots := make(chan struct{}, opts.concurrency)
+	go func() {
+		for i, wt := range worktrees {
+			slots <- struct{}{}
+			if stopped.Load() {
+				outcomes[i] = foreachOutcome{skipped: true}
+				close(done[i])
+				<-slots
+				continue
+			}
+			go func(i int, wt *domain.WorktreeInfo) {
+				defer func() {
+					close(done[i])
+					<-slots
+				}()
+				result, err := runner.Run(ctx, wt.Path, command)
+				outcomes[i] = foreachOutcome{result: result, err: err}
+				if collector != nil {
+					outcomes[i].logPath = collector.LogPath(wt.Path, opts.outputDir)
+					outcomes[i].logErr = writeForeachLog(collector, wt.Path, opts.outputDir, outcomes[i])
+				}
+				if opts.stopOnFailure && outcomes[i].failed() {
+					stopped.Store(true)
+				}
+			}(i, wt)
+		}
+	}()
+
+	for i, wt := range worktrees {
+		<-done[i]
+		report(wt, outcomes[i])
+	}
+}
+
+// foreachName names a worktree in foreach output: its branch, or its path when detached
+func foreachName(wt *domain.WorktreeInfo) string {
+	if wt.Branch == "" || wt.IsDetached {
+		return wt.Path
+	}
+	return wt.Branch
+}
+
+// displayForeachOutcome prints a worktree's header, command output and result line
+func displayForeachOutcome(out io.Writer, wt *domain.WorktreeInfo, outcome foreachOutcome, quiet bool) {
+	name := foreachName(wt)
+
+	switch {
+	case outcome.skipped:
+		_, _ = fmt.Fprintf(out, "%s: skipped\n", name)
+	case outcome.err != nil:
+		_, _ = fmt.Fprintf(out, "==> %s\n%s: failed: %v\n", name, name, outcome.err)
+	default:
+		_, _ = fmt.Fprintf(out, "==> %s\n", name)
+		if outcome.result.Output != "" {
+			_, _ = fmt.Fprintln(out, strings.TrimRight(outcome.result.Output, "\n"))
+		}
+		if !outcome.result.Succeeded() {
+			_, _ = fmt.Fprintf(out, "%s: failed (exit code %d)\n", name, outcome.result.ExitCode)
+		} else if !quiet {
+			_, _ = fmt.Fprintf(out, "%s: ok\n", name)
+		}
+	}
+}
+
+// writeForeachLog writes a worktree's output, or why the command could not run, to its log file
+func writeForeachLog(collector *FileOutputCollector, worktreePath, outputDir string, outcome foreachOutcome) error {
+	w, closeLog := collector.Collect(worktreePath, outputDir)
+	if outcome.err != nil {
+		_, _ = fmt.Fprintf(w, "failed: %v\n", outcome.err)
+	} else {
+		_, _ = io.WriteString(w, outcome.result.Output)
+	}
+	return closeLog()
+}
+
+// displayForeachSummary prints the exit code and log file of each worktree run with --output-dir
+func displayForeachSummary(out io.Writer, worktrees []*domain.WorktreeInfo, outcomes []foreachOutcome) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "WORKTREE\tEXIT\tOUTPUT")
+	for i, outcome := range outcomes {
+		var exit string
+		output := outcome.logPath
+		switch {
+		case outcome.skipped:
+			exit, output = "skipped", "-"
+		case outcome.err != nil:
+			exit = "error"
+		default:
+			exit = strconv.Itoa(outcome.result.ExitCode)
+		}
+		if outcome.logErr != nil {
+			output = "not written: " + outcome.logErr.Error()
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", foreachName(worktrees[i]), exit, output)
+	}
+	_ = w.Flush()
+}
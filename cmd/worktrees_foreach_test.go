@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestWorktreesForeachCommand_Execute(t *testing.T) {
+	worktrees := []*domain.WorktreeInfo{
+		{Path: "/wt/proj/a", Branch: "a"},
+		{Path: "/wt/proj/b", Branch: "b"},
+		{Path: "/wt/proj/c", Branch: "c"},
+	}
+	ok := func(output string) *domain.CommandRunResult {
+		return &domain.CommandRunResult{ExitCode: 0, Output: output}
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		setupRunner func(r *mocks.MockCommandRunner)
+		expectError string
+		expectOut   string
+	}{
+		{
+			name: "runs sequentially in worktree order",
+			args: []string{"foreach", "make"},
+			setupRunner: func(r *mocks.MockCommandRunner) {
+				r.On("Run", mock.Anything, "/wt/proj/a", "make").Return(ok("built a"), nil).Once()
+				r.On("Run", mock.Anything, "/wt/proj/b", "make").Return(ok("built b"), nil).Once()
+				r.On("Run", mock.Anything, "/wt/proj/c", "make").Return(ok(""), nil).Once()
+			},
+			expectOut: "==> a\nbuilt a\na: ok\n==> b\nbuilt b\nb: ok\n==> c\nc: ok\n",
+		},
+		{
+			name: "concurrent runs are reported in worktree order",
+			args: []string{"foreach", "--concurrency", "3", "make"},
+			setupRunner: func(r *mocks.MockCommandRunner) {
+				r.On("Run", mock.Anything, "/wt/proj/a", "make").After(50*time.Millisecond).Return(ok("built a"), nil).Once()
+				r.On("Run", mock.Anything, "/wt/proj/b", "make").After(20*time.Millisecond).Return(ok("built b"), nil).Once()
+				r.On("Run", mock.Anything, "/wt/proj/c", "make").Return(ok("built c"), nil).Once()
+			},
+			expectOut: "==> a\nbuilt a\na: ok\n==> b\nbuilt b\nb: ok\n==> c\nbuilt c\nc: ok\n",
+		},
+		{
+			name: "failure continues and reports exit code",
+			args: []string{"foreach", "make"},
+			setupRunner: func(r *mocks.MockCommandRunner) {
+				r.On("Run", mock.Anything, "/wt/proj/a", "make").Return(ok(""), nil).Once()
+				r.On("Run", mock.Anything, "/wt/proj/b", "make").Return(&domain.CommandRunResult{ExitCode: 2, Output: "boom"}, nil).Once()
+				r.On("Run", mock.Anything, "/wt/proj/c", "make").Return(nil, errors.New("sh: not found")).Once()
+			},
+			expectError: "command failed in 2 of 3 worktrees",
+			expectOut:   "==> a\na: ok\n==> b\nboom\nb: failed (exit code 2)\n==> c\nc: failed: sh: not found\n",
+		},
+		{
+			name: "stop on failure skips remaining worktrees",
+			args: []string{"foreach", "--stop-on-failure", "make"},
+			setupRunner: func(r *mocks.MockCommandRunner) {
+				r.On("Run", mock.Anything, "/wt/proj/a", "make").Return(&domain.CommandRunResult{ExitCode: 1}, nil).Once()
+			},
+			expectError: "command failed in 1 of 3 worktrees (2 skipped)",
+			expectOut:   "==> a\na: failed (exit code 1)\nb: skipped\nc: skipped\n",
+		},
+		{
+			name: "parallel runs every worktree at once",
+			args: []string{"foreach", "--parallel", "make"},
+			setupRunner: func(r *mocks.MockCommandRunner) {
+				var started sync.WaitGroup
+				started.Add(3)
+				for _, wt := range worktrees {
+					r.On("Run", mock.Anything, wt.Path, "make").Run(func(_ mock.Arguments) {
+						started.Done()
+						started.Wait()
+					}).Return(ok(""), nil).Once()
+				}
+			},
+			expectOut: "==> a\na: ok\n==> b\nb: ok\n==> c\nc: ok\n",
+		},
+		{
+			name:        "parallel rejected with concurrency",
+			args:        []string{"foreach", "--parallel", "--concurrency", "2", "make"},
+			setupRunner: func(_ *mocks.MockCommandRunner) {},
+			expectError: "--parallel cannot be combined with --concurrency",
+		},
+		{
+			name:        "invalid concurrency",
+			args:        []string{"foreach", "--concurrency", "0", "make"},
+			setupRunner: func(_ *mocks.MockCommandRunner) {},
+			expectError: "concurrency must be at least 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := mocks.NewMockContextService()
+			ws := mocks.NewMockWorktreeService()
+			runner := mocks.NewMockCommandRunner()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+			ws.On("ListWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+				return req.ProjectName == "proj"
+			})).Return(worktrees, nil)
+			tc.setupRunner(runner)
+
+			config := &CommandConfig{Services: &ServiceContainer{ContextService: cs, WorktreeService: ws, CommandRunner: runner}}
+			cmd := NewWorktreesCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.expectOut != "" {
+				assert.Equal(t, tc.expectOut, out.String())
+			}
+			runner.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWorktreesForeachCommand_OutsideProject(t *testing.T) {
+	cs := mocks.NewMockContextService()
+	cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+
+	cmd := NewWorktreesCommand(&CommandConfig{Services: &ServiceContainer{ContextService: cs}})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"foreach", "make"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "inside a project")
+}
+
+func TestRunForeach_RespectsConcurrency(t *testing.T) {
+	worktrees := make([]*domain.WorktreeInfo, 6)
+	for i := range worktrees {
+		worktrees[i] = &domain.WorktreeInfo{Path: string(rune('a' + i))}
+	}
+
+	var mu sync.Mutex
+	running, peak := 0, 0
+	runner := mocks.NewMockCommandRunner()
+	runner.On("Run", mock.Anything, mock.Anything, "true").Run(func(_ mock.Arguments) {
+		mu.Lock()
+		running++
+		peak = max(peak, running)
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+	}).Return(&domain.CommandRunResult{}, nil)
+
+	var order []string
+	runForeach(t.Context(), runner, worktrees, "true", worktreesForeachOptions{concurrency: 2}, nil, func(wt *domain.WorktreeInfo, _ foreachOutcome) {
+		order = append(order, wt.Path)
+	})
+
+	assert.Equal(t, []string{"a", "b", "c", "d", "e", "f"}, order)
+	assert.LessOrEqual(t, peak, 2)
+}
+
+func TestWorktreesForeachCommand_OutputDir(t *testing.T) {
+	outputDir := filepath.Join(t.TempDir(), "wt-output")
+	worktrees := []*domain.WorktreeInfo{
+		{Path: "/wt/proj/feature-a", Branch: "feature/a"},
+		{Path: "/wt/proj/b", Branch: "b"},
+		{Path: "/wt/proj/c", Branch: "c"},
+	}
+
+	cs := mocks.NewMockContextService()
+	ws := mocks.NewMockWorktreeService()
+	runner := mocks.NewMockCommandRunner()
+	cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj"}, nil)
+	ws.On("ListWorktrees", mock.Anything, mock.Anything).Return(worktrees, nil)
+	runner.On("Run", mock.Anything, "/wt/proj/feature-a", "go test ./...").Return(&domain.CommandRunResult{Output: "PASS\n"}, nil)
+	runner.On("Run", mock.Anything, "/wt/proj/b", "go test ./...").Return(&domain.CommandRunResult{ExitCode: 1, Output: "FAIL\n"}, nil)
+	runner.On("Run", mock.Anything, "/wt/proj/c", "go test ./...").Return(nil, errors.New("sh: not found"))
+
+	config := &CommandConfig{Services: &ServiceContainer{ContextService: cs, WorktreeService: ws, CommandRunner: runner}}
+	cmd := NewWorktreesCommand(config)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"foreach", "--parallel", "--output-dir", outputDir, "go test ./..."})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "command failed in 2 of 3 worktrees")
+
+	logA := filepath.Join(outputDir, "proj-feature-a.log")
+	logB := filepath.Join(outputDir, "proj-b.log")
+	logC := filepath.Join(outputDir, "proj-c.log")
+	assert.Equal(t, fmt.Sprintf(
+		"WORKTREE   EXIT   OUTPUT\n"+
+			"feature/a  0      %s\n"+
+			"b          1      %s\n"+
+			"c          error  %s\n", logA, logB, logC), out.String())
+
+	for path, expected := range map[string]string{logA: "PASS\n", logB: "FAIL\n", logC: "failed: sh: not found\n"} {
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(content))
+	}
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// Output formats of worktrees health-check
+const (
+	healthOutputText  = "text"
+	healthOutputJUnit = "junit"
+)
+
+// newWorktreesHealthCheckCmd creates the worktrees health-check subcommand
+func newWorktreesHealthCheckCmd(config *CommandConfig) *cobra.Command {
+	var projectName, output string
+
+	cmd := &cobra.Command{
+		Use:   "health-check",
+		Short: "Check the health of every worktree",
+		Long: `Check every worktree of every project: its directory must exist, be connected
+to its repository and have no unresolved conflicts. Prints a table with the
+issues found and fails unless every worktree is healthy, so it can run as a
+CI step; --output junit prints a JUnit XML report for CI test views instead.
+
+Examples:
+  twiggit worktrees health-check
+  twiggit worktrees health-check --project myproject
+  twiggit worktrees health-check --output junit > health.xml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeWorktreesHealthCheck(cmd, config, projectName, output)
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "Only check this project (defaults to every project)")
+	cmd.Flags().StringVarP(&output, "output", "o", healthOutputText, "Output format (text or junit)")
+
+	// Unhealthy worktrees are reported in the output; main reports the error
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	carapace.Gen(cmd).FlagCompletion(map[string]carapace.Action{
+		"project": actionProjects(config),
+		"output":  carapace.ActionValues(healthOutputText, healthOutputJUnit),
+	})
+
+	return cmd
+}
+
+// executeWorktreesHealthCheck checks the worktrees of the selected projects and fails when any is unhealthy
+func executeWorktreesHealthCheck(cmd *cobra.Command, config *CommandConfig, projectName, output string) error {
+	ctx := context.Background()
+
+	if output != healthOutputText && output != healthOutputJUnit {
+		return domain.NewValidationError("worktrees health-check", "output", output, "must be 'text' or 'junit'")
+	}
+
+	projects, err := resolveVerifyProjects(ctx, config, projectName, projectName == "")
+	if err != nil {
+		return err
+	}
+
+	started := time.Now()
+	report := &domain.HealthReport{}
+	for _, project := range projects {
+		logv(cmd, 1, "Checking worktrees of %s", project.Name)
+		worktrees, err := config.Services.WorktreeService.ListWorktrees(ctx, &domain.ListWorktreesRequest{ProjectName: project.Name})
+		if err != nil {
+			return fmt.Errorf("failed to list worktrees of %s: %w", project.Name, err)
+		}
+
+		for _, wt := range worktrees {
+			health, err := config.Services.WorktreeService.GetWorktreeHealth(ctx, wt.Path)
+			if err != nil {
+				return fmt.Errorf("failed to check %s: %w", wt.Path, err)
+			}
+			health.ProjectName = project.Name
+			if !wt.IsDetached && wt.Branch != "" {
+				health.Branch = wt.Branch
+			}
+			logv(cmd, 2, "  %s: %d issue(s)", wt.Path, len(health.Issues))
+			report.Add(health)
+		}
+	}
+
+	if output == healthOutputJUnit {
+		formatter := &JUnitFormatter{Timestamp: started}
+		_, _ = cmd.OutOrStdout().Write(formatter.Format(report))
+	} else {
+		displayHealthReport(cmd.OutOrStdout(), report)
+	}
+
+	if report.UnhealthyCount > 0 {
+		return fmt.Errorf("%d of %d worktree(s) unhealthy", report.UnhealthyCount, len(report.Worktrees))
+	}
+	return nil
+}
+
+// displayHealthReport prints the PROJECT/WORKTREE/HEALTH table, the issues and a totals line
+func displayHealthReport(out io.Writer, report *domain.HealthReport) {
+	if len(report.Worktrees) == 0 {
+		_, _ = fmt.Fprintln(out, "No worktrees to check")
+		return
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PROJECT\tWORKTREE\tHEALTH")
+	for _, health := range report.Worktrees {
+		state := "ok"
+		if !health.Healthy() {
+			state = fmt.Sprintf("unhealthy (%d issue(s))", len(health.Issues))
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", health.ProjectName, healthWorktreeName(health), state)
+	}
+	_ = w.Flush()
+
+	if len(report.Issues) > 0 {
+		_, _ = fmt.Fprintln(out, "\nIssues:")
+		for _, issue := range report.Issues {
+			_, _ = fmt.Fprintf(out, "  %s: %s\n", issue.WorktreePath, issue.Issue)
+		}
+	}
+	_, _ = fmt.Fprintf(out, "\n%d healthy, %d unhealthy\n", report.HealthyCount, report.UnhealthyCount)
+}
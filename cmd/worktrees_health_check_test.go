@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestWorktreesHealthCheckCommand_Execute(t *testing.T) {
+	projA := &domain.ProjectInfo{Name: "proj-a", GitRepoPath: "/repos/proj-a"}
+	projB := &domain.ProjectInfo{Name: "proj-b", GitRepoPath: "/repos/proj-b"}
+	healthy := func(path string) *domain.WorktreeHealth {
+		return &domain.WorktreeHealth{WorktreePath: path}
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		setupMocks  func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService)
+		expectError string
+		expectOut   []string
+	}{
+		{
+			name: "all projects healthy",
+			args: []string{},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("ListProjects", mock.Anything).Return([]*domain.ProjectInfo{projA, projB}, nil)
+				ws.On("ListWorktrees", mock.Anything, &domain.ListWorktreesRequest{ProjectName: "proj-a"}).
+					Return([]*domain.WorktreeInfo{{Path: "/wt/proj-a/feature", Branch: "feature"}}, nil)
+				ws.On("ListWorktrees", mock.Anything, &domain.ListWorktreesRequest{ProjectName: "proj-b"}).
+					Return([]*domain.WorktreeInfo{{Path: "/wt/proj-b/fix", Branch: "fix"}}, nil)
+				ws.On("GetWorktreeHealth", mock.Anything, "/wt/proj-a/feature").Return(healthy("/wt/proj-a/feature"), nil)
+				ws.On("GetWorktreeHealth", mock.Anything, "/wt/proj-b/fix").Return(healthy("/wt/proj-b/fix"), nil)
+			},
+			expectOut: []string{"PROJECT", "proj-a", "feature", "proj-b", "fix", "2 healthy, 0 unhealthy"},
+		},
+		{
+			name: "unhealthy worktree fails the command",
+			args: []string{"--project", "proj-a"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "proj-a", mock.Anything).Return(projA, nil)
+				ws.On("ListWorktrees", mock.Anything, &domain.ListWorktreesRequest{ProjectName: "proj-a"}).
+					Return([]*domain.WorktreeInfo{
+						{Path: "/wt/proj-a/feature", Branch: "feature"},
+						{Path: "/wt/proj-a/gone", Branch: "gone"},
+					}, nil)
+				ws.On("GetWorktreeHealth", mock.Anything, "/wt/proj-a/feature").Return(healthy("/wt/proj-a/feature"), nil)
+				ws.On("GetWorktreeHealth", mock.Anything, "/wt/proj-a/gone").Return(&domain.WorktreeHealth{
+					WorktreePath: "/wt/proj-a/gone",
+					Issues:       []domain.HealthIssue{{WorktreePath: "/wt/proj-a/gone", Issue: "directory is missing"}},
+				}, nil)
+			},
+			expectError: "1 of 2 worktree(s) unhealthy",
+			expectOut:   []string{"unhealthy (1 issue(s))", "/wt/proj-a/gone: directory is missing", "1 healthy, 1 unhealthy"},
+		},
+		{
+			name: "no worktrees",
+			args: []string{},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("ListProjects", mock.Anything).Return([]*domain.ProjectInfo{projA}, nil)
+				ws.On("ListWorktrees", mock.Anything, mock.Anything).Return([]*domain.WorktreeInfo{}, nil)
+			},
+			expectOut: []string{"No worktrees to check"},
+		},
+		{
+			name:        "invalid output",
+			args:        []string{"--output", "yaml"},
+			setupMocks:  func(_ *mocks.MockWorktreeService, _ *mocks.MockProjectService) {},
+			expectError: "must be 'text' or 'junit'",
+		},
+		{
+			name: "health check error",
+			args: []string{},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("ListProjects", mock.Anything).Return([]*domain.ProjectInfo{projA}, nil)
+				ws.On("ListWorktrees", mock.Anything, mock.Anything).
+					Return([]*domain.WorktreeInfo{{Path: "/wt/proj-a/feature", Branch: "feature"}}, nil)
+				ws.On("GetWorktreeHealth", mock.Anything, "/wt/proj-a/feature").Return(nil, errors.New("boom"))
+			},
+			expectError: "failed to check /wt/proj-a/feature",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+			tc.setupMocks(ws, ps)
+
+			config := &CommandConfig{Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps}}
+			cmd := NewWorktreesCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(append([]string{"health-check"}, tc.args...))
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+		})
+	}
+}
+
+func TestWorktreesHealthCheckCommand_JUnitOutput(t *testing.T) {
+	projA := &domain.ProjectInfo{Name: "proj-a", GitRepoPath: "/repos/proj-a"}
+	ws := mocks.NewMockWorktreeService()
+	cs := mocks.NewMockContextService()
+	ps := mocks.NewMockProjectService()
+	ps.On("ListProjects", mock.Anything).Return([]*domain.ProjectInfo{projA}, nil)
+	ws.On("ListWorktrees", mock.Anything, mock.Anything).
+		Return([]*domain.WorktreeInfo{{Path: "/wt/proj-a/feature", Branch: "feature"}}, nil)
+	ws.On("GetWorktreeHealth", mock.Anything, "/wt/proj-a/feature").Return(&domain.WorktreeHealth{
+		WorktreePath: "/wt/proj-a/feature",
+		Issues:       []domain.HealthIssue{{WorktreePath: "/wt/proj-a/feature", Issue: "2 file(s) with unresolved conflicts"}},
+	}, nil)
+
+	config := &CommandConfig{Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps}}
+	cmd := NewWorktreesCommand(config)
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"health-check", "--output", "junit"})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+
+	// Only the XML document is written to stdout, so it can be redirected to a report file
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(out.Bytes(), &suites))
+	assert.Equal(t, 1, suites.Tests)
+	assert.Equal(t, 1, suites.Failures)
+	require.Len(t, suites.Suites, 1)
+	require.Len(t, suites.Suites[0].Cases, 1)
+	assert.Equal(t, "feature", suites.Suites[0].Cases[0].Name)
+	require.NotNil(t, suites.Suites[0].Cases[0].Failure)
+}
@@ -0,0 +1,278 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// Results of locking or unlocking one worktree
+const (
+	lockResultLocked   = "locked"
+	lockResultUnlocked = "unlocked"
+	lockResultSkipped  = "skipped"
+	lockResultError    = "error"
+)
+
+// lockOutcome is what happened to one worktree of lock-all or unlock-all
+type lockOutcome struct {
+	worktree string
+	result   string
+	reason   string // Why the worktree was skipped
+	err      error
+}
+
+// newWorktreesLockAllCmd creates the worktrees lock-all subcommand
+func newWorktreesLockAllCmd(config *CommandConfig) *cobra.Command {
+	var projectName, reason string
+
+	cmd := &cobra.Command{
+		Use:   "lock-all",
+		Short: "Lock every worktree of a project",
+		Long: `Lock every worktree of a project with git worktree lock, so that prune,
+move and remove leave them alone during maintenance such as git filter-repo
+or changing the remote URL. Worktrees that are already locked are skipped.
+
+Prints a table with the result for each worktree and fails when any
+worktree could not be locked.
+
+Examples:
+  twiggit worktrees lock-all
+  twiggit worktrees lock-all --project myproject --reason "filter-repo in progress"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeWorktreesLock(cmd, config, projectName, true, reason)
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "Project to lock (defaults to the current project)")
+	cmd.Flags().StringVar(&reason, "reason", "", "Reason recorded with each lock")
+
+	// Failed worktrees are reported in the table; main reports the error
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	registerLockFlagCompletion(cmd, config)
+
+	return cmd
+}
+
+// newWorktreesUnlockAllCmd creates the worktrees unlock-all subcommand
+func newWorktreesUnlockAllCmd(config *CommandConfig) *cobra.Command {
+	var projectName string
+
+	cmd := &cobra.Command{
+		Use:   "unlock-all",
+		Short: "Unlock every worktree of a project",
+		Long: `Remove the lock of every locked worktree of a project, undoing lock-all.
+Worktrees that are not locked are skipped.
+
+Examples:
+  twiggit worktrees unlock-all
+  twiggit worktrees unlock-all --project myproject`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeWorktreesLock(cmd, config, projectName, false, "")
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "Project to unlock (defaults to the current project)")
+
+	// Failed worktrees are reported in the table; main reports the error
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	registerLockFlagCompletion(cmd, config)
+
+	return cmd
+}
+
+// newWorktreesListLockedCmd creates the worktrees list-locked subcommand
+func newWorktreesListLockedCmd(config *CommandConfig) *cobra.Command {
+	var projectName string
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "list-locked",
+		Short: "List locked worktrees",
+		Long: `List the worktrees of a project that are locked, with the reason given
+when they were locked.
+
+Examples:
+  twiggit worktrees list-locked
+  twiggit worktrees list-locked --project myproject
+  twiggit worktrees list-locked --all`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeWorktreesListLocked(cmd, config, projectName, all)
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "Project to list (defaults to the current project)")
+	cmd.Flags().BoolVarP(&all, "all", "a", false, "List locked worktrees of all projects")
+	registerLockFlagCompletion(cmd, config)
+
+	return cmd
+}
+
+// registerLockFlagCompletion completes --project with project names
+func registerLockFlagCompletion(cmd *cobra.Command, config *CommandConfig) {
+	carapace.Gen(cmd).FlagCompletion(map[string]carapace.Action{
+		"project": actionProjects(config),
+	})
+}
+
+// executeWorktreesLock locks (or unlocks) every worktree of the project and prints a table
+func executeWorktreesLock(cmd *cobra.Command, config *CommandConfig, projectName string, lock bool, reason string) error {
+	ctx := context.Background()
+
+	projects, err := resolveVerifyProjects(ctx, config, projectName, false)
+	if err != nil {
+		return err
+	}
+	project := projects[0]
+
+	worktrees, err := config.Services.WorktreeService.ListWorktrees(ctx, &domain.ListWorktreesRequest{ProjectName: project.Name})
+	if err != nil {
+		return fmt.Errorf("failed to list worktrees of %s: %w", project.Name, err)
+	}
+	if len(worktrees) == 0 {
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "No worktrees in %s\n", project.Name)
+		return nil
+	}
+
+	outcomes := make([]lockOutcome, 0, len(worktrees))
+	for _, wt := range worktrees {
+		outcome := lockWorktree(ctx, config, project, wt, lock, reason)
+		logv(cmd, 2, "  %s: %s", outcome.worktree, outcome.result)
+		outcomes = append(outcomes, outcome)
+	}
+
+	failed := displayLockOutcomes(cmd.OutOrStdout(), cmd.ErrOrStderr(), outcomes, lock)
+	if failed > 0 {
+		verb := "lock"
+		if !lock {
+			verb = "unlock"
+		}
+		return fmt.Errorf("failed to %s %d of %d worktree(s)", verb, failed, len(worktrees))
+	}
+	return nil
+}
+
+// lockWorktree locks or unlocks one worktree, skipping it when it is already in that state
+func lockWorktree(ctx context.Context, config *CommandConfig, project *domain.ProjectInfo, wt *domain.WorktreeInfo, lock bool, reason string) lockOutcome {
+	outcome := lockOutcome{worktree: lockWorktreeName(wt)}
+
+	switch {
+	case lock && wt.Locked:
+		outcome.result, outcome.reason = lockResultSkipped, "already locked"
+	case !lock && !wt.Locked:
+		outcome.result, outcome.reason = lockResultSkipped, "not locked"
+	case lock:
+		outcome.result = lockResultLocked
+		outcome.err = config.Services.WorktreeService.LockWorktree(ctx, project.GitRepoPath, wt.Path, reason)
+	default:
+		outcome.result = lockResultUnlocked
+		outcome.err = config.Services.WorktreeService.UnlockWorktree(ctx, project.GitRepoPath, wt.Path)
+	}
+
+	if outcome.err != nil {
+		outcome.result = lockResultError
+	}
+	return outcome
+}
+
+// lockWorktreeName names a worktree by its branch, or its directory when detached
+func lockWorktreeName(wt *domain.WorktreeInfo) string {
+	if wt.Branch == "" || wt.IsDetached {
+		return filepath.Base(wt.Path) + " (detached)"
+	}
+	return wt.Branch
+}
+
+// displayLockOutcomes prints the WORKTREE/RESULT table and a totals line, lists errors
+// on errOut and returns the number of failed worktrees
+func displayLockOutcomes(out, errOut io.Writer, outcomes []lockOutcome, lock bool) int {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "WORKTREE\tRESULT")
+	changed, skipped, failed := 0, 0, 0
+	for _, outcome := range outcomes {
+		result := outcome.result
+		switch outcome.result {
+		case lockResultLocked, lockResultUnlocked:
+			changed++
+		case lockResultSkipped:
+			skipped++
+			result += " (" + outcome.reason + ")"
+		case lockResultError:
+			failed++
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", outcome.worktree, result)
+	}
+	_ = w.Flush()
+
+	verb := lockResultLocked
+	if !lock {
+		verb = lockResultUnlocked
+	}
+	_, _ = fmt.Fprintf(out, "\n%d %s, %d skipped, %d failed\n", changed, verb, skipped, failed)
+
+	for _, outcome := range outcomes {
+		if outcome.err != nil {
+			_, _ = fmt.Fprintf(errOut, "Error: %s: %v\n", outcome.worktree, outcome.err)
+		}
+	}
+	return failed
+}
+
+// executeWorktreesListLocked prints the locked worktrees of the selected projects
+func executeWorktreesListLocked(cmd *cobra.Command, config *CommandConfig, projectName string, all bool) error {
+	ctx := context.Background()
+
+	if all && projectName != "" {
+		return domain.NewValidationError("worktrees list-locked", "project", projectName, "cannot combine --project with --all")
+	}
+
+	projects, err := resolveVerifyProjects(ctx, config, projectName, all)
+	if err != nil {
+		return err
+	}
+
+	var locked []*domain.WorktreeInfo
+	for _, project := range projects {
+		worktrees, err := config.Services.WorktreeService.ListWorktrees(ctx, &domain.ListWorktreesRequest{ProjectName: project.Name})
+		if err != nil {
+			return fmt.Errorf("failed to list worktrees of %s: %w", project.Name, err)
+		}
+		for _, wt := range worktrees {
+			if wt.Locked {
+				wt.Project = project.Name
+				locked = append(locked, wt)
+			}
+		}
+	}
+
+	out := cmd.OutOrStdout()
+	if len(locked) == 0 {
+		_, _ = fmt.Fprintln(out, "No locked worktrees")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PROJECT\tWORKTREE\tREASON\tPATH")
+	for _, wt := range locked {
+		reason := wt.LockReason
+		if reason == "" {
+			reason = "-"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", wt.Project, lockWorktreeName(wt), reason, wt.Path)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestWorktreesLockCommands_Execute(t *testing.T) {
+	projA := &domain.ProjectInfo{Name: "proj-a", GitRepoPath: "/repos/proj-a"}
+	projB := &domain.ProjectInfo{Name: "proj-b", GitRepoPath: "/repos/proj-b"}
+	listA := &domain.ListWorktreesRequest{ProjectName: "proj-a"}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		setupMocks   func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService)
+		expectError  string
+		expectOut    []string
+		expectStderr []string
+	}{
+		{
+			name: "lock-all locks unlocked worktrees",
+			args: []string{"lock-all", "--reason", "filter-repo"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "", mock.Anything).Return(projA, nil)
+				ws.On("ListWorktrees", mock.Anything, listA).Return([]*domain.WorktreeInfo{
+					{Path: "/wt/proj-a/feature", Branch: "feature"},
+					{Path: "/wt/proj-a/release", Branch: "release", Locked: true},
+					{Path: "/wt/proj-a/abc123", IsDetached: true},
+				}, nil)
+				ws.On("LockWorktree", mock.Anything, "/repos/proj-a", "/wt/proj-a/feature", "filter-repo").Return(nil)
+				ws.On("LockWorktree", mock.Anything, "/repos/proj-a", "/wt/proj-a/abc123", "filter-repo").Return(nil)
+			},
+			expectOut: []string{"WORKTREE", "feature", "locked", "release", "skipped (already locked)", "abc123 (detached)", "2 locked, 1 skipped, 0 failed"},
+		},
+		{
+			name: "lock-all reports failures",
+			args: []string{"lock-all", "--project", "proj-a"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "proj-a", mock.Anything).Return(projA, nil)
+				ws.On("ListWorktrees", mock.Anything, listA).Return([]*domain.WorktreeInfo{
+					{Path: "/wt/proj-a/feature", Branch: "feature"},
+					{Path: "/wt/proj-a/other", Branch: "other"},
+				}, nil)
+				ws.On("LockWorktree", mock.Anything, "/repos/proj-a", "/wt/proj-a/feature", "").Return(errors.New("permission denied"))
+				ws.On("LockWorktree", mock.Anything, "/repos/proj-a", "/wt/proj-a/other", "").Return(nil)
+			},
+			expectError:  "failed to lock 1 of 2 worktree(s)",
+			expectOut:    []string{"1 locked, 0 skipped, 1 failed"},
+			expectStderr: []string{"Error: feature: permission denied"},
+		},
+		{
+			name: "unlock-all unlocks locked worktrees",
+			args: []string{"unlock-all"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "", mock.Anything).Return(projA, nil)
+				ws.On("ListWorktrees", mock.Anything, listA).Return([]*domain.WorktreeInfo{
+					{Path: "/wt/proj-a/feature", Branch: "feature", Locked: true},
+					{Path: "/wt/proj-a/release", Branch: "release"},
+				}, nil)
+				ws.On("UnlockWorktree", mock.Anything, "/repos/proj-a", "/wt/proj-a/feature").Return(nil)
+			},
+			expectOut: []string{"unlocked", "skipped (not locked)", "1 unlocked, 1 skipped, 0 failed"},
+		},
+		{
+			name: "lock-all without worktrees",
+			args: []string{"lock-all"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "", mock.Anything).Return(projA, nil)
+				ws.On("ListWorktrees", mock.Anything, listA).Return([]*domain.WorktreeInfo{}, nil)
+			},
+			expectOut: []string{"No worktrees in proj-a"},
+		},
+		{
+			name: "list-locked all projects",
+			args: []string{"list-locked", "--all"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("ListProjects", mock.Anything).Return([]*domain.ProjectInfo{projA, projB}, nil)
+				ws.On("ListWorktrees", mock.Anything, listA).Return([]*domain.WorktreeInfo{
+					{Path: "/wt/proj-a/feature", Branch: "feature", Locked: true, LockReason: "filter-repo"},
+					{Path: "/wt/proj-a/release", Branch: "release"},
+				}, nil)
+				ws.On("ListWorktrees", mock.Anything, &domain.ListWorktreesRequest{ProjectName: "proj-b"}).Return([]*domain.WorktreeInfo{
+					{Path: "/wt/proj-b/main-fix", Branch: "main-fix", Locked: true},
+				}, nil)
+			},
+			expectOut: []string{"PROJECT", "proj-a", "feature", "filter-repo", "/wt/proj-a/feature", "proj-b", "main-fix"},
+		},
+		{
+			name: "list-locked without locked worktrees",
+			args: []string{"list-locked"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "", mock.Anything).Return(projA, nil)
+				ws.On("ListWorktrees", mock.Anything, listA).Return([]*domain.WorktreeInfo{{Path: "/wt/proj-a/feature", Branch: "feature"}}, nil)
+			},
+			expectOut: []string{"No locked worktrees"},
+		},
+		{
+			name:        "list-locked project with --all",
+			args:        []string{"list-locked", "--project", "proj-a", "--all"},
+			setupMocks:  func(_ *mocks.MockWorktreeService, _ *mocks.MockProjectService) {},
+			expectError: "cannot combine --project with --all",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj-a"}, nil)
+			tc.setupMocks(ws, ps)
+
+			config := &CommandConfig{Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps}}
+			cmd := NewWorktreesCommand(config)
+			var out, stderr bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&stderr)
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+			for _, expected := range tc.expectStderr {
+				assert.Contains(t, stderr.String(), expected)
+			}
+			ws.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// newWorktreesPrintEnvCmd creates the worktrees print-env subcommand
+func newWorktreesPrintEnvCmd(config *CommandConfig) *cobra.Command {
+	var all bool
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "print-env",
+		Short: "Print worktrees as shell or Makefile variables",
+		Long: `Print the worktrees of the current project (or every project with --all) as
+variable assignments for build systems: WORKTREE_<n>_PATH, WORKTREE_<n>_BRANCH,
+WORKTREE_<n>_PROJECT and WORKTREE_COUNT, numbered from 0 in list order.
+Values are quoted for sh when needed; --format makefile uses := assignments.
+
+Examples:
+  eval "$(twiggit worktrees print-env)"
+  twiggit worktrees print-env --all > worktrees.env
+  twiggit worktrees print-env --format makefile > worktrees.mk`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeWorktreesPrintEnv(cmd, config, all, format)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&all, "all", "a", false, "Print the worktrees of every project")
+	cmd.Flags().StringVar(&format, "format", string(EnvStyleShell), "Assignment syntax (shell or makefile)")
+
+	carapace.Gen(cmd).FlagCompletion(map[string]carapace.Action{
+		"format": carapace.ActionValues(string(EnvStyleShell), string(EnvStyleMakefile)),
+	})
+
+	return cmd
+}
+
+// executeWorktreesPrintEnv lists the worktrees and prints them with EnvFormatter
+func executeWorktreesPrintEnv(cmd *cobra.Command, config *CommandConfig, all bool, format string) error {
+	ctx := context.Background()
+
+	style := EnvStyle(format)
+	if style != EnvStyleShell && style != EnvStyleMakefile {
+		return domain.NewValidationError("worktrees print-env", "format", format, "must be 'shell' or 'makefile'")
+	}
+
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil {
+		return fmt.Errorf("context detection failed: %w", err)
+	}
+	if !all && currentCtx.ProjectName == "" {
+		return domain.NewValidationError("worktrees print-env", "context", currentCtx.Type.String(), "run print-env from inside a project or use --all")
+	}
+
+	req := &domain.ListWorktreesRequest{Context: currentCtx, ListAllProjects: all}
+	if !all {
+		req.ProjectName = currentCtx.ProjectName
+	}
+	logv(cmd, 1, "Listing worktrees")
+	worktrees, err := config.Services.WorktreeService.ListWorktrees(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to list worktrees: %w", err)
+	}
+
+	_, _ = fmt.Fprint(cmd.OutOrStdout(), (&EnvFormatter{}).FormatWorktrees(worktrees, style))
+	return nil
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestWorktreesPrintEnvCommand_Execute(t *testing.T) {
+	projectCtx := &domain.Context{Type: domain.ContextProject, ProjectName: "api", Path: "/repos/api"}
+	worktrees := []*domain.WorktreeInfo{{Path: "/wt/api/feature-x", Branch: "feature-x"}}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		context     *domain.Context
+		request     func(req *domain.ListWorktreesRequest) bool
+		expectError string
+		expectOut   string
+	}{
+		{
+			name:      "current project as shell variables",
+			context:   projectCtx,
+			request:   func(req *domain.ListWorktreesRequest) bool { return req.ProjectName == "api" && !req.ListAllProjects },
+			expectOut: "WORKTREE_0_PATH=/wt/api/feature-x\nWORKTREE_0_BRANCH=feature-x\nWORKTREE_COUNT=1\n",
+		},
+		{
+			name:      "all projects as Makefile variables",
+			args:      []string{"--all", "--format", "makefile"},
+			context:   &domain.Context{Type: domain.ContextOutsideGit},
+			request:   func(req *domain.ListWorktreesRequest) bool { return req.ListAllProjects },
+			expectOut: "WORKTREE_0_PATH := /wt/api/feature-x\nWORKTREE_0_BRANCH := feature-x\nWORKTREE_COUNT := 1\n",
+		},
+		{
+			name:        "outside a project without --all",
+			context:     &domain.Context{Type: domain.ContextOutsideGit},
+			expectError: "run print-env from inside a project or use --all",
+		},
+		{
+			name:        "unsupported format",
+			args:        []string{"--format", "json"},
+			context:     projectCtx,
+			expectError: "must be 'shell' or 'makefile'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			cs.On("GetCurrentContext").Return(tc.context, nil)
+			if tc.request != nil {
+				ws.On("ListWorktrees", mock.Anything, mock.MatchedBy(tc.request)).Return(worktrees, nil)
+			}
+
+			cmd := NewWorktreesCommand(&CommandConfig{Services: &ServiceContainer{WorktreeService: ws, ContextService: cs}})
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(append([]string{"print-env"}, tc.args...))
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectOut, out.String())
+			ws.AssertExpectations(t)
+		})
+	}
+}
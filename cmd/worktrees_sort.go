@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// defaultSortKey is the configuration key holding the default order of list
+const defaultSortKey = "default_sort"
+
+// sortModeValues lists the --sort values for help and completion
+var sortModeValues = []string{string(domain.SortByBranch), string(domain.SortByProject), string(domain.SortByPath), string(domain.SortByAge)}
+
+// newWorktreesSortCmd creates the worktrees sort subcommand
+func newWorktreesSortCmd(config *CommandConfig) *cobra.Command {
+	var unset bool
+
+	cmd := &cobra.Command{
+		Use:   "sort <branch|project|path|age>",
+		Short: "Set the default order of twiggit list",
+		Long: `Save the order twiggit list uses when --sort is not given, as default_sort
+in the configuration file. --unset removes the preference, so list keeps
+the order git reports.
+
+Examples:
+  twiggit worktrees sort branch   Always list worktrees by branch name
+  twiggit worktrees sort age      Most recently updated first
+  twiggit worktrees sort --unset  Back to git's order`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if unset {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var value string
+			if len(args) > 0 {
+				value = args[0]
+			}
+			return executeWorktreesSort(cmd, config, value)
+		},
+	}
+
+	cmd.Flags().BoolVar(&unset, "unset", false, "Remove the default order")
+
+	carapace.Gen(cmd).PositionalCompletion(
+		carapace.ActionValues(sortModeValues...),
+	)
+
+	return cmd
+}
+
+// executeWorktreesSort writes (or with an empty value removes) default_sort and confirms it
+func executeWorktreesSort(cmd *cobra.Command, config *CommandConfig, value string) error {
+	if _, err := domain.ParseSortMode(value); err != nil {
+		return err
+	}
+
+	logv(cmd, 1, "Setting %s to %q", defaultSortKey, value)
+	if err := config.Services.ConfigManager.SetValue(defaultSortKey, value); err != nil {
+		return fmt.Errorf("failed to save the default sort: %w", err)
+	}
+
+	if isQuiet(cmd) {
+		return nil
+	}
+	if value == "" {
+		_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Removed the default sort: twiggit list keeps git's order")
+		return nil
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "twiggit list now sorts by %s (override with --sort)\n", value)
+	return nil
+}
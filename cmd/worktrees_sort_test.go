@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestWorktreesSortCommand(t *testing.T) {
+	testCases := []struct {
+		name          string
+		args          []string
+		setErr        error
+		expectValue   string
+		expectOutput  string
+		expectError   string
+		expectNoWrite bool
+	}{
+		{name: "sets the preference", args: []string{"branch"}, expectValue: "branch", expectOutput: "twiggit list now sorts by branch"},
+		{name: "unsets the preference", args: []string{"--unset"}, expectValue: "", expectOutput: "Removed the default sort"},
+		{name: "unsupported order", args: []string{"size"}, expectError: "unsupported sort order", expectNoWrite: true},
+		{name: "order required", args: []string{}, expectError: "accepts 1 arg(s)", expectNoWrite: true},
+		{name: "no order with --unset", args: []string{"age", "--unset"}, expectError: "unknown command", expectNoWrite: true},
+		{name: "write failure", args: []string{"age"}, setErr: errors.New("disk full"), expectValue: "age", expectError: "failed to save the default sort"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			manager := mocks.NewMockConfigManager()
+			manager.On("SetValue", "default_sort", tc.expectValue).Return(tc.setErr).Maybe()
+
+			cmd := newWorktreesSortCmd(&CommandConfig{
+				Services: &ServiceContainer{ConfigManager: manager},
+				Config:   domain.DefaultConfig(),
+			})
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(tc.args)
+
+			err := cmd.Execute()
+			if tc.expectNoWrite {
+				manager.AssertNotCalled(t, "SetValue", "default_sort", tc.expectValue)
+			}
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			manager.AssertExpectations(t)
+			assert.Contains(t, out.String(), tc.expectOutput)
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+	"twiggit/internal/infrastructure"
+)
+
+const (
+	// metricsPath is where --serve exposes the metrics
+	metricsPath = "/metrics"
+	// prometheusContentType is the media type of the text exposition format
+	prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+	// metricsReadHeaderTimeout bounds slow clients of --serve
+	metricsReadHeaderTimeout = 10 * time.Second
+)
+
+// worktreesStatsOptions holds the flag values for the worktrees stats command
+type worktreesStatsOptions struct {
+	project    string
+	prometheus bool
+	outputFile string
+	serve      string
+}
+
+// newWorktreesStatsCmd creates the worktrees stats subcommand
+func newWorktreesStatsCmd(config *CommandConfig) *cobra.Command {
+	var opts worktreesStatsOptions
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show worktree counts, dirty worktrees and ages per project",
+		Long: `Show how many worktrees each project has, how many have uncommitted changes
+and how old their HEAD commits are.
+
+--prometheus prints the metrics in the Prometheus text format instead:
+twiggit_worktrees_total and twiggit_worktrees_dirty per project, and
+twiggit_worktrees_age_seconds per worktree. --output-file writes them
+atomically, for node_exporter's textfile collector; --serve answers scrapes
+of /metrics with fresh metrics until Ctrl-C.
+
+Examples:
+  twiggit worktrees stats
+  twiggit worktrees stats --prometheus --output-file /var/lib/node_exporter/twiggit.prom
+  twiggit worktrees stats --serve :9091`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeWorktreesStats(cmd, config, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.project, "project", "p", "", "Only this project (defaults to every project)")
+	cmd.Flags().BoolVar(&opts.prometheus, "prometheus", false, "Print the metrics in the Prometheus text format")
+	cmd.Flags().StringVar(&opts.outputFile, "output-file", "", "Write the Prometheus metrics to this file instead of stdout (implies --prometheus)")
+	cmd.Flags().StringVar(&opts.serve, "serve", "", "Serve the Prometheus metrics on this address (e.g. :9091) at "+metricsPath)
+	cmd.MarkFlagsMutuallyExclusive("output-file", "serve")
+
+	carapace.Gen(cmd).FlagCompletion(map[string]carapace.Action{
+		"project":     actionProjects(config),
+		"output-file": carapace.ActionFiles(),
+	})
+
+	return cmd
+}
+
+// executeWorktreesStats prints, writes or serves the stats of the selected projects
+func executeWorktreesStats(cmd *cobra.Command, config *CommandConfig, opts worktreesStatsOptions) error {
+	ctx := context.Background()
+
+	if opts.serve != "" {
+		return serveWorktreeMetrics(ctx, cmd, config, opts)
+	}
+
+	stats, err := collectWorktreeStats(ctx, cmd, config, opts.project)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case opts.outputFile != "":
+		if err := infrastructure.WriteFileAtomic(opts.outputFile, (&PrometheusFormatter{}).Format(stats), 0644); err != nil {
+			return fmt.Errorf("failed to write metrics: %w", err)
+		}
+		logv(cmd, 1, "Wrote metrics to %s", opts.outputFile)
+	case opts.prometheus:
+		_, _ = cmd.OutOrStdout().Write((&PrometheusFormatter{}).Format(stats))
+	default:
+		displayWorktreeStats(cmd.OutOrStdout(), stats)
+	}
+	return nil
+}
+
+// collectWorktreeStats counts the worktrees of the selected projects, checking each one for changes
+func collectWorktreeStats(ctx context.Context, cmd *cobra.Command, config *CommandConfig, projectName string) (map[string]*domain.ProjectStats, error) {
+	projects, err := resolveVerifyProjects(ctx, config, projectName, projectName == "")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	stats := make(map[string]*domain.ProjectStats, len(projects))
+	for _, project := range projects {
+		logv(cmd, 2, "  collecting stats of %s", project.Name)
+		worktrees, err := config.Services.WorktreeService.ListWorktrees(ctx, &domain.ListWorktreesRequest{
+			ProjectName:        project.Name,
+			IncludeLastUpdated: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list worktrees of %s: %w", project.Name, err)
+		}
+
+		projectStats := &domain.ProjectStats{ProjectName: project.Name}
+		for _, wt := range worktrees {
+			status, err := config.Services.WorktreeService.GetWorktreeStatus(ctx, wt.Path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get status of %s: %w", wt.Path, err)
+			}
+			branch := wt.Branch
+			if wt.IsDetached || branch == "" {
+				branch = filepath.Base(wt.Path)
+			}
+			projectStats.Add(branch, !status.IsClean, wt.LastUpdated, now)
+		}
+		stats[project.Name] = projectStats
+	}
+	return stats, nil
+}
+
+// serveWorktreeMetrics answers scrapes of metricsPath on opts.serve until Ctrl-C
+func serveWorktreeMetrics(ctx context.Context, cmd *cobra.Command, config *CommandConfig, opts worktreesStatsOptions) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, newMetricsHandler(func(ctx context.Context) (map[string]*domain.ProjectStats, error) {
+		return collectWorktreeStats(ctx, cmd, config, opts.project)
+	}))
+	server := &http.Server{Addr: opts.serve, Handler: mux, ReadHeaderTimeout: metricsReadHeaderTimeout}
+
+	errs := make(chan error, 1)
+	go func() { errs <- server.ListenAndServe() }()
+	_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Serving worktree metrics on %s%s (Ctrl-C to stop)\n", opts.serve, metricsPath)
+
+	select {
+	case err := <-errs:
+		return fmt.Errorf("failed to serve metrics on %s: %w", opts.serve, err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to stop the metrics server: %w", err)
+	}
+	return nil
+}
+
+// newMetricsHandler serves freshly collected stats in the Prometheus text format;
+// a failed collection is a 500 so the scrape is marked down
+func newMetricsHandler(collect func(ctx context.Context) (map[string]*domain.ProjectStats, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		stats, err := collect(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", prometheusContentType)
+		_, _ = w.Write((&PrometheusFormatter{}).Format(stats))
+	})
+}
+
+// displayWorktreeStats prints a PROJECT/WORKTREES/DIRTY/OLDEST table
+func displayWorktreeStats(out io.Writer, stats map[string]*domain.ProjectStats) {
+	if len(stats) == 0 {
+		_, _ = fmt.Fprintln(out, "No projects found")
+		return
+	}
+
+	projects := make([]string, 0, len(stats))
+	for name := range stats {
+		projects = append(projects, name)
+	}
+	slices.Sort(projects)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PROJECT\tWORKTREES\tDIRTY\tOLDEST")
+	for _, name := range projects {
+		s := stats[name]
+		oldest := "-"
+		if len(s.Ages) > 0 {
+			oldest = formatStatsAge(s.Oldest())
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%s\n", name, s.Total, s.Dirty, oldest)
+	}
+	_ = w.Flush()
+}
+
+// formatStatsAge rounds an age down to hours below a day, to days above
+func formatStatsAge(age time.Duration) string {
+	if age < 24*time.Hour {
+		return fmt.Sprintf("%dh", int(age.Hours()))
+	}
+	return fmt.Sprintf("%dd", int(age.Hours()/24))
+}
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestWorktreesStatsCommand_Execute(t *testing.T) {
+	projA := &domain.ProjectInfo{Name: "proj-a", GitRepoPath: "/repos/proj-a"}
+	projB := &domain.ProjectInfo{Name: "proj-b", GitRepoPath: "/repos/proj-b"}
+	twoDaysAgo := time.Now().Add(-49 * time.Hour)
+
+	setupMocks := func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+		ps.On("ListProjects", mock.Anything).Return([]*domain.ProjectInfo{projA, projB}, nil)
+		ws.On("ListWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+			return req.ProjectName == "proj-a" && req.IncludeLastUpdated
+		})).Return([]*domain.WorktreeInfo{
+			{Path: "/wt/proj-a/feature", Branch: "feature", LastUpdated: twoDaysAgo},
+			{Path: "/wt/proj-a/scratch", IsDetached: true},
+		}, nil)
+		ws.On("ListWorktrees", mock.Anything, mock.MatchedBy(func(req *domain.ListWorktreesRequest) bool {
+			return req.ProjectName == "proj-b"
+		})).Return([]*domain.WorktreeInfo{}, nil)
+		ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj-a/feature").Return(&domain.WorktreeStatus{IsClean: false}, nil)
+		ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj-a/scratch").Return(&domain.WorktreeStatus{IsClean: true}, nil)
+	}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		setupMocks  func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService)
+		expectError string
+		expectOut   []string
+	}{
+		{
+			name:       "table",
+			setupMocks: setupMocks,
+			expectOut:  []string{"PROJECT  WORKTREES  DIRTY  OLDEST\nproj-a   2          1      2d\nproj-b   0          0      -\n"},
+		},
+		{
+			name:       "prometheus",
+			args:       []string{"--prometheus"},
+			setupMocks: setupMocks,
+			expectOut: []string{
+				`twiggit_worktrees_total{project="proj-a"} 2`,
+				`twiggit_worktrees_dirty{project="proj-a"} 1`,
+				`twiggit_worktrees_total{project="proj-b"} 0`,
+				`twiggit_worktrees_age_seconds{project="proj-a",branch="feature"} 17640`,
+			},
+		},
+		{
+			name: "single project",
+			args: []string{"--project", "proj-b", "--prometheus"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "proj-b", mock.Anything).Return(projB, nil)
+				ws.On("ListWorktrees", mock.Anything, mock.Anything).Return([]*domain.WorktreeInfo{}, nil)
+			},
+			expectOut: []string{`twiggit_worktrees_total{project="proj-b"} 0`},
+		},
+		{
+			name: "status error",
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("ListProjects", mock.Anything).Return([]*domain.ProjectInfo{projA}, nil)
+				ws.On("ListWorktrees", mock.Anything, mock.Anything).Return([]*domain.WorktreeInfo{{Path: "/wt/proj-a/feature", Branch: "feature"}}, nil)
+				ws.On("GetWorktreeStatus", mock.Anything, "/wt/proj-a/feature").Return(nil, errors.New("boom"))
+			},
+			expectError: "failed to get status of /wt/proj-a/feature",
+		},
+		{
+			name:        "output file with serve",
+			args:        []string{"--output-file", "x.prom", "--serve", ":9091"},
+			setupMocks:  func(_ *mocks.MockWorktreeService, _ *mocks.MockProjectService) {},
+			expectError: "none of the others can be",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextOutsideGit}, nil)
+			tc.setupMocks(ws, ps)
+
+			cmd := NewWorktreesCommand(&CommandConfig{Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps}})
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(append([]string{"stats"}, tc.args...))
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+		})
+	}
+}
+
+func TestWorktreesStatsCommand_OutputFile(t *testing.T) {
+	ws := mocks.NewMockWorktreeService()
+	cs := mocks.NewMockContextService()
+	ps := mocks.NewMockProjectService()
+	ps.On("ListProjects", mock.Anything).Return([]*domain.ProjectInfo{{Name: "proj-a"}}, nil)
+	ws.On("ListWorktrees", mock.Anything, mock.Anything).Return([]*domain.WorktreeInfo{}, nil)
+
+	path := filepath.Join(t.TempDir(), "twiggit.prom")
+	require.NoError(t, os.WriteFile(path, []byte("stale"), 0600))
+
+	cmd := NewWorktreesCommand(&CommandConfig{Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps}})
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"stats", "--output-file", path})
+	require.NoError(t, cmd.Execute())
+
+	assert.Empty(t, out.String(), "metrics go to the file only")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `twiggit_worktrees_total{project="proj-a"} 0`)
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "no temporary file is left behind")
+}
+
+func TestMetricsHandler(t *testing.T) {
+	stats := newTestProjectStats()
+
+	t.Run("serves the exposition", func(t *testing.T) {
+		handler := newMetricsHandler(func(context.Context) (map[string]*domain.ProjectStats, error) { return stats, nil })
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, metricsPath, nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, prometheusContentType, rec.Header().Get("Content-Type"))
+		assert.Equal(t, string((&PrometheusFormatter{}).Format(stats)), rec.Body.String())
+	})
+
+	t.Run("collection failure is a server error", func(t *testing.T) {
+		handler := newMetricsHandler(func(context.Context) (map[string]*domain.ProjectStats, error) { return nil, errors.New("boom") })
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, metricsPath, nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), "boom")
+	})
+
+	t.Run("only GET and HEAD", func(t *testing.T) {
+		handler := newMetricsHandler(func(context.Context) (map[string]*domain.ProjectStats, error) { return stats, nil })
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, metricsPath, nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
+
+func TestFormatStatsAge(t *testing.T) {
+	assert.Equal(t, "0h", formatStatsAge(30*time.Minute))
+	assert.Equal(t, "23h", formatStatsAge(23*time.Hour+59*time.Minute))
+	assert.Equal(t, "1d", formatStatsAge(24*time.Hour))
+	assert.Equal(t, "14d", formatStatsAge(14*24*time.Hour+5*time.Hour))
+}
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/carapace-sh/carapace"
+	"github.com/spf13/cobra"
+
+	"twiggit/internal/domain"
+)
+
+// newWorktreesVerifyCmd creates the worktrees verify subcommand
+func newWorktreesVerifyCmd(config *CommandConfig) *cobra.Command {
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "verify [project]",
+		Short: "Check that git and twiggit agree on a project's worktrees",
+		Long: `Compare the worktrees registered with git against the worktrees
+twiggit discovers in its worktrees directory.
+
+Reports worktrees known to git but missing from the worktrees directory,
+and directories in the worktrees directory that git does not know about.
+Exits with an error when any discrepancy is found.
+
+Examples:
+  twiggit worktrees verify            Verify the current project
+  twiggit worktrees verify myproject  Verify a specific project
+  twiggit worktrees verify --all      Verify every project`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var projectName string
+			if len(args) > 0 {
+				projectName = args[0]
+			}
+			return executeWorktreesVerify(cmd, config, projectName, all)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&all, "all", "a", false, "Verify all projects")
+
+	carapace.Gen(cmd).PositionalCompletion(
+		actionProjects(config),
+	)
+
+	return cmd
+}
+
+// executeWorktreesVerify verifies the selected projects and fails when any discrepancy is found
+func executeWorktreesVerify(cmd *cobra.Command, config *CommandConfig, projectName string, all bool) error {
+	ctx := context.Background()
+
+	if all && projectName != "" {
+		return domain.NewValidationError("worktrees verify", "project", projectName, "cannot combine a project with --all")
+	}
+
+	projects, err := resolveVerifyProjects(ctx, config, projectName, all)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	discrepancies := 0
+	for _, project := range projects {
+		logv(cmd, 1, "Verifying worktrees of %s", project.Name)
+
+		verification, err := config.Services.WorktreeService.VerifyWorktrees(ctx, project)
+		if err != nil {
+			return fmt.Errorf("failed to verify worktrees of %s: %w", project.Name, err)
+		}
+
+		displayWorktreeVerification(out, verification)
+		discrepancies += len(verification.GitOnly) + len(verification.DiscoveredOnly)
+	}
+
+	if discrepancies > 0 {
+		return fmt.Errorf("found %d worktree discrepancies", discrepancies)
+	}
+	return nil
+}
+
+// resolveVerifyProjects returns every project with --all, otherwise the named or current project
+func resolveVerifyProjects(ctx context.Context, config *CommandConfig, projectName string, all bool) ([]*domain.ProjectInfo, error) {
+	if all {
+		projects, err := config.Services.ProjectService.ListProjects(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list projects: %w", err)
+		}
+		return projects, nil
+	}
+
+	currentCtx, err := config.Services.ContextService.GetCurrentContext()
+	if err != nil {
+		return nil, fmt.Errorf("context detection failed: %w", err)
+	}
+
+	project, err := config.Services.ProjectService.DiscoverProject(ctx, projectName, currentCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover project: %w", err)
+	}
+	return []*domain.ProjectInfo{project}, nil
+}
+
+// displayWorktreeVerification prints the discrepancies of one project with suggested fixes
+func displayWorktreeVerification(out io.Writer, verification *domain.WorktreeVerification) {
+	if !verification.HasDiscrepancies() {
+		_, _ = fmt.Fprintf(out, "%s: OK\n", verification.ProjectName)
+		return
+	}
+
+	_, _ = fmt.Fprintf(out, "%s:\n", verification.ProjectName)
+	if len(verification.GitOnly) > 0 {
+		_, _ = fmt.Fprintf(out, "  Known to git but not found in %s:\n", verification.WorktreesDir)
+		for _, path := range verification.GitOnly {
+			_, _ = fmt.Fprintf(out, "    %s\n", path)
+		}
+		_, _ = fmt.Fprintln(out, "  Fix: move them with 'git worktree move', or run 'git worktree prune' if they no longer exist")
+	}
+	if len(verification.DiscoveredOnly) > 0 {
+		_, _ = fmt.Fprintln(out, "  Found in the worktrees directory but not known to git:")
+		for _, path := range verification.DiscoveredOnly {
+			_, _ = fmt.Fprintf(out, "    %s\n", path)
+		}
+		_, _ = fmt.Fprintln(out, "  Fix: run 'git worktree repair <path>' from the project, or remove the directory")
+	}
+}
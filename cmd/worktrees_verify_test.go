@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func TestWorktreesVerifyCommand_Execute(t *testing.T) {
+	projA := &domain.ProjectInfo{Name: "proj-a", GitRepoPath: "/repos/proj-a"}
+	projB := &domain.ProjectInfo{Name: "proj-b", GitRepoPath: "/repos/proj-b"}
+
+	testCases := []struct {
+		name        string
+		args        []string
+		setupMocks  func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService)
+		expectError string
+		expectOut   []string
+	}{
+		{
+			name: "current project consistent",
+			args: []string{},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "", mock.Anything).Return(projA, nil)
+				ws.On("VerifyWorktrees", mock.Anything, projA).Return(&domain.WorktreeVerification{ProjectName: "proj-a"}, nil)
+			},
+			expectOut: []string{"proj-a: OK"},
+		},
+		{
+			name: "discrepancies fail the command",
+			args: []string{"proj-a"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "proj-a", mock.Anything).Return(projA, nil)
+				ws.On("VerifyWorktrees", mock.Anything, projA).Return(&domain.WorktreeVerification{
+					ProjectName:    "proj-a",
+					WorktreesDir:   "/wt/proj-a",
+					GitOnly:        []string{"/elsewhere/manual"},
+					DiscoveredOnly: []string{"/wt/proj-a/orphaned"},
+				}, nil)
+			},
+			expectError: "found 2 worktree discrepancies",
+			expectOut:   []string{"/elsewhere/manual", "/wt/proj-a/orphaned", "git worktree repair"},
+		},
+		{
+			name: "all projects",
+			args: []string{"--all"},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("ListProjects", mock.Anything).Return([]*domain.ProjectInfo{projA, projB}, nil)
+				ws.On("VerifyWorktrees", mock.Anything, projA).Return(&domain.WorktreeVerification{ProjectName: "proj-a"}, nil)
+				ws.On("VerifyWorktrees", mock.Anything, projB).Return(&domain.WorktreeVerification{ProjectName: "proj-b"}, nil)
+			},
+			expectOut: []string{"proj-a: OK", "proj-b: OK"},
+		},
+		{
+			name:        "project with --all",
+			args:        []string{"proj-a", "--all"},
+			setupMocks:  func(_ *mocks.MockWorktreeService, _ *mocks.MockProjectService) {},
+			expectError: "cannot combine a project with --all",
+		},
+		{
+			name: "service error",
+			args: []string{},
+			setupMocks: func(ws *mocks.MockWorktreeService, ps *mocks.MockProjectService) {
+				ps.On("DiscoverProject", mock.Anything, "", mock.Anything).Return(projA, nil)
+				ws.On("VerifyWorktrees", mock.Anything, projA).Return(nil, errors.New("not a repository"))
+			},
+			expectError: "failed to verify worktrees of proj-a",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ws := mocks.NewMockWorktreeService()
+			cs := mocks.NewMockContextService()
+			ps := mocks.NewMockProjectService()
+			cs.On("GetCurrentContext").Return(&domain.Context{Type: domain.ContextProject, ProjectName: "proj-a"}, nil)
+			tc.setupMocks(ws, ps)
+
+			config := &CommandConfig{Services: &ServiceContainer{WorktreeService: ws, ContextService: cs, ProjectService: ps}}
+			cmd := NewWorktreesCommand(config)
+			var out bytes.Buffer
+			cmd.SetOut(&out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(append([]string{"verify"}, tc.args...))
+
+			err := cmd.Execute()
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, expected := range tc.expectOut {
+				assert.Contains(t, out.String(), expected)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package application
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/go-git/go-git/v5"
 	"twiggit/internal/domain"
@@ -14,12 +16,36 @@ type ConfigManager interface {
 
 	// GetConfig returns the loaded configuration (immutable after Load)
 	GetConfig() *domain.Config
+
+	// LoadForProject loads the configuration with the settings of repoPath's .twiggit.toml over
+	// the config file: scalars replace, lists are merged. TWIGGIT_* variables still win.
+	LoadForProject(repoPath string) (*domain.Config, error)
+
+	// SetValue sets a dotted key (e.g. "default_sort", "git.cli_timeout") in the config file;
+	// an empty value removes the key. The file is validated, then atomically rewritten.
+	SetValue(key, value string) error
+}
+
+// ConfigWatcher reloads configuration when the config file changes on disk
+type ConfigWatcher interface {
+	// Subscribe returns a channel receiving each successfully reloaded configuration
+	Subscribe() <-chan *domain.Config
+
+	// Stop stops watching and closes all subscriber channels
+	Stop() error
 }
 
 // ContextDetector detects the current git context
 type ContextDetector interface {
 	// DetectContext detects the context from the given directory
 	DetectContext(dir string) (*domain.Context, error)
+
+	// DetectFromEnvironment builds a context from TWIGGIT_CONTEXT or CI variables, nil when none are set
+	DetectFromEnvironment() (*domain.Context, error)
+
+	// DetectFromGitConfig builds a worktree context from the worktree.gitdir option of
+	// <path>/.git/config (symlinked git directories), nil when it is not set
+	DetectFromGitConfig(path string) (*domain.Context, error)
 }
 
 // ContextResolver resolves target identifiers based on current context
@@ -31,6 +57,124 @@ type ContextResolver interface {
 	GetResolutionSuggestions(ctx *domain.Context, partial string, opts ...domain.SuggestionOption) ([]*domain.ResolutionSuggestion, error)
 }
 
+// WorktreeOwnerFinder finds the project owning a worktree; ProjectService implements it
+type WorktreeOwnerFinder interface {
+	// FindProjectByWorktreePath determines which project owns the given worktree path
+	FindProjectByWorktreePath(ctx context.Context, worktreePath string) (*domain.ProjectInfo, error)
+}
+
+// Initializer sets up a new twiggit workspace
+type Initializer interface {
+	// Initialize creates the workspace directories and writes a starter configuration
+	Initialize(ctx context.Context, opts domain.InitOptions) error
+
+	// ConfigPath returns the configuration file path used when none is given
+	ConfigPath() string
+}
+
+// LinkRegistry records dependency links between worktrees
+type LinkRegistry interface {
+	// AddLink records that the worktree depends on the given branch
+	AddLink(worktreePath, dependencyBranch string) error
+
+	// GetLinks returns the branches the worktree depends on
+	GetLinks(worktreePath string) ([]string, error)
+
+	// CleanStaleLinks removes links to branches without a worktree in the project and
+	// returns them as "<branch> -> <dependency>"
+	CleanStaleLinks(ctx context.Context, projectPath string) ([]string, error)
+}
+
+// HookCopier copies git hook scripts between projects
+type HookCopier interface {
+	// Copy copies the executable hooks of the source project's hooks directory (only the named
+	// ones when hooks is non-empty) to the destination's. Existing hooks are skipped unless
+	// overwrite is set; per-hook problems are reported in the result, not as an error.
+	Copy(srcProjectPath, dstProjectPath string, hooks []string, overwrite bool) (*domain.CopyHooksResult, error)
+}
+
+// WorktreeArchiver writes a worktree's files as an archive
+type WorktreeArchiver interface {
+	// Archive writes the files under worktreePath, without .git, as a deterministic .tar.gz
+	// to w, with the manifest as domain.ExportManifestName at its root. Symlinks are stored
+	// as links, not followed.
+	Archive(w io.Writer, worktreePath string, manifest *domain.ExportManifest) (*domain.ExportResult, error)
+}
+
+// NetworkChecker checks connectivity before git remote operations
+type NetworkChecker interface {
+	// IsReachable dials host ("host" or "host:port", default port 443). An unreachable
+	// host returns false with a *domain.NetworkUnreachableError.
+	IsReachable(host string) (bool, error)
+}
+
+// TerminalDetector reports what the terminal twiggit runs in supports, so output can adapt
+type TerminalDetector interface {
+	// IsTerminal reports whether the file descriptor is a terminal
+	IsTerminal(fd uintptr) bool
+
+	// SupportsColor reports whether ANSI styling is wanted, from NO_COLOR, TERM and
+	// COLORTERM; it is false in CI
+	SupportsColor() bool
+
+	// GetSize returns the width and height of the terminal on stdout
+	GetSize() (width, height int, err error)
+
+	// IsCI reports whether twiggit runs in continuous integration (CI, GITHUB_ACTIONS,
+	// TRAVIS or CIRCLECI set), where color, spinners and prompts are off by default
+	IsCI() bool
+}
+
+// TokenStore persists API tokens for hosting providers, one per host
+type TokenStore interface {
+	// Save stores the token, replacing any token already stored for its host
+	Save(token domain.StoredToken) error
+
+	// Get returns the token stored for host, or nil when there is none
+	Get(host string) (*domain.StoredToken, error)
+
+	// List returns every stored token, ordered by host
+	List() ([]domain.StoredToken, error)
+
+	// Remove deletes the token stored for host
+	Remove(host string) error
+}
+
+// TokenValidator checks API tokens against their hosting provider
+type TokenValidator interface {
+	// Validate calls the host's /user endpoint and returns the username the token authenticates as
+	Validate(ctx context.Context, host, token string) (string, error)
+}
+
+// CIWatcher polls a hosting provider for the CI status of a commit
+type CIWatcher interface {
+	// Watch sends the commit's CI status now and after every interval until it is final
+	// (success, failure or error) or ctx ends, then closes the channel
+	Watch(ctx context.Context, owner, repo, sha string, interval time.Duration) (<-chan domain.CIStatus, error)
+}
+
+// PullRequestFinder looks up the pull request of a branch on a hosting provider
+type PullRequestFinder interface {
+	// FindPullRequest returns the branch's open pull request, or its merged one when none
+	// is open; nil when the branch has neither
+	FindPullRequest(ctx context.Context, owner, repo, branch string) (*domain.OpenPR, error)
+}
+
+// EphemeralRegistry records worktrees to delete when a shell session exits
+type EphemeralRegistry interface {
+	// Register records the worktree for cleanup when the session ends
+	Register(worktreePath, sessionID string) error
+
+	// GetRegistered returns the worktrees registered for the session
+	GetRegistered(sessionID string) ([]string, error)
+
+	// Unregister removes the worktree from the session's registrations
+	Unregister(worktreePath, sessionID string) error
+
+	// ListSessions returns every session with registered worktrees, ordered by session ID
+	ListSessions() ([]domain.EphemeralSession, error)
+}
+
 // HookRunRequest contains the context needed to execute hooks
 type HookRunRequest struct {
 	HookType       domain.HookType
@@ -40,12 +184,92 @@ type HookRunRequest struct {
 	SourceBranch   string
 	MainRepoPath   string
 	ConfigFilePath string
+	ChangedFile    string   // Exported as TWIGGIT_CHANGED_FILE (post-change hooks)
+	ExtraCommands  []string // Run after the post-create commands of ConfigFilePath, e.g. create --preset hooks
+}
+
+// ProcessManager tracks background processes started on behalf of worktrees
+type ProcessManager interface {
+	// Start launches a detached shell command in the worktree and records its PID
+	Start(worktreePath, command string, env []string) (*domain.ManagedProcess, error)
+
+	// List returns running managed processes; records of exited processes are pruned
+	List() ([]domain.ManagedProcess, error)
+
+	// Kill sends SIGTERM (then SIGKILL after a grace period) to the worktree's processes
+	Kill(ctx context.Context, worktreePath string) ([]domain.ManagedProcess, error)
+}
+
+// CommandRunner runs user-supplied shell commands, e.g. for worktrees foreach
+type CommandRunner interface {
+	// Run runs command through the platform shell in dir and waits for it; a non-zero exit is
+	// reported in the result, not as an error
+	Run(ctx context.Context, dir, command string) (*domain.CommandRunResult, error)
+}
+
+// EnvInheritor copies variables from the current environment into a worktree
+type EnvInheritor interface {
+	// Inherit appends the named variables to the worktree's .env file; unset variables are skipped
+	Inherit(vars []string, worktreePath string) error
+}
+
+// EditorLauncher opens files in an external editor
+type EditorLauncher interface {
+	// Open runs the editor command in dir with files as arguments and waits for it to exit
+	Open(ctx context.Context, dir, editor string, files []string) error
 }
 
 // HookRunner defines the interface for executing post-create hooks
 type HookRunner interface {
 	// Run executes hooks of the specified type with the given request context
 	Run(ctx context.Context, req *HookRunRequest) (*domain.HookResult, error)
+
+	// LoadConfig reads the hooks of a .twiggit.toml file; a missing file or one without
+	// hooks returns nil, a malformed file an error
+	LoadConfig(configFilePath string) (*domain.HookConfig, error)
+}
+
+// ProjectSettingsStore reads and edits the project-wide settings of a repository's .twiggit.toml
+type ProjectSettingsStore interface {
+	// Load reads the settings of the .twiggit.toml in repoPath; a missing file gives empty settings
+	Load(repoPath string) (*domain.ProjectSettings, error)
+
+	// SetDefaultWorktree writes default_worktree, keeping the comments and hooks of the file;
+	// an empty branch removes the key
+	SetDefaultWorktree(repoPath, branch string) error
+}
+
+// NavigationHistoryStore persists the navigation history across shell sessions
+type NavigationHistoryStore interface {
+	// Load returns the stored paths, newest first; a missing store gives no paths
+	Load() ([]string, error)
+
+	// Save replaces the stored paths
+	Save(paths []string) error
+}
+
+// CompletionCache keeps shell completion candidates briefly, so repeated TABs do not rescan the workspace
+type CompletionCache interface {
+	// Load returns the cached values; false when there are none or they are older than the TTL
+	Load() ([]string, bool)
+
+	// Save replaces the cached values and restarts the TTL
+	Save(values []string) error
+}
+
+// ChangeWatcher runs a hook whenever files in a worktree change
+type ChangeWatcher interface {
+	// Watch blocks until ctx ends, running req's hook through hookRunner in req.WorktreePath after
+	// each burst of changes and passing every run to report. It returns nil when ctx ends.
+	Watch(ctx context.Context, req *HookRunRequest, hookRunner HookRunner, report func(domain.HookRun)) error
+}
+
+// WorktreeWatcher reports worktrees appearing in and disappearing from a worktrees directory
+type WorktreeWatcher interface {
+	// WatchWorktrees watches the <project>/<worktree> directories of workspacePath and sends an
+	// event for each worktree created or removed after the call. It blocks until ctx ends and
+	// closes events when it returns; it returns nil when ctx ends.
+	WatchWorktrees(ctx context.Context, workspacePath string, events chan<- domain.WatchEvent) error
 }
 
 // GoGitClient defines go-git operations (deterministic routing - no CLI fallback)
@@ -72,8 +296,29 @@ type GoGitClient interface {
 	// ListRemotes lists all remotes in repository
 	ListRemotes(ctx context.Context, repoPath string) ([]domain.RemoteInfo, error)
 
+	// GetRemoteBranches lists remote-tracking branches; Name is the branch and Remote is "<remote>/<branch>"
+	GetRemoteBranches(ctx context.Context, repoPath string) ([]domain.BranchInfo, error)
+
 	// GetCommitInfo returns information about a specific commit
 	GetCommitInfo(ctx context.Context, repoPath, commitHash string) (*domain.CommitInfo, error)
+
+	// ResolveRevision returns the full commit hash a revision (SHA, tag, branch, HEAD~n) points at
+	ResolveRevision(ctx context.Context, repoPath, revision string) (string, error)
+
+	// CopyBranchConfig copies [branch "<src>"] config settings to dstBranch (no-op if none)
+	CopyBranchConfig(ctx context.Context, repoPath, srcBranch, dstBranch string) error
+
+	// FetchRemote fetches remoteName, streaming progress to progress (nil discards it);
+	// a missing remote is domain.ErrGitCommand, an up-to-date remote is not an error
+	FetchRemote(ctx context.Context, repoPath, remoteName string, progress io.Writer) error
+
+	// Clone clones remoteURL into path and checks out its default branch; progress may be nil
+	Clone(ctx context.Context, remoteURL, path string, progress io.Writer) error
+
+	// CherryPick commits the changes of each commit on top of HEAD of the worktree at repoPath,
+	// keeping author and message. Files also changed since the commit's parent stop it with a
+	// *domain.CherryPickConflictError (errors.Is domain.ErrConflict); earlier commits stay applied.
+	CherryPick(ctx context.Context, repoPath string, commits []string) error
 }
 
 // CLIClient defines CLI operations for worktree management ONLY
@@ -82,20 +327,158 @@ type CLIClient interface {
 	// CreateWorktree creates new worktree using git CLI (idempotent)
 	CreateWorktree(ctx context.Context, repoPath, branchName, sourceBranch string, worktreePath string) error
 
+	// InitBareWorktree registers a detached worktree without checking out any files
+	InitBareWorktree(ctx context.Context, repoPath, targetPath string) error
+
 	// DeleteWorktree removes worktree using git CLI (idempotent, no-op if already deleted)
 	DeleteWorktree(ctx context.Context, repoPath, worktreePath string, force bool) error
 
 	// ListWorktrees lists all worktrees using git CLI (idempotent)
 	ListWorktrees(ctx context.Context, repoPath string) ([]domain.WorktreeInfo, error)
 
+	// GetWorktreeList returns the records of git worktree list --porcelain, including
+	// worktrees created outside twiggit (idempotent)
+	GetWorktreeList(ctx context.Context, repoPath string) ([]domain.GitWorktreeEntry, error)
+
 	// PruneWorktrees removes stale worktree references
 	PruneWorktrees(ctx context.Context, repoPath string) error
 
+	// LockWorktree locks a worktree (git worktree lock) so prune, move and remove leave it alone;
+	// an empty reason locks without one
+	LockWorktree(ctx context.Context, repoPath, worktreePath, reason string) error
+
+	// UnlockWorktree removes the lock of a worktree (git worktree unlock)
+	UnlockWorktree(ctx context.Context, repoPath, worktreePath string) error
+
+	// RepairWorktrees reconnects moved worktrees with the repository at repoPath
+	RepairWorktrees(ctx context.Context, repoPath string, worktreePaths []string) error
+
+	// MoveWorktree moves a linked worktree to newPath, updating the repository's links (git worktree move)
+	MoveWorktree(ctx context.Context, repoPath, worktreePath, newPath string) error
+
+	// RenameBranch renames a branch with its config and reflog (git branch -m); worktrees
+	// that have it checked out follow the new name
+	RenameBranch(ctx context.Context, repoPath, oldName, newName string) error
+
 	// IsBranchMerged checks if a branch is merged into the current branch
 	IsBranchMerged(ctx context.Context, repoPath, branchName string) (bool, error)
 
 	// DeleteBranch deletes a branch using git CLI (handles worktree-referenced branches)
 	DeleteBranch(ctx context.Context, repoPath, branchName string) error
+
+	// DeleteRemoteBranch deletes a branch on a remote (git push <remote> --delete); wraps
+	// domain.ErrRemoteBranchNotFound when the remote has no such branch
+	DeleteRemoteBranch(ctx context.Context, repoPath, remote, branchName string) error
+
+	// GetStashList lists the stash entries of the repository, newest first (stash@{0})
+	GetStashList(ctx context.Context, repoPath string) ([]domain.StashEntry, error)
+
+	// ApplyStash applies stash@{stashIndex} of srcRepoPath with its index to dstWorktreePath;
+	// conflicts leave the worktree unmerged and wrap domain.ErrStashConflict
+	ApplyStash(ctx context.Context, srcRepoPath, dstWorktreePath string, stashIndex int) error
+
+	// DropStash removes stash@{stashIndex}
+	DropStash(ctx context.Context, repoPath string, stashIndex int) error
+
+	// CreateStash stashes the uncommitted changes of worktreePath, untracked files included,
+	// as stash@{0} with message (git's "WIP on <branch>" subject when empty); nothing to stash is an error
+	CreateStash(ctx context.Context, worktreePath, message string) error
+
+	// CheckoutBranch checks out branchName in worktreePath; a non-empty startPoint creates
+	// the branch there first (git checkout -b)
+	CheckoutBranch(ctx context.Context, worktreePath, branchName, startPoint string) error
+
+	// FetchTagsOnly fetches the tags of remote without the branches they are not on
+	// (git fetch <remote> refs/tags/*:refs/tags/*)
+	FetchTagsOnly(ctx context.Context, repoPath, remote string) error
+
+	// FetchBranch fetches one branch of remote, updating its remote-tracking branch
+	// (git fetch <remote> <branch>)
+	FetchBranch(ctx context.Context, repoPath, remote, branch string) error
+
+	// FetchAllRemotes fetches the branches and tags of every remote (git fetch --all)
+	FetchAllRemotes(ctx context.Context, repoPath string) error
+
+	// Pull pulls the upstream of the worktree's branch (git pull --rebase, or --no-rebase to
+	// merge) and returns how many commits HEAD moved by; a conflicting pull is aborted and
+	// wraps domain.ErrConflict
+	Pull(ctx context.Context, worktreePath string, rebase bool) (int, error)
+
+	// BranchExists reports whether a local branch exists (git show-ref --verify)
+	BranchExists(ctx context.Context, repoPath, branchName string) (bool, error)
+
+	// ResolveRevision resolves a revision to the full hash of the commit it points at
+	// (git rev-parse --verify <rev>^{commit})
+	ResolveRevision(ctx context.Context, repoPath, revision string) (string, error)
+
+	// GetRepositoryStatus reads the branch, HEAD commit, changed files and upstream
+	// ahead/behind counts of a worktree (git status --porcelain=v2 --branch)
+	GetRepositoryStatus(ctx context.Context, repoPath string) (domain.RepositoryStatus, error)
+
+	// Fsck checks the connectivity and validity of the repository's objects
+	// (git fsck --no-dangling); a non-zero exit is an error carrying git's output
+	Fsck(ctx context.Context, repoPath string) error
+
+	// PruneTags removes local tags deleted on the default remote (git fetch --prune --prune-tags)
+	PruneTags(ctx context.Context, repoPath string) error
+
+	// LogBetween lists commits reachable from toRef but not from fromRef
+	LogBetween(ctx context.Context, repoPath, fromRef, toRef string) ([]domain.CommitInfo, error)
+
+	// GetCommitHistory lists the most recent limit commits reachable from HEAD, newest first
+	GetCommitHistory(ctx context.Context, repoPath string, limit int) ([]domain.CommitInfo, error)
+
+	// GetLastActivity returns the HEAD commit of the worktree: its author, date and subject
+	GetLastActivity(ctx context.Context, worktreePath string) (*domain.CommitInfo, error)
+
+	// GetCommitStat summarizes the files and lines a commit changed (git show --stat)
+	GetCommitStat(ctx context.Context, repoPath, commitHash string) (*domain.StatusSnapshot, error)
+
+	// GetMergeBase returns the best common ancestor of two refs ("" when they share no history)
+	GetMergeBase(ctx context.Context, repoPath, branch, ref string) (string, error)
+
+	// GetUpstreamBranch returns the tracking branch of branch, e.g. origin/feature ("" when it tracks none)
+	GetUpstreamBranch(ctx context.Context, repoPath, branch string) (string, error)
+
+	// GetBranchRelationship counts the commits of branch missing from base and the other way around
+	GetBranchRelationship(ctx context.Context, repoPath, branch, base string) (*domain.BranchRelationship, error)
+
+	// CompareWorktrees computes diff statistics between two branches
+	CompareWorktrees(ctx context.Context, repoPath, branch1, branch2 string) (*domain.WorktreeComparison, error)
+
+	// GetConflictingFiles lists unmerged paths in the worktree (git status --porcelain)
+	GetConflictingFiles(ctx context.Context, worktreePath string) ([]domain.ConflictFile, error)
+
+	// GetObjectStats reports object counts and sizes (git count-objects -v)
+	GetObjectStats(ctx context.Context, repoPath string) (*domain.ObjectStats, error)
+
+	// GarbageCollect runs git gc in the repository
+	GarbageCollect(ctx context.Context, repoPath string) error
+
+	// SetConfig sets a config value scoped to the worktree (git config --worktree)
+	SetConfig(ctx context.Context, worktreePath, key, value string) error
+
+	// GetGlobalConfig reads a value from the global git config; unset keys return ""
+	GetGlobalConfig(ctx context.Context, key string) (string, error)
+
+	// GetConfig reads a value from the git config visible in the repository; unset keys return ""
+	GetConfig(ctx context.Context, repoPath, key string) (string, error)
+
+	// GetHooksDir returns the directory git runs hooks from: core.hooksPath when set,
+	// otherwise the hooks directory of the main repository (shared by its worktrees)
+	GetHooksDir(ctx context.Context, repoPath string) (string, error)
+
+	// ValidateHooks reports hooks that are not executable or whose interpreter is missing
+	ValidateHooks(ctx context.Context, repoPath string) []domain.HookIssue
+
+	// GetAllLocalConfig reads the repository and worktree-scoped config of a worktree (git config --local/--worktree --list --null)
+	GetAllLocalConfig(ctx context.Context, worktreePath string) (map[string]string, error)
+
+	// GetBranchDescription reads branch.<branch>.description; branches without one return ""
+	GetBranchDescription(ctx context.Context, repoPath, branch string) (string, error)
+
+	// SetBranchDescription writes branch.<branch>.description; an empty description removes it
+	SetBranchDescription(ctx context.Context, repoPath, branch, description string) error
 }
 
 // GitClient provides unified git operations with deterministic routing
@@ -130,6 +513,10 @@ type ContextService interface {
 	// DetectContextFromPath detects context from a file system path
 	DetectContextFromPath(path string) (*domain.Context, error)
 
+	// GetContextForPath detects context from an explicit path without touching the process's
+	// working directory; commands taking a path argument use it instead of changing directory
+	GetContextForPath(ctx context.Context, path string) (*domain.Context, error)
+
 	// ResolveIdentifier resolves an identifier to a resolution result
 	ResolveIdentifier(identifier string) (*domain.ResolutionResult, error)
 
@@ -157,6 +544,24 @@ type WorktreeService interface {
 	// GetWorktreeStatus retrieves the status of a specific worktree
 	GetWorktreeStatus(ctx context.Context, worktreePath string) (*domain.WorktreeStatus, error)
 
+	// GetWorktreeDetails looks up the tracking branch, stash count and last commit of a worktree
+	// concurrently, within a few seconds; lookups that fail or time out leave their error in the details
+	GetWorktreeDetails(ctx context.Context, worktree *domain.WorktreeInfo) *domain.WorktreeDetails
+
+	// GetProjectSummary counts dirty and conflicted worktrees of the project at projectPath
+	GetProjectSummary(ctx context.Context, projectPath string) (*domain.ProjectStatusSummary, error)
+
+	// GetWorktreeStats aggregates the status, upstream tracking and HEAD commit times of the
+	// linked worktrees of the project at projectPath, reading them concurrently
+	GetWorktreeStats(ctx context.Context, projectPath string) (*domain.WorktreeStats, error)
+
+	// ListRemoteCandidates lists remote branches without a local worktree for the projects of req
+	ListRemoteCandidates(ctx context.Context, req *domain.ListWorktreesRequest) ([]*domain.RemoteWorktreeCandidate, error)
+
+	// DiscoverWorktreesWithFilter returns the status of the project's worktrees kept by filter,
+	// fetching status only for worktrees the filter keeps
+	DiscoverWorktreesWithFilter(ctx context.Context, projectPath string, filter domain.WorktreeFilter) ([]*domain.WorktreeStatus, error)
+
 	// ValidateWorktree validates that a worktree is properly configured
 	ValidateWorktree(ctx context.Context, worktreePath string) error
 
@@ -171,6 +576,98 @@ type WorktreeService interface {
 
 	// GetWorktreeByPath retrieves worktree info by its path
 	GetWorktreeByPath(ctx context.Context, projectPath, worktreePath string) (*domain.WorktreeInfo, error)
+
+	// LogBetween lists commits reachable from toRef but not from fromRef
+	LogBetween(ctx context.Context, repoPath, fromRef, toRef string) ([]domain.CommitInfo, error)
+
+	// GetWorktreeTimeline returns the worktree's last limit commits with the changes each made
+	GetWorktreeTimeline(ctx context.Context, worktreePath string, limit int) (*domain.WorktreeTimeline, error)
+
+	// CompareBranches summarizes file and commit differences between two branches
+	CompareBranches(ctx context.Context, repoPath, base, target string) (*domain.WorktreeComparison, error)
+
+	// CopyBranchConfig copies branch-specific git config from srcBranch to dstBranch
+	CopyBranchConfig(ctx context.Context, repoPath, srcBranch, dstBranch string) error
+
+	// VerifyWorktrees compares git's worktree list with the worktrees found in the project's worktrees directory
+	VerifyWorktrees(ctx context.Context, project *domain.ProjectInfo) (*domain.WorktreeVerification, error)
+
+	// GetWorktreeHealth checks that the worktree's directory and git metadata are intact and that it
+	// has no unresolved conflicts. Problems are returned as issues; the error is for invalid input.
+	GetWorktreeHealth(ctx context.Context, worktreePath string) (*domain.WorktreeHealth, error)
+
+	// ExportGitConfig reads the local git config of every non-bare worktree of the project
+	ExportGitConfig(ctx context.Context, project *domain.ProjectInfo) ([]*domain.WorktreeGitConfig, error)
+
+	// GetConflictingFiles lists files with merge conflicts in the worktree
+	GetConflictingFiles(ctx context.Context, worktreePath string) ([]domain.ConflictFile, error)
+
+	// EnableCommitSigning turns on GPG commit signing in the worktree's own git config
+	EnableCommitSigning(ctx context.Context, worktreePath string) error
+
+	// ValidateHooks returns the repository's hooks directory and the hooks git cannot run
+	ValidateHooks(ctx context.Context, repoPath string) (string, []domain.HookIssue, error)
+
+	// DeleteRemoteBranch deletes the branch on the remote; wraps domain.ErrRemoteBranchNotFound
+	// when the remote has no such branch
+	DeleteRemoteBranch(ctx context.Context, repoPath, remote, branch string) error
+
+	// FetchTagsOnly refreshes the tags of remote without fetching branches
+	FetchTagsOnly(ctx context.Context, repoPath, remote string) error
+
+	// FetchBranch refreshes <remote>/<branch> without touching the local branch
+	FetchBranch(ctx context.Context, repoPath, remote, branch string) error
+
+	// FetchAllRemotes refreshes the remote-tracking branches of every remote; linked
+	// worktrees share them with the main repository
+	FetchAllRemotes(ctx context.Context, repoPath string) error
+
+	// SyncWorktree pulls the upstream of the worktree's branch (rebasing unless rebase is false);
+	// worktrees with uncommitted changes or a detached HEAD are skipped. The outcome and any error are in the result.
+	SyncWorktree(ctx context.Context, worktree *domain.WorktreeInfo, rebase bool) *domain.SyncResult
+
+	// CheckRepositoryIntegrity runs git fsck on the repository; corruption is returned as an error
+	CheckRepositoryIntegrity(ctx context.Context, repoPath string) error
+
+	// PruneTags removes local tags that were deleted on the remote
+	PruneTags(ctx context.Context, repoPath string) error
+
+	// RenameWorktree renames the branch of a linked worktree and moves the worktree to the
+	// path of the new branch; uncommitted changes are refused unless req.Force is set
+	RenameWorktree(ctx context.Context, req *domain.RenameWorktreeRequest) (*domain.RenameWorktreeResult, error)
+
+	// CloneAndCreate clones req.RemoteURL (http(s) or ssh) into the projects directory, then
+	// creates the first worktree on req.BranchName from the clone's default branch
+	CloneAndCreate(ctx context.Context, req *domain.CloneCreateRequest) (*domain.CloneCreateResult, error)
+
+	// ListStashes lists the stash entries of a worktree, newest first; all worktrees of a
+	// project share one stash
+	ListStashes(ctx context.Context, worktreePath string) ([]domain.StashEntry, error)
+
+	// PushStash stashes the uncommitted changes of a worktree, untracked files included
+	PushStash(ctx context.Context, worktreePath, message string) error
+
+	// PopStash applies stash@{index} to a worktree and drops it. On conflicts the entry is
+	// kept, like git stash pop, and the conflicting files are returned.
+	PopStash(ctx context.Context, worktreePath string, index int) ([]domain.ConflictFile, error)
+
+	// DropStash removes stash@{index}
+	DropStash(ctx context.Context, worktreePath string, index int) error
+
+	// LockWorktree locks the worktree with an optional reason
+	LockWorktree(ctx context.Context, repoPath, worktreePath, reason string) error
+
+	// UnlockWorktree unlocks the worktree
+	UnlockWorktree(ctx context.Context, repoPath, worktreePath string) error
+
+	// SetMergeStrategy configures how git pull integrates changes into branch, in the worktree's own git config
+	SetMergeStrategy(ctx context.Context, worktreePath, branch string, strategy domain.MergeStrategy) error
+
+	// GetBranchDescription returns the branch description ("" when unset)
+	GetBranchDescription(ctx context.Context, repoPath, branch string) (string, error)
+
+	// SetBranchDescription sets the branch description; an empty description removes it
+	SetBranchDescription(ctx context.Context, repoPath, branch, description string) error
 }
 
 // ProjectService provides project discovery and management operations
@@ -189,6 +686,21 @@ type ProjectService interface {
 
 	// GetProjectInfo retrieves detailed information about a project
 	GetProjectInfo(ctx context.Context, projectPath string) (*domain.ProjectInfo, error)
+
+	// FindProjectByWorktreePath determines which project owns the given worktree path
+	FindProjectByWorktreePath(ctx context.Context, worktreePath string) (*domain.ProjectInfo, error)
+
+	// ForgetWorktreeOwner drops the cached owner of a worktree that has been removed
+	ForgetWorktreeOwner(worktreePath string)
+
+	// AnalyzeObjectStats collects object statistics per project, ordered by descending footprint
+	AnalyzeObjectStats(ctx context.Context, projects []*domain.ProjectSummary) []domain.ProjectObjectStats
+
+	// GarbageCollect runs git gc in the project's repository
+	GarbageCollect(ctx context.Context, project *domain.ProjectSummary) error
+
+	// RenameProject renames a project's repository and worktree directories, keeping its worktrees usable
+	RenameProject(ctx context.Context, oldName, newName string) error
 }
 
 // NavigationService provides path resolution and navigation operations
@@ -201,6 +713,18 @@ type NavigationService interface {
 
 	// GetNavigationSuggestions provides completion suggestions for navigation
 	GetNavigationSuggestions(ctx context.Context, context *domain.Context, partial string) ([]*domain.ResolutionSuggestion, error)
+
+	// NavigateToProject resolves a project to the worktree of its default_worktree branch, or to
+	// the project root when none is set; a default whose worktree is gone falls back to the root
+	// with ResolutionResult.Warning set
+	NavigateToProject(ctx context.Context, projectName string) (*domain.ResolutionResult, error)
+
+	// RecordVisit adds a worktree path navigated to at the front of the history, dropping the
+	// oldest entry beyond navigation.history_size; revisiting the newest entry changes nothing
+	RecordVisit(path string) error
+
+	// GetHistory returns the visited worktree paths, newest first
+	GetHistory() []string
 }
 
 // ShellService provides shell integration and wrapper management operations
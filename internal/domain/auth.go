@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// Supported hosts for API tokens
+const (
+	AuthHostGitHub = "github.com"
+	AuthHostGitLab = "gitlab.com"
+)
+
+// maskedTokenVisible is how many characters MaskToken keeps at each end
+const maskedTokenVisible = 4
+
+// authHostAliases maps the names accepted by twiggit auth to their hosts
+var authHostAliases = map[string]string{
+	"github":       AuthHostGitHub,
+	AuthHostGitHub: AuthHostGitHub,
+	"gitlab":       AuthHostGitLab,
+	AuthHostGitLab: AuthHostGitLab,
+}
+
+// StoredToken is an API token saved for a hosting provider
+type StoredToken struct {
+	Host     string
+	Username string // Identity the token authenticated as when it was added
+	Token    string
+	AddedAt  time.Time
+}
+
+// NormalizeAuthHost resolves "github"/"gitlab" or their hostnames to the host tokens are stored under
+func NormalizeAuthHost(name string) (string, error) {
+	host, ok := authHostAliases[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return "", NewValidationError("NormalizeAuthHost", "host", name, "unsupported host; use github or gitlab")
+	}
+	return host, nil
+}
+
+// MaskToken hides all but the first and last few characters of a token.
+// Tokens too short to keep both ends are masked completely.
+func MaskToken(token string) string {
+	if len(token) <= 3*maskedTokenVisible {
+		return strings.Repeat("*", len(token))
+	}
+	return token[:maskedTokenVisible] + "..." + token[len(token)-maskedTokenVisible:]
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"path"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// jiraKeyPattern matches an issue key such as PROJ-123 at the start of a branch name segment.
+// Matching is case-insensitive since --auto-name slugs are lowercase.
+var jiraKeyPattern = regexp.MustCompile(`^(?i)([a-z][a-z0-9]*-[0-9]+)(?:[^a-z0-9]|$)`)
+
+// BranchDescriptionData holds the values available to branch_description_template
+type BranchDescriptionData struct {
+	Branch  string
+	Project string
+	JiraKey string // Uppercased issue key leading the branch name, "" when there is none
+}
+
+// NewBranchDescriptionData builds template data for a branch of a project
+func NewBranchDescriptionData(project, branch string) BranchDescriptionData {
+	return BranchDescriptionData{
+		Branch:  branch,
+		Project: project,
+		JiraKey: ExtractJiraKey(branch),
+	}
+}
+
+// ExtractJiraKey returns the issue key that starts the last segment of the branch name,
+// so "feature/proj-123-login" gives "PROJ-123". Returns "" when the segment does not start with one.
+func ExtractJiraKey(branch string) string {
+	match := jiraKeyPattern.FindStringSubmatch(path.Base(branch))
+	if match == nil {
+		return ""
+	}
+	return strings.ToUpper(match[1])
+}
+
+// ParseBranchDescriptionTemplate parses a branch_description_template value
+func ParseBranchDescriptionTemplate(text string) (*template.Template, error) {
+	return template.New("branch_description_template").Option("missingkey=error").Parse(text)
+}
+
+// RenderBranchDescription executes the template with data; surrounding whitespace is trimmed
+func RenderBranchDescription(text string, data BranchDescriptionData) (string, error) {
+	tmpl, err := ParseBranchDescriptionTemplate(text)
+	if err != nil {
+		return "", NewValidationError("RenderBranchDescription", "branch_description_template", text, "invalid template: "+err.Error())
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", NewValidationError("RenderBranchDescription", "branch_description_template", text, "template failed: "+err.Error())
+	}
+	return strings.TrimSpace(b.String()), nil
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractJiraKey(t *testing.T) {
+	testCases := []struct {
+		branch   string
+		expected string
+	}{
+		{branch: "PROJ-123", expected: "PROJ-123"},
+		{branch: "proj-123-fix-login", expected: "PROJ-123"},
+		{branch: "feature/ABC2-7_oauth", expected: "ABC2-7"},
+		{branch: "fix-login-page", expected: ""},
+		{branch: "release/1.2.3", expected: ""},
+		{branch: "proj-12a", expected: ""},
+		{branch: "", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.branch, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ExtractJiraKey(tc.branch))
+		})
+	}
+}
+
+func TestRenderBranchDescription(t *testing.T) {
+	data := NewBranchDescriptionData("shop", "feature/proj-42-checkout")
+
+	description, err := RenderBranchDescription("{{.JiraKey}}: {{.Branch}} in {{.Project}}\n", data)
+	require.NoError(t, err)
+	assert.Equal(t, "PROJ-42: feature/proj-42-checkout in shop", description)
+
+	description, err = RenderBranchDescription("{{with .JiraKey}}{{.}} {{end}}{{.Branch}}", NewBranchDescriptionData("shop", "spike"))
+	require.NoError(t, err)
+	assert.Equal(t, "spike", description)
+
+	_, err = RenderBranchDescription("{{.Branch", data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid template")
+
+	_, err = RenderBranchDescription("{{.Ticket}}", data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "template failed")
+}
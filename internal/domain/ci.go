@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"net/url"
+	"strings"
+)
+
+// CI states reported by CIWatcher, matching GitHub's combined commit status
+const (
+	CIStatePending = "pending"
+	CIStateSuccess = "success"
+	CIStateFailure = "failure"
+	CIStateError   = "error"
+)
+
+// CIStatus is the CI state of a commit
+type CIStatus struct {
+	State       string // One of the CIState* values
+	Description string // Provider summary, e.g. "2 of 5 checks passed" or the pipeline status
+	URL         string // Link to the CI run, "" when unknown
+}
+
+// Done reports whether the state is final (success, failure or error)
+func (s CIStatus) Done() bool {
+	return s.State == CIStateSuccess || s.State == CIStateFailure || s.State == CIStateError
+}
+
+// ParseRemoteRepository splits a remote URL into its host and repository path, so
+// "git@github.com:acme/api.git" gives ("github.com", "acme", "api"). owner keeps nested
+// GitLab groups ("group/subgroup"). ok is false for local paths and URLs without an owner.
+func ParseRemoteRepository(remoteURL string) (host, owner, repo string, ok bool) {
+	var repoPath string
+	if strings.Contains(remoteURL, "://") {
+		u, err := url.Parse(remoteURL)
+		if err != nil || u.Hostname() == "" {
+			return "", "", "", false
+		}
+		host, repoPath = u.Hostname(), u.Path
+	} else {
+		// scp-like syntax; a colon after a slash means a local path
+		colon := strings.Index(remoteURL, ":")
+		if colon <= 0 || strings.Contains(remoteURL[:colon], "/") {
+			return "", "", "", false
+		}
+		host, repoPath = remoteURL[:colon], remoteURL[colon+1:]
+		if at := strings.LastIndex(host, "@"); at >= 0 {
+			host = host[at+1:]
+		}
+	}
+
+	repoPath = strings.TrimSuffix(strings.Trim(repoPath, "/"), ".git")
+	slash := strings.LastIndex(repoPath, "/")
+	if host == "" || slash <= 0 || slash == len(repoPath)-1 {
+		return "", "", "", false
+	}
+	return host, repoPath[:slash], repoPath[slash+1:], true
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCIStatus_Done(t *testing.T) {
+	assert.False(t, CIStatus{State: CIStatePending}.Done())
+	assert.True(t, CIStatus{State: CIStateSuccess}.Done())
+	assert.True(t, CIStatus{State: CIStateFailure}.Done())
+	assert.True(t, CIStatus{State: CIStateError}.Done())
+}
+
+func TestParseRemoteRepository(t *testing.T) {
+	testCases := []struct {
+		url   string
+		host  string
+		owner string
+		repo  string
+		ok    bool
+	}{
+		{url: "git@github.com:acme/api.git", host: "github.com", owner: "acme", repo: "api", ok: true},
+		{url: "https://github.com/acme/api", host: "github.com", owner: "acme", repo: "api", ok: true},
+		{url: "ssh://git@gitlab.com:2222/group/sub/tool.git", host: "gitlab.com", owner: "group/sub", repo: "tool", ok: true},
+		{url: "https://gitlab.com/acme/api.git/", host: "gitlab.com", owner: "acme", repo: "api", ok: true},
+		{url: "https://github.com/api"},
+		{url: "/srv/git/api.git"},
+		{url: "./relative/api"},
+		{url: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			host, owner, repo, ok := ParseRemoteRepository(tc.url)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.host, host)
+			assert.Equal(t, tc.owner, owner)
+			assert.Equal(t, tc.repo, repo)
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package domain
 
 import (
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"time"
 )
 
@@ -25,6 +28,21 @@ type GitConfig struct {
 
 	// Enable caching for git operations
 	CacheEnabled bool `toml:"cache_enabled" koanf:"cache_enabled"`
+
+	// Sign commits in newly created worktrees (same as create --gpg-sign)
+	GPGSignCommits bool `toml:"gpg_sign_commits" koanf:"gpg_sign_commits"`
+
+	// Signing key for new worktrees; falls back to the global user.signingkey
+	GPGSigningKey string `toml:"gpg_signing_key" koanf:"gpg_signing_key"`
+
+	// Check out an existing local branch in create even when --source or a preset asked for another source (same as create --use-local-branch)
+	UseLocalBranchIfExists bool `toml:"use_local_branch_if_exists" koanf:"use_local_branch_if_exists"`
+
+	// Skip the connectivity check before remote operations (same as --no-network-check)
+	SkipNetworkCheck bool `toml:"skip_network_check" koanf:"skip_network_check"`
+
+	// How git pull integrates changes in new worktrees: merge, squash or rebase (create --squash-on-merge forces squash)
+	DefaultMergeStrategy string `toml:"default_merge_strategy" koanf:"default_merge_strategy"`
 }
 
 // ServiceConfig holds service-specific configuration
@@ -40,7 +58,8 @@ type ValidationConfig struct {
 	StrictBranchNames    bool     `toml:"strict_branch_names" koanf:"strict_branch_names"`
 	RequireCleanWorktree bool     `toml:"require_clean_worktree" koanf:"require_clean_worktree"`
 	AllowForceDelete     bool     `toml:"allow_force_delete" koanf:"allow_force_delete"`
-	ProtectedBranches    []string `toml:"protected_branches" koanf:"protected_branches"`
+	ProtectedBranches    []string `toml:"protected_branches" koanf:"protected_branches"` // Exact names or filepath.Match globs such as release/*
+	MaxDeleteDefault     int      `toml:"max_delete_default" koanf:"max_delete_default"` // Prune candidate limit (0 = no limit)
 }
 
 // NavigationConfig holds navigation-specific configuration
@@ -48,6 +67,7 @@ type NavigationConfig struct {
 	EnableSuggestions bool `toml:"enable_suggestions" koanf:"enable_suggestions"`
 	MaxSuggestions    int  `toml:"max_suggestions" koanf:"max_suggestions"`
 	FuzzyMatching     bool `toml:"fuzzy_matching" koanf:"fuzzy_matching"`
+	HistorySize       int  `toml:"history_size" koanf:"history_size"` // Visited worktrees kept for history and back (0 = no history)
 }
 
 // ShellWrapperConfig represents shell wrapper specific configuration
@@ -95,6 +115,18 @@ type ShellConfig struct {
 	HookTimeout int `toml:"hook_timeout" koanf:"hook_timeout"`
 }
 
+// ThemeConfig represents terminal output styling configuration
+type ThemeConfig struct {
+	// Worktrees active within this many days are shown as recent (list --color-by-age)
+	AgeColorYoungDays int `toml:"age_color_young_days" koanf:"age_color_young_days"`
+
+	// Worktrees inactive for more than this many days are shown as old
+	AgeColorOldDays int `toml:"age_color_old_days" koanf:"age_color_old_days"`
+
+	// Worktrees inactive for more than this many days are shown dimmed
+	AgeColorStaleDays int `toml:"age_color_stale_days" koanf:"age_color_stale_days"`
+}
+
 // Config represents the complete application configuration
 type Config struct {
 	// Directory paths
@@ -104,6 +136,19 @@ type Config struct {
 	// Default principal branch
 	DefaultSourceBranch string `toml:"default_source_branch" koanf:"default_source_branch"`
 
+	// Go template for the description of branches created without --set-description
+	// ({{.Branch}}, {{.Project}}, {{.JiraKey}}); empty leaves new branches undescribed
+	BranchDescriptionTemplate string `toml:"branch_description_template" koanf:"branch_description_template"`
+
+	// Change into new worktrees after create when run through the shell wrapper (create --no-cd skips it)
+	CdOnCreate bool `toml:"cd_on_create" koanf:"cd_on_create"`
+
+	// Order of list output when --sort is not given: branch, project, path or age; empty keeps git's order
+	DefaultSort string `toml:"default_sort" koanf:"default_sort"`
+
+	// Refuse to create worktrees starting this many commits behind DefaultSourceBranch; 0 disables the check
+	MaxBehindCommits int `toml:"max_behind_commits" koanf:"max_behind_commits"`
+
 	// Context detection settings
 	ContextDetection ContextDetectionConfig `toml:"context_detection" koanf:"context_detection"`
 
@@ -124,6 +169,12 @@ type Config struct {
 
 	// Completion settings
 	Completion CompletionConfig `toml:"completion" koanf:"completion"`
+
+	// Output styling settings
+	Theme ThemeConfig `toml:"theme" koanf:"theme"`
+
+	// Settings of create --preset, keyed by preset name
+	Presets map[string]PresetConfig `toml:"presets" koanf:"presets"`
 }
 
 // DefaultConfig returns the default configuration values
@@ -142,14 +193,16 @@ func DefaultConfig() *Config {
 		ProjectsDirectory:   filepath.Join(home, "Projects"),
 		WorktreesDirectory:  filepath.Join(home, "Worktrees"),
 		DefaultSourceBranch: "main",
+		CdOnCreate:          true,
 		ContextDetection: ContextDetectionConfig{
 			CacheTTL:            "5m",
 			GitOperationTimeout: "30s",
 			EnableGitValidation: true,
 		},
 		Git: GitConfig{
-			CLITimeout:   30,
-			CacheEnabled: true,
+			CLITimeout:           30,
+			CacheEnabled:         true,
+			DefaultMergeStrategy: string(MergeStrategyMerge),
 		},
 		Services: ServiceConfig{
 			CacheEnabled:  true,
@@ -167,6 +220,7 @@ func DefaultConfig() *Config {
 			EnableSuggestions: true,
 			MaxSuggestions:    10,
 			FuzzyMatching:     false,
+			HistorySize:       10,
 		},
 		Shell: ShellConfig{
 			Enabled:     true,
@@ -185,6 +239,11 @@ func DefaultConfig() *Config {
 			ExcludeBranches: []string{},
 			ExcludeProjects: []string{},
 		},
+		Theme: ThemeConfig{
+			AgeColorYoungDays: 1,
+			AgeColorOldDays:   7,
+			AgeColorStaleDays: 30,
+		},
 	}
 }
 
@@ -207,6 +266,52 @@ func (c *Config) Validate() error {
 		validationErrors = append(validationErrors, "default_source_branch cannot be empty")
 	}
 
+	if _, err := ParseBranchDescriptionTemplate(c.BranchDescriptionTemplate); err != nil {
+		validationErrors = append(validationErrors, "branch_description_template is not a valid template: "+err.Error())
+	}
+
+	if _, err := ParseMergeStrategy(c.Git.DefaultMergeStrategy); err != nil {
+		validationErrors = append(validationErrors, "git.default_merge_strategy must be merge, squash or rebase")
+	}
+
+	if _, err := ParseSortMode(c.DefaultSort); err != nil {
+		validationErrors = append(validationErrors, "default_sort must be branch, project, path or age")
+	}
+
+	if c.MaxBehindCommits < 0 {
+		validationErrors = append(validationErrors, "max_behind_commits cannot be negative")
+	}
+
+	for _, pattern := range c.Validation.ProtectedBranches {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			validationErrors = append(validationErrors, "validation.protected_branches entry "+strconv.Quote(pattern)+" is not a valid glob pattern")
+		}
+	}
+
+	if c.Validation.MaxDeleteDefault < 0 {
+		validationErrors = append(validationErrors, "validation.max_delete_default cannot be negative")
+	}
+
+	if c.Navigation.HistorySize < 0 {
+		validationErrors = append(validationErrors, "navigation.history_size cannot be negative")
+	}
+
+	if c.Theme.AgeColorYoungDays < 0 ||
+		c.Theme.AgeColorYoungDays > c.Theme.AgeColorOldDays ||
+		c.Theme.AgeColorOldDays > c.Theme.AgeColorStaleDays {
+		validationErrors = append(validationErrors, "theme age colors must satisfy 0 <= age_color_young_days <= age_color_old_days <= age_color_stale_days")
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(c.Presets)) {
+		preset := c.Presets[name]
+		if _, err := ParsePresetNamingTemplate(name, preset.NamingTemplate); err != nil {
+			validationErrors = append(validationErrors, "presets."+name+".naming_template is not a valid template: "+err.Error())
+		}
+		if preset.SourceBranch != "" && ValidateBranchName(preset.SourceBranch).IsError() {
+			validationErrors = append(validationErrors, "presets."+name+".source_branch is not a valid branch name")
+		}
+	}
+
 	if len(validationErrors) > 0 {
 		return NewValidationError("Config.Validate", "validation", "", "config validation failed").
 			WithSuggestions(validationErrors)
@@ -214,3 +319,14 @@ func (c *Config) Validate() error {
 
 	return nil
 }
+
+// IsProtectedBranch reports whether branch matches any validation.protected_branches
+// entry, each interpreted as a filepath.Match glob (plain names match exactly)
+func (c *Config) IsProtectedBranch(branch string) bool {
+	for _, pattern := range c.Validation.ProtectedBranches {
+		if matched, err := filepath.Match(pattern, branch); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
@@ -15,6 +15,31 @@ func TestDefaultConfig(t *testing.T) {
 	assert.NotEmpty(t, config.WorktreesDirectory)
 	assert.Equal(t, "main", config.DefaultSourceBranch)
 	assert.Equal(t, []string{"main", "master", "develop", "staging", "production"}, config.Validation.ProtectedBranches)
+	assert.Equal(t, string(MergeStrategyMerge), config.Git.DefaultMergeStrategy)
+	assert.Equal(t, ThemeConfig{AgeColorYoungDays: 1, AgeColorOldDays: 7, AgeColorStaleDays: 30}, config.Theme)
+}
+
+func TestConfig_IsProtectedBranch(t *testing.T) {
+	config := DefaultConfig()
+	config.Validation.ProtectedBranches = []string{"main", "release/*", "hotfix-?"}
+
+	tests := []struct {
+		branch   string
+		expected bool
+	}{
+		{branch: "main", expected: true},
+		{branch: "release/1.0", expected: true},
+		{branch: "release/1.0/rc", expected: false},
+		{branch: "hotfix-1", expected: true},
+		{branch: "hotfix-12", expected: false},
+		{branch: "feature", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.branch, func(t *testing.T) {
+			assert.Equal(t, tt.expected, config.IsProtectedBranch(tt.branch))
+		})
+	}
 }
 
 func TestValidate(t *testing.T) {
@@ -29,6 +54,82 @@ func TestValidate(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("negative max delete default", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Validation.MaxDeleteDefault = -1
+
+		err := config.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "validation.max_delete_default cannot be negative")
+	})
+
+	t.Run("negative history size", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Navigation.HistorySize = -1
+
+		err := config.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "navigation.history_size cannot be negative")
+	})
+
+	t.Run("malformed protected branch pattern", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Validation.ProtectedBranches = []string{"main", "release/[0-9"}
+
+		err := config.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `validation.protected_branches entry "release/[0-9" is not a valid glob pattern`)
+	})
+
+	t.Run("negative max behind commits", func(t *testing.T) {
+		config := DefaultConfig()
+		config.MaxBehindCommits = -1
+
+		err := config.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "max_behind_commits cannot be negative")
+	})
+
+	t.Run("invalid presets", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Presets = map[string]PresetConfig{
+			"hotfix": {NamingTemplate: "{{.Name"},
+			"spike":  {SourceBranch: "not a branch"},
+		}
+
+		err := config.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "presets.hotfix.naming_template is not a valid template")
+		assert.Contains(t, err.Error(), "presets.spike.source_branch is not a valid branch name")
+	})
+
+	t.Run("unsupported default merge strategy", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Git.DefaultMergeStrategy = "octopus"
+
+		err := config.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "git.default_merge_strategy must be merge, squash or rebase")
+	})
+
+	t.Run("theme age thresholds out of order", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Theme.AgeColorOldDays = 60
+
+		err := config.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "age_color_young_days <= age_color_old_days <= age_color_stale_days")
+	})
+
+	t.Run("invalid branch description template", func(t *testing.T) {
+		config := DefaultConfig()
+		config.BranchDescriptionTemplate = "{{.JiraKey"
+
+		err := config.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "branch_description_template is not a valid template")
+	})
+
 	t.Run("invalid projects directory", func(t *testing.T) {
 		config := &Config{
 			ProjectsDirectory:   "relative/path",
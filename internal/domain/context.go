@@ -68,6 +68,7 @@ type ResolutionResult struct {
 	ProjectName  string
 	BranchName   string
 	Explanation  string
+	Warning      string // Set when the resolution fell back from what was configured
 }
 
 // ResolutionSuggestion represents a completion suggestion
@@ -0,0 +1,13 @@
+package domain
+
+// EphemeralSession lists the worktrees registered for cleanup when a shell session exits
+type EphemeralSession struct {
+	SessionID     string   // Shell session identifier (the shell's PID when set by the wrapper)
+	WorktreePaths []string // Worktrees to delete when the session ends
+	Active        bool     // Whether the session's shell is still running (unknown IDs count as active)
+}
+
+// IsStranded reports whether the session ended without cleaning up its worktrees
+func (s *EphemeralSession) IsStranded() bool {
+	return !s.Active && len(s.WorktreePaths) > 0
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEphemeralSession_IsStranded(t *testing.T) {
+	testCases := []struct {
+		name     string
+		session  EphemeralSession
+		expected bool
+	}{
+		{name: "ended session with worktrees", session: EphemeralSession{WorktreePaths: []string{"/wt/a"}}, expected: true},
+		{name: "active session", session: EphemeralSession{WorktreePaths: []string{"/wt/a"}, Active: true}, expected: false},
+		{name: "ended session without worktrees", session: EphemeralSession{}, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.session.IsStranded())
+		})
+	}
+}
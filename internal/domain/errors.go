@@ -208,3 +208,91 @@ func NewConfigError(path, message string, cause error) *ConfigError {
 		Cause:   cause,
 	}
 }
+
+// ErrRemoteBranchNotFound indicates the branch to delete does not exist on the remote
+var ErrRemoteBranchNotFound = errors.New("remote branch not found")
+
+// ErrGitCommand indicates a git operation was asked for something the repository does not have,
+// such as fetching from a remote that is not configured
+var ErrGitCommand = errors.New("git command failed")
+
+// ErrStashConflict indicates a stash was applied but left conflicting files
+var ErrStashConflict = errors.New("stash applied with conflicts")
+
+// ErrConflict is matched by errors.Is for a CherryPickConflictError
+var ErrConflict = errors.New("conflicting changes")
+
+// CherryPickConflictError indicates a commit could not be cherry-picked because files it
+// changes were also changed on the target branch
+type CherryPickConflictError struct {
+	Commit string
+	Paths  []string
+}
+
+func (e *CherryPickConflictError) Error() string {
+	commit := e.Commit
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+	return fmt.Sprintf("cherry-pick of %s conflicts in %s", commit, strings.Join(e.Paths, ", "))
+}
+
+// Is reports whether target is ErrConflict
+func (e *CherryPickConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// NewCherryPickConflictError creates a new cherry-pick conflict error
+func NewCherryPickConflictError(commit string, paths []string) *CherryPickConflictError {
+	return &CherryPickConflictError{Commit: commit, Paths: paths}
+}
+
+// ErrBranchTooFarBehind indicates a new worktree would start too many commits behind the main branch
+var ErrBranchTooFarBehind = errors.New("branch is too far behind the main branch")
+
+// ErrTargetNotEmpty indicates a directory reused as a worktree holds more than an unused .git
+var ErrTargetNotEmpty = errors.New("target directory is not empty")
+
+// ErrNetworkUnreachable is matched by errors.Is for a NetworkUnreachableError
+var ErrNetworkUnreachable = errors.New("network unreachable")
+
+// NetworkUnreachableError indicates a remote host could not be reached before a git remote operation
+type NetworkUnreachableError struct {
+	Host  string
+	Cause error
+}
+
+func (e *NetworkUnreachableError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("cannot reach %s: %v", e.Host, e.Cause)
+	}
+	return fmt.Sprintf("cannot reach %s: %v", e.Host, ErrNetworkUnreachable)
+}
+
+func (e *NetworkUnreachableError) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports whether target is ErrNetworkUnreachable
+func (e *NetworkUnreachableError) Is(target error) bool {
+	return target == ErrNetworkUnreachable
+}
+
+// NewNetworkUnreachableError creates a new network unreachable error
+func NewNetworkUnreachableError(host string, cause error) *NetworkUnreachableError {
+	return &NetworkUnreachableError{Host: host, Cause: cause}
+}
+
+// AlreadyInitializedError indicates that a configuration file already exists
+type AlreadyInitializedError struct {
+	ConfigPath string
+}
+
+func (e *AlreadyInitializedError) Error() string {
+	return "twiggit is already initialized: configuration exists at " + e.ConfigPath
+}
+
+// NewAlreadyInitializedError creates a new already-initialized error
+func NewAlreadyInitializedError(configPath string) *AlreadyInitializedError {
+	return &AlreadyInitializedError{ConfigPath: configPath}
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -292,3 +293,13 @@ func TestGitWorktreeError_IsNotFound(t *testing.T) {
 		})
 	}
 }
+
+func TestNetworkUnreachableError(t *testing.T) {
+	cause := fmt.Errorf("dial tcp: i/o timeout")
+	err := fmt.Errorf("fetch origin: %w", NewNetworkUnreachableError("github.com:443", cause))
+
+	assert.ErrorIs(t, err, ErrNetworkUnreachable)
+	assert.ErrorIs(t, err, cause)
+	assert.Equal(t, "fetch origin: cannot reach github.com:443: dial tcp: i/o timeout", err.Error())
+	assert.Equal(t, "cannot reach github.com:443: network unreachable", NewNetworkUnreachableError("github.com:443", nil).Error())
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// nonPortableGitConfigSections describe the repository itself rather than user preferences,
+// so they are left out of exported snippets
+var nonPortableGitConfigSections = []string{"core", "extensions", "remote", "branch", "submodule", "includeif"}
+
+// WorktreeGitConfig holds the local git config of one worktree
+type WorktreeGitConfig struct {
+	Path   string
+	Branch string
+	GitDir string // Git directory matched by includeIf "gitdir:" (<repo>/.git/worktrees/<name> for linked worktrees)
+	Config map[string]string
+}
+
+// IsPortableGitConfigKey reports whether a config key can be applied to another worktree through ~/.gitconfig
+func IsPortableGitConfigKey(key string) bool {
+	section, _, _ := strings.Cut(strings.ToLower(key), ".")
+	return !slices.Contains(nonPortableGitConfigSections, section)
+}
+
+// RenderGitConfigSnippet renders an [includeIf "gitdir:..."] section per worktree pointing at a file
+// under includeDir, followed by that file's contents as comments. Worktrees without portable keys are skipped.
+func RenderGitConfigSnippet(projectName, includeDir string, configs []*WorktreeGitConfig) string {
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "# Generated by twiggit worktrees export-gitconfig for %s.\n", projectName)
+	_, _ = fmt.Fprintln(&b, "# Save each commented block to the file named by the path above it,")
+	_, _ = fmt.Fprintln(&b, "# then add the includeIf sections to ~/.gitconfig.")
+
+	rendered := 0
+	for _, wt := range configs {
+		if wt == nil {
+			continue
+		}
+		body := renderGitConfigSections(wt.Config)
+		if body == "" {
+			continue
+		}
+		rendered++
+
+		name := wt.Branch
+		if name == "" {
+			name = filepath.Base(wt.Path)
+		}
+		slug := Slugify(name)
+		if slug == "" {
+			slug = "worktree"
+		}
+
+		_, _ = fmt.Fprintf(&b, "\n# %s (%s)\n", name, wt.Path)
+		_, _ = fmt.Fprintf(&b, "[includeIf \"gitdir:%s\"]\n", escapeGitConfigString(wt.GitDir))
+		_, _ = fmt.Fprintf(&b, "\tpath = %s\n", escapeGitConfigValue(includeDir+"/"+slug+".gitconfig"))
+		for _, line := range strings.Split(strings.TrimSuffix(body, "\n"), "\n") {
+			_, _ = fmt.Fprintf(&b, "# %s\n", line)
+		}
+	}
+
+	if rendered == 0 {
+		_, _ = fmt.Fprintln(&b, "\n# No worktree has portable local config.")
+	}
+	return b.String()
+}
+
+// renderGitConfigSections formats the portable keys of config as gitconfig sections in sorted order
+func renderGitConfigSections(config map[string]string) string {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		if IsPortableGitConfigKey(key) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	var b strings.Builder
+	currentHeader := ""
+	for _, key := range keys {
+		firstDot := strings.Index(key, ".")
+		lastDot := strings.LastIndex(key, ".")
+		if firstDot <= 0 || lastDot == len(key)-1 {
+			continue
+		}
+
+		header := "[" + key[:firstDot] + "]"
+		if lastDot > firstDot {
+			header = fmt.Sprintf("[%s \"%s\"]", key[:firstDot], escapeGitConfigString(key[firstDot+1:lastDot]))
+		}
+		if header != currentHeader {
+			_, _ = fmt.Fprintln(&b, header)
+			currentHeader = header
+		}
+		_, _ = fmt.Fprintf(&b, "\t%s = %s\n", key[lastDot+1:], escapeGitConfigValue(config[key]))
+	}
+	return b.String()
+}
+
+// escapeGitConfigString escapes backslashes, quotes and control characters for use inside double quotes
+func escapeGitConfigString(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`).Replace(s)
+}
+
+// escapeGitConfigValue quotes a value when git would otherwise strip or misread part of it
+func escapeGitConfigValue(value string) string {
+	escaped := escapeGitConfigString(value)
+	if escaped != value || strings.ContainsAny(value, "#;") || strings.TrimSpace(value) != value {
+		return `"` + escaped + `"`
+	}
+	return value
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPortableGitConfigKey(t *testing.T) {
+	testCases := []struct {
+		key      string
+		expected bool
+	}{
+		{key: "user.signingkey", expected: true},
+		{key: "merge.conflictStyle", expected: true},
+		{key: "core.bare", expected: false},
+		{key: "remote.origin.url", expected: false},
+		{key: "branch.main.merge", expected: false},
+		{key: "extensions.worktreeconfig", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.key, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsPortableGitConfigKey(tc.key))
+		})
+	}
+}
+
+func TestRenderGitConfigSnippet(t *testing.T) {
+	t.Run("renders includeIf sections with commented contents", func(t *testing.T) {
+		configs := []*WorktreeGitConfig{
+			{
+				Path:   "/wt/proj/feature-x",
+				Branch: "feature/x",
+				GitDir: "/repos/proj/.git/worktrees/feature-x",
+				Config: map[string]string{
+					"core.bare":               "false",
+					"user.name":               "Jane Doe",
+					"user.signingkey":         "ABC123",
+					"alias.lg":                "log --format=%h=%s",
+					"merge.tool":              "vimdiff",
+					"url.git@host:.insteadof": "https://host/",
+					"commit.template":         "line one\nline two",
+				},
+			},
+			{
+				Path:   "/repos/proj",
+				Branch: "main",
+				GitDir: "/repos/proj/.git",
+				Config: map[string]string{"core.bare": "false"},
+			},
+		}
+
+		snippet := RenderGitConfigSnippet("proj", "~/.config/git/twiggit/proj", configs)
+
+		assert.Contains(t, snippet, "# Generated by twiggit worktrees export-gitconfig for proj.\n")
+		assert.Contains(t, snippet, `# feature/x (/wt/proj/feature-x)
+[includeIf "gitdir:/repos/proj/.git/worktrees/feature-x"]
+	path = ~/.config/git/twiggit/proj/feature-x.gitconfig
+# [alias]
+# 	lg = log --format=%h=%s
+# [commit]
+# 	template = "line one\nline two"
+# [merge]
+# 	tool = vimdiff
+# [url "git@host:"]
+# 	insteadof = https://host/
+# [user]
+# 	name = Jane Doe
+# 	signingkey = ABC123
+`)
+		assert.NotContains(t, snippet, "bare")
+		assert.NotContains(t, snippet, "# main (")
+		assert.NotContains(t, snippet, "No worktree has portable local config")
+	})
+
+	t.Run("nothing portable", func(t *testing.T) {
+		snippet := RenderGitConfigSnippet("proj", "~/x", []*WorktreeGitConfig{
+			{Path: "/repos/proj", Branch: "main", Config: map[string]string{"core.bare": "false"}},
+		})
+
+		assert.Contains(t, snippet, "# No worktree has portable local config.")
+		assert.NotContains(t, snippet, "[includeIf")
+	})
+
+	t.Run("detached worktree is named after its directory", func(t *testing.T) {
+		snippet := RenderGitConfigSnippet("proj", "~/x", []*WorktreeGitConfig{
+			{Path: "/wt/proj/scratch", GitDir: "/g", Config: map[string]string{"user.name": " padded "}},
+		})
+
+		assert.Contains(t, snippet, "path = ~/x/scratch.gitconfig")
+		assert.Contains(t, snippet, `# 	name = " padded "`)
+	})
+}
@@ -1,6 +1,10 @@
 package domain
 
 import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -16,12 +20,94 @@ type BranchInfo struct {
 
 // WorktreeInfo represents information about a git worktree
 type WorktreeInfo struct {
-	Path       string // Absolute path to worktree
-	Branch     string // Branch name
-	Commit     string // Commit hash
-	IsBare     bool   // Whether this is a bare worktree
-	IsDetached bool   // Whether worktree is in detached HEAD state
-	Modified   bool   // Whether worktree has uncommitted changes
+	Path        string    // Absolute path to worktree
+	Branch      string    // Branch name
+	Commit      string    // Commit hash
+	IsBare      bool      // Whether this is a bare worktree
+	IsDetached  bool      // Whether worktree is in detached HEAD state
+	Modified    bool      // Whether worktree has uncommitted changes
+	LastUpdated time.Time // Time of the HEAD commit (zero when unknown)
+	Project     string    // Owning project name (set by services when known)
+	AheadCount  int       // Commits after ListWorktreesRequest.SinceCommit (set only when filtering)
+	Description string    // branch.<branch>.description (set only when requested)
+	Locked      bool      // Whether git worktree lock protects the worktree from prune, move and remove
+	LockReason  string    // Reason given when the worktree was locked ("" when none was given)
+
+	CommitAuthorName  string // Author of the HEAD commit (set with LastUpdated)
+	CommitAuthorEmail string // Author email of the HEAD commit (set with LastUpdated)
+
+	PRInfo  *OpenPR          // Pull request of the branch (set only by list --with-pr; nil when there is none)
+	Details *WorktreeDetails // Tracking, stash and last commit details (set only by list --verbose)
+}
+
+// WorktreeDetails is the extra git information list --verbose shows for a worktree. Each
+// lookup fails on its own: the fields it fills stay zero and its error is kept, wrapping
+// context.DeadlineExceeded when the lookup ran out of time.
+type WorktreeDetails struct {
+	Upstream    string // Tracking branch, e.g. origin/feature-x ("" when none)
+	AheadCount  int    // Commits not pushed to Upstream
+	BehindCount int    // Commits of Upstream not in the branch
+	StashCount  int    // Stash entries made on the branch
+	LastAuthor  string // Author of the HEAD commit
+	LastMessage string // Subject of the HEAD commit
+
+	UpstreamErr error // Failure to resolve Upstream or count against it
+	StashErr    error // Failure to list stashes
+	ActivityErr error // Failure to read the HEAD commit
+}
+
+// BranchRelationship counts the commits two refs do not share
+type BranchRelationship struct {
+	Branch      string // Compared ref
+	Base        string // Ref compared against, e.g. main
+	AheadCount  int    // Commits on Branch missing from Base
+	BehindCount int    // Commits on Base missing from Branch
+}
+
+// GitWorktreeEntry is one record of `git worktree list --porcelain`
+type GitWorktreeEntry struct {
+	Path       string // Absolute path to the worktree
+	HEAD       string // Commit checked out ("" for a bare repository)
+	Branch     string // Branch name without refs/heads/ ("" when detached or bare)
+	IsBare     bool   // The record is the bare repository itself
+	IsDetached bool   // HEAD is detached
+	Locked     bool   // Protected by git worktree lock
+	LockReason string // Reason given when locking ("" when none was given)
+}
+
+// WorktreeInfo converts the entry into the WorktreeInfo used by services
+func (e GitWorktreeEntry) WorktreeInfo() WorktreeInfo {
+	return WorktreeInfo{
+		Path:       e.Path,
+		Branch:     e.Branch,
+		Commit:     e.HEAD,
+		IsBare:     e.IsBare,
+		IsDetached: e.IsDetached,
+		Locked:     e.Locked,
+		LockReason: e.LockReason,
+	}
+}
+
+// Age returns how long ago the worktree was last updated.
+// A zero LastUpdated is treated as now, so unknown ages are never stale.
+func (w *WorktreeInfo) Age() time.Duration {
+	return w.ageAt(time.Now())
+}
+
+// IsStale reports whether the worktree has not been updated for longer than threshold
+func (w *WorktreeInfo) IsStale(threshold time.Duration) bool {
+	return w.isStaleAt(time.Now(), threshold)
+}
+
+func (w *WorktreeInfo) ageAt(now time.Time) time.Duration {
+	if w.LastUpdated.IsZero() {
+		return 0
+	}
+	return now.Sub(w.LastUpdated)
+}
+
+func (w *WorktreeInfo) isStaleAt(now time.Time, threshold time.Duration) bool {
+	return w.ageAt(now) > threshold
 }
 
 // RepositoryStatus represents the status of a git repository
@@ -44,6 +130,95 @@ type RemoteInfo struct {
 	PushURL  string // Push URL
 }
 
+// RemoteAddress returns the host:port a remote URL connects to. scp-like
+// (user@host:path) and ssh:// URLs use port 22, https 443, http 80 and git:// 9418
+// unless the URL names a port. Local paths and file:// URLs return false.
+func RemoteAddress(remoteURL string) (string, bool) {
+	if remoteURL == "" {
+		return "", false
+	}
+
+	if !strings.Contains(remoteURL, "://") {
+		// scp-like syntax; a colon after a slash means a local path
+		colon := strings.Index(remoteURL, ":")
+		if colon <= 0 || strings.Contains(remoteURL[:colon], "/") {
+			return "", false
+		}
+		host := remoteURL[:colon]
+		if at := strings.LastIndex(host, "@"); at >= 0 {
+			host = host[at+1:]
+		}
+		if host == "" {
+			return "", false
+		}
+		return net.JoinHostPort(host, "22"), true
+	}
+
+	u, err := url.Parse(remoteURL)
+	if err != nil || u.Hostname() == "" {
+		return "", false
+	}
+
+	port := u.Port()
+	if port == "" {
+		switch u.Scheme {
+		case "ssh", "git+ssh", "ssh+git":
+			port = "22"
+		case "https":
+			port = "443"
+		case "http":
+			port = "80"
+		case "git":
+			port = "9418"
+		default:
+			return "", false
+		}
+	}
+	return net.JoinHostPort(u.Hostname(), port), true
+}
+
+// ValidateCloneURL accepts http(s):// and ssh:// URLs and scp-like ssh syntax
+// (user@host:path); other schemes and local paths are a ValidationError.
+func ValidateCloneURL(remoteURL string) error {
+	if strings.TrimSpace(remoteURL) == "" {
+		return NewValidationError("CloneCreateRequest", "RemoteURL", remoteURL, "remote URL cannot be empty")
+	}
+
+	scheme := "ssh"
+	if strings.Contains(remoteURL, "://") {
+		u, err := url.Parse(remoteURL)
+		if err != nil {
+			return NewValidationError("CloneCreateRequest", "RemoteURL", remoteURL, "invalid remote URL: "+err.Error())
+		}
+		scheme = u.Scheme
+	} else if _, ok := RemoteAddress(remoteURL); !ok {
+		return NewValidationError("CloneCreateRequest", "RemoteURL", remoteURL, "local paths are not supported; use http(s) or ssh").
+			WithSuggestions([]string{"Use https://host/owner/repo.git or git@host:owner/repo.git"})
+	}
+
+	switch scheme {
+	case "http", "https", "ssh", "git+ssh", "ssh+git":
+		if _, ok := RemoteAddress(remoteURL); ok {
+			return nil
+		}
+		return NewValidationError("CloneCreateRequest", "RemoteURL", remoteURL, "remote URL has no host").
+			WithSuggestions([]string{"Use https://host/owner/repo.git or git@host:owner/repo.git"})
+	default:
+		return NewValidationError("CloneCreateRequest", "RemoteURL", remoteURL, "unsupported scheme "+scheme+"; use http(s) or ssh").
+			WithSuggestions([]string{"Use https://host/owner/repo.git or git@host:owner/repo.git"})
+	}
+}
+
+// ProjectNameFromURL returns the last path element of a remote URL without its .git suffix
+// ("https://github.com/me/proj.git" and "git@github.com:me/proj" both give "proj")
+func ProjectNameFromURL(remoteURL string) string {
+	name := strings.TrimRight(remoteURL, "/")
+	if i := strings.LastIndexAny(name, "/:"); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.TrimSuffix(name, ".git")
+}
+
 // CommitInfo represents information about a git commit
 type CommitInfo struct {
 	Hash      string    // Commit hash
@@ -64,3 +239,110 @@ type GitRepository struct {
 	Worktrees     []WorktreeInfo   // List of worktrees
 	Status        RepositoryStatus // Current status
 }
+
+// FileStat represents a single file in a diff between two refs
+type FileStat struct {
+	Path string // File path (rename notation kept as reported by git)
+	Mode string // Change kind: "modified", "created", "deleted", "renamed" or "mode changed"
+}
+
+// ObjectStats holds repository object statistics from git count-objects (sizes in bytes)
+type ObjectStats struct {
+	Count         int64 // Number of loose objects
+	Size          int64 // Disk space used by loose objects
+	InPack        int64 // Number of objects in packs
+	Packs         int64 // Number of pack files
+	SizePack      int64 // Disk space used by pack files
+	PrunePackable int64 // Loose objects that are also present in packs
+	Garbage       int64 // Files in the object directory that are not valid objects or packs
+	SizeGarbage   int64 // Disk space used by garbage files
+}
+
+// Footprint returns the packed and garbage size, used to rank repositories for garbage collection
+func (s *ObjectStats) Footprint() int64 {
+	return s.SizePack + s.SizeGarbage
+}
+
+// EstimatedSavings estimates the space git gc frees: garbage files plus the share of
+// loose objects that are already packed. Repacking gains are not included.
+func (s *ObjectStats) EstimatedSavings() int64 {
+	savings := s.SizeGarbage
+	if s.Count > 0 {
+		savings += s.Size * s.PrunePackable / s.Count
+	}
+	return savings
+}
+
+// StashEntry is one entry of git stash list
+type StashEntry struct {
+	Index   int    // n in stash@{n}
+	Message string // Stash subject, e.g. "WIP on main: abc1234 Add login"
+}
+
+// Ref returns the stash@{n} reference of the entry
+func (e StashEntry) Ref() string {
+	return StashRef(e.Index)
+}
+
+// OnBranch reports whether the entry was stashed on branch; git records it as
+// "WIP on <branch>: ..." or "On <branch>: ..." for stashes with a message
+func (e StashEntry) OnBranch(branch string) bool {
+	if branch == "" {
+		return false
+	}
+	return strings.HasPrefix(e.Message, "WIP on "+branch+":") || strings.HasPrefix(e.Message, "On "+branch+":")
+}
+
+// Branch returns the branch the entry was stashed on, "(no branch)" for a detached HEAD,
+// or "" when the message is in neither of git's forms
+func (e StashEntry) Branch() string {
+	branch, _ := e.split()
+	return branch
+}
+
+// Subject returns the message without its "WIP on <branch>: " or "On <branch>: " prefix
+func (e StashEntry) Subject() string {
+	_, subject := e.split()
+	return subject
+}
+
+// split separates the branch of the message from the rest
+func (e StashEntry) split() (string, string) {
+	rest, ok := strings.CutPrefix(e.Message, "WIP on ")
+	if !ok {
+		rest, ok = strings.CutPrefix(e.Message, "On ")
+	}
+	if !ok {
+		return "", e.Message
+	}
+	branch, subject, ok := strings.Cut(rest, ": ")
+	if !ok {
+		return "", e.Message
+	}
+	return branch, subject
+}
+
+// StashRef returns the stash@{n} reference of stash index n
+func StashRef(index int) string {
+	return fmt.Sprintf("stash@{%d}", index)
+}
+
+// ConflictFile describes an unmerged path during a merge, rebase or cherry-pick
+type ConflictFile struct {
+	Path        string // Path relative to the worktree root
+	OurStatus   string // Change on our side: "modified", "added" or "deleted"
+	TheirStatus string // Change on their side: "modified", "added" or "deleted"
+}
+
+// WorktreeComparison summarizes the differences between two branches
+type WorktreeComparison struct {
+	Base             string       // Branch the comparison starts from
+	Target           string       // Branch compared against the base
+	FilesChanged     int          // Number of files changed
+	Insertions       int          // Number of inserted lines
+	Deletions        int          // Number of deleted lines
+	ChangedFiles     []FileStat   // Per-file change details
+	HasCommonHistory bool         // Whether the branches share a merge base
+	OnlyInBase       []CommitInfo // Commits reachable from Base but not Target
+	OnlyInTarget     []CommitInfo // Commits reachable from Target but not Base
+}
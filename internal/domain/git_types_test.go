@@ -0,0 +1,206 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitWorktreeEntry_WorktreeInfo(t *testing.T) {
+	entry := GitWorktreeEntry{Path: "/wt/a", HEAD: "abc123", Branch: "a", IsDetached: false, Locked: true, LockReason: "usb disk"}
+
+	assert.Equal(t, WorktreeInfo{Path: "/wt/a", Branch: "a", Commit: "abc123", Locked: true, LockReason: "usb disk"}, entry.WorktreeInfo())
+	assert.True(t, GitWorktreeEntry{Path: "/repo.git", IsBare: true}.WorktreeInfo().IsBare)
+}
+
+func TestWorktreeInfo_Age(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		lastUpdated time.Time
+		expected    time.Duration
+	}{
+		{name: "ten days old", lastUpdated: now.Add(-240 * time.Hour), expected: 240 * time.Hour},
+		{name: "just updated", lastUpdated: now, expected: 0},
+		{name: "unknown update time", lastUpdated: time.Time{}, expected: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wt := &WorktreeInfo{LastUpdated: tc.lastUpdated}
+			assert.Equal(t, tc.expected, wt.ageAt(now))
+		})
+	}
+}
+
+func TestWorktreeInfo_IsStale(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	week := 7 * 24 * time.Hour
+
+	testCases := []struct {
+		name        string
+		lastUpdated time.Time
+		expected    bool
+	}{
+		{name: "older than threshold", lastUpdated: now.Add(-8 * 24 * time.Hour), expected: true},
+		{name: "exactly at threshold", lastUpdated: now.Add(-week), expected: false},
+		{name: "newer than threshold", lastUpdated: now.Add(-time.Hour), expected: false},
+		{name: "unknown update time is never stale", lastUpdated: time.Time{}, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			wt := &WorktreeInfo{LastUpdated: tc.lastUpdated}
+			assert.Equal(t, tc.expected, wt.isStaleAt(now, week))
+		})
+	}
+}
+
+func TestWorktreeInfo_AgeUsesCurrentTime(t *testing.T) {
+	wt := &WorktreeInfo{LastUpdated: time.Now().Add(-48 * time.Hour)}
+
+	assert.GreaterOrEqual(t, wt.Age(), 48*time.Hour)
+	assert.True(t, wt.IsStale(24*time.Hour))
+	assert.False(t, (&WorktreeInfo{}).IsStale(0))
+}
+
+func TestStashEntry_OnBranch(t *testing.T) {
+	testCases := []struct {
+		message  string
+		branch   string
+		expected bool
+	}{
+		{message: "WIP on feature/login: abc1234 Add login", branch: "feature/login", expected: true},
+		{message: "On feature/login: auto-stash before creating hotfix", branch: "feature/login", expected: true},
+		{message: "WIP on feature/login-v2: abc1234 Add login", branch: "feature/login", expected: false},
+		{message: "WIP on main: abc1234 Fix", branch: "feature/login", expected: false},
+		{message: "WIP on (no branch): abc1234 Fix", branch: "", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.message, func(t *testing.T) {
+			assert.Equal(t, tc.expected, StashEntry{Message: tc.message}.OnBranch(tc.branch))
+		})
+	}
+}
+
+func TestStashEntry_BranchAndSubject(t *testing.T) {
+	testCases := []struct {
+		message         string
+		expectedBranch  string
+		expectedSubject string
+	}{
+		{message: "WIP on feature-login: abc1234 Add login", expectedBranch: "feature-login", expectedSubject: "abc1234 Add login"},
+		{message: "On main: half-done refactor", expectedBranch: "main", expectedSubject: "half-done refactor"},
+		{message: "WIP on (no branch): abc1234 Fix", expectedBranch: "(no branch)", expectedSubject: "abc1234 Fix"},
+		{message: "autostash", expectedBranch: "", expectedSubject: "autostash"},
+		{message: "On main without a colon", expectedBranch: "", expectedSubject: "On main without a colon"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.message, func(t *testing.T) {
+			entry := StashEntry{Message: tc.message}
+			assert.Equal(t, tc.expectedBranch, entry.Branch())
+			assert.Equal(t, tc.expectedSubject, entry.Subject())
+		})
+	}
+}
+
+func TestObjectStats_FootprintAndSavings(t *testing.T) {
+	testCases := []struct {
+		name              string
+		stats             ObjectStats
+		expectedFootprint int64
+		expectedSavings   int64
+	}{
+		{
+			name:              "garbage and packable loose objects",
+			stats:             ObjectStats{Count: 10, Size: 1000, PrunePackable: 4, SizePack: 5000, SizeGarbage: 300},
+			expectedFootprint: 5300,
+			expectedSavings:   700,
+		},
+		{
+			name:              "fully packed repository",
+			stats:             ObjectStats{SizePack: 8192},
+			expectedFootprint: 8192,
+			expectedSavings:   0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedFootprint, tc.stats.Footprint())
+			assert.Equal(t, tc.expectedSavings, tc.stats.EstimatedSavings())
+		})
+	}
+}
+
+func TestRemoteAddress(t *testing.T) {
+	testCases := []struct {
+		url      string
+		expected string
+		ok       bool
+	}{
+		{url: "git@github.com:org/repo.git", expected: "github.com:22", ok: true},
+		{url: "github.com:org/repo.git", expected: "github.com:22", ok: true},
+		{url: "ssh://git@gitlab.example.com:2222/org/repo.git", expected: "gitlab.example.com:2222", ok: true},
+		{url: "ssh://git@gitlab.example.com/org/repo.git", expected: "gitlab.example.com:22", ok: true},
+		{url: "https://github.com/org/repo.git", expected: "github.com:443", ok: true},
+		{url: "http://git.local/repo.git", expected: "git.local:80", ok: true},
+		{url: "git://git.kernel.org/pub/scm/git/git.git", expected: "git.kernel.org:9418", ok: true},
+		{url: "https://[::1]:8443/repo.git", expected: "[::1]:8443", ok: true},
+		{url: "/srv/git/repo.git", ok: false},
+		{url: "./relative/repo", ok: false},
+		{url: "file:///srv/git/repo.git", ok: false},
+		{url: "", ok: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			address, ok := RemoteAddress(tc.url)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, address)
+		})
+	}
+}
+
+func TestValidateCloneURL(t *testing.T) {
+	valid := []string{
+		"https://github.com/me/proj.git",
+		"http://git.internal:8080/proj",
+		"ssh://git@github.com/me/proj.git",
+		"git@github.com:me/proj.git",
+	}
+	for _, remoteURL := range valid {
+		assert.NoError(t, ValidateCloneURL(remoteURL), remoteURL)
+	}
+
+	invalid := map[string]string{
+		"":                             "cannot be empty",
+		"file:///srv/git/proj.git":     "unsupported scheme file",
+		"git://github.com/me/proj.git": "unsupported scheme git",
+		"ftp://example.com/proj.git":   "unsupported scheme ftp",
+		"/srv/git/proj.git":            "local paths are not supported",
+		"https:///proj.git":            "no host",
+	}
+	for remoteURL, message := range invalid {
+		err := ValidateCloneURL(remoteURL)
+		require.Error(t, err, remoteURL)
+		assert.Contains(t, err.Error(), message, remoteURL)
+	}
+}
+
+func TestProjectNameFromURL(t *testing.T) {
+	for remoteURL, expected := range map[string]string{
+		"https://github.com/me/proj.git": "proj",
+		"https://github.com/me/proj/":    "proj",
+		"git@github.com:me/proj.git":     "proj",
+		"git@host:proj.git":              "proj",
+		"ssh://git@host:2222/team/api":   "api",
+	} {
+		assert.Equal(t, expected, ProjectNameFromURL(remoteURL), remoteURL)
+	}
+}
@@ -1,21 +1,28 @@
 package domain
 
+import "time"
+
 // HookType represents the type of hook being executed
 type HookType string
 
 const (
 	// HookPostCreate is the hook executed after worktree creation
 	HookPostCreate HookType = "post-create"
+
+	// HookPostChange is the hook executed by create --watch after files in the worktree change
+	HookPostChange HookType = "post-change"
 )
 
 // HookConfig represents the hooks section of .twiggit.toml
 type HookConfig struct {
 	PostCreate *HookDefinition `toml:"post-create" koanf:"post-create"`
+	PostChange *HookDefinition `toml:"post-change" koanf:"post-change"` // Background commands are ignored
 }
 
 // HookDefinition represents a single hook's configuration
 type HookDefinition struct {
-	Commands []string `toml:"commands" koanf:"commands"`
+	Commands   []string `toml:"commands" koanf:"commands"`
+	Background []string `toml:"background" koanf:"background"` // Long-running commands tracked by the process manager
 }
 
 // HookResult represents the result of hook execution
@@ -26,6 +33,47 @@ type HookResult struct {
 	Failures []HookFailure
 }
 
+// HookRun is one post-change hook run triggered by a file change
+type HookRun struct {
+	ChangedFile string        // Last file changed before the run
+	Result      *HookResult   // nil when Err is set
+	Err         error         // Hook could not be run
+	Duration    time.Duration // Time spent running the hook
+}
+
+// ExitCode returns 0 when every command succeeded, otherwise the exit code of the
+// first failed command (-1 when the hook could not be run)
+func (r HookRun) ExitCode() int {
+	if r.Err != nil || r.Result == nil {
+		return -1
+	}
+	if len(r.Result.Failures) == 0 {
+		return 0
+	}
+	return r.Result.Failures[0].ExitCode
+}
+
+// HookIssue describes a git hook that will not run as expected
+type HookIssue struct {
+	HookName string // File name in the hooks directory ("" for problems with the directory itself)
+	Issue    string
+}
+
+// CopyHooksResult reports what HookCopier.Copy did with each git hook
+type CopyHooksResult struct {
+	SrcDir  string          // Hooks directory of the source project
+	DstDir  string          // Hooks directory of the destination project
+	Copied  []string        // Hooks written to DstDir
+	Skipped []string        // Hooks kept because DstDir already has them (no overwrite)
+	Failed  []HookCopyError // Hooks that could not be copied
+}
+
+// HookCopyError describes a git hook that could not be copied
+type HookCopyError struct {
+	HookName string
+	Err      error
+}
+
 // HookFailure represents details of a failed hook command
 type HookFailure struct {
 	Command  string
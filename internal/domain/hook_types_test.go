@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHookRun_ExitCode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		run      HookRun
+		expected int
+	}{
+		{name: "success", run: HookRun{Result: &HookResult{Executed: true, Success: true}}, expected: 0},
+		{name: "first failure", run: HookRun{Result: &HookResult{Failures: []HookFailure{{ExitCode: 2}, {ExitCode: 1}}}}, expected: 2},
+		{name: "hook could not run", run: HookRun{Err: errors.New("boom")}, expected: -1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.run.ExitCode())
+		})
+	}
+}
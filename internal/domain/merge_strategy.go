@@ -0,0 +1,39 @@
+package domain
+
+// MergeStrategy selects how git pull integrates upstream changes into a worktree's branch
+type MergeStrategy string
+
+const (
+	// MergeStrategyMerge keeps git's default pull behavior
+	MergeStrategyMerge MergeStrategy = "merge"
+	// MergeStrategySquash squashes pulled changes (branch.<name>.mergeOptions = --squash)
+	MergeStrategySquash MergeStrategy = "squash"
+	// MergeStrategyRebase rebases onto pulled changes (branch.<name>.rebase = true)
+	MergeStrategyRebase MergeStrategy = "rebase"
+)
+
+// ParseMergeStrategy converts a default_merge_strategy value to a MergeStrategy; "" means merge
+func ParseMergeStrategy(value string) (MergeStrategy, error) {
+	switch strategy := MergeStrategy(value); strategy {
+	case "":
+		return MergeStrategyMerge, nil
+	case MergeStrategyMerge, MergeStrategySquash, MergeStrategyRebase:
+		return strategy, nil
+	default:
+		return MergeStrategyMerge, NewValidationError("ParseMergeStrategy", "default_merge_strategy", value, "unsupported merge strategy").
+			WithSuggestions([]string{"Use one of: merge, squash, rebase"})
+	}
+}
+
+// BranchConfig returns the git config key and value that apply the strategy to branch.
+// ok is false for MergeStrategyMerge, which needs no config.
+func (s MergeStrategy) BranchConfig(branch string) (key, value string, ok bool) {
+	switch s {
+	case MergeStrategySquash:
+		return "branch." + branch + ".mergeOptions", "--squash", true
+	case MergeStrategyRebase:
+		return "branch." + branch + ".rebase", "true", true
+	default:
+		return "", "", false
+	}
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMergeStrategy(t *testing.T) {
+	for value, expected := range map[string]MergeStrategy{
+		"":       MergeStrategyMerge,
+		"merge":  MergeStrategyMerge,
+		"squash": MergeStrategySquash,
+		"rebase": MergeStrategyRebase,
+	} {
+		strategy, err := ParseMergeStrategy(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, strategy)
+	}
+
+	_, err := ParseMergeStrategy("octopus")
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, err.Error(), "unsupported merge strategy")
+}
+
+func TestMergeStrategy_BranchConfig(t *testing.T) {
+	testCases := []struct {
+		strategy MergeStrategy
+		key      string
+		value    string
+		ok       bool
+	}{
+		{strategy: MergeStrategyMerge},
+		{strategy: MergeStrategySquash, key: "branch.feature/x.mergeOptions", value: "--squash", ok: true},
+		{strategy: MergeStrategyRebase, key: "branch.feature/x.rebase", value: "true", ok: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.strategy), func(t *testing.T) {
+			key, value, ok := tc.strategy.BranchConfig("feature/x")
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.key, key)
+			assert.Equal(t, tc.value, value)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// BuiltinPresets are the presets create --preset accepts without a [presets.<name>] table
+var BuiltinPresets = []string{"feature", "hotfix", "release", "experiment"}
+
+// PresetConfig is a [presets.<name>] table: the settings create --preset <name> applies
+type PresetConfig struct {
+	// Branch new worktrees start from; empty uses default_source_branch
+	SourceBranch string `toml:"source_branch" koanf:"source_branch"`
+
+	// Go template for the branch name ({{.Name}}, {{.Preset}}); empty gives "<preset>-{{.Name}}"
+	NamingTemplate string `toml:"naming_template" koanf:"naming_template"`
+
+	// Lock new worktrees (git worktree lock) so delete and prune leave them alone
+	ProtectedOnDelete bool `toml:"protected_on_delete" koanf:"protected_on_delete"`
+
+	// Commands run in new worktrees after the post-create hook of .twiggit.toml
+	Hooks []string `toml:"hooks" koanf:"hooks"`
+
+	// Fetch the source branch from origin and start from origin/<source_branch>
+	AutoFetch bool `toml:"auto_fetch" koanf:"auto_fetch"`
+}
+
+// PresetNameData holds the values available to naming_template
+type PresetNameData struct {
+	Name   string // Branch argument given to create
+	Preset string
+}
+
+// ResolvedPreset holds the create parameters of a preset applied to a branch argument
+type ResolvedPreset struct {
+	Preset            string
+	BranchName        string
+	SourceBranch      string
+	ProtectedOnDelete bool
+	Hooks             []string
+	AutoFetch         bool
+}
+
+// PresetResolver applies presets to the branch argument of create
+type PresetResolver struct{}
+
+// Resolve looks up presetName in cfg (falling back to the built-in presets) and computes the
+// branch name from branchArg and the preset's naming template
+func (PresetResolver) Resolve(presetName, branchArg string, cfg *Config) (*ResolvedPreset, error) {
+	preset, ok := cfg.Presets[presetName]
+	if !ok && !slices.Contains(BuiltinPresets, presetName) {
+		return nil, NewValidationError("PresetResolver.Resolve", "preset", presetName, "unknown preset").
+			WithSuggestions([]string{"Available presets: " + strings.Join(PresetNames(cfg), ", ")})
+	}
+	if branchArg == "" {
+		return nil, NewValidationError("PresetResolver.Resolve", "branch", "", "a branch name is required with a preset")
+	}
+
+	tmpl, err := ParsePresetNamingTemplate(presetName, preset.NamingTemplate)
+	if err != nil {
+		return nil, NewValidationError("PresetResolver.Resolve", "naming_template", preset.NamingTemplate, "invalid template: "+err.Error())
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, PresetNameData{Name: branchArg, Preset: presetName}); err != nil {
+		return nil, NewValidationError("PresetResolver.Resolve", "naming_template", preset.NamingTemplate, "template failed: "+err.Error())
+	}
+	branchName := strings.TrimSpace(b.String())
+	if validation := ValidateBranchName(branchName); validation.IsError() {
+		return nil, validation.Error
+	}
+
+	source := preset.SourceBranch
+	if source == "" {
+		source = cfg.DefaultSourceBranch
+	}
+
+	return &ResolvedPreset{
+		Preset:            presetName,
+		BranchName:        branchName,
+		SourceBranch:      source,
+		ProtectedOnDelete: preset.ProtectedOnDelete,
+		Hooks:             preset.Hooks,
+		AutoFetch:         preset.AutoFetch,
+	}, nil
+}
+
+// ParsePresetNamingTemplate parses the naming_template of a preset; empty gives "<preset>-{{.Name}}"
+func ParsePresetNamingTemplate(presetName, text string) (*template.Template, error) {
+	if text == "" {
+		text = presetName + "-{{.Name}}"
+	}
+	return template.New("naming_template").Option("missingkey=error").Parse(text)
+}
+
+// PresetNames returns the built-in and configured preset names, sorted
+func PresetNames(cfg *Config) []string {
+	names := slices.Clone(BuiltinPresets)
+	for name := range cfg.Presets {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
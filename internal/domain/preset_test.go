@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresetResolver_Resolve(t *testing.T) {
+	cfg := &Config{
+		DefaultSourceBranch: "main",
+		Presets: map[string]PresetConfig{
+			"hotfix":  {SourceBranch: "production", ProtectedOnDelete: true, Hooks: []string{"make deps"}, AutoFetch: true},
+			"spike":   {NamingTemplate: "{{.Preset}}-{{.Name}}-wip"},
+			"broken":  {NamingTemplate: "{{.Ticket}}"},
+			"slash":   {NamingTemplate: "{{.Preset}}/{{.Name}}"},
+			"release": {NamingTemplate: "rel-{{.Name}}"},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		preset      string
+		branchArg   string
+		expected    *ResolvedPreset
+		errContains string
+	}{
+		{
+			name:      "configured preset",
+			preset:    "hotfix",
+			branchArg: "critical-bug-fix",
+			expected: &ResolvedPreset{
+				Preset: "hotfix", BranchName: "hotfix-critical-bug-fix", SourceBranch: "production",
+				ProtectedOnDelete: true, Hooks: []string{"make deps"}, AutoFetch: true,
+			},
+		},
+		{
+			name:      "built-in preset without a table",
+			preset:    "experiment",
+			branchArg: "new-cache",
+			expected:  &ResolvedPreset{Preset: "experiment", BranchName: "experiment-new-cache", SourceBranch: "main"},
+		},
+		{
+			name:      "naming template",
+			preset:    "spike",
+			branchArg: "grpc",
+			expected:  &ResolvedPreset{Preset: "spike", BranchName: "spike-grpc-wip", SourceBranch: "main"},
+		},
+		{
+			name:      "table overrides a built-in preset",
+			preset:    "release",
+			branchArg: "1.4",
+			expected:  &ResolvedPreset{Preset: "release", BranchName: "rel-1.4", SourceBranch: "main"},
+		},
+		{name: "unknown preset", preset: "chore", branchArg: "x", errContains: "unknown preset"},
+		{name: "missing branch", preset: "feature", branchArg: "", errContains: "branch name is required"},
+		{name: "template failure", preset: "broken", branchArg: "x", errContains: "template failed"},
+		{name: "invalid branch name", preset: "slash", branchArg: "x", errContains: "branch name format is invalid"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resolved, err := PresetResolver{}.Resolve(tc.preset, tc.branchArg, cfg)
+			if tc.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, resolved)
+		})
+	}
+}
+
+func TestPresetNames(t *testing.T) {
+	cfg := &Config{Presets: map[string]PresetConfig{"spike": {}, "hotfix": {}}}
+
+	assert.Equal(t, []string{"experiment", "feature", "hotfix", "release", "spike"}, PresetNames(cfg))
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// ManagedProcess is a background process started by twiggit on behalf of a worktree
+type ManagedProcess struct {
+	PID          int       // Process ID (also the process group ID on Unix)
+	Command      string    // Shell command that was started
+	WorktreePath string    // Worktree the process belongs to
+	StartedAt    time.Time // When the process was started
+}
+
+// CommandRunResult is the outcome of running a shell command to completion
+type CommandRunResult struct {
+	ExitCode int           // Exit code of the shell (0 on success)
+	Output   string        // Combined stdout and stderr
+	Duration time.Duration // Wall-clock run time
+}
+
+// Succeeded reports whether the command exited with status 0
+func (r *CommandRunResult) Succeeded() bool {
+	return r.ExitCode == 0
+}
@@ -0,0 +1,6 @@
+package domain
+
+// ProjectSettings holds the project-wide settings at the top level of a repository's .twiggit.toml
+type ProjectSettings struct {
+	DefaultWorktree string `toml:"default_worktree" koanf:"default_worktree"` // Branch whose worktree cd <project> opens ("" = project root)
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Pull request states reported by PullRequestFinder
+const (
+	PRStateOpen   = "open"
+	PRStateDraft  = "draft"
+	PRStateMerged = "merged"
+)
+
+// PRTitleMaxLength is how many characters of a pull request title Summary keeps
+const PRTitleMaxLength = 40
+
+// OpenPR is the pull request (GitHub) or merge request (GitLab) of a branch
+type OpenPR struct {
+	Number int    // PR number, or the project-scoped MR iid on GitLab
+	Title  string // Full title
+	State  string // One of the PRState* values
+	URL    string // Web link to the pull request
+}
+
+// Summary formats the pull request as "#12 Title (open)", truncating long titles
+func (pr *OpenPR) Summary() string {
+	return fmt.Sprintf("#%d %s (%s)", pr.Number, truncateTitle(pr.Title, PRTitleMaxLength), pr.State)
+}
+
+// truncateTitle shortens title to at most limit characters, ending with "…" when cut
+func truncateTitle(title string, limit int) string {
+	title = strings.TrimSpace(title)
+	if utf8.RuneCountInString(title) <= limit {
+		return title
+	}
+	runes := []rune(title)
+	return strings.TrimSpace(string(runes[:limit-1])) + "…"
+}
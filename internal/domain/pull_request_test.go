@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenPR_Summary(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pr       OpenPR
+		expected string
+	}{
+		{name: "short title", pr: OpenPR{Number: 12, Title: "Fix login", State: PRStateOpen}, expected: "#12 Fix login (open)"},
+		{name: "exactly 40 characters", pr: OpenPR{Number: 3, Title: "0123456789012345678901234567890123456789", State: PRStateDraft}, expected: "#3 0123456789012345678901234567890123456789 (draft)"},
+		{name: "long title is truncated", pr: OpenPR{Number: 7, Title: "Rework the worktree discovery cache to support TTLs", State: PRStateMerged}, expected: "#7 Rework the worktree discovery cache to… (merged)"},
+		{name: "multibyte characters count once", pr: OpenPR{Number: 1, Title: "Ünïcödé " + "ééééééééééééééééééééééééééééééééééé", State: PRStateOpen}, expected: "#1 Ünïcödé ééééééééééééééééééééééééééééééé… (open)"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.pr.Summary())
+		})
+	}
+}
@@ -1,10 +1,17 @@
 package domain
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
 
+// ErrProjectNameConflict is the cause of a ProjectServiceError when the target project name is already in use
+var ErrProjectNameConflict = errors.New("project name already in use")
+
+// ErrProjectNotFound is the cause of a ProjectServiceError when no project has the requested name
+var ErrProjectNotFound = errors.New("project not found")
+
 // ServiceError represents a general service operation error
 type ServiceError struct {
 	Service   string // Service name (e.g., "WorktreeService", "ProjectService")
@@ -1,27 +1,85 @@
 package domain
 
+import "time"
+
 // CreateWorktreeRequest represents a request to create a new worktree
 type CreateWorktreeRequest struct {
 	ProjectName  string   // Name of the project
 	BranchName   string   // Name of the branch to create
 	SourceBranch string   // Source branch to create from
 	Context      *Context // Current context for resolution
-	Force        bool     // Force creation even if branch exists
+	Force        bool     // Force creation even if branch exists, or from a dirty FromWorktree
+
+	ExplicitSource bool   // SourceBranch was asked for (--source, a preset) rather than defaulted
+	WorktreeOnly   bool   // Register the worktree without checking out a branch or files
+	UseLocalBranch bool   // Check out an existing BranchName even though the explicit SourceBranch is then ignored
+	FromWorktree   string // Branch whose worktree HEAD commit the new branch starts at (overrides SourceBranch)
+	SourceRef      string // Commit SHA, tag or other revision the new branch starts at (overrides SourceBranch)
+	FromStash      *int   // Stash index applied to the new worktree after checkout (nil applies nothing)
+	DropStash      bool   // Drop the FromStash entry once it applied without conflicts
+	AutoStash      bool   // Stash uncommitted changes of the Context worktree before creating
+
+	CheckoutOnConflict bool // When the worktree path cannot be created, check out the branch in the clean Context worktree instead
+
+	MaxBehindCommits *int // Refuse when the start point is this many commits behind the main branch; nil uses max_behind_commits, 0 disables
+
+	WorktreePath       string   // Absolute target directory replacing {worktrees_dir}/{project}/{branch}; requires ReuseExistingPath
+	ReuseExistingPath  bool     // Create in the existing WorktreePath when it is empty or holds only an unused .git
+	PostCreateCommands []string // Run after the post-create hook of .twiggit.toml (create --preset hooks)
 }
 
 // DeleteWorktreeRequest represents a request to delete a worktree
 type DeleteWorktreeRequest struct {
 	WorktreePath string   // Path to the worktree to delete
 	Force        bool     // Force deletion even if there are uncommitted changes
+	DeleteBranch bool     // Also delete the worktree's branch once the worktree is removed
 	Context      *Context // Current context for validation
 }
 
+// RenameWorktreeRequest represents a request to rename the branch of a linked worktree
+// and move the worktree to the directory of the new branch
+type RenameWorktreeRequest struct {
+	WorktreePath string // Path to the worktree to rename
+	NewBranch    string // New branch name
+	Force        bool   // Rename even if there are uncommitted changes
+}
+
+// RenameWorktreeResult represents the result of renaming a worktree
+type RenameWorktreeResult struct {
+	OldBranch string // Branch name before the rename
+	NewBranch string // Branch name after the rename
+	OldPath   string // Worktree path before the move
+	NewPath   string // Worktree path after the move
+}
+
+// CloneCreateRequest represents a request to clone a remote repository into the projects
+// directory and create its first worktree
+type CloneCreateRequest struct {
+	RemoteURL   string // http(s) or ssh URL of the repository
+	ProjectName string // Directory name in the projects directory (default: URL basename without .git)
+	BranchName  string // New branch of the first worktree, started at the clone's default branch
+}
+
+// CloneCreateResult represents the result of cloning a repository and creating its first worktree
+type CloneCreateResult struct {
+	ProjectName   string                // Name of the cloned project
+	ProjectPath   string                // Path of the clone in the projects directory
+	DefaultBranch string                // Branch checked out by the clone, the start of the new branch
+	Created       *CreateWorktreeResult // The first worktree
+}
+
 // ListWorktreesRequest represents a request to list worktrees
 type ListWorktreesRequest struct {
 	ProjectName     string   // Name of the project (optional, uses context if empty)
 	Context         *Context // Current context for project resolution
 	IncludeMain     bool     // Include main worktree in results
 	ListAllProjects bool     // List worktrees from all discovered projects (overrides ProjectName)
+
+	IncludeLastUpdated  bool   // Populate WorktreeInfo.LastUpdated from the HEAD commit
+	SinceCommit         string // Keep only worktrees with commits after this ref, setting AheadCount
+	IncludeDescriptions bool   // Populate WorktreeInfo.Description from the branch description
+	OnlyMine            bool   // Keep only worktrees whose HEAD commit author email is the user's git email
+	BranchFilter        string // Keep only branches matching this glob (path.Match), also applied to remote candidates
 }
 
 // ResolvePathRequest represents a request to resolve a path identifier
@@ -40,6 +98,13 @@ type PruneWorktreesRequest struct {
 	DryRun           bool     // Preview only, no actual deletion
 	AllProjects      bool     // Prune across all projects
 	SpecificWorktree string   // Specific worktree to prune (project/branch format)
+
+	AdditionalProtectedPatterns []string      // Extra branch glob patterns protected for this invocation only
+	OlderThan                   time.Duration // Only prune worktrees not updated within this duration (0 disables)
+	MaxDelete                   *int          // Abort when more worktrees are candidates (nil falls back to config)
+
+	ConfirmIndividually bool                               // Ask before deleting each worktree that passed every check
+	Confirm             func(wt *PruneWorktreeResult) bool // Answers the question; required with ConfirmIndividually
 }
 
 // PruneWorktreesResult represents the result of a prune operation
@@ -65,3 +130,10 @@ type PruneWorktreeResult struct {
 	SkipReason    string // Reason for skipping (if applicable)
 	Error         error  // Error that occurred during pruning (if any)
 }
+
+// InitOptions represents the options for initializing a twiggit workspace
+type InitOptions struct {
+	WorkspaceDir string // Directory where worktrees are created
+	ProjectsDir  string // Directory containing project repositories
+	ConfigPath   string // Config file to write (defaults to the XDG config path)
+}
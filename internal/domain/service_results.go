@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"cmp"
+	"slices"
+	"strings"
 	"time"
 )
 
@@ -39,6 +42,7 @@ type WorktreeStatus struct {
 	IsClean               bool
 	HasUncommittedChanges bool
 	BranchStatus          string // "ahead", "behind", "diverged", "up-to-date"
+	ConflictFiles         []ConflictFile
 }
 
 // ProjectInfo represents comprehensive project information
@@ -54,6 +58,217 @@ type ProjectInfo struct {
 	LastModified  time.Time
 }
 
+// DefaultActiveThreshold is how recently a worktree must have been updated to count as active
+const DefaultActiveThreshold = 30 * 24 * time.Hour
+
+// ActiveWorktrees returns the worktrees updated within threshold (DefaultActiveThreshold when omitted).
+// Worktrees with an unknown LastUpdated are considered active.
+func (p *ProjectInfo) ActiveWorktrees(threshold ...time.Duration) []*WorktreeInfo {
+	limit := DefaultActiveThreshold
+	if len(threshold) > 0 {
+		limit = threshold[0]
+	}
+	return p.activeWorktreesAt(time.Now(), limit)
+}
+
+// StaleWorktrees returns the worktrees not updated for longer than threshold
+func (p *ProjectInfo) StaleWorktrees(threshold time.Duration) []*WorktreeInfo {
+	return p.staleWorktreesAt(time.Now(), threshold)
+}
+
+// LastCommitTime returns the date of the most recent branch tip commit (zero when unknown)
+func (p *ProjectInfo) LastCommitTime() time.Time {
+	var latest time.Time
+	for _, branch := range p.Branches {
+		if branch.Date.After(latest) {
+			latest = branch.Date
+		}
+	}
+	return latest
+}
+
+// HasActiveWork reports whether any active worktree has uncommitted changes
+func (p *ProjectInfo) HasActiveWork() bool {
+	return p.hasActiveWorkAt(time.Now(), DefaultActiveThreshold)
+}
+
+func (p *ProjectInfo) activeWorktreesAt(now time.Time, threshold time.Duration) []*WorktreeInfo {
+	return p.filterWorktrees(func(wt *WorktreeInfo) bool { return !wt.isStaleAt(now, threshold) })
+}
+
+func (p *ProjectInfo) staleWorktreesAt(now time.Time, threshold time.Duration) []*WorktreeInfo {
+	return p.filterWorktrees(func(wt *WorktreeInfo) bool { return wt.isStaleAt(now, threshold) })
+}
+
+func (p *ProjectInfo) hasActiveWorkAt(now time.Time, threshold time.Duration) bool {
+	for _, wt := range p.activeWorktreesAt(now, threshold) {
+		if wt.Modified {
+			return true
+		}
+	}
+	return false
+}
+
+// filterWorktrees returns a new slice of the non-nil worktrees matching keep
+func (p *ProjectInfo) filterWorktrees(keep func(*WorktreeInfo) bool) []*WorktreeInfo {
+	var matched []*WorktreeInfo
+	for _, wt := range p.Worktrees {
+		if wt != nil && keep(wt) {
+			matched = append(matched, wt)
+		}
+	}
+	return matched
+}
+
+// WorktreeVerification lists differences between git's worktree list and the
+// worktrees twiggit discovers in its worktrees directory
+type WorktreeVerification struct {
+	ProjectName    string
+	WorktreesDir   string
+	GitOnly        []string // Registered with git but not found in the worktrees directory
+	DiscoveredOnly []string // Found in the worktrees directory but not registered with git
+}
+
+// HasDiscrepancies reports whether git and twiggit disagree about any worktree
+func (v *WorktreeVerification) HasDiscrepancies() bool {
+	return len(v.GitOnly) > 0 || len(v.DiscoveredOnly) > 0
+}
+
+// HealthIssue is one problem found by a worktree health check
+type HealthIssue struct {
+	WorktreePath string
+	Issue        string
+}
+
+// WorktreeHealth is the health check result of one worktree; no issues means healthy
+type WorktreeHealth struct {
+	ProjectName  string
+	Branch       string
+	WorktreePath string
+	Issues       []HealthIssue
+}
+
+// Healthy reports whether the check found no issue
+func (h *WorktreeHealth) Healthy() bool {
+	return len(h.Issues) == 0
+}
+
+// HealthReport aggregates the health of many worktrees, in the order they were added
+type HealthReport struct {
+	HealthyCount   int
+	UnhealthyCount int
+	Issues         []HealthIssue
+	Worktrees      []*WorktreeHealth
+}
+
+// Add counts one worktree's result and collects its issues
+func (r *HealthReport) Add(health *WorktreeHealth) {
+	r.Worktrees = append(r.Worktrees, health)
+	if health.Healthy() {
+		r.HealthyCount++
+		return
+	}
+	r.UnhealthyCount++
+	r.Issues = append(r.Issues, health.Issues...)
+}
+
+// DoctorSeverity is how serious a doctor finding is
+type DoctorSeverity string
+
+const (
+	// DoctorError is a problem that breaks twiggit or git; doctor exits non-zero
+	DoctorError DoctorSeverity = "ERROR"
+
+	// DoctorWarning is a problem worth fixing that does not break anything yet
+	DoctorWarning DoctorSeverity = "WARNING"
+)
+
+// DoctorFinding is one failed doctor check
+type DoctorFinding struct {
+	Severity DoctorSeverity
+	Subject  string // What was checked: a path, "config" or "<project>"
+	Problem  string
+	Fix      string // Human-readable suggestion
+}
+
+// DoctorReport collects doctor findings in the order they were found
+type DoctorReport struct {
+	Findings []DoctorFinding
+}
+
+// Add records a finding
+func (r *DoctorReport) Add(severity DoctorSeverity, subject, problem, fix string) {
+	r.Findings = append(r.Findings, DoctorFinding{Severity: severity, Subject: subject, Problem: problem, Fix: fix})
+}
+
+// Count returns the number of findings with the given severity
+func (r *DoctorReport) Count(severity DoctorSeverity) int {
+	count := 0
+	for _, finding := range r.Findings {
+		if finding.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+// WorktreeAge is how long ago a worktree's HEAD commit was made
+type WorktreeAge struct {
+	Branch string
+	Age    time.Duration
+}
+
+// ProjectStats summarizes the worktrees of one project for worktrees stats
+type ProjectStats struct {
+	ProjectName string
+	Total       int           // Worktrees, main worktree excluded
+	Dirty       int           // Worktrees with uncommitted changes
+	Ages        []WorktreeAge // Worktrees whose HEAD commit time is known
+}
+
+// Add counts one worktree; lastUpdated is its HEAD commit time (zero when unknown)
+func (s *ProjectStats) Add(branch string, dirty bool, lastUpdated, now time.Time) {
+	s.Total++
+	if dirty {
+		s.Dirty++
+	}
+	if !lastUpdated.IsZero() {
+		s.Ages = append(s.Ages, WorktreeAge{Branch: branch, Age: max(now.Sub(lastUpdated), 0)})
+	}
+}
+
+// Oldest returns the largest worktree age, 0 when no age is known
+func (s *ProjectStats) Oldest() time.Duration {
+	var oldest time.Duration
+	for _, age := range s.Ages {
+		oldest = max(oldest, age.Age)
+	}
+	return oldest
+}
+
+// ProjectObjectStats pairs a project with its object statistics or the error that prevented analysis
+type ProjectObjectStats struct {
+	ProjectName string
+	GitRepoPath string
+	Stats       *ObjectStats
+	Err         error
+}
+
+// SortByFootprint orders analyzed projects by descending footprint; projects that failed sort last by name
+func SortByFootprint(stats []ProjectObjectStats) {
+	slices.SortStableFunc(stats, func(a, b ProjectObjectStats) int {
+		switch {
+		case a.Stats == nil && b.Stats == nil:
+			return strings.Compare(a.ProjectName, b.ProjectName)
+		case a.Stats == nil:
+			return 1
+		case b.Stats == nil:
+			return -1
+		}
+		return cmp.Compare(b.Stats.Footprint(), a.Stats.Footprint())
+	})
+}
+
 // ProjectSummary represents lightweight project information without expensive git data
 type ProjectSummary struct {
 	Name        string
@@ -61,8 +276,120 @@ type ProjectSummary struct {
 	GitRepoPath string
 }
 
+// ProjectStatusSummary aggregates worktree statuses for one project
+type ProjectStatusSummary struct {
+	ProjectPath string
+	Worktrees   int // Linked worktrees counted, including those whose status failed
+	Dirty       int // Worktrees with uncommitted changes
+	Conflicts   int // Worktrees with unmerged paths
+	Errors      int // Worktrees whose status could not be read
+}
+
+// WorktreeStats aggregates the status of the linked worktrees of one project (stats)
+type WorktreeStats struct {
+	ProjectPath  string
+	Total        int       // Linked worktrees counted, including those whose status failed
+	Clean        int       // Worktrees without uncommitted changes
+	Dirty        int       // Worktrees with uncommitted changes
+	Ahead        int       // Worktrees with commits their upstream does not have
+	Behind       int       // Worktrees missing commits of their upstream
+	Errors       int       // Worktrees whose status could not be read
+	OldestCommit time.Time // Oldest HEAD commit time; zero when none is known
+	NewestCommit time.Time // Newest HEAD commit time; zero when none is known
+}
+
+// AddCommitTime widens the oldest and newest commit times to include t; zero is ignored
+func (s *WorktreeStats) AddCommitTime(t time.Time) {
+	if t.IsZero() {
+		return
+	}
+	if s.OldestCommit.IsZero() || t.Before(s.OldestCommit) {
+		s.OldestCommit = t
+	}
+	if s.NewestCommit.IsZero() || t.After(s.NewestCommit) {
+		s.NewestCommit = t
+	}
+}
+
+// RemoteWorktreeCandidate is a remote-tracking branch without a local worktree (list --remote)
+type RemoteWorktreeCandidate struct {
+	Project    string
+	Branch     string    // Branch name without the remote prefix
+	Remote     string    // Remote-tracking branch, e.g. origin/feature-x
+	Commit     string    // Latest commit hash on the remote-tracking branch
+	Author     string    // Author of the latest commit
+	CommitTime time.Time // Date of the latest commit
+}
+
 // CreateWorktreeResult represents the result of a worktree creation operation
 type CreateWorktreeResult struct {
-	Worktree   *WorktreeInfo
-	HookResult *HookResult
+	Worktree       *WorktreeInfo
+	HookResult     *HookResult
+	StashConflicts []ConflictFile // Files left unmerged by applying FromStash; the stash is kept
+	AutoStashPath  string         // Worktree whose changes AutoStash stashed as stash@{0}; empty when it was clean
+
+	// CheckoutFallback is why the worktree could not be created when CheckoutOnConflict checked
+	// the branch out in the Context worktree instead (Worktree.Path); nil when it was created
+	CheckoutFallback error
+}
+
+// ExportManifestName is the file at the root of an export archive that records its source
+const ExportManifestName = "twiggit-manifest.json"
+
+// ExportManifest describes the worktree an export archive was made from
+type ExportManifest struct {
+	Project string `json:"project"`
+	Branch  string `json:"branch"`
+	Commit  string `json:"commit"` // HEAD commit SHA; the archive holds the working tree, changes included
+}
+
+// ExportResult reports what WorktreeArchiver.Archive wrote
+type ExportResult struct {
+	Files    int // Regular files archived
+	Symlinks int // Symlinks archived as links, not followed
+}
+
+// WatchEventType is what happened to a worktree reported by a WorktreeWatcher
+type WatchEventType string
+
+const (
+	// WatchEventCreated is a worktree that appeared in the worktrees directory
+	WatchEventCreated WatchEventType = "created"
+	// WatchEventRemoved is a worktree that disappeared from the worktrees directory
+	WatchEventRemoved WatchEventType = "removed"
+)
+
+// WatchEvent reports a worktree added to or removed from the worktrees directory
+type WatchEvent struct {
+	Type     WatchEventType
+	Project  string
+	Worktree *WorktreeInfo // Path and, when its git directory is readable, Branch
+}
+
+// SyncOutcome is what syncing a worktree with its upstream did
+type SyncOutcome string
+
+const (
+	// SyncUpdated is a worktree whose branch moved to include upstream commits
+	SyncUpdated SyncOutcome = "updated"
+	// SyncUpToDate is a worktree that already had every upstream commit
+	SyncUpToDate SyncOutcome = "up-to-date"
+	// SyncConflict is a worktree whose pull conflicted and was aborted
+	SyncConflict SyncOutcome = "conflict"
+	// SyncSkipped is a worktree left alone because it has uncommitted changes
+	SyncSkipped SyncOutcome = "skipped"
+	// SyncFailed is a worktree whose status or pull failed
+	SyncFailed SyncOutcome = "failed"
+	// SyncInterrupted is a worktree whose pull was cancelled while running and then aborted
+	SyncInterrupted SyncOutcome = "interrupted"
+	// SyncCancelled is a worktree whose pull never started because the sync was cancelled
+	SyncCancelled SyncOutcome = "cancelled"
+)
+
+// SyncResult reports how one worktree was synced
+type SyncResult struct {
+	Worktree *WorktreeInfo
+	Outcome  SyncOutcome
+	Commits  int   // Commits HEAD moved by; only set for SyncUpdated
+	Err      error // Why the worktree conflicted, failed, was interrupted or was cancelled
 }
@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectInfo_ActiveAndStaleWorktrees(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	recent := &WorktreeInfo{Branch: "recent", LastUpdated: now.Add(-24 * time.Hour)}
+	old := &WorktreeInfo{Branch: "old", LastUpdated: now.Add(-60 * 24 * time.Hour)}
+	ancient := &WorktreeInfo{Branch: "ancient", LastUpdated: now.Add(-365 * 24 * time.Hour)}
+	unknown := &WorktreeInfo{Branch: "unknown"}
+
+	testCases := []struct {
+		name           string
+		worktrees      []*WorktreeInfo
+		expectedActive []string
+		expectedStale  []string
+	}{
+		{
+			name:           "mixed activity",
+			worktrees:      []*WorktreeInfo{recent, old, unknown},
+			expectedActive: []string{"recent", "unknown"},
+			expectedStale:  []string{"old"},
+		},
+		{
+			name:           "all worktrees stale",
+			worktrees:      []*WorktreeInfo{old, ancient},
+			expectedActive: nil,
+			expectedStale:  []string{"old", "ancient"},
+		},
+		{
+			name:           "zero worktrees",
+			worktrees:      nil,
+			expectedActive: nil,
+			expectedStale:  nil,
+		},
+		{
+			name:           "zero last updated is never stale",
+			worktrees:      []*WorktreeInfo{unknown},
+			expectedActive: []string{"unknown"},
+			expectedStale:  nil,
+		},
+		{
+			name:           "nil entries are ignored",
+			worktrees:      []*WorktreeInfo{nil, recent},
+			expectedActive: []string{"recent"},
+			expectedStale:  nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			project := &ProjectInfo{Worktrees: tc.worktrees}
+			original := append([]*WorktreeInfo(nil), tc.worktrees...)
+
+			assert.Equal(t, tc.expectedActive, worktreeBranches(project.activeWorktreesAt(now, DefaultActiveThreshold)))
+			assert.Equal(t, tc.expectedStale, worktreeBranches(project.staleWorktreesAt(now, DefaultActiveThreshold)))
+			assert.Equal(t, original, project.Worktrees)
+		})
+	}
+}
+
+func TestProjectInfo_ActiveWorktreesThreshold(t *testing.T) {
+	project := &ProjectInfo{Worktrees: []*WorktreeInfo{
+		{Branch: "yesterday", LastUpdated: time.Now().Add(-24 * time.Hour)},
+		{Branch: "last-week", LastUpdated: time.Now().Add(-7 * 24 * time.Hour)},
+	}}
+
+	assert.Equal(t, []string{"yesterday", "last-week"}, worktreeBranches(project.ActiveWorktrees()))
+	assert.Equal(t, []string{"yesterday"}, worktreeBranches(project.ActiveWorktrees(48*time.Hour)))
+	assert.Equal(t, []string{"last-week"}, worktreeBranches(project.StaleWorktrees(48*time.Hour)))
+}
+
+func TestProjectInfo_HasActiveWork(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		worktrees []*WorktreeInfo
+		expected  bool
+	}{
+		{
+			name:      "active dirty worktree",
+			worktrees: []*WorktreeInfo{{LastUpdated: now.Add(-time.Hour), Modified: true}},
+			expected:  true,
+		},
+		{
+			name:      "active clean worktree",
+			worktrees: []*WorktreeInfo{{LastUpdated: now.Add(-time.Hour)}},
+			expected:  false,
+		},
+		{
+			name:      "only stale worktrees are dirty",
+			worktrees: []*WorktreeInfo{{LastUpdated: now.Add(-60 * 24 * time.Hour), Modified: true}},
+			expected:  false,
+		},
+		{
+			name:      "dirty worktree with unknown update time",
+			worktrees: []*WorktreeInfo{{Modified: true}},
+			expected:  true,
+		},
+		{
+			name:      "zero worktrees",
+			worktrees: nil,
+			expected:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			project := &ProjectInfo{Worktrees: tc.worktrees}
+			assert.Equal(t, tc.expected, project.hasActiveWorkAt(now, DefaultActiveThreshold))
+		})
+	}
+}
+
+func worktreeBranches(worktrees []*WorktreeInfo) []string {
+	var branches []string
+	for _, wt := range worktrees {
+		branches = append(branches, wt.Branch)
+	}
+	return branches
+}
+
+func TestProjectInfo_LastCommitTime(t *testing.T) {
+	older := time.Date(2026, 9, 1, 10, 0, 0, 0, time.UTC)
+	newer := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+
+	project := &ProjectInfo{Branches: []*BranchInfo{{Name: "main", Date: older}, {Name: "feature", Date: newer}, {Name: "empty"}}}
+	assert.Equal(t, newer, project.LastCommitTime())
+	assert.True(t, (&ProjectInfo{}).LastCommitTime().IsZero())
+}
+
+func TestSortByFootprint(t *testing.T) {
+	stats := []ProjectObjectStats{
+		{ProjectName: "zeta-failed", Err: assert.AnError},
+		{ProjectName: "small", Stats: &ObjectStats{SizePack: 10}},
+		{ProjectName: "alpha-failed", Err: assert.AnError},
+		{ProjectName: "large", Stats: &ObjectStats{SizePack: 10, SizeGarbage: 90}},
+	}
+
+	SortByFootprint(stats)
+
+	names := make([]string, 0, len(stats))
+	for _, s := range stats {
+		names = append(names, s.ProjectName)
+	}
+	assert.Equal(t, []string{"large", "small", "alpha-failed", "zeta-failed"}, names)
+}
+
+func TestProjectStats_Add(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	stats := &ProjectStats{ProjectName: "api"}
+	stats.Add("feature", true, now.Add(-48*time.Hour), now)
+	stats.Add("fix", false, now.Add(-time.Hour), now)
+	stats.Add("scratch", false, time.Time{}, now)
+	stats.Add("future", false, now.Add(time.Hour), now)
+
+	assert.Equal(t, 4, stats.Total)
+	assert.Equal(t, 1, stats.Dirty)
+	assert.Equal(t, []WorktreeAge{
+		{Branch: "feature", Age: 48 * time.Hour},
+		{Branch: "fix", Age: time.Hour},
+		{Branch: "future", Age: 0},
+	}, stats.Ages, "unknown commit times are left out and clock skew is clamped")
+	assert.Equal(t, 48*time.Hour, stats.Oldest())
+	assert.Zero(t, (&ProjectStats{}).Oldest())
+}
+
+func TestWorktreeStats_AddCommitTime(t *testing.T) {
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	stats := &WorktreeStats{}
+	stats.AddCommitTime(time.Time{})
+	assert.True(t, stats.OldestCommit.IsZero(), "unknown commit times are ignored")
+
+	stats.AddCommitTime(mar)
+	stats.AddCommitTime(jan)
+	stats.AddCommitTime(time.Time{})
+	assert.Equal(t, jan, stats.OldestCommit)
+	assert.Equal(t, mar, stats.NewestCommit)
+}
+
+func TestHealthReport_Add(t *testing.T) {
+	report := &HealthReport{}
+	report.Add(&WorktreeHealth{WorktreePath: "/wt/a"})
+	report.Add(&WorktreeHealth{WorktreePath: "/wt/b", Issues: []HealthIssue{
+		{WorktreePath: "/wt/b", Issue: "directory is missing"},
+	}})
+	report.Add(&WorktreeHealth{WorktreePath: "/wt/c", Issues: []HealthIssue{
+		{WorktreePath: "/wt/c", Issue: "2 file(s) with unresolved conflicts"},
+		{WorktreePath: "/wt/c", Issue: "cannot read git status"},
+	}})
+
+	assert.Equal(t, 1, report.HealthyCount)
+	assert.Equal(t, 2, report.UnhealthyCount)
+	assert.Len(t, report.Worktrees, 3)
+	assert.Equal(t, []string{"/wt/b", "/wt/c", "/wt/c"}, []string{report.Issues[0].WorktreePath, report.Issues[1].WorktreePath, report.Issues[2].WorktreePath})
+	assert.True(t, report.Worktrees[0].Healthy())
+	assert.False(t, report.Worktrees[2].Healthy())
+}
+
+func TestDoctorReport_Count(t *testing.T) {
+	report := &DoctorReport{}
+	assert.Equal(t, 0, report.Count(DoctorError))
+
+	report.Add(DoctorWarning, "/wt/proj/stale", "orphaned directory", "remove it")
+	report.Add(DoctorError, "proj", "git fsck failed", "re-clone")
+	report.Add(DoctorError, "config", "invalid TOML", "fix the file")
+
+	assert.Equal(t, 2, report.Count(DoctorError))
+	assert.Equal(t, 1, report.Count(DoctorWarning))
+	assert.Equal(t, "proj", report.Findings[1].Subject)
+}
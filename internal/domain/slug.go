@@ -0,0 +1,34 @@
+package domain
+
+import "strings"
+
+// MaxSlugLength is the maximum length of a generated slug
+const MaxSlugLength = 50
+
+// Slugify converts free-form text into a branch-safe slug.
+// The result is lowercase, runs of characters outside [a-z0-9] become a single
+// hyphen, leading/trailing hyphens are trimmed and the result is truncated to
+// MaxSlugLength characters.
+func Slugify(s string) string {
+	var b strings.Builder
+	pendingHyphen := false
+
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingHyphen = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingHyphen = true
+	}
+
+	slug := b.String()
+	if len(slug) > MaxSlugLength {
+		slug = strings.TrimRight(slug[:MaxSlugLength], "-")
+	}
+
+	return slug
+}
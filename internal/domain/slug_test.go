@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugify(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "jira key", input: "PROJ-123", expected: "proj-123"},
+		{name: "spaces and punctuation", input: "Fix: login page (SSO)!", expected: "fix-login-page-sso"},
+		{name: "collapses separator runs", input: "a  --  b__c", expected: "a-b-c"},
+		{name: "trims leading and trailing", input: "  --hello--  ", expected: "hello"},
+		{name: "dots and slashes", input: "release/1.2.3", expected: "release-1-2-3"},
+		{name: "unicode letters are replaced", input: "Café déjà vu", expected: "caf-d-j-vu"},
+		{name: "unicode only", input: "日本語", expected: ""},
+		{name: "emoji between words", input: "ship 🚀 it", expected: "ship-it"},
+		{name: "all special characters", input: "!@#$%^&*()", expected: ""},
+		{name: "empty", input: "", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Slugify(tc.input))
+		})
+	}
+}
+
+func TestSlugify_Truncation(t *testing.T) {
+	t.Run("truncates long input", func(t *testing.T) {
+		result := Slugify(strings.Repeat("abc", 40))
+		assert.Len(t, result, MaxSlugLength)
+	})
+
+	t.Run("does not end with hyphen after truncation", func(t *testing.T) {
+		input := strings.Repeat("a", MaxSlugLength-1) + " tail"
+		result := Slugify(input)
+		assert.Equal(t, strings.Repeat("a", MaxSlugLength-1), result)
+	})
+
+	t.Run("result is a valid branch name", func(t *testing.T) {
+		result := Slugify("LIN-42: Rework the very long onboarding flow for enterprise customers")
+		assert.LessOrEqual(t, len(result), MaxSlugLength)
+		assert.True(t, ValidateBranchName(result).IsSuccess())
+	})
+}
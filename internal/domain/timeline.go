@@ -0,0 +1,38 @@
+package domain
+
+import "fmt"
+
+// StatusSnapshot summarizes the changes a single commit made (git show --stat)
+type StatusSnapshot struct {
+	FilesChanged int // Number of files the commit changed
+	Insertions   int // Number of inserted lines
+	Deletions    int // Number of deleted lines
+}
+
+// Clean reports whether the commit left the tree unchanged, as empty commits and
+// merges without conflict resolutions do
+func (s StatusSnapshot) Clean() bool {
+	return s.FilesChanged == 0
+}
+
+// Summary describes the change as "N file(s), +I -D", or "no changes" for a clean commit
+func (s StatusSnapshot) Summary() string {
+	if s.Clean() {
+		return "no changes"
+	}
+	return fmt.Sprintf("%d file(s), +%d -%d", s.FilesChanged, s.Insertions, s.Deletions)
+}
+
+// TimelineEntry pairs a commit with the changes it made
+type TimelineEntry struct {
+	CommitInfo
+	StatusSnapshot
+}
+
+// WorktreeTimeline is the recent history of a worktree, newest commit first
+type WorktreeTimeline struct {
+	Path    string          // Worktree path
+	Branch  string          // Checked out branch ("" when detached)
+	Dirty   bool            // Whether the working tree has uncommitted changes now
+	Commits []TimelineEntry // Most recent commits reachable from HEAD
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusSnapshot_Summary(t *testing.T) {
+	testCases := []struct {
+		name     string
+		snapshot StatusSnapshot
+		clean    bool
+		expected string
+	}{
+		{name: "empty commit", snapshot: StatusSnapshot{}, clean: true, expected: "no changes"},
+		{name: "single file", snapshot: StatusSnapshot{FilesChanged: 1, Insertions: 3}, expected: "1 file(s), +3 -0"},
+		{name: "several files", snapshot: StatusSnapshot{FilesChanged: 4, Insertions: 12, Deletions: 7}, expected: "4 file(s), +12 -7"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.clean, tc.snapshot.Clean())
+			assert.Equal(t, tc.expected, tc.snapshot.Summary())
+		})
+	}
+}
+
+func TestTimelineEntry_EmbedsCommitAndSnapshot(t *testing.T) {
+	entry := TimelineEntry{
+		CommitInfo:     CommitInfo{ShortHash: "abc1234", Message: "Add login"},
+		StatusSnapshot: StatusSnapshot{FilesChanged: 2, Insertions: 10, Deletions: 1},
+	}
+
+	assert.Equal(t, "abc1234", entry.ShortHash)
+	assert.False(t, entry.Clean())
+	assert.Equal(t, "2 file(s), +10 -1", entry.Summary())
+}
@@ -163,6 +163,32 @@ func ValidateProjectNamePathTraversal(projectName string) Result[bool] {
 	return NewResult(true)
 }
 
+// projectNameReservedChars are path separators and characters reserved in file names on common operating systems
+const projectNameReservedChars = `/\<>:"|?*`
+
+// ValidateProjectNameCharacters checks that a project name is usable as a single directory name
+func ValidateProjectNameCharacters(projectName string) Result[bool] {
+	invalid := projectName == "." || projectName == ".." || strings.ContainsAny(projectName, projectNameReservedChars) ||
+		strings.ContainsFunc(projectName, func(r rune) bool { return r < 0x20 || r == 0x7f })
+	if invalid {
+		return NewErrorResult[bool](
+			NewValidationError("Validation", "ProjectName", projectName, "project name contains a path separator or reserved character").
+				WithSuggestions([]string{"Avoid / \\ < > : \" | ? * and control characters"}),
+		)
+	}
+	return NewResult(true)
+}
+
+// ValidateProjectDirectoryName composes the validations for naming an existing project's directory,
+// which allows any characters the file system accepts (e.g. dots)
+func ValidateProjectDirectoryName(projectName string) Result[bool] {
+	pipeline := NewValidationPipeline(
+		ValidateProjectNameNotEmpty,
+		ValidateProjectNameCharacters,
+	)
+	return pipeline.Validate(projectName)
+}
+
 // ValidateProjectName composes all project name validations
 func ValidateProjectName(projectName string) Result[bool] {
 	pipeline := NewValidationPipeline(
@@ -80,6 +80,20 @@ func TestValidateProjectName_PathTraversal(t *testing.T) {
 	}
 }
 
+func TestValidateProjectDirectoryName(t *testing.T) {
+	for _, projectName := range []string{"my-project", "my.project", "project..v2", "Project 2"} {
+		t.Run(projectName, func(t *testing.T) {
+			assert.True(t, ValidateProjectDirectoryName(projectName).IsSuccess(), "%q should be accepted", projectName)
+		})
+	}
+
+	for _, projectName := range []string{"", "  ", ".", "..", "a/b", `a\b`, "a:b", "a*b", "a?b", "a|b", "a<b", "a\x00b"} {
+		t.Run(projectName, func(t *testing.T) {
+			assert.True(t, ValidateProjectDirectoryName(projectName).IsError(), "%q should be rejected", projectName)
+		})
+	}
+}
+
 func TestValidateShellType_EmptyShell(t *testing.T) {
 	result := ValidateShellType("")
 
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+// WorktreeFilter reports whether a discovered worktree should be kept
+type WorktreeFilter func(*WorktreeInfo) bool
+
+// CombineFilters returns a filter that keeps a worktree only when every filter keeps it.
+// Nil filters are ignored; with no filters every worktree is kept.
+func CombineFilters(filters ...WorktreeFilter) WorktreeFilter {
+	return func(wt *WorktreeInfo) bool {
+		for _, filter := range filters {
+			if filter != nil && !filter(wt) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// And is CombineFilters: a worktree is kept only when every filter keeps it
+func And(filters ...WorktreeFilter) WorktreeFilter {
+	return CombineFilters(filters...)
+}
+
+// Or returns a filter that keeps a worktree when any filter keeps it.
+// Nil filters are ignored; with no filters every worktree is kept.
+func Or(filters ...WorktreeFilter) WorktreeFilter {
+	return func(wt *WorktreeInfo) bool {
+		applied := false
+		for _, filter := range filters {
+			if filter == nil {
+				continue
+			}
+			if filter(wt) {
+				return true
+			}
+			applied = true
+		}
+		return !applied
+	}
+}
+
+// FilterWorktrees returns the worktrees kept by filter, in order; a nil filter keeps all
+func FilterWorktrees(worktrees []*WorktreeInfo, filter WorktreeFilter) []*WorktreeInfo {
+	if filter == nil {
+		return worktrees
+	}
+	var kept []*WorktreeInfo
+	for _, wt := range worktrees {
+		if filter(wt) {
+			kept = append(kept, wt)
+		}
+	}
+	return kept
+}
+
+// FilterDirty keeps worktrees with uncommitted changes (Modified)
+func FilterDirty() WorktreeFilter {
+	return func(wt *WorktreeInfo) bool { return wt.Modified }
+}
+
+// FilterClean keeps worktrees without uncommitted changes (Modified)
+func FilterClean() WorktreeFilter {
+	return func(wt *WorktreeInfo) bool { return !wt.Modified }
+}
+
+// FilterByBranch keeps worktrees whose branch matches the glob (path.Match).
+// An empty pattern keeps every worktree; an invalid pattern keeps none.
+func FilterByBranch(pattern string) WorktreeFilter {
+	return func(wt *WorktreeInfo) bool {
+		if pattern == "" {
+			return true
+		}
+		matched, err := path.Match(pattern, wt.Branch)
+		return err == nil && matched
+	}
+}
+
+// FilterByAge keeps worktrees last updated more than olderThan ago and less than newerThan
+// ago. A nil bound is not checked; a zero LastUpdated counts as now (see WorktreeInfo.Age).
+func FilterByAge(olderThan, newerThan *time.Duration) WorktreeFilter {
+	return func(wt *WorktreeInfo) bool {
+		if olderThan != nil && !wt.IsStale(*olderThan) {
+			return false
+		}
+		return newerThan == nil || wt.Age() < *newerThan
+	}
+}
+
+// FilterByAuthor keeps worktrees whose HEAD commit author email matches, ignoring case
+func FilterByAuthor(email string) WorktreeFilter {
+	return func(wt *WorktreeInfo) bool { return strings.EqualFold(wt.CommitAuthorEmail, email) }
+}
+
+// FilterNotProtected keeps worktrees whose branch is not one of protectedBranches
+func FilterNotProtected(protectedBranches []string) WorktreeFilter {
+	return func(wt *WorktreeInfo) bool { return !slices.Contains(protectedBranches, wt.Branch) }
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombineFilters(t *testing.T) {
+	feature := func(wt *WorktreeInfo) bool { return strings.HasPrefix(wt.Branch, "feature/") }
+	attached := func(wt *WorktreeInfo) bool { return !wt.IsDetached }
+
+	testCases := []struct {
+		name     string
+		filters  []WorktreeFilter
+		worktree *WorktreeInfo
+		expected bool
+	}{
+		{name: "no filters keeps everything", worktree: &WorktreeInfo{Branch: "main"}, expected: true},
+		{name: "nil filter is ignored", filters: []WorktreeFilter{nil}, worktree: &WorktreeInfo{Branch: "main"}, expected: true},
+		{name: "all filters match", filters: []WorktreeFilter{feature, attached}, worktree: &WorktreeInfo{Branch: "feature/x"}, expected: true},
+		{name: "one filter rejects", filters: []WorktreeFilter{feature, attached}, worktree: &WorktreeInfo{Branch: "feature/x", IsDetached: true}, expected: false},
+		{name: "first filter rejects", filters: []WorktreeFilter{feature, nil}, worktree: &WorktreeInfo{Branch: "main"}, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, CombineFilters(tc.filters...)(tc.worktree))
+		})
+	}
+}
+
+func TestAndOr(t *testing.T) {
+	feature := FilterByBranch("feature/*")
+	dirty := FilterDirty()
+
+	testCases := []struct {
+		name     string
+		filter   WorktreeFilter
+		worktree *WorktreeInfo
+		expected bool
+	}{
+		{name: "and keeps when all keep", filter: And(feature, dirty), worktree: &WorktreeInfo{Branch: "feature/x", Modified: true}, expected: true},
+		{name: "and drops when one drops", filter: And(feature, dirty), worktree: &WorktreeInfo{Branch: "feature/x"}, expected: false},
+		{name: "or keeps when one keeps", filter: Or(feature, dirty), worktree: &WorktreeInfo{Branch: "main", Modified: true}, expected: true},
+		{name: "or drops when none keep", filter: Or(feature, dirty), worktree: &WorktreeInfo{Branch: "main"}, expected: false},
+		{name: "or without filters keeps everything", filter: Or(), worktree: &WorktreeInfo{Branch: "main"}, expected: true},
+		{name: "or ignores nil filters", filter: Or(nil, feature), worktree: &WorktreeInfo{Branch: "main"}, expected: false},
+		{name: "nested composition", filter: And(Or(feature, FilterByBranch("fix/*")), FilterClean()), worktree: &WorktreeInfo{Branch: "fix/y"}, expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.filter(tc.worktree))
+		})
+	}
+}
+
+func TestFilterConstructors(t *testing.T) {
+	hour, day := time.Hour, 24*time.Hour
+	now := time.Now()
+
+	testCases := []struct {
+		name     string
+		filter   WorktreeFilter
+		worktree *WorktreeInfo
+		expected bool
+	}{
+		{name: "dirty keeps modified", filter: FilterDirty(), worktree: &WorktreeInfo{Modified: true}, expected: true},
+		{name: "dirty drops clean", filter: FilterDirty(), worktree: &WorktreeInfo{}, expected: false},
+		{name: "clean keeps clean", filter: FilterClean(), worktree: &WorktreeInfo{}, expected: true},
+		{name: "clean drops modified", filter: FilterClean(), worktree: &WorktreeInfo{Modified: true}, expected: false},
+		{name: "branch glob matches", filter: FilterByBranch("feature/*"), worktree: &WorktreeInfo{Branch: "feature/login"}, expected: true},
+		{name: "branch glob does not match", filter: FilterByBranch("feature/*"), worktree: &WorktreeInfo{Branch: "fix/login"}, expected: false},
+		{name: "empty branch pattern keeps", filter: FilterByBranch(""), worktree: &WorktreeInfo{Branch: "main"}, expected: true},
+		{name: "invalid branch pattern drops", filter: FilterByBranch("[feature"), worktree: &WorktreeInfo{Branch: "[feature"}, expected: false},
+		{name: "older than keeps old", filter: FilterByAge(&day, nil), worktree: &WorktreeInfo{LastUpdated: now.Add(-48 * time.Hour)}, expected: true},
+		{name: "older than drops recent", filter: FilterByAge(&day, nil), worktree: &WorktreeInfo{LastUpdated: now.Add(-time.Hour)}, expected: false},
+		{name: "older than drops unknown age", filter: FilterByAge(&hour, nil), worktree: &WorktreeInfo{}, expected: false},
+		{name: "newer than keeps recent", filter: FilterByAge(nil, &day), worktree: &WorktreeInfo{LastUpdated: now.Add(-time.Hour)}, expected: true},
+		{name: "newer than drops old", filter: FilterByAge(nil, &day), worktree: &WorktreeInfo{LastUpdated: now.Add(-48 * time.Hour)}, expected: false},
+		{name: "age window", filter: FilterByAge(&hour, &day), worktree: &WorktreeInfo{LastUpdated: now.Add(-3 * time.Hour)}, expected: true},
+		{name: "no age bounds keeps", filter: FilterByAge(nil, nil), worktree: &WorktreeInfo{}, expected: true},
+		{name: "author matches ignoring case", filter: FilterByAuthor("Dev@Example.com"), worktree: &WorktreeInfo{CommitAuthorEmail: "dev@example.com"}, expected: true},
+		{name: "author differs", filter: FilterByAuthor("dev@example.com"), worktree: &WorktreeInfo{CommitAuthorEmail: "other@example.com"}, expected: false},
+		{name: "unprotected branch kept", filter: FilterNotProtected([]string{"main", "develop"}), worktree: &WorktreeInfo{Branch: "feature"}, expected: true},
+		{name: "protected branch dropped", filter: FilterNotProtected([]string{"main", "develop"}), worktree: &WorktreeInfo{Branch: "develop"}, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.filter(tc.worktree))
+		})
+	}
+}
+
+func TestFilterWorktrees(t *testing.T) {
+	worktrees := []*WorktreeInfo{{Branch: "feature/a"}, {Branch: "main"}, {Branch: "feature/b"}}
+
+	assert.Equal(t, []*WorktreeInfo{worktrees[0], worktrees[2]}, FilterWorktrees(worktrees, FilterByBranch("feature/*")))
+	assert.Equal(t, worktrees, FilterWorktrees(worktrees, nil))
+	assert.Empty(t, FilterWorktrees(worktrees, FilterByBranch("release/*")))
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// GroupByMode selects how worktrees are grouped for display
+type GroupByMode string
+
+const (
+	// GroupByNone disables grouping
+	GroupByNone GroupByMode = ""
+	// GroupByProject groups worktrees by owning project
+	GroupByProject GroupByMode = "project"
+	// GroupByStatus groups worktrees by clean/dirty working tree
+	GroupByStatus GroupByMode = "status"
+	// GroupByAge groups worktrees into age buckets based on LastUpdated
+	GroupByAge GroupByMode = "age"
+)
+
+// Age bucket labels used by GroupByAge, in display order
+const (
+	AgeBucketDay   = "< 1d"
+	AgeBucketWeek  = "1d-1w"
+	AgeBucketMonth = "1w-1m"
+	AgeBucketOlder = "> 1m"
+)
+
+// ageBuckets lists the age bucket labels in display order
+var ageBuckets = []string{AgeBucketDay, AgeBucketWeek, AgeBucketMonth, AgeBucketOlder}
+
+const (
+	day   = 24 * time.Hour
+	week  = 7 * day
+	month = 30 * day
+)
+
+// unknownProjectGroup is the group name for worktrees without a project
+const unknownProjectGroup = "(unknown)"
+
+// ParseGroupByMode converts a --group-by value to a GroupByMode
+func ParseGroupByMode(value string) (GroupByMode, error) {
+	switch mode := GroupByMode(value); mode {
+	case GroupByNone, GroupByProject, GroupByStatus, GroupByAge:
+		return mode, nil
+	default:
+		return GroupByNone, NewValidationError("ParseGroupByMode", "group-by", value, "unsupported group-by mode").
+			WithSuggestions([]string{"Use one of: project, status, age"})
+	}
+}
+
+// AgeBucket returns the GroupByAge label for the given age
+func AgeBucket(age time.Duration) string {
+	switch {
+	case age < day:
+		return AgeBucketDay
+	case age < week:
+		return AgeBucketWeek
+	case age < month:
+		return AgeBucketMonth
+	default:
+		return AgeBucketOlder
+	}
+}
+
+// GroupWorktrees groups worktrees by the given mode, preserving input order within each group.
+// GroupByNone returns a single group keyed by the empty string.
+func GroupWorktrees(worktrees []*WorktreeInfo, by GroupByMode) map[string][]*WorktreeInfo {
+	return groupWorktreesAt(worktrees, by, time.Now())
+}
+
+func groupWorktreesAt(worktrees []*WorktreeInfo, by GroupByMode, now time.Time) map[string][]*WorktreeInfo {
+	groups := make(map[string][]*WorktreeInfo)
+	for _, wt := range worktrees {
+		key := groupKey(wt, by, now)
+		groups[key] = append(groups[key], wt)
+	}
+	return groups
+}
+
+func groupKey(wt *WorktreeInfo, by GroupByMode, now time.Time) string {
+	switch by {
+	case GroupByProject:
+		if wt.Project == "" {
+			return unknownProjectGroup
+		}
+		return wt.Project
+	case GroupByStatus:
+		if wt.Modified {
+			return "dirty"
+		}
+		return "clean"
+	case GroupByAge:
+		return AgeBucket(wt.ageAt(now))
+	default:
+		return ""
+	}
+}
+
+// SortedGroupNames returns group names in display order: alphabetical,
+// except age buckets which are ordered from newest to oldest.
+func SortedGroupNames(groups map[string][]*WorktreeInfo, by GroupByMode) []string {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+
+	if by == GroupByAge {
+		slices.SortFunc(names, func(a, b string) int {
+			return slices.Index(ageBuckets, a) - slices.Index(ageBuckets, b)
+		})
+		return names
+	}
+
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGroupByMode(t *testing.T) {
+	for _, value := range []string{"", "project", "status", "age"} {
+		mode, err := ParseGroupByMode(value)
+		require.NoError(t, err)
+		assert.Equal(t, GroupByMode(value), mode)
+	}
+
+	_, err := ParseGroupByMode("branch")
+	require.Error(t, err)
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
+
+func TestAgeBucket(t *testing.T) {
+	testCases := []struct {
+		age      time.Duration
+		expected string
+	}{
+		{age: 0, expected: AgeBucketDay},
+		{age: 23 * time.Hour, expected: AgeBucketDay},
+		{age: 24 * time.Hour, expected: AgeBucketWeek},
+		{age: 6 * 24 * time.Hour, expected: AgeBucketWeek},
+		{age: 7 * 24 * time.Hour, expected: AgeBucketMonth},
+		{age: 29 * 24 * time.Hour, expected: AgeBucketMonth},
+		{age: 30 * 24 * time.Hour, expected: AgeBucketOlder},
+		{age: 400 * 24 * time.Hour, expected: AgeBucketOlder},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.age.String(), func(t *testing.T) {
+			assert.Equal(t, tc.expected, AgeBucket(tc.age))
+		})
+	}
+}
+
+func TestGroupWorktrees(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	api := &WorktreeInfo{Branch: "api", Project: "backend", LastUpdated: now.Add(-2 * time.Hour)}
+	ui := &WorktreeInfo{Branch: "ui", Project: "frontend", Modified: true, LastUpdated: now.Add(-3 * 24 * time.Hour)}
+	db := &WorktreeInfo{Branch: "db", Project: "backend", LastUpdated: now.Add(-90 * 24 * time.Hour)}
+	orphan := &WorktreeInfo{Branch: "orphan"}
+	worktrees := []*WorktreeInfo{api, ui, db, orphan}
+
+	t.Run("by project", func(t *testing.T) {
+		groups := groupWorktreesAt(worktrees, GroupByProject, now)
+		assert.Equal(t, []*WorktreeInfo{api, db}, groups["backend"])
+		assert.Equal(t, []*WorktreeInfo{ui}, groups["frontend"])
+		assert.Equal(t, []*WorktreeInfo{orphan}, groups[unknownProjectGroup])
+		assert.Equal(t, []string{"(unknown)", "backend", "frontend"}, SortedGroupNames(groups, GroupByProject))
+	})
+
+	t.Run("by status", func(t *testing.T) {
+		groups := groupWorktreesAt(worktrees, GroupByStatus, now)
+		assert.Equal(t, []*WorktreeInfo{api, db, orphan}, groups["clean"])
+		assert.Equal(t, []*WorktreeInfo{ui}, groups["dirty"])
+		assert.Equal(t, []string{"clean", "dirty"}, SortedGroupNames(groups, GroupByStatus))
+	})
+
+	t.Run("by age", func(t *testing.T) {
+		groups := groupWorktreesAt(worktrees, GroupByAge, now)
+		assert.Equal(t, []*WorktreeInfo{api, orphan}, groups[AgeBucketDay])
+		assert.Equal(t, []*WorktreeInfo{ui}, groups[AgeBucketWeek])
+		assert.Equal(t, []*WorktreeInfo{db}, groups[AgeBucketOlder])
+		assert.Equal(t, []string{AgeBucketDay, AgeBucketWeek, AgeBucketOlder}, SortedGroupNames(groups, GroupByAge))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		groups := GroupWorktrees(worktrees, GroupByNone)
+		assert.Equal(t, worktrees, groups[""])
+	})
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// SortMode selects the order in which worktrees are listed
+type SortMode string
+
+const (
+	// SortNone keeps the order git reports
+	SortNone SortMode = ""
+	// SortByBranch orders worktrees alphabetically by branch
+	SortByBranch SortMode = "branch"
+	// SortByProject orders worktrees by project, then branch
+	SortByProject SortMode = "project"
+	// SortByPath orders worktrees by path
+	SortByPath SortMode = "path"
+	// SortByAge orders worktrees from the most to the least recently updated
+	SortByAge SortMode = "age"
+)
+
+// ParseSortMode converts a --sort or default_sort value to a SortMode
+func ParseSortMode(value string) (SortMode, error) {
+	switch mode := SortMode(value); mode {
+	case SortNone, SortByBranch, SortByProject, SortByPath, SortByAge:
+		return mode, nil
+	default:
+		return SortNone, NewValidationError("ParseSortMode", "sort", value, "unsupported sort order").
+			WithSuggestions([]string{"Use one of: branch, project, path, age"})
+	}
+}
+
+// SortWorktrees sorts worktrees in place; the sort is stable, so ties keep their order.
+// SortByAge treats a zero LastUpdated as now, like WorktreeInfo.Age.
+func SortWorktrees(worktrees []*WorktreeInfo, by SortMode) {
+	sortWorktreesAt(worktrees, by, time.Now())
+}
+
+func sortWorktreesAt(worktrees []*WorktreeInfo, by SortMode, now time.Time) {
+	switch by {
+	case SortByBranch:
+		slices.SortStableFunc(worktrees, func(a, b *WorktreeInfo) int { return cmp.Compare(a.Branch, b.Branch) })
+	case SortByProject:
+		slices.SortStableFunc(worktrees, func(a, b *WorktreeInfo) int {
+			return cmp.Or(cmp.Compare(a.Project, b.Project), cmp.Compare(a.Branch, b.Branch))
+		})
+	case SortByPath:
+		slices.SortStableFunc(worktrees, func(a, b *WorktreeInfo) int { return cmp.Compare(a.Path, b.Path) })
+	case SortByAge:
+		slices.SortStableFunc(worktrees, func(a, b *WorktreeInfo) int { return cmp.Compare(a.ageAt(now), b.ageAt(now)) })
+	}
+}
+
+// PageWorktrees returns the page of worktrees starting at offset, at most limit long
+// (0 means no limit). An offset past the end gives an empty page.
+func PageWorktrees(worktrees []*WorktreeInfo, offset, limit int) []*WorktreeInfo {
+	offset = min(max(offset, 0), len(worktrees))
+	end := len(worktrees)
+	if limit > 0 {
+		end = min(offset+limit, end)
+	}
+	return worktrees[offset:end]
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSortMode(t *testing.T) {
+	for _, value := range []string{"", "branch", "project", "path", "age"} {
+		mode, err := ParseSortMode(value)
+		require.NoError(t, err)
+		assert.Equal(t, SortMode(value), mode)
+	}
+
+	_, err := ParseSortMode("status")
+	require.Error(t, err)
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
+
+func TestSortWorktrees(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	list := func() []*WorktreeInfo {
+		return []*WorktreeInfo{
+			{Project: "b", Branch: "main", Path: "/w/b/main", LastUpdated: now.Add(-48 * time.Hour)},
+			{Project: "a", Branch: "zeta", Path: "/w/a/zeta", LastUpdated: now.Add(-time.Hour)},
+			{Project: "a", Branch: "alpha", Path: "/w/a/alpha"},
+		}
+	}
+	branches := func(worktrees []*WorktreeInfo) []string {
+		names := make([]string, 0, len(worktrees))
+		for _, wt := range worktrees {
+			names = append(names, wt.Project+"/"+wt.Branch)
+		}
+		return names
+	}
+
+	testCases := []struct {
+		by       SortMode
+		expected []string
+	}{
+		{by: SortNone, expected: []string{"b/main", "a/zeta", "a/alpha"}},
+		{by: SortByBranch, expected: []string{"a/alpha", "b/main", "a/zeta"}},
+		{by: SortByProject, expected: []string{"a/alpha", "a/zeta", "b/main"}},
+		{by: SortByPath, expected: []string{"a/alpha", "a/zeta", "b/main"}},
+		{by: SortByAge, expected: []string{"a/alpha", "a/zeta", "b/main"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.by), func(t *testing.T) {
+			worktrees := list()
+			sortWorktreesAt(worktrees, tc.by, now)
+			assert.Equal(t, tc.expected, branches(worktrees))
+		})
+	}
+}
+
+func TestPageWorktrees(t *testing.T) {
+	worktrees := make([]*WorktreeInfo, 25)
+	for i := range worktrees {
+		worktrees[i] = &WorktreeInfo{Branch: fmt.Sprintf("b%02d", i)}
+	}
+
+	testCases := []struct {
+		name          string
+		offset, limit int
+		first, count  int
+	}{
+		{name: "first page", offset: 0, limit: 10, first: 0, count: 10},
+		{name: "second page", offset: 10, limit: 10, first: 10, count: 10},
+		{name: "last partial page", offset: 20, limit: 10, first: 20, count: 5},
+		{name: "offset past the end", offset: 30, limit: 10, count: 0},
+		{name: "no limit", offset: 5, limit: 0, first: 5, count: 20},
+		{name: "negative offset", offset: -1, limit: 3, first: 0, count: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			page := PageWorktrees(worktrees, tc.offset, tc.limit)
+			require.Len(t, page, tc.count)
+			if tc.count > 0 {
+				assert.Equal(t, worktrees[tc.first], page[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package infrastructure
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.ChangeWatcher = (*changeWatcher)(nil)
+
+// DefaultChangeDebounce is how long a worktree must stay quiet before the hook runs
+const DefaultChangeDebounce = 500 * time.Millisecond
+
+type changeWatcher struct {
+	debounce time.Duration
+}
+
+// NewChangeWatcher creates a ChangeWatcher. An optional debounce overrides DefaultChangeDebounce.
+func NewChangeWatcher(debounce ...time.Duration) application.ChangeWatcher {
+	d := DefaultChangeDebounce
+	if len(debounce) > 0 {
+		d = debounce[0]
+	}
+	return &changeWatcher{debounce: d}
+}
+
+// Watch watches every directory of the worktree except .git; directories created later are
+// added as they appear. Events within the debounce window produce a single hook run.
+func (w *changeWatcher) Watch(ctx context.Context, req *application.HookRunRequest, hookRunner application.HookRunner, report func(domain.HookRun)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return domain.NewWorktreeServiceError(req.WorktreePath, req.BranchName, "WatchChanges", "failed to create file watcher", err)
+	}
+	defer watcher.Close()
+
+	if err := addWatchTree(watcher, req.WorktreePath); err != nil {
+		return domain.NewWorktreeServiceError(req.WorktreePath, req.BranchName, "WatchChanges", "failed to watch worktree", err)
+	}
+
+	timer := time.NewTimer(w.debounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	var changed string
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if isGitPath(req.WorktreePath, event.Name) || event.Op == fsnotify.Chmod {
+				continue
+			}
+			if event.Has(fsnotify.Create) {
+				// Best-effort: a directory removed again before it is added is simply missed
+				_ = addWatchTree(watcher, event.Name)
+			}
+			changed = event.Name
+			timer.Reset(w.debounce)
+		case <-timer.C:
+			report(runChangeHook(ctx, req, hookRunner, changed))
+		case _, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+		}
+	}
+}
+
+// runChangeHook runs the hook for one burst of changes and times it
+func runChangeHook(ctx context.Context, req *application.HookRunRequest, hookRunner application.HookRunner, changed string) domain.HookRun {
+	runReq := *req
+	runReq.ChangedFile = changed
+
+	start := time.Now()
+	result, err := hookRunner.Run(ctx, &runReq)
+	return domain.HookRun{ChangedFile: changed, Result: result, Err: err, Duration: time.Since(start)}
+}
+
+// addWatchTree adds root and every directory below it except .git directories;
+// fsnotify does not watch recursively
+func addWatchTree(watcher *fsnotify.Watcher, root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == root {
+				return err
+			}
+			return nil
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if d.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		return watcher.Add(path)
+	})
+}
+
+// isGitPath reports whether path is the worktree's .git file or directory, or inside it
+func isGitPath(worktreePath, path string) bool {
+	rel, err := filepath.Rel(worktreePath, path)
+	if err != nil {
+		return false
+	}
+	first, _, _ := strings.Cut(filepath.ToSlash(rel), "/")
+	return first == ".git"
+}
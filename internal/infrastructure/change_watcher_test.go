@@ -0,0 +1,134 @@
+package infrastructure
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+// hookRunnerFunc adapts a function to application.HookRunner
+type hookRunnerFunc func(ctx context.Context, req *application.HookRunRequest) (*domain.HookResult, error)
+
+func (f hookRunnerFunc) Run(ctx context.Context, req *application.HookRunRequest) (*domain.HookResult, error) {
+	return f(ctx, req)
+}
+
+func (f hookRunnerFunc) LoadConfig(string) (*domain.HookConfig, error) {
+	return nil, nil
+}
+
+// startChangeWatcher runs Watch in the background and returns the channel of reported runs
+func startChangeWatcher(t *testing.T, worktree string, runner application.HookRunner) <-chan domain.HookRun {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	runs := make(chan domain.HookRun, 10)
+	done := make(chan error, 1)
+	ready := make(chan struct{})
+
+	go func() {
+		close(ready)
+		done <- NewChangeWatcher(50*time.Millisecond).Watch(ctx, &application.HookRunRequest{
+			HookType:     domain.HookPostChange,
+			WorktreePath: worktree,
+		}, runner, func(run domain.HookRun) { runs <- run })
+	}()
+	<-ready
+	t.Cleanup(func() {
+		cancel()
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Error("Watch did not return after cancellation")
+		}
+	})
+
+	// Give the watcher time to register its directories
+	time.Sleep(100 * time.Millisecond)
+	return runs
+}
+
+func waitHookRun(t *testing.T, runs <-chan domain.HookRun) domain.HookRun {
+	t.Helper()
+	select {
+	case run := <-runs:
+		return run
+	case <-time.After(5 * time.Second):
+		t.Fatal("no hook run reported")
+		return domain.HookRun{}
+	}
+}
+
+func TestChangeWatcher_DebouncesAndPassesChangedFile(t *testing.T) {
+	worktree := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(worktree, "pkg"), 0755))
+
+	requests := make(chan application.HookRunRequest, 10)
+	runner := hookRunnerFunc(func(_ context.Context, req *application.HookRunRequest) (*domain.HookResult, error) {
+		requests <- *req
+		return &domain.HookResult{HookType: req.HookType, Executed: true, Success: true}, nil
+	})
+	runs := startChangeWatcher(t, worktree, runner)
+
+	for i := range 3 {
+		require.NoError(t, os.WriteFile(filepath.Join(worktree, "a.txt"), []byte{byte(i)}, 0644))
+	}
+	last := filepath.Join(worktree, "pkg", "b.go")
+	require.NoError(t, os.WriteFile(last, []byte("package pkg"), 0644))
+
+	run := waitHookRun(t, runs)
+	assert.Equal(t, last, run.ChangedFile)
+	assert.Equal(t, 0, run.ExitCode())
+	req := <-requests
+	assert.Equal(t, domain.HookPostChange, req.HookType)
+	assert.Equal(t, last, req.ChangedFile)
+
+	select {
+	case extra := <-runs:
+		t.Fatalf("burst of changes ran the hook more than once: %+v", extra)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestChangeWatcher_WatchesNewDirectoriesAndIgnoresGit(t *testing.T) {
+	worktree := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(worktree, ".git"), 0755))
+
+	runner := hookRunnerFunc(func(_ context.Context, req *application.HookRunRequest) (*domain.HookResult, error) {
+		return &domain.HookResult{Executed: true, Failures: []domain.HookFailure{{Command: "make", ExitCode: 2}}}, nil
+	})
+	runs := startChangeWatcher(t, worktree, runner)
+
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, ".git", "index"), []byte("x"), 0644))
+	select {
+	case run := <-runs:
+		t.Fatalf("change inside .git ran the hook: %+v", run)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	newDir := filepath.Join(worktree, "internal")
+	require.NoError(t, os.Mkdir(newDir, 0755))
+	waitHookRun(t, runs) // The directory creation itself
+
+	changed := filepath.Join(newDir, "file.go")
+	require.NoError(t, os.WriteFile(changed, []byte("package internal"), 0644))
+	run := waitHookRun(t, runs)
+	assert.Equal(t, changed, run.ChangedFile)
+	assert.Equal(t, 2, run.ExitCode())
+}
+
+func TestChangeWatcher_MissingWorktree(t *testing.T) {
+	err := NewChangeWatcher().Watch(context.Background(), &application.HookRunRequest{
+		WorktreePath: filepath.Join(t.TempDir(), "missing"),
+	}, hookRunnerFunc(nil), func(domain.HookRun) {})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to watch worktree")
+}
@@ -0,0 +1,224 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.CIWatcher = (*ciWatcher)(nil)
+
+// DefaultCIRequestTimeout bounds each CI status request
+const DefaultCIRequestTimeout = 10 * time.Second
+
+// githubCombinedStatus holds the fields of GitHub's combined commit status response
+type githubCombinedStatus struct {
+	State      string `json:"state"`
+	TotalCount int    `json:"total_count"`
+	Statuses   []struct {
+		State     string `json:"state"`
+		TargetURL string `json:"target_url"`
+	} `json:"statuses"`
+}
+
+// gitlabCommit holds the pipeline fields of GitLab's single commit response
+type gitlabCommit struct {
+	LastPipeline *struct {
+		Status string `json:"status"`
+		WebURL string `json:"web_url"`
+	} `json:"last_pipeline"`
+}
+
+type ciWatcher struct {
+	client  *http.Client
+	host    string
+	baseURL string
+	tokens  application.TokenStore
+}
+
+// NewCIWatcher creates a CIWatcher for github.com or gitlab.com, authenticating with the
+// token stored for the host when there is one. An optional timeout overrides DefaultCIRequestTimeout.
+func NewCIWatcher(host string, tokens application.TokenStore, timeout ...time.Duration) application.CIWatcher {
+	t := DefaultCIRequestTimeout
+	if len(timeout) > 0 {
+		t = timeout[0]
+	}
+	return &ciWatcher{client: &http.Client{Timeout: t}, host: host, baseURL: defaultAPIBaseURLs[host], tokens: tokens}
+}
+
+// Watch fetches the status once before returning, so unknown repositories and rejected
+// tokens are reported as errors. Later request failures are skipped until the next poll.
+func (w *ciWatcher) Watch(ctx context.Context, owner, repo, sha string, interval time.Duration) (<-chan domain.CIStatus, error) {
+	if w.baseURL == "" {
+		return nil, domain.NewValidationError("WatchCI", "host", w.host, "unsupported host; use github or gitlab")
+	}
+	if owner == "" || repo == "" {
+		return nil, domain.NewValidationError("WatchCI", "repo", owner+"/"+repo, "owner and repository are required")
+	}
+	if sha == "" {
+		return nil, domain.NewValidationError("WatchCI", "sha", "", "commit cannot be empty")
+	}
+	if interval <= 0 {
+		return nil, domain.NewValidationError("WatchCI", "interval", interval.String(), "interval must be positive")
+	}
+
+	token, err := w.token()
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := w.fetch(ctx, owner, repo, sha, token)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(chan domain.CIStatus, 1)
+	updates <- status
+	if status.Done() {
+		close(updates)
+		return updates, nil
+	}
+
+	go w.poll(ctx, owner, repo, sha, token, interval, updates)
+	return updates, nil
+}
+
+// poll sends a status every interval until it is final or ctx ends
+func (w *ciWatcher) poll(ctx context.Context, owner, repo, sha, token string, interval time.Duration, updates chan<- domain.CIStatus) {
+	defer close(updates)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		status, err := w.fetch(ctx, owner, repo, sha, token)
+		if err != nil {
+			continue
+		}
+
+		select {
+		case updates <- status:
+		case <-ctx.Done():
+			return
+		}
+		if status.Done() {
+			return
+		}
+	}
+}
+
+// token returns the stored token for the host, "" when none is stored
+func (w *ciWatcher) token() (string, error) {
+	if w.tokens == nil {
+		return "", nil
+	}
+	stored, err := w.tokens.Get(w.host)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s token: %w", w.host, err)
+	}
+	if stored == nil {
+		return "", nil
+	}
+	return stored.Token, nil
+}
+
+// fetch requests the current CI status of the commit
+func (w *ciWatcher) fetch(ctx context.Context, owner, repo, sha, token string) (domain.CIStatus, error) {
+	var endpoint string
+	if w.host == domain.AuthHostGitLab {
+		endpoint = fmt.Sprintf("%s/projects/%s/repository/commits/%s", w.baseURL, url.PathEscape(owner+"/"+repo), url.PathEscape(sha))
+	} else {
+		endpoint = fmt.Sprintf("%s/repos/%s/%s/commits/%s/status", w.baseURL, url.PathEscape(owner), url.PathEscape(repo), url.PathEscape(sha))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return domain.CIStatus{}, fmt.Errorf("failed to build request for %s: %w", w.host, err)
+	}
+	setAPIAuthHeaders(req, w.host, token)
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return domain.CIStatus{}, fmt.Errorf("failed to reach %s: %w", w.host, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return domain.CIStatus{}, domain.NewValidationError("WatchCI", "token", domain.MaskToken(token), fmt.Sprintf("%s rejected the request (%s); store a token with 'twiggit auth add'", w.host, resp.Status))
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return domain.CIStatus{}, fmt.Errorf("%s has no commit %s in %s/%s; push the branch first", w.host, sha, owner, repo)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return domain.CIStatus{}, fmt.Errorf("unexpected response from %s: %s", w.host, resp.Status)
+	}
+
+	if w.host == domain.AuthHostGitLab {
+		var commit gitlabCommit
+		if err := json.NewDecoder(resp.Body).Decode(&commit); err != nil {
+			return domain.CIStatus{}, fmt.Errorf("failed to parse %s commit response: %w", w.host, err)
+		}
+		return gitlabCIStatus(commit), nil
+	}
+
+	var combined githubCombinedStatus
+	if err := json.NewDecoder(resp.Body).Decode(&combined); err != nil {
+		return domain.CIStatus{}, fmt.Errorf("failed to parse %s status response: %w", w.host, err)
+	}
+	return githubCIStatus(combined), nil
+}
+
+// githubCIStatus converts a combined status, linking the first status that has not succeeded
+func githubCIStatus(combined githubCombinedStatus) domain.CIStatus {
+	status := domain.CIStatus{State: combined.State}
+	if combined.TotalCount == 0 {
+		status.Description = "no status reported yet"
+		return status
+	}
+
+	passed := 0
+	for _, s := range combined.Statuses {
+		if s.State == domain.CIStateSuccess {
+			passed++
+		} else if status.URL == "" {
+			status.URL = s.TargetURL
+		}
+	}
+	if status.URL == "" && len(combined.Statuses) > 0 {
+		status.URL = combined.Statuses[0].TargetURL
+	}
+	status.Description = fmt.Sprintf("%d of %d checks passed", passed, combined.TotalCount)
+	return status
+}
+
+// gitlabCIStatus maps the commit's last pipeline status onto the CIState values
+func gitlabCIStatus(commit gitlabCommit) domain.CIStatus {
+	if commit.LastPipeline == nil {
+		return domain.CIStatus{State: domain.CIStatePending, Description: "no pipeline yet"}
+	}
+
+	status := domain.CIStatus{Description: "pipeline " + commit.LastPipeline.Status, URL: commit.LastPipeline.WebURL}
+	switch commit.LastPipeline.Status {
+	case "success":
+		status.State = domain.CIStateSuccess
+	case "failed":
+		status.State = domain.CIStateFailure
+	case "canceled", "skipped":
+		status.State = domain.CIStateError
+	default:
+		status.State = domain.CIStatePending
+	}
+	return status
+}
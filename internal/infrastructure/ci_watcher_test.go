@@ -0,0 +1,125 @@
+package infrastructure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func newTestCIWatcher(t *testing.T, host string, handler http.HandlerFunc) *ciWatcher {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return &ciWatcher{client: &http.Client{Timeout: time.Second}, host: host, baseURL: server.URL}
+}
+
+// collectCIStatuses reads updates until the channel closes
+func collectCIStatuses(t *testing.T, updates <-chan domain.CIStatus) []domain.CIStatus {
+	t.Helper()
+	var statuses []domain.CIStatus
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case status, ok := <-updates:
+			if !ok {
+				return statuses
+			}
+			statuses = append(statuses, status)
+		case <-timeout:
+			t.Fatal("watch did not finish")
+		}
+	}
+}
+
+func TestCIWatcher_GitHubPollsUntilFinal(t *testing.T) {
+	var requests atomic.Int32
+	watcher := newTestCIWatcher(t, domain.AuthHostGitHub, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/acme/api/commits/abc123/status", r.URL.Path)
+		assert.Equal(t, "Bearer ghp_stored", r.Header.Get("Authorization"))
+		if requests.Add(1) == 1 {
+			_, _ = w.Write([]byte(`{"state":"pending","total_count":2,"statuses":[{"state":"success","target_url":"https://ci/1"},{"state":"pending","target_url":"https://ci/2"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"state":"success","total_count":2,"statuses":[{"state":"success","target_url":"https://ci/1"},{"state":"success","target_url":"https://ci/2"}]}`))
+	})
+	tokens := mocks.NewMockTokenStore()
+	tokens.On("Get", domain.AuthHostGitHub).Return(&domain.StoredToken{Host: domain.AuthHostGitHub, Token: "ghp_stored"}, nil)
+	watcher.tokens = tokens
+
+	updates, err := watcher.Watch(context.Background(), "acme", "api", "abc123", 10*time.Millisecond)
+	require.NoError(t, err)
+
+	assert.Equal(t, []domain.CIStatus{
+		{State: domain.CIStatePending, Description: "1 of 2 checks passed", URL: "https://ci/2"},
+		{State: domain.CIStateSuccess, Description: "2 of 2 checks passed", URL: "https://ci/1"},
+	}, collectCIStatuses(t, updates))
+}
+
+func TestCIWatcher_GitLabPipeline(t *testing.T) {
+	watcher := newTestCIWatcher(t, domain.AuthHostGitLab, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/group%2Fsub%2Ftool/repository/commits/abc123", r.URL.EscapedPath())
+		assert.Empty(t, r.Header.Get("PRIVATE-TOKEN"), "no token is sent when none is stored")
+		_, _ = w.Write([]byte(`{"id":"abc123","last_pipeline":{"status":"failed","web_url":"https://gitlab/p/1"}}`))
+	})
+
+	updates, err := watcher.Watch(context.Background(), "group/sub", "tool", "abc123", time.Minute)
+	require.NoError(t, err)
+
+	assert.Equal(t, []domain.CIStatus{
+		{State: domain.CIStateFailure, Description: "pipeline failed", URL: "https://gitlab/p/1"},
+	}, collectCIStatuses(t, updates))
+}
+
+func TestCIWatcher_StopsOnContextCancel(t *testing.T) {
+	watcher := newTestCIWatcher(t, domain.AuthHostGitHub, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"state":"pending","total_count":0,"statuses":[]}`))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates, err := watcher.Watch(ctx, "acme", "api", "abc123", time.Hour)
+	require.NoError(t, err)
+
+	first := <-updates
+	assert.Equal(t, domain.CIStatus{State: domain.CIStatePending, Description: "no status reported yet"}, first)
+
+	cancel()
+	assert.Empty(t, collectCIStatuses(t, updates))
+}
+
+func TestCIWatcher_Errors(t *testing.T) {
+	watcher := newTestCIWatcher(t, domain.AuthHostGitHub, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/repos/acme/private/commits/abc123/status" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := watcher.Watch(context.Background(), "acme", "api", "abc123", time.Second)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "push the branch first")
+
+	_, err = watcher.Watch(context.Background(), "acme", "private", "abc123", time.Second)
+	var validationErr *domain.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, err.Error(), "twiggit auth add")
+
+	_, err = watcher.Watch(context.Background(), "acme", "api", "", time.Second)
+	require.ErrorAs(t, err, &validationErr)
+
+	_, err = watcher.Watch(context.Background(), "acme", "api", "abc123", 0)
+	require.ErrorAs(t, err, &validationErr)
+
+	_, err = NewCIWatcher("bitbucket.org", nil).Watch(context.Background(), "acme", "api", "abc123", time.Second)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported host")
+}
@@ -1,11 +1,16 @@
 package infrastructure
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
@@ -65,6 +70,112 @@ func buildWorktreeRemoveArgs(worktreePath string, force bool) []string {
 	return args
 }
 
+// logFieldSeparator separates fields in git log output (ASCII unit separator)
+const logFieldSeparator = "\x1f"
+
+// logFormat is the git log --format string parsed by parseLogOutput
+const logFormat = "--format=%H%x1f%h%x1f%an%x1f%ae%x1f%at%x1f%s"
+
+// parseLogOutput parses git log output produced with logFormat
+func parseLogOutput(output string) []domain.CommitInfo {
+	commits := make([]domain.CommitInfo, 0)
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := strings.SplitN(line, logFieldSeparator, 6)
+		if len(fields) != 6 {
+			continue
+		}
+
+		commit := domain.CommitInfo{
+			Hash:      fields[0],
+			ShortHash: fields[1],
+			Author:    fields[2],
+			Email:     fields[3],
+			Message:   fields[5],
+		}
+		if unix, err := strconv.ParseInt(fields[4], 10, 64); err == nil {
+			commit.Date = time.Unix(unix, 0)
+		}
+		commits = append(commits, commit)
+	}
+	return commits
+}
+
+// diffStatSummaryPattern matches the final summary line of git diff --stat
+var diffStatSummaryPattern = regexp.MustCompile(`^(\d+) files? changed(?:, (\d+) insertions?\(\+\))?(?:, (\d+) deletions?\(-\))?$`)
+
+// parseDiffStat parses the output of git diff --stat --summary into a comparison
+func parseDiffStat(output string) *domain.WorktreeComparison {
+	comparison := &domain.WorktreeComparison{ChangedFiles: []domain.FileStat{}}
+	modes := make(map[string]string)
+
+	for _, rawLine := range strings.Split(output, "\n") {
+		line := strings.TrimSpace(rawLine)
+		if line == "" {
+			continue
+		}
+
+		if matches := diffStatSummaryPattern.FindStringSubmatch(line); matches != nil {
+			comparison.FilesChanged, _ = strconv.Atoi(matches[1])
+			comparison.Insertions, _ = strconv.Atoi(matches[2])
+			comparison.Deletions, _ = strconv.Atoi(matches[3])
+			continue
+		}
+
+		if path, mode, ok := parseDiffSummaryLine(line); ok {
+			if path != "" {
+				modes[path] = mode
+			}
+			continue
+		}
+
+		if idx := strings.LastIndex(line, " | "); idx > 0 {
+			comparison.ChangedFiles = append(comparison.ChangedFiles, domain.FileStat{
+				Path: strings.TrimSpace(line[:idx]),
+				Mode: "modified",
+			})
+		}
+	}
+
+	for i := range comparison.ChangedFiles {
+		if mode, ok := modes[comparison.ChangedFiles[i].Path]; ok {
+			comparison.ChangedFiles[i].Mode = mode
+		} else if strings.Contains(comparison.ChangedFiles[i].Path, " => ") {
+			comparison.ChangedFiles[i].Mode = "renamed"
+		}
+	}
+
+	return comparison
+}
+
+// parseDiffSummaryLine parses a git diff --summary line such as "create mode 100644 path"
+func parseDiffSummaryLine(line string) (path, mode string, ok bool) {
+	switch {
+	case strings.HasPrefix(line, "create mode "):
+		fields := strings.SplitN(line, " ", 4)
+		if len(fields) == 4 {
+			return fields[3], "created", true
+		}
+	case strings.HasPrefix(line, "delete mode "):
+		fields := strings.SplitN(line, " ", 4)
+		if len(fields) == 4 {
+			return fields[3], "deleted", true
+		}
+	case strings.HasPrefix(line, "mode change "):
+		fields := strings.SplitN(line, " ", 6)
+		if len(fields) == 6 {
+			return fields[5], "mode changed", true
+		}
+	case strings.HasPrefix(line, "rename "):
+		return "", "", true
+	}
+	return "", "", false
+}
+
 // CLIClientImpl implements CLIClient using git CLI commands
 type CLIClientImpl struct {
 	executor CommandExecutor
@@ -98,7 +209,7 @@ func (c *CLIClientImpl) CreateWorktree(ctx context.Context, repoPath, branchName
 	}
 
 	// Check if branch already exists
-	branchExists, err := c.branchExists(ctx, repoPath, branchName)
+	branchExists, err := c.BranchExists(ctx, repoPath, branchName)
 	if err != nil {
 		return domain.NewGitWorktreeError(worktreePath, branchName, "failed to check if branch exists", err)
 	}
@@ -126,6 +237,30 @@ func (c *CLIClientImpl) CreateWorktree(ctx context.Context, repoPath, branchName
 	return nil
 }
 
+// InitBareWorktree registers a worktree at targetPath without checking out any files.
+// --detach keeps git from creating a branch named after the directory.
+func (c *CLIClientImpl) InitBareWorktree(ctx context.Context, repoPath, targetPath string) error {
+	if repoPath == "" {
+		return domain.NewGitWorktreeError(targetPath, "", "repository path cannot be empty", nil)
+	}
+	if targetPath == "" {
+		return domain.NewGitWorktreeError(targetPath, "", "worktree path cannot be empty", nil)
+	}
+	if _, err := os.Stat(targetPath); err == nil {
+		return domain.NewGitWorktreeError(targetPath, "", "target path already exists", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "worktree", "add", "--no-checkout", "--detach", targetPath)
+	if err != nil {
+		return domain.NewGitWorktreeError(targetPath, "", "failed to create worktree", err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitWorktreeError(targetPath, "", "git worktree add failed: "+result.Stderr, nil)
+	}
+
+	return nil
+}
+
 // DeleteWorktree removes worktree using git CLI (idempotent, no-op if already deleted)
 func (c *CLIClientImpl) DeleteWorktree(ctx context.Context, repoPath, worktreePath string, force bool) error {
 	// Validate inputs
@@ -162,6 +297,20 @@ func (c *CLIClientImpl) DeleteWorktree(ctx context.Context, repoPath, worktreePa
 
 // ListWorktrees lists all worktrees using git CLI (idempotent)
 func (c *CLIClientImpl) ListWorktrees(ctx context.Context, repoPath string) ([]domain.WorktreeInfo, error) {
+	entries, err := c.GetWorktreeList(ctx, repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	worktrees := make([]domain.WorktreeInfo, len(entries))
+	for i, entry := range entries {
+		worktrees[i] = entry.WorktreeInfo()
+	}
+	return worktrees, nil
+}
+
+// GetWorktreeList returns the records of git worktree list --porcelain (idempotent)
+func (c *CLIClientImpl) GetWorktreeList(ctx context.Context, repoPath string) ([]domain.GitWorktreeEntry, error) {
 	// Validate input
 	if repoPath == "" {
 		return nil, domain.NewGitWorktreeError("", "", "repository path cannot be empty", nil)
@@ -178,8 +327,7 @@ func (c *CLIClientImpl) ListWorktrees(ctx context.Context, repoPath string) ([]d
 			"git worktree list failed: "+result.Stderr, nil)
 	}
 
-	// Parse output
-	return c.parseWorktreeList(result.Stdout)
+	return parseWorktreeList(result.Stdout), nil
 }
 
 // PruneWorktrees removes stale worktree references
@@ -203,6 +351,68 @@ func (c *CLIClientImpl) PruneWorktrees(ctx context.Context, repoPath string) err
 	return nil
 }
 
+// RepairWorktrees runs git worktree repair so worktrees and the repository point at each other
+// again after either was moved
+func (c *CLIClientImpl) RepairWorktrees(ctx context.Context, repoPath string, worktreePaths []string) error {
+	if repoPath == "" {
+		return domain.NewGitWorktreeError("", "", "repository path cannot be empty", nil)
+	}
+
+	args := append([]string{"worktree", "repair"}, worktreePaths...)
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, args...)
+	if err != nil {
+		return domain.NewGitWorktreeError("", "", "failed to repair worktrees", err)
+	}
+
+	if result.ExitCode != 0 {
+		return domain.NewGitWorktreeError("", "",
+			"git worktree repair failed: "+result.Stderr, nil)
+	}
+
+	return nil
+}
+
+// MoveWorktree moves a linked worktree with git worktree move, which fails for locked worktrees
+// and for a newPath that exists
+func (c *CLIClientImpl) MoveWorktree(ctx context.Context, repoPath, worktreePath, newPath string) error {
+	if repoPath == "" {
+		return domain.NewGitWorktreeError(worktreePath, "", "repository path cannot be empty", nil)
+	}
+	if worktreePath == "" || newPath == "" {
+		return domain.NewGitWorktreeError(worktreePath, "", "worktree paths cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "worktree", "move", worktreePath, newPath)
+	if err != nil {
+		return domain.NewGitWorktreeError(worktreePath, "", "failed to move worktree", err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitWorktreeError(worktreePath, "",
+			"git worktree move failed: "+result.Stderr, nil)
+	}
+	return nil
+}
+
+// RenameBranch renames a branch using git branch -m
+func (c *CLIClientImpl) RenameBranch(ctx context.Context, repoPath, oldName, newName string) error {
+	if repoPath == "" {
+		return domain.NewGitWorktreeError("", oldName, "repository path cannot be empty", nil)
+	}
+	if oldName == "" || newName == "" {
+		return domain.NewGitWorktreeError(repoPath, oldName, "branch names cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "branch", "-m", oldName, newName)
+	if err != nil {
+		return domain.NewGitWorktreeError(repoPath, oldName, "failed to rename branch", err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitWorktreeError(repoPath, oldName,
+			"git branch -m failed: "+result.Stderr, nil)
+	}
+	return nil
+}
+
 // DeleteBranch deletes a branch using git CLI (handles worktree-referenced branches)
 func (c *CLIClientImpl) DeleteBranch(ctx context.Context, repoPath, branchName string) error {
 	if repoPath == "" {
@@ -228,100 +438,1187 @@ func (c *CLIClientImpl) DeleteBranch(ctx context.Context, repoPath, branchName s
 	return nil
 }
 
-// IsBranchMerged checks if a branch is merged into the current branch
-func (c *CLIClientImpl) IsBranchMerged(ctx context.Context, repoPath, branchName string) (bool, error) {
-	// Validate input
+// DeleteRemoteBranch deletes branchName on remote with git push --delete
+func (c *CLIClientImpl) DeleteRemoteBranch(ctx context.Context, repoPath, remote, branchName string) error {
 	if repoPath == "" {
-		return false, domain.NewGitWorktreeError("", branchName, "repository path cannot be empty", nil)
+		return domain.NewGitWorktreeError("", branchName, "repository path cannot be empty", nil)
+	}
+	if remote == "" {
+		return domain.NewGitWorktreeError(repoPath, branchName, "remote name cannot be empty", nil)
 	}
 	if branchName == "" {
-		return false, domain.NewGitWorktreeError("", "", "branch name cannot be empty", nil)
+		return domain.NewGitWorktreeError(repoPath, "", "branch name cannot be empty", nil)
 	}
 
-	// Execute git branch --merged command
-	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "branch", "--merged")
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "push", remote, "--delete", branchName)
+	if result != nil && result.ExitCode != 0 && strings.Contains(result.Stderr, "remote ref does not exist") {
+		return domain.NewGitWorktreeError(repoPath, branchName, "branch not found on "+remote, domain.ErrRemoteBranchNotFound)
+	}
 	if err != nil {
-		return false, domain.NewGitWorktreeError("", branchName, "failed to check merged status", err)
+		return domain.NewGitWorktreeError(repoPath, branchName, "failed to delete remote branch", err)
 	}
 
 	if result.ExitCode != 0 {
-		return false, domain.NewGitWorktreeError("", branchName,
-			"git branch --merged failed: "+result.Stderr, nil)
+		return domain.NewGitWorktreeError(repoPath, branchName,
+			"git push --delete failed: "+result.Stderr, nil)
 	}
 
-	// Check if branch name appears in merged branches output
-	mergedBranches := strings.Split(result.Stdout, "\n")
-	for _, branch := range mergedBranches {
-		trimmed := strings.TrimSpace(branch)
-		// Remove leading markers: * (current branch), + (branch in another worktree)
-		trimmed = strings.TrimPrefix(trimmed, "*")
-		trimmed = strings.TrimPrefix(trimmed, "+")
-		trimmed = strings.TrimSpace(trimmed)
-		if trimmed == branchName {
-			return true, nil
-		}
+	return nil
+}
+
+// stashListFormat prints the stash reference and subject of each entry, NUL-separated
+const stashListFormat = "--format=%gd%x00%gs"
+
+// GetStashList lists stash entries using git stash list
+func (c *CLIClientImpl) GetStashList(ctx context.Context, repoPath string) ([]domain.StashEntry, error) {
+	if repoPath == "" {
+		return nil, domain.NewGitWorktreeError("", "", "repository path cannot be empty", nil)
 	}
 
-	return false, nil
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "stash", "list", stashListFormat)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(repoPath, "", "failed to list stashes", err)
+	}
+	if result.ExitCode != 0 {
+		return nil, domain.NewGitWorktreeError(repoPath, "", "git stash list failed: "+result.Stderr, nil)
+	}
+	return parseStashList(result.Stdout), nil
 }
 
-// parseWorktreeList parses the output of `git worktree list --porcelain`
-func (c *CLIClientImpl) parseWorktreeList(output string) ([]domain.WorktreeInfo, error) {
-	var worktrees []domain.WorktreeInfo
-	var currentWorktree *domain.WorktreeInfo
-
-	lines := strings.Split(output, "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" {
+// parseStashList parses "stash@{n}\x00subject" lines; malformed lines are skipped
+func parseStashList(output string) []domain.StashEntry {
+	var entries []domain.StashEntry
+	for _, line := range strings.Split(output, "\n") {
+		ref, message, ok := strings.Cut(line, "\x00")
+		if !ok {
+			continue
+		}
+		index, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(ref, "stash@{"), "}"))
+		if err != nil {
 			continue
 		}
+		entries = append(entries, domain.StashEntry{Index: index, Message: message})
+	}
+	return entries
+}
 
-		if strings.HasPrefix(line, "worktree ") {
-			// Save previous worktree if exists
-			if currentWorktree != nil {
-				worktrees = append(worktrees, *currentWorktree)
-			}
+// ApplyStash resolves stash@{stashIndex} in srcRepoPath and applies that commit in
+// dstWorktreePath with --index, so staged changes stay staged. Worktrees share refs/stash,
+// so no ref has to be copied; resolving it first pins the entry even if the stash changes.
+func (c *CLIClientImpl) ApplyStash(ctx context.Context, srcRepoPath, dstWorktreePath string, stashIndex int) error {
+	if srcRepoPath == "" {
+		return domain.NewGitWorktreeError(dstWorktreePath, "", "repository path cannot be empty", nil)
+	}
+	if dstWorktreePath == "" {
+		return domain.NewGitWorktreeError("", "", "worktree path cannot be empty", nil)
+	}
+	ref := domain.StashRef(stashIndex)
 
-			// Start new worktree
-			path := strings.TrimPrefix(line, "worktree ")
-			absPath, err := filepath.Abs(path)
-			if err != nil {
-				absPath = path // Use original path if conversion fails
-			}
+	resolved, err := c.executor.ExecuteWithTimeout(ctx, srcRepoPath, "git", c.timeout, "rev-parse", "--verify", "--quiet", ref)
+	if err != nil || resolved.ExitCode != 0 {
+		return domain.NewGitWorktreeError(srcRepoPath, "", ref+" does not exist", err)
+	}
+	commit := strings.TrimSpace(resolved.Stdout)
 
-			currentWorktree = &domain.WorktreeInfo{
-				Path: absPath,
-			}
-		} else if currentWorktree != nil {
-			if strings.HasPrefix(line, "HEAD ") {
-				commit := strings.TrimPrefix(line, "HEAD ")
-				currentWorktree.Commit = commit
-			} else if strings.HasPrefix(line, "branch ") {
-				branchRef := strings.TrimPrefix(line, "branch ")
-				// Extract branch name from refs/heads/branch-name
-				if strings.HasPrefix(branchRef, "refs/heads/") {
-					currentWorktree.Branch = strings.TrimPrefix(branchRef, "refs/heads/")
-				} else {
-					currentWorktree.Branch = branchRef
-				}
-				currentWorktree.IsDetached = false
-			} else if strings.HasPrefix(line, "detached") {
-				currentWorktree.IsDetached = true
-			}
+	result, err := c.executor.ExecuteWithTimeout(ctx, dstWorktreePath, "git", c.timeout, "stash", "apply", "--index", commit)
+	if result != nil && result.ExitCode != 0 && strings.Contains(result.Stdout+result.Stderr, "CONFLICT") {
+		return domain.NewGitWorktreeError(dstWorktreePath, "", "applying "+ref+" left conflicts", domain.ErrStashConflict)
+	}
+	if err != nil {
+		return domain.NewGitWorktreeError(dstWorktreePath, "", "failed to apply "+ref, err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitWorktreeError(dstWorktreePath, "", "git stash apply failed: "+result.Stderr, nil)
+	}
+	return nil
+}
+
+// DropStash removes a stash entry using git stash drop
+func (c *CLIClientImpl) DropStash(ctx context.Context, repoPath string, stashIndex int) error {
+	if repoPath == "" {
+		return domain.NewGitWorktreeError("", "", "repository path cannot be empty", nil)
+	}
+	ref := domain.StashRef(stashIndex)
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "stash", "drop", ref)
+	if err != nil {
+		return domain.NewGitWorktreeError(repoPath, "", "failed to drop "+ref, err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitWorktreeError(repoPath, "", "git stash drop failed: "+result.Stderr, nil)
+	}
+	return nil
+}
+
+// CreateStash stashes tracked and untracked changes using git stash push; an empty message
+// keeps git's "WIP on <branch>: <commit>" subject. Git reports "No local changes to save"
+// with exit code 0, so that output is turned into an error.
+func (c *CLIClientImpl) CreateStash(ctx context.Context, worktreePath, message string) error {
+	if worktreePath == "" {
+		return domain.NewGitWorktreeError("", "", "worktree path cannot be empty", nil)
+	}
+
+	args := []string{"stash", "push", "--include-untracked"}
+	if message != "" {
+		args = append(args, "-m", message)
+	}
+	result, err := c.executor.ExecuteWithTimeout(ctx, worktreePath, "git", c.timeout, args...)
+	if err != nil {
+		return domain.NewGitWorktreeError(worktreePath, "", "failed to stash changes", err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitWorktreeError(worktreePath, "", "git stash push failed: "+result.Stderr, nil)
+	}
+	if strings.Contains(result.Stdout, "No local changes to save") {
+		return domain.NewGitWorktreeError(worktreePath, "", "no local changes to stash", nil)
+	}
+	return nil
+}
+
+// CheckoutBranch switches worktreePath to branchName, creating it at startPoint when one is given
+func (c *CLIClientImpl) CheckoutBranch(ctx context.Context, worktreePath, branchName, startPoint string) error {
+	if worktreePath == "" {
+		return domain.NewGitWorktreeError("", branchName, "worktree path cannot be empty", nil)
+	}
+	if branchName == "" {
+		return domain.NewGitWorktreeError(worktreePath, "", "branch name cannot be empty", nil)
+	}
+
+	args := []string{"checkout", branchName}
+	if startPoint != "" {
+		args = []string{"checkout", "-b", branchName, startPoint}
+	}
+	result, err := c.executor.ExecuteWithTimeout(ctx, worktreePath, "git", c.timeout, args...)
+	if err != nil {
+		return domain.NewGitWorktreeError(worktreePath, branchName, "failed to check out branch", err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitWorktreeError(worktreePath, branchName, "git checkout failed: "+result.Stderr, nil)
+	}
+	return nil
+}
+
+// FetchTagsOnly fetches only tag refs (and the objects they need) from remote. Unlike
+// git fetch --tags it does not fetch branches as well.
+func (c *CLIClientImpl) FetchTagsOnly(ctx context.Context, repoPath, remote string) error {
+	if repoPath == "" {
+		return domain.NewGitWorktreeError("", "", "repository path cannot be empty", nil)
+	}
+	if remote == "" {
+		return domain.NewGitWorktreeError(repoPath, "", "remote name cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "fetch", remote, "refs/tags/*:refs/tags/*")
+	if err != nil {
+		return domain.NewGitWorktreeError(repoPath, "", "failed to fetch tags from "+remote, err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitWorktreeError(repoPath, "",
+			"git fetch of tags failed: "+result.Stderr, nil)
+	}
+	return nil
+}
+
+// FetchBranch fetches branch from remote. git updates refs/remotes/<remote>/<branch> through
+// the remote's configured refspec; the local branch is left alone.
+func (c *CLIClientImpl) FetchBranch(ctx context.Context, repoPath, remote, branch string) error {
+	if repoPath == "" {
+		return domain.NewGitWorktreeError("", branch, "repository path cannot be empty", nil)
+	}
+	if remote == "" {
+		return domain.NewGitWorktreeError(repoPath, branch, "remote name cannot be empty", nil)
+	}
+	if branch == "" {
+		return domain.NewGitWorktreeError(repoPath, "", "branch name cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "fetch", remote, branch)
+	if err != nil {
+		return domain.NewGitWorktreeError(repoPath, branch, "failed to fetch "+branch+" from "+remote, err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitWorktreeError(repoPath, branch,
+			"git fetch failed: "+result.Stderr, nil)
+	}
+	return nil
+}
+
+// FetchAllRemotes fetches every configured remote. Linked worktrees share refs with the main
+// repository, so one fetch updates the remote-tracking branches of all of them.
+func (c *CLIClientImpl) FetchAllRemotes(ctx context.Context, repoPath string) error {
+	if repoPath == "" {
+		return domain.NewGitWorktreeError("", "", "repository path cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "fetch", "--all")
+	if err != nil {
+		return domain.NewGitWorktreeError(repoPath, "", "failed to fetch remotes", err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitWorktreeError(repoPath, "",
+			"git fetch --all failed: "+result.Stderr, nil)
+	}
+	return nil
+}
+
+// pullAbortTimeout bounds the rebase/merge abort run after an interrupted or conflicting pull
+const pullAbortTimeout = 10 * time.Second
+
+// Pull pulls the upstream of the worktree's branch, rebasing local commits onto it when rebase
+// is set, and returns how many commits HEAD moved by. A conflicting pull is aborted so the
+// worktree is left as it was, and the error wraps domain.ErrConflict. A pull interrupted by ctx
+// is aborted the same way, and the error wraps ctx.Err().
+func (c *CLIClientImpl) Pull(ctx context.Context, worktreePath string, rebase bool) (int, error) {
+	if worktreePath == "" {
+		return 0, domain.NewGitWorktreeError("", "", "worktree path cannot be empty", nil)
+	}
+
+	before, err := c.revParseHead(ctx, worktreePath)
+	if err != nil {
+		return 0, err
+	}
+
+	args := []string{"pull", "--no-rebase"}
+	if rebase {
+		args = []string{"pull", "--rebase"}
+	}
+	result, err := c.executor.ExecuteWithTimeout(ctx, worktreePath, "git", c.timeout, args...)
+	conflict := result != nil && result.ExitCode != 0 && strings.Contains(result.Stdout+result.Stderr, "CONFLICT")
+	if conflict || ctx.Err() != nil {
+		// The abort must run even when ctx was cancelled, or the worktree stays mid-rebase;
+		// it fails harmlessly when the pull was stopped before starting one
+		abort := []string{"merge", "--abort"}
+		if rebase {
+			abort = []string{"rebase", "--abort"}
 		}
+		_, _ = c.executor.ExecuteWithTimeout(context.WithoutCancel(ctx), worktreePath, "git", pullAbortTimeout, abort...)
+	}
+	if ctx.Err() != nil {
+		return 0, domain.NewGitWorktreeError(worktreePath, "", "pull interrupted", ctx.Err())
+	}
+	if conflict {
+		return 0, domain.NewGitWorktreeError(worktreePath, "", "pull conflicts with local commits", domain.ErrConflict)
+	}
+	if err != nil {
+		return 0, domain.NewGitWorktreeError(worktreePath, "", "failed to pull", err)
+	}
+	if result.ExitCode != 0 {
+		return 0, domain.NewGitWorktreeError(worktreePath, "", "git pull failed: "+result.Stderr, nil)
+	}
+
+	after, err := c.revParseHead(ctx, worktreePath)
+	if err != nil || after == before {
+		return 0, err
+	}
+	count, err := c.executor.ExecuteWithTimeout(ctx, worktreePath, "git", c.timeout, "rev-list", "--count", before+".."+after)
+	if err != nil || count.ExitCode != 0 {
+		return 0, domain.NewGitWorktreeError(worktreePath, "", "failed to count pulled commits", err)
+	}
+	commits, err := strconv.Atoi(strings.TrimSpace(count.Stdout))
+	if err != nil {
+		return 0, domain.NewGitWorktreeError(worktreePath, "", "unexpected git rev-list output: "+count.Stdout, err)
 	}
+	return commits, nil
+}
 
-	// Add last worktree
-	if currentWorktree != nil {
-		worktrees = append(worktrees, *currentWorktree)
+// revParseHead returns the commit HEAD points at
+func (c *CLIClientImpl) revParseHead(ctx context.Context, worktreePath string) (string, error) {
+	result, err := c.executor.ExecuteWithTimeout(ctx, worktreePath, "git", c.timeout, "rev-parse", "HEAD")
+	if err != nil || result.ExitCode != 0 {
+		return "", domain.NewGitWorktreeError(worktreePath, "", "failed to resolve HEAD", err)
 	}
+	return strings.TrimSpace(result.Stdout), nil
+}
 
-	return worktrees, nil
+// ResolveRevision resolves a revision (commit SHA, tag, branch or an expression such as
+// HEAD~2) to the full hash of the commit it points at; annotated tags are peeled.
+func (c *CLIClientImpl) ResolveRevision(ctx context.Context, repoPath, revision string) (string, error) {
+	if revision == "" {
+		return "", domain.NewGitRepositoryError(repoPath, "revision cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "rev-parse", "--verify", "--quiet", revision+"^{commit}")
+	if err != nil || result.ExitCode != 0 {
+		return "", domain.NewGitRepositoryError(repoPath, "failed to resolve revision "+revision, err)
+	}
+	return strings.TrimSpace(result.Stdout), nil
+}
+
+// GetRepositoryStatus reads the status of a worktree from git status --porcelain=v2 --branch.
+// Unlike the go-git status it fills Ahead and Behind when the branch has an upstream; a
+// detached HEAD is reported as branch "HEAD".
+func (c *CLIClientImpl) GetRepositoryStatus(ctx context.Context, repoPath string) (domain.RepositoryStatus, error) {
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "status", "--porcelain=v2", "--branch")
+	if err != nil {
+		return domain.RepositoryStatus{}, domain.NewGitRepositoryError(repoPath, "failed to get repository status", err)
+	}
+	if result.ExitCode != 0 {
+		return domain.RepositoryStatus{}, domain.NewGitRepositoryError(repoPath, "git status failed: "+result.Stderr, nil)
+	}
+	return parsePorcelainStatus(result.Stdout), nil
+}
+
+// parsePorcelainStatus parses git status --porcelain=v2 --branch output. Staged additions are
+// Added, deletions in the index or worktree Deleted, other tracked changes (renames and
+// unmerged paths included) Modified.
+func parsePorcelainStatus(output string) domain.RepositoryStatus {
+	status := domain.RepositoryStatus{IsClean: true}
+	for _, line := range strings.Split(output, "\n") {
+		var xy, path string
+		switch {
+		case strings.HasPrefix(line, "# branch.oid "):
+			if oid := strings.TrimPrefix(line, "# branch.oid "); oid != "(initial)" {
+				status.Commit = oid
+			}
+			continue
+		case strings.HasPrefix(line, "# branch.head "):
+			status.Branch = strings.TrimPrefix(line, "# branch.head ")
+			if status.Branch == "(detached)" {
+				status.Branch = "HEAD"
+			}
+			continue
+		case strings.HasPrefix(line, "# branch.ab "):
+			_, _ = fmt.Sscanf(strings.TrimPrefix(line, "# branch.ab "), "+%d -%d", &status.Ahead, &status.Behind)
+			continue
+		case strings.HasPrefix(line, "? "):
+			status.IsClean = false
+			status.Untracked = append(status.Untracked, strings.TrimPrefix(line, "? "))
+			continue
+		case strings.HasPrefix(line, "1 "):
+			if fields := strings.SplitN(line, " ", 9); len(fields) == 9 {
+				xy, path = fields[1], fields[8]
+			}
+		case strings.HasPrefix(line, "2 "):
+			if fields := strings.SplitN(line, " ", 10); len(fields) == 10 {
+				xy = fields[1]
+				path, _, _ = strings.Cut(fields[9], "\t")
+			}
+		case strings.HasPrefix(line, "u "):
+			if fields := strings.SplitN(line, " ", 11); len(fields) == 11 {
+				xy, path = fields[1], fields[10]
+			}
+		}
+		if path == "" {
+			continue
+		}
+
+		status.IsClean = false
+		switch {
+		case strings.Contains(xy, "D"):
+			status.Deleted = append(status.Deleted, path)
+		case xy[0] == 'A':
+			status.Added = append(status.Added, path)
+		default:
+			status.Modified = append(status.Modified, path)
+		}
+	}
+	return status
+}
+
+// Fsck checks the connectivity and validity of the repository's objects. Dangling objects
+// are normal leftovers of rebases and resets, so they are not reported.
+func (c *CLIClientImpl) Fsck(ctx context.Context, repoPath string) error {
+	if repoPath == "" {
+		return domain.NewGitRepositoryError("", "repository path cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "fsck", "--no-dangling")
+	if err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to run git fsck", err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitRepositoryError(repoPath, "git fsck failed: "+strings.TrimSpace(result.Stderr), nil)
+	}
+	return nil
+}
+
+// PruneTags removes local tags that no longer exist on the default remote. --prune-tags
+// only takes effect together with --prune, which also drops stale remote-tracking branches.
+func (c *CLIClientImpl) PruneTags(ctx context.Context, repoPath string) error {
+	if repoPath == "" {
+		return domain.NewGitWorktreeError("", "", "repository path cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "fetch", "--prune", "--prune-tags")
+	if err != nil {
+		return domain.NewGitWorktreeError(repoPath, "", "failed to prune tags", err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitWorktreeError(repoPath, "",
+			"git fetch --prune-tags failed: "+result.Stderr, nil)
+	}
+	return nil
+}
+
+// LockWorktree locks a worktree using git CLI
+func (c *CLIClientImpl) LockWorktree(ctx context.Context, repoPath, worktreePath, reason string) error {
+	if repoPath == "" {
+		return domain.NewGitWorktreeError(worktreePath, "", "repository path cannot be empty", nil)
+	}
+	if worktreePath == "" {
+		return domain.NewGitWorktreeError("", "", "worktree path cannot be empty", nil)
+	}
+
+	args := []string{"worktree", "lock"}
+	if reason != "" {
+		args = append(args, "--reason", reason)
+	}
+	args = append(args, worktreePath)
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, args...)
+	if err != nil {
+		return domain.NewGitWorktreeError(worktreePath, "", "failed to lock worktree", err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitWorktreeError(worktreePath, "",
+			"git worktree lock failed: "+result.Stderr, nil)
+	}
+	return nil
+}
+
+// UnlockWorktree unlocks a worktree using git CLI
+func (c *CLIClientImpl) UnlockWorktree(ctx context.Context, repoPath, worktreePath string) error {
+	if repoPath == "" {
+		return domain.NewGitWorktreeError(worktreePath, "", "repository path cannot be empty", nil)
+	}
+	if worktreePath == "" {
+		return domain.NewGitWorktreeError("", "", "worktree path cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "worktree", "unlock", worktreePath)
+	if err != nil {
+		return domain.NewGitWorktreeError(worktreePath, "", "failed to unlock worktree", err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitWorktreeError(worktreePath, "",
+			"git worktree unlock failed: "+result.Stderr, nil)
+	}
+	return nil
+}
+
+// IsBranchMerged checks if a branch is merged into the current branch
+func (c *CLIClientImpl) IsBranchMerged(ctx context.Context, repoPath, branchName string) (bool, error) {
+	// Validate input
+	if repoPath == "" {
+		return false, domain.NewGitWorktreeError("", branchName, "repository path cannot be empty", nil)
+	}
+	if branchName == "" {
+		return false, domain.NewGitWorktreeError("", "", "branch name cannot be empty", nil)
+	}
+
+	// Execute git branch --merged command
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "branch", "--merged")
+	if err != nil {
+		return false, domain.NewGitWorktreeError("", branchName, "failed to check merged status", err)
+	}
+
+	if result.ExitCode != 0 {
+		return false, domain.NewGitWorktreeError("", branchName,
+			"git branch --merged failed: "+result.Stderr, nil)
+	}
+
+	// Check if branch name appears in merged branches output
+	mergedBranches := strings.Split(result.Stdout, "\n")
+	for _, branch := range mergedBranches {
+		trimmed := strings.TrimSpace(branch)
+		// Remove leading markers: * (current branch), + (branch in another worktree)
+		trimmed = strings.TrimPrefix(trimmed, "*")
+		trimmed = strings.TrimPrefix(trimmed, "+")
+		trimmed = strings.TrimSpace(trimmed)
+		if trimmed == branchName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// LogBetween lists commits reachable from toRef but not from fromRef (git log fromRef..toRef)
+func (c *CLIClientImpl) LogBetween(ctx context.Context, repoPath, fromRef, toRef string) ([]domain.CommitInfo, error) {
+	if repoPath == "" {
+		return nil, domain.NewGitWorktreeError("", toRef, "repository path cannot be empty", nil)
+	}
+	if fromRef == "" || toRef == "" {
+		return nil, domain.NewGitWorktreeError("", toRef, "both refs are required", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "log", logFormat, fromRef+".."+toRef)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError("", toRef, "failed to list commits", err)
+	}
+
+	if result.ExitCode != 0 {
+		return nil, domain.NewGitWorktreeError("", toRef, "git log failed: "+result.Stderr, nil)
+	}
+
+	return parseLogOutput(result.Stdout), nil
+}
+
+// GetCommitHistory lists the most recent commits reachable from HEAD, newest first (git log -n)
+func (c *CLIClientImpl) GetCommitHistory(ctx context.Context, repoPath string, limit int) ([]domain.CommitInfo, error) {
+	if repoPath == "" {
+		return nil, domain.NewGitWorktreeError("", "", "repository path cannot be empty", nil)
+	}
+	if limit <= 0 {
+		return nil, domain.NewGitWorktreeError(repoPath, "", "limit must be positive", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "log", logFormat, "-n", strconv.Itoa(limit))
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(repoPath, "", "failed to read commit history", err)
+	}
+
+	if result.ExitCode != 0 {
+		return nil, domain.NewGitWorktreeError(repoPath, "", "git log failed: "+result.Stderr, nil)
+	}
+
+	return parseLogOutput(result.Stdout), nil
+}
+
+// GetLastActivity returns the HEAD commit of the worktree (git log -1)
+func (c *CLIClientImpl) GetLastActivity(ctx context.Context, worktreePath string) (*domain.CommitInfo, error) {
+	if worktreePath == "" {
+		return nil, domain.NewGitWorktreeError("", "", "worktree path cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, worktreePath, "git", c.timeout, "log", logFormat, "-n", "1")
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(worktreePath, "", "failed to read the last commit", err)
+	}
+	if result.ExitCode != 0 {
+		return nil, domain.NewGitWorktreeError(worktreePath, "", "git log failed: "+result.Stderr, nil)
+	}
+
+	commits := parseLogOutput(result.Stdout)
+	if len(commits) == 0 {
+		return nil, domain.NewGitWorktreeError(worktreePath, "", "unexpected git log output: "+result.Stdout, nil)
+	}
+	return &commits[0], nil
+}
+
+// GetCommitStat summarizes the changes made by a commit (git show --stat)
+func (c *CLIClientImpl) GetCommitStat(ctx context.Context, repoPath, commitHash string) (*domain.StatusSnapshot, error) {
+	if repoPath == "" {
+		return nil, domain.NewGitWorktreeError("", "", "repository path cannot be empty", nil)
+	}
+	if commitHash == "" {
+		return nil, domain.NewGitWorktreeError(repoPath, "", "commit hash cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout,
+		"show", "--stat=10000,10000", "--format=", commitHash)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(repoPath, "", "failed to read stats of commit "+commitHash, err)
+	}
+	if result.ExitCode != 0 {
+		return nil, domain.NewGitWorktreeError(repoPath, "", "git show failed: "+result.Stderr, nil)
+	}
+
+	stat := parseDiffStat(result.Stdout)
+	return &domain.StatusSnapshot{
+		FilesChanged: stat.FilesChanged,
+		Insertions:   stat.Insertions,
+		Deletions:    stat.Deletions,
+	}, nil
+}
+
+// GetMergeBase returns the best common ancestor of two refs (git merge-base)
+func (c *CLIClientImpl) GetMergeBase(ctx context.Context, repoPath, branch, ref string) (string, error) {
+	if repoPath == "" {
+		return "", domain.NewGitWorktreeError("", branch, "repository path cannot be empty", nil)
+	}
+	if branch == "" || ref == "" {
+		return "", domain.NewGitWorktreeError("", branch, "both refs are required", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "merge-base", branch, ref)
+	// merge-base exits 1 when the refs share no history
+	if result != nil && result.ExitCode == 1 {
+		return "", nil
+	}
+	if err != nil {
+		return "", domain.NewGitWorktreeError("", branch, "failed to find merge base with "+ref, err)
+	}
+	if result.ExitCode != 0 {
+		return "", domain.NewGitWorktreeError("", branch, "git merge-base failed: "+result.Stderr, nil)
+	}
+
+	return strings.TrimSpace(result.Stdout), nil
+}
+
+// GetUpstreamBranch returns the tracking branch of branch, e.g. origin/feature (git rev-parse --abbrev-ref <branch>@{upstream})
+func (c *CLIClientImpl) GetUpstreamBranch(ctx context.Context, repoPath, branch string) (string, error) {
+	if repoPath == "" {
+		return "", domain.NewGitWorktreeError("", branch, "repository path cannot be empty", nil)
+	}
+	if branch == "" {
+		return "", domain.NewGitWorktreeError(repoPath, "", "branch name cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "rev-parse", "--abbrev-ref", branch+"@{upstream}")
+	// git rev-parse fails with "no upstream configured" for branches that track nothing
+	if result != nil && result.ExitCode != 0 && strings.Contains(result.Stderr, "no upstream configured") {
+		return "", nil
+	}
+	if err != nil {
+		return "", domain.NewGitWorktreeError(repoPath, branch, "failed to resolve the upstream branch", err)
+	}
+	if result.ExitCode != 0 {
+		return "", domain.NewGitWorktreeError(repoPath, branch, "git rev-parse failed: "+result.Stderr, nil)
+	}
+
+	return strings.TrimSpace(result.Stdout), nil
+}
+
+// GetBranchRelationship counts the commits branch and base do not share (git rev-list --left-right --count)
+func (c *CLIClientImpl) GetBranchRelationship(ctx context.Context, repoPath, branch, base string) (*domain.BranchRelationship, error) {
+	if repoPath == "" {
+		return nil, domain.NewGitWorktreeError("", branch, "repository path cannot be empty", nil)
+	}
+	if branch == "" || base == "" {
+		return nil, domain.NewGitWorktreeError("", branch, "both refs are required", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout,
+		"rev-list", "--left-right", "--count", base+"..."+branch)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError("", branch, "failed to compare with "+base, err)
+	}
+	if result.ExitCode != 0 {
+		return nil, domain.NewGitWorktreeError("", branch, "git rev-list failed: "+result.Stderr, nil)
+	}
+
+	// Left side: commits only reachable from base; right side: only from branch
+	fields := strings.Fields(result.Stdout)
+	if len(fields) != 2 {
+		return nil, domain.NewGitWorktreeError("", branch, "unexpected git rev-list output: "+result.Stdout, nil)
+	}
+	behind, errBehind := strconv.Atoi(fields[0])
+	ahead, errAhead := strconv.Atoi(fields[1])
+	if errBehind != nil || errAhead != nil {
+		return nil, domain.NewGitWorktreeError("", branch, "unexpected git rev-list output: "+result.Stdout, nil)
+	}
+
+	return &domain.BranchRelationship{Branch: branch, Base: base, AheadCount: ahead, BehindCount: behind}, nil
+}
+
+// CompareWorktrees computes diff statistics between two branches (git diff --stat branch1..branch2)
+func (c *CLIClientImpl) CompareWorktrees(ctx context.Context, repoPath, branch1, branch2 string) (*domain.WorktreeComparison, error) {
+	if repoPath == "" {
+		return nil, domain.NewGitWorktreeError("", branch1, "repository path cannot be empty", nil)
+	}
+	if branch1 == "" || branch2 == "" {
+		return nil, domain.NewGitWorktreeError("", branch1, "both branches are required", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout,
+		"diff", "--stat=10000,10000", "--summary", branch1+".."+branch2)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError("", branch1, "failed to compare branches", err)
+	}
+	if result.ExitCode != 0 {
+		return nil, domain.NewGitWorktreeError("", branch1, "git diff failed: "+result.Stderr, nil)
+	}
+
+	comparison := parseDiffStat(result.Stdout)
+	comparison.Base = branch1
+	comparison.Target = branch2
+
+	// merge-base exits 1 when the branches share no history
+	mergeBase, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "merge-base", branch1, branch2)
+	comparison.HasCommonHistory = err == nil && mergeBase != nil && mergeBase.ExitCode == 0
+
+	return comparison, nil
+}
+
+// GetConflictingFiles lists unmerged paths in the worktree (git status --porcelain -z)
+func (c *CLIClientImpl) GetConflictingFiles(ctx context.Context, worktreePath string) ([]domain.ConflictFile, error) {
+	if worktreePath == "" {
+		return nil, domain.NewGitWorktreeError("", "", "worktree path cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, worktreePath, "git", c.timeout, "status", "--porcelain", "-z")
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(worktreePath, "", "failed to get status", err)
+	}
+	if result.ExitCode != 0 {
+		return nil, domain.NewGitWorktreeError(worktreePath, "", "git status failed: "+result.Stderr, nil)
+	}
+
+	return parseConflictStatus(result.Stdout), nil
+}
+
+// conflictSides maps unmerged porcelain status codes to our and their side of the conflict
+var conflictSides = map[string][2]string{
+	"UU": {"modified", "modified"},
+	"AA": {"added", "added"},
+	"DD": {"deleted", "deleted"},
+	"AU": {"added", "modified"},
+	"UA": {"modified", "added"},
+	"DU": {"deleted", "modified"},
+	"UD": {"modified", "deleted"},
+}
+
+// parseConflictStatus extracts unmerged entries from NUL-separated git status --porcelain -z output
+func parseConflictStatus(output string) []domain.ConflictFile {
+	conflicts := []domain.ConflictFile{}
+	entries := strings.Split(output, "\x00")
+
+	for i := 0; i < len(entries); i++ {
+		entry := entries[i]
+		if len(entry) < 4 {
+			continue
+		}
+
+		code := entry[:2]
+		// Renames and copies are followed by their source path as a separate entry
+		if code[0] == 'R' || code[0] == 'C' {
+			i++
+			continue
+		}
+
+		if sides, ok := conflictSides[code]; ok {
+			conflicts = append(conflicts, domain.ConflictFile{
+				Path:        entry[3:],
+				OurStatus:   sides[0],
+				TheirStatus: sides[1],
+			})
+		}
+	}
+
+	return conflicts
+}
+
+// garbageCollectTimeout bounds git gc, which can take much longer than other commands
+const garbageCollectTimeout = 10 * time.Minute
+
+// GetObjectStats reports object counts and sizes (git count-objects -v).
+// The non-human-readable form is used so sizes are exact KiB values.
+func (c *CLIClientImpl) GetObjectStats(ctx context.Context, repoPath string) (*domain.ObjectStats, error) {
+	if repoPath == "" {
+		return nil, domain.NewGitRepositoryError("", "repository path cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "count-objects", "-v")
+	if err != nil {
+		return nil, domain.NewGitRepositoryError(repoPath, "failed to count objects", err)
+	}
+	if result.ExitCode != 0 {
+		return nil, domain.NewGitRepositoryError(repoPath, "git count-objects failed: "+result.Stderr, nil)
+	}
+
+	return parseCountObjects(result.Stdout), nil
+}
+
+// parseCountObjects parses git count-objects -v output; size fields are converted from KiB to bytes
+func parseCountObjects(output string) *domain.ObjectStats {
+	stats := &domain.ObjectStats{}
+	fields := map[string]*int64{
+		"count":          &stats.Count,
+		"size":           &stats.Size,
+		"in-pack":        &stats.InPack,
+		"packs":          &stats.Packs,
+		"size-pack":      &stats.SizePack,
+		"prune-packable": &stats.PrunePackable,
+		"garbage":        &stats.Garbage,
+		"size-garbage":   &stats.SizeGarbage,
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		target, ok := fields[key]
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			continue
+		}
+		if strings.HasPrefix(key, "size") {
+			n *= 1024
+		}
+		*target = n
+	}
+
+	return stats
+}
+
+// GarbageCollect runs git gc in the repository
+func (c *CLIClientImpl) GarbageCollect(ctx context.Context, repoPath string) error {
+	if repoPath == "" {
+		return domain.NewGitRepositoryError("", "repository path cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", garbageCollectTimeout, "gc", "--quiet")
+	if err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to run git gc", err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitRepositoryError(repoPath, "git gc failed: "+result.Stderr, nil)
+	}
+	return nil
+}
+
+// SetConfig sets a config value scoped to the worktree (git config --worktree).
+// A plain --local write from a linked worktree would land in the shared repository
+// config, so the worktreeConfig extension is enabled first.
+func (c *CLIClientImpl) SetConfig(ctx context.Context, worktreePath, key, value string) error {
+	if worktreePath == "" {
+		return domain.NewGitWorktreeError("", "", "worktree path cannot be empty", nil)
+	}
+	if key == "" {
+		return domain.NewGitWorktreeError(worktreePath, "", "config key cannot be empty", nil)
+	}
+
+	for _, args := range [][]string{
+		{"config", "extensions.worktreeConfig", "true"},
+		{"config", "--worktree", key, value},
+	} {
+		result, err := c.executor.ExecuteWithTimeout(ctx, worktreePath, "git", c.timeout, args...)
+		if err != nil {
+			return domain.NewGitWorktreeError(worktreePath, "", "failed to set config "+key, err)
+		}
+		if result.ExitCode != 0 {
+			return domain.NewGitWorktreeError(worktreePath, "", "git config failed: "+result.Stderr, nil)
+		}
+	}
+	return nil
+}
+
+// GetGlobalConfig reads a value from the global git config (git config --global --get)
+func (c *CLIClientImpl) GetGlobalConfig(ctx context.Context, key string) (string, error) {
+	if key == "" {
+		return "", domain.NewGitRepositoryError("", "config key cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, "", "git", c.timeout, "config", "--global", "--get", key)
+	// git config exits 1 when the key is not set
+	if result != nil && result.ExitCode == 1 {
+		return "", nil
+	}
+	if err != nil {
+		return "", domain.NewGitRepositoryError("", "failed to read global config "+key, err)
+	}
+	if result.ExitCode != 0 {
+		return "", domain.NewGitRepositoryError("", "git config failed: "+result.Stderr, nil)
+	}
+
+	return strings.TrimSpace(result.Stdout), nil
+}
+
+// GetConfig reads a config value visible in the repository, from any scope (git config --get)
+func (c *CLIClientImpl) GetConfig(ctx context.Context, repoPath, key string) (string, error) {
+	if repoPath == "" {
+		return "", domain.NewGitRepositoryError("", "repository path cannot be empty", nil)
+	}
+	if key == "" {
+		return "", domain.NewGitRepositoryError(repoPath, "config key cannot be empty", nil)
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "config", "--get", key)
+	// git config exits 1 when the key is not set
+	if result != nil && result.ExitCode == 1 {
+		return "", nil
+	}
+	if err != nil {
+		return "", domain.NewGitRepositoryError(repoPath, "failed to read config "+key, err)
+	}
+	if result.ExitCode != 0 {
+		return "", domain.NewGitRepositoryError(repoPath, "git config failed: "+result.Stderr, nil)
+	}
+
+	return strings.TrimSpace(result.Stdout), nil
+}
+
+// GetHooksDir returns the directory git runs hooks from
+func (c *CLIClientImpl) GetHooksDir(ctx context.Context, repoPath string) (string, error) {
+	dir, _, err := c.hooksDir(ctx, repoPath)
+	return dir, err
+}
+
+// hooksDir resolves the hooks directory and reports whether it comes from core.hooksPath.
+// A relative core.hooksPath is relative to the working tree, where git runs hooks.
+func (c *CLIClientImpl) hooksDir(ctx context.Context, repoPath string) (string, bool, error) {
+	hooksPath, err := c.GetConfig(ctx, repoPath, "core.hooksPath")
+	if err != nil {
+		return "", false, err
+	}
+	if hooksPath != "" {
+		if rest, ok := strings.CutPrefix(hooksPath, "~/"); ok {
+			if home, err := os.UserHomeDir(); err == nil {
+				hooksPath = filepath.Join(home, rest)
+			}
+		}
+		if !filepath.IsAbs(hooksPath) {
+			hooksPath = filepath.Join(repoPath, hooksPath)
+		}
+		return hooksPath, true, nil
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "rev-parse", "--git-common-dir")
+	if err != nil {
+		return "", false, domain.NewGitRepositoryError(repoPath, "failed to locate git directory", err)
+	}
+	if result.ExitCode != 0 {
+		return "", false, domain.NewGitRepositoryError(repoPath, "git rev-parse failed: "+result.Stderr, nil)
+	}
+
+	commonDir := strings.TrimSpace(result.Stdout)
+	if !filepath.IsAbs(commonDir) {
+		commonDir = filepath.Join(repoPath, commonDir)
+	}
+	return filepath.Join(commonDir, "hooks"), false, nil
+}
+
+// ValidateHooks checks every hook in the hooks directory; *.sample files are ignored like git does.
+// A missing default hooks directory means no hooks, a missing core.hooksPath is an issue.
+func (c *CLIClientImpl) ValidateHooks(ctx context.Context, repoPath string) []domain.HookIssue {
+	dir, configured, err := c.hooksDir(ctx, repoPath)
+	if err != nil {
+		return []domain.HookIssue{{Issue: "cannot locate hooks directory: " + err.Error()}}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		if configured {
+			return []domain.HookIssue{{Issue: "core.hooksPath points to missing directory " + dir}}
+		}
+		return nil
+	}
+	if err != nil {
+		return []domain.HookIssue{{Issue: "cannot read hooks directory: " + err.Error()}}
+	}
+
+	var issues []domain.HookIssue
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasSuffix(entry.Name(), ".sample") {
+			continue
+		}
+		if issue := checkHookFile(filepath.Join(dir, entry.Name())); issue != "" {
+			issues = append(issues, domain.HookIssue{HookName: entry.Name(), Issue: issue})
+		}
+	}
+	return issues
+}
+
+// checkHookFile returns why git cannot run the hook, or "" when it looks runnable
+func checkHookFile(path string) string {
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "broken symlink"
+		}
+		return err.Error()
+	}
+	// Git for Windows does not use the executable bit and resolves interpreters itself
+	if runtime.GOOS == "windows" {
+		return ""
+	}
+	if info.Mode().Perm()&0111 == 0 {
+		return "not executable (run chmod +x " + path + ")"
+	}
+
+	interpreter := hookInterpreter(path)
+	if interpreter == "" {
+		return ""
+	}
+	if filepath.IsAbs(interpreter) {
+		if _, err := os.Stat(interpreter); err != nil {
+			return "interpreter " + interpreter + " not found"
+		}
+		return ""
+	}
+	if _, err := exec.LookPath(interpreter); err != nil {
+		return "interpreter " + interpreter + " not found in PATH"
+	}
+	return ""
+}
+
+// hookInterpreter returns the program named by the hook's #! line ("" without one);
+// for "#!/usr/bin/env [-S] prog" it is prog
+func hookInterpreter(path string) string {
+	f, err := os.Open(path) // #nosec G304 -- hook file inside the repository's hooks directory
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	line, _ := bufio.NewReader(f).ReadString('\n')
+	rest, ok := strings.CutPrefix(line, "#!")
+	if !ok {
+		return ""
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return ""
+	}
+	if filepath.Base(fields[0]) != "env" {
+		return fields[0]
+	}
+	for _, field := range fields[1:] {
+		if !strings.HasPrefix(field, "-") && !strings.Contains(field, "=") {
+			return field
+		}
+	}
+	return ""
+}
+
+// GetAllLocalConfig reads every repository and worktree-scoped config value visible from the worktree.
+// git config --local alone misses config.worktree, so --worktree is read second and wins on conflicts;
+// without the worktreeConfig extension both scopes read the same file.
+func (c *CLIClientImpl) GetAllLocalConfig(ctx context.Context, worktreePath string) (map[string]string, error) {
+	if worktreePath == "" {
+		return nil, domain.NewGitWorktreeError("", "", "worktree path cannot be empty", nil)
+	}
+
+	config := make(map[string]string)
+	for _, scope := range []string{"--local", "--worktree"} {
+		result, err := c.executor.ExecuteWithTimeout(ctx, worktreePath, "git", c.timeout, "config", scope, "--list", "--null")
+		if err != nil {
+			return nil, domain.NewGitWorktreeError(worktreePath, "", "failed to list config", err)
+		}
+		if result.ExitCode != 0 {
+			return nil, domain.NewGitWorktreeError(worktreePath, "", "git config failed: "+result.Stderr, nil)
+		}
+		for key, value := range parseConfigList(result.Stdout) {
+			config[key] = value
+		}
+	}
+	return config, nil
+}
+
+// parseConfigList parses git config --list --null output: entries end with NUL and the key is
+// separated from the value by the first newline. A key without a newline is an implicit true.
+func parseConfigList(output string) map[string]string {
+	config := make(map[string]string)
+	for _, entry := range strings.Split(output, "\x00") {
+		if entry == "" {
+			continue
+		}
+		key, value, found := strings.Cut(entry, "\n")
+		if !found {
+			value = "true"
+		}
+		config[key] = value
+	}
+	return config
+}
+
+// GetBranchDescription reads branch.<branch>.description, the value git branch --edit-description writes
+func (c *CLIClientImpl) GetBranchDescription(ctx context.Context, repoPath, branch string) (string, error) {
+	if repoPath == "" {
+		return "", domain.NewGitRepositoryError("", "repository path cannot be empty", nil)
+	}
+	if branch == "" {
+		return "", domain.NewGitRepositoryError(repoPath, "branch name cannot be empty", nil)
+	}
+
+	key := branchDescriptionKey(branch)
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "config", "--get", key)
+	// git config exits 1 when the key is not set
+	if result != nil && result.ExitCode == 1 {
+		return "", nil
+	}
+	if err != nil {
+		return "", domain.NewGitRepositoryError(repoPath, "failed to read "+key, err)
+	}
+	if result.ExitCode != 0 {
+		return "", domain.NewGitRepositoryError(repoPath, "git config failed: "+result.Stderr, nil)
+	}
+
+	return strings.TrimSpace(result.Stdout), nil
+}
+
+// SetBranchDescription writes branch.<branch>.description in the repository config.
+// An empty description unsets the key; unsetting a missing key is not an error.
+func (c *CLIClientImpl) SetBranchDescription(ctx context.Context, repoPath, branch, description string) error {
+	if repoPath == "" {
+		return domain.NewGitRepositoryError("", "repository path cannot be empty", nil)
+	}
+	if branch == "" {
+		return domain.NewGitRepositoryError(repoPath, "branch name cannot be empty", nil)
+	}
+
+	key := branchDescriptionKey(branch)
+	args := []string{"config", key, description}
+	if description == "" {
+		args = []string{"config", "--unset", key}
+	}
+
+	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, args...)
+	// git config --unset exits 5 when the key is not set
+	if description == "" && result != nil && result.ExitCode == 5 {
+		return nil
+	}
+	if err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to write "+key, err)
+	}
+	if result.ExitCode != 0 {
+		return domain.NewGitRepositoryError(repoPath, "git config failed: "+result.Stderr, nil)
+	}
+	return nil
+}
+
+// branchDescriptionKey returns the config key holding the branch description
+func branchDescriptionKey(branch string) string {
+	return "branch." + branch + ".description"
+}
+
+// parseWorktreeList parses the output of `git worktree list --porcelain`.
+// Records start with a worktree line; unknown attributes (e.g. prunable) are ignored.
+func parseWorktreeList(output string) []domain.GitWorktreeEntry {
+	var entries []domain.GitWorktreeEntry
+	var current *domain.GitWorktreeEntry
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if path, ok := strings.CutPrefix(line, "worktree "); ok {
+			if current != nil {
+				entries = append(entries, *current)
+			}
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				absPath = path // Use original path if conversion fails
+			}
+			current = &domain.GitWorktreeEntry{Path: absPath}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "HEAD "):
+			current.HEAD = strings.TrimPrefix(line, "HEAD ")
+		case strings.HasPrefix(line, "branch "):
+			current.Branch = strings.TrimPrefix(strings.TrimPrefix(line, "branch "), "refs/heads/")
+			current.IsDetached = false
+		case line == "detached":
+			current.IsDetached = true
+		case line == "bare":
+			current.IsBare = true
+		case line == "locked" || strings.HasPrefix(line, "locked "):
+			current.Locked = true
+			current.LockReason = strings.TrimPrefix(strings.TrimPrefix(line, "locked"), " ")
+		}
+	}
+
+	if current != nil {
+		entries = append(entries, *current)
+	}
+	return entries
 }
 
-// branchExists checks if a branch exists using git CLI
-func (c *CLIClientImpl) branchExists(ctx context.Context, repoPath, branchName string) (bool, error) {
+// BranchExists checks if a local branch exists using git CLI
+func (c *CLIClientImpl) BranchExists(ctx context.Context, repoPath, branchName string) (bool, error) {
 	// Use git show-ref to check if branch exists
 	result, err := c.executor.ExecuteWithTimeout(ctx, repoPath, "git", c.timeout, "show-ref", "--verify", "--quiet", "refs/heads/"+branchName)
 	if err != nil {
@@ -2,8 +2,13 @@ package infrastructure
 
 import (
 	"context"
+	"errors"
 	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -236,6 +241,87 @@ func TestCLIClient_PruneWorktrees(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCLIClient_LockWorktree(t *testing.T) {
+	tests := []struct {
+		name        string
+		reason      string
+		args        []string
+		mockResult  *CommandResult
+		errContains string
+	}{
+		{
+			name:       "with reason",
+			reason:     "filter-repo",
+			args:       []string{"worktree", "lock", "--reason", "filter-repo", "/test/wt"},
+			mockResult: &CommandResult{ExitCode: 0},
+		},
+		{
+			name:       "without reason",
+			args:       []string{"worktree", "lock", "/test/wt"},
+			mockResult: &CommandResult{ExitCode: 0},
+		},
+		{
+			name:        "already locked",
+			args:        []string{"worktree", "lock", "/test/wt"},
+			mockResult:  &CommandResult{ExitCode: 128, Stderr: "fatal: '/test/wt' is already locked"},
+			errContains: "git worktree lock failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExecutor := NewMockCommandExecutor()
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"), tt.args).Return(tt.mockResult, nil)
+			client := NewCLIClient(mockExecutor)
+
+			err := client.LockWorktree(context.Background(), "/test/repo", "/test/wt", tt.reason)
+
+			if tt.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+
+	t.Run("empty worktree path", func(t *testing.T) {
+		err := NewCLIClient(NewMockCommandExecutor()).LockWorktree(context.Background(), "/test/repo", "", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "worktree path cannot be empty")
+	})
+}
+
+func TestCLIClient_UnlockWorktree(t *testing.T) {
+	mockExecutor := NewMockCommandExecutor()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"), []string{"worktree", "unlock", "/test/wt"}).Return(&CommandResult{ExitCode: 0}, nil)
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"), []string{"worktree", "unlock", "/test/other"}).Return(&CommandResult{ExitCode: 128, Stderr: "fatal: '/test/other' is not locked"}, nil)
+	client := NewCLIClient(mockExecutor)
+
+	require.NoError(t, client.UnlockWorktree(context.Background(), "/test/repo", "/test/wt"))
+
+	err := client.UnlockWorktree(context.Background(), "/test/repo", "/test/other")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is not locked")
+}
+
+func TestCLIClient_RepairWorktrees(t *testing.T) {
+	mockExecutor := NewMockCommandExecutor()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"worktree", "repair", "/wt/a", "/wt/b"}).Return(&CommandResult{ExitCode: 0}, nil).Once()
+	client := NewCLIClient(mockExecutor)
+
+	require.NoError(t, client.RepairWorktrees(context.Background(), "/test/repo", []string{"/wt/a", "/wt/b"}))
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"worktree", "repair", "/wt/a"}).Return(&CommandResult{ExitCode: 1, Stderr: "error: not a valid path"}, nil).Once()
+	err := client.RepairWorktrees(context.Background(), "/test/repo", []string{"/wt/a"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "git worktree repair failed")
+
+	require.Error(t, client.RepairWorktrees(context.Background(), "", nil))
+}
+
 func TestCLIClient_IsBranchMerged(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -285,122 +371,1483 @@ func TestCLIClient_IsBranchMerged(t *testing.T) {
 	}
 }
 
-func TestCLIClient_DeleteBranch(t *testing.T) {
+func TestCLIClient_FetchTagsOnly(t *testing.T) {
 	tests := []struct {
 		name        string
-		repoPath    string
-		branchName  string
 		mockResult  *CommandResult
-		mockError   error
-		expectErr   bool
 		errContains string
 	}{
 		{
-			name:       "successful deletion",
-			repoPath:   "/test/repo",
-			branchName: "feature-branch",
-			mockResult: &CommandResult{ExitCode: 0, Stdout: "", Stderr: ""},
-			expectErr:  false,
+			name:       "fetches tag refs only",
+			mockResult: &CommandResult{ExitCode: 0},
 		},
 		{
-			name:        "empty repo path",
-			repoPath:    "",
-			branchName:  "feature",
-			expectErr:   true,
-			errContains: "repository path cannot be empty",
+			name:        "unknown remote",
+			mockResult:  &CommandResult{ExitCode: 128, Stderr: "fatal: 'upstream' does not appear to be a git repository"},
+			errContains: "git fetch of tags failed",
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExecutor := NewMockCommandExecutor()
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+				[]string{"fetch", "upstream", "refs/tags/*:refs/tags/*"}).Return(tt.mockResult, nil)
+			client := NewCLIClient(mockExecutor)
+
+			err := client.FetchTagsOnly(context.Background(), "/test/repo", "upstream")
+
+			if tt.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+
+	t.Run("empty remote", func(t *testing.T) {
+		err := NewCLIClient(NewMockCommandExecutor()).FetchTagsOnly(context.Background(), "/test/repo", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "remote name cannot be empty")
+	})
+}
+
+func TestCLIClient_FetchBranch(t *testing.T) {
+	mockExecutor := NewMockCommandExecutor()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"fetch", "origin", "production"}).Return(&CommandResult{ExitCode: 0}, nil).Once()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"fetch", "origin", "gone"}).Return(&CommandResult{ExitCode: 128, Stderr: "fatal: couldn't find remote ref gone"}, nil).Once()
+	client := NewCLIClient(mockExecutor)
+
+	require.NoError(t, client.FetchBranch(context.Background(), "/test/repo", "origin", "production"))
+
+	err := client.FetchBranch(context.Background(), "/test/repo", "origin", "gone")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "couldn't find remote ref gone")
+	mockExecutor.AssertExpectations(t)
+
+	err = client.FetchBranch(context.Background(), "/test/repo", "origin", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "branch name cannot be empty")
+}
+
+func TestCLIClient_FetchAllRemotes(t *testing.T) {
+	mockExecutor := NewMockCommandExecutor()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"fetch", "--all"}).Return(&CommandResult{ExitCode: 0}, nil).Once()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/offline", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"fetch", "--all"}).Return(&CommandResult{ExitCode: 1, Stderr: "error: could not fetch origin"}, nil).Once()
+	client := NewCLIClient(mockExecutor)
+
+	require.NoError(t, client.FetchAllRemotes(context.Background(), "/test/repo"))
+
+	err := client.FetchAllRemotes(context.Background(), "/test/offline")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "could not fetch origin")
+	mockExecutor.AssertExpectations(t)
+
+	err = client.FetchAllRemotes(context.Background(), "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "repository path cannot be empty")
+}
+
+func TestCLIClient_Pull(t *testing.T) {
+	head := func(m *MockCommandExecutor, dir string, commits ...string) {
+		for _, commit := range commits {
+			m.On("ExecuteWithTimeout", mock.Anything, dir, "git", mock.AnythingOfType("time.Duration"),
+				[]string{"rev-parse", "HEAD"}).Return(&CommandResult{Stdout: commit + "\n"}, nil).Once()
+		}
+	}
+
+	t.Run("counts the commits HEAD moved by", func(t *testing.T) {
+		mockExecutor := NewMockCommandExecutor()
+		head(mockExecutor, "/wt", "aaa", "bbb")
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/wt", "git", mock.AnythingOfType("time.Duration"),
+			[]string{"pull", "--rebase"}).Return(&CommandResult{}, nil).Once()
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/wt", "git", mock.AnythingOfType("time.Duration"),
+			[]string{"rev-list", "--count", "aaa..bbb"}).Return(&CommandResult{Stdout: "3\n"}, nil).Once()
+
+		commits, err := NewCLIClient(mockExecutor).Pull(context.Background(), "/wt", true)
+		require.NoError(t, err)
+		assert.Equal(t, 3, commits)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("unchanged HEAD is no commits", func(t *testing.T) {
+		mockExecutor := NewMockCommandExecutor()
+		head(mockExecutor, "/wt", "aaa", "aaa")
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/wt", "git", mock.AnythingOfType("time.Duration"),
+			[]string{"pull", "--no-rebase"}).Return(&CommandResult{Stdout: "Already up to date.\n"}, nil).Once()
+
+		commits, err := NewCLIClient(mockExecutor).Pull(context.Background(), "/wt", false)
+		require.NoError(t, err)
+		assert.Zero(t, commits)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("conflict is aborted", func(t *testing.T) {
+		mockExecutor := NewMockCommandExecutor()
+		head(mockExecutor, "/wt", "aaa")
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/wt", "git", mock.AnythingOfType("time.Duration"),
+			[]string{"pull", "--rebase"}).
+			Return(&CommandResult{ExitCode: 1, Stdout: "CONFLICT (content): Merge conflict in main.go\n"}, errors.New("exit status 1")).Once()
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/wt", "git", mock.AnythingOfType("time.Duration"),
+			[]string{"rebase", "--abort"}).Return(&CommandResult{}, nil).Once()
+
+		_, err := NewCLIClient(mockExecutor).Pull(context.Background(), "/wt", true)
+		require.ErrorIs(t, err, domain.ErrConflict)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("interrupted pull is aborted without the cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		mockExecutor := NewMockCommandExecutor()
+		head(mockExecutor, "/wt", "aaa")
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/wt", "git", mock.AnythingOfType("time.Duration"),
+			[]string{"pull", "--rebase"}).Run(func(mock.Arguments) { cancel() }).
+			Return(&CommandResult{ExitCode: -1}, context.Canceled).Once()
+		mockExecutor.On("ExecuteWithTimeout", mock.MatchedBy(func(ctx context.Context) bool { return ctx.Err() == nil }), "/wt", "git",
+			pullAbortTimeout, []string{"rebase", "--abort"}).Return(&CommandResult{}, nil).Once()
+
+		_, err := NewCLIClient(mockExecutor).Pull(ctx, "/wt", true)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.NotErrorIs(t, err, domain.ErrConflict)
+		mockExecutor.AssertExpectations(t)
+	})
+
+	t.Run("failed pull", func(t *testing.T) {
+		mockExecutor := NewMockCommandExecutor()
+		head(mockExecutor, "/wt", "aaa")
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/wt", "git", mock.AnythingOfType("time.Duration"),
+			[]string{"pull", "--rebase"}).Return(&CommandResult{ExitCode: 1, Stderr: "There is no tracking information for the current branch."}, nil).Once()
+
+		_, err := NewCLIClient(mockExecutor).Pull(context.Background(), "/wt", true)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no tracking information")
+		assert.NotErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("empty path", func(t *testing.T) {
+		_, err := NewCLIClient(NewMockCommandExecutor()).Pull(context.Background(), "", true)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "worktree path cannot be empty")
+	})
+}
+
+func TestCLIClient_ResolveRevision(t *testing.T) {
+	mockExecutor := NewMockCommandExecutor()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"rev-parse", "--verify", "--quiet", "v1.0^{commit}"}).Return(&CommandResult{Stdout: "3f2a9c1e\n"}, nil).Once()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"rev-parse", "--verify", "--quiet", "missing^{commit}"}).Return(&CommandResult{ExitCode: 1}, errors.New("exit status 1")).Once()
+	client := NewCLIClient(mockExecutor)
+
+	hash, err := client.ResolveRevision(context.Background(), "/test/repo", "v1.0")
+	require.NoError(t, err)
+	assert.Equal(t, "3f2a9c1e", hash)
+
+	_, err = client.ResolveRevision(context.Background(), "/test/repo", "missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to resolve revision missing")
+	mockExecutor.AssertExpectations(t)
+}
+
+func TestParsePorcelainStatus(t *testing.T) {
+	testCases := []struct {
+		name     string
+		output   string
+		expected domain.RepositoryStatus
+	}{
 		{
-			name:        "empty branch name",
-			repoPath:    "/test/repo",
-			branchName:  "",
-			expectErr:   true,
-			errContains: "branch name cannot be empty",
+			name:     "clean branch with upstream",
+			output:   "# branch.oid 3f2a9c1e\n# branch.head main\n# branch.upstream origin/main\n# branch.ab +2 -1\n",
+			expected: domain.RepositoryStatus{IsClean: true, Branch: "main", Commit: "3f2a9c1e", Ahead: 2, Behind: 1},
 		},
 		{
-			name:       "branch not found - idempotent",
-			repoPath:   "/test/repo",
-			branchName: "non-existent",
-			mockResult: &CommandResult{ExitCode: 1, Stdout: "", Stderr: "error: branch 'non-existent' not found"},
-			expectErr:  false,
+			name: "changed files",
+			output: "# branch.oid 3f2a9c1e\n# branch.head feature\n" +
+				"1 .M N... 100644 100644 100644 aaa aaa main.go\n" +
+				"1 A. N... 000000 100644 100644 000 bbb new file.go\n" +
+				"1 .D N... 100644 100644 000000 ccc ccc old.go\n" +
+				"2 R. N... 100644 100644 100644 ddd ddd R100 renamed.go\toriginal.go\n" +
+				"u UU N... 100644 100644 100644 100644 eee fff 000 conflict.go\n" +
+				"? notes.txt\n",
+			expected: domain.RepositoryStatus{
+				Branch: "feature", Commit: "3f2a9c1e",
+				Modified:  []string{"main.go", "renamed.go", "conflict.go"},
+				Added:     []string{"new file.go"},
+				Deleted:   []string{"old.go"},
+				Untracked: []string{"notes.txt"},
+			},
 		},
 		{
-			name:        "git command fails",
-			repoPath:    "/test/repo",
-			branchName:  "feature",
-			mockResult:  &CommandResult{ExitCode: 1, Stdout: "", Stderr: "error: some error"},
-			expectErr:   true,
-			errContains: "git branch -D failed",
+			name:     "detached HEAD in a new repository",
+			output:   "# branch.oid (initial)\n# branch.head (detached)\n",
+			expected: domain.RepositoryStatus{IsClean: true, Branch: "HEAD"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, parsePorcelainStatus(tc.output))
+		})
+	}
+}
+
+func TestCLIClient_Fsck(t *testing.T) {
+	mockExecutor := NewMockCommandExecutor()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"fsck", "--no-dangling"}).Return(&CommandResult{ExitCode: 0}, nil).Once()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/broken", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"fsck", "--no-dangling"}).Return(&CommandResult{ExitCode: 2, Stderr: "error: HEAD: invalid sha1 pointer\n"}, nil).Once()
+	client := NewCLIClient(mockExecutor)
+
+	require.NoError(t, client.Fsck(context.Background(), "/test/repo"))
+
+	err := client.Fsck(context.Background(), "/test/broken")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid sha1 pointer")
+	mockExecutor.AssertExpectations(t)
+
+	err = client.Fsck(context.Background(), "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "repository path cannot be empty")
+}
+
+func TestCLIClient_RenameBranchAndMoveWorktree(t *testing.T) {
+	mockExecutor := NewMockCommandExecutor()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"branch", "-m", "old", "new"}).Return(&CommandResult{ExitCode: 0}, nil).Once()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"worktree", "move", "/wt/old", "/wt/new"}).Return(&CommandResult{ExitCode: 128, Stderr: "fatal: cannot move a locked working tree"}, nil).Once()
+	client := NewCLIClient(mockExecutor)
+
+	require.NoError(t, client.RenameBranch(context.Background(), "/test/repo", "old", "new"))
+
+	err := client.MoveWorktree(context.Background(), "/test/repo", "/wt/old", "/wt/new")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot move a locked working tree")
+	mockExecutor.AssertExpectations(t)
+
+	err = client.RenameBranch(context.Background(), "/test/repo", "old", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "branch names cannot be empty")
+}
+
+func TestCLIClient_PruneTags(t *testing.T) {
+	mockExecutor := NewMockCommandExecutor()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"fetch", "--prune", "--prune-tags"}).Return(&CommandResult{ExitCode: 0}, nil).Once()
+	client := NewCLIClient(mockExecutor)
+
+	require.NoError(t, client.PruneTags(context.Background(), "/test/repo"))
+	mockExecutor.AssertExpectations(t)
+
+	err := client.PruneTags(context.Background(), "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "repository path cannot be empty")
+}
+
+func TestCLIClient_GetStashList(t *testing.T) {
+	mockExecutor := NewMockCommandExecutor()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"stash", "list", "--format=%gd%x00%gs"}).Return(&CommandResult{
+		ExitCode: 0,
+		Stdout:   "stash@{0}\x00WIP on main: abc123 fix\nstash@{1}\x00On feature: half-done\n",
+	}, nil)
+	client := NewCLIClient(mockExecutor)
+
+	entries, err := client.GetStashList(context.Background(), "/test/repo")
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.StashEntry{
+		{Index: 0, Message: "WIP on main: abc123 fix"},
+		{Index: 1, Message: "On feature: half-done"},
+	}, entries)
+}
+
+func TestParseStashList(t *testing.T) {
+	assert.Empty(t, parseStashList(""))
+	assert.Equal(t, []domain.StashEntry{{Index: 2, Message: "On main: x"}},
+		parseStashList("garbage\nstash@{x}\x00bad index\nstash@{2}\x00On main: x"))
+}
+
+func TestCLIClient_ApplyStash(t *testing.T) {
+	tests := []struct {
+		name        string
+		resolve     *CommandResult
+		apply       *CommandResult
+		applyErr    error
+		errContains string
+		conflict    bool
+	}{
+		{
+			name:    "applies the resolved stash commit",
+			resolve: &CommandResult{ExitCode: 0, Stdout: "deadbeef\n"},
+			apply:   &CommandResult{ExitCode: 0},
+		},
+		{
+			name:        "missing stash entry",
+			resolve:     &CommandResult{ExitCode: 1},
+			errContains: "stash@{1} does not exist",
+		},
+		{
+			name:        "conflicts",
+			resolve:     &CommandResult{ExitCode: 0, Stdout: "deadbeef\n"},
+			apply:       &CommandResult{ExitCode: 1, Stdout: "CONFLICT (content): Merge conflict in a.txt"},
+			applyErr:    errors.New("exit status 1"),
+			errContains: "left conflicts",
+			conflict:    true,
+		},
+		{
+			name:        "other failure",
+			resolve:     &CommandResult{ExitCode: 0, Stdout: "deadbeef\n"},
+			apply:       &CommandResult{ExitCode: 1, Stderr: "error: local changes would be overwritten"},
+			errContains: "git stash apply failed",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockExecutor := NewMockCommandExecutor()
-			if tt.repoPath != "" && tt.branchName != "" {
-				mockExecutor.On("ExecuteWithTimeout", mock.Anything, tt.repoPath, "git", mock.AnythingOfType("time.Duration"), []string{"branch", "-D", tt.branchName}).Return(tt.mockResult, tt.mockError)
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+				[]string{"rev-parse", "--verify", "--quiet", "stash@{1}"}).Return(tt.resolve, nil)
+			if tt.apply != nil {
+				mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/worktree", "git", mock.AnythingOfType("time.Duration"),
+					[]string{"stash", "apply", "--index", "deadbeef"}).Return(tt.apply, tt.applyErr)
 			}
 			client := NewCLIClient(mockExecutor)
 
-			err := client.DeleteBranch(context.Background(), tt.repoPath, tt.branchName)
+			err := client.ApplyStash(context.Background(), "/test/repo", "/test/worktree", 1)
 
-			if tt.expectErr {
-				require.Error(t, err)
-				if tt.errContains != "" {
-					assert.Contains(t, err.Error(), tt.errContains)
-				}
-			} else {
+			mockExecutor.AssertExpectations(t)
+			if tt.errContains == "" {
 				require.NoError(t, err)
+				return
 			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+			assert.Equal(t, tt.conflict, errors.Is(err, domain.ErrStashConflict))
 		})
 	}
 }
 
-func TestCLIClient_Timeout(t *testing.T) {
+func TestCLIClient_DropStash(t *testing.T) {
 	mockExecutor := NewMockCommandExecutor()
-	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"), []string{"worktree", "list", "--porcelain"}).Return(&CommandResult{ExitCode: 0, Stdout: ""}, nil)
-	client := NewCLIClient(mockExecutor, 5)
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"stash", "drop", "stash@{3}"}).Return(&CommandResult{ExitCode: 0}, nil).Once()
+	client := NewCLIClient(mockExecutor)
 
-	_, err := client.ListWorktrees(context.Background(), "/test/repo")
-	assert.NoError(t, err)
+	require.NoError(t, client.DropStash(context.Background(), "/test/repo", 3))
+	mockExecutor.AssertExpectations(t)
+
+	err := client.DropStash(context.Background(), "", 3)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "repository path cannot be empty")
 }
 
-func TestCLIClient_ParseWorktreeList(t *testing.T) {
-	client := NewCLIClient(nil)
+func TestCLIClient_CreateStash(t *testing.T) {
+	tests := []struct {
+		name        string
+		result      *CommandResult
+		errContains string
+	}{
+		{name: "stashes the changes", result: &CommandResult{ExitCode: 0, Stdout: "Saved working directory and index state On main: auto-stash before creating x"}},
+		{name: "nothing to stash", result: &CommandResult{ExitCode: 0, Stdout: "No local changes to save"}, errContains: "no local changes to stash"},
+		{name: "git failure", result: &CommandResult{ExitCode: 1, Stderr: "fatal: not a git repository"}, errContains: "git stash push failed"},
+	}
 
-	output := `worktree /path/to/repo
-HEAD abcdef1
-branch refs/heads/main
-worktree /path/to/worktree1
-HEAD bcdef2a
-branch refs/heads/feature-branch
-worktree /path/to/worktree2
-HEAD cdef3ab
-detached`
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExecutor := NewMockCommandExecutor()
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/worktree", "git", mock.AnythingOfType("time.Duration"),
+				[]string{"stash", "push", "--include-untracked", "-m", "auto-stash before creating x"}).Return(tt.result, nil)
+			client := NewCLIClient(mockExecutor)
 
-	worktrees, err := client.parseWorktreeList(output)
-	require.NoError(t, err)
-	assert.Len(t, worktrees, 3)
+			err := client.CreateStash(context.Background(), "/test/worktree", "auto-stash before creating x")
 
-	assert.Equal(t, "/path/to/repo", worktrees[0].Path)
-	assert.Equal(t, "main", worktrees[0].Branch)
-	assert.Equal(t, "abcdef1", worktrees[0].Commit)
-	assert.False(t, worktrees[0].IsDetached)
+			mockExecutor.AssertExpectations(t)
+			if tt.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
 
-	assert.Equal(t, "/path/to/worktree1", worktrees[1].Path)
-	assert.Equal(t, "feature-branch", worktrees[1].Branch)
-	assert.Equal(t, "bcdef2a", worktrees[1].Commit)
-	assert.False(t, worktrees[1].IsDetached)
+func TestCLIClient_CreateStash_WithoutMessage(t *testing.T) {
+	mockExecutor := NewMockCommandExecutor()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/worktree", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"stash", "push", "--include-untracked"}).Return(&CommandResult{ExitCode: 0}, nil).Once()
+	client := NewCLIClient(mockExecutor)
 
-	assert.Equal(t, "/path/to/worktree2", worktrees[2].Path)
-	assert.Equal(t, "cdef3ab", worktrees[2].Commit)
-	assert.True(t, worktrees[2].IsDetached)
+	require.NoError(t, client.CreateStash(context.Background(), "/test/worktree", ""))
+	mockExecutor.AssertExpectations(t)
 }
 
-func findWorktree(worktrees []domain.WorktreeInfo, path string) *domain.WorktreeInfo {
-	for _, worktree := range worktrees {
-		if worktree.Path == path {
-			return &worktree
-		}
+func TestCLIClient_CheckoutBranch(t *testing.T) {
+	tests := []struct {
+		name        string
+		startPoint  string
+		args        []string
+		result      *CommandResult
+		errContains string
+	}{
+		{name: "existing branch", args: []string{"checkout", "feature"}, result: &CommandResult{ExitCode: 0}},
+		{name: "new branch at start point", startPoint: "main", args: []string{"checkout", "-b", "feature", "main"}, result: &CommandResult{ExitCode: 0}},
+		{
+			name:        "local changes would be overwritten",
+			args:        []string{"checkout", "feature"},
+			result:      &CommandResult{ExitCode: 1, Stderr: "error: Your local changes to the following files would be overwritten by checkout"},
+			errContains: "git checkout failed",
+		},
 	}
-	return nil
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExecutor := NewMockCommandExecutor()
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/worktree", "git", mock.AnythingOfType("time.Duration"), tt.args).Return(tt.result, nil)
+			client := NewCLIClient(mockExecutor)
+
+			err := client.CheckoutBranch(context.Background(), "/test/worktree", "feature", tt.startPoint)
+
+			mockExecutor.AssertExpectations(t)
+			if tt.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
+
+func TestCLIClient_DeleteRemoteBranch(t *testing.T) {
+	tests := []struct {
+		name           string
+		remote         string
+		branchName     string
+		mockResult     *CommandResult
+		expectErr      bool
+		expectNotFound bool
+		errContains    string
+	}{
+		{
+			name:       "successful deletion",
+			remote:     "origin",
+			branchName: "feature",
+			mockResult: &CommandResult{ExitCode: 0, Stderr: "To /remote.git\n - [deleted]         feature\n"},
+		},
+		{
+			name:           "remote branch missing",
+			remote:         "origin",
+			branchName:     "feature",
+			mockResult:     &CommandResult{ExitCode: 1, Stderr: "error: unable to delete 'feature': remote ref does not exist\n"},
+			expectErr:      true,
+			expectNotFound: true,
+			errContains:    "branch not found on origin",
+		},
+		{
+			name:        "push rejected",
+			remote:      "origin",
+			branchName:  "feature",
+			mockResult:  &CommandResult{ExitCode: 1, Stderr: "remote: permission denied"},
+			expectErr:   true,
+			errContains: "git push --delete failed",
+		},
+		{
+			name:        "empty remote",
+			branchName:  "feature",
+			expectErr:   true,
+			errContains: "remote name cannot be empty",
+		},
+		{
+			name:        "empty branch name",
+			remote:      "origin",
+			expectErr:   true,
+			errContains: "branch name cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExecutor := NewMockCommandExecutor()
+			if tt.mockResult != nil {
+				mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+					[]string{"push", tt.remote, "--delete", tt.branchName}).Return(tt.mockResult, nil)
+			}
+			client := NewCLIClient(mockExecutor)
+
+			err := client.DeleteRemoteBranch(context.Background(), "/test/repo", tt.remote, tt.branchName)
+
+			if !tt.expectErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+			assert.Equal(t, tt.expectNotFound, errors.Is(err, domain.ErrRemoteBranchNotFound))
+		})
+	}
+}
+
+func TestCLIClient_DeleteBranch(t *testing.T) {
+	tests := []struct {
+		name        string
+		repoPath    string
+		branchName  string
+		mockResult  *CommandResult
+		mockError   error
+		expectErr   bool
+		errContains string
+	}{
+		{
+			name:       "successful deletion",
+			repoPath:   "/test/repo",
+			branchName: "feature-branch",
+			mockResult: &CommandResult{ExitCode: 0, Stdout: "", Stderr: ""},
+			expectErr:  false,
+		},
+		{
+			name:        "empty repo path",
+			repoPath:    "",
+			branchName:  "feature",
+			expectErr:   true,
+			errContains: "repository path cannot be empty",
+		},
+		{
+			name:        "empty branch name",
+			repoPath:    "/test/repo",
+			branchName:  "",
+			expectErr:   true,
+			errContains: "branch name cannot be empty",
+		},
+		{
+			name:       "branch not found - idempotent",
+			repoPath:   "/test/repo",
+			branchName: "non-existent",
+			mockResult: &CommandResult{ExitCode: 1, Stdout: "", Stderr: "error: branch 'non-existent' not found"},
+			expectErr:  false,
+		},
+		{
+			name:        "git command fails",
+			repoPath:    "/test/repo",
+			branchName:  "feature",
+			mockResult:  &CommandResult{ExitCode: 1, Stdout: "", Stderr: "error: some error"},
+			expectErr:   true,
+			errContains: "git branch -D failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExecutor := NewMockCommandExecutor()
+			if tt.repoPath != "" && tt.branchName != "" {
+				mockExecutor.On("ExecuteWithTimeout", mock.Anything, tt.repoPath, "git", mock.AnythingOfType("time.Duration"), []string{"branch", "-D", tt.branchName}).Return(tt.mockResult, tt.mockError)
+			}
+			client := NewCLIClient(mockExecutor)
+
+			err := client.DeleteBranch(context.Background(), tt.repoPath, tt.branchName)
+
+			if tt.expectErr {
+				require.Error(t, err)
+				if tt.errContains != "" {
+					assert.Contains(t, err.Error(), tt.errContains)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCLIClient_Timeout(t *testing.T) {
+	mockExecutor := NewMockCommandExecutor()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"), []string{"worktree", "list", "--porcelain"}).Return(&CommandResult{ExitCode: 0, Stdout: ""}, nil)
+	client := NewCLIClient(mockExecutor, 5)
+
+	_, err := client.ListWorktrees(context.Background(), "/test/repo")
+	assert.NoError(t, err)
+}
+
+func TestCLIClient_ParseWorktreeList(t *testing.T) {
+	output := `worktree /path/to/repo
+HEAD abcdef1
+branch refs/heads/main
+worktree /path/to/worktree1
+HEAD bcdef2a
+branch refs/heads/feature-branch
+worktree /path/to/worktree2
+HEAD cdef3ab
+detached
+locked
+worktree /path/to/worktree3
+HEAD def4abc
+branch refs/heads/release
+locked moving to new disk
+
+worktree /path/to/worktree4
+HEAD ef5abcd
+branch refs/heads/feature/x
+prunable gitdir file points to non-existent location`
+
+	worktrees := parseWorktreeList(output)
+	assert.Len(t, worktrees, 5)
+
+	assert.Equal(t, "/path/to/repo", worktrees[0].Path)
+	assert.Equal(t, "main", worktrees[0].Branch)
+	assert.Equal(t, "abcdef1", worktrees[0].HEAD)
+	assert.False(t, worktrees[0].IsDetached)
+
+	assert.Equal(t, "/path/to/worktree1", worktrees[1].Path)
+	assert.Equal(t, "feature-branch", worktrees[1].Branch)
+	assert.Equal(t, "bcdef2a", worktrees[1].HEAD)
+	assert.False(t, worktrees[1].IsDetached)
+
+	assert.Equal(t, "/path/to/worktree2", worktrees[2].Path)
+	assert.Equal(t, "cdef3ab", worktrees[2].HEAD)
+	assert.True(t, worktrees[2].IsDetached)
+	assert.True(t, worktrees[2].Locked)
+	assert.Empty(t, worktrees[2].LockReason)
+
+	assert.False(t, worktrees[1].Locked)
+	assert.True(t, worktrees[3].Locked)
+	assert.Equal(t, "moving to new disk", worktrees[3].LockReason)
+
+	assert.Equal(t, domain.GitWorktreeEntry{Path: "/path/to/worktree4", HEAD: "ef5abcd", Branch: "feature/x"}, worktrees[4])
+}
+
+func TestCLIClient_ParseWorktreeList_Bare(t *testing.T) {
+	output := "worktree /srv/repo.git\nbare\n\nworktree /srv/wt/main\nHEAD abcdef1\nbranch refs/heads/main\n"
+
+	assert.Equal(t, []domain.GitWorktreeEntry{
+		{Path: "/srv/repo.git", IsBare: true},
+		{Path: "/srv/wt/main", HEAD: "abcdef1", Branch: "main"},
+	}, parseWorktreeList(output))
+}
+
+func TestCLIClient_GetWorktreeList(t *testing.T) {
+	mockExecutor := NewMockCommandExecutor()
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"), []string{"worktree", "list", "--porcelain"}).
+		Return(&CommandResult{ExitCode: 0, Stdout: "worktree /test/repo\nbare\n\nworktree /test/wt\nHEAD abc\ndetached\n"}, nil)
+	client := NewCLIClient(mockExecutor)
+
+	entries, err := client.GetWorktreeList(context.Background(), "/test/repo")
+	require.NoError(t, err)
+	assert.Equal(t, []domain.GitWorktreeEntry{
+		{Path: "/test/repo", IsBare: true},
+		{Path: "/test/wt", HEAD: "abc", IsDetached: true},
+	}, entries)
+
+	worktrees, err := client.ListWorktrees(context.Background(), "/test/repo")
+	require.NoError(t, err)
+	assert.True(t, worktrees[0].IsBare)
+	assert.Equal(t, "abc", worktrees[1].Commit)
+
+	_, err = client.GetWorktreeList(context.Background(), "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "repository path cannot be empty")
+}
+
+func findWorktree(worktrees []domain.WorktreeInfo, path string) *domain.WorktreeInfo {
+	for _, worktree := range worktrees {
+		if worktree.Path == path {
+			return &worktree
+		}
+	}
+	return nil
+}
+
+func TestCLIClient_ParseLogOutput(t *testing.T) {
+	output := "abc123\x1fabc\x1fAlice\x1falice@example.com\x1f1700000000\x1fAdd feature\n" +
+		"def456\x1fdef\x1fBob\x1fbob@example.com\x1f1700000100\x1fFix: handle a\x1fb\n" +
+		"malformed line\n"
+
+	commits := parseLogOutput(output)
+	require.Len(t, commits, 2)
+	assert.Equal(t, "abc123", commits[0].Hash)
+	assert.Equal(t, "abc", commits[0].ShortHash)
+	assert.Equal(t, "Alice", commits[0].Author)
+	assert.Equal(t, "alice@example.com", commits[0].Email)
+	assert.Equal(t, int64(1700000000), commits[0].Date.Unix())
+	assert.Equal(t, "Add feature", commits[0].Message)
+	assert.Equal(t, "Fix: handle a\x1fb", commits[1].Message)
+
+	assert.Empty(t, parseLogOutput(""))
+}
+
+func TestCLIClient_LogBetween(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockExecutor := new(MockCommandExecutor)
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+			[]string{"log", logFormat, "main..feature"}).
+			Return(&CommandResult{ExitCode: 0, Stdout: "abc123\x1fabc\x1fAlice\x1fa@x\x1f1700000000\x1fWork\n"}, nil)
+		client := NewCLIClient(mockExecutor)
+
+		commits, err := client.LogBetween(context.Background(), "/test/repo", "main", "feature")
+		require.NoError(t, err)
+		require.Len(t, commits, 1)
+		assert.Equal(t, "Work", commits[0].Message)
+	})
+
+	t.Run("git failure", func(t *testing.T) {
+		mockExecutor := new(MockCommandExecutor)
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"), mock.Anything).
+			Return(&CommandResult{ExitCode: 128, Stderr: "unknown revision"}, nil)
+		client := NewCLIClient(mockExecutor)
+
+		_, err := client.LogBetween(context.Background(), "/test/repo", "main", "missing")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown revision")
+	})
+
+	t.Run("missing refs", func(t *testing.T) {
+		client := NewCLIClient(new(MockCommandExecutor))
+
+		_, err := client.LogBetween(context.Background(), "/test/repo", "", "feature")
+		require.Error(t, err)
+	})
+}
+
+func TestCLIClient_GetCommitHistory(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockExecutor := new(MockCommandExecutor)
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/wt", "git", mock.AnythingOfType("time.Duration"),
+			[]string{"log", logFormat, "-n", "2"}).
+			Return(&CommandResult{ExitCode: 0, Stdout: "b2\x1fb2\x1fAlice\x1fa@x\x1f1700000100\x1fSecond\na1\x1fa1\x1fBob\x1fb@x\x1f1700000000\x1fFirst\n"}, nil)
+		client := NewCLIClient(mockExecutor)
+
+		commits, err := client.GetCommitHistory(context.Background(), "/test/wt", 2)
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+		assert.Equal(t, "Second", commits[0].Message)
+		assert.Equal(t, "Bob", commits[1].Author)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		client := NewCLIClient(new(MockCommandExecutor))
+
+		_, err := client.GetCommitHistory(context.Background(), "/test/wt", 0)
+		require.Error(t, err)
+	})
+}
+
+func TestCLIClient_GetCommitStat(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockExecutor := new(MockCommandExecutor)
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/wt", "git", mock.AnythingOfType("time.Duration"),
+			[]string{"show", "--stat=10000,10000", "--format=", "abc123"}).
+			Return(&CommandResult{ExitCode: 0, Stdout: " a.go | 3 ++-\n b.go | 1 +\n 2 files changed, 3 insertions(+), 1 deletion(-)\n"}, nil)
+		client := NewCLIClient(mockExecutor)
+
+		stat, err := client.GetCommitStat(context.Background(), "/test/wt", "abc123")
+		require.NoError(t, err)
+		assert.Equal(t, &domain.StatusSnapshot{FilesChanged: 2, Insertions: 3, Deletions: 1}, stat)
+	})
+
+	t.Run("empty commit", func(t *testing.T) {
+		mockExecutor := new(MockCommandExecutor)
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/wt", "git", mock.AnythingOfType("time.Duration"), mock.Anything).
+			Return(&CommandResult{ExitCode: 0, Stdout: ""}, nil)
+		client := NewCLIClient(mockExecutor)
+
+		stat, err := client.GetCommitStat(context.Background(), "/test/wt", "abc123")
+		require.NoError(t, err)
+		assert.True(t, stat.Clean())
+	})
+
+	t.Run("git failure", func(t *testing.T) {
+		mockExecutor := new(MockCommandExecutor)
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/wt", "git", mock.AnythingOfType("time.Duration"), mock.Anything).
+			Return(&CommandResult{ExitCode: 128, Stderr: "bad object"}, nil)
+		client := NewCLIClient(mockExecutor)
+
+		_, err := client.GetCommitStat(context.Background(), "/test/wt", "missing")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "bad object")
+	})
+}
+
+func TestCLIClient_ParseDiffStat(t *testing.T) {
+	output := ` README.md                 |  4 ++--
+ cmd/new.go                | 20 ++++++++++++++++++++
+ old.txt                   |  3 ---
+ script.sh                 |  0
+ {src => lib}/util.go      |  2 +-
+ 5 files changed, 23 insertions(+), 6 deletions(-)
+ create mode 100644 cmd/new.go
+ delete mode 100644 old.txt
+ mode change 100644 => 100755 script.sh
+ rename {src => lib}/util.go (90%)
+`
+
+	comparison := parseDiffStat(output)
+	assert.Equal(t, 5, comparison.FilesChanged)
+	assert.Equal(t, 23, comparison.Insertions)
+	assert.Equal(t, 6, comparison.Deletions)
+	assert.Equal(t, []domain.FileStat{
+		{Path: "README.md", Mode: "modified"},
+		{Path: "cmd/new.go", Mode: "created"},
+		{Path: "old.txt", Mode: "deleted"},
+		{Path: "script.sh", Mode: "mode changed"},
+		{Path: "{src => lib}/util.go", Mode: "renamed"},
+	}, comparison.ChangedFiles)
+}
+
+func TestCLIClient_ParseDiffStat_Variants(t *testing.T) {
+	testCases := []struct {
+		name       string
+		output     string
+		files      int
+		insertions int
+		deletions  int
+	}{
+		{name: "empty diff", output: "", files: 0},
+		{name: "insertions only", output: " a | 1 +\n 1 file changed, 1 insertion(+)\n", files: 1, insertions: 1},
+		{name: "deletions only", output: " a | 2 --\n 1 file changed, 2 deletions(-)\n", files: 1, deletions: 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			comparison := parseDiffStat(tc.output)
+			assert.Equal(t, tc.files, comparison.FilesChanged)
+			assert.Equal(t, tc.insertions, comparison.Insertions)
+			assert.Equal(t, tc.deletions, comparison.Deletions)
+		})
+	}
+}
+
+func TestCLIClient_CompareWorktrees(t *testing.T) {
+	testCases := []struct {
+		name           string
+		mergeBase      *CommandResult
+		expectedCommon bool
+	}{
+		{name: "shared history", mergeBase: &CommandResult{ExitCode: 0, Stdout: "abc123\n"}, expectedCommon: true},
+		{name: "unrelated histories", mergeBase: &CommandResult{ExitCode: 1}, expectedCommon: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockExecutor := new(MockCommandExecutor)
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+				[]string{"diff", "--stat=10000,10000", "--summary", "feature..main"}).
+				Return(&CommandResult{ExitCode: 0, Stdout: " a.go | 1 +\n 1 file changed, 1 insertion(+)\n"}, nil)
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+				[]string{"merge-base", "feature", "main"}).Return(tc.mergeBase, nil)
+			client := NewCLIClient(mockExecutor)
+
+			comparison, err := client.CompareWorktrees(context.Background(), "/test/repo", "feature", "main")
+			require.NoError(t, err)
+			assert.Equal(t, "feature", comparison.Base)
+			assert.Equal(t, "main", comparison.Target)
+			assert.Equal(t, 1, comparison.FilesChanged)
+			assert.Equal(t, tc.expectedCommon, comparison.HasCommonHistory)
+		})
+	}
+}
+
+func TestCLIClient_ParseConflictStatus(t *testing.T) {
+	output := strings.Join([]string{
+		"UU main.go",
+		"AA new file.go",
+		"DD gone.go",
+		"AU ours-added.go",
+		"UA theirs-added.go",
+		"DU ours-deleted.go",
+		"UD theirs-deleted.go",
+		" M clean-change.go",
+		"R  renamed.go",
+		"UU-source-of-rename.go",
+		"?? untracked.go",
+		"",
+	}, "\x00")
+
+	conflicts := parseConflictStatus(output)
+	assert.Equal(t, []domain.ConflictFile{
+		{Path: "main.go", OurStatus: "modified", TheirStatus: "modified"},
+		{Path: "new file.go", OurStatus: "added", TheirStatus: "added"},
+		{Path: "gone.go", OurStatus: "deleted", TheirStatus: "deleted"},
+		{Path: "ours-added.go", OurStatus: "added", TheirStatus: "modified"},
+		{Path: "theirs-added.go", OurStatus: "modified", TheirStatus: "added"},
+		{Path: "ours-deleted.go", OurStatus: "deleted", TheirStatus: "modified"},
+		{Path: "theirs-deleted.go", OurStatus: "modified", TheirStatus: "deleted"},
+	}, conflicts)
+
+	assert.Empty(t, parseConflictStatus(""))
+}
+
+func TestCLIClient_GetConflictingFiles(t *testing.T) {
+	mockExecutor := new(MockCommandExecutor)
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/worktree", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"status", "--porcelain", "-z"}).
+		Return(&CommandResult{ExitCode: 0, Stdout: "UU main.go\x00 M other.go\x00"}, nil).Once()
+	client := NewCLIClient(mockExecutor)
+
+	conflicts, err := client.GetConflictingFiles(context.Background(), "/test/worktree")
+	require.NoError(t, err)
+	assert.Equal(t, []domain.ConflictFile{{Path: "main.go", OurStatus: "modified", TheirStatus: "modified"}}, conflicts)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/worktree", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"status", "--porcelain", "-z"}).
+		Return(&CommandResult{ExitCode: 128, Stderr: "not a git repository"}, nil).Once()
+	_, err = client.GetConflictingFiles(context.Background(), "/test/worktree")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "git status failed")
+
+	_, err = client.GetConflictingFiles(context.Background(), "")
+	require.Error(t, err)
+}
+
+func TestCLIClient_ParseCountObjects(t *testing.T) {
+	output := `count: 12
+size: 48
+in-pack: 3400
+packs: 2
+size-pack: 10240
+prune-packable: 3
+garbage: 1
+size-garbage: 8
+`
+
+	assert.Equal(t, &domain.ObjectStats{
+		Count:         12,
+		Size:          48 * 1024,
+		InPack:        3400,
+		Packs:         2,
+		SizePack:      10240 * 1024,
+		PrunePackable: 3,
+		Garbage:       1,
+		SizeGarbage:   8 * 1024,
+	}, parseCountObjects(output))
+
+	assert.Equal(t, &domain.ObjectStats{}, parseCountObjects("warning: garbage found\nunknown: 5\ncount: nan\n"))
+}
+
+func TestCLIClient_GetObjectStats(t *testing.T) {
+	mockExecutor := new(MockCommandExecutor)
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"count-objects", "-v"}).
+		Return(&CommandResult{ExitCode: 0, Stdout: "count: 2\nsize-pack: 4\n"}, nil).Once()
+	client := NewCLIClient(mockExecutor)
+
+	stats, err := client.GetObjectStats(context.Background(), "/test/repo")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.Count)
+	assert.Equal(t, int64(4096), stats.SizePack)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"count-objects", "-v"}).
+		Return(&CommandResult{ExitCode: 128, Stderr: "not a git repository"}, nil).Once()
+	_, err = client.GetObjectStats(context.Background(), "/test/repo")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "git count-objects failed")
+}
+
+func TestCLIClient_GarbageCollect(t *testing.T) {
+	mockExecutor := new(MockCommandExecutor)
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", garbageCollectTimeout,
+		[]string{"gc", "--quiet"}).Return(&CommandResult{ExitCode: 0}, nil).Once()
+	client := NewCLIClient(mockExecutor)
+
+	require.NoError(t, client.GarbageCollect(context.Background(), "/test/repo"))
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", garbageCollectTimeout,
+		[]string{"gc", "--quiet"}).Return(&CommandResult{ExitCode: 1, Stderr: "gc is already running"}, nil).Once()
+	err := client.GarbageCollect(context.Background(), "/test/repo")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "git gc failed")
+
+	require.Error(t, client.GarbageCollect(context.Background(), ""))
+}
+
+func TestCLIClient_SetConfig(t *testing.T) {
+	mockExecutor := new(MockCommandExecutor)
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/worktree", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"config", "extensions.worktreeConfig", "true"}).Return(&CommandResult{ExitCode: 0}, nil)
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/worktree", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"config", "--worktree", "commit.gpgsign", "true"}).Return(&CommandResult{ExitCode: 0}, nil).Once()
+	client := NewCLIClient(mockExecutor)
+
+	require.NoError(t, client.SetConfig(context.Background(), "/test/worktree", "commit.gpgsign", "true"))
+	mockExecutor.AssertExpectations(t)
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/worktree", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"config", "--worktree", "user.signingkey", "ABC"}).Return(&CommandResult{ExitCode: 255, Stderr: "could not lock config file"}, nil).Once()
+	err := client.SetConfig(context.Background(), "/test/worktree", "user.signingkey", "ABC")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "git config failed")
+
+	require.Error(t, client.SetConfig(context.Background(), "", "commit.gpgsign", "true"))
+	require.Error(t, client.SetConfig(context.Background(), "/test/worktree", "", "true"))
+}
+
+func TestCLIClient_GetBranchRelationship(t *testing.T) {
+	testCases := []struct {
+		name        string
+		result      *CommandResult
+		expected    *domain.BranchRelationship
+		expectError string
+	}{
+		{
+			name:     "diverged",
+			result:   &CommandResult{ExitCode: 0, Stdout: "12\t3\n"},
+			expected: &domain.BranchRelationship{Branch: "feature", Base: "main", AheadCount: 3, BehindCount: 12},
+		},
+		{
+			name:     "up to date",
+			result:   &CommandResult{ExitCode: 0, Stdout: "0\t0\n"},
+			expected: &domain.BranchRelationship{Branch: "feature", Base: "main"},
+		},
+		{name: "unknown ref", result: &CommandResult{ExitCode: 128, Stderr: "fatal: ambiguous argument"}, expectError: "git rev-list failed"},
+		{name: "unexpected output", result: &CommandResult{ExitCode: 0, Stdout: "12\n"}, expectError: "unexpected git rev-list output"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockExecutor := new(MockCommandExecutor)
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+				[]string{"rev-list", "--left-right", "--count", "main...feature"}).Return(tc.result, nil)
+			client := NewCLIClient(mockExecutor)
+
+			relationship, err := client.GetBranchRelationship(context.Background(), "/test/repo", "feature", "main")
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, relationship)
+		})
+	}
+}
+
+func TestCLIClient_GetUpstreamBranch(t *testing.T) {
+	testCases := []struct {
+		name        string
+		result      *CommandResult
+		execErr     error
+		expected    string
+		expectError string
+	}{
+		{name: "tracking branch", result: &CommandResult{ExitCode: 0, Stdout: "origin/feature\n"}, expected: "origin/feature"},
+		{
+			name:     "no upstream",
+			result:   &CommandResult{ExitCode: 128, Stderr: "fatal: no upstream configured for branch 'feature'"},
+			execErr:  errors.New("exit status 128"),
+			expected: "",
+		},
+		{
+			name:        "unknown branch",
+			result:      &CommandResult{ExitCode: 128, Stderr: "fatal: ambiguous argument 'feature@{upstream}'"},
+			expectError: "git rev-parse failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockExecutor := new(MockCommandExecutor)
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/worktree", "git", mock.AnythingOfType("time.Duration"),
+				[]string{"rev-parse", "--abbrev-ref", "feature@{upstream}"}).Return(tc.result, tc.execErr)
+			client := NewCLIClient(mockExecutor)
+
+			upstream, err := client.GetUpstreamBranch(context.Background(), "/test/worktree", "feature")
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, upstream)
+		})
+	}
+}
+
+func TestCLIClient_GetLastActivity(t *testing.T) {
+	testCases := []struct {
+		name        string
+		result      *CommandResult
+		expected    *domain.CommitInfo
+		expectError string
+	}{
+		{
+			name:   "head commit",
+			result: &CommandResult{ExitCode: 0, Stdout: "abc123def\x1fabc123d\x1fJane Doe\x1fjane@example.com\x1f1700000000\x1fFix login redirect\n"},
+			expected: &domain.CommitInfo{
+				Hash: "abc123def", ShortHash: "abc123d", Author: "Jane Doe", Email: "jane@example.com",
+				Date: time.Unix(1700000000, 0), Message: "Fix login redirect",
+			},
+		},
+		{name: "no commits", result: &CommandResult{ExitCode: 128, Stderr: "fatal: your current branch 'main' does not have any commits yet"}, expectError: "git log failed"},
+		{name: "unexpected output", result: &CommandResult{ExitCode: 0, Stdout: ""}, expectError: "unexpected git log output"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockExecutor := new(MockCommandExecutor)
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/worktree", "git", mock.AnythingOfType("time.Duration"),
+				[]string{"log", logFormat, "-n", "1"}).Return(tc.result, nil)
+			client := NewCLIClient(mockExecutor)
+
+			commit, err := client.GetLastActivity(context.Background(), "/test/worktree")
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, commit)
+		})
+	}
+}
+
+func TestCLIClient_GetMergeBase(t *testing.T) {
+	testCases := []struct {
+		name        string
+		result      *CommandResult
+		execErr     error
+		expected    string
+		expectError string
+	}{
+		{name: "common ancestor", result: &CommandResult{ExitCode: 0, Stdout: "abc123\n"}, expected: "abc123"},
+		{name: "no common history", result: &CommandResult{ExitCode: 1}, execErr: errors.New("exit status 1"), expected: ""},
+		{name: "unknown ref", result: &CommandResult{ExitCode: 128, Stderr: "fatal: Not a valid object name v9"}, expectError: "git merge-base failed"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockExecutor := new(MockCommandExecutor)
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+				[]string{"merge-base", "feature", "v1.2.3"}).Return(tc.result, tc.execErr)
+			client := NewCLIClient(mockExecutor)
+
+			mergeBase, err := client.GetMergeBase(context.Background(), "/test/repo", "feature", "v1.2.3")
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, mergeBase)
+		})
+	}
+}
+
+func TestCLIClient_InitBareWorktree(t *testing.T) {
+	existing := t.TempDir()
+	target := filepath.Join(t.TempDir(), "scratch")
+
+	mockExecutor := new(MockCommandExecutor)
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"worktree", "add", "--no-checkout", "--detach", target}).Return(&CommandResult{ExitCode: 0}, nil).Once()
+	client := NewCLIClient(mockExecutor)
+
+	require.NoError(t, client.InitBareWorktree(context.Background(), "/test/repo", target))
+	mockExecutor.AssertExpectations(t)
+
+	err := client.InitBareWorktree(context.Background(), "/test/repo", existing)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "target path already exists")
+
+	mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+		[]string{"worktree", "add", "--no-checkout", "--detach", target}).Return(&CommandResult{ExitCode: 128, Stderr: "fatal: not a git repository"}, nil).Once()
+	err = client.InitBareWorktree(context.Background(), "/test/repo", target)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "git worktree add failed")
+
+	require.Error(t, client.InitBareWorktree(context.Background(), "", target))
+	require.Error(t, client.InitBareWorktree(context.Background(), "/test/repo", ""))
+}
+
+func TestCLIClient_GetGlobalConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		result      *CommandResult
+		execErr     error
+		expected    string
+		expectError bool
+	}{
+		{name: "set key", result: &CommandResult{ExitCode: 0, Stdout: "ABCD1234\n"}, expected: "ABCD1234"},
+		{name: "unset key", result: &CommandResult{ExitCode: 1}, expected: ""},
+		{name: "unset key reported as error", result: &CommandResult{ExitCode: 1}, execErr: errors.New("exit status 1"), expected: ""},
+		{name: "invalid config", result: &CommandResult{ExitCode: 3, Stderr: "bad config line"}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockExecutor := new(MockCommandExecutor)
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "", "git", mock.AnythingOfType("time.Duration"),
+				[]string{"config", "--global", "--get", "user.signingkey"}).Return(tc.result, tc.execErr)
+			client := NewCLIClient(mockExecutor)
+
+			value, err := client.GetGlobalConfig(context.Background(), "user.signingkey")
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+}
+
+func TestCLIClient_GetConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		result      *CommandResult
+		execErr     error
+		expected    string
+		expectError bool
+	}{
+		{name: "set key", result: &CommandResult{ExitCode: 0, Stdout: ".githooks\n"}, expected: ".githooks"},
+		{name: "unset key", result: &CommandResult{ExitCode: 1}, execErr: errors.New("exit status 1"), expected: ""},
+		{name: "invalid config", result: &CommandResult{ExitCode: 3, Stderr: "bad config line"}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockExecutor := new(MockCommandExecutor)
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+				[]string{"config", "--get", "core.hooksPath"}).Return(tc.result, tc.execErr)
+			client := NewCLIClient(mockExecutor)
+
+			value, err := client.GetConfig(context.Background(), "/test/repo", "core.hooksPath")
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+}
+
+func TestCLIClient_GetHooksDir(t *testing.T) {
+	configArgs := []string{"config", "--get", "core.hooksPath"}
+	commonDirArgs := []string{"rev-parse", "--git-common-dir"}
+
+	testCases := []struct {
+		name      string
+		hooksPath string
+		commonDir string
+		expected  string
+	}{
+		{name: "relative core.hooksPath", hooksPath: ".githooks", expected: filepath.Join("/test/repo", ".githooks")},
+		{name: "absolute core.hooksPath", hooksPath: "/shared/hooks", expected: "/shared/hooks"},
+		{name: "main repository", commonDir: ".git", expected: filepath.Join("/test/repo", ".git", "hooks")},
+		{name: "linked worktree shares main hooks", commonDir: "/test/main/.git", expected: filepath.Join("/test/main", ".git", "hooks")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockExecutor := new(MockCommandExecutor)
+			if tc.hooksPath != "" {
+				mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"), configArgs).
+					Return(&CommandResult{ExitCode: 0, Stdout: tc.hooksPath + "\n"}, nil)
+			} else {
+				mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"), configArgs).
+					Return(&CommandResult{ExitCode: 1}, nil)
+				mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"), commonDirArgs).
+					Return(&CommandResult{ExitCode: 0, Stdout: tc.commonDir + "\n"}, nil)
+			}
+			client := NewCLIClient(mockExecutor)
+
+			dir, err := client.GetHooksDir(context.Background(), "/test/repo")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, dir)
+		})
+	}
+}
+
+func TestCLIClient_ValidateHooks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("git for Windows ignores the executable bit")
+	}
+	configArgs := []string{"config", "--get", "core.hooksPath"}
+	newClient := func(hooksPath string) *CLIClientImpl {
+		mockExecutor := new(MockCommandExecutor)
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"), configArgs).
+			Return(&CommandResult{ExitCode: 0, Stdout: hooksPath + "\n"}, nil)
+		return NewCLIClient(mockExecutor)
+	}
+
+	t.Run("reports broken hooks", func(t *testing.T) {
+		dir := t.TempDir()
+		write := func(name, content string, mode os.FileMode) {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), mode))
+			require.NoError(t, os.Chmod(filepath.Join(dir, name), mode))
+		}
+		write("pre-commit", "#!/bin/sh\nexit 0\n", 0755)
+		write("post-commit", "#!/bin/sh\nexit 0\n", 0644)
+		write("pre-push", "#!/nonexistent/interpreter\n", 0755)
+		write("commit-msg", "#!/usr/bin/env -S twiggit-missing-interpreter --flag\n", 0755)
+		write("pre-rebase.sample", "#!/bin/sh\n", 0644)
+		require.NoError(t, os.Symlink(filepath.Join(dir, "missing"), filepath.Join(dir, "post-checkout")))
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "lib"), 0755))
+
+		issues := newClient(dir).ValidateHooks(context.Background(), "/test/repo")
+
+		assert.ElementsMatch(t, []domain.HookIssue{
+			{HookName: "commit-msg", Issue: "interpreter twiggit-missing-interpreter not found in PATH"},
+			{HookName: "post-checkout", Issue: "broken symlink"},
+			{HookName: "post-commit", Issue: "not executable (run chmod +x " + filepath.Join(dir, "post-commit") + ")"},
+			{HookName: "pre-push", Issue: "interpreter /nonexistent/interpreter not found"},
+		}, issues)
+	})
+
+	t.Run("missing core.hooksPath directory", func(t *testing.T) {
+		missing := filepath.Join(t.TempDir(), "missing")
+		issues := newClient(missing).ValidateHooks(context.Background(), "/test/repo")
+		assert.Equal(t, []domain.HookIssue{{Issue: "core.hooksPath points to missing directory " + missing}}, issues)
+	})
+
+	t.Run("missing default hooks directory has no issues", func(t *testing.T) {
+		repo := t.TempDir()
+		mockExecutor := new(MockCommandExecutor)
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, repo, "git", mock.AnythingOfType("time.Duration"), configArgs).
+			Return(&CommandResult{ExitCode: 1}, nil)
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, repo, "git", mock.AnythingOfType("time.Duration"), []string{"rev-parse", "--git-common-dir"}).
+			Return(&CommandResult{ExitCode: 0, Stdout: ".git\n"}, nil)
+
+		assert.Empty(t, NewCLIClient(mockExecutor).ValidateHooks(context.Background(), repo))
+	})
+}
+
+func TestCLIClient_GetAllLocalConfig(t *testing.T) {
+	localArgs := []string{"config", "--local", "--list", "--null"}
+	worktreeArgs := []string{"config", "--worktree", "--list", "--null"}
+
+	t.Run("values containing equals signs and newlines", func(t *testing.T) {
+		mockExecutor := new(MockCommandExecutor)
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/wt", "git", mock.AnythingOfType("time.Duration"), localArgs).
+			Return(&CommandResult{ExitCode: 0, Stdout: "core.bare\nfalse\x00alias.lg\nlog --format=%h=%s\x00merge.ff\x00user.name\nLocal\x00"}, nil)
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/wt", "git", mock.AnythingOfType("time.Duration"), worktreeArgs).
+			Return(&CommandResult{ExitCode: 0, Stdout: "user.name\nWorktree\x00commit.template\nline one\nline=two\x00"}, nil)
+		client := NewCLIClient(mockExecutor)
+
+		config, err := client.GetAllLocalConfig(context.Background(), "/test/wt")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"core.bare":       "false",
+			"alias.lg":        "log --format=%h=%s",
+			"merge.ff":        "true",
+			"user.name":       "Worktree",
+			"commit.template": "line one\nline=two",
+		}, config)
+	})
+
+	t.Run("git config fails", func(t *testing.T) {
+		mockExecutor := new(MockCommandExecutor)
+		mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/wt", "git", mock.AnythingOfType("time.Duration"), localArgs).
+			Return(&CommandResult{ExitCode: 128, Stderr: "not a git repository"}, nil)
+		client := NewCLIClient(mockExecutor)
+
+		_, err := client.GetAllLocalConfig(context.Background(), "/test/wt")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not a git repository")
+	})
+
+	client := NewCLIClient(new(MockCommandExecutor))
+	_, err := client.GetAllLocalConfig(context.Background(), "")
+	require.Error(t, err)
+}
+
+func TestCLIClient_GetBranchDescription(t *testing.T) {
+	testCases := []struct {
+		name        string
+		result      *CommandResult
+		execErr     error
+		expected    string
+		expectError string
+	}{
+		{name: "described branch", result: &CommandResult{ExitCode: 0, Stdout: "Payment retries\n"}, expected: "Payment retries"},
+		{name: "no description", result: &CommandResult{ExitCode: 1}, execErr: errors.New("exit status 1"), expected: ""},
+		{name: "invalid config", result: &CommandResult{ExitCode: 3, Stderr: "bad config line"}, expectError: "git config failed"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockExecutor := new(MockCommandExecutor)
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+				[]string{"config", "--get", "branch.feature.description"}).Return(tc.result, tc.execErr)
+			client := NewCLIClient(mockExecutor)
+
+			description, err := client.GetBranchDescription(context.Background(), "/test/repo", "feature")
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, description)
+		})
+	}
+
+	client := NewCLIClient(new(MockCommandExecutor))
+	_, err := client.GetBranchDescription(context.Background(), "", "feature")
+	require.Error(t, err)
+	_, err = client.GetBranchDescription(context.Background(), "/test/repo", "")
+	require.Error(t, err)
+}
+
+func TestCLIClient_SetBranchDescription(t *testing.T) {
+	testCases := []struct {
+		name        string
+		description string
+		args        []string
+		result      *CommandResult
+		execErr     error
+		expectError string
+	}{
+		{
+			name:        "set description",
+			description: "Payment retries",
+			args:        []string{"config", "branch.feature.description", "Payment retries"},
+			result:      &CommandResult{ExitCode: 0},
+		},
+		{
+			name:   "empty description unsets",
+			args:   []string{"config", "--unset", "branch.feature.description"},
+			result: &CommandResult{ExitCode: 0},
+		},
+		{
+			name:    "unset missing description",
+			args:    []string{"config", "--unset", "branch.feature.description"},
+			result:  &CommandResult{ExitCode: 5},
+			execErr: errors.New("exit status 5"),
+		},
+		{
+			name:        "locked config",
+			description: "Payment retries",
+			args:        []string{"config", "branch.feature.description", "Payment retries"},
+			result:      &CommandResult{ExitCode: 255, Stderr: "could not lock config file"},
+			expectError: "git config failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockExecutor := new(MockCommandExecutor)
+			mockExecutor.On("ExecuteWithTimeout", mock.Anything, "/test/repo", "git", mock.AnythingOfType("time.Duration"),
+				tc.args).Return(tc.result, tc.execErr).Once()
+			client := NewCLIClient(mockExecutor)
+
+			err := client.SetBranchDescription(context.Background(), "/test/repo", "feature", tc.description)
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			mockExecutor.AssertExpectations(t)
+		})
+	}
+
+	client := NewCLIClient(new(MockCommandExecutor))
+	require.Error(t, client.SetBranchDescription(context.Background(), "", "feature", "x"))
+	require.Error(t, client.SetBranchDescription(context.Background(), "/test/repo", "", "x"))
 }
@@ -0,0 +1,64 @@
+package infrastructure
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"time"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.CommandRunner = (*commandRunner)(nil)
+
+// DefaultCommandRunTimeout bounds a single user command (builds and test suites can be slow)
+const DefaultCommandRunTimeout = 30 * time.Minute
+
+// commandRunner runs shell commands through a CommandExecutor
+type commandRunner struct {
+	executor CommandExecutor
+	timeout  time.Duration
+	goos     string
+}
+
+// NewCommandRunner creates a CommandRunner that runs commands through executor, each bounded by timeout
+func NewCommandRunner(executor CommandExecutor, timeout time.Duration) application.CommandRunner {
+	return &commandRunner{executor: executor, timeout: timeout, goos: runtime.GOOS}
+}
+
+// Run runs command with sh -c (powershell -Command on Windows) in dir
+func (r *commandRunner) Run(ctx context.Context, dir, command string) (*domain.CommandRunResult, error) {
+	if strings.TrimSpace(command) == "" {
+		return nil, domain.NewValidationError("Run", "command", command, "command cannot be empty")
+	}
+
+	shell, args := shellInvocation(r.goos, command)
+	result, err := r.executor.ExecuteWithTimeout(ctx, dir, shell, r.timeout, args...)
+	// The executor returns the result alongside an error for non-zero exits
+	if result == nil {
+		return nil, err
+	}
+
+	output := strings.TrimSpace(result.Stdout)
+	if stderr := strings.TrimSpace(result.Stderr); stderr != "" {
+		if output != "" {
+			output += "\n"
+		}
+		output += stderr
+	}
+
+	return &domain.CommandRunResult{
+		ExitCode: result.ExitCode,
+		Output:   output,
+		Duration: result.Duration,
+	}, nil
+}
+
+// shellInvocation returns the shell and arguments that run command on goos
+func shellInvocation(goos, command string) (string, []string) {
+	if goos == "windows" {
+		return "powershell", []string{"-NoProfile", "-Command", command}
+	}
+	return "sh", []string{"-c", command}
+}
@@ -0,0 +1,68 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+func TestCommandRunner_Run(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		executor := NewMockCommandExecutor()
+		executor.On("ExecuteWithTimeout", mock.Anything, "/wt/a", "sh", DefaultCommandRunTimeout, []string{"-c", "make"}).
+			Return(&CommandResult{ExitCode: 0, Stdout: "built\n"}, nil)
+		runner := &commandRunner{executor: executor, timeout: DefaultCommandRunTimeout, goos: "linux"}
+
+		result, err := runner.Run(context.Background(), "/wt/a", "make")
+		require.NoError(t, err)
+		assert.True(t, result.Succeeded())
+		assert.Equal(t, "built", result.Output)
+	})
+
+	t.Run("non-zero exit is a result, not an error", func(t *testing.T) {
+		executor := NewMockCommandExecutor()
+		executor.On("ExecuteWithTimeout", mock.Anything, "/wt/a", "sh", DefaultCommandRunTimeout, []string{"-c", "make"}).
+			Return(&CommandResult{ExitCode: 2, Stdout: "building", Stderr: "error: failed"},
+				domain.NewGitCommandError("sh", []string{"-c", "make"}, 2, "", "", "command exited with non-zero status", nil))
+		runner := &commandRunner{executor: executor, timeout: DefaultCommandRunTimeout, goos: "linux"}
+
+		result, err := runner.Run(context.Background(), "/wt/a", "make")
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.ExitCode)
+		assert.Equal(t, "building\nerror: failed", result.Output)
+	})
+
+	t.Run("shell could not start", func(t *testing.T) {
+		executor := NewMockCommandExecutor()
+		executor.On("ExecuteWithTimeout", mock.Anything, "/wt/a", "sh", DefaultCommandRunTimeout, []string{"-c", "make"}).
+			Return(nil, errors.New("executable file not found"))
+		runner := &commandRunner{executor: executor, timeout: DefaultCommandRunTimeout, goos: "linux"}
+
+		_, err := runner.Run(context.Background(), "/wt/a", "make")
+		require.Error(t, err)
+	})
+
+	t.Run("empty command", func(t *testing.T) {
+		runner := NewCommandRunner(NewMockCommandExecutor(), DefaultCommandRunTimeout)
+
+		_, err := runner.Run(context.Background(), "/wt/a", "  ")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "command cannot be empty")
+	})
+}
+
+func TestShellInvocation(t *testing.T) {
+	shell, args := shellInvocation("linux", "go build ./...")
+	assert.Equal(t, "sh", shell)
+	assert.Equal(t, []string{"-c", "go build ./..."}, args)
+
+	shell, args = shellInvocation("windows", "go build ./...")
+	assert.Equal(t, "powershell", shell)
+	assert.Equal(t, []string{"-NoProfile", "-Command", "go build ./..."}, args)
+}
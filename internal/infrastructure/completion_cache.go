@@ -0,0 +1,72 @@
+package infrastructure
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"twiggit/internal/application"
+)
+
+var _ application.CompletionCache = (*completionCache)(nil)
+
+// DefaultCompletionCacheTTL is how long __complete-worktrees reuses its project/branch list
+const DefaultCompletionCacheTTL = 30 * time.Second
+
+// DefaultCompletionCachePath returns the XDG cache file used for worktree completion
+// ($XDG_CACHE_HOME/twiggit/complete-worktrees, defaulting to ~/.cache/twiggit/complete-worktrees);
+// a predictable path under the shared temp directory could be created by another user first
+func DefaultCompletionCachePath() string {
+	cacheHome := os.Getenv("XDG_CACHE_HOME")
+	if cacheHome == "" {
+		home, _ := os.UserHomeDir()
+		cacheHome = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(cacheHome, "twiggit", "complete-worktrees")
+}
+
+type completionCache struct {
+	path string
+	ttl  time.Duration
+	now  func() time.Time
+}
+
+// NewCompletionCache creates a CompletionCache keeping one value per line in path for ttl
+func NewCompletionCache(path string, ttl time.Duration) application.CompletionCache {
+	return &completionCache{path: path, ttl: ttl, now: time.Now}
+}
+
+// Load returns the cached values while the file is younger than the TTL; a missing,
+// unreadable or expired file is a miss
+func (c *completionCache) Load() ([]string, bool) {
+	info, err := os.Stat(c.path)
+	if err != nil || c.now().Sub(info.ModTime()) >= c.ttl {
+		return nil, false
+	}
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return nil, false
+	}
+	content := strings.TrimSuffix(string(data), "\n")
+	if content == "" {
+		return []string{}, true
+	}
+	return strings.Split(content, "\n"), true
+}
+
+// Save replaces the cache file, creating its directory when needed
+func (c *completionCache) Save(values []string) error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(c.path), err)
+	}
+	var content string
+	if len(values) > 0 {
+		content = strings.Join(values, "\n") + "\n"
+	}
+	if err := WriteFileAtomic(c.path, []byte(content), 0600); err != nil {
+		return fmt.Errorf("failed to write completion cache %s: %w", c.path, err)
+	}
+	return nil
+}
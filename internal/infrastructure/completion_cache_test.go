@@ -0,0 +1,49 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultCompletionCachePath(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", "/cache")
+	assert.Equal(t, filepath.Join("/cache", "twiggit", "complete-worktrees"), DefaultCompletionCachePath())
+
+	t.Setenv("XDG_CACHE_HOME", "")
+	t.Setenv("HOME", "/home/user")
+	assert.Equal(t, filepath.Join("/home/user", ".cache", "twiggit", "complete-worktrees"), DefaultCompletionCachePath())
+}
+
+func TestCompletionCache_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "twiggit", "complete-worktrees")
+	cache := NewCompletionCache(path, time.Minute)
+
+	_, ok := cache.Load()
+	assert.False(t, ok, "a missing file is a miss")
+
+	require.NoError(t, cache.Save([]string{"api/main", "web/feature"}))
+	values, ok := cache.Load()
+	require.True(t, ok)
+	assert.Equal(t, []string{"api/main", "web/feature"}, values)
+
+	require.NoError(t, cache.Save(nil))
+	values, ok = cache.Load()
+	require.True(t, ok, "an empty workspace is cached too")
+	assert.Empty(t, values)
+}
+
+func TestCompletionCache_Expires(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "complete-worktrees")
+	cache := NewCompletionCache(path, 30*time.Second)
+	require.NoError(t, cache.Save([]string{"api/main"}))
+
+	stale := time.Now().Add(-31 * time.Second)
+	require.NoError(t, os.Chtimes(path, stale, stale))
+	_, ok := cache.Load()
+	assert.False(t, ok)
+}
@@ -0,0 +1,256 @@
+package infrastructure
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"twiggit/internal/domain"
+)
+
+// configLockTimeout is how long SetValue waits for another twiggit process writing the config file
+const configLockTimeout = 5 * time.Second
+
+// configFieldType returns the Go type of a dotted config key, found through the toml tags
+// of domain.Config. Tables are not settable.
+func configFieldType(key string) (reflect.Type, error) {
+	t := reflect.TypeOf(domain.Config{})
+	parts := strings.Split(key, ".")
+	for i, part := range parts {
+		field, ok := configFieldByKey(t, part)
+		if !ok {
+			return nil, fmt.Errorf("unknown configuration key %q", key)
+		}
+		t = field.Type
+		if t.Kind() == reflect.Struct && t != reflect.TypeOf(time.Duration(0)) {
+			if i == len(parts)-1 {
+				return nil, fmt.Errorf("%q is a table; set one of its keys instead", key)
+			}
+			continue
+		}
+		if i != len(parts)-1 {
+			return nil, fmt.Errorf("unknown configuration key %q", key)
+		}
+	}
+	return t, nil
+}
+
+// configFieldByKey finds the field of a config struct with the given toml key
+func configFieldByKey(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := range t.NumField() {
+		if name, ok := sampleConfigKey(t.Field(i)); ok && name == key {
+			return t.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// configValueLiteral converts a command-line value to the TOML literal of the key's type.
+// Lists are comma-separated; durations use Go syntax (e.g. 5m).
+func configValueLiteral(key, value string) (string, error) {
+	v, err := parseConfigValue(key, value)
+	if err != nil {
+		return "", err
+	}
+	return sampleConfigValue(v, "")
+}
+
+// parseConfigValue converts a command-line or environment value to the key's type.
+// Booleans also accept yes/no; lists are comma-separated.
+func parseConfigValue(key, value string) (reflect.Value, error) {
+	t, err := configFieldType(key)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	v := reflect.New(t).Elem()
+	switch {
+	case t == reflect.TypeOf(time.Duration(0)):
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%s must be a duration such as 5m: %w", key, err)
+		}
+		v.SetInt(int64(d))
+	case t.Kind() == reflect.String:
+		v.SetString(value)
+	case t.Kind() == reflect.Bool:
+		b, err := parseConfigBool(value)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%s must be true or false", key)
+		}
+		v.SetBool(b)
+	case t.Kind() == reflect.Int || t.Kind() == reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("%s must be a whole number", key)
+		}
+		v.SetInt(n)
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String:
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return reflect.Value{}, fmt.Errorf("%s has unsupported type %s", key, t)
+	}
+	return v, nil
+}
+
+// parseConfigBool accepts the strconv.ParseBool forms (true, 1, false, 0, ...) and yes/no
+func parseConfigBool(value string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "yes":
+		return true, nil
+	case "no":
+		return false, nil
+	}
+	return strconv.ParseBool(strings.TrimSpace(value))
+}
+
+// configLine is a key/value entry of a TOML file spanning lines [start, end]
+type configLine struct {
+	table, key string
+	start, end int
+}
+
+// scanConfigLines finds the key/value entries and [table] headers of a TOML file.
+// Headers map each table to its line; multi-line arrays span until their brackets close.
+func scanConfigLines(lines []string) ([]configLine, map[string]int) {
+	var entries []configLine
+	headers := map[string]int{}
+	table := ""
+	for i := 0; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "[") {
+			name, _, _ := strings.Cut(strings.TrimPrefix(trimmed, "["), "]")
+			table = strings.TrimSpace(name)
+			headers[table] = i
+			continue
+		}
+		key, value, ok := strings.Cut(trimmed, "=")
+		if !ok {
+			continue
+		}
+		entry := configLine{table: table, key: strings.TrimSpace(key), start: i, end: i}
+		depth := strings.Count(value, "[") - strings.Count(value, "]")
+		for depth > 0 && entry.end+1 < len(lines) {
+			entry.end++
+			depth += strings.Count(lines[entry.end], "[") - strings.Count(lines[entry.end], "]")
+		}
+		entries = append(entries, entry)
+		i = entry.end
+	}
+	return entries, headers
+}
+
+// setConfigKey returns content with the dotted key set to the TOML literal, keeping comments
+// and the rest of the file. An empty literal removes the key.
+func setConfigKey(content, key, literal string) string {
+	table, leaf := "", key
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		table, leaf = key[:i], key[i+1:]
+	}
+
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	if content == "" {
+		lines = nil
+	}
+	entries, headers := scanConfigLines(lines)
+	line := leaf + " = " + literal
+
+	// Replace or remove an existing entry
+	last := -1
+	for _, entry := range entries {
+		if entry.table != table {
+			continue
+		}
+		if entry.key == leaf {
+			var replacement []string
+			if literal != "" {
+				indent := lines[entry.start][:len(lines[entry.start])-len(strings.TrimLeft(lines[entry.start], " \t"))]
+				replacement = []string{indent + line}
+			}
+			lines = append(lines[:entry.start], append(replacement, lines[entry.end+1:]...)...)
+			return joinConfigLines(lines)
+		}
+		last = entry.end
+	}
+	if literal == "" {
+		return content
+	}
+
+	header, hasTable := headers[table]
+	switch {
+	case last >= 0:
+		lines = insertConfigLines(lines, last+1, line)
+	case table != "" && hasTable:
+		lines = insertConfigLines(lines, header+1, line)
+	case table != "":
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "["+table+"]", line)
+	default:
+		lines = insertConfigLines(lines, topLevelInsertion(lines, headers), line)
+	}
+	return joinConfigLines(lines)
+}
+
+// topLevelInsertion returns where a first top-level key goes: before the first table and
+// the comment block above it, since TOML requires top-level keys before any table
+func topLevelInsertion(lines []string, headers map[string]int) int {
+	first := len(lines)
+	for _, line := range headers {
+		first = min(first, line)
+	}
+	if first == len(lines) {
+		return first
+	}
+	for first > 0 && strings.HasPrefix(strings.TrimSpace(lines[first-1]), "#") {
+		first--
+	}
+	return first
+}
+
+func insertConfigLines(lines []string, at int, inserted ...string) []string {
+	return append(lines[:at], append(inserted, lines[at:]...)...)
+}
+
+func joinConfigLines(lines []string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// WriteFileAtomic replaces path with data through a temporary file in the same directory,
+// so readers never see a partly written file
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
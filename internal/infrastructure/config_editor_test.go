@@ -0,0 +1,197 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValueLiteral(t *testing.T) {
+	testCases := []struct {
+		key, value  string
+		expected    string
+		errContains string
+	}{
+		{key: "default_sort", value: "branch", expected: `"branch"`},
+		{key: "cd_on_create", value: "false", expected: "false"},
+		{key: "git.cli_timeout", value: "45", expected: "45"},
+		{key: "services.cache_ttl", value: "10m", expected: `"10m0s"`},
+		{key: "validation.protected_branches", value: "main, release/*", expected: `["main", "release/*"]`},
+		{key: "git.cli_timeout", value: "soon", errContains: "must be a whole number"},
+		{key: "cd_on_create", value: "maybe", errContains: "must be true or false"},
+		{key: "services.cache_ttl", value: "10", errContains: "must be a duration"},
+		{key: "git", value: "x", errContains: "is a table"},
+		{key: "git.nope", value: "x", errContains: "unknown configuration key"},
+		{key: "default_sort.x", value: "x", errContains: "unknown configuration key"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.key+"="+tc.value, func(t *testing.T) {
+			literal, err := configValueLiteral(tc.key, tc.value)
+			if tc.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, literal)
+		})
+	}
+}
+
+func TestSetConfigKey(t *testing.T) {
+	const sample = `# twiggit configuration
+
+# Directory of projects
+projects_dir = "/p"
+
+# Git operations
+[git]
+# Timeout
+cli_timeout = 30
+
+[validation]
+protected_branches = [
+  "main",
+]
+`
+	testCases := []struct {
+		name     string
+		content  string
+		key      string
+		literal  string
+		expected string
+	}{
+		{
+			name:    "replaces a top-level key in place",
+			content: sample, key: "projects_dir", literal: `"/q"`,
+			expected: `# twiggit configuration
+
+# Directory of projects
+projects_dir = "/q"
+
+# Git operations
+[git]
+# Timeout
+cli_timeout = 30
+
+[validation]
+protected_branches = [
+  "main",
+]
+`,
+		},
+		{
+			name:    "adds a top-level key after the last top-level key",
+			content: sample, key: "default_sort", literal: `"branch"`,
+			expected: `# twiggit configuration
+
+# Directory of projects
+projects_dir = "/p"
+default_sort = "branch"
+
+# Git operations
+[git]
+# Timeout
+cli_timeout = 30
+
+[validation]
+protected_branches = [
+  "main",
+]
+`,
+		},
+		{
+			name:    "adds a top-level key before the comment of the first table",
+			content: "# Git operations\n[git]\ncli_timeout = 30\n", key: "default_sort", literal: `"age"`,
+			expected: "default_sort = \"age\"\n# Git operations\n[git]\ncli_timeout = 30\n",
+		},
+		{
+			name:    "adds a key to an existing table",
+			content: sample, key: "git.cache_enabled", literal: "false",
+			expected: `# twiggit configuration
+
+# Directory of projects
+projects_dir = "/p"
+
+# Git operations
+[git]
+# Timeout
+cli_timeout = 30
+cache_enabled = false
+
+[validation]
+protected_branches = [
+  "main",
+]
+`,
+		},
+		{
+			name:    "replaces a multi-line array",
+			content: sample, key: "validation.protected_branches", literal: `["develop"]`,
+			expected: `# twiggit configuration
+
+# Directory of projects
+projects_dir = "/p"
+
+# Git operations
+[git]
+# Timeout
+cli_timeout = 30
+
+[validation]
+protected_branches = ["develop"]
+`,
+		},
+		{
+			name:    "removes a key",
+			content: sample, key: "git.cli_timeout", literal: "",
+			expected: `# twiggit configuration
+
+# Directory of projects
+projects_dir = "/p"
+
+# Git operations
+[git]
+# Timeout
+
+[validation]
+protected_branches = [
+  "main",
+]
+`,
+		},
+		{name: "removing a missing key changes nothing", content: sample, key: "default_sort", literal: "", expected: sample},
+		{name: "creates the table", content: "projects_dir = \"/p\"\n", key: "theme.age_color_old_days", literal: "20", expected: "projects_dir = \"/p\"\n\n[theme]\nage_color_old_days = 20\n"},
+		{name: "empty file", content: "", key: "default_sort", literal: `"path"`, expected: "default_sort = \"path\"\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, setConfigKey(tc.content, tc.key, tc.literal))
+		})
+	}
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0600))
+
+	require.NoError(t, WriteFileAtomic(path, []byte("new"), 0644))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	if os.PathSeparator == '/' {
+		assert.Equal(t, os.FileMode(0644), info.Mode().Perm())
+	}
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "the temporary file is gone")
+}
@@ -1,9 +1,12 @@
 package infrastructure
 
 import (
+	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 
 	"github.com/knadh/koanf/parsers/toml"
@@ -98,6 +101,13 @@ func copyConfig(config *domain.Config) *domain.Config {
 		Navigation:          config.Navigation,
 		Shell:               config.Shell,
 		Completion:          config.Completion,
+		Theme:               config.Theme,
+		Presets:             maps.Clone(config.Presets),
+
+		BranchDescriptionTemplate: config.BranchDescriptionTemplate,
+		CdOnCreate:                config.CdOnCreate,
+		DefaultSort:               config.DefaultSort,
+		MaxBehindCommits:          config.MaxBehindCommits,
 	}
 }
 
@@ -115,39 +125,219 @@ func NewConfigManager() application.ConfigManager {
 
 // Load loads configuration from defaults and config file
 func (m *koanfConfigManager) Load() (*domain.Config, error) {
-	// 1. Load defaults
+	// Start from a fresh koanf so a reload drops keys removed from the file
+	m.ko = koanf.New(".")
+
+	// 1-2. Load defaults and the config file
+	configPath, err := m.loadGlobal()
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Override keys with their TWIGGIT_* environment variables
+	if err := m.loadEnv(); err != nil {
+		return nil, err
+	}
+
+	// 4-6. Unmarshal, normalize and validate
+	config, err := m.decode(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// 7. Store immutable config
+	m.config = config
+
+	// 8. Return a copy using pure function to maintain immutability
+	return copyConfig(config), nil
+}
+
+// LoadForProject loads the configuration of the project whose main repository is repoPath:
+// defaults, the config file, then the configuration keys of the project's .twiggit.toml
+// (mergeProjectConfig), then TWIGGIT_* environment variables. The loaded configuration
+// returned by GetConfig is not changed.
+func (m *koanfConfigManager) LoadForProject(repoPath string) (*domain.Config, error) {
+	project := &koanfConfigManager{ko: koanf.New(".")}
+	configPath, err := project.loadGlobal()
+	if err != nil {
+		return nil, err
+	}
+
+	projectPath := filepath.Join(repoPath, projectSettingsFile)
+	content, err := os.ReadFile(projectPath) // #nosec G304 -- file of a discovered project
+	switch {
+	case err == nil:
+		if err := project.mergeProjectConfig(projectPath, content); err != nil {
+			return nil, err
+		}
+		configPath = projectPath
+	case !os.IsNotExist(err):
+		return nil, domain.NewConfigError(projectPath, "failed to read project settings", err)
+	}
+
+	if err := project.loadEnv(); err != nil {
+		return nil, err
+	}
+	return project.decode(configPath)
+}
+
+// loadGlobal loads the defaults and then the config file, returning the config file's path
+func (m *koanfConfigManager) loadGlobal() (string, error) {
 	if err := m.loadDefaults(); err != nil {
-		return nil, domain.NewConfigError("", "failed to load default configuration", err)
+		return "", domain.NewConfigError("", "failed to load default configuration", err)
 	}
 
-	// 2. Load config file using pure function for existence check
 	configPath := m.getConfigFilePath()
 	if configFileExists(configPath) {
-		// Load TOML file
 		if err := m.ko.Load(file.Provider(configPath), toml.Parser()); err != nil {
-			return nil, domain.NewConfigError(configPath, "failed to parse config file", err)
+			return "", domain.NewConfigError(configPath, "failed to parse config file", err)
 		}
 	}
+	return configPath, nil
+}
+
+// projectConfigExcluded are the keys .twiggit.toml cannot set: they locate the projects
+// themselves, so every project must see the same value
+var projectConfigExcluded = map[string]bool{"projects_dir": true, "worktrees_dir": true}
 
-	// 3. Unmarshal to config object
+// mergeProjectConfig sets the configuration keys of .twiggit.toml content over the loaded
+// ones: scalars replace the global value, lists are added to it (without duplicates).
+// Other keys of the file (default_worktree, hooks) and unknown keys are ignored, like
+// unknown keys of the config file; presets cannot be set per project.
+func (m *koanfConfigManager) mergeProjectConfig(path string, content []byte) error {
+	k := koanf.New(".")
+	if err := k.Load(contentProvider(content), toml.Parser()); err != nil {
+		return domain.NewConfigError(path, "project settings do not parse", err)
+	}
+
+	for _, key := range k.Keys() {
+		if projectConfigExcluded[key] {
+			return domain.NewConfigError(path, key+" can only be set in the global config file", nil)
+		}
+		t, err := configFieldType(key)
+		if err != nil {
+			continue
+		}
+		value := k.Get(key)
+		if t.Kind() == reflect.Slice {
+			value = unionConfigList(m.ko.Get(key), value)
+		}
+		if err := m.ko.Set(key, value); err != nil {
+			return domain.NewConfigError(path, "failed to apply "+key, err)
+		}
+	}
+	return nil
+}
+
+// unionConfigList returns the items of global followed by the items of project that global
+// lacks. A project value that is not a list is returned as is, for decode to reject.
+func unionConfigList(global, project any) any {
+	items, ok := project.([]any)
+	if !ok {
+		return project
+	}
+
+	var merged []any
+	seen := make(map[string]bool)
+	add := func(item any) {
+		if key := fmt.Sprint(item); !seen[key] {
+			seen[key] = true
+			merged = append(merged, item)
+		}
+	}
+	switch g := global.(type) {
+	case []any:
+		for _, item := range g {
+			add(item)
+		}
+	case []string:
+		for _, item := range g {
+			add(item)
+		}
+	}
+	for _, item := range items {
+		add(item)
+	}
+	return merged
+}
+
+// decode unmarshals the loaded keys to a config object, expands its paths and validates it
+func (m *koanfConfigManager) decode(configPath string) (*domain.Config, error) {
 	config := &domain.Config{}
 	if err := m.ko.Unmarshal("", config); err != nil {
 		return nil, domain.NewConfigError(configPath, "failed to unmarshal configuration", err)
 	}
 
-	// 4. Normalize paths (expand environment variables and tilde)
+	// Normalize paths (expand environment variables and tilde)
 	normalizeConfigPaths(config)
 
-	// 5. Validate configuration using pure function
 	if err := validateConfig(config); err != nil {
 		return nil, domain.NewConfigError(configPath, "validation failed", err)
 	}
+	return config, nil
+}
 
-	// 6. Store immutable config
-	m.config = config
+// SetValue sets a dotted key in the config file, keeping its comments. The edit is
+// validated like Load before the file is replaced; a lock file serializes concurrent writers.
+func (m *koanfConfigManager) SetValue(key, value string) error {
+	configPath := m.getConfigFilePath()
 
-	// 7. Return a copy using pure function to maintain immutability
-	return copyConfig(config), nil
+	literal := ""
+	if value != "" {
+		var err error
+		if literal, err = configValueLiteral(key, value); err != nil {
+			return domain.NewConfigError(configPath, "invalid value: "+err.Error(), err)
+		}
+	} else if _, err := configFieldType(key); err != nil {
+		return domain.NewConfigError(configPath, "cannot unset: "+err.Error(), err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil { // #nosec G301 -- standard directory perms (rwxr-xr-x)
+		return domain.NewConfigError(configPath, "failed to create directory "+filepath.Dir(configPath), err)
+	}
+	unlock, err := lockFile(configPath, configLockTimeout)
+	if err != nil {
+		return domain.NewConfigError(configPath, "failed to lock config file", err)
+	}
+	defer unlock()
+
+	content, err := os.ReadFile(configPath) // #nosec G304 -- XDG config path
+	if err != nil && !os.IsNotExist(err) {
+		return domain.NewConfigError(configPath, "failed to read config file", err)
+	}
+	updated := setConfigKey(string(content), key, literal)
+
+	// Validate the edited file on its own koanf so a rejected edit leaves m untouched
+	check := &koanfConfigManager{ko: koanf.New(".")}
+	if err := check.loadDefaults(); err != nil {
+		return domain.NewConfigError("", "failed to load default configuration", err)
+	}
+	if err := check.ko.Load(contentProvider([]byte(updated)), toml.Parser()); err != nil {
+		return domain.NewConfigError(configPath, "edited config file does not parse", err)
+	}
+	config, err := check.decode(configPath)
+	if err != nil {
+		return err
+	}
+
+	if err := WriteFileAtomic(configPath, []byte(updated), 0644); err != nil {
+		return domain.NewConfigError(configPath, "failed to write config file", err)
+	}
+	m.ko, m.config = check.ko, config
+	return nil
+}
+
+// contentProvider is a koanf.Provider over config file content held in memory
+type contentProvider []byte
+
+// ReadBytes returns the content for the parser
+func (p contentProvider) ReadBytes() ([]byte, error) {
+	return p, nil
+}
+
+// Read is not supported; the content needs a parser
+func (p contentProvider) Read() (map[string]interface{}, error) {
+	return nil, errors.New("contentProvider requires a parser")
 }
 
 // GetConfig returns the loaded configuration (immutable copy)
@@ -173,6 +363,9 @@ func (m *koanfConfigManager) loadDefaults() error {
 	if err := m.ko.Set("default_source_branch", defaults.DefaultSourceBranch); err != nil {
 		return fmt.Errorf("failed to set default_source_branch default: %w", err)
 	}
+	if err := m.ko.Set("cd_on_create", defaults.CdOnCreate); err != nil {
+		return fmt.Errorf("failed to set cd_on_create default: %w", err)
+	}
 
 	// Set nested structure defaults
 	if err := m.ko.Set("context_detection.cache_ttl", defaults.ContextDetection.CacheTTL); err != nil {
@@ -191,12 +384,79 @@ func (m *koanfConfigManager) loadDefaults() error {
 	if err := m.ko.Set("git.cache_enabled", defaults.Git.CacheEnabled); err != nil {
 		return fmt.Errorf("failed to set git.cache_enabled default: %w", err)
 	}
+	if err := m.ko.Set("git.default_merge_strategy", defaults.Git.DefaultMergeStrategy); err != nil {
+		return fmt.Errorf("failed to set git.default_merge_strategy default: %w", err)
+	}
+	if err := m.ko.Set("navigation.history_size", defaults.Navigation.HistorySize); err != nil {
+		return fmt.Errorf("failed to set navigation.history_size default: %w", err)
+	}
 	if err := m.ko.Set("completion.timeout", defaults.Completion.Timeout); err != nil {
 		return fmt.Errorf("failed to set completion.timeout default: %w", err)
 	}
+	if err := m.ko.Set("theme.age_color_young_days", defaults.Theme.AgeColorYoungDays); err != nil {
+		return fmt.Errorf("failed to set theme.age_color_young_days default: %w", err)
+	}
+	if err := m.ko.Set("theme.age_color_old_days", defaults.Theme.AgeColorOldDays); err != nil {
+		return fmt.Errorf("failed to set theme.age_color_old_days default: %w", err)
+	}
+	if err := m.ko.Set("theme.age_color_stale_days", defaults.Theme.AgeColorStaleDays); err != nil {
+		return fmt.Errorf("failed to set theme.age_color_stale_days default: %w", err)
+	}
+	return nil
+}
+
+// loadEnv sets every config key whose environment variable (ConfigEnvVar) is set, parsing
+// the value like 'twiggit config set'. Presets cannot be set from the environment.
+func (m *koanfConfigManager) loadEnv() error {
+	for _, key := range configEnvKeys(reflect.TypeOf(domain.Config{}), "") {
+		name := ConfigEnvVar(key)
+		value, ok := os.LookupEnv(name)
+		if !ok || configEnvReserved[name] {
+			continue
+		}
+		v, err := parseConfigValue(key, value)
+		if err != nil {
+			return domain.NewConfigError(name, "invalid environment variable: "+err.Error(), err)
+		}
+		if err := m.ko.Set(key, v.Interface()); err != nil {
+			return domain.NewConfigError(name, "failed to apply environment variable", err)
+		}
+	}
 	return nil
 }
 
+// configEnvReserved are TWIGGIT_* variables with another meaning: the shell wrapper exports
+// TWIGGIT_CD_ON_CREATE=1 for every create, which must not override cd_on_create
+var configEnvReserved = map[string]bool{"TWIGGIT_CD_ON_CREATE": true}
+
+// ConfigEnvVar returns the environment variable that overrides a dotted config key,
+// e.g. TWIGGIT_GIT_CLI_TIMEOUT for git.cli_timeout
+func ConfigEnvVar(key string) string {
+	return "TWIGGIT_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+// configEnvKeys lists the dotted keys of the settable fields of a config struct, skipping maps
+func configEnvKeys(t reflect.Type, prefix string) []string {
+	var keys []string
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, ok := sampleConfigKey(field)
+		if !ok {
+			continue
+		}
+		key := prefix + name
+		switch {
+		case field.Type.Kind() == reflect.Map:
+			continue
+		case field.Type.Kind() == reflect.Struct:
+			keys = append(keys, configEnvKeys(field.Type, key+".")...)
+		default:
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
 // getConfigFilePath returns the path to the configuration file following XDG Base Directory specification
 func (m *koanfConfigManager) getConfigFilePath() string {
 	xdgHome := os.Getenv("XDG_CONFIG_HOME")
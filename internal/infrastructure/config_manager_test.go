@@ -3,7 +3,11 @@ package infrastructure
 import (
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -42,8 +46,11 @@ func TestConfigManager_LoadDefaults(t *testing.T) {
 	assert.Equal(t, defaultConfig.DefaultSourceBranch, config.DefaultSourceBranch)
 	assert.Equal(t, defaultConfig.Git.CLITimeout, config.Git.CLITimeout)
 	assert.Equal(t, defaultConfig.Git.CacheEnabled, config.Git.CacheEnabled)
+	assert.Equal(t, "merge", config.Git.DefaultMergeStrategy)
+	assert.True(t, config.CdOnCreate, "cd_on_create defaults to true")
 
 	assert.Equal(t, defaultConfig.ContextDetection.CacheTTL, config.ContextDetection.CacheTTL)
+	assert.Equal(t, defaultConfig.Navigation.HistorySize, config.Navigation.HistorySize)
 }
 
 func TestConfigManager_GetConfigImmutable(t *testing.T) {
@@ -180,6 +187,16 @@ func TestConfigManager_CopyConfig(t *testing.T) {
 	copiedConfig.ProjectsDirectory = "/modified/path"
 	assert.NotEqual(t, "/modified/path", originalConfig.ProjectsDirectory)
 	assert.Equal(t, "/home/user/Projects", originalConfig.ProjectsDirectory)
+
+	t.Run("copies every section", func(t *testing.T) {
+		full := domain.DefaultConfig()
+		full.BranchDescriptionTemplate = "{{.Branch}}"
+		full.CdOnCreate = false
+		full.MaxBehindCommits = 10
+		full.Theme.AgeColorStaleDays = 90
+		full.Presets = map[string]domain.PresetConfig{"hotfix": {SourceBranch: "production", AutoFetch: true}}
+		assert.Equal(t, full, copyConfig(full))
+	})
 }
 
 func TestConfigManager_LoadDefaultsErrorHandling(t *testing.T) {
@@ -384,3 +401,358 @@ backup_dir = "~/backups"
 	assert.Equal(t, "/custom/worktrees", config.WorktreesDirectory)
 	assert.Equal(t, filepath.Join(tempDir, "backups"), config.Shell.Wrapper.BackupDir)
 }
+
+func TestConfigManager_LoadEnvOverrides(t *testing.T) {
+	configFile := `projects_dir = "/toml/projects"
+worktrees_dir = "/toml/worktrees"
+default_source_branch = "develop"
+max_behind_commits = 10
+
+[git]
+cli_timeout = 30
+gpg_sign_commits = false
+
+[services]
+cache_ttl = "1m"
+
+[validation]
+protected_branches = ["main"]
+
+[navigation]
+fuzzy_matching = false
+`
+
+	testCases := []struct {
+		name   string
+		envVar string
+		value  string
+		check  func(t *testing.T, config *domain.Config)
+	}{
+		{name: "projects dir", envVar: "TWIGGIT_PROJECTS_DIR", value: "/env/projects", check: func(t *testing.T, c *domain.Config) {
+			assert.Equal(t, "/env/projects", c.ProjectsDirectory)
+		}},
+		{name: "worktrees dir", envVar: "TWIGGIT_WORKTREES_DIR", value: "/env/worktrees", check: func(t *testing.T, c *domain.Config) {
+			assert.Equal(t, "/env/worktrees", c.WorktreesDirectory)
+		}},
+		{name: "default source branch", envVar: "TWIGGIT_DEFAULT_SOURCE_BRANCH", value: "trunk", check: func(t *testing.T, c *domain.Config) {
+			assert.Equal(t, "trunk", c.DefaultSourceBranch)
+		}},
+		{name: "integer", envVar: "TWIGGIT_MAX_BEHIND_COMMITS", value: "3", check: func(t *testing.T, c *domain.Config) {
+			assert.Equal(t, 3, c.MaxBehindCommits)
+		}},
+		{name: "nested integer", envVar: "TWIGGIT_GIT_CLI_TIMEOUT", value: "90", check: func(t *testing.T, c *domain.Config) {
+			assert.Equal(t, 90, c.Git.CLITimeout)
+		}},
+		{name: "boolean true", envVar: "TWIGGIT_GIT_GPG_SIGN_COMMITS", value: "true", check: func(t *testing.T, c *domain.Config) {
+			assert.True(t, c.Git.GPGSignCommits)
+		}},
+		{name: "boolean 1", envVar: "TWIGGIT_GIT_GPG_SIGN_COMMITS", value: "1", check: func(t *testing.T, c *domain.Config) {
+			assert.True(t, c.Git.GPGSignCommits)
+		}},
+		{name: "boolean yes", envVar: "TWIGGIT_NAVIGATION_FUZZY_MATCHING", value: "yes", check: func(t *testing.T, c *domain.Config) {
+			assert.True(t, c.Navigation.FuzzyMatching)
+		}},
+		{name: "duration", envVar: "TWIGGIT_SERVICES_CACHE_TTL", value: "5m", check: func(t *testing.T, c *domain.Config) {
+			assert.Equal(t, 5*time.Minute, c.Services.CacheTTL)
+		}},
+		{name: "list", envVar: "TWIGGIT_VALIDATION_PROTECTED_BRANCHES", value: "main, release/*", check: func(t *testing.T, c *domain.Config) {
+			assert.Equal(t, []string{"main", "release/*"}, c.Validation.ProtectedBranches)
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			manager, tempDir, _ := setupConfigManagerTest(t)
+			configPath := resolveConfigPath(tempDir, "")
+			require.NoError(t, os.MkdirAll(filepath.Dir(configPath), 0755))
+			require.NoError(t, os.WriteFile(configPath, []byte(configFile), 0644))
+			t.Setenv(tc.envVar, tc.value)
+
+			config, err := manager.Load()
+			require.NoError(t, err)
+			tc.check(t, config)
+		})
+	}
+
+	t.Run("invalid value", func(t *testing.T) {
+		manager, _, _ := setupConfigManagerTest(t)
+		t.Setenv("TWIGGIT_GIT_CLI_TIMEOUT", "soon")
+
+		_, err := manager.Load()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "TWIGGIT_GIT_CLI_TIMEOUT")
+		assert.Contains(t, err.Error(), "git.cli_timeout must be a whole number")
+	})
+
+	t.Run("shell wrapper variable is not an override", func(t *testing.T) {
+		manager, tempDir, _ := setupConfigManagerTest(t)
+		configPath := resolveConfigPath(tempDir, "")
+		require.NoError(t, os.MkdirAll(filepath.Dir(configPath), 0755))
+		require.NoError(t, os.WriteFile(configPath, []byte("cd_on_create = false\n"), 0644))
+		t.Setenv("TWIGGIT_CD_ON_CREATE", "1")
+
+		config, err := manager.Load()
+		require.NoError(t, err)
+		assert.False(t, config.CdOnCreate)
+	})
+}
+
+func TestConfigEnvVar(t *testing.T) {
+	assert.Equal(t, "TWIGGIT_PROJECTS_DIR", ConfigEnvVar("projects_dir"))
+	assert.Equal(t, "TWIGGIT_SHELL_WRAPPER_BACKUP_DIR", ConfigEnvVar("shell.wrapper.backup_dir"))
+
+	keys := configEnvKeys(reflect.TypeOf(domain.Config{}), "")
+	assert.Contains(t, keys, "default_source_branch")
+	assert.Contains(t, keys, "shell.wrapper.backup_dir")
+	for _, key := range keys {
+		assert.NotContains(t, key, "presets", "presets are not settable from the environment")
+	}
+}
+
+func TestConfigManager_LoadDefaultMergeStrategy(t *testing.T) {
+	manager, tempDir, _ := setupConfigManagerTest(t)
+	configPath := resolveConfigPath(tempDir, "")
+	require.NoError(t, os.MkdirAll(filepath.Dir(configPath), 0755))
+
+	require.NoError(t, os.WriteFile(configPath, []byte("[git]\ndefault_merge_strategy = \"squash\"\n"), 0644))
+	config, err := manager.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "squash", config.Git.DefaultMergeStrategy)
+
+	require.NoError(t, os.WriteFile(configPath, []byte("[git]\ndefault_merge_strategy = \"octopus\"\n"), 0644))
+	_, err = manager.Load()
+	require.Error(t, err, "unsupported strategies are rejected")
+	assert.Contains(t, err.Error(), "validation failed")
+}
+
+func TestConfigManager_LoadPresets(t *testing.T) {
+	manager, tempDir, _ := setupConfigManagerTest(t)
+	configPath := resolveConfigPath(tempDir, "")
+	require.NoError(t, os.MkdirAll(filepath.Dir(configPath), 0755))
+
+	content := `[presets.hotfix]
+source_branch = "production"
+protected_on_delete = true
+hooks = ["make deps"]
+auto_fetch = true
+
+[presets.spike]
+naming_template = "spike-{{.Name}}-wip"
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(content), 0644))
+	config, err := manager.Load()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]domain.PresetConfig{
+		"hotfix": {SourceBranch: "production", ProtectedOnDelete: true, Hooks: []string{"make deps"}, AutoFetch: true},
+		"spike":  {NamingTemplate: "spike-{{.Name}}-wip"},
+	}, config.Presets)
+
+	require.NoError(t, os.WriteFile(configPath, []byte("[presets.spike]\nnaming_template = \"{{.Name\"\n"), 0644))
+	_, err = manager.Load()
+	require.Error(t, err, "invalid naming templates are rejected")
+	assert.Contains(t, err.Error(), "validation failed")
+}
+
+func TestConfigManager_ReloadDropsRemovedKeys(t *testing.T) {
+	manager, tempDir, _ := setupConfigManagerTest(t)
+	configPath := resolveConfigPath(tempDir, "")
+	require.NoError(t, os.MkdirAll(filepath.Dir(configPath), 0755))
+
+	require.NoError(t, os.WriteFile(configPath, []byte("default_source_branch = \"develop\"\n"), 0644))
+	config, err := manager.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "develop", config.DefaultSourceBranch)
+
+	require.NoError(t, os.WriteFile(configPath, []byte(""), 0644))
+	config, err = manager.Load()
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultConfig().DefaultSourceBranch, config.DefaultSourceBranch)
+}
+
+func TestConfigManager_SetValue(t *testing.T) {
+	manager, tempDir, _ := setupConfigManagerTest(t)
+	configPath := resolveConfigPath(tempDir, "")
+
+	require.NoError(t, manager.SetValue("default_sort", "branch"), "the config file and its directory are created")
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "default_sort = \"branch\"\n", string(data))
+	assert.Equal(t, "branch", manager.GetConfig().DefaultSort)
+
+	require.NoError(t, os.WriteFile(configPath, []byte("# keep me\ndefault_sort = \"branch\"\n"), 0644))
+	require.NoError(t, manager.SetValue("default_sort", "age"))
+	config, err := manager.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "age", config.DefaultSort)
+	data, err = os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "# keep me", "comments are kept")
+
+	err = manager.SetValue("default_sort", "status")
+	require.Error(t, err, "invalid values are rejected")
+	assert.Contains(t, err.Error(), "validation failed")
+	data, err = os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `default_sort = "age"`, "a rejected value leaves the file alone")
+
+	require.NoError(t, manager.SetValue("default_sort", ""))
+	config, err = manager.Load()
+	require.NoError(t, err)
+	assert.Empty(t, config.DefaultSort)
+
+	err = manager.SetValue("no_such_key", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown configuration key")
+}
+
+func TestConfigManager_SetValueConcurrent(t *testing.T) {
+	_, tempDir, _ := setupConfigManagerTest(t)
+	configPath := resolveConfigPath(tempDir, "")
+
+	keys := map[string]string{
+		"default_sort":                  "path",
+		"git.cli_timeout":               "42",
+		"git.cache_enabled":             "false",
+		"shell.timeout":                 "12",
+		"navigation.max_suggestions":    "7",
+		"validation.max_delete_default": "3",
+		"completion.timeout":            "1s",
+		"default_source_branch":         "develop",
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(keys)*5)
+	for round := range 5 {
+		for key, value := range keys {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				// Separate managers stand in for separate twiggit processes
+				if round%2 == 1 {
+					value = ""
+				}
+				errs <- NewConfigManager().SetValue(key, value)
+			}()
+		}
+		wg.Wait()
+	}
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	// Rounds alternate set and unset, ending with set: every key must be present once
+	config, err := NewConfigManager().Load()
+	require.NoError(t, err, "the file is never corrupted")
+	assert.Equal(t, "path", config.DefaultSort)
+	assert.Equal(t, 42, config.Git.CLITimeout)
+	assert.False(t, config.Git.CacheEnabled)
+	assert.Equal(t, 7, config.Navigation.MaxSuggestions)
+	assert.Equal(t, "develop", config.DefaultSourceBranch)
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(data), "default_sort ="))
+	assert.NoFileExists(t, configPath+".lock")
+}
+
+func TestConfigManager_LoadForProject(t *testing.T) {
+	globalFile := `default_source_branch = "main"
+max_behind_commits = 5
+
+[validation]
+protected_branches = ["main", "release/*"]
+`
+
+	testCases := []struct {
+		name        string
+		projectFile string // "" writes no .twiggit.toml
+		env         map[string]string
+		expectError string
+		// Suggestion of the ValidationError behind expectError
+		expectSuggestion string
+		check            func(t *testing.T, c *domain.Config)
+	}{
+		{
+			name: "no project file keeps the global config",
+			check: func(t *testing.T, c *domain.Config) {
+				assert.Equal(t, "main", c.DefaultSourceBranch)
+				assert.Equal(t, []string{"main", "release/*"}, c.Validation.ProtectedBranches)
+			},
+		},
+		{
+			name: "scalars replace and lists are merged",
+			projectFile: `default_worktree = "develop"
+default_source_branch = "develop"
+
+[validation]
+protected_branches = ["develop", "main"]
+
+[hooks.post-create]
+commands = ["make deps"]
+`,
+			check: func(t *testing.T, c *domain.Config) {
+				assert.Equal(t, "develop", c.DefaultSourceBranch)
+				assert.Equal(t, 5, c.MaxBehindCommits)
+				assert.Equal(t, []string{"main", "release/*", "develop"}, c.Validation.ProtectedBranches)
+			},
+		},
+		{
+			name:        "environment variables beat the project",
+			projectFile: "default_source_branch = \"develop\"\n",
+			env:         map[string]string{"TWIGGIT_DEFAULT_SOURCE_BRANCH": "trunk"},
+			check: func(t *testing.T, c *domain.Config) {
+				assert.Equal(t, "trunk", c.DefaultSourceBranch)
+			},
+		},
+		{
+			name:             "validated like the global config",
+			projectFile:      "max_behind_commits = -1\n",
+			expectError:      "validation failed",
+			expectSuggestion: "max_behind_commits cannot be negative",
+		},
+		{
+			name:        "directories cannot be overridden",
+			projectFile: "worktrees_dir = \"/elsewhere\"\n",
+			expectError: "worktrees_dir can only be set in the global config file",
+		},
+		{
+			name:        "unparsable project file",
+			projectFile: "default_source_branch = \n",
+			expectError: "project settings do not parse",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			manager, tempDir, _ := setupConfigManagerTest(t)
+			configPath := resolveConfigPath(tempDir, "")
+			require.NoError(t, os.MkdirAll(filepath.Dir(configPath), 0755))
+			require.NoError(t, os.WriteFile(configPath, []byte(globalFile), 0644))
+			repoPath := t.TempDir()
+			if tc.projectFile != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(repoPath, ".twiggit.toml"), []byte(tc.projectFile), 0644))
+			}
+			for name, value := range tc.env {
+				t.Setenv(name, value)
+			}
+			global, err := manager.Load()
+			require.NoError(t, err)
+
+			config, err := manager.LoadForProject(repoPath)
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				if tc.expectSuggestion != "" {
+					var validationErr *domain.ValidationError
+					require.ErrorAs(t, err, &validationErr)
+					assert.Contains(t, validationErr.Suggestions(), tc.expectSuggestion)
+				}
+				return
+			}
+			require.NoError(t, err)
+			tc.check(t, config)
+			assert.Equal(t, global, manager.GetConfig(), "LoadForProject must not change the loaded config")
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package infrastructure
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"twiggit/internal/domain"
+)
+
+// bareTOMLKey matches the table names TOML accepts without quotes
+var bareTOMLKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// SaveConfig writes every key of config to path as TOML, going through the toml tags of
+// domain.Config. The file is replaced atomically (WriteFileAtomic); loading it back gives
+// the same configuration. Unlike ConfigManager.SetValue, comments of an existing file are lost.
+func SaveConfig(path string, config *domain.Config) error {
+	content, err := buildConfigTOML(config)
+	if err != nil {
+		return domain.NewConfigError(path, "failed to serialize configuration", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil { // #nosec G301 -- standard directory perms (rwxr-xr-x)
+		return domain.NewConfigError(path, "failed to create directory "+filepath.Dir(path), err)
+	}
+	if err := WriteFileAtomic(path, []byte(content), 0644); err != nil {
+		return domain.NewConfigError(path, "failed to write config file", err)
+	}
+	return nil
+}
+
+// buildConfigTOML renders config as TOML without comments
+func buildConfigTOML(config *domain.Config) (string, error) {
+	var b strings.Builder
+	b.WriteString("# twiggit configuration\n")
+	if err := writeConfigTable(&b, "", reflect.ValueOf(*config)); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// writeConfigTable writes the scalar keys of a struct, then each nested struct as a [table]
+// and each map entry as a [table.<name>]; TOML requires a table's own keys before its sub-tables
+func writeConfigTable(b *strings.Builder, prefix string, v reflect.Value) error {
+	t := v.Type()
+	for i := range t.NumField() {
+		key, ok := sampleConfigKey(t.Field(i))
+		if !ok || v.Field(i).Kind() == reflect.Struct || v.Field(i).Kind() == reflect.Map {
+			continue
+		}
+		value, err := sampleConfigValue(v.Field(i), "")
+		if err != nil {
+			return fmt.Errorf("%s%s: %w", prefix, key, err)
+		}
+		fmt.Fprintf(b, "%s = %s\n", key, value)
+	}
+
+	for i := range t.NumField() {
+		key, ok := sampleConfigKey(t.Field(i))
+		if !ok {
+			continue
+		}
+		field := v.Field(i)
+		switch field.Kind() {
+		case reflect.Struct:
+			fmt.Fprintf(b, "\n[%s]\n", prefix+key)
+			if err := writeConfigTable(b, prefix+key+".", field); err != nil {
+				return err
+			}
+		case reflect.Map:
+			names := make([]string, 0, field.Len())
+			for _, name := range field.MapKeys() {
+				names = append(names, name.String())
+			}
+			slices.Sort(names)
+			for _, name := range names {
+				table := prefix + key + "." + tomlTableKey(name)
+				fmt.Fprintf(b, "\n[%s]\n", table)
+				if err := writeConfigTable(b, table+".", field.MapIndex(reflect.ValueOf(name))); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// tomlTableKey quotes a map key that is not a bare TOML key
+func tomlTableKey(name string) string {
+	if bareTOMLKey.MatchString(name) {
+		return name
+	}
+	return strconv.Quote(name)
+}
+
+// GetConfigValue returns the value of a dotted key of config in the form SetValue accepts:
+// lists comma-separated, durations in Go syntax (e.g. 5m0s). Tables are not readable.
+func GetConfigValue(config *domain.Config, key string) (string, error) {
+	if _, err := configFieldType(key); err != nil {
+		return "", err
+	}
+
+	v := reflect.ValueOf(*config)
+	for _, part := range strings.Split(key, ".") {
+		field, _ := configFieldByKey(v.Type(), part)
+		v = v.FieldByIndex(field.Index)
+	}
+
+	switch {
+	case v.Kind() == reflect.Slice:
+		items := make([]string, v.Len())
+		for i := range v.Len() {
+			items[i] = v.Index(i).String()
+		}
+		return strings.Join(items, ","), nil
+	case v.Kind() == reflect.String:
+		return v.String(), nil
+	default:
+		return fmt.Sprint(v.Interface()), nil
+	}
+}
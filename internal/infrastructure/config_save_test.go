@@ -0,0 +1,106 @@
+package infrastructure
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+func TestSaveConfig_RoundTrip(t *testing.T) {
+	manager, tempDir, _ := setupConfigManagerTest(t)
+	configPath := resolveConfigPath(tempDir, "")
+
+	config, err := manager.Load()
+	require.NoError(t, err)
+	config.ProjectsDirectory = "/work/projects"
+	config.DefaultSort = "age"
+	config.BranchDescriptionTemplate = `Work on "{{.Branch}}"`
+	config.Git.CLITimeout = 45
+	config.Services.CacheTTL = 90 * time.Second
+	config.Validation.ProtectedBranches = []string{"main", "release/*"}
+	config.Presets = map[string]domain.PresetConfig{
+		"feature": {SourceBranch: "develop", Hooks: []string{"make deps"}},
+		"ui fix":  {NamingTemplate: "ui-{{.Name}}", ProtectedOnDelete: true, Hooks: []string{}},
+	}
+
+	require.NoError(t, SaveConfig(configPath, config))
+	loaded, err := manager.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "/work/projects", loaded.ProjectsDirectory)
+	assert.Equal(t, `Work on "{{.Branch}}"`, loaded.BranchDescriptionTemplate)
+	assert.Equal(t, 45, loaded.Git.CLITimeout)
+	assert.Equal(t, 90*time.Second, loaded.Services.CacheTTL)
+	assert.Equal(t, []string{"main", "release/*"}, loaded.Validation.ProtectedBranches)
+	assert.Equal(t, config.Presets, loaded.Presets)
+
+	// Saving what was loaded writes the same file and loads the same configuration
+	first, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	require.NoError(t, SaveConfig(configPath, loaded))
+	second, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, string(first), string(second))
+	reloaded, err := manager.Load()
+	require.NoError(t, err)
+	assert.Equal(t, loaded, reloaded)
+
+	entries, err := os.ReadDir(tempDir + "/twiggit")
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "no temporary file is left behind")
+}
+
+func TestSaveConfig_SetValueRoundTrip(t *testing.T) {
+	manager, tempDir, _ := setupConfigManagerTest(t)
+	configPath := resolveConfigPath(tempDir, "")
+	require.NoError(t, SaveConfig(configPath, domain.DefaultConfig()))
+
+	require.NoError(t, manager.SetValue("completion.exclude_branches", "wip/*, tmp"))
+	require.NoError(t, manager.SetValue("services.cache_ttl", "2m"))
+
+	loaded, err := manager.Load()
+	require.NoError(t, err)
+	value, err := GetConfigValue(loaded, "completion.exclude_branches")
+	require.NoError(t, err)
+	assert.Equal(t, "wip/*,tmp", value)
+	value, err = GetConfigValue(loaded, "services.cache_ttl")
+	require.NoError(t, err)
+	assert.Equal(t, "2m0s", value)
+}
+
+func TestGetConfigValue(t *testing.T) {
+	config := domain.DefaultConfig()
+	config.DefaultSourceBranch = "develop"
+	config.Git.CacheEnabled = false
+	config.Navigation.HistorySize = 25
+
+	testCases := []struct {
+		key         string
+		expected    string
+		expectError string
+	}{
+		{key: "default_source_branch", expected: "develop"},
+		{key: "git.cache_enabled", expected: "false"},
+		{key: "navigation.history_size", expected: "25"},
+		{key: "completion.exclude_projects", expected: ""},
+		{key: "git", expectError: "is a table"},
+		{key: "git.nope", expectError: "unknown configuration key"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.key, func(t *testing.T) {
+			value, err := GetConfigValue(config, tc.key)
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, value)
+		})
+	}
+}
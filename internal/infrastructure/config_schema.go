@@ -0,0 +1,187 @@
+package infrastructure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"time"
+
+	"twiggit/internal/domain"
+)
+
+// jsonSchemaDialect is the JSON Schema version GenerateJSONSchema writes
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// GenerateJSONSchema returns a JSON Schema of config.toml for editor validation and completion
+// (e.g. taplo). Like the sample config it is built by reflection over the toml tags of
+// domain.Config, with descriptions from configMeta and defaults from domain.DefaultConfig.
+// A schema reflector such as invopop/jsonschema would read json tags and still need the
+// descriptions, defaults and duration patterns added by key path, which this walk shares
+// with the sample config.
+func GenerateJSONSchema() ([]byte, error) {
+	home, _ := os.UserHomeDir()
+	schema, err := schemaForValue("", reflect.ValueOf(*domain.DefaultConfig()), home, true)
+	if err != nil {
+		return nil, domain.NewConfigError("", "failed to build configuration schema", err)
+	}
+	schema["$schema"] = jsonSchemaDialect
+	schema["title"] = "twiggit configuration"
+	return encodeJSONSchema(schema)
+}
+
+// GenerateProjectJSONSchema returns a JSON Schema of a project's .twiggit.toml: the keys of
+// config.toml it can override (all but projects_dir, worktrees_dir and presets), without
+// defaults since unset keys keep the global value, plus default_worktree and the hooks table
+func GenerateProjectJSONSchema() ([]byte, error) {
+	schema, err := schemaForValue("", reflect.ValueOf(domain.Config{}), "", false)
+	if err != nil {
+		return nil, domain.NewConfigError("", "failed to build project settings schema", err)
+	}
+	properties := schema["properties"].(map[string]any)
+	for key := range projectConfigExcluded {
+		delete(properties, key)
+	}
+	delete(properties, "presets")
+
+	properties[defaultWorktreeKey] = map[string]any{
+		"type":        "string",
+		"description": "Branch whose worktree 'twiggit cd <project>' opens (set with 'twiggit worktrees set-default')",
+	}
+	hook := func(description string) map[string]any {
+		return map[string]any{
+			"type":        "object",
+			"description": description,
+			"properties": map[string]any{
+				"commands":   map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+				"background": map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+			},
+			"additionalProperties": false,
+		}
+	}
+	properties["hooks"] = map[string]any{
+		"type":        "object",
+		"description": "Commands run in worktrees of the project",
+		"properties": map[string]any{
+			"post-create": hook("Commands run in a new worktree after create"),
+			"post-change": hook("Commands run in a watched worktree after its files change (background commands are ignored)"),
+		},
+		"additionalProperties": false,
+	}
+
+	schema["$schema"] = jsonSchemaDialect
+	schema["title"] = "twiggit project settings"
+	return encodeJSONSchema(schema)
+}
+
+// encodeJSONSchema encodes a schema as indented JSON
+func encodeJSONSchema(schema map[string]any) ([]byte, error) {
+	// Descriptions mention <name> placeholders, which default escaping would turn into \u003c
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(schema); err != nil {
+		return nil, domain.NewConfigError("", "failed to encode schema", err)
+	}
+	return b.Bytes(), nil
+}
+
+// schemaForValue describes the type of v; key is its dotted TOML path, used to look up the
+// descriptions of struct fields. With defaults, the values of v's fields are their defaults;
+// map values are zero values and have none.
+func schemaForValue(key string, v reflect.Value, home string, defaults bool) (map[string]any, error) {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		return map[string]any{"type": "string", "pattern": `^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`}, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}, nil
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}, nil
+	case reflect.Int, reflect.Int64:
+		return map[string]any{"type": "integer"}, nil
+	case reflect.Slice:
+		items, err := schemaForValue(key, reflect.Zero(v.Type().Elem()), home, false)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"type": "array", "items": items}, nil
+	case reflect.Map:
+		values, err := schemaForValue(key+".*", reflect.Zero(v.Type().Elem()), home, false)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"type": "object", "additionalProperties": values}, nil
+	case reflect.Struct:
+		return schemaForStruct(key, v, home, defaults)
+	default:
+		return nil, fmt.Errorf("%s: unsupported type %s", key, v.Type())
+	}
+}
+
+// schemaForStruct describes a TOML table; keys not in the struct are rejected so that
+// misspelled keys show up in the editor
+func schemaForStruct(key string, v reflect.Value, home string, defaults bool) (map[string]any, error) {
+	prefix := ""
+	if key != "" {
+		prefix = key + "."
+	}
+
+	properties := map[string]any{}
+	t := v.Type()
+	for i := range t.NumField() {
+		fieldKey, ok := sampleConfigKey(t.Field(i))
+		if !ok {
+			continue
+		}
+		fullKey := prefix + fieldKey
+
+		property, err := schemaForValue(fullKey, v.Field(i), home, defaults)
+		if err != nil {
+			return nil, err
+		}
+		description, ok := configMeta[fullKey]
+		if !ok {
+			return nil, fmt.Errorf("configuration key %s has no description in configMeta", fullKey)
+		}
+		property["description"] = description
+		if value := schemaDefault(v.Field(i), home); defaults && value != nil {
+			property["default"] = value
+		}
+		properties[fieldKey] = property
+	}
+
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}, nil
+}
+
+// schemaDefault returns the value of a scalar or list field as the sample config writes it,
+// nil for tables and maps
+func schemaDefault(v reflect.Value, home string) any {
+	if d, ok := v.Interface().(time.Duration); ok {
+		return d.String()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return tildePath(v.String(), home)
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int64:
+		return v.Int()
+	case reflect.Slice:
+		items := make([]any, 0, v.Len())
+		for i := range v.Len() {
+			items = append(items, schemaDefault(v.Index(i), home))
+		}
+		return items
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,163 @@
+package infrastructure
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/knadh/koanf/parsers/toml"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validateSchema validates value with a JSON Schema 2020-12 validator, after compiling the
+// schema against the draft's meta-schema. It returns "<instance location>: <message>" for
+// every failing keyword, sorted.
+func validateSchema(t *testing.T, schemaData []byte, value any) []string {
+	t.Helper()
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(schemaData))
+	require.NoError(t, err)
+	compiler := jsonschema.NewCompiler()
+	require.NoError(t, compiler.AddResource("schema.json", doc))
+	schema, err := compiler.Compile("schema.json")
+	require.NoError(t, err)
+
+	// The parsed TOML goes through JSON, as an editor sees the document
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	err = schema.Validate(instance)
+	if err == nil {
+		return nil
+	}
+	var validationErr *jsonschema.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	var messages []string
+	for _, unit := range validationErr.BasicOutput().Errors {
+		if unit.Error != nil {
+			messages = append(messages, unit.InstanceLocation+": "+unit.Error.String())
+		}
+	}
+	sort.Strings(messages)
+	return messages
+}
+
+// parseTOML parses config file content the way ConfigManager does
+func parseTOML(t *testing.T, content string) map[string]any {
+	t.Helper()
+	value, err := toml.Parser().Unmarshal([]byte(content))
+	require.NoError(t, err)
+	return value
+}
+
+func TestGenerateJSONSchema(t *testing.T) {
+	data, err := GenerateJSONSchema()
+	require.NoError(t, err)
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(data, &schema))
+
+	assert.Equal(t, jsonSchemaDialect, schema["$schema"])
+	properties := schema["properties"].(map[string]any)
+	git := properties["git"].(map[string]any)
+	cliTimeout := git["properties"].(map[string]any)["cli_timeout"].(map[string]any)
+	assert.Equal(t, "integer", cliTimeout["type"])
+	assert.Equal(t, "Timeout for git commands, in seconds", cliTimeout["description"])
+	assert.InDelta(t, 30, cliTimeout["default"], 0)
+	assert.Equal(t, "~/Projects", properties["projects_dir"].(map[string]any)["default"])
+
+	presets := properties["presets"].(map[string]any)
+	preset := presets["additionalProperties"].(map[string]any)
+	autoFetch := preset["properties"].(map[string]any)["auto_fetch"].(map[string]any)
+	assert.Equal(t, "boolean", autoFetch["type"])
+	assert.NotContains(t, autoFetch, "default", "map values have no defaults")
+}
+
+func TestGenerateJSONSchema_ValidatesKnownGoodConfig(t *testing.T) {
+	schema, err := GenerateJSONSchema()
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, WriteSampleConfig(path))
+	sample, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Empty(t, validateSchema(t, schema, parseTOML(t, string(sample))), "the sample config is valid")
+
+	custom := `projects_dir = "~/code"
+max_behind_commits = 20
+
+[services]
+cache_ttl = "1h30m"
+
+[validation]
+protected_branches = ["main", "release"]
+
+[presets.hotfix]
+source_branch = "production"
+hooks = ["make deps"]
+auto_fetch = true
+`
+	assert.Empty(t, validateSchema(t, schema, parseTOML(t, custom)))
+}
+
+func TestGenerateJSONSchema_RejectsInvalidConfig(t *testing.T) {
+	schema, err := GenerateJSONSchema()
+	require.NoError(t, err)
+
+	content := `default_source_brnch = "main"
+cd_on_create = "yes"
+
+[services]
+cache_ttl = "five minutes"
+
+[presets.hotfix]
+auto_fetch = 1
+`
+	assert.Equal(t, []string{
+		"/cd_on_create: got string, want boolean",
+		"/presets/hotfix/auto_fetch: got number, want boolean",
+		`/services/cache_ttl: 'five minutes' does not match pattern '^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$'`,
+		": additional properties 'default_source_brnch' not allowed",
+	}, validateSchema(t, schema, parseTOML(t, content)))
+}
+
+func TestGenerateProjectJSONSchema(t *testing.T) {
+	data, err := GenerateProjectJSONSchema()
+	require.NoError(t, err)
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(data, &schema))
+
+	valid := `default_worktree = "develop"
+default_source_branch = "develop"
+
+[validation]
+protected_branches = ["develop"]
+
+[hooks.post-create]
+commands = ["mise trust", "npm install"]
+background = ["npm run dev"]
+`
+	assert.Empty(t, validateSchema(t, data, parseTOML(t, valid)))
+	assert.NotContains(t, schema["properties"].(map[string]any)["default_source_branch"], "default",
+		"unset keys keep the global value")
+
+	invalid := `worktrees_dir = "/elsewhere"
+
+[presets.hotfix]
+auto_fetch = true
+
+[hooks.post-merge]
+commands = ["make"]
+`
+	messages := validateSchema(t, data, parseTOML(t, invalid))
+	require.Len(t, messages, 2)
+	assert.Equal(t, "/hooks: additional properties 'post-merge' not allowed", messages[0])
+	// The validator lists the extra keys of one table in map order
+	assert.Contains(t, messages[1], "'presets'")
+	assert.Contains(t, messages[1], "'worktrees_dir'")
+}
@@ -0,0 +1,131 @@
+package infrastructure
+
+import (
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.ConfigWatcher = (*configWatcher)(nil)
+
+// configReloadDelay lets a burst of events (truncate, then write) settle before reloading
+const configReloadDelay = 50 * time.Millisecond
+
+type configWatcher struct {
+	manager    application.ConfigManager
+	configPath string
+	watcher    *fsnotify.Watcher
+	done       sync.WaitGroup
+	stopOnce   sync.Once
+
+	// mutex protects subscribers and stopped
+	mutex       sync.Mutex
+	subscribers []chan *domain.Config
+	stopped     bool
+}
+
+// NewConfigWatcher reloads configuration through manager whenever configPath is written.
+// The parent directory is watched so that editors replacing the file are noticed too.
+func NewConfigWatcher(manager application.ConfigManager, configPath string) (application.ConfigWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, domain.NewConfigError(configPath, "failed to create config watcher", err)
+	}
+	if err := watcher.Add(filepath.Dir(configPath)); err != nil {
+		_ = watcher.Close()
+		return nil, domain.NewConfigError(configPath, "failed to watch config directory", err)
+	}
+
+	w := &configWatcher{
+		manager:    manager,
+		configPath: filepath.Clean(configPath),
+		watcher:    watcher,
+	}
+	w.done.Add(1)
+	go w.run()
+	return w, nil
+}
+
+// Subscribe returns a channel that receives each successfully reloaded configuration.
+// The channel holds only the latest configuration and is closed by Stop.
+func (w *configWatcher) Subscribe() <-chan *domain.Config {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	ch := make(chan *domain.Config, 1)
+	if w.stopped {
+		close(ch)
+		return ch
+	}
+	w.subscribers = append(w.subscribers, ch)
+	return ch
+}
+
+// Stop stops watching and closes every subscriber channel
+func (w *configWatcher) Stop() error {
+	var err error
+	w.stopOnce.Do(func() {
+		err = w.watcher.Close()
+		w.done.Wait()
+
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+		for _, ch := range w.subscribers {
+			close(ch)
+		}
+		w.subscribers = nil
+		w.stopped = true
+	})
+	return err
+}
+
+// run reloads the configuration on writes to the config file until the watcher is closed
+func (w *configWatcher) run() {
+	defer w.done.Done()
+
+	timer := time.NewTimer(configReloadDelay)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) != w.configPath || !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) {
+				continue
+			}
+			timer.Reset(configReloadDelay)
+		case <-timer.C:
+			config, err := w.manager.Load()
+			if err != nil {
+				// Keep the previous configuration until the file is valid again
+				continue
+			}
+			w.publish(config)
+		case _, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+// publish hands config to every subscriber, replacing a configuration not yet received
+func (w *configWatcher) publish(config *domain.Config) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	for _, ch := range w.subscribers {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- config
+	}
+}
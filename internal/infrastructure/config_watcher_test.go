@@ -0,0 +1,91 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+func setupConfigWatcherTest(t *testing.T, initial string) (string, <-chan *domain.Config) {
+	t.Helper()
+	manager, tempDir, _ := setupConfigManagerTest(t)
+	configPath := resolveConfigPath(tempDir, "")
+	require.NoError(t, os.MkdirAll(filepath.Dir(configPath), 0755))
+	require.NoError(t, os.WriteFile(configPath, []byte(initial), 0644))
+	_, err := manager.Load()
+	require.NoError(t, err)
+
+	watcher, err := NewConfigWatcher(manager, configPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = watcher.Stop() })
+	return configPath, watcher.Subscribe()
+}
+
+func TestConfigWatcher_ReloadsOnWrite(t *testing.T) {
+	configPath, updates := setupConfigWatcherTest(t, "default_source_branch = \"main\"\n")
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`default_source_branch = "develop"
+
+[validation]
+protected_branches = ["main", "release"]
+`), 0644))
+
+	select {
+	case config := <-updates:
+		require.NotNil(t, config)
+		assert.Equal(t, "develop", config.DefaultSourceBranch)
+		assert.Equal(t, []string{"main", "release"}, config.Validation.ProtectedBranches)
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("config was not reloaded within 500ms")
+	}
+}
+
+func TestConfigWatcher_SkipsInvalidConfig(t *testing.T) {
+	configPath, updates := setupConfigWatcherTest(t, "default_source_branch = \"main\"\n")
+
+	require.NoError(t, os.WriteFile(configPath, []byte("default_source_branch = [\n"), 0644))
+	select {
+	case config := <-updates:
+		t.Fatalf("invalid config must not be published, got %+v", config)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	require.NoError(t, os.WriteFile(configPath, []byte("default_source_branch = \"trunk\"\n"), 0644))
+	select {
+	case config := <-updates:
+		assert.Equal(t, "trunk", config.DefaultSourceBranch)
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("config was not reloaded after it became valid")
+	}
+}
+
+func TestConfigWatcher_StopClosesSubscribers(t *testing.T) {
+	manager, tempDir, _ := setupConfigManagerTest(t)
+	configPath := resolveConfigPath(tempDir, "")
+	require.NoError(t, os.MkdirAll(filepath.Dir(configPath), 0755))
+
+	watcher, err := NewConfigWatcher(manager, configPath)
+	require.NoError(t, err)
+	updates := watcher.Subscribe()
+
+	require.NoError(t, watcher.Stop())
+	require.NoError(t, watcher.Stop(), "stopping twice is a no-op")
+
+	_, open := <-updates
+	assert.False(t, open)
+	_, open = <-watcher.Subscribe()
+	assert.False(t, open, "subscribing after Stop returns a closed channel")
+}
+
+func TestConfigWatcher_MissingDirectory(t *testing.T) {
+	_, err := NewConfigWatcher(NewConfigManager(), filepath.Join(t.TempDir(), "missing", "config.toml"))
+	require.Error(t, err)
+	var configErr *domain.ConfigError
+	assert.ErrorAs(t, err, &configErr)
+}
@@ -1,6 +1,7 @@
 package infrastructure
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -8,6 +9,8 @@ import (
 	"sync"
 	"time"
 
+	gitconfig "github.com/go-git/go-git/v5/plumbing/format/config"
+
 	"twiggit/internal/application"
 	"twiggit/internal/domain"
 )
@@ -17,11 +20,25 @@ type worktreeCacheEntry struct {
 	expiresAt time.Time
 }
 
+// contextEnvVar overrides context detection with "project" or "project/branch"
+const contextEnvVar = "TWIGGIT_CONTEXT"
+
+// ciContextEnvVars lists the project and branch variables of supported CI platforms, in priority order
+var ciContextEnvVars = []struct {
+	project string
+	branch  string
+}{
+	{project: "CI_PROJECT_NAME", branch: "CI_BRANCH_NAME"},
+	{project: "GITHUB_REPOSITORY", branch: "GITHUB_REF_NAME"},
+	{project: "BITBUCKET_REPO_SLUG", branch: "BITBUCKET_BRANCH"},
+}
+
 type contextDetector struct {
 	config *domain.Config
 	cache  map[string]worktreeCacheEntry
 	mu     sync.RWMutex
 	ttl    time.Duration
+	getenv func(string) string
 }
 
 // NewContextDetector creates a new context detector
@@ -31,6 +48,7 @@ func NewContextDetector(cfg *domain.Config) application.ContextDetector {
 		config: cfg,
 		cache:  make(map[string]worktreeCacheEntry),
 		ttl:    ttl,
+		getenv: os.Getenv,
 	}
 }
 
@@ -64,6 +82,16 @@ func (cd *contextDetector) DetectContext(dir string) (*domain.Context, error) {
 		return nil, domain.NewContextDetectionError(dir, "failed to normalize directory", err)
 	}
 
+	// Context injected through the environment wins over git-based detection
+	envCtx, err := cd.DetectFromEnvironment()
+	if err != nil {
+		return nil, err
+	}
+	if envCtx != nil {
+		envCtx.Path = normalizedDir
+		return envCtx, nil
+	}
+
 	// Perform detection
 	ctx := cd.detectContextInternal(normalizedDir)
 	if ctx == nil {
@@ -73,18 +101,71 @@ func (cd *contextDetector) DetectContext(dir string) (*domain.Context, error) {
 	return ctx, nil
 }
 
+// DetectFromEnvironment builds a context from TWIGGIT_CONTEXT or, failing that, from the first
+// CI platform whose project and branch variables are both set. Returns nil when none apply.
+func (cd *contextDetector) DetectFromEnvironment() (*domain.Context, error) {
+	if value := cd.getenv(contextEnvVar); value != "" {
+		projectName, branchName, _ := strings.Cut(value, "/")
+		if projectName == "" || strings.HasSuffix(value, "/") {
+			return nil, domain.NewContextDetectionError(contextEnvVar, fmt.Sprintf("invalid value '%s': expected 'project' or 'project/branch'", value), nil)
+		}
+		return cd.environmentContext(projectName, branchName, contextEnvVar), nil
+	}
+
+	for _, vars := range ciContextEnvVars {
+		project := cd.getenv(vars.project)
+		branch := cd.getenv(vars.branch)
+		if project == "" || branch == "" {
+			continue
+		}
+		// GITHUB_REPOSITORY is "owner/repo"
+		if i := strings.LastIndex(project, "/"); i >= 0 {
+			project = project[i+1:]
+		}
+		return cd.environmentContext(project, branch, vars.project+", "+vars.branch), nil
+	}
+
+	return nil, nil
+}
+
+// environmentContext builds a worktree context, or a project context when branchName is empty,
+// located where twiggit would place it
+func (cd *contextDetector) environmentContext(projectName, branchName, source string) *domain.Context {
+	if branchName == "" {
+		return &domain.Context{
+			Type:        domain.ContextProject,
+			ProjectName: projectName,
+			Path:        filepath.Join(cd.config.ProjectsDirectory, projectName),
+			Explanation: fmt.Sprintf("Project '%s' from environment (%s)", projectName, source),
+		}
+	}
+	return &domain.Context{
+		Type:        domain.ContextWorktree,
+		ProjectName: projectName,
+		BranchName:  branchName,
+		Path:        filepath.Join(cd.config.WorktreesDirectory, projectName, branchName),
+		Explanation: fmt.Sprintf("Worktree for project '%s' on branch '%s' from environment (%s)", projectName, branchName, source),
+	}
+}
+
 func (cd *contextDetector) detectContextInternal(dir string) *domain.Context {
 	// Priority 1: Check worktree pattern first
 	if ctx := cd.detectWorktreeContext(dir); ctx != nil {
 		return ctx
 	}
 
-	// Priority 2: Check project context
+	// Priority 2: Linked worktree recorded in .git/config (symlinked git directory). Checked
+	// before the project context, which matches any directory with a .git entry.
+	if ctx, err := cd.DetectFromGitConfig(dir); err == nil && ctx != nil {
+		return ctx
+	}
+
+	// Priority 3: Check project context
 	if ctx := cd.detectProjectContext(dir); ctx != nil {
 		return ctx
 	}
 
-	// Priority 3: Outside git context
+	// Priority 4: Outside git context
 	return &domain.Context{
 		Type:        domain.ContextOutsideGit,
 		Path:        dir,
@@ -133,6 +214,81 @@ func (cd *contextDetector) detectWorktreeContext(dir string) *domain.Context {
 	}
 }
 
+// DetectFromGitConfig detects a linked worktree whose .git is a directory (or a symlink to one)
+// rather than a gitdir file: the worktree.gitdir option of its .git/config names the
+// <main>/.git/worktrees/<name> directory. Returns nil when the option is not set.
+func (cd *contextDetector) DetectFromGitConfig(path string) (*domain.Context, error) {
+	root := FindGitDirByTraversal(path)
+	if root == nil {
+		return nil, nil
+	}
+	gitPath := filepath.Join(*root, ".git")
+	if info, err := os.Stat(gitPath); err != nil || !info.IsDir() {
+		return nil, nil
+	}
+
+	gitdir, err := readWorktreeGitDirOption(filepath.Join(gitPath, "config"))
+	if err != nil {
+		return nil, domain.NewContextDetectionError(path, "failed to read .git/config", err)
+	}
+	if gitdir == "" {
+		return nil, nil
+	}
+	if !filepath.IsAbs(gitdir) {
+		gitdir = filepath.Join(*root, gitdir)
+	}
+	gitdir = filepath.Clean(gitdir)
+
+	mainRepo := mainRepoFromWorktreeGitDir(gitdir)
+	if mainRepo == "" {
+		return nil, domain.NewContextDetectionError(path, "worktree.gitdir "+gitdir+" is not a <repo>/.git/worktrees/<name> directory", nil)
+	}
+
+	projectName := cd.extractProjectName(mainRepo)
+	branchName := readHeadBranch(gitdir)
+	if branchName == "" {
+		branchName = filepath.Base(gitdir)
+	}
+	return &domain.Context{
+		Type:        domain.ContextWorktree,
+		ProjectName: projectName,
+		BranchName:  branchName,
+		Path:        path,
+		Explanation: fmt.Sprintf("In worktree for project '%s' on branch '%s' (from worktree.gitdir in .git/config)", projectName, branchName),
+	}, nil
+}
+
+// readWorktreeGitDirOption returns the worktree.gitdir option of a git config file, "" when unset
+func readWorktreeGitDirOption(configPath string) (string, error) {
+	file, err := os.Open(configPath) // #nosec G304 -- configPath is the .git/config of a detected repository
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = file.Close() }()
+
+	cfg := gitconfig.New()
+	if err := gitconfig.NewDecoder(file).Decode(cfg); err != nil {
+		return "", err
+	}
+	return cfg.Section("worktree").Option("gitdir"), nil
+}
+
+// readHeadBranch returns the branch the HEAD file of gitDir points at, "" when detached or unreadable
+func readHeadBranch(gitDir string) string {
+	content, err := os.ReadFile(filepath.Join(gitDir, "HEAD")) // #nosec G304 -- HEAD of a worktree git directory
+	if err != nil {
+		return ""
+	}
+	branch, ok := strings.CutPrefix(strings.TrimSpace(string(content)), "ref: refs/heads/")
+	if !ok {
+		return ""
+	}
+	return branch
+}
+
 func (cd *contextDetector) detectProjectContext(dir string) *domain.Context {
 	gitDir := FindGitDirByTraversal(dir)
 	if gitDir != nil {
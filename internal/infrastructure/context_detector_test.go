@@ -194,6 +194,50 @@ func TestContextDetector_ProjectTraversal(t *testing.T) {
 	assert.Equal(t, tempDir, ctx.Path)
 }
 
+func TestContextDetector_DetectFromGitConfig(t *testing.T) {
+	tempDir := t.TempDir()
+
+	mainRepo := filepath.Join(tempDir, "Projects", "test-project")
+	worktreeGitDir := filepath.Join(mainRepo, ".git", "worktrees", "feature")
+	require.NoError(t, os.MkdirAll(worktreeGitDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(worktreeGitDir, "HEAD"), []byte("ref: refs/heads/feature-x\n"), 0644))
+
+	worktreeDir := filepath.Join(tempDir, "elsewhere", "feature")
+	require.NoError(t, os.MkdirAll(filepath.Join(worktreeDir, ".git"), 0755))
+	gitConfig := "[core]\n\tbare = false\n[worktree]\n\tgitdir = " + worktreeGitDir + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(worktreeDir, ".git", "config"), []byte(gitConfig), 0644))
+
+	detector := NewContextDetector(&domain.Config{WorktreesDirectory: filepath.Join(tempDir, "Worktrees")})
+
+	ctx, err := detector.DetectFromGitConfig(worktreeDir)
+	require.NoError(t, err)
+	require.NotNil(t, ctx)
+	assert.Equal(t, domain.ContextWorktree, ctx.Type)
+	assert.Equal(t, "test-project", ctx.ProjectName)
+	assert.Equal(t, "feature-x", ctx.BranchName)
+
+	// DetectContext falls back to it before treating the directory as a project
+	ctx, err = detector.DetectContext(worktreeDir)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ContextWorktree, ctx.Type)
+	assert.Equal(t, "test-project", ctx.ProjectName)
+
+	t.Run("no worktree section", func(t *testing.T) {
+		ctx, err := detector.DetectFromGitConfig(mainRepo)
+		require.NoError(t, err)
+		assert.Nil(t, ctx)
+	})
+
+	t.Run("gitdir outside a worktrees directory", func(t *testing.T) {
+		badDir := filepath.Join(tempDir, "bad")
+		require.NoError(t, os.MkdirAll(filepath.Join(badDir, ".git"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(badDir, ".git", "config"), []byte("[worktree]\n\tgitdir = /tmp/not-a-worktree\n"), 0644))
+
+		_, err := detector.DetectFromGitConfig(badDir)
+		require.Error(t, err)
+	})
+}
+
 func TestContextDetector_InvalidWorktree(t *testing.T) {
 	tempDir := t.TempDir()
 
@@ -282,3 +326,181 @@ func TestContextDetectionError(t *testing.T) {
 	assert.Equal(t, "context detection failed for /test/path: test message", err.Error())
 	assert.Equal(t, originalErr, err.Unwrap())
 }
+
+func TestContextDetector_DetectFromEnvironment(t *testing.T) {
+	config := &domain.Config{ProjectsDirectory: "/projects", WorktreesDirectory: "/worktrees"}
+
+	tests := []struct {
+		name           string
+		env            map[string]string
+		expectNil      bool
+		expectError    string
+		expectedType   domain.ContextType
+		expectedProj   string
+		expectedBranch string
+		expectedPath   string
+	}{
+		{
+			name:      "no environment variables",
+			env:       map[string]string{},
+			expectNil: true,
+		},
+		{
+			name:           "TWIGGIT_CONTEXT with branch",
+			env:            map[string]string{"TWIGGIT_CONTEXT": "proj/feature/login"},
+			expectedType:   domain.ContextWorktree,
+			expectedProj:   "proj",
+			expectedBranch: "feature/login",
+			expectedPath:   "/worktrees/proj/feature/login",
+		},
+		{
+			name:         "TWIGGIT_CONTEXT project only",
+			env:          map[string]string{"TWIGGIT_CONTEXT": "proj"},
+			expectedType: domain.ContextProject,
+			expectedProj: "proj",
+			expectedPath: "/projects/proj",
+		},
+		{
+			name: "TWIGGIT_CONTEXT takes priority over CI variables",
+			env: map[string]string{
+				"TWIGGIT_CONTEXT":   "proj/main",
+				"CI_PROJECT_NAME":   "gitlab-proj",
+				"CI_BRANCH_NAME":    "gitlab-branch",
+				"GITHUB_REPOSITORY": "owner/gh-proj",
+				"GITHUB_REF_NAME":   "gh-branch",
+			},
+			expectedType:   domain.ContextWorktree,
+			expectedProj:   "proj",
+			expectedBranch: "main",
+			expectedPath:   "/worktrees/proj/main",
+		},
+		{
+			name:        "TWIGGIT_CONTEXT without project",
+			env:         map[string]string{"TWIGGIT_CONTEXT": "/main"},
+			expectError: "expected 'project' or 'project/branch'",
+		},
+		{
+			name:        "TWIGGIT_CONTEXT with empty branch",
+			env:         map[string]string{"TWIGGIT_CONTEXT": "proj/"},
+			expectError: "expected 'project' or 'project/branch'",
+		},
+		{
+			name:           "CI_PROJECT_NAME and CI_BRANCH_NAME",
+			env:            map[string]string{"CI_PROJECT_NAME": "proj", "CI_BRANCH_NAME": "feature"},
+			expectedType:   domain.ContextWorktree,
+			expectedProj:   "proj",
+			expectedBranch: "feature",
+			expectedPath:   "/worktrees/proj/feature",
+		},
+		{
+			name:           "GitHub Actions strips the repository owner",
+			env:            map[string]string{"GITHUB_REPOSITORY": "owner/proj", "GITHUB_REF_NAME": "feature"},
+			expectedType:   domain.ContextWorktree,
+			expectedProj:   "proj",
+			expectedBranch: "feature",
+			expectedPath:   "/worktrees/proj/feature",
+		},
+		{
+			name:           "Bitbucket Pipelines",
+			env:            map[string]string{"BITBUCKET_REPO_SLUG": "proj", "BITBUCKET_BRANCH": "feature"},
+			expectedType:   domain.ContextWorktree,
+			expectedProj:   "proj",
+			expectedBranch: "feature",
+			expectedPath:   "/worktrees/proj/feature",
+		},
+		{
+			name: "CI_* takes priority over GitHub and Bitbucket",
+			env: map[string]string{
+				"CI_PROJECT_NAME":     "ci-proj",
+				"CI_BRANCH_NAME":      "ci-branch",
+				"GITHUB_REPOSITORY":   "owner/gh-proj",
+				"GITHUB_REF_NAME":     "gh-branch",
+				"BITBUCKET_REPO_SLUG": "bb-proj",
+				"BITBUCKET_BRANCH":    "bb-branch",
+			},
+			expectedType:   domain.ContextWorktree,
+			expectedProj:   "ci-proj",
+			expectedBranch: "ci-branch",
+			expectedPath:   "/worktrees/ci-proj/ci-branch",
+		},
+		{
+			name: "GitHub takes priority over Bitbucket",
+			env: map[string]string{
+				"GITHUB_REPOSITORY":   "owner/gh-proj",
+				"GITHUB_REF_NAME":     "gh-branch",
+				"BITBUCKET_REPO_SLUG": "bb-proj",
+				"BITBUCKET_BRANCH":    "bb-branch",
+			},
+			expectedType:   domain.ContextWorktree,
+			expectedProj:   "gh-proj",
+			expectedBranch: "gh-branch",
+			expectedPath:   "/worktrees/gh-proj/gh-branch",
+		},
+		{
+			name: "incomplete platform falls through to the next",
+			env: map[string]string{
+				"CI_PROJECT_NAME":     "gitlab-proj",
+				"BITBUCKET_REPO_SLUG": "bb-proj",
+				"BITBUCKET_BRANCH":    "bb-branch",
+			},
+			expectedType:   domain.ContextWorktree,
+			expectedProj:   "bb-proj",
+			expectedBranch: "bb-branch",
+			expectedPath:   "/worktrees/bb-proj/bb-branch",
+		},
+		{
+			name:      "project without branch is ignored",
+			env:       map[string]string{"GITHUB_REPOSITORY": "owner/proj"},
+			expectNil: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			detector := &contextDetector{
+				config: config,
+				cache:  make(map[string]worktreeCacheEntry),
+				getenv: func(key string) string { return tc.env[key] },
+			}
+
+			ctx, err := detector.DetectFromEnvironment()
+
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expectNil {
+				assert.Nil(t, ctx)
+				return
+			}
+			require.NotNil(t, ctx)
+			assert.Equal(t, tc.expectedType, ctx.Type)
+			assert.Equal(t, tc.expectedProj, ctx.ProjectName)
+			assert.Equal(t, tc.expectedBranch, ctx.BranchName)
+			assert.Equal(t, tc.expectedPath, ctx.Path)
+		})
+	}
+}
+
+func TestContextDetector_DetectContext_PrefersEnvironment(t *testing.T) {
+	dir := t.TempDir()
+	detector := &contextDetector{
+		config: &domain.Config{ProjectsDirectory: "/projects", WorktreesDirectory: "/worktrees"},
+		cache:  make(map[string]worktreeCacheEntry),
+		getenv: func(key string) string {
+			return map[string]string{"TWIGGIT_CONTEXT": "proj/feature"}[key]
+		},
+	}
+
+	ctx, err := detector.DetectContext(dir)
+	require.NoError(t, err)
+
+	normalized, err := NormalizePath(dir)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ContextWorktree, ctx.Type)
+	assert.Equal(t, "proj", ctx.ProjectName)
+	assert.Equal(t, "feature", ctx.BranchName)
+	assert.Equal(t, normalized, ctx.Path)
+}
@@ -111,7 +111,7 @@ func (cr *contextResolver) resolveMainIdentifier(ctx *domain.Context) (*domain.R
 		)
 	}
 
-	projectPath := filepath.Join(cr.config.ProjectsDirectory, ctx.ProjectName)
+	projectName, projectPath := cr.worktreeProject(ctx)
 	if err := validatePathUnder(cr.config.ProjectsDirectory, projectPath, "project", "projects"); err != nil {
 		return nil, err
 	}
@@ -119,8 +119,8 @@ func (cr *contextResolver) resolveMainIdentifier(ctx *domain.Context) (*domain.R
 	return &domain.ResolutionResult{
 		ResolvedPath: projectPath,
 		Type:         domain.PathTypeProject,
-		ProjectName:  ctx.ProjectName,
-		Explanation:  fmt.Sprintf("Resolved 'main' to project root '%s'", ctx.ProjectName),
+		ProjectName:  projectName,
+		Explanation:  fmt.Sprintf("Resolved 'main' to project root '%s'", projectName),
 	}, nil
 }
 
@@ -167,16 +167,52 @@ func filterSuggestions(suggestions []string, partial string) []string {
 }
 
 type contextResolver struct {
-	config     *domain.Config
-	gitService application.GitClient
+	config      *domain.Config
+	gitService  application.GitClient
+	ownerFinder application.WorktreeOwnerFinder
+}
+
+// ContextResolverOption configures a context resolver
+type ContextResolverOption func(*contextResolver)
+
+// WithWorktreeOwnerFinder makes the resolver look up the project owning the current worktree,
+// so worktrees outside the <worktrees_dir>/<project> layout still find their repository.
+// Without it, the project is assumed at <projects_dir>/<project>.
+func WithWorktreeOwnerFinder(finder application.WorktreeOwnerFinder) ContextResolverOption {
+	return func(cr *contextResolver) {
+		cr.ownerFinder = finder
+	}
+}
+
+// WorktreeOwnerFinderFunc adapts a function to WorktreeOwnerFinder
+type WorktreeOwnerFinderFunc func(ctx context.Context, worktreePath string) (*domain.ProjectInfo, error)
+
+// FindProjectByWorktreePath calls f
+func (f WorktreeOwnerFinderFunc) FindProjectByWorktreePath(ctx context.Context, worktreePath string) (*domain.ProjectInfo, error) {
+	return f(ctx, worktreePath)
 }
 
 // NewContextResolver creates a new context resolver
-func NewContextResolver(cfg *domain.Config, gitService application.GitClient) application.ContextResolver {
-	return &contextResolver{
+func NewContextResolver(cfg *domain.Config, gitService application.GitClient, opts ...ContextResolverOption) application.ContextResolver {
+	cr := &contextResolver{
 		config:     cfg,
 		gitService: gitService,
 	}
+	for _, opt := range opts {
+		opt(cr)
+	}
+	return cr
+}
+
+// worktreeProject returns the name and path of the context's project. In worktree context that
+// is the owner found by the finder; otherwise, or when none is found, <projects_dir>/<project>.
+func (cr *contextResolver) worktreeProject(ctx *domain.Context) (string, string) {
+	if ctx.Type == domain.ContextWorktree && cr.ownerFinder != nil && ctx.Path != "" {
+		if project, err := cr.ownerFinder.FindProjectByWorktreePath(context.Background(), ctx.Path); err == nil && project != nil {
+			return project.Name, project.Path
+		}
+	}
+	return ctx.ProjectName, filepath.Join(cr.config.ProjectsDirectory, ctx.ProjectName)
 }
 
 func (cr *contextResolver) ResolveIdentifier(ctx *domain.Context, identifier string) (*domain.ResolutionResult, error) {
@@ -345,7 +381,7 @@ func (cr *contextResolver) addBranchSuggestions(suggestions []*domain.Resolution
 	// When in worktree context, ListBranches should be called on project path, not worktree path
 	var listPath string
 	if ctx.Type == domain.ContextWorktree {
-		listPath = filepath.Join(cr.config.ProjectsDirectory, ctx.ProjectName)
+		_, listPath = cr.worktreeProject(ctx)
 	} else {
 		listPath = ctx.Path
 	}
@@ -460,10 +496,9 @@ func (cr *contextResolver) getWorktreeContextSuggestions(ctx *domain.Context, pa
 
 	if cr.gitService != nil && ctx.Path != "" {
 		// When in worktree context, ListWorktrees should be called on project path, not worktree path
-		// Construct project path from project name and projects directory
 		var listPath string
 		if ctx.Type == domain.ContextWorktree {
-			listPath = filepath.Join(cr.config.ProjectsDirectory, ctx.ProjectName)
+			_, listPath = cr.worktreeProject(ctx)
 		} else {
 			listPath = ctx.Path
 		}
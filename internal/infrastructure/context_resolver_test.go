@@ -1242,3 +1242,55 @@ func TestContextResolver_WorktreeStatusFields(t *testing.T) {
 		}
 	}
 }
+
+func TestContextResolver_WorktreeOwnerFinder(t *testing.T) {
+	config := setupContextResolverTest(t)
+	worktreePath := "/home/user/Worktrees/custom/feature-branch"
+	ctx := &domain.Context{
+		Type:        domain.ContextWorktree,
+		ProjectName: "custom",
+		BranchName:  "feature-branch",
+		Path:        worktreePath,
+	}
+
+	t.Run("main resolves to the owning project", func(t *testing.T) {
+		finder := mocks.NewMockProjectService()
+		finder.On("FindProjectByWorktreePath", mock.Anything, worktreePath).
+			Return(&domain.ProjectInfo{Name: "real-repo", Path: "/home/user/Projects/real-repo"}, nil)
+		resolver := NewContextResolver(config, nil, WithWorktreeOwnerFinder(finder))
+
+		result, err := resolver.ResolveIdentifier(ctx, "main")
+		require.NoError(t, err)
+		assert.Equal(t, "/home/user/Projects/real-repo", result.ResolvedPath)
+		assert.Equal(t, "real-repo", result.ProjectName)
+	})
+
+	t.Run("suggestions list the owning project", func(t *testing.T) {
+		finder := mocks.NewMockProjectService()
+		finder.On("FindProjectByWorktreePath", mock.Anything, worktreePath).
+			Return(&domain.ProjectInfo{Name: "real-repo", Path: "/home/user/Projects/real-repo"}, nil)
+		mockGitService := mocks.NewMockGitService()
+		mockGitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/home/user/Projects/real-repo").
+			Return([]domain.WorktreeInfo{{Branch: "other-branch", Path: "/home/user/Worktrees/custom/other-branch"}}, nil)
+		mockGitService.MockGoGitClient.On("ListBranches", mock.Anything, "/home/user/Projects/real-repo").
+			Return([]domain.BranchInfo{}, nil)
+		resolver := NewContextResolver(config, mockGitService, WithWorktreeOwnerFinder(finder))
+
+		suggestions, err := resolver.GetResolutionSuggestions(ctx, "other")
+		require.NoError(t, err)
+		require.Len(t, suggestions, 1)
+		assert.Equal(t, "other-branch", suggestions[0].Text)
+	})
+
+	t.Run("falls back to the projects directory", func(t *testing.T) {
+		finder := mocks.NewMockProjectService()
+		finder.On("FindProjectByWorktreePath", mock.Anything, worktreePath).
+			Return(nil, domain.NewProjectServiceError("", worktreePath, "FindProjectByWorktreePath", "no project owns this worktree", nil))
+		resolver := NewContextResolver(config, nil, WithWorktreeOwnerFinder(finder))
+
+		result, err := resolver.ResolveIdentifier(ctx, "main")
+		require.NoError(t, err)
+		assert.Equal(t, "/home/user/Projects/custom", result.ResolvedPath)
+		assert.Equal(t, "custom", result.ProjectName)
+	})
+}
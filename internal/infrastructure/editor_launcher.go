@@ -0,0 +1,47 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"twiggit/internal/application"
+)
+
+var _ application.EditorLauncher = (*editorLauncher)(nil)
+
+// editorLauncher runs editors attached to the terminal
+type editorLauncher struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// NewEditorLauncher creates an editor launcher connected to the process's standard streams
+func NewEditorLauncher() application.EditorLauncher {
+	return &editorLauncher{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}
+}
+
+// Open runs the editor in dir, passing files after any arguments contained in editor (e.g. "code --wait")
+func (l *editorLauncher) Open(ctx context.Context, dir, editor string, files []string) error {
+	fields := strings.Fields(editor)
+	if len(fields) == 0 {
+		return errors.New("editor command cannot be empty")
+	}
+
+	args := append(fields[1:], files...)
+	command := exec.CommandContext(ctx, fields[0], args...) // #nosec G204 -- editor is chosen by the user on the command line
+	command.Dir = dir
+	command.Stdin = l.stdin
+	command.Stdout = l.stdout
+	command.Stderr = l.stderr
+
+	if err := command.Run(); err != nil {
+		return fmt.Errorf("failed to run editor %s: %w", fields[0], err)
+	}
+	return nil
+}
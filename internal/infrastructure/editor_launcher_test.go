@@ -0,0 +1,39 @@
+package infrastructure
+
+import (
+	"context"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditorLauncher_Open(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses touch as the editor")
+	}
+
+	dir := t.TempDir()
+	launcher := NewEditorLauncher()
+
+	require.NoError(t, launcher.Open(context.Background(), dir, "touch -c", []string{"missing.txt"}))
+	assert.NoFileExists(t, filepath.Join(dir, "missing.txt"), "editor arguments should precede the files")
+
+	require.NoError(t, launcher.Open(context.Background(), dir, "touch", []string{"a.go", "b.go"}))
+	assert.FileExists(t, filepath.Join(dir, "a.go"))
+	assert.FileExists(t, filepath.Join(dir, "b.go"))
+}
+
+func TestEditorLauncher_OpenErrors(t *testing.T) {
+	launcher := NewEditorLauncher()
+
+	err := launcher.Open(context.Background(), t.TempDir(), "   ", []string{"a.go"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "editor command cannot be empty")
+
+	err = launcher.Open(context.Background(), t.TempDir(), "twiggit-no-such-editor", []string{"a.go"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to run editor twiggit-no-such-editor")
+}
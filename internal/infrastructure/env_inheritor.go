@@ -0,0 +1,97 @@
+package infrastructure
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.EnvInheritor = (*envInheritor)(nil)
+
+// EnvFileName is the per-worktree dotenv file written by EnvInheritor
+const EnvFileName = ".env"
+
+var (
+	envVarNamePattern   = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	envBareValuePattern = regexp.MustCompile(`^[A-Za-z0-9_./:@%+,-]*$`)
+)
+
+// envInheritor copies variables from the process environment into worktree .env files
+type envInheritor struct {
+	lookupEnv func(string) (string, bool)
+	stderr    io.Writer
+}
+
+// NewEnvInheritor creates an EnvInheritor reading the process environment and
+// warning about unset variables on stderr
+func NewEnvInheritor() application.EnvInheritor {
+	return &envInheritor{lookupEnv: os.LookupEnv, stderr: os.Stderr}
+}
+
+// Inherit appends the requested variables to the worktree's .env file, creating it when absent.
+// Unset variables are skipped with a warning; no file is touched when none are set.
+func (e *envInheritor) Inherit(vars []string, worktreePath string) error {
+	for _, name := range vars {
+		if !envVarNamePattern.MatchString(name) {
+			return domain.NewValidationError("Inherit", "vars", name, "environment variable names may contain only letters, digits and underscores")
+		}
+	}
+
+	var entries strings.Builder
+	seen := make(map[string]bool, len(vars))
+	for _, name := range vars {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		value, ok := e.lookupEnv(name)
+		if !ok {
+			_, _ = fmt.Fprintf(e.stderr, "Warning: environment variable %s is not set, not writing it to %s\n", name, EnvFileName)
+			continue
+		}
+		entries.WriteString(name + "=" + dotenvValue(value) + "\n")
+	}
+	if entries.Len() == 0 {
+		return nil
+	}
+
+	envFile := filepath.Join(worktreePath, EnvFileName)
+	content, err := os.ReadFile(envFile) // #nosec G304 -- path inside the worktree being set up
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read %s: %w", envFile, err)
+	}
+
+	prefix := ""
+	if len(content) > 0 && !strings.HasSuffix(string(content), "\n") {
+		prefix = "\n"
+	}
+
+	f, err := os.OpenFile(envFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600) // #nosec G304 -- path inside the worktree being set up
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", envFile, err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(prefix + entries.String()); err != nil {
+		return fmt.Errorf("failed to write %s: %w", envFile, err)
+	}
+	return nil
+}
+
+// dotenvValue leaves simple values bare and double-quotes the rest, escaping
+// backslashes, quotes, dollars and newlines
+func dotenvValue(value string) string {
+	if envBareValuePattern.MatchString(value) {
+		return value
+	}
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "$", `\$`, "\n", `\n`)
+	return `"` + replacer.Replace(value) + `"`
+}
@@ -0,0 +1,114 @@
+package infrastructure
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEnvInheritor(env map[string]string) (*envInheritor, *bytes.Buffer) {
+	var stderr bytes.Buffer
+	return &envInheritor{
+		lookupEnv: func(name string) (string, bool) {
+			value, ok := env[name]
+			return value, ok
+		},
+		stderr: &stderr,
+	}, &stderr
+}
+
+func TestEnvInheritor_Inherit(t *testing.T) {
+	env := map[string]string{
+		"DATABASE_URL": "postgres://localhost:5432/app",
+		"API_KEY":      `s3cr3t "quoted" $HOME`,
+		"EMPTY":        "",
+		"UNREQUESTED":  "never",
+	}
+
+	testCases := []struct {
+		name         string
+		existing     string
+		vars         []string
+		expectFile   string
+		expectNoFile bool
+		expectWarn   string
+		expectError  string
+	}{
+		{
+			name:       "creates file with present variables",
+			vars:       []string{"DATABASE_URL", "API_KEY", "EMPTY"},
+			expectFile: "DATABASE_URL=postgres://localhost:5432/app\nAPI_KEY=\"s3cr3t \\\"quoted\\\" \\$HOME\"\nEMPTY=\n",
+		},
+		{
+			name:       "appends to existing file",
+			existing:   "PORT=3000",
+			vars:       []string{"DATABASE_URL", "DATABASE_URL"},
+			expectFile: "PORT=3000\nDATABASE_URL=postgres://localhost:5432/app\n",
+		},
+		{
+			name:       "missing variables are skipped with a warning",
+			existing:   "PORT=3000\n",
+			vars:       []string{"MISSING", "DATABASE_URL"},
+			expectFile: "PORT=3000\nDATABASE_URL=postgres://localhost:5432/app\n",
+			expectWarn: "Warning: environment variable MISSING is not set",
+		},
+		{
+			name:         "only missing variables leave no file",
+			vars:         []string{"MISSING"},
+			expectNoFile: true,
+			expectWarn:   "MISSING is not set",
+		},
+		{
+			name:         "invalid name",
+			vars:         []string{"DATABASE_URL", "BAD-NAME"},
+			expectNoFile: true,
+			expectError:  "environment variable names",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			worktreePath := t.TempDir()
+			envFile := filepath.Join(worktreePath, EnvFileName)
+			if tc.existing != "" {
+				require.NoError(t, os.WriteFile(envFile, []byte(tc.existing), 0600))
+			}
+
+			inheritor, stderr := newTestEnvInheritor(env)
+			err := inheritor.Inherit(tc.vars, worktreePath)
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.expectWarn != "" {
+				assert.Contains(t, stderr.String(), tc.expectWarn)
+			} else {
+				assert.Empty(t, stderr.String())
+			}
+
+			if tc.expectNoFile {
+				_, statErr := os.Stat(envFile)
+				assert.True(t, os.IsNotExist(statErr))
+				return
+			}
+			content, err := os.ReadFile(envFile)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectFile, string(content))
+			assert.NotContains(t, string(content), "UNREQUESTED")
+		})
+	}
+}
+
+func TestDotenvValue(t *testing.T) {
+	assert.Equal(t, "abc-1.2", dotenvValue("abc-1.2"))
+	assert.Equal(t, `"a b"`, dotenvValue("a b"))
+	assert.Equal(t, `"line1\nline2"`, dotenvValue("line1\nline2"))
+	assert.Equal(t, `"C:\\dir"`, dotenvValue(`C:\dir`))
+}
@@ -0,0 +1,221 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.EphemeralRegistry = (*ephemeralRegistry)(nil)
+
+// ephemeralFilePrefix prefixes the per-session registration files
+const ephemeralFilePrefix = "twiggit-ephemeral-"
+
+// sessionIDPattern restricts session IDs to characters that are safe in file names
+var sessionIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// ephemeralFile is the on-disk format of a session's registration file
+type ephemeralFile struct {
+	SessionID string   `json:"session_id"`
+	Worktrees []string `json:"worktrees"`
+}
+
+// DefaultEphemeralDir returns the per-user XDG state directory holding ephemeral registrations
+// ($XDG_STATE_HOME/twiggit/ephemeral, defaulting to ~/.local/state/twiggit/ephemeral); a shared
+// directory such as $TMPDIR would let other users plant registrations that clean deletes
+func DefaultEphemeralDir() string {
+	stateHome := os.Getenv("XDG_STATE_HOME")
+	if stateHome == "" {
+		home, _ := os.UserHomeDir()
+		stateHome = filepath.Join(home, ".local", "state")
+	}
+	return filepath.Join(stateHome, "twiggit", "ephemeral")
+}
+
+type ephemeralRegistry struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewEphemeralRegistry creates an EphemeralRegistry storing
+// twiggit-ephemeral-<session-id>.json files in dir
+func NewEphemeralRegistry(dir string) application.EphemeralRegistry {
+	return &ephemeralRegistry{dir: dir}
+}
+
+// Register records the worktree for cleanup when the session ends
+func (r *ephemeralRegistry) Register(worktreePath, sessionID string) error {
+	if worktreePath == "" {
+		return domain.NewValidationError("Register", "worktreePath", "", "worktree path cannot be empty")
+	}
+	if err := validateSessionID("Register", sessionID); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file, err := r.readFile(r.filePath(sessionID))
+	if err != nil {
+		return err
+	}
+	worktreePath = filepath.Clean(worktreePath)
+	if slices.Contains(file.Worktrees, worktreePath) {
+		return nil
+	}
+	file.SessionID = sessionID
+	file.Worktrees = append(file.Worktrees, worktreePath)
+	return r.writeFile(sessionID, file)
+}
+
+// GetRegistered returns the worktrees registered for the session
+func (r *ephemeralRegistry) GetRegistered(sessionID string) ([]string, error) {
+	if err := validateSessionID("GetRegistered", sessionID); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file, err := r.readFile(r.filePath(sessionID))
+	if err != nil {
+		return nil, err
+	}
+	if file.Worktrees == nil {
+		return []string{}, nil
+	}
+	return file.Worktrees, nil
+}
+
+// Unregister removes the worktree from the session, deleting the file once it is empty
+func (r *ephemeralRegistry) Unregister(worktreePath, sessionID string) error {
+	if err := validateSessionID("Unregister", sessionID); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	file, err := r.readFile(r.filePath(sessionID))
+	if err != nil {
+		return err
+	}
+	file.Worktrees = slices.DeleteFunc(file.Worktrees, func(path string) bool {
+		return path == filepath.Clean(worktreePath)
+	})
+	return r.writeFile(sessionID, file)
+}
+
+// ListSessions returns every session with registered worktrees, ordered by session ID.
+// A session is active while a process with its numeric ID is running.
+func (r *ephemeralRegistry) ListSessions() ([]domain.EphemeralSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	matches, err := filepath.Glob(filepath.Join(r.dir, ephemeralFilePrefix+"*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ephemeral registrations: %w", err)
+	}
+
+	sessions := []domain.EphemeralSession{}
+	for _, path := range matches {
+		sessionID := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(path), ephemeralFilePrefix), ".json")
+		if !sessionIDPattern.MatchString(sessionID) {
+			continue
+		}
+
+		file, err := r.readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if len(file.Worktrees) == 0 {
+			continue
+		}
+
+		sessions = append(sessions, domain.EphemeralSession{
+			SessionID:     sessionID,
+			WorktreePaths: file.Worktrees,
+			Active:        sessionActive(sessionID),
+		})
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].SessionID < sessions[j].SessionID
+	})
+	return sessions, nil
+}
+
+// filePath returns the registration file for a session
+func (r *ephemeralRegistry) filePath(sessionID string) string {
+	return filepath.Join(r.dir, ephemeralFilePrefix+sessionID+".json")
+}
+
+// readFile reads a registration file, returning an empty file when it does not exist
+func (r *ephemeralRegistry) readFile(path string) (*ephemeralFile, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is inside the ephemeral registration directory
+	if errors.Is(err, os.ErrNotExist) {
+		return &ephemeralFile{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var file ephemeralFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &file, nil
+}
+
+// writeFile writes the session's registration file, removing it when no worktrees remain
+func (r *ephemeralRegistry) writeFile(sessionID string, file *ephemeralFile) error {
+	path := r.filePath(sessionID)
+	if len(file.Worktrees) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(r.dir, 0700); err != nil {
+		return fmt.Errorf("failed to create %s: %w", r.dir, err)
+	}
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode ephemeral registrations: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// validateSessionID rejects session IDs that are empty or unsafe in a file name
+func validateSessionID(operation, sessionID string) error {
+	if !sessionIDPattern.MatchString(sessionID) {
+		return domain.NewValidationError(operation, "sessionID", sessionID, "session ID must be non-empty and contain only letters, digits, '-' or '_'")
+	}
+	return nil
+}
+
+// sessionActive reports whether the session's shell is still running.
+// Non-numeric session IDs cannot be checked and are treated as active.
+func sessionActive(sessionID string) bool {
+	pid, err := strconv.Atoi(sessionID)
+	if err != nil {
+		return true
+	}
+	return processAlive(pid)
+}
@@ -0,0 +1,88 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+func TestDefaultEphemeralDir(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", "/state")
+	assert.Equal(t, filepath.Join("/state", "twiggit", "ephemeral"), DefaultEphemeralDir())
+
+	t.Setenv("XDG_STATE_HOME", "")
+	t.Setenv("HOME", "/home/user")
+	assert.Equal(t, filepath.Join("/home/user", ".local", "state", "twiggit", "ephemeral"), DefaultEphemeralDir())
+}
+
+func TestEphemeralRegistry_RegisterAndGet(t *testing.T) {
+	dir := t.TempDir()
+	registry := NewEphemeralRegistry(dir)
+
+	paths, err := registry.GetRegistered("1234")
+	require.NoError(t, err)
+	assert.Empty(t, paths)
+
+	require.NoError(t, registry.Register("/wt/proj/spike", "1234"))
+	require.NoError(t, registry.Register("/wt/proj/other/", "1234"))
+	require.NoError(t, registry.Register("/wt/proj/spike", "1234"))
+
+	paths, err = registry.GetRegistered("1234")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/wt/proj/spike", "/wt/proj/other"}, paths)
+	assert.FileExists(t, filepath.Join(dir, "twiggit-ephemeral-1234.json"))
+
+	require.NoError(t, registry.Unregister("/wt/proj/spike", "1234"))
+	require.NoError(t, registry.Unregister("/wt/proj/other", "1234"))
+	assert.NoFileExists(t, filepath.Join(dir, "twiggit-ephemeral-1234.json"))
+}
+
+func TestEphemeralRegistry_InvalidSessionID(t *testing.T) {
+	registry := NewEphemeralRegistry(t.TempDir())
+
+	for _, sessionID := range []string{"", "../escape", "a/b"} {
+		require.Error(t, registry.Register("/wt/proj/spike", sessionID), sessionID)
+		_, err := registry.GetRegistered(sessionID)
+		require.Error(t, err, sessionID)
+	}
+	require.Error(t, registry.Register("", "1234"))
+}
+
+func TestEphemeralRegistry_ListSessions(t *testing.T) {
+	dir := t.TempDir()
+	registry := NewEphemeralRegistry(dir)
+
+	alive := strconv.Itoa(os.Getpid())
+	require.NoError(t, registry.Register("/wt/proj/alive", alive))
+	require.NoError(t, registry.Register("/wt/proj/named", "editor-session"))
+	require.NoError(t, registry.Register("/wt/proj/gone", "999999999"))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "unrelated.json"), []byte("{}"), 0600))
+
+	sessions, err := registry.ListSessions()
+	require.NoError(t, err)
+	require.Len(t, sessions, 3)
+
+	byID := map[string]domain.EphemeralSession{}
+	for _, session := range sessions {
+		byID[session.SessionID] = session
+	}
+	assert.True(t, byID[alive].Active)
+	assert.True(t, byID["editor-session"].Active, "non-numeric sessions cannot be checked")
+	assert.False(t, byID["999999999"].Active)
+	assert.Equal(t, []string{"/wt/proj/gone"}, byID["999999999"].WorktreePaths)
+	assert.Equal(t, "editor-session", sessions[2].SessionID, "sessions are ordered by ID")
+}
+
+func TestEphemeralRegistry_ListSessionsMissingDir(t *testing.T) {
+	registry := NewEphemeralRegistry(filepath.Join(t.TempDir(), "missing"))
+
+	sessions, err := registry.ListSessions()
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+}
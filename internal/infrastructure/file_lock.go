@@ -0,0 +1,42 @@
+package infrastructure
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+const (
+	// fileLockPoll is how often a held lock is retried
+	fileLockPoll = 10 * time.Millisecond
+	// fileLockStale is the age after which a lock left by a crashed process is broken
+	fileLockStale = 10 * time.Second
+)
+
+// lockFile takes an exclusive lock on path by creating path.lock, waiting up to timeout
+// for other holders. It works across processes and platforms; the returned function
+// releases the lock.
+func lockFile(path string, timeout time.Duration) (func(), error) {
+	lockPath := path + ".lock"
+	deadline := time.Now().Add(timeout)
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600) // #nosec G304 -- lock next to a twiggit-managed file
+		if err == nil {
+			_ = f.Close()
+			return func() { _ = os.Remove(lockPath) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("failed to create lock file %s: %w", lockPath, err)
+		}
+
+		if info, statErr := os.Stat(lockPath); statErr == nil && time.Since(info.ModTime()) > fileLockStale {
+			_ = os.Remove(lockPath)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for lock file %s (remove it if no twiggit command is running)", lockPath)
+		}
+		time.Sleep(fileLockPoll)
+	}
+}
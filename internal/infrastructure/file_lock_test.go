@@ -0,0 +1,41 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+
+	unlock, err := lockFile(path, time.Second)
+	require.NoError(t, err)
+	assert.FileExists(t, path+".lock")
+
+	_, err = lockFile(path, 50*time.Millisecond)
+	require.Error(t, err, "a held lock times out")
+	assert.Contains(t, err.Error(), "timed out waiting for lock file")
+
+	unlock()
+	assert.NoFileExists(t, path+".lock")
+
+	unlock, err = lockFile(path, time.Second)
+	require.NoError(t, err, "a released lock can be taken again")
+	unlock()
+}
+
+func TestLockFile_BreaksStaleLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(path+".lock", nil, 0600))
+	old := time.Now().Add(-2 * fileLockStale)
+	require.NoError(t, os.Chtimes(path+".lock", old, old))
+
+	unlock, err := lockFile(path, 50*time.Millisecond)
+	require.NoError(t, err)
+	unlock()
+}
@@ -2,6 +2,8 @@ package infrastructure
 
 import (
 	"context"
+	"errors"
+	"io"
 
 	"github.com/go-git/go-git/v5"
 	"twiggit/internal/application"
@@ -10,18 +12,96 @@ import (
 
 var _ application.GitClient = (*CompositeGitClient)(nil)
 
+// ClientPreference selects which client runs an operation both clients implement
+type ClientPreference int
+
+const (
+	// PreferGoGit runs the operation with go-git and falls back to the CLI when it fails
+	PreferGoGit ClientPreference = iota
+	// PreferCLI runs the operation with the CLI and falls back to go-git when it fails
+	PreferCLI
+	// GoGitOnly runs the operation with go-git only
+	GoGitOnly
+	// CLIOnly runs the operation with the CLI only
+	CLIOnly
+)
+
+// Operations that both clients implement and can be routed by a CapabilityMap
+const (
+	OpBranchExists        = "BranchExists"
+	OpResolveRevision     = "ResolveRevision"
+	OpGetRepositoryStatus = "GetRepositoryStatus"
+)
+
+// CapabilityMap maps operation names (OpBranchExists, ...) to the client preference used to run them
+type CapabilityMap map[string]ClientPreference
+
+// DefaultCapabilityMap routes every dual-implemented operation to go-git only
+func DefaultCapabilityMap() CapabilityMap {
+	return CapabilityMap{
+		OpBranchExists:        GoGitOnly,
+		OpResolveRevision:     GoGitOnly,
+		OpGetRepositoryStatus: GoGitOnly,
+	}
+}
+
+// CompositeOption configures a CompositeGitClient
+type CompositeOption func(*CompositeGitClient)
+
+// WithCapabilityMap overrides the routing of the operations in capabilities; operations it
+// does not list keep the DefaultCapabilityMap preference. Operations only one client
+// implements (worktrees, merge and rebase through pull are CLI only) are not routable.
+func WithCapabilityMap(capabilities CapabilityMap) CompositeOption {
+	return func(c *CompositeGitClient) {
+		for op, preference := range capabilities {
+			c.capabilities[op] = preference
+		}
+	}
+}
+
 // CompositeGitClient implements GitClient by combining GoGit and CLI functionality
 type CompositeGitClient struct {
-	goGitClient application.GoGitClient
-	cliClient   application.CLIClient
+	goGitClient  application.GoGitClient
+	cliClient    application.CLIClient
+	capabilities CapabilityMap
 }
 
 // NewCompositeGitClient creates a new composite GitClient
-func NewCompositeGitClient(goGitClient application.GoGitClient, cliClient application.CLIClient) application.GitClient {
-	return &CompositeGitClient{
-		goGitClient: goGitClient,
-		cliClient:   cliClient,
+func NewCompositeGitClient(goGitClient application.GoGitClient, cliClient application.CLIClient, opts ...CompositeOption) application.GitClient {
+	c := &CompositeGitClient{
+		goGitClient:  goGitClient,
+		cliClient:    cliClient,
+		capabilities: DefaultCapabilityMap(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// route runs op with the client its capability preference selects. A failure of the preferred
+// client falls back to the other one unless ctx is done; when both fail the errors are joined.
+func route[T any](ctx context.Context, c *CompositeGitClient, op string, goGit, cli func() (T, error)) (T, error) {
+	first, second := goGit, cli
+	switch c.capabilities[op] {
+	case GoGitOnly:
+		return goGit()
+	case CLIOnly:
+		return cli()
+	case PreferCLI:
+		first, second = cli, goGit
+	case PreferGoGit:
+	}
+
+	value, err := first()
+	if err == nil || ctx.Err() != nil {
+		return value, err
+	}
+	value, fallbackErr := second()
+	if fallbackErr != nil {
+		return value, errors.Join(err, fallbackErr)
 	}
+	return value, nil
 }
 
 // OpenRepository opens a git repository using the GoGit client
@@ -42,18 +122,22 @@ func (c *CompositeGitClient) ListBranches(ctx context.Context, repoPath string)
 	return branches, nil
 }
 
-// BranchExists checks if a branch exists using the GoGit client
+// BranchExists checks if a branch exists, using the GoGit client by default
 func (c *CompositeGitClient) BranchExists(ctx context.Context, repoPath, branchName string) (bool, error) {
-	exists, err := c.goGitClient.BranchExists(ctx, repoPath, branchName)
+	exists, err := route(ctx, c, OpBranchExists,
+		func() (bool, error) { return c.goGitClient.BranchExists(ctx, repoPath, branchName) },
+		func() (bool, error) { return c.cliClient.BranchExists(ctx, repoPath, branchName) })
 	if err != nil {
 		return false, domain.NewGitRepositoryError(repoPath, "failed to check branch existence", err)
 	}
 	return exists, nil
 }
 
-// GetRepositoryStatus gets the repository status using the GoGit client
+// GetRepositoryStatus gets the repository status, using the GoGit client by default
 func (c *CompositeGitClient) GetRepositoryStatus(ctx context.Context, repoPath string) (domain.RepositoryStatus, error) {
-	status, err := c.goGitClient.GetRepositoryStatus(ctx, repoPath)
+	status, err := route(ctx, c, OpGetRepositoryStatus,
+		func() (domain.RepositoryStatus, error) { return c.goGitClient.GetRepositoryStatus(ctx, repoPath) },
+		func() (domain.RepositoryStatus, error) { return c.cliClient.GetRepositoryStatus(ctx, repoPath) })
 	if err != nil {
 		return domain.RepositoryStatus{}, domain.NewGitRepositoryError(repoPath, "failed to get repository status", err)
 	}
@@ -86,6 +170,15 @@ func (c *CompositeGitClient) ListRemotes(ctx context.Context, repoPath string) (
 	return remotes, nil
 }
 
+// GetRemoteBranches lists remote-tracking branches using the GoGit client
+func (c *CompositeGitClient) GetRemoteBranches(ctx context.Context, repoPath string) ([]domain.BranchInfo, error) {
+	branches, err := c.goGitClient.GetRemoteBranches(ctx, repoPath)
+	if err != nil {
+		return nil, domain.NewGitRepositoryError(repoPath, "failed to list remote branches", err)
+	}
+	return branches, nil
+}
+
 // GetCommitInfo gets commit information using the GoGit client
 func (c *CompositeGitClient) GetCommitInfo(ctx context.Context, repoPath, commitHash string) (*domain.CommitInfo, error) {
 	info, err := c.goGitClient.GetCommitInfo(ctx, repoPath, commitHash)
@@ -95,6 +188,39 @@ func (c *CompositeGitClient) GetCommitInfo(ctx context.Context, repoPath, commit
 	return info, nil
 }
 
+// ResolveRevision resolves a revision to a commit hash, using the GoGit client by default
+func (c *CompositeGitClient) ResolveRevision(ctx context.Context, repoPath, revision string) (string, error) {
+	return route(ctx, c, OpResolveRevision,
+		func() (string, error) { return c.goGitClient.ResolveRevision(ctx, repoPath, revision) },
+		func() (string, error) { return c.cliClient.ResolveRevision(ctx, repoPath, revision) })
+}
+
+// CopyBranchConfig copies branch-specific config using the GoGit client
+func (c *CompositeGitClient) CopyBranchConfig(ctx context.Context, repoPath, srcBranch, dstBranch string) error {
+	if err := c.goGitClient.CopyBranchConfig(ctx, repoPath, srcBranch, dstBranch); err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to copy branch config", err)
+	}
+	return nil
+}
+
+// FetchRemote fetches a remote using the GoGit client
+func (c *CompositeGitClient) FetchRemote(ctx context.Context, repoPath, remoteName string, progress io.Writer) error {
+	if err := c.goGitClient.FetchRemote(ctx, repoPath, remoteName, progress); err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to fetch remote "+remoteName, err)
+	}
+	return nil
+}
+
+// Clone clones a repository using the GoGit client
+func (c *CompositeGitClient) Clone(ctx context.Context, remoteURL, path string, progress io.Writer) error {
+	return c.goGitClient.Clone(ctx, remoteURL, path, progress)
+}
+
+// CherryPick cherry-picks commits using the GoGit client
+func (c *CompositeGitClient) CherryPick(ctx context.Context, repoPath string, commits []string) error {
+	return c.goGitClient.CherryPick(ctx, repoPath, commits)
+}
+
 // CreateWorktree creates a worktree using the CLI client
 func (c *CompositeGitClient) CreateWorktree(ctx context.Context, repoPath, branchName, sourceBranch string, worktreePath string) error {
 	if err := c.cliClient.CreateWorktree(ctx, repoPath, branchName, sourceBranch, worktreePath); err != nil {
@@ -103,6 +229,17 @@ func (c *CompositeGitClient) CreateWorktree(ctx context.Context, repoPath, branc
 	return nil
 }
 
+// InitBareWorktree validates the repository and registers a worktree without checkout using the CLI client
+func (c *CompositeGitClient) InitBareWorktree(ctx context.Context, repoPath, targetPath string) error {
+	if err := c.goGitClient.ValidateRepository(repoPath); err != nil {
+		return domain.NewGitRepositoryError(repoPath, "not a valid git repository", err)
+	}
+	if err := c.cliClient.InitBareWorktree(ctx, repoPath, targetPath); err != nil {
+		return domain.NewGitWorktreeError(targetPath, "", "failed to create worktree without checkout", err)
+	}
+	return nil
+}
+
 // DeleteWorktree deletes a worktree using the CLI client
 func (c *CompositeGitClient) DeleteWorktree(ctx context.Context, repoPath, worktreePath string, force bool) error {
 	if err := c.cliClient.DeleteWorktree(ctx, repoPath, worktreePath, force); err != nil {
@@ -120,6 +257,31 @@ func (c *CompositeGitClient) ListWorktrees(ctx context.Context, repoPath string)
 	return worktrees, nil
 }
 
+// GetWorktreeList lists git's worktree records using the CLI client; go-git cannot read linked worktrees
+func (c *CompositeGitClient) GetWorktreeList(ctx context.Context, repoPath string) ([]domain.GitWorktreeEntry, error) {
+	entries, err := c.cliClient.GetWorktreeList(ctx, repoPath)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(repoPath, "", "failed to list worktrees", err)
+	}
+	return entries, nil
+}
+
+// LockWorktree locks a worktree using the CLI client
+func (c *CompositeGitClient) LockWorktree(ctx context.Context, repoPath, worktreePath, reason string) error {
+	if err := c.cliClient.LockWorktree(ctx, repoPath, worktreePath, reason); err != nil {
+		return domain.NewGitWorktreeError(worktreePath, "", "failed to lock worktree", err)
+	}
+	return nil
+}
+
+// UnlockWorktree unlocks a worktree using the CLI client
+func (c *CompositeGitClient) UnlockWorktree(ctx context.Context, repoPath, worktreePath string) error {
+	if err := c.cliClient.UnlockWorktree(ctx, repoPath, worktreePath); err != nil {
+		return domain.NewGitWorktreeError(worktreePath, "", "failed to unlock worktree", err)
+	}
+	return nil
+}
+
 // PruneWorktrees prunes stale worktree references using the CLI client
 func (c *CompositeGitClient) PruneWorktrees(ctx context.Context, repoPath string) error {
 	if err := c.cliClient.PruneWorktrees(ctx, repoPath); err != nil {
@@ -128,6 +290,30 @@ func (c *CompositeGitClient) PruneWorktrees(ctx context.Context, repoPath string
 	return nil
 }
 
+// RepairWorktrees reconnects moved worktrees using the CLI client
+func (c *CompositeGitClient) RepairWorktrees(ctx context.Context, repoPath string, worktreePaths []string) error {
+	if err := c.cliClient.RepairWorktrees(ctx, repoPath, worktreePaths); err != nil {
+		return domain.NewGitWorktreeError(repoPath, "", "failed to repair worktrees", err)
+	}
+	return nil
+}
+
+// MoveWorktree moves a linked worktree using the CLI client
+func (c *CompositeGitClient) MoveWorktree(ctx context.Context, repoPath, worktreePath, newPath string) error {
+	if err := c.cliClient.MoveWorktree(ctx, repoPath, worktreePath, newPath); err != nil {
+		return domain.NewGitWorktreeError(worktreePath, "", "failed to move worktree", err)
+	}
+	return nil
+}
+
+// RenameBranch renames a branch using the CLI client
+func (c *CompositeGitClient) RenameBranch(ctx context.Context, repoPath, oldName, newName string) error {
+	if err := c.cliClient.RenameBranch(ctx, repoPath, oldName, newName); err != nil {
+		return domain.NewGitWorktreeError(repoPath, oldName, "failed to rename branch", err)
+	}
+	return nil
+}
+
 // IsBranchMerged checks if a branch is merged into the current branch using the CLI client
 func (c *CompositeGitClient) IsBranchMerged(ctx context.Context, repoPath, branchName string) (bool, error) {
 	merged, err := c.cliClient.IsBranchMerged(ctx, repoPath, branchName)
@@ -144,3 +330,262 @@ func (c *CompositeGitClient) DeleteBranch(ctx context.Context, repoPath, branchN
 	}
 	return nil
 }
+
+// DeleteRemoteBranch deletes a branch on a remote using the CLI client
+func (c *CompositeGitClient) DeleteRemoteBranch(ctx context.Context, repoPath, remote, branchName string) error {
+	if err := c.cliClient.DeleteRemoteBranch(ctx, repoPath, remote, branchName); err != nil {
+		return domain.NewGitWorktreeError(repoPath, branchName, "failed to delete remote branch", err)
+	}
+	return nil
+}
+
+// GetStashList lists stash entries using the CLI client
+func (c *CompositeGitClient) GetStashList(ctx context.Context, repoPath string) ([]domain.StashEntry, error) {
+	entries, err := c.cliClient.GetStashList(ctx, repoPath)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(repoPath, "", "failed to list stashes", err)
+	}
+	return entries, nil
+}
+
+// ApplyStash applies a stash entry to a worktree using the CLI client
+func (c *CompositeGitClient) ApplyStash(ctx context.Context, srcRepoPath, dstWorktreePath string, stashIndex int) error {
+	if err := c.cliClient.ApplyStash(ctx, srcRepoPath, dstWorktreePath, stashIndex); err != nil {
+		return domain.NewGitWorktreeError(dstWorktreePath, "", "failed to apply stash", err)
+	}
+	return nil
+}
+
+// DropStash removes a stash entry using the CLI client
+func (c *CompositeGitClient) DropStash(ctx context.Context, repoPath string, stashIndex int) error {
+	if err := c.cliClient.DropStash(ctx, repoPath, stashIndex); err != nil {
+		return domain.NewGitWorktreeError(repoPath, "", "failed to drop stash", err)
+	}
+	return nil
+}
+
+// CreateStash stashes the changes of a worktree using the CLI client
+func (c *CompositeGitClient) CreateStash(ctx context.Context, worktreePath, message string) error {
+	if err := c.cliClient.CreateStash(ctx, worktreePath, message); err != nil {
+		return domain.NewGitWorktreeError(worktreePath, "", "failed to create stash", err)
+	}
+	return nil
+}
+
+// CheckoutBranch checks out a branch in a worktree using the CLI client
+func (c *CompositeGitClient) CheckoutBranch(ctx context.Context, worktreePath, branchName, startPoint string) error {
+	if err := c.cliClient.CheckoutBranch(ctx, worktreePath, branchName, startPoint); err != nil {
+		return domain.NewGitWorktreeError(worktreePath, branchName, "failed to check out branch", err)
+	}
+	return nil
+}
+
+// FetchTagsOnly fetches the tags of a remote using the CLI client
+func (c *CompositeGitClient) FetchTagsOnly(ctx context.Context, repoPath, remote string) error {
+	if err := c.cliClient.FetchTagsOnly(ctx, repoPath, remote); err != nil {
+		return domain.NewGitWorktreeError(repoPath, "", "failed to fetch tags", err)
+	}
+	return nil
+}
+
+// FetchAllRemotes fetches every remote using the CLI client
+func (c *CompositeGitClient) FetchAllRemotes(ctx context.Context, repoPath string) error {
+	if err := c.cliClient.FetchAllRemotes(ctx, repoPath); err != nil {
+		return domain.NewGitWorktreeError(repoPath, "", "failed to fetch remotes", err)
+	}
+	return nil
+}
+
+// Pull pulls the upstream of a worktree's branch using the CLI client
+func (c *CompositeGitClient) Pull(ctx context.Context, worktreePath string, rebase bool) (int, error) {
+	commits, err := c.cliClient.Pull(ctx, worktreePath, rebase)
+	if err != nil {
+		return 0, domain.NewGitWorktreeError(worktreePath, "", "failed to pull", err)
+	}
+	return commits, nil
+}
+
+// Fsck checks the repository's objects using the CLI client
+func (c *CompositeGitClient) Fsck(ctx context.Context, repoPath string) error {
+	return c.cliClient.Fsck(ctx, repoPath)
+}
+
+// FetchBranch fetches one branch of a remote using the CLI client
+func (c *CompositeGitClient) FetchBranch(ctx context.Context, repoPath, remote, branch string) error {
+	if err := c.cliClient.FetchBranch(ctx, repoPath, remote, branch); err != nil {
+		return domain.NewGitWorktreeError(repoPath, branch, "failed to fetch branch", err)
+	}
+	return nil
+}
+
+// PruneTags removes local tags deleted on the remote using the CLI client
+func (c *CompositeGitClient) PruneTags(ctx context.Context, repoPath string) error {
+	if err := c.cliClient.PruneTags(ctx, repoPath); err != nil {
+		return domain.NewGitWorktreeError(repoPath, "", "failed to prune tags", err)
+	}
+	return nil
+}
+
+// LogBetween lists commits between two refs using the CLI client
+func (c *CompositeGitClient) LogBetween(ctx context.Context, repoPath, fromRef, toRef string) ([]domain.CommitInfo, error) {
+	commits, err := c.cliClient.LogBetween(ctx, repoPath, fromRef, toRef)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(repoPath, toRef, "failed to list commits between refs", err)
+	}
+	return commits, nil
+}
+
+// GetCommitHistory lists recent commits using the CLI client
+func (c *CompositeGitClient) GetCommitHistory(ctx context.Context, repoPath string, limit int) ([]domain.CommitInfo, error) {
+	commits, err := c.cliClient.GetCommitHistory(ctx, repoPath, limit)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(repoPath, "", "failed to read commit history", err)
+	}
+	return commits, nil
+}
+
+// GetLastActivity reads the HEAD commit of a worktree using the CLI client
+func (c *CompositeGitClient) GetLastActivity(ctx context.Context, worktreePath string) (*domain.CommitInfo, error) {
+	commit, err := c.cliClient.GetLastActivity(ctx, worktreePath)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(worktreePath, "", "failed to read the last commit", err)
+	}
+	return commit, nil
+}
+
+// GetCommitStat summarizes the changes of a commit using the CLI client
+func (c *CompositeGitClient) GetCommitStat(ctx context.Context, repoPath, commitHash string) (*domain.StatusSnapshot, error) {
+	stat, err := c.cliClient.GetCommitStat(ctx, repoPath, commitHash)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(repoPath, "", "failed to read commit stats", err)
+	}
+	return stat, nil
+}
+
+// GetMergeBase finds the common ancestor of two refs using the CLI client
+func (c *CompositeGitClient) GetMergeBase(ctx context.Context, repoPath, branch, ref string) (string, error) {
+	mergeBase, err := c.cliClient.GetMergeBase(ctx, repoPath, branch, ref)
+	if err != nil {
+		return "", domain.NewGitWorktreeError(repoPath, branch, "failed to find merge base", err)
+	}
+	return mergeBase, nil
+}
+
+// GetUpstreamBranch resolves the tracking branch of a branch using the CLI client
+func (c *CompositeGitClient) GetUpstreamBranch(ctx context.Context, repoPath, branch string) (string, error) {
+	upstream, err := c.cliClient.GetUpstreamBranch(ctx, repoPath, branch)
+	if err != nil {
+		return "", domain.NewGitWorktreeError(repoPath, branch, "failed to resolve the upstream branch", err)
+	}
+	return upstream, nil
+}
+
+// GetBranchRelationship counts the commits two refs do not share using the CLI client
+func (c *CompositeGitClient) GetBranchRelationship(ctx context.Context, repoPath, branch, base string) (*domain.BranchRelationship, error) {
+	relationship, err := c.cliClient.GetBranchRelationship(ctx, repoPath, branch, base)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(repoPath, branch, "failed to compare branches", err)
+	}
+	return relationship, nil
+}
+
+// GetConflictingFiles lists unmerged paths in a worktree using the CLI client
+func (c *CompositeGitClient) GetConflictingFiles(ctx context.Context, worktreePath string) ([]domain.ConflictFile, error) {
+	conflicts, err := c.cliClient.GetConflictingFiles(ctx, worktreePath)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(worktreePath, "", "failed to list conflicting files", err)
+	}
+	return conflicts, nil
+}
+
+// GetObjectStats reports repository object statistics using the CLI client
+func (c *CompositeGitClient) GetObjectStats(ctx context.Context, repoPath string) (*domain.ObjectStats, error) {
+	stats, err := c.cliClient.GetObjectStats(ctx, repoPath)
+	if err != nil {
+		return nil, domain.NewGitRepositoryError(repoPath, "failed to get object stats", err)
+	}
+	return stats, nil
+}
+
+// GarbageCollect runs git gc using the CLI client
+func (c *CompositeGitClient) GarbageCollect(ctx context.Context, repoPath string) error {
+	if err := c.cliClient.GarbageCollect(ctx, repoPath); err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to collect garbage", err)
+	}
+	return nil
+}
+
+// SetConfig sets a worktree-scoped config value using the CLI client
+func (c *CompositeGitClient) SetConfig(ctx context.Context, worktreePath, key, value string) error {
+	if err := c.cliClient.SetConfig(ctx, worktreePath, key, value); err != nil {
+		return domain.NewGitWorktreeError(worktreePath, "", "failed to set config "+key, err)
+	}
+	return nil
+}
+
+// GetGlobalConfig reads a global config value using the CLI client
+func (c *CompositeGitClient) GetGlobalConfig(ctx context.Context, key string) (string, error) {
+	value, err := c.cliClient.GetGlobalConfig(ctx, key)
+	if err != nil {
+		return "", domain.NewGitRepositoryError("", "failed to read global config "+key, err)
+	}
+	return value, nil
+}
+
+// GetConfig reads a config value visible in the repository using the CLI client
+func (c *CompositeGitClient) GetConfig(ctx context.Context, repoPath, key string) (string, error) {
+	value, err := c.cliClient.GetConfig(ctx, repoPath, key)
+	if err != nil {
+		return "", domain.NewGitRepositoryError(repoPath, "failed to read config "+key, err)
+	}
+	return value, nil
+}
+
+// GetHooksDir returns the hooks directory using the CLI client
+func (c *CompositeGitClient) GetHooksDir(ctx context.Context, repoPath string) (string, error) {
+	dir, err := c.cliClient.GetHooksDir(ctx, repoPath)
+	if err != nil {
+		return "", domain.NewGitRepositoryError(repoPath, "failed to locate hooks directory", err)
+	}
+	return dir, nil
+}
+
+// ValidateHooks checks the repository's hooks using the CLI client
+func (c *CompositeGitClient) ValidateHooks(ctx context.Context, repoPath string) []domain.HookIssue {
+	return c.cliClient.ValidateHooks(ctx, repoPath)
+}
+
+// GetAllLocalConfig reads a worktree's local config using the CLI client
+func (c *CompositeGitClient) GetAllLocalConfig(ctx context.Context, worktreePath string) (map[string]string, error) {
+	config, err := c.cliClient.GetAllLocalConfig(ctx, worktreePath)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(worktreePath, "", "failed to read local config", err)
+	}
+	return config, nil
+}
+
+// GetBranchDescription reads a branch description using the CLI client
+func (c *CompositeGitClient) GetBranchDescription(ctx context.Context, repoPath, branch string) (string, error) {
+	description, err := c.cliClient.GetBranchDescription(ctx, repoPath, branch)
+	if err != nil {
+		return "", domain.NewGitRepositoryError(repoPath, "failed to read description of "+branch, err)
+	}
+	return description, nil
+}
+
+// SetBranchDescription writes a branch description using the CLI client
+func (c *CompositeGitClient) SetBranchDescription(ctx context.Context, repoPath, branch, description string) error {
+	if err := c.cliClient.SetBranchDescription(ctx, repoPath, branch, description); err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to set description of "+branch, err)
+	}
+	return nil
+}
+
+// CompareWorktrees computes diff statistics between two branches using the CLI client
+func (c *CompositeGitClient) CompareWorktrees(ctx context.Context, repoPath, branch1, branch2 string) (*domain.WorktreeComparison, error) {
+	comparison, err := c.cliClient.CompareWorktrees(ctx, repoPath, branch1, branch2)
+	if err != nil {
+		return nil, domain.NewGitWorktreeError(repoPath, branch1, "failed to compare branches", err)
+	}
+	return comparison, nil
+}
@@ -1,6 +1,7 @@
 package infrastructure
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"testing"
@@ -162,6 +163,51 @@ func TestGitClient_ListRemotes_RoutesToGoGitClient(t *testing.T) {
 	assert.Equal(t, expectedRemotes, remotes)
 }
 
+func TestGitClient_GetRemoteBranches_RoutesToGoGitClient(t *testing.T) {
+	mockGoGitClient := mocks.NewMockGoGitClient()
+	mockCLIClient := mocks.NewMockCLIClient()
+	compositeClient := NewCompositeGitClient(mockGoGitClient, mockCLIClient)
+	t.Cleanup(func() {
+		mockGoGitClient.AssertExpectations(t)
+	})
+
+	ctx := context.Background()
+	repoPath := "/path/to/repo"
+	expectedBranches := []domain.BranchInfo{
+		{Name: "feature-x", Remote: "origin/feature-x", Commit: "abc123"},
+	}
+
+	mockGoGitClient.On("GetRemoteBranches", ctx, repoPath).Return(expectedBranches, nil)
+
+	branches, err := compositeClient.GetRemoteBranches(ctx, repoPath)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedBranches, branches)
+}
+
+func TestGitClient_FetchRemote_RoutesToGoGitClient(t *testing.T) {
+	mockGoGitClient := mocks.NewMockGoGitClient()
+	mockCLIClient := mocks.NewMockCLIClient()
+	compositeClient := NewCompositeGitClient(mockGoGitClient, mockCLIClient)
+	t.Cleanup(func() {
+		mockGoGitClient.AssertExpectations(t)
+	})
+
+	ctx := context.Background()
+	repoPath := "/path/to/repo"
+	var progress bytes.Buffer
+
+	mockGoGitClient.On("FetchRemote", ctx, repoPath, "origin", &progress).Return(nil).Once()
+	mockGoGitClient.On("FetchRemote", ctx, repoPath, "upstream", &progress).Return(domain.ErrGitCommand).Once()
+
+	require.NoError(t, compositeClient.FetchRemote(ctx, repoPath, "origin", &progress))
+
+	err := compositeClient.FetchRemote(ctx, repoPath, "upstream", &progress)
+	require.Error(t, err)
+	require.ErrorIs(t, err, domain.ErrGitCommand)
+	assert.Contains(t, err.Error(), "failed to fetch remote upstream")
+}
+
 func TestGitClient_GetCommitInfo_RoutesToGoGitClient(t *testing.T) {
 	mockGoGitClient := mocks.NewMockGoGitClient()
 	mockCLIClient := mocks.NewMockCLIClient()
@@ -311,3 +357,117 @@ func TestGitClient_IsBranchMerged_RoutesToCLIClient(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, merged)
 }
+
+func TestGitClient_CapabilityMap_Routing(t *testing.T) {
+	ctx := context.Background()
+	repoPath := "/path/to/repo"
+	status := domain.RepositoryStatus{IsClean: true, Branch: "main"}
+
+	t.Run("defaults to go-git only", func(t *testing.T) {
+		mockGoGitClient := mocks.NewMockGoGitClient()
+		mockCLIClient := mocks.NewMockCLIClient()
+		compositeClient := NewCompositeGitClient(mockGoGitClient, mockCLIClient)
+		mockGoGitClient.On("BranchExists", ctx, repoPath, "main").Return(false, errors.New("corrupt ref"))
+
+		_, err := compositeClient.BranchExists(ctx, repoPath, "main")
+
+		require.Error(t, err)
+		mockCLIClient.AssertNotCalled(t, "BranchExists", ctx, repoPath, "main")
+	})
+
+	t.Run("CLIOnly never invokes go-git", func(t *testing.T) {
+		mockGoGitClient := mocks.NewMockGoGitClient()
+		mockCLIClient := mocks.NewMockCLIClient()
+		compositeClient := NewCompositeGitClient(mockGoGitClient, mockCLIClient, WithCapabilityMap(CapabilityMap{
+			OpBranchExists: CLIOnly, OpResolveRevision: CLIOnly, OpGetRepositoryStatus: CLIOnly,
+		}))
+		mockCLIClient.On("BranchExists", ctx, repoPath, "main").Return(true, nil)
+		mockCLIClient.On("ResolveRevision", ctx, repoPath, "HEAD").Return("", errors.New("bad revision"))
+		mockCLIClient.On("GetRepositoryStatus", ctx, repoPath).Return(status, nil)
+
+		exists, err := compositeClient.BranchExists(ctx, repoPath, "main")
+		require.NoError(t, err)
+		assert.True(t, exists)
+		_, err = compositeClient.ResolveRevision(ctx, repoPath, "HEAD")
+		require.Error(t, err)
+		got, err := compositeClient.GetRepositoryStatus(ctx, repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, status, got)
+
+		mockCLIClient.AssertExpectations(t)
+		mockGoGitClient.AssertNotCalled(t, "BranchExists", ctx, repoPath, "main")
+		mockGoGitClient.AssertNotCalled(t, "ResolveRevision", ctx, repoPath, "HEAD")
+		mockGoGitClient.AssertNotCalled(t, "GetRepositoryStatus", ctx, repoPath)
+	})
+
+	t.Run("GoGitOnly never invokes the CLI", func(t *testing.T) {
+		mockGoGitClient := mocks.NewMockGoGitClient()
+		mockCLIClient := mocks.NewMockCLIClient()
+		compositeClient := NewCompositeGitClient(mockGoGitClient, mockCLIClient, WithCapabilityMap(CapabilityMap{
+			OpResolveRevision: GoGitOnly, OpGetRepositoryStatus: GoGitOnly,
+		}))
+		mockGoGitClient.On("ResolveRevision", ctx, repoPath, "HEAD").Return("", errors.New("bad revision"))
+		mockGoGitClient.On("GetRepositoryStatus", ctx, repoPath).Return(status, nil)
+
+		_, err := compositeClient.ResolveRevision(ctx, repoPath, "HEAD")
+		require.Error(t, err)
+		_, err = compositeClient.GetRepositoryStatus(ctx, repoPath)
+		require.NoError(t, err)
+
+		mockGoGitClient.AssertExpectations(t)
+		mockCLIClient.AssertNotCalled(t, "ResolveRevision", ctx, repoPath, "HEAD")
+		mockCLIClient.AssertNotCalled(t, "GetRepositoryStatus", ctx, repoPath)
+	})
+
+	t.Run("PreferGoGit falls back to the CLI", func(t *testing.T) {
+		mockGoGitClient := mocks.NewMockGoGitClient()
+		mockCLIClient := mocks.NewMockCLIClient()
+		compositeClient := NewCompositeGitClient(mockGoGitClient, mockCLIClient, WithCapabilityMap(CapabilityMap{
+			OpGetRepositoryStatus: PreferGoGit,
+		}))
+		mockGoGitClient.On("GetRepositoryStatus", ctx, repoPath).Return(domain.RepositoryStatus{}, errors.New("reference not found")).Once()
+		mockCLIClient.On("GetRepositoryStatus", ctx, repoPath).Return(status, nil).Once()
+
+		got, err := compositeClient.GetRepositoryStatus(ctx, repoPath)
+
+		require.NoError(t, err)
+		assert.Equal(t, status, got)
+		mockGoGitClient.AssertExpectations(t)
+		mockCLIClient.AssertExpectations(t)
+	})
+
+	t.Run("PreferCLI falls back to go-git and joins both errors", func(t *testing.T) {
+		mockGoGitClient := mocks.NewMockGoGitClient()
+		mockCLIClient := mocks.NewMockCLIClient()
+		compositeClient := NewCompositeGitClient(mockGoGitClient, mockCLIClient, WithCapabilityMap(CapabilityMap{
+			OpResolveRevision: PreferCLI,
+		}))
+		cliErr := errors.New("git not found")
+		goGitErr := errors.New("reference not found")
+		mockCLIClient.On("ResolveRevision", ctx, repoPath, "HEAD").Return("", cliErr).Once()
+		mockGoGitClient.On("ResolveRevision", ctx, repoPath, "HEAD").Return("", goGitErr).Once()
+
+		_, err := compositeClient.ResolveRevision(ctx, repoPath, "HEAD")
+
+		require.ErrorIs(t, err, cliErr)
+		require.ErrorIs(t, err, goGitErr)
+		mockGoGitClient.AssertExpectations(t)
+		mockCLIClient.AssertExpectations(t)
+	})
+
+	t.Run("no fallback once the context is done", func(t *testing.T) {
+		mockGoGitClient := mocks.NewMockGoGitClient()
+		mockCLIClient := mocks.NewMockCLIClient()
+		compositeClient := NewCompositeGitClient(mockGoGitClient, mockCLIClient, WithCapabilityMap(CapabilityMap{
+			OpResolveRevision: PreferCLI,
+		}))
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		mockCLIClient.On("ResolveRevision", cancelled, repoPath, "HEAD").Return("", context.Canceled).Once()
+
+		_, err := compositeClient.ResolveRevision(cancelled, repoPath, "HEAD")
+
+		require.ErrorIs(t, err, context.Canceled)
+		mockGoGitClient.AssertNotCalled(t, "ResolveRevision", cancelled, repoPath, "HEAD")
+	})
+}
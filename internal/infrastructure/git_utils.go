@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"twiggit/internal/application"
 )
@@ -53,6 +54,39 @@ func FindGitRepositories(dir string, gitService application.GoGitClient) ([]GitD
 	return gitDirs, nil
 }
 
+// FindWorktreeDirectories finds linked worktrees below the specified directory.
+// A directory is a linked worktree when it contains a .git file; the search does
+// not descend into worktrees or full repositories.
+func FindWorktreeDirectories(dir string) ([]string, error) {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return []string{}, nil
+	}
+
+	worktrees := make([]string, 0, 10)
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() || path == dir {
+			return nil
+		}
+
+		info, statErr := os.Stat(filepath.Join(path, ".git"))
+		if statErr != nil {
+			return nil
+		}
+		if !info.IsDir() {
+			worktrees = append(worktrees, path)
+		}
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan directory %s: %w", dir, err)
+	}
+
+	return worktrees, nil
+}
+
 // FindMainRepoByTraversal traverses up the directory tree from the given path
 // to find the main git repository (not a worktree). Returns the path if found,
 // empty string otherwise.
@@ -94,6 +128,70 @@ func FindGitDirByTraversal(startPath string) *string {
 	return nil
 }
 
+// FindMainRepoFromGitFile traverses up from the given path to the nearest
+// worktree .git file and resolves its gitdir pointer back to the main
+// repository. Returns an empty string if no linked worktree is found.
+func FindMainRepoFromGitFile(startPath string) string {
+	gitRoot := FindGitDirByTraversal(startPath)
+	if gitRoot == nil {
+		return ""
+	}
+
+	gitPath := filepath.Join(*gitRoot, ".git")
+	info, err := os.Stat(gitPath)
+	if err != nil || info.IsDir() {
+		return ""
+	}
+
+	gitdir := ResolveWorktreeGitDir(*gitRoot)
+	if gitdir == "" {
+		return ""
+	}
+
+	return mainRepoFromWorktreeGitDir(gitdir)
+}
+
+// mainRepoFromWorktreeGitDir returns <main> for a linked worktree git directory of the form
+// <main>/.git/worktrees/<name>, or an empty string for any other path
+func mainRepoFromWorktreeGitDir(gitdir string) string {
+	worktreesDir := filepath.Dir(filepath.Clean(gitdir))
+	if filepath.Base(worktreesDir) != "worktrees" {
+		return ""
+	}
+
+	return filepath.Dir(filepath.Dir(worktreesDir))
+}
+
+// ResolveWorktreeGitDir returns the git directory of the worktree at worktreePath:
+// <path>/.git for a main worktree, or the target of the .git file's gitdir pointer
+// for a linked worktree. Returns an empty string if neither is found.
+func ResolveWorktreeGitDir(worktreePath string) string {
+	gitPath := filepath.Join(worktreePath, ".git")
+	info, err := os.Stat(gitPath)
+	if err != nil {
+		return ""
+	}
+	if info.IsDir() {
+		return gitPath
+	}
+
+	content, err := os.ReadFile(gitPath) // #nosec G304 -- path is the worktree's own .git file
+	if err != nil {
+		return ""
+	}
+
+	gitdir, found := strings.CutPrefix(strings.TrimSpace(string(content)), "gitdir:")
+	if !found {
+		return ""
+	}
+
+	gitdir = strings.TrimSpace(gitdir)
+	if !filepath.IsAbs(gitdir) {
+		gitdir = filepath.Join(worktreePath, gitdir)
+	}
+	return filepath.Clean(gitdir)
+}
+
 // IsMainRepo checks if the given path is a main repository (not a worktree)
 // by verifying that .git is a directory and does not contain a gitdir file
 func IsMainRepo(path string) bool {
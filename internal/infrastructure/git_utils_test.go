@@ -21,6 +21,35 @@ func setupGitUtilsTest(t *testing.T) string {
 	return tmpDir
 }
 
+func TestGitUtils_FindWorktreeDirectories(t *testing.T) {
+	t.Run("non_existent_directory_returns_empty", func(t *testing.T) {
+		result, err := FindWorktreeDirectories("/nonexistent/path")
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("finds_linked_worktrees_at_any_depth", func(t *testing.T) {
+		tmpDir := setupGitUtilsTest(t)
+		flat := filepath.Join(tmpDir, "feature")
+		nested := filepath.Join(tmpDir, "feature", "nested", "x")
+		slashed := filepath.Join(tmpDir, "bugfix", "login")
+		fullRepo := filepath.Join(tmpDir, "clone")
+		plain := filepath.Join(tmpDir, "notes")
+
+		for _, dir := range []string{flat, nested, slashed, plain} {
+			require.NoError(t, os.MkdirAll(dir, 0755))
+		}
+		require.NoError(t, os.WriteFile(filepath.Join(flat, ".git"), []byte("gitdir: /repo/.git/worktrees/feature"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(nested, ".git"), []byte("gitdir: /repo/.git/worktrees/x"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(slashed, ".git"), []byte("gitdir: /repo/.git/worktrees/login"), 0644))
+		require.NoError(t, os.MkdirAll(filepath.Join(fullRepo, ".git"), 0755))
+
+		result, err := FindWorktreeDirectories(tmpDir)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{flat, slashed}, result)
+	})
+}
+
 func TestGitUtils_FindGitRepositories(t *testing.T) {
 	t.Run("non_existent_directory_returns_empty", func(t *testing.T) {
 		result, err := FindGitRepositories("/nonexistent/path", nil)
@@ -302,6 +331,61 @@ func TestGitUtils_IsMainRepo(t *testing.T) {
 	})
 }
 
+func TestGitUtils_FindMainRepoFromGitFile(t *testing.T) {
+	t.Run("resolves_main_repo_from_worktree_git_file", func(t *testing.T) {
+		tmpDir := setupGitUtilsTest(t)
+		mainRepo := filepath.Join(tmpDir, "main")
+		require.NoError(t, os.MkdirAll(filepath.Join(mainRepo, ".git", "worktrees", "feature"), 0755))
+		worktree := filepath.Join(tmpDir, "elsewhere", "feature")
+		require.NoError(t, os.MkdirAll(filepath.Join(worktree, "src"), 0755))
+		gitFile := "gitdir: " + filepath.Join(mainRepo, ".git", "worktrees", "feature") + "\n"
+		require.NoError(t, os.WriteFile(filepath.Join(worktree, ".git"), []byte(gitFile), 0644))
+
+		assert.Equal(t, mainRepo, FindMainRepoFromGitFile(filepath.Join(worktree, "src")))
+	})
+
+	t.Run("returns_empty_for_main_repo", func(t *testing.T) {
+		tmpDir := setupGitUtilsTest(t)
+		require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "repo", ".git"), 0755))
+
+		assert.Empty(t, FindMainRepoFromGitFile(filepath.Join(tmpDir, "repo")))
+	})
+
+	t.Run("returns_empty_for_unexpected_gitdir", func(t *testing.T) {
+		tmpDir := setupGitUtilsTest(t)
+		worktree := filepath.Join(tmpDir, "wt")
+		require.NoError(t, os.MkdirAll(worktree, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(worktree, ".git"), []byte("gitdir: /somewhere/odd"), 0644))
+
+		assert.Empty(t, FindMainRepoFromGitFile(worktree))
+	})
+}
+
+func TestGitUtils_ResolveWorktreeGitDir(t *testing.T) {
+	t.Run("main_worktree", func(t *testing.T) {
+		tmpDir := setupGitUtilsTest(t)
+		repo := filepath.Join(tmpDir, "repo")
+		require.NoError(t, os.MkdirAll(filepath.Join(repo, ".git"), 0755))
+
+		assert.Equal(t, filepath.Join(repo, ".git"), ResolveWorktreeGitDir(repo))
+	})
+
+	t.Run("linked_worktree_with_relative_gitdir", func(t *testing.T) {
+		tmpDir := setupGitUtilsTest(t)
+		worktree := filepath.Join(tmpDir, "wt")
+		require.NoError(t, os.MkdirAll(worktree, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(worktree, ".git"), []byte("gitdir: ../main/.git/worktrees/wt\n"), 0644))
+
+		assert.Equal(t, filepath.Join(tmpDir, "main", ".git", "worktrees", "wt"), ResolveWorktreeGitDir(worktree))
+	})
+
+	t.Run("not_a_worktree", func(t *testing.T) {
+		tmpDir := setupGitUtilsTest(t)
+
+		assert.Empty(t, ResolveWorktreeGitDir(tmpDir))
+	})
+}
+
 func TestGitUtils_GitDir(t *testing.T) {
 	t.Run("struct_fields_are_correct", func(t *testing.T) {
 		gitDir := GitDir{
@@ -1,13 +1,23 @@
 package infrastructure
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	formatcfg "github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	lru "github.com/hashicorp/golang-lru/v2"
 	"twiggit/internal/application"
 	"twiggit/internal/domain"
@@ -315,6 +325,50 @@ func (c *GoGitClientImpl) ListRemotes(_ context.Context, repoPath string) ([]dom
 	return remoteInfos, nil
 }
 
+// GetRemoteBranches lists remote-tracking branches (refs/remotes/<remote>/<branch>),
+// skipping symbolic refs such as origin/HEAD
+func (c *GoGitClientImpl) GetRemoteBranches(_ context.Context, repoPath string) ([]domain.BranchInfo, error) {
+	repo, err := c.OpenRepository(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := repo.References()
+	if err != nil {
+		return nil, domain.NewGitRepositoryError(repoPath, "failed to list references", err)
+	}
+
+	var branchInfos []domain.BranchInfo
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if !ref.Name().IsRemote() || ref.Type() != plumbing.HashReference {
+			return nil
+		}
+		remoteBranch := ref.Name().Short()
+		_, branchName, ok := strings.Cut(remoteBranch, "/")
+		if !ok || branchName == "HEAD" {
+			return nil
+		}
+
+		branchInfo := domain.BranchInfo{
+			Name:   branchName,
+			Remote: remoteBranch,
+			Commit: ref.Hash().String(),
+		}
+		if commit, err := repo.CommitObject(ref.Hash()); err == nil {
+			branchInfo.Author = commit.Author.Name
+			branchInfo.Date = commit.Author.When
+		}
+
+		branchInfos = append(branchInfos, branchInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, domain.NewGitRepositoryError(repoPath, "failed to iterate references", err)
+	}
+
+	return branchInfos, nil
+}
+
 // GetCommitInfo returns information about a specific commit
 func (c *GoGitClientImpl) GetCommitInfo(_ context.Context, repoPath, commitHash string) (*domain.CommitInfo, error) {
 	repo, err := c.OpenRepository(repoPath)
@@ -342,3 +396,343 @@ func (c *GoGitClientImpl) GetCommitInfo(_ context.Context, repoPath, commitHash
 
 	return commitInfo, nil
 }
+
+// ResolveRevision resolves a revision (commit SHA, tag, branch or an expression such as
+// HEAD~2) to the full hash of the commit it points at; annotated tags are peeled.
+func (c *GoGitClientImpl) ResolveRevision(_ context.Context, repoPath, revision string) (string, error) {
+	repo, err := c.OpenRepository(repoPath)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := repo.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		return "", domain.NewGitRepositoryError(repoPath, "failed to resolve revision "+revision, err)
+	}
+	return hash.String(), nil
+}
+
+// branchConfigExcludedKeys are branch settings tied to the source branch's identity
+// (upstream tracking and description) rather than its workflow, so they are not copied.
+var branchConfigExcludedKeys = []string{"remote", "merge", "description"}
+
+// FetchRemote fetches the configured refspecs of remoteName with go-git. progress receives the
+// remote's progress messages and may be nil. A remote that is not configured is domain.ErrGitCommand;
+// git.NoErrAlreadyUpToDate is not an error.
+func (c *GoGitClientImpl) FetchRemote(ctx context.Context, repoPath, remoteName string, progress io.Writer) error {
+	repo, err := c.OpenRepository(repoPath)
+	if err != nil {
+		return err
+	}
+
+	if _, err := repo.Remote(remoteName); err != nil {
+		if errors.Is(err, git.ErrRemoteNotFound) {
+			return domain.NewGitRepositoryError(repoPath, fmt.Sprintf("remote %s does not exist", remoteName), domain.ErrGitCommand)
+		}
+		return domain.NewGitRepositoryError(repoPath, "failed to read remote "+remoteName, err)
+	}
+
+	err = repo.FetchContext(ctx, &git.FetchOptions{RemoteName: remoteName, Progress: progress})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return domain.NewGitRepositoryError(repoPath, "failed to fetch "+remoteName, err)
+	}
+	return nil
+}
+
+// Clone clones remoteURL into path with go-git, checking out the remote's default branch.
+// progress receives the remote's progress messages and may be nil.
+func (c *GoGitClientImpl) Clone(ctx context.Context, remoteURL, path string, progress io.Writer) error {
+	if _, err := git.PlainCloneContext(ctx, path, false, &git.CloneOptions{URL: remoteURL, Progress: progress}); err != nil {
+		return domain.NewGitRepositoryError(path, "failed to clone "+remoteURL, err)
+	}
+	return nil
+}
+
+// CherryPick applies each commit's changes against its parent to HEAD of the worktree at repoPath
+// and commits them with the original author and message plus a "(cherry picked from commit <sha>)"
+// line; the committer is the configured git user. go-git has no three-way merge, so a file the
+// commit changes must be unchanged on HEAD since the commit's parent, or already match the commit.
+// Commits whose changes are all present already are skipped.
+func (c *GoGitClientImpl) CherryPick(_ context.Context, repoPath string, commits []string) error {
+	if len(commits) == 0 {
+		return domain.NewValidationError("CherryPick", "commits", "", "at least one commit is required")
+	}
+
+	repo, err := c.OpenRepository(repoPath)
+	if err != nil {
+		return err
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to open worktree", err)
+	}
+
+	for _, revision := range commits {
+		if err := cherryPickCommit(repo, worktree, repoPath, revision); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cherryPickCommit applies and commits one commit, or returns without changing anything
+func cherryPickCommit(repo *git.Repository, worktree *git.Worktree, repoPath, revision string) error {
+	hash, err := repo.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to resolve commit "+revision, err)
+	}
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to read commit "+revision, err)
+	}
+	if commit.NumParents() != 1 {
+		return domain.NewGitRepositoryError(repoPath, "cannot cherry-pick "+revision+": only commits with exactly one parent are supported", nil)
+	}
+
+	changes, err := cherryPickChanges(repo, worktree, commit)
+	if err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to cherry-pick "+revision, err)
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	root := worktree.Filesystem.Root()
+	for _, change := range changes {
+		if err := applyCherryPickChange(repo, worktree, root, change); err != nil {
+			return domain.NewGitRepositoryError(repoPath, "failed to apply "+change.path+" from "+revision, err)
+		}
+	}
+
+	message := strings.TrimRight(commit.Message, "\n") + "\n\n(cherry picked from commit " + hash.String() + ")\n"
+	author := commit.Author
+	if _, err := worktree.Commit(message, &git.CommitOptions{Author: &author, Committer: cherryPickCommitter(repo, author)}); err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to commit cherry-pick of "+revision, err)
+	}
+	return nil
+}
+
+// cherryPickChange is a file to write from the cherry-picked commit, or to delete when entry is nil
+type cherryPickChange struct {
+	path  string
+	entry *object.TreeEntry
+}
+
+// cherryPickChanges compares the commit's changes with HEAD: files HEAD changed differently are a
+// *domain.CherryPickConflictError. Local changes to the picked files are refused, and so are
+// staged or modified files elsewhere, which the cherry-pick commit would otherwise pick up.
+func cherryPickChanges(repo *git.Repository, worktree *git.Worktree, commit *object.Commit) ([]cherryPickChange, error) {
+	parent, err := commit.Parent(0)
+	if err != nil {
+		return nil, err
+	}
+	parentTree, err := parent.Tree()
+	if err != nil {
+		return nil, err
+	}
+	commitTree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	headCommit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, err
+	}
+	headTree, err := headCommit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := object.DiffTree(parentTree, commitTree)
+	if err != nil {
+		return nil, err
+	}
+	status, err := worktree.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []cherryPickChange
+	var conflicts, dirty []string
+	checked := map[string]bool{}
+	for _, change := range diff {
+		path := change.To.Name
+		if path == "" {
+			path = change.From.Name
+		}
+
+		var ours plumbing.Hash
+		if entry, err := headTree.FindEntry(path); err == nil {
+			ours = entry.Hash
+		}
+		switch {
+		case ours == change.To.TreeEntry.Hash:
+			continue
+		case ours != change.From.TreeEntry.Hash:
+			conflicts = append(conflicts, path)
+			continue
+		}
+		checked[path] = true
+		if file, ok := status[path]; ok && (file.Worktree != git.Unmodified || file.Staging != git.Unmodified) {
+			dirty = append(dirty, path)
+			continue
+		}
+
+		picked := cherryPickChange{path: path}
+		if change.To.Name != "" {
+			entry := change.To.TreeEntry
+			picked.entry = &entry
+		}
+		changes = append(changes, picked)
+	}
+
+	if len(conflicts) > 0 {
+		slices.Sort(conflicts)
+		return nil, domain.NewCherryPickConflictError(commit.Hash.String(), conflicts)
+	}
+	if len(dirty) > 0 {
+		slices.Sort(dirty)
+		return nil, fmt.Errorf("local changes to %s would be overwritten", strings.Join(dirty, ", "))
+	}
+
+	var unrelated []string
+	for path, file := range status {
+		if checked[path] || file.Staging == git.Untracked {
+			continue
+		}
+		if file.Staging != git.Unmodified || file.Worktree != git.Unmodified {
+			unrelated = append(unrelated, path)
+		}
+	}
+	if len(unrelated) > 0 {
+		slices.Sort(unrelated)
+		return nil, fmt.Errorf("staged or modified files %s would be committed with the cherry-pick; commit or stash them first", strings.Join(unrelated, ", "))
+	}
+	return changes, nil
+}
+
+// applyCherryPickChange writes or deletes one file in the worktree and stages it
+func applyCherryPickChange(repo *git.Repository, worktree *git.Worktree, root string, change cherryPickChange) error {
+	if change.entry == nil {
+		_, err := worktree.Remove(change.path)
+		return err
+	}
+	if change.entry.Mode == filemode.Submodule {
+		return errors.New("submodules are not supported")
+	}
+
+	blob, err := repo.BlobObject(change.entry.Hash)
+	if err != nil {
+		return err
+	}
+	reader, err := blob.Reader()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = reader.Close() }()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	fullPath := filepath.Join(root, filepath.FromSlash(change.path))
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil { // #nosec G301 -- standard directory perms (rwxr-xr-x)
+		return err
+	}
+	switch change.entry.Mode {
+	case filemode.Symlink:
+		_ = os.Remove(fullPath)
+		if err := os.Symlink(string(content), fullPath); err != nil {
+			return err
+		}
+	default:
+		perm := os.FileMode(0644)
+		if change.entry.Mode == filemode.Executable {
+			perm = 0755
+		}
+		if err := os.WriteFile(fullPath, content, perm); err != nil { // #nosec G306 -- same mode git checks files out with
+			return err
+		}
+		if err := os.Chmod(fullPath, perm); err != nil {
+			return err
+		}
+	}
+
+	_, err = worktree.Add(change.path)
+	return err
+}
+
+// cherryPickCommitter signs cherry-picked commits as the git user of the repository's own
+// config, else of the global config, or as the original author when no user is configured.
+// Name and email come from the same config so identities are not mixed.
+func cherryPickCommitter(repo *git.Repository, author object.Signature) *object.Signature {
+	committer := object.Signature{Name: author.Name, Email: author.Email, When: time.Now()}
+	if cfg, err := repo.Config(); err == nil && cfg.User.Name != "" {
+		committer.Name, committer.Email = cfg.User.Name, cfg.User.Email
+	} else if cfg, err := config.LoadConfig(config.GlobalScope); err == nil && cfg.User.Name != "" {
+		committer.Name, committer.Email = cfg.User.Name, cfg.User.Email
+	}
+	return &committer
+}
+
+// CopyBranchConfig copies the [branch "<srcBranch>"] settings to dstBranch.
+// It is a no-op when the source branch has no configuration.
+func (c *GoGitClientImpl) CopyBranchConfig(_ context.Context, repoPath, srcBranch, dstBranch string) error {
+	if srcBranch == "" || dstBranch == "" {
+		return domain.NewValidationError("CopyBranchConfig", "branch", srcBranch+" -> "+dstBranch, "source and destination branch names are required")
+	}
+
+	repo, err := c.OpenRepository(repoPath)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to read git config", err)
+	}
+
+	section := cfg.Raw.Section("branch")
+	if !section.HasSubsection(srcBranch) {
+		return nil
+	}
+
+	src := section.Subsection(srcBranch)
+	dst := section.Subsection(dstBranch)
+	seen := make(map[string]bool)
+	for _, opt := range src.Options {
+		key := strings.ToLower(opt.Key)
+		if slices.Contains(branchConfigExcludedKeys, key) {
+			continue
+		}
+		// Replace existing values on first sight, keep multi-valued keys intact
+		if !seen[key] {
+			dst.RemoveOption(opt.Key)
+			seen[key] = true
+		}
+		dst.AddOption(opt.Key, opt.Value)
+	}
+	if len(seen) == 0 {
+		return nil
+	}
+
+	// Re-parse the raw config so the new branch subsection is tracked in cfg.Branches;
+	// otherwise marshalling drops subsections it does not know about.
+	var buf bytes.Buffer
+	if err := formatcfg.NewEncoder(&buf).Encode(cfg.Raw); err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to encode git config", err)
+	}
+	updated := config.NewConfig()
+	if err := updated.Unmarshal(buf.Bytes()); err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to parse git config", err)
+	}
+
+	if err := repo.Storer.SetConfig(updated); err != nil {
+		return domain.NewGitRepositoryError(repoPath, "failed to write git config", err)
+	}
+	return nil
+}
@@ -1,11 +1,16 @@
 package infrastructure
 
 import (
+	"bytes"
 	"context"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -123,6 +128,98 @@ func TestGoGitClient_ListRemotes(t *testing.T) {
 	assert.Empty(t, remotes)
 }
 
+func TestGoGitClient_GetRemoteBranches(t *testing.T) {
+	client := NewGoGitClient()
+	tempDir := t.TempDir()
+
+	branches, err := client.GetRemoteBranches(context.Background(), "/non/existent/path")
+	require.Error(t, err)
+	assert.Nil(t, branches)
+
+	repoPath := setupTestRepo(t, tempDir)
+	remoteRefs := filepath.Join(repoPath, ".git", "refs", "remotes", "origin")
+	require.NoError(t, os.MkdirAll(filepath.Join(remoteRefs, "feature"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(remoteRefs, "feature", "login"), []byte("1111111111111111111111111111111111111111\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(remoteRefs, "HEAD"), []byte("ref: refs/remotes/origin/feature/login\n"), 0644))
+
+	branches, err = client.GetRemoteBranches(context.Background(), repoPath)
+	require.NoError(t, err)
+	require.Len(t, branches, 1, "origin/HEAD is skipped")
+	assert.Equal(t, "feature/login", branches[0].Name)
+	assert.Equal(t, "origin/feature/login", branches[0].Remote)
+	assert.Equal(t, "1111111111111111111111111111111111111111", branches[0].Commit)
+}
+
+func TestGoGitClient_FetchRemote(t *testing.T) {
+	client := NewGoGitClient(false)
+	tempDir := t.TempDir()
+
+	err := client.FetchRemote(context.Background(), "/non/existent/path", "origin", nil)
+	require.Error(t, err)
+
+	sourcePath := filepath.Join(tempDir, "source")
+	source, err := git.PlainInit(sourcePath, false)
+	require.NoError(t, err)
+	commitFile(t, source, sourcePath, "README.md", "first")
+
+	clonePath := filepath.Join(tempDir, "clone")
+	clone, err := git.PlainClone(clonePath, false, &git.CloneOptions{URL: sourcePath})
+	require.NoError(t, err)
+
+	require.NoError(t, client.FetchRemote(context.Background(), clonePath, "origin", nil), "up to date is not an error")
+
+	head := commitFile(t, source, sourcePath, "README.md", "second")
+	var progress bytes.Buffer
+	require.NoError(t, client.FetchRemote(context.Background(), clonePath, "origin", &progress))
+	ref, err := clone.Reference(plumbing.NewRemoteReferenceName("origin", "master"), true)
+	require.NoError(t, err)
+	assert.Equal(t, head, ref.Hash())
+
+	err = client.FetchRemote(context.Background(), clonePath, "upstream", nil)
+	require.Error(t, err)
+	require.ErrorIs(t, err, domain.ErrGitCommand)
+	assert.Contains(t, err.Error(), "remote upstream does not exist")
+}
+
+func TestGoGitClient_Clone(t *testing.T) {
+	client := NewGoGitClient(false)
+	tempDir := t.TempDir()
+
+	sourcePath := filepath.Join(tempDir, "source")
+	source, err := git.PlainInit(sourcePath, false)
+	require.NoError(t, err)
+	head := commitFile(t, source, sourcePath, "README.md", "first")
+
+	clonePath := filepath.Join(tempDir, "projects", "clone")
+	require.NoError(t, client.Clone(context.Background(), sourcePath, clonePath, nil))
+	clone, err := git.PlainOpen(clonePath)
+	require.NoError(t, err)
+	ref, err := clone.Head()
+	require.NoError(t, err)
+	assert.Equal(t, head, ref.Hash())
+	assert.FileExists(t, filepath.Join(clonePath, "README.md"))
+
+	err = client.Clone(context.Background(), filepath.Join(tempDir, "missing"), filepath.Join(tempDir, "other"), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to clone")
+}
+
+// commitFile writes content to name in the repository at path and commits it
+func commitFile(t *testing.T, repo *git.Repository, path, name, content string) plumbing.Hash {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(filepath.Join(path, name), []byte(content+"\n"), 0644))
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = worktree.Add(name)
+	require.NoError(t, err)
+	hash, err := worktree.Commit(content, &git.CommitOptions{
+		Author: &object.Signature{Name: "Test User", Email: "test@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+	return hash
+}
+
 func TestGoGitClient_GetCommitInfo(t *testing.T) {
 	client := NewGoGitClient()
 	tempDir := t.TempDir()
@@ -137,6 +234,36 @@ func TestGoGitClient_GetCommitInfo(t *testing.T) {
 	assert.Nil(t, commit)
 }
 
+func TestGoGitClient_ResolveRevision(t *testing.T) {
+	client := NewGoGitClient(false)
+	repoPath := filepath.Join(t.TempDir(), "repo")
+	repo, err := git.PlainInit(repoPath, false)
+	require.NoError(t, err)
+	first := commitFile(t, repo, repoPath, "README.md", "first")
+	second := commitFile(t, repo, repoPath, "README.md", "second")
+	_, err = repo.CreateTag("v1.0.0", first, &git.CreateTagOptions{
+		Message: "release", Tagger: &object.Signature{Name: "Test User", Email: "test@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	for revision, expected := range map[string]plumbing.Hash{
+		"v1.0.0":            first, // annotated tag peeled to its commit
+		first.String():      first,
+		"HEAD":              second,
+		"HEAD~1":            first,
+		"master":            second,
+		"refs/heads/master": second,
+	} {
+		hash, err := client.ResolveRevision(context.Background(), repoPath, revision)
+		require.NoError(t, err, revision)
+		assert.Equal(t, expected.String(), hash, revision)
+	}
+
+	_, err = client.ResolveRevision(context.Background(), repoPath, "v9.9.9")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to resolve revision v9.9.9")
+}
+
 func findBranch(branches []domain.BranchInfo, name string) *domain.BranchInfo {
 	for _, branch := range branches {
 		if branch.Name == name {
@@ -176,3 +303,204 @@ func setupTestRepo(t *testing.T, tempDir string) string {
 
 	return repoPath
 }
+
+func TestGoGitClient_CopyBranchConfig(t *testing.T) {
+	client := NewGoGitClient(false)
+	repoPath := setupTestRepo(t, t.TempDir())
+	gitConfig := `[core]
+	bare = false
+[branch "main"]
+	remote = origin
+	merge = refs/heads/main
+	rebase = interactive
+	mergeoptions = --no-ff
+	description = Main line
+`
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, ".git", "config"), []byte(gitConfig), 0644))
+
+	require.NoError(t, client.CopyBranchConfig(context.Background(), repoPath, "main", "feature"))
+
+	repo, err := client.OpenRepository(repoPath)
+	require.NoError(t, err)
+	cfg, err := repo.Config()
+	require.NoError(t, err)
+
+	feature := cfg.Raw.Section("branch").Subsection("feature")
+	assert.Equal(t, "interactive", feature.Option("rebase"))
+	assert.Equal(t, "--no-ff", feature.Option("mergeoptions"))
+	assert.False(t, feature.HasOption("remote"), "upstream tracking must not be copied")
+	assert.False(t, feature.HasOption("merge"), "upstream tracking must not be copied")
+	assert.False(t, feature.HasOption("description"))
+	require.Contains(t, cfg.Branches, "feature")
+	assert.Equal(t, "interactive", cfg.Branches["feature"].Rebase)
+
+	main := cfg.Raw.Section("branch").Subsection("main")
+	assert.Equal(t, "origin", main.Option("remote"))
+}
+
+func TestGoGitClient_CopyBranchConfig_NoSourceConfig(t *testing.T) {
+	client := NewGoGitClient(false)
+	repoPath := setupTestRepo(t, t.TempDir())
+
+	require.NoError(t, client.CopyBranchConfig(context.Background(), repoPath, "main", "feature"))
+
+	repo, err := client.OpenRepository(repoPath)
+	require.NoError(t, err)
+	cfg, err := repo.Config()
+	require.NoError(t, err)
+	assert.False(t, cfg.Raw.Section("branch").HasSubsection("feature"))
+
+	require.Error(t, client.CopyBranchConfig(context.Background(), repoPath, "", "feature"))
+	require.Error(t, client.CopyBranchConfig(context.Background(), "/non/existent/path", "main", "feature"))
+}
+
+// setupCherryPickRepo commits a.txt on master, changes it on a feature branch and returns to
+// master with an unrelated commit. It returns the repository path and the feature commit.
+func setupCherryPickRepo(t *testing.T) (string, *git.Repository, plumbing.Hash) {
+	t.Helper()
+	repoPath := t.TempDir()
+	repo, err := git.PlainInit(repoPath, false)
+	require.NoError(t, err)
+	commitFile(t, repo, repoPath, "a.txt", "one")
+
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+	require.NoError(t, worktree.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("feature"), Create: true}))
+	picked := commitFile(t, repo, repoPath, "a.txt", "two")
+
+	require.NoError(t, worktree.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("master")}))
+	commitFile(t, repo, repoPath, "c.txt", "unrelated")
+	return repoPath, repo, picked
+}
+
+func TestGoGitClient_CherryPick(t *testing.T) {
+	repoPath, repo, picked := setupCherryPickRepo(t)
+
+	err := NewGoGitClient().CherryPick(context.Background(), repoPath, []string{picked.String()[:10]})
+	require.NoError(t, err)
+
+	head, err := repo.Head()
+	require.NoError(t, err)
+	commit, err := repo.CommitObject(head.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, "two\n\n(cherry picked from commit "+picked.String()+")\n", commit.Message)
+	assert.Equal(t, "Test User", commit.Author.Name)
+
+	content, err := os.ReadFile(filepath.Join(repoPath, "a.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "two\n", string(content))
+	assert.FileExists(t, filepath.Join(repoPath, "c.txt"))
+
+	// Picking it again finds the change already present and commits nothing
+	require.NoError(t, NewGoGitClient().CherryPick(context.Background(), repoPath, []string{picked.String()}))
+	again, err := repo.Head()
+	require.NoError(t, err)
+	assert.Equal(t, head.Hash(), again.Hash())
+}
+
+func TestGoGitClient_CherryPick_Conflict(t *testing.T) {
+	repoPath, repo, picked := setupCherryPickRepo(t)
+	commitFile(t, repo, repoPath, "a.txt", "three")
+	before, err := repo.Head()
+	require.NoError(t, err)
+
+	err = NewGoGitClient().CherryPick(context.Background(), repoPath, []string{picked.String()})
+	require.Error(t, err)
+	require.ErrorIs(t, err, domain.ErrConflict)
+	var conflictErr *domain.CherryPickConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Equal(t, []string{"a.txt"}, conflictErr.Paths)
+	assert.Equal(t, picked.String(), conflictErr.Commit)
+
+	after, err := repo.Head()
+	require.NoError(t, err)
+	assert.Equal(t, before.Hash(), after.Hash(), "nothing is committed")
+}
+
+func TestGoGitClient_CherryPick_LocalChanges(t *testing.T) {
+	repoPath, _, picked := setupCherryPickRepo(t)
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "a.txt"), []byte("edited\n"), 0644))
+
+	err := NewGoGitClient().CherryPick(context.Background(), repoPath, []string{picked.String()})
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, domain.ErrConflict)
+
+	content, readErr := os.ReadFile(filepath.Join(repoPath, "a.txt"))
+	require.NoError(t, readErr)
+	assert.Equal(t, "edited\n", string(content))
+}
+
+func TestGoGitClient_CherryPick_UnrelatedStagedChanges(t *testing.T) {
+	repoPath, repo, picked := setupCherryPickRepo(t)
+	before, err := repo.Head()
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "c.txt"), []byte("staged\n"), 0644))
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = worktree.Add("c.txt")
+	require.NoError(t, err)
+
+	err = NewGoGitClient().CherryPick(context.Background(), repoPath, []string{picked.String()})
+	var repoErr *domain.GitRepositoryError
+	require.ErrorAs(t, err, &repoErr)
+	require.Error(t, repoErr.Cause)
+	assert.Contains(t, repoErr.Cause.Error(), "staged or modified files c.txt")
+
+	after, err := repo.Head()
+	require.NoError(t, err)
+	assert.Equal(t, before.Hash(), after.Hash(), "nothing is committed")
+	content, err := os.ReadFile(filepath.Join(repoPath, "a.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "one\n", string(content), "the picked change is not applied")
+
+	// Untracked files outside the picked set are left alone
+	require.NoError(t, worktree.Restore(&git.RestoreOptions{Staged: true, Worktree: true, Files: []string{"c.txt"}}))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "notes.txt"), []byte("scratch\n"), 0644))
+	require.NoError(t, NewGoGitClient().CherryPick(context.Background(), repoPath, []string{picked.String()}))
+}
+
+func TestGoGitClient_CherryPick_CommitterFromRepoConfig(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, ".config"))
+	require.NoError(t, os.WriteFile(filepath.Join(home, ".gitconfig"),
+		[]byte("[user]\n\tname = Global User\n\temail = global@example.com\n"), 0644))
+
+	t.Run("repository user wins over the global one", func(t *testing.T) {
+		repoPath, repo, picked := setupCherryPickRepo(t)
+		cfg, err := repo.Config()
+		require.NoError(t, err)
+		cfg.User.Name, cfg.User.Email = "Local User", "local@example.com"
+		require.NoError(t, repo.SetConfig(cfg))
+
+		require.NoError(t, NewGoGitClient().CherryPick(context.Background(), repoPath, []string{picked.String()}))
+		head, err := repo.Head()
+		require.NoError(t, err)
+		commit, err := repo.CommitObject(head.Hash())
+		require.NoError(t, err)
+		assert.Equal(t, "Local User", commit.Committer.Name)
+		assert.Equal(t, "local@example.com", commit.Committer.Email)
+		assert.Equal(t, "Test User", commit.Author.Name)
+	})
+
+	t.Run("global user without a repository one", func(t *testing.T) {
+		repoPath, repo, picked := setupCherryPickRepo(t)
+
+		require.NoError(t, NewGoGitClient().CherryPick(context.Background(), repoPath, []string{picked.String()}))
+		head, err := repo.Head()
+		require.NoError(t, err)
+		commit, err := repo.CommitObject(head.Hash())
+		require.NoError(t, err)
+		assert.Equal(t, "Global User", commit.Committer.Name)
+		assert.Equal(t, "global@example.com", commit.Committer.Email)
+	})
+}
+
+func TestGoGitClient_CherryPick_InvalidInput(t *testing.T) {
+	repoPath, _, _ := setupCherryPickRepo(t)
+	client := NewGoGitClient()
+
+	require.Error(t, client.CherryPick(context.Background(), repoPath, nil))
+	require.Error(t, client.CherryPick(context.Background(), repoPath, []string{"no-such-commit"}))
+}
@@ -0,0 +1,148 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.HookCopier = (*hookCopier)(nil)
+
+// copiedHookPerm is the mode of copied hooks: git only runs executable hooks
+const copiedHookPerm = 0755
+
+type hookCopier struct {
+	gitClient application.CLIClient
+}
+
+// NewHookCopier creates a HookCopier. The git client resolves each project's hooks
+// directory, honoring core.hooksPath.
+func NewHookCopier(gitClient application.CLIClient) application.HookCopier {
+	return &hookCopier{gitClient: gitClient}
+}
+
+// Copy copies hooks from the source project's hooks directory to the destination's,
+// writing each one atomically with mode 0755
+func (c *hookCopier) Copy(srcProjectPath, dstProjectPath string, hooks []string, overwrite bool) (*domain.CopyHooksResult, error) {
+	ctx := context.Background()
+
+	srcDir, err := c.gitClient.GetHooksDir(ctx, srcProjectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate hooks directory of %s: %w", srcProjectPath, err)
+	}
+	dstDir, err := c.gitClient.GetHooksDir(ctx, dstProjectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate hooks directory of %s: %w", dstProjectPath, err)
+	}
+	if filepath.Clean(srcDir) == filepath.Clean(dstDir) {
+		return nil, fmt.Errorf("both projects use the hooks directory %s", srcDir)
+	}
+
+	result := &domain.CopyHooksResult{SrcDir: srcDir, DstDir: dstDir}
+	names, err := selectHooks(srcDir, hooks, result)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return result, nil
+	}
+
+	if err := os.MkdirAll(dstDir, 0755); err != nil { // #nosec G301 -- standard directory perms (rwxr-xr-x)
+		return nil, fmt.Errorf("failed to create hooks directory %s: %w", dstDir, err)
+	}
+	for _, name := range names {
+		copied, err := copyHook(filepath.Join(srcDir, name), filepath.Join(dstDir, name), overwrite)
+		switch {
+		case err != nil:
+			result.Failed = append(result.Failed, domain.HookCopyError{HookName: name, Err: err})
+		case copied:
+			result.Copied = append(result.Copied, name)
+		default:
+			result.Skipped = append(result.Skipped, name)
+		}
+	}
+	return result, nil
+}
+
+// selectHooks returns the hooks to copy: every executable hook of srcDir, or the named ones.
+// Named hooks that are missing or not executable are recorded as failures in result.
+func selectHooks(srcDir string, hooks []string, result *domain.CopyHooksResult) ([]string, error) {
+	if len(hooks) == 0 {
+		entries, err := os.ReadDir(srcDir)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read hooks directory %s: %w", srcDir, err)
+		}
+		var names []string
+		for _, entry := range entries {
+			if entry.IsDir() || strings.HasSuffix(entry.Name(), ".sample") {
+				continue
+			}
+			if isExecutableHook(filepath.Join(srcDir, entry.Name())) == nil {
+				names = append(names, entry.Name())
+			}
+		}
+		return names, nil
+	}
+
+	var names []string
+	for _, name := range hooks {
+		if slices.Contains(names, name) {
+			continue
+		}
+		if name == "" || name != filepath.Base(name) || name == "." || name == ".." {
+			result.Failed = append(result.Failed, domain.HookCopyError{HookName: name, Err: errors.New("invalid hook name")})
+			continue
+		}
+		if err := isExecutableHook(filepath.Join(srcDir, name)); err != nil {
+			result.Failed = append(result.Failed, domain.HookCopyError{HookName: name, Err: err})
+			continue
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// isExecutableHook returns why path is not a hook git would run, nil when it is one
+func isExecutableHook(path string) error {
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("not found in %s", filepath.Dir(path))
+	}
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return errors.New("is a directory")
+	}
+	// Git for Windows does not use the executable bit
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0111 == 0 {
+		return errors.New("not executable")
+	}
+	return nil
+}
+
+// copyHook copies one hook; false without error means the destination existed and was kept
+func copyHook(srcPath, dstPath string, overwrite bool) (bool, error) {
+	if _, err := os.Lstat(dstPath); err == nil && !overwrite {
+		return false, nil
+	}
+	data, err := os.ReadFile(srcPath) // #nosec G304 -- hook in the source project's hooks directory
+	if err != nil {
+		return false, fmt.Errorf("failed to read: %w", err)
+	}
+	if err := WriteFileAtomic(dstPath, data, copiedHookPerm); err != nil {
+		return false, fmt.Errorf("failed to write: %w", err)
+	}
+	return true, nil
+}
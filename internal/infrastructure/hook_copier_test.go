@@ -0,0 +1,134 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+// setupHookCopier creates source hooks and a destination hooks directory that does not exist yet
+func setupHookCopier(t *testing.T) (*mocks.MockCLIClient, string, string) {
+	t.Helper()
+	root := t.TempDir()
+	srcDir := filepath.Join(root, "src", ".git", "hooks")
+	dstDir := filepath.Join(root, "dst", ".git", "hooks")
+	require.NoError(t, os.MkdirAll(srcDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "commit-msg"), []byte("#!/bin/sh\necho msg\n"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "pre-push"), []byte("#!/bin/sh\necho push\n"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "pre-commit.sample"), []byte("#!/bin/sh\n"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "notes.txt"), []byte("not a hook"), 0644))
+
+	gitClient := mocks.NewMockCLIClient()
+	gitClient.On("GetHooksDir", mock.Anything, "/src").Return(srcDir, nil)
+	gitClient.On("GetHooksDir", mock.Anything, "/dst").Return(dstDir, nil)
+	return gitClient, srcDir, dstDir
+}
+
+func TestHookCopier_CopiesExecutableHooks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("executable bits are not used on Windows")
+	}
+	gitClient, _, dstDir := setupHookCopier(t)
+
+	result, err := NewHookCopier(gitClient).Copy("/src", "/dst", nil, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"commit-msg", "pre-push"}, result.Copied)
+	assert.Empty(t, result.Skipped)
+	assert.Empty(t, result.Failed)
+	assert.Equal(t, dstDir, result.DstDir)
+
+	info, err := os.Stat(filepath.Join(dstDir, "pre-push"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+	data, err := os.ReadFile(filepath.Join(dstDir, "commit-msg"))
+	require.NoError(t, err)
+	assert.Equal(t, "#!/bin/sh\necho msg\n", string(data))
+	assert.NoFileExists(t, filepath.Join(dstDir, "notes.txt"))
+	assert.NoFileExists(t, filepath.Join(dstDir, "pre-commit.sample"))
+}
+
+func TestHookCopier_KeepsExistingHooksUnlessOverwrite(t *testing.T) {
+	gitClient, _, dstDir := setupHookCopier(t)
+	require.NoError(t, os.MkdirAll(dstDir, 0755))
+	existing := filepath.Join(dstDir, "commit-msg")
+	require.NoError(t, os.WriteFile(existing, []byte("#!/bin/sh\nmine\n"), 0755))
+
+	result, err := NewHookCopier(gitClient).Copy("/src", "/dst", []string{"commit-msg"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"commit-msg"}, result.Skipped)
+	data, _ := os.ReadFile(existing)
+	assert.Equal(t, "#!/bin/sh\nmine\n", string(data))
+
+	result, err = NewHookCopier(gitClient).Copy("/src", "/dst", []string{"commit-msg"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"commit-msg"}, result.Copied)
+	data, _ = os.ReadFile(existing)
+	assert.Equal(t, "#!/bin/sh\necho msg\n", string(data))
+}
+
+func TestHookCopier_NamedHooks(t *testing.T) {
+	gitClient, _, dstDir := setupHookCopier(t)
+
+	result, err := NewHookCopier(gitClient).Copy("/src", "/dst", []string{"pre-push", "post-merge", "../escape", "pre-push"}, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pre-push"}, result.Copied)
+	require.Len(t, result.Failed, 2)
+	assert.Equal(t, "post-merge", result.Failed[0].HookName)
+	assert.Contains(t, result.Failed[0].Err.Error(), "not found")
+	assert.Equal(t, domain.HookCopyError{HookName: "../escape", Err: result.Failed[1].Err}, result.Failed[1])
+	assert.Contains(t, result.Failed[1].Err.Error(), "invalid hook name")
+	assert.NoFileExists(t, filepath.Join(dstDir, "commit-msg"))
+}
+
+func TestHookCopier_NonExecutableNamedHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("executable bits are not used on Windows")
+	}
+	gitClient, _, _ := setupHookCopier(t)
+
+	result, err := NewHookCopier(gitClient).Copy("/src", "/dst", []string{"notes.txt"}, false)
+
+	require.NoError(t, err)
+	require.Len(t, result.Failed, 1)
+	assert.Contains(t, result.Failed[0].Err.Error(), "not executable")
+}
+
+func TestHookCopier_Errors(t *testing.T) {
+	t.Run("shared hooks directory", func(t *testing.T) {
+		gitClient := mocks.NewMockCLIClient()
+		gitClient.On("GetHooksDir", mock.Anything, mock.Anything).Return("/shared/hooks", nil)
+
+		_, err := NewHookCopier(gitClient).Copy("/src", "/dst", nil, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "both projects use the hooks directory /shared/hooks")
+	})
+
+	t.Run("missing source hooks directory copies nothing", func(t *testing.T) {
+		gitClient := mocks.NewMockCLIClient()
+		gitClient.On("GetHooksDir", mock.Anything, "/src").Return(filepath.Join(t.TempDir(), "missing"), nil)
+		gitClient.On("GetHooksDir", mock.Anything, "/dst").Return(filepath.Join(t.TempDir(), "hooks"), nil)
+
+		result, err := NewHookCopier(gitClient).Copy("/src", "/dst", nil, false)
+		require.NoError(t, err)
+		assert.Empty(t, result.Copied)
+	})
+
+	t.Run("hooks directory not found", func(t *testing.T) {
+		gitClient := mocks.NewMockCLIClient()
+		gitClient.On("GetHooksDir", mock.Anything, "/src").Return("", domain.NewGitRepositoryError("/src", "not a git repository", nil))
+
+		_, err := NewHookCopier(gitClient).Copy("/src", "/dst", nil, false)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to locate hooks directory of /src")
+	})
+}
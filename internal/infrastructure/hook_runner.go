@@ -19,23 +19,39 @@ var _ application.HookRunner = (*hookRunner)(nil)
 
 type hookRunner struct {
 	executor       CommandExecutor
+	processManager application.ProcessManager
 	defaultTimeout time.Duration
 }
 
-// NewHookRunner creates a new HookRunner for executing post-create hooks
-func NewHookRunner(executor CommandExecutor, hookTimeoutSeconds ...int) application.HookRunner {
+// NewHookRunner creates a new HookRunner for executing post-create hooks.
+// processManager starts background hook commands; when nil they are reported as failures.
+func NewHookRunner(executor CommandExecutor, processManager application.ProcessManager, hookTimeoutSeconds ...int) application.HookRunner {
 	defaultTimeout := 30 * time.Second
 	if len(hookTimeoutSeconds) > 0 {
 		defaultTimeout = time.Duration(hookTimeoutSeconds[0]) * time.Second
 	}
 	return &hookRunner{
 		executor:       executor,
+		processManager: processManager,
 		defaultTimeout: defaultTimeout,
 	}
 }
 
 func (r *hookRunner) Run(ctx context.Context, req *application.HookRunRequest) (*domain.HookResult, error) {
-	if req.ConfigFilePath == "" {
+	definition := r.loadDefinition(req)
+
+	// Commands added by the caller run after the ones of .twiggit.toml
+	if req.HookType == domain.HookPostCreate && len(req.ExtraCommands) > 0 {
+		merged := &domain.HookDefinition{}
+		if definition != nil {
+			merged.Commands = append(merged.Commands, definition.Commands...)
+			merged.Background = definition.Background
+		}
+		merged.Commands = append(merged.Commands, req.ExtraCommands...)
+		definition = merged
+	}
+
+	if definition == nil || (len(definition.Commands) == 0 && len(definition.Background) == 0) {
 		return &domain.HookResult{
 			HookType: req.HookType,
 			Executed: false,
@@ -44,58 +60,57 @@ func (r *hookRunner) Run(ctx context.Context, req *application.HookRunRequest) (
 		}, nil
 	}
 
+	result, err := r.executeCommands(ctx, req, definition.Commands)
+	if err != nil {
+		return result, err
+	}
+	r.startBackground(req, definition.Background, result)
+	return result, nil
+}
+
+// loadDefinition returns the hook of req's type in req.ConfigFilePath, nil when there is none.
+// A file that does not parse is reported on stderr and treated as having no hooks.
+func (r *hookRunner) loadDefinition(req *application.HookRunRequest) *domain.HookDefinition {
+	if req.ConfigFilePath == "" {
+		return nil
+	}
+
 	if _, err := os.Stat(req.ConfigFilePath); os.IsNotExist(err) {
-		return &domain.HookResult{
-			HookType: req.HookType,
-			Executed: false,
-			Success:  true,
-			Failures: nil,
-		}, nil
+		return nil
 	}
 
 	config, err := r.readHookConfig(req.ConfigFilePath)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "warning: failed to parse %s: %v\n", req.ConfigFilePath, err)
-		return &domain.HookResult{
-			HookType: req.HookType,
-			Executed: false,
-			Success:  true,
-			Failures: nil,
-		}, nil
+		return nil
 	}
 
 	if config == nil {
-		return &domain.HookResult{
-			HookType: req.HookType,
-			Executed: false,
-			Success:  true,
-			Failures: nil,
-		}, nil
+		return nil
 	}
 
-	var definition *domain.HookDefinition
 	switch req.HookType {
 	case domain.HookPostCreate:
-		definition = config.PostCreate
-	default:
-		return &domain.HookResult{
-			HookType: req.HookType,
-			Executed: false,
-			Success:  true,
-			Failures: nil,
-		}, nil
+		return config.PostCreate
+	case domain.HookPostChange:
+		// Starting background commands on every change would pile up processes
+		if config.PostChange != nil {
+			return &domain.HookDefinition{Commands: config.PostChange.Commands}
+		}
 	}
+	return nil
+}
 
-	if definition == nil || len(definition.Commands) == 0 {
-		return &domain.HookResult{
-			HookType: req.HookType,
-			Executed: false,
-			Success:  true,
-			Failures: nil,
-		}, nil
+// LoadConfig reads the hooks of a .twiggit.toml file without running them
+func (r *hookRunner) LoadConfig(configFilePath string) (*domain.HookConfig, error) {
+	if _, err := os.Stat(configFilePath); os.IsNotExist(err) {
+		return nil, nil
 	}
-
-	return r.executeCommands(ctx, req, definition.Commands)
+	config, err := r.readHookConfig(configFilePath)
+	if err != nil {
+		return nil, domain.NewConfigError(configFilePath, "failed to read hooks", err)
+	}
+	return config, nil
 }
 
 func (r *hookRunner) readHookConfig(path string) (*domain.HookConfig, error) {
@@ -159,6 +174,60 @@ func (r *hookRunner) executeCommands(ctx context.Context, req *application.HookR
 	return result, nil
 }
 
+// startBackground launches long-running hook commands through the process manager
+func (r *hookRunner) startBackground(req *application.HookRunRequest, commands []string, result *domain.HookResult) {
+	env := r.buildEnv(req)
+
+	for _, cmd := range commands {
+		if strings.TrimSpace(cmd) == "" {
+			continue
+		}
+
+		if r.processManager == nil {
+			result.Success = false
+			result.Failures = append(result.Failures, domain.HookFailure{
+				Command:  cmd,
+				ExitCode: -1,
+				Output:   "background hooks are not supported without a process manager",
+			})
+			continue
+		}
+
+		if _, err := r.processManager.Start(req.WorktreePath, cmd, env); err != nil {
+			result.Success = false
+			result.Failures = append(result.Failures, domain.HookFailure{
+				Command:  cmd,
+				ExitCode: -1,
+				Output:   err.Error(),
+			})
+		}
+	}
+}
+
+// buildEnv returns the TWIGGIT_* variables as KEY=value pairs
+func (r *hookRunner) buildEnv(req *application.HookRunRequest) []string {
+	var env []string
+	if req.WorktreePath != "" {
+		env = append(env, "TWIGGIT_WORKTREE_PATH="+req.WorktreePath)
+	}
+	if req.ProjectName != "" {
+		env = append(env, "TWIGGIT_PROJECT_NAME="+req.ProjectName)
+	}
+	if req.BranchName != "" {
+		env = append(env, "TWIGGIT_BRANCH_NAME="+req.BranchName)
+	}
+	if req.SourceBranch != "" {
+		env = append(env, "TWIGGIT_SOURCE_BRANCH="+req.SourceBranch)
+	}
+	if req.MainRepoPath != "" {
+		env = append(env, "TWIGGIT_MAIN_REPO_PATH="+req.MainRepoPath)
+	}
+	if req.ChangedFile != "" {
+		env = append(env, "TWIGGIT_CHANGED_FILE="+req.ChangedFile)
+	}
+	return env
+}
+
 func (r *hookRunner) buildEnvExports(req *application.HookRunRequest) string {
 	var exports strings.Builder
 	if req.WorktreePath != "" {
@@ -176,5 +245,8 @@ func (r *hookRunner) buildEnvExports(req *application.HookRunRequest) string {
 	if req.MainRepoPath != "" {
 		exports.WriteString(fmt.Sprintf("export TWIGGIT_MAIN_REPO_PATH=%q; ", req.MainRepoPath))
 	}
+	if req.ChangedFile != "" {
+		exports.WriteString(fmt.Sprintf("export TWIGGIT_CHANGED_FILE=%q; ", req.ChangedFile))
+	}
 	return exports.String()
 }
@@ -2,8 +2,11 @@ package infrastructure
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,12 +16,13 @@ import (
 
 	"twiggit/internal/application"
 	"twiggit/internal/domain"
+	"twiggit/test/mocks"
 )
 
 func setupHookRunnerTest(t *testing.T) (application.HookRunner, *MockCommandExecutor, string) {
 	t.Helper()
 	mockExec := NewMockCommandExecutor()
-	runner := NewHookRunner(mockExec)
+	runner := NewHookRunner(mockExec, nil)
 	tempDir := t.TempDir()
 	return runner, mockExec, tempDir
 }
@@ -53,7 +57,7 @@ func TestHookRunner_Run_EmptyConfigFile_ReturnsNotExecuted(t *testing.T) {
 		ConfigFilePath: configPath,
 	}
 
-	runner := NewHookRunner(mockExec)
+	runner := NewHookRunner(mockExec, nil)
 	result, err := runner.Run(context.Background(), req)
 
 	require.NoError(t, err)
@@ -134,6 +138,57 @@ commands = ["mise trust", "npm install", "echo done"]
 	assert.Equal(t, 1, result.Failures[0].ExitCode)
 }
 
+func TestHookRunner_Run_ExtraCommands(t *testing.T) {
+	endsWith := func(command string) any {
+		return mock.MatchedBy(func(args []string) bool {
+			return len(args) == 2 && strings.HasSuffix(args[1], command)
+		})
+	}
+
+	t.Run("run after the commands of the config file", func(t *testing.T) {
+		runner, mockExec, tempDir := setupHookRunnerTest(t)
+		configPath := filepath.Join(tempDir, ".twiggit.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte("[hooks.post-create]\ncommands = [\"mise trust\"]\n"), 0644))
+
+		first := mockExec.On("ExecuteWithTimeout", mock.Anything, tempDir, "sh", defaultTimeout(), endsWith("mise trust")).
+			Return(&CommandResult{ExitCode: 0}, nil).Once()
+		mockExec.On("ExecuteWithTimeout", mock.Anything, tempDir, "sh", defaultTimeout(), endsWith("make deps")).
+			Return(&CommandResult{ExitCode: 0}, nil).Once().NotBefore(first)
+
+		result, err := runner.Run(context.Background(), &application.HookRunRequest{
+			HookType:       domain.HookPostCreate,
+			WorktreePath:   tempDir,
+			ConfigFilePath: configPath,
+			ExtraCommands:  []string{"make deps"},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, result.Executed)
+		assert.True(t, result.Success)
+		mockExec.AssertExpectations(t)
+	})
+
+	t.Run("run without a config file", func(t *testing.T) {
+		runner, mockExec, tempDir := setupHookRunnerTest(t)
+		mockExec.On("ExecuteWithTimeout", mock.Anything, tempDir, "sh", defaultTimeout(), endsWith("make deps")).
+			Return(&CommandResult{ExitCode: 2, Stderr: "no rule"}, nil).Once()
+
+		result, err := runner.Run(context.Background(), &application.HookRunRequest{
+			HookType:       domain.HookPostCreate,
+			WorktreePath:   tempDir,
+			ConfigFilePath: filepath.Join(tempDir, ".twiggit.toml"),
+			ExtraCommands:  []string{"make deps"},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, result.Executed)
+		assert.False(t, result.Success)
+		require.Len(t, result.Failures, 1)
+		assert.Equal(t, "make deps", result.Failures[0].Command)
+		mockExec.AssertExpectations(t)
+	})
+}
+
 func TestHookRunner_Run_MalformedTOML_LogsWarningAndReturnsNotExecuted(t *testing.T) {
 	runner, _, tempDir := setupHookRunnerTest(t)
 	configPath := filepath.Join(tempDir, ".twiggit.toml")
@@ -254,3 +309,134 @@ commands = ["echo test"]
 func defaultTimeout() time.Duration {
 	return 30 * time.Second
 }
+
+func TestHookRunner_Run_BackgroundCommands_StartedByProcessManager(t *testing.T) {
+	mockExec := NewMockCommandExecutor()
+	processes := mocks.NewMockProcessManager()
+	runner := NewHookRunner(mockExec, processes)
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, ".twiggit.toml")
+
+	configContent := `
+[hooks.post-create]
+background = ["npm run dev", "broken"]
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(configContent), 0644))
+
+	processes.On("Start", tempDir, "npm run dev", mock.MatchedBy(func(env []string) bool {
+		return slices.Contains(env, "TWIGGIT_BRANCH_NAME=feature")
+	})).Return(&domain.ManagedProcess{PID: 42}, nil).Once()
+	processes.On("Start", tempDir, "broken", mock.Anything).Return(nil, errors.New("exec failed")).Once()
+
+	result, err := runner.Run(context.Background(), &application.HookRunRequest{
+		HookType:       domain.HookPostCreate,
+		WorktreePath:   tempDir,
+		BranchName:     "feature",
+		ConfigFilePath: configPath,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.Executed)
+	assert.False(t, result.Success)
+	require.Len(t, result.Failures, 1)
+	assert.Equal(t, "broken", result.Failures[0].Command)
+	processes.AssertExpectations(t)
+	mockExec.AssertNotCalled(t, "ExecuteWithTimeout", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHookRunner_Run_BackgroundCommands_WithoutProcessManager(t *testing.T) {
+	runner, _, tempDir := setupHookRunnerTest(t)
+	configPath := filepath.Join(tempDir, ".twiggit.toml")
+	require.NoError(t, os.WriteFile(configPath, []byte("[hooks.post-create]\nbackground = [\"npm run dev\"]\n"), 0644))
+
+	result, err := runner.Run(context.Background(), &application.HookRunRequest{
+		HookType:       domain.HookPostCreate,
+		WorktreePath:   tempDir,
+		ConfigFilePath: configPath,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	require.Len(t, result.Failures, 1)
+	assert.Contains(t, result.Failures[0].Output, "process manager")
+}
+
+func TestHookRunner_Run_PostChange(t *testing.T) {
+	mockExec := NewMockCommandExecutor()
+	processes := mocks.NewMockProcessManager()
+	runner := NewHookRunner(mockExec, processes)
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, ".twiggit.toml")
+
+	configContent := `
+[hooks.post-create]
+commands = ["npm install"]
+
+[hooks.post-change]
+commands = ["go test ./..."]
+background = ["npm run dev"]
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(configContent), 0644))
+
+	var capturedArgs []string
+	mockExec.On("ExecuteWithTimeout",
+		mock.Anything, tempDir, "sh", defaultTimeout(), mock.AnythingOfType("[]string"),
+	).Run(func(args mock.Arguments) {
+		capturedArgs = args.Get(4).([]string)
+	}).Return(&CommandResult{ExitCode: 0}, nil).Once()
+
+	result, err := runner.Run(context.Background(), &application.HookRunRequest{
+		HookType:       domain.HookPostChange,
+		WorktreePath:   tempDir,
+		ChangedFile:    filepath.Join(tempDir, "main.go"),
+		ConfigFilePath: configPath,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.Executed)
+	assert.True(t, result.Success)
+	require.Len(t, capturedArgs, 2)
+	assert.Contains(t, capturedArgs[1], `export TWIGGIT_CHANGED_FILE="`+filepath.Join(tempDir, "main.go")+`"`)
+	assert.Contains(t, capturedArgs[1], "go test ./...")
+	assert.NotContains(t, capturedArgs[1], "npm install")
+	processes.AssertNotCalled(t, "Start", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHookRunner_LoadConfig(t *testing.T) {
+	runner, mockExec, tempDir := setupHookRunnerTest(t)
+
+	t.Run("reads hooks", func(t *testing.T) {
+		configPath := filepath.Join(tempDir, "hooks.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`
+[hooks.post-create]
+commands = ["mise trust", "npm ci"]
+background = ["npm run dev"]
+
+[hooks.post-change]
+commands = ["make test"]
+`), 0644))
+
+		config, err := runner.LoadConfig(configPath)
+		require.NoError(t, err)
+		require.NotNil(t, config)
+		assert.Equal(t, &domain.HookDefinition{Commands: []string{"mise trust", "npm ci"}, Background: []string{"npm run dev"}}, config.PostCreate)
+		assert.Equal(t, []string{"make test"}, config.PostChange.Commands)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		config, err := runner.LoadConfig(filepath.Join(tempDir, "missing.toml"))
+		require.NoError(t, err)
+		assert.Nil(t, config)
+	})
+
+	t.Run("malformed file", func(t *testing.T) {
+		configPath := filepath.Join(tempDir, "broken.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte("[hooks.post-create\n"), 0644))
+
+		_, err := runner.LoadConfig(configPath)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read hooks")
+	})
+
+	mockExec.AssertNotCalled(t, "ExecuteWithTimeout", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,88 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.Initializer = (*initializer)(nil)
+
+// buildStarterConfig renders a starter config.toml with the given directories
+func buildStarterConfig(projectsDir, worktreesDir, defaultSourceBranch string) string {
+	return fmt.Sprintf(`# twiggit configuration
+# Generated by 'twiggit init workspace'. See the README for all options.
+
+# Directory containing your project repositories
+projects_dir = %s
+
+# Directory where twiggit creates worktrees (<worktrees_dir>/<project>/<branch>)
+worktrees_dir = %s
+
+# Branch new worktrees are created from when --source is not given
+default_source_branch = %s
+`, strconv.Quote(projectsDir), strconv.Quote(worktreesDir), strconv.Quote(defaultSourceBranch))
+}
+
+type initializer struct{}
+
+// NewInitializer creates a new workspace initializer
+func NewInitializer() application.Initializer {
+	return &initializer{}
+}
+
+// ConfigPath returns the XDG configuration file path
+func (i *initializer) ConfigPath() string {
+	home, _ := os.UserHomeDir()
+	return resolveConfigPath(os.Getenv("XDG_CONFIG_HOME"), home)
+}
+
+// Initialize creates the workspace and projects directories and writes a starter configuration
+func (i *initializer) Initialize(_ context.Context, opts domain.InitOptions) error {
+	configPath := opts.ConfigPath
+	if configPath == "" {
+		configPath = i.ConfigPath()
+	}
+
+	if configFileExists(configPath) {
+		return domain.NewAlreadyInitializedError(configPath)
+	}
+
+	workspaceDir := expandConfigPath(opts.WorkspaceDir)
+	if workspaceDir == "" {
+		return domain.NewValidationError("Initialize", "WorkspaceDir", "", "workspace directory is required")
+	}
+
+	defaults := domain.DefaultConfig()
+	projectsDir := expandConfigPath(opts.ProjectsDir)
+	if projectsDir == "" {
+		projectsDir = defaults.ProjectsDirectory
+	}
+
+	workspaceDir, err := filepath.Abs(workspaceDir)
+	if err != nil {
+		return domain.NewValidationError("Initialize", "WorkspaceDir", opts.WorkspaceDir, "invalid workspace directory")
+	}
+	projectsDir, err = filepath.Abs(projectsDir)
+	if err != nil {
+		return domain.NewValidationError("Initialize", "ProjectsDir", opts.ProjectsDir, "invalid projects directory")
+	}
+
+	for _, dir := range []string{workspaceDir, projectsDir, filepath.Dir(configPath)} {
+		if err := os.MkdirAll(dir, 0755); err != nil { // #nosec G301 -- standard directory perms (rwxr-xr-x)
+			return domain.NewConfigError(configPath, "failed to create directory "+dir, err)
+		}
+	}
+
+	content := buildStarterConfig(projectsDir, workspaceDir, defaults.DefaultSourceBranch)
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil { // #nosec G306 -- config file is not sensitive
+		return domain.NewConfigError(configPath, "failed to write config file", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package infrastructure
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+func TestInitializer_Initialize(t *testing.T) {
+	t.Run("creates directories and starter config", func(t *testing.T) {
+		tempDir := t.TempDir()
+		t.Setenv("XDG_CONFIG_HOME", filepath.Join(tempDir, "config"))
+
+		workspace := filepath.Join(tempDir, "Worktrees")
+		projects := filepath.Join(tempDir, "Projects")
+
+		ini := NewInitializer()
+		err := ini.Initialize(context.Background(), domain.InitOptions{
+			WorkspaceDir: workspace,
+			ProjectsDir:  projects,
+		})
+		require.NoError(t, err)
+
+		assert.DirExists(t, workspace)
+		assert.DirExists(t, projects)
+		assert.FileExists(t, ini.ConfigPath())
+
+		config, err := NewConfigManager().Load()
+		require.NoError(t, err)
+		assert.Equal(t, workspace, config.WorktreesDirectory)
+		assert.Equal(t, projects, config.ProjectsDirectory)
+	})
+
+	t.Run("existing config returns already initialized", func(t *testing.T) {
+		tempDir := t.TempDir()
+		configPath := filepath.Join(tempDir, "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte("projects_dir = \"/tmp\"\n"), 0644))
+
+		err := NewInitializer().Initialize(context.Background(), domain.InitOptions{
+			WorkspaceDir: filepath.Join(tempDir, "Worktrees"),
+			ConfigPath:   configPath,
+		})
+
+		var initErr *domain.AlreadyInitializedError
+		require.ErrorAs(t, err, &initErr)
+		assert.Equal(t, configPath, initErr.ConfigPath)
+		assert.NoDirExists(t, filepath.Join(tempDir, "Worktrees"))
+	})
+
+	t.Run("missing workspace directory", func(t *testing.T) {
+		err := NewInitializer().Initialize(context.Background(), domain.InitOptions{
+			ConfigPath: filepath.Join(t.TempDir(), "config.toml"),
+		})
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func TestInitializer_BuildStarterConfig(t *testing.T) {
+	content := buildStarterConfig("/home/user/Projects", "/home/user/Worktrees", "main")
+
+	assert.Contains(t, content, `projects_dir = "/home/user/Projects"`)
+	assert.Contains(t, content, `worktrees_dir = "/home/user/Worktrees"`)
+	assert.Contains(t, content, `default_source_branch = "main"`)
+}
@@ -0,0 +1,190 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.LinkRegistry = (*linkRegistry)(nil)
+
+// LinksFileName is the per-worktree file recording dependency links
+const LinksFileName = ".twiggit-links"
+
+// linksFile is the on-disk format of the links file
+type linksFile struct {
+	DependsOn []string `json:"depends_on"`
+}
+
+// resolveExcludeFile returns the info/exclude file git reads for the worktree.
+// Linked worktrees share the main repository's info/exclude.
+func resolveExcludeFile(worktreePath string) string {
+	if mainRepo := FindMainRepoFromGitFile(worktreePath); mainRepo != "" {
+		return filepath.Join(mainRepo, ".git", "info", "exclude")
+	}
+	return filepath.Join(worktreePath, ".git", "info", "exclude")
+}
+
+// appendExcludeEntry adds entry to the exclude file unless it is already present
+func appendExcludeEntry(excludeFile, entry string) error {
+	content, err := os.ReadFile(excludeFile) // #nosec G304 -- path derived from the worktree's git metadata
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read %s: %w", excludeFile, err)
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.TrimSpace(line) == entry {
+			return nil
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(excludeFile), 0755); err != nil { // #nosec G301 -- standard directory perms (rwxr-xr-x)
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(excludeFile), err)
+	}
+
+	prefix := ""
+	if len(content) > 0 && !strings.HasSuffix(string(content), "\n") {
+		prefix = "\n"
+	}
+
+	f, err := os.OpenFile(excludeFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644) // #nosec G302,G304 -- standard git file perms
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", excludeFile, err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(prefix + entry + "\n"); err != nil {
+		return fmt.Errorf("failed to write %s: %w", excludeFile, err)
+	}
+	return nil
+}
+
+type linkRegistry struct {
+	gitClient application.CLIClient
+}
+
+// NewLinkRegistry creates a new LinkRegistry backed by .twiggit-links files.
+// The git client lists a project's worktrees when cleaning stale links.
+func NewLinkRegistry(gitClient application.CLIClient) application.LinkRegistry {
+	return &linkRegistry{gitClient: gitClient}
+}
+
+// AddLink records that the worktree depends on dependencyBranch
+func (r *linkRegistry) AddLink(worktreePath, dependencyBranch string) error {
+	if worktreePath == "" {
+		return domain.NewValidationError("AddLink", "worktreePath", "", "worktree path cannot be empty")
+	}
+	if dependencyBranch == "" {
+		return domain.NewValidationError("AddLink", "dependencyBranch", "", "dependency branch cannot be empty")
+	}
+
+	links, err := r.GetLinks(worktreePath)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(links, dependencyBranch) {
+		return nil
+	}
+
+	if err := writeLinksFile(worktreePath, append(links, dependencyBranch)); err != nil {
+		return err
+	}
+
+	return appendExcludeEntry(resolveExcludeFile(worktreePath), LinksFileName)
+}
+
+// GetLinks returns the branches the worktree depends on
+func (r *linkRegistry) GetLinks(worktreePath string) ([]string, error) {
+	linksPath := filepath.Join(worktreePath, LinksFileName)
+	data, err := os.ReadFile(linksPath) // #nosec G304 -- fixed file name inside the worktree
+	if errors.Is(err, os.ErrNotExist) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", linksPath, err)
+	}
+
+	var links linksFile
+	if err := json.Unmarshal(data, &links); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", linksPath, err)
+	}
+	if links.DependsOn == nil {
+		return []string{}, nil
+	}
+	return links.DependsOn, nil
+}
+
+// CleanStaleLinks removes links to branches that no longer have a worktree in the project
+// and returns the removed links as "<branch> -> <dependency>"
+func (r *linkRegistry) CleanStaleLinks(ctx context.Context, projectPath string) ([]string, error) {
+	worktrees, err := r.gitClient.ListWorktrees(ctx, projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list worktrees of %s: %w", projectPath, err)
+	}
+
+	active := make(map[string]bool, len(worktrees))
+	for _, wt := range worktrees {
+		if wt.Branch != "" {
+			active[wt.Branch] = true
+		}
+	}
+
+	removed := []string{}
+	for _, wt := range worktrees {
+		if wt.IsBare {
+			continue
+		}
+
+		links, err := r.GetLinks(wt.Path)
+		if err != nil {
+			return removed, err
+		}
+		kept := slices.DeleteFunc(slices.Clone(links), func(link string) bool { return !active[link] })
+		if len(kept) == len(links) {
+			continue
+		}
+
+		name := wt.Branch
+		if name == "" {
+			name = filepath.Base(wt.Path)
+		}
+		for _, link := range links {
+			if !active[link] {
+				removed = append(removed, name+" -> "+link)
+			}
+		}
+
+		if err := writeLinksFile(wt.Path, kept); err != nil {
+			return removed, err
+		}
+	}
+	return removed, nil
+}
+
+// writeLinksFile writes the worktree's links file, removing it when no links remain
+func writeLinksFile(worktreePath string, links []string) error {
+	linksPath := filepath.Join(worktreePath, LinksFileName)
+	if len(links) == 0 {
+		if err := os.Remove(linksPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove %s: %w", linksPath, err)
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(linksFile{DependsOn: links}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode links: %w", err)
+	}
+	if err := os.WriteFile(linksPath, append(data, '\n'), 0644); err != nil { // #nosec G306 -- links file is not sensitive
+		return fmt.Errorf("failed to write %s: %w", linksPath, err)
+	}
+	return nil
+}
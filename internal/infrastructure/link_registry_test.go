@@ -0,0 +1,118 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func setupLinkedWorktree(t *testing.T) (worktreePath, mainRepo string) {
+	t.Helper()
+	tempDir := t.TempDir()
+
+	mainRepo = filepath.Join(tempDir, "project")
+	gitdir := filepath.Join(mainRepo, ".git", "worktrees", "feature")
+	require.NoError(t, os.MkdirAll(gitdir, 0755))
+
+	worktreePath = filepath.Join(tempDir, "worktrees", "feature")
+	require.NoError(t, os.MkdirAll(worktreePath, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, ".git"), []byte(fmt.Sprintf("gitdir: %s\n", gitdir)), 0644))
+
+	return worktreePath, mainRepo
+}
+
+func TestLinkRegistry_AddAndGetLinks(t *testing.T) {
+	worktreePath, mainRepo := setupLinkedWorktree(t)
+	registry := NewLinkRegistry(mocks.NewMockCLIClient())
+
+	links, err := registry.GetLinks(worktreePath)
+	require.NoError(t, err)
+	assert.Empty(t, links)
+
+	require.NoError(t, registry.AddLink(worktreePath, "feature-base"))
+	require.NoError(t, registry.AddLink(worktreePath, "api-client"))
+	require.NoError(t, registry.AddLink(worktreePath, "feature-base"))
+
+	links, err = registry.GetLinks(worktreePath)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature-base", "api-client"}, links)
+
+	exclude, err := os.ReadFile(filepath.Join(mainRepo, ".git", "info", "exclude"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(exclude), LinksFileName))
+}
+
+func TestLinkRegistry_AppendsToExistingExclude(t *testing.T) {
+	worktreePath, mainRepo := setupLinkedWorktree(t)
+	excludeFile := filepath.Join(mainRepo, ".git", "info", "exclude")
+	require.NoError(t, os.MkdirAll(filepath.Dir(excludeFile), 0755))
+	require.NoError(t, os.WriteFile(excludeFile, []byte("*.log"), 0644))
+
+	require.NoError(t, NewLinkRegistry(mocks.NewMockCLIClient()).AddLink(worktreePath, "feature-base"))
+
+	exclude, err := os.ReadFile(excludeFile)
+	require.NoError(t, err)
+	assert.Equal(t, "*.log\n"+LinksFileName+"\n", string(exclude))
+}
+
+func TestLinkRegistry_Validation(t *testing.T) {
+	registry := NewLinkRegistry(mocks.NewMockCLIClient())
+
+	require.Error(t, registry.AddLink("", "feature-base"))
+	require.Error(t, registry.AddLink(t.TempDir(), ""))
+}
+
+func TestLinkRegistry_CorruptFile(t *testing.T) {
+	worktreePath, _ := setupLinkedWorktree(t)
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, LinksFileName), []byte("not json"), 0644))
+
+	_, err := NewLinkRegistry(mocks.NewMockCLIClient()).GetLinks(worktreePath)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse")
+}
+
+func TestLinkRegistry_CleanStaleLinks(t *testing.T) {
+	tempDir := t.TempDir()
+	ui := filepath.Join(tempDir, "feature-ui")
+	api := filepath.Join(tempDir, "feature-api")
+	for _, dir := range []string{ui, api} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, ".git"), 0755))
+	}
+
+	gitClient := mocks.NewMockCLIClient()
+	registry := NewLinkRegistry(gitClient)
+	require.NoError(t, registry.AddLink(ui, "feature-api"))
+	require.NoError(t, registry.AddLink(ui, "feature-deleted"))
+	require.NoError(t, registry.AddLink(api, "feature-deleted"))
+
+	gitClient.On("ListWorktrees", mock.Anything, "/repos/project").Return([]domain.WorktreeInfo{
+		{Path: "/repos/project.git", IsBare: true},
+		{Path: ui, Branch: "feature-ui"},
+		{Path: api, Branch: "feature-api"},
+	}, nil).Once()
+
+	removed, err := registry.CleanStaleLinks(context.Background(), "/repos/project")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature-ui -> feature-deleted", "feature-api -> feature-deleted"}, removed)
+
+	links, err := registry.GetLinks(ui)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature-api"}, links)
+	assert.NoFileExists(t, filepath.Join(api, LinksFileName), "a links file without links is removed")
+
+	gitClient.On("ListWorktrees", mock.Anything, "/repos/project").Return(nil, errors.New("not a repository")).Once()
+	_, err = registry.CleanStaleLinks(context.Background(), "/repos/project")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to list worktrees")
+}
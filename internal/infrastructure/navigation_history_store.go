@@ -0,0 +1,68 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"twiggit/internal/application"
+)
+
+var _ application.NavigationHistoryStore = (*navigationHistoryStore)(nil)
+
+// navigationHistoryFile is the on-disk format of the navigation history
+type navigationHistoryFile struct {
+	Paths []string `json:"paths"`
+}
+
+// DefaultNavigationHistoryPath returns the XDG state file used for the navigation history
+// ($XDG_STATE_HOME/twiggit/history.json, defaulting to ~/.local/state/twiggit/history.json)
+func DefaultNavigationHistoryPath() string {
+	stateHome := os.Getenv("XDG_STATE_HOME")
+	if stateHome == "" {
+		home, _ := os.UserHomeDir()
+		stateHome = filepath.Join(home, ".local", "state")
+	}
+	return filepath.Join(stateHome, "twiggit", "history.json")
+}
+
+type navigationHistoryStore struct {
+	path string
+}
+
+// NewNavigationHistoryStore creates a NavigationHistoryStore keeping the history in path
+func NewNavigationHistoryStore(path string) application.NavigationHistoryStore {
+	return &navigationHistoryStore{path: path}
+}
+
+// Load reads the stored paths, newest first; a missing file gives no paths
+func (s *navigationHistoryStore) Load() ([]string, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read navigation history %s: %w", s.path, err)
+	}
+	var file navigationHistoryFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse navigation history %s: %w", s.path, err)
+	}
+	return file.Paths, nil
+}
+
+// Save replaces the history file, creating its directory when needed
+func (s *navigationHistoryStore) Save(paths []string) error {
+	data, err := json.MarshalIndent(navigationHistoryFile{Paths: paths}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode navigation history: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(s.path), err)
+	}
+	if err := WriteFileAtomic(s.path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write navigation history %s: %w", s.path, err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNavigationHistoryStore_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "twiggit", "history.json")
+	store := NewNavigationHistoryStore(path)
+
+	paths, err := store.Load()
+	require.NoError(t, err)
+	assert.Empty(t, paths)
+
+	require.NoError(t, store.Save([]string{"/wt/web/main", "/wt/api/feature"}))
+	paths, err = store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/wt/web/main", "/wt/api/feature"}, paths)
+}
+
+func TestNavigationHistoryStore_CorruptFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.json")
+	require.NoError(t, os.WriteFile(path, []byte("{not json"), 0644))
+
+	_, err := NewNavigationHistoryStore(path).Load()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse navigation history")
+}
+
+func TestDefaultNavigationHistoryPath(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", "/state")
+	assert.Equal(t, filepath.Join("/state", "twiggit", "history.json"), DefaultNavigationHistoryPath())
+}
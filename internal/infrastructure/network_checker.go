@@ -0,0 +1,52 @@
+package infrastructure
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.NetworkChecker = (*networkChecker)(nil)
+
+// DefaultNetworkCheckTimeout bounds the connectivity dial
+const DefaultNetworkCheckTimeout = 3 * time.Second
+
+// defaultNetworkCheckPort is dialed when the host has no port
+const defaultNetworkCheckPort = "443"
+
+type networkChecker struct {
+	timeout time.Duration
+	dial    func(network, address string, timeout time.Duration) (net.Conn, error)
+}
+
+// NewNetworkChecker creates a NetworkChecker that dials over TCP.
+// An optional timeout overrides DefaultNetworkCheckTimeout.
+func NewNetworkChecker(timeout ...time.Duration) application.NetworkChecker {
+	t := DefaultNetworkCheckTimeout
+	if len(timeout) > 0 {
+		t = timeout[0]
+	}
+	return &networkChecker{timeout: t, dial: net.DialTimeout}
+}
+
+// IsReachable opens and closes a TCP connection to host
+func (c *networkChecker) IsReachable(host string) (bool, error) {
+	if strings.TrimSpace(host) == "" {
+		return false, domain.NewValidationError("IsReachable", "host", host, "host cannot be empty")
+	}
+
+	address := host
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		address = net.JoinHostPort(host, defaultNetworkCheckPort)
+	}
+
+	conn, err := c.dial("tcp", address, c.timeout)
+	if err != nil {
+		return false, domain.NewNetworkUnreachableError(address, err)
+	}
+	_ = conn.Close()
+	return true, nil
+}
@@ -0,0 +1,60 @@
+package infrastructure
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+func TestNetworkChecker_IsReachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	checker := NewNetworkChecker(time.Second)
+
+	reachable, err := checker.IsReachable(listener.Addr().String())
+	require.NoError(t, err)
+	assert.True(t, reachable)
+
+	closedAddr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	reachable, err = checker.IsReachable(closedAddr)
+	assert.False(t, reachable)
+	require.ErrorIs(t, err, domain.ErrNetworkUnreachable)
+	assert.Contains(t, err.Error(), closedAddr)
+}
+
+func TestNetworkChecker_DefaultPort(t *testing.T) {
+	var dialed string
+	checker := &networkChecker{
+		timeout: DefaultNetworkCheckTimeout,
+		dial: func(_, address string, timeout time.Duration) (net.Conn, error) {
+			dialed = address
+			assert.Equal(t, DefaultNetworkCheckTimeout, timeout)
+			return nil, errors.New("no route to host")
+		},
+	}
+
+	reachable, err := checker.IsReachable("github.com")
+	assert.False(t, reachable)
+	require.ErrorIs(t, err, domain.ErrNetworkUnreachable)
+	assert.Equal(t, "github.com:443", dialed)
+
+	_, err = checker.IsReachable("git.example.com:22")
+	require.Error(t, err)
+	assert.Equal(t, "git.example.com:22", dialed)
+}
+
+func TestNetworkChecker_EmptyHost(t *testing.T) {
+	reachable, err := NewNetworkChecker().IsReachable(" ")
+	assert.False(t, reachable)
+	var validationErr *domain.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
@@ -1,6 +1,8 @@
 package infrastructure
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 
@@ -94,3 +96,63 @@ func IsPathUnder(base, target string) (bool, error) {
 	}
 	return true, nil
 }
+
+// RelocatePath maps path from below oldBase to the same place below newBase.
+// Returns false when path is not oldBase or below it. oldBase is also compared with
+// symlinks resolved, since git reports worktree paths that way.
+func RelocatePath(path, oldBase, newBase string) (string, bool) {
+	bases := []string{filepath.Clean(oldBase)}
+	if resolved, err := filepath.EvalSymlinks(oldBase); err == nil && resolved != bases[0] {
+		bases = append(bases, resolved)
+	}
+
+	for _, base := range bases {
+		rel, err := filepath.Rel(base, filepath.Clean(path))
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		return filepath.Join(newBase, rel), true
+	}
+	return "", false
+}
+
+// RetargetSymlinks points the symlinks directly inside dir that target oldTarget, or a path
+// below it, at the matching path below newTarget. Returns the number of links updated.
+func RetargetSymlinks(dir, oldTarget, newTarget string) (int, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	updated := 0
+	for _, entry := range entries {
+		if entry.Type()&os.ModeSymlink == 0 {
+			continue
+		}
+
+		link := filepath.Join(dir, entry.Name())
+		target, err := os.Readlink(link)
+		if err != nil {
+			return updated, fmt.Errorf("failed to read symlink %s: %w", link, err)
+		}
+		if !filepath.IsAbs(target) {
+			target = filepath.Join(dir, target)
+		}
+
+		relocated, ok := RelocatePath(target, oldTarget, newTarget)
+		if !ok {
+			continue
+		}
+		if err := os.Remove(link); err != nil {
+			return updated, fmt.Errorf("failed to remove symlink %s: %w", link, err)
+		}
+		if err := os.Symlink(relocated, link); err != nil {
+			return updated, fmt.Errorf("failed to create symlink %s: %w", link, err)
+		}
+		updated++
+	}
+	return updated, nil
+}
@@ -486,3 +486,60 @@ func TestPathUtils_ExtractProjectFromWorktreePath(t *testing.T) {
 		})
 	}
 }
+
+func TestPathUtils_RelocatePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected string
+		ok       bool
+	}{
+		{name: "base itself", path: "/wt/old", expected: "/wt/new", ok: true},
+		{name: "below base", path: "/wt/old/feature/login", expected: "/wt/new/feature/login", ok: true},
+		{name: "sibling with common prefix", path: "/wt/older/feature", ok: false},
+		{name: "outside base", path: "/elsewhere/feature", ok: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			relocated, ok := RelocatePath(tc.path, "/wt/old", "/wt/new")
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, relocated)
+		})
+	}
+}
+
+func TestPathUtils_RetargetSymlinks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks require elevated privileges on Windows")
+	}
+
+	dir := t.TempDir()
+	oldTarget := filepath.Join(dir, "old")
+	newTarget := filepath.Join(dir, "new")
+	require.NoError(t, os.MkdirAll(filepath.Join(newTarget, "feature"), 0755))
+
+	require.NoError(t, os.Symlink(oldTarget, filepath.Join(dir, "absolute")))
+	require.NoError(t, os.Symlink(filepath.Join("old", "feature"), filepath.Join(dir, "relative")))
+	require.NoError(t, os.Symlink("/unrelated", filepath.Join(dir, "unrelated")))
+
+	updated, err := RetargetSymlinks(dir, oldTarget, newTarget)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated)
+
+	target, err := os.Readlink(filepath.Join(dir, "absolute"))
+	require.NoError(t, err)
+	assert.Equal(t, newTarget, target)
+
+	target, err = os.Readlink(filepath.Join(dir, "relative"))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(newTarget, "feature"), target)
+
+	target, err = os.Readlink(filepath.Join(dir, "unrelated"))
+	require.NoError(t, err)
+	assert.Equal(t, "/unrelated", target)
+
+	updated, err = RetargetSymlinks(filepath.Join(dir, "missing"), oldTarget, newTarget)
+	require.NoError(t, err)
+	assert.Zero(t, updated)
+}
@@ -0,0 +1,23 @@
+//go:build !windows && !linux
+
+package infrastructure
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// processStartTime returns when the process started, as reported by ps (whole seconds)
+func processStartTime(pid int) (time.Time, error) {
+	cmd := exec.Command("ps", "-o", "lstart=", "-p", strconv.Itoa(pid)) // #nosec G204 -- fixed command, numeric PID
+	cmd.Env = append(os.Environ(), "LC_ALL=C")
+	out, err := cmd.Output()
+	if err != nil {
+		return time.Time{}, err
+	}
+	// lstart pads the day of month; normalise the spacing before parsing
+	return time.ParseInLocation("Mon Jan 2 15:04:05 2006", strings.Join(strings.Fields(string(out)), " "), time.Local)
+}
@@ -0,0 +1,295 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.ProcessManager = (*processManager)(nil)
+
+// DefaultKillGracePeriod is how long Kill waits after SIGTERM before sending SIGKILL
+const DefaultKillGracePeriod = 5 * time.Second
+
+// processStartTolerance is how far a process's start time may be from its record's StartedAt
+// for the PID to still be the recorded process; ps reports whole seconds
+const processStartTolerance = 2 * time.Second
+
+// pidFile is the on-disk format of a worktree's PID file
+type pidFile struct {
+	WorktreePath string      `json:"worktree_path"`
+	Processes    []pidRecord `json:"processes"`
+}
+
+// pidRecord is a single tracked process in a PID file. StartedAt is the start time the
+// system reports for the PID, which tells the process apart from a later one reusing the PID.
+type pidRecord struct {
+	PID       int       `json:"pid"`
+	Command   string    `json:"command"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// DefaultPIDDir returns the XDG data directory used for PID files
+// ($XDG_DATA_HOME/twiggit/pids, defaulting to ~/.local/share/twiggit/pids)
+func DefaultPIDDir() string {
+	dataHome := os.Getenv("XDG_DATA_HOME")
+	if dataHome == "" {
+		home, _ := os.UserHomeDir()
+		dataHome = filepath.Join(home, ".local", "share")
+	}
+	return filepath.Join(dataHome, "twiggit", "pids")
+}
+
+type processManager struct {
+	pidDir      string
+	gracePeriod time.Duration
+	mu          sync.Mutex
+}
+
+// NewProcessManager creates a ProcessManager storing PID files in pidDir.
+// An optional grace period overrides DefaultKillGracePeriod.
+func NewProcessManager(pidDir string, gracePeriod ...time.Duration) application.ProcessManager {
+	grace := DefaultKillGracePeriod
+	if len(gracePeriod) > 0 {
+		grace = gracePeriod[0]
+	}
+	return &processManager{
+		pidDir:      pidDir,
+		gracePeriod: grace,
+	}
+}
+
+// Start launches command via `sh -c` in the worktree, detached from twiggit, and records its PID
+func (m *processManager) Start(worktreePath, command string, env []string) (*domain.ManagedProcess, error) {
+	if worktreePath == "" {
+		return nil, domain.NewValidationError("Start", "worktreePath", "", "worktree path cannot be empty")
+	}
+	if strings.TrimSpace(command) == "" {
+		return nil, domain.NewValidationError("Start", "command", command, "command cannot be empty")
+	}
+
+	proc := exec.Command("sh", "-c", command) // #nosec G204 -- command comes from the project's hook configuration
+	proc.Dir = worktreePath
+	proc.Env = append(os.Environ(), env...)
+	configureDetached(proc)
+
+	if err := proc.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start %q: %w", command, err)
+	}
+
+	startedAt, err := processStartTime(proc.Process.Pid)
+	if err != nil {
+		startedAt = time.Now()
+	}
+	managed := &domain.ManagedProcess{
+		PID:          proc.Process.Pid,
+		Command:      command,
+		WorktreePath: worktreePath,
+		StartedAt:    startedAt,
+	}
+
+	// Reap the process if it exits while twiggit is still running; otherwise it
+	// is reparented when twiggit exits
+	go func() { _ = proc.Wait() }()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, err := m.readPIDFile(m.pidFilePath(worktreePath))
+	if err != nil {
+		return managed, err
+	}
+	file.WorktreePath = worktreePath
+	file.Processes = append(file.Processes, pidRecord{PID: managed.PID, Command: command, StartedAt: managed.StartedAt})
+	if err := m.writePIDFile(worktreePath, file); err != nil {
+		return managed, err
+	}
+
+	return managed, nil
+}
+
+// List returns running managed processes across all worktrees, ordered by worktree then PID.
+// Records of exited processes, or of PIDs now used by another process, are removed from their PID files.
+func (m *processManager) List() ([]domain.ManagedProcess, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entries, err := os.ReadDir(m.pidDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return []domain.ManagedProcess{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", m.pidDir, err)
+	}
+
+	processes := []domain.ManagedProcess{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		path := filepath.Join(m.pidDir, entry.Name())
+		file, err := m.readPIDFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		running := m.pruneStale(path, file)
+		processes = append(processes, running...)
+	}
+
+	sort.Slice(processes, func(i, j int) bool {
+		if processes[i].WorktreePath != processes[j].WorktreePath {
+			return processes[i].WorktreePath < processes[j].WorktreePath
+		}
+		return processes[i].PID < processes[j].PID
+	})
+	return processes, nil
+}
+
+// Kill terminates the worktree's running processes with SIGTERM, escalating to SIGKILL
+// after the grace period, and removes the PID file. It returns the processes that were signalled.
+// Only processes whose start time matches their record are signalled; a process that cannot be
+// signalled is reported in the error but its record is removed like the others.
+func (m *processManager) Kill(ctx context.Context, worktreePath string) ([]domain.ManagedProcess, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	path := m.pidFilePath(worktreePath)
+	file, err := m.readPIDFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	running := m.pruneStale(path, file)
+	signalled := make([]domain.ManagedProcess, 0, len(running))
+	for _, proc := range running {
+		if err := terminateProcess(proc.PID); err != nil && processAlive(proc.PID) {
+			errs = append(errs, fmt.Errorf("failed to send SIGTERM to process %d: %w", proc.PID, err))
+			continue
+		}
+		signalled = append(signalled, proc)
+	}
+
+	deadline := time.Now().Add(m.gracePeriod)
+	for _, proc := range signalled {
+		for processAlive(proc.PID) && time.Now().Before(deadline) {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(100 * time.Millisecond):
+			}
+		}
+		if processAlive(proc.PID) {
+			if err := killProcess(proc.PID); err != nil && processAlive(proc.PID) {
+				errs = append(errs, fmt.Errorf("failed to send SIGKILL to process %d: %w", proc.PID, err))
+			}
+		}
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		errs = append(errs, fmt.Errorf("failed to remove %s: %w", path, err))
+	}
+	return signalled, errors.Join(errs...)
+}
+
+// pidFilePath returns the PID file path for a worktree, keyed by a hash of its cleaned path
+func (m *processManager) pidFilePath(worktreePath string) string {
+	sum := sha256.Sum256([]byte(filepath.Clean(worktreePath)))
+	return filepath.Join(m.pidDir, hex.EncodeToString(sum[:8])+".json")
+}
+
+// readPIDFile reads a PID file, returning an empty file when it does not exist
+func (m *processManager) readPIDFile(path string) (*pidFile, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is inside the twiggit PID directory
+	if errors.Is(err, os.ErrNotExist) {
+		return &pidFile{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var file pidFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &file, nil
+}
+
+// writePIDFile writes the worktree's PID file, removing it when no processes remain
+func (m *processManager) writePIDFile(worktreePath string, file *pidFile) error {
+	path := m.pidFilePath(worktreePath)
+	if len(file.Processes) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(m.pidDir, 0700); err != nil {
+		return fmt.Errorf("failed to create %s: %w", m.pidDir, err)
+	}
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode PID file: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// pruneStale drops from the PID file at path the processes that exited or whose PID now
+// belongs to another process (see ownsProcess), and returns the running ones
+func (m *processManager) pruneStale(path string, file *pidFile) []domain.ManagedProcess {
+	var alive []pidRecord
+	running := []domain.ManagedProcess{}
+	for _, record := range file.Processes {
+		if !processAlive(record.PID) || !ownsProcess(record) {
+			continue
+		}
+		alive = append(alive, record)
+		running = append(running, domain.ManagedProcess{
+			PID:          record.PID,
+			Command:      record.Command,
+			WorktreePath: file.WorktreePath,
+			StartedAt:    record.StartedAt,
+		})
+	}
+
+	if len(alive) != len(file.Processes) {
+		file.Processes = alive
+		if len(alive) == 0 {
+			_ = os.Remove(path)
+		} else if file.WorktreePath != "" {
+			_ = m.writePIDFile(file.WorktreePath, file)
+		}
+	}
+	return running
+}
+
+// ownsProcess reports whether the record's PID is still the process twiggit started: PID files
+// outlive reboots and PIDs are reused. A process whose start time cannot be read is not ours.
+func ownsProcess(record pidRecord) bool {
+	started, err := processStartTime(record.PID)
+	if err != nil {
+		return false
+	}
+	diff := started.Sub(record.StartedAt)
+	return diff <= processStartTolerance && diff >= -processStartTolerance
+}
@@ -0,0 +1,173 @@
+package infrastructure
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func skipWithoutPOSIXShell(t *testing.T) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("process manager tests require a POSIX shell")
+	}
+}
+
+func waitForExit(t *testing.T, pid int) {
+	t.Helper()
+	require.Eventually(t, func() bool { return !processAlive(pid) }, 5*time.Second, 20*time.Millisecond)
+}
+
+func TestDefaultPIDDir(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", "/data")
+	assert.Equal(t, filepath.Join("/data", "twiggit", "pids"), DefaultPIDDir())
+
+	t.Setenv("XDG_DATA_HOME", "")
+	t.Setenv("HOME", "/home/user")
+	assert.Equal(t, filepath.Join("/home/user", ".local", "share", "twiggit", "pids"), DefaultPIDDir())
+}
+
+func TestProcessManager_StartListKill(t *testing.T) {
+	skipWithoutPOSIXShell(t)
+	pidDir := t.TempDir()
+	worktree := t.TempDir()
+	manager := NewProcessManager(pidDir, 2*time.Second)
+
+	started, err := manager.Start(worktree, "sleep 30", []string{"TWIGGIT_BRANCH_NAME=feature"})
+	require.NoError(t, err)
+	assert.Positive(t, started.PID)
+	assert.Equal(t, worktree, started.WorktreePath)
+
+	processes, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, processes, 1)
+	assert.Equal(t, started.PID, processes[0].PID)
+	assert.Equal(t, "sleep 30", processes[0].Command)
+	assert.Equal(t, worktree, processes[0].WorktreePath)
+
+	killed, err := manager.Kill(context.Background(), worktree)
+	require.NoError(t, err)
+	require.Len(t, killed, 1)
+	waitForExit(t, started.PID)
+
+	entries, err := os.ReadDir(pidDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "PID file should be removed after kill")
+}
+
+func TestProcessManager_KillEscalatesToSIGKILL(t *testing.T) {
+	skipWithoutPOSIXShell(t)
+	worktree := t.TempDir()
+	manager := NewProcessManager(t.TempDir(), 200*time.Millisecond)
+
+	started, err := manager.Start(worktree, `trap "" TERM; while true; do sleep 1; done`, nil)
+	require.NoError(t, err)
+	time.Sleep(100 * time.Millisecond) // let the shell install its trap
+
+	killed, err := manager.Kill(context.Background(), worktree)
+	require.NoError(t, err)
+	require.Len(t, killed, 1)
+	waitForExit(t, started.PID)
+}
+
+func TestProcessManager_ListPrunesExitedProcesses(t *testing.T) {
+	skipWithoutPOSIXShell(t)
+	pidDir := t.TempDir()
+	worktree := t.TempDir()
+	manager := NewProcessManager(pidDir)
+
+	started, err := manager.Start(worktree, "true", nil)
+	require.NoError(t, err)
+	waitForExit(t, started.PID)
+
+	processes, err := manager.List()
+	require.NoError(t, err)
+	assert.Empty(t, processes)
+
+	entries, err := os.ReadDir(pidDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestProcessManager_EmptyState(t *testing.T) {
+	manager := NewProcessManager(filepath.Join(t.TempDir(), "missing"))
+
+	processes, err := manager.List()
+	require.NoError(t, err)
+	assert.Empty(t, processes)
+
+	killed, err := manager.Kill(context.Background(), "/no/such/worktree")
+	require.NoError(t, err)
+	assert.Empty(t, killed)
+}
+
+func TestProcessManager_StartValidation(t *testing.T) {
+	manager := NewProcessManager(t.TempDir())
+
+	_, err := manager.Start("", "sleep 1", nil)
+	require.Error(t, err)
+	_, err = manager.Start(t.TempDir(), "  ", nil)
+	require.Error(t, err)
+}
+
+func TestProcessManager_CorruptPIDFile(t *testing.T) {
+	pidDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(pidDir, "broken.json"), []byte("not json"), 0600))
+
+	_, err := NewProcessManager(pidDir).List()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse")
+}
+
+func TestProcessManager_KillSkipsReusedPID(t *testing.T) {
+	skipWithoutPOSIXShell(t)
+	pidDir := t.TempDir()
+	worktree := t.TempDir()
+	manager := NewProcessManager(pidDir, 200*time.Millisecond).(*processManager)
+
+	// A process twiggit did not start holds the PID of a record from before a reboot
+	other := exec.Command("sleep", "30")
+	require.NoError(t, other.Start())
+	t.Cleanup(func() { _ = other.Process.Kill(); _ = other.Wait() })
+	require.NoError(t, manager.writePIDFile(worktree, &pidFile{
+		WorktreePath: worktree,
+		Processes:    []pidRecord{{PID: other.Process.Pid, Command: "npm run dev", StartedAt: time.Now().Add(-48 * time.Hour)}},
+	}))
+
+	processes, err := manager.List()
+	require.NoError(t, err)
+	assert.Empty(t, processes)
+
+	require.NoError(t, manager.writePIDFile(worktree, &pidFile{
+		WorktreePath: worktree,
+		Processes:    []pidRecord{{PID: other.Process.Pid, Command: "npm run dev", StartedAt: time.Now().Add(-48 * time.Hour)}},
+	}))
+	killed, err := manager.Kill(context.Background(), worktree)
+	require.NoError(t, err)
+	assert.Empty(t, killed)
+	assert.True(t, processAlive(other.Process.Pid), "the unrelated process must not be signalled")
+
+	entries, err := os.ReadDir(pidDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "the stale record is dropped")
+}
+
+func TestProcessStartTime(t *testing.T) {
+	skipWithoutPOSIXShell(t)
+	before := time.Now()
+	proc := exec.Command("sleep", "30")
+	require.NoError(t, proc.Start())
+	t.Cleanup(func() { _ = proc.Process.Kill(); _ = proc.Wait() })
+
+	started, err := processStartTime(proc.Process.Pid)
+	require.NoError(t, err)
+	assert.WithinDuration(t, before, started, processStartTolerance)
+	assert.True(t, ownsProcess(pidRecord{PID: proc.Process.Pid, StartedAt: started}))
+}
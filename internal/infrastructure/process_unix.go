@@ -0,0 +1,41 @@
+//go:build !windows
+
+package infrastructure
+
+import (
+	"errors"
+	"os/exec"
+	"syscall"
+)
+
+// configureDetached starts the process in its own process group so it survives
+// twiggit exiting and so the whole group can be signalled
+func configureDetached(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// processAlive reports whether a process with the given PID exists
+func processAlive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	err := syscall.Kill(pid, 0)
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
+
+// terminateProcess sends SIGTERM to the process group, falling back to the process
+func terminateProcess(pid int) error {
+	return signalGroup(pid, syscall.SIGTERM)
+}
+
+// killProcess sends SIGKILL to the process group, falling back to the process
+func killProcess(pid int) error {
+	return signalGroup(pid, syscall.SIGKILL)
+}
+
+func signalGroup(pid int, sig syscall.Signal) error {
+	if err := syscall.Kill(-pid, sig); err == nil {
+		return nil
+	}
+	return syscall.Kill(pid, sig)
+}
@@ -0,0 +1,52 @@
+//go:build windows
+
+package infrastructure
+
+import (
+	"os"
+	"os/exec"
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+// configureDetached is a no-op on Windows; child processes already outlive their parent
+func configureDetached(_ *exec.Cmd) {}
+
+// processAlive reports whether a process with the given PID exists
+func processAlive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	_, err := os.FindProcess(pid)
+	return err == nil
+}
+
+// terminateProcess stops the process; Windows has no SIGTERM so it is killed directly
+func terminateProcess(pid int) error {
+	return killProcess(pid)
+}
+
+// killProcess forcibly stops the process
+func killProcess(pid int) error {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+	return proc.Kill()
+}
+
+// processStartTime returns the creation time of the process
+func processStartTime(pid int) (time.Time, error) {
+	handle, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, uint32(pid)) // #nosec G115 -- PIDs are positive
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer func() { _ = windows.CloseHandle(handle) }()
+
+	var creation, exit, kernel, user windows.Filetime
+	if err := windows.GetProcessTimes(handle, &creation, &exit, &kernel, &user); err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, creation.Nanoseconds()), nil
+}
@@ -0,0 +1,88 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/knadh/koanf/parsers/toml"
+	"github.com/knadh/koanf/v2"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+// projectSettingsFile is the per-project configuration file at the repository root, shared with hooks
+const projectSettingsFile = ".twiggit.toml"
+
+// defaultWorktreeKey is the top-level key of .twiggit.toml naming the default worktree
+const defaultWorktreeKey = "default_worktree"
+
+var _ application.ProjectSettingsStore = (*projectSettingsStore)(nil)
+
+type projectSettingsStore struct{}
+
+// NewProjectSettingsStore creates a store for the project settings of .twiggit.toml files
+func NewProjectSettingsStore() application.ProjectSettingsStore {
+	return &projectSettingsStore{}
+}
+
+// Load reads the settings of the .twiggit.toml in repoPath; a missing file gives empty settings
+func (s *projectSettingsStore) Load(repoPath string) (*domain.ProjectSettings, error) {
+	path := filepath.Join(repoPath, projectSettingsFile)
+	content, err := os.ReadFile(path) // #nosec G304 -- file of a discovered project
+	if os.IsNotExist(err) {
+		return &domain.ProjectSettings{}, nil
+	}
+	if err != nil {
+		return nil, domain.NewConfigError(path, "failed to read project settings", err)
+	}
+	return parseProjectSettings(path, content)
+}
+
+// SetDefaultWorktree writes default_worktree to the .twiggit.toml in repoPath, keeping its
+// comments and hooks; an empty branch removes the key
+func (s *projectSettingsStore) SetDefaultWorktree(repoPath, branch string) error {
+	path := filepath.Join(repoPath, projectSettingsFile)
+
+	literal := ""
+	if branch != "" {
+		literal = strconv.Quote(branch)
+	}
+
+	unlock, err := lockFile(path, configLockTimeout)
+	if err != nil {
+		return domain.NewConfigError(path, "failed to lock project settings", err)
+	}
+	defer unlock()
+
+	content, err := os.ReadFile(path) // #nosec G304 -- file of a discovered project
+	if err != nil && !os.IsNotExist(err) {
+		return domain.NewConfigError(path, "failed to read project settings", err)
+	}
+	updated := setConfigKey(string(content), defaultWorktreeKey, literal)
+	if updated == string(content) {
+		return nil
+	}
+	if _, err := parseProjectSettings(path, []byte(updated)); err != nil {
+		return err
+	}
+
+	if err := WriteFileAtomic(path, []byte(updated), 0644); err != nil {
+		return domain.NewConfigError(path, "failed to write project settings", err)
+	}
+	return nil
+}
+
+// parseProjectSettings decodes the top-level settings of .twiggit.toml content
+func parseProjectSettings(path string, content []byte) (*domain.ProjectSettings, error) {
+	k := koanf.New(".")
+	if err := k.Load(contentProvider(content), toml.Parser()); err != nil {
+		return nil, domain.NewConfigError(path, "project settings do not parse", err)
+	}
+	var settings domain.ProjectSettings
+	if err := k.Unmarshal("", &settings); err != nil {
+		return nil, domain.NewConfigError(path, "invalid project settings", err)
+	}
+	return &settings, nil
+}
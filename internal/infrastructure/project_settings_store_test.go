@@ -0,0 +1,78 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectSettingsStore_LoadMissingFile(t *testing.T) {
+	settings, err := NewProjectSettingsStore().Load(t.TempDir())
+
+	require.NoError(t, err)
+	assert.Empty(t, settings.DefaultWorktree)
+}
+
+func TestProjectSettingsStore_SetAndUnsetDefaultWorktree(t *testing.T) {
+	repo := t.TempDir()
+	path := filepath.Join(repo, projectSettingsFile)
+	original := `# project hooks
+[hooks.post-create]
+commands = ["mise trust"]
+`
+	require.NoError(t, os.WriteFile(path, []byte(original), 0644))
+	store := NewProjectSettingsStore()
+
+	require.NoError(t, store.SetDefaultWorktree(repo, "feature/login"))
+
+	settings, err := store.Load(repo)
+	require.NoError(t, err)
+	assert.Equal(t, "feature/login", settings.DefaultWorktree)
+	hooks, err := NewHookRunner(nil, nil).LoadConfig(path)
+	require.NoError(t, err)
+	require.NotNil(t, hooks)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "# project hooks")
+
+	require.NoError(t, store.SetDefaultWorktree(repo, ""))
+
+	settings, err = store.Load(repo)
+	require.NoError(t, err)
+	assert.Empty(t, settings.DefaultWorktree)
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, original, string(content))
+}
+
+func TestProjectSettingsStore_SetCreatesFile(t *testing.T) {
+	repo := t.TempDir()
+	store := NewProjectSettingsStore()
+
+	require.NoError(t, store.SetDefaultWorktree(repo, "develop"))
+
+	settings, err := store.Load(repo)
+	require.NoError(t, err)
+	assert.Equal(t, "develop", settings.DefaultWorktree)
+}
+
+func TestProjectSettingsStore_UnsetWithoutFileIsNoop(t *testing.T) {
+	repo := t.TempDir()
+
+	require.NoError(t, NewProjectSettingsStore().SetDefaultWorktree(repo, ""))
+
+	assert.NoFileExists(t, filepath.Join(repo, projectSettingsFile))
+}
+
+func TestProjectSettingsStore_LoadInvalidFile(t *testing.T) {
+	repo := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(repo, projectSettingsFile), []byte("default_worktree = [\n"), 0644))
+
+	_, err := NewProjectSettingsStore().Load(repo)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "project settings do not parse")
+}
@@ -0,0 +1,265 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.PullRequestFinder = (*pullRequestFinder)(nil)
+
+// PullRequestCacheTTL is how long a looked-up pull request (or its absence) is reused
+const PullRequestCacheTTL = 5 * time.Minute
+
+// PullRequestCacheFileName is the cache file under the twiggit cache directory
+const PullRequestCacheFileName = "pull-requests.json"
+
+// githubPull holds the fields of one entry of GitHub's pull request list
+type githubPull struct {
+	Number   int     `json:"number"`
+	Title    string  `json:"title"`
+	State    string  `json:"state"`
+	Draft    bool    `json:"draft"`
+	MergedAt *string `json:"merged_at"`
+	HTMLURL  string  `json:"html_url"`
+}
+
+// gitlabMergeRequest holds the fields of one entry of GitLab's merge request list
+type gitlabMergeRequest struct {
+	IID    int    `json:"iid"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+	Draft  bool   `json:"draft"`
+	WebURL string `json:"web_url"`
+}
+
+// pullRequestCacheEntry is one cached lookup; PR is nil when the branch had no pull request
+type pullRequestCacheEntry struct {
+	PR        *domain.OpenPR `json:"pr"`
+	FetchedAt time.Time      `json:"fetched_at"`
+}
+
+// DefaultPullRequestCachePath returns the XDG cache file used for pull request lookups
+// ($XDG_CACHE_HOME/twiggit/pull-requests.json, defaulting to ~/.cache/twiggit/pull-requests.json)
+func DefaultPullRequestCachePath() string {
+	cacheHome := os.Getenv("XDG_CACHE_HOME")
+	if cacheHome == "" {
+		home, _ := os.UserHomeDir()
+		cacheHome = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(cacheHome, "twiggit", PullRequestCacheFileName)
+}
+
+type pullRequestFinder struct {
+	client    *http.Client
+	host      string
+	baseURL   string
+	tokens    application.TokenStore
+	cachePath string // "" disables caching
+	now       func() time.Time
+	mu        sync.Mutex
+}
+
+// NewPullRequestFinder creates a PullRequestFinder for github.com or gitlab.com, authenticating
+// with the token stored for the host when there is one. Results are cached per branch in
+// cachePath for PullRequestCacheTTL. An optional timeout overrides DefaultCIRequestTimeout.
+func NewPullRequestFinder(host string, tokens application.TokenStore, cachePath string, timeout ...time.Duration) application.PullRequestFinder {
+	t := DefaultCIRequestTimeout
+	if len(timeout) > 0 {
+		t = timeout[0]
+	}
+	return &pullRequestFinder{
+		client:    &http.Client{Timeout: t},
+		host:      host,
+		baseURL:   defaultAPIBaseURLs[host],
+		tokens:    tokens,
+		cachePath: cachePath,
+		now:       time.Now,
+	}
+}
+
+// FindPullRequest returns the branch's open pull request, or its merged one when none is open
+func (f *pullRequestFinder) FindPullRequest(ctx context.Context, owner, repo, branch string) (*domain.OpenPR, error) {
+	if f.baseURL == "" {
+		return nil, domain.NewValidationError("FindPullRequest", "host", f.host, "unsupported host; use github or gitlab")
+	}
+	if owner == "" || repo == "" {
+		return nil, domain.NewValidationError("FindPullRequest", "repo", owner+"/"+repo, "owner and repository are required")
+	}
+	if branch == "" {
+		return nil, domain.NewValidationError("FindPullRequest", "branch", "", "branch cannot be empty")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := f.host + "/" + owner + "/" + repo + "#" + branch
+	cache := f.readCache()
+	if entry, ok := cache[key]; ok && f.now().Sub(entry.FetchedAt) < PullRequestCacheTTL {
+		return entry.PR, nil
+	}
+
+	token, err := f.token()
+	if err != nil {
+		return nil, err
+	}
+	pr, err := f.fetch(ctx, owner, repo, branch, token)
+	if err != nil {
+		return nil, err
+	}
+
+	cache[key] = pullRequestCacheEntry{PR: pr, FetchedAt: f.now()}
+	f.writeCache(cache)
+	return pr, nil
+}
+
+// token returns the stored token for the host, "" when none is stored
+func (f *pullRequestFinder) token() (string, error) {
+	if f.tokens == nil {
+		return "", nil
+	}
+	stored, err := f.tokens.Get(f.host)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s token: %w", f.host, err)
+	}
+	if stored == nil {
+		return "", nil
+	}
+	return stored.Token, nil
+}
+
+// fetch lists the pull requests whose head is branch and picks the one to show
+func (f *pullRequestFinder) fetch(ctx context.Context, owner, repo, branch, token string) (*domain.OpenPR, error) {
+	query := url.Values{"state": {"all"}, "per_page": {"20"}}
+	var endpoint string
+	if f.host == domain.AuthHostGitLab {
+		query.Set("source_branch", branch)
+		endpoint = fmt.Sprintf("%s/projects/%s/merge_requests?%s", f.baseURL, url.PathEscape(owner+"/"+repo), query.Encode())
+	} else {
+		query.Set("head", owner+":"+branch)
+		endpoint = fmt.Sprintf("%s/repos/%s/%s/pulls?%s", f.baseURL, url.PathEscape(owner), url.PathEscape(repo), query.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request for %s: %w", f.host, err)
+	}
+	setAPIAuthHeaders(req, f.host, token)
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach %s: %w", f.host, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, domain.NewValidationError("FindPullRequest", "token", domain.MaskToken(token), fmt.Sprintf("%s rejected the request (%s); store a token with 'twiggit auth add'", f.host, resp.Status))
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%s has no repository %s/%s", f.host, owner, repo)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response from %s: %s", f.host, resp.Status)
+	}
+
+	if f.host == domain.AuthHostGitLab {
+		var requests []gitlabMergeRequest
+		if err := json.NewDecoder(resp.Body).Decode(&requests); err != nil {
+			return nil, fmt.Errorf("failed to parse %s merge request response: %w", f.host, err)
+		}
+		return gitlabPullRequest(requests), nil
+	}
+
+	var pulls []githubPull
+	if err := json.NewDecoder(resp.Body).Decode(&pulls); err != nil {
+		return nil, fmt.Errorf("failed to parse %s pull request response: %w", f.host, err)
+	}
+	return githubPullRequest(pulls), nil
+}
+
+// githubPullRequest picks the first open pull request, then the first merged one;
+// pulls closed without merging are ignored
+func githubPullRequest(pulls []githubPull) *domain.OpenPR {
+	var merged *domain.OpenPR
+	for _, p := range pulls {
+		pr := &domain.OpenPR{Number: p.Number, Title: p.Title, URL: p.HTMLURL}
+		switch {
+		case p.State == "open" && p.Draft:
+			pr.State = domain.PRStateDraft
+			return pr
+		case p.State == "open":
+			pr.State = domain.PRStateOpen
+			return pr
+		case p.MergedAt != nil && merged == nil:
+			pr.State = domain.PRStateMerged
+			merged = pr
+		}
+	}
+	return merged
+}
+
+// gitlabPullRequest picks the first opened merge request, then the first merged one
+func gitlabPullRequest(requests []gitlabMergeRequest) *domain.OpenPR {
+	var merged *domain.OpenPR
+	for _, r := range requests {
+		pr := &domain.OpenPR{Number: r.IID, Title: r.Title, URL: r.WebURL}
+		switch {
+		case r.State == "opened" && r.Draft:
+			pr.State = domain.PRStateDraft
+			return pr
+		case r.State == "opened":
+			pr.State = domain.PRStateOpen
+			return pr
+		case r.State == "merged" && merged == nil:
+			pr.State = domain.PRStateMerged
+			merged = pr
+		}
+	}
+	return merged
+}
+
+// readCache loads the cache file; a missing or unreadable cache is empty
+func (f *pullRequestFinder) readCache() map[string]pullRequestCacheEntry {
+	cache := map[string]pullRequestCacheEntry{}
+	if f.cachePath == "" {
+		return cache
+	}
+	data, err := os.ReadFile(f.cachePath) // #nosec G304 -- path is the twiggit cache file
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return map[string]pullRequestCacheEntry{}
+	}
+	return cache
+}
+
+// writeCache stores the unexpired entries; failures only cost a later lookup
+func (f *pullRequestFinder) writeCache(cache map[string]pullRequestCacheEntry) {
+	if f.cachePath == "" {
+		return
+	}
+	for key, entry := range cache {
+		if f.now().Sub(entry.FetchedAt) >= PullRequestCacheTTL {
+			delete(cache, key)
+		}
+	}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(f.cachePath), 0700); err != nil {
+		return
+	}
+	_ = os.WriteFile(f.cachePath, data, 0600)
+}
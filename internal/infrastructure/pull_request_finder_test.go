@@ -0,0 +1,161 @@
+package infrastructure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+	"twiggit/test/mocks"
+)
+
+func newTestPullRequestFinder(t *testing.T, host string, handler http.HandlerFunc) *pullRequestFinder {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return &pullRequestFinder{
+		client:    &http.Client{Timeout: time.Second},
+		host:      host,
+		baseURL:   server.URL,
+		cachePath: filepath.Join(t.TempDir(), PullRequestCacheFileName),
+		now:       time.Now,
+	}
+}
+
+func TestPullRequestFinder_GitHubResponses(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		expected *domain.OpenPR
+	}{
+		{
+			name:     "open pull request",
+			body:     `[{"number":42,"title":"Add login","state":"open","draft":false,"html_url":"https://github.com/acme/api/pull/42"}]`,
+			expected: &domain.OpenPR{Number: 42, Title: "Add login", State: domain.PRStateOpen, URL: "https://github.com/acme/api/pull/42"},
+		},
+		{
+			name:     "draft pull request",
+			body:     `[{"number":43,"title":"WIP","state":"open","draft":true,"html_url":"https://github.com/acme/api/pull/43"}]`,
+			expected: &domain.OpenPR{Number: 43, Title: "WIP", State: domain.PRStateDraft, URL: "https://github.com/acme/api/pull/43"},
+		},
+		{
+			name: "open pull request wins over a merged one",
+			body: `[{"number":10,"title":"Old","state":"closed","merged_at":"2026-01-01T00:00:00Z","html_url":"u10"},
+				{"number":11,"title":"New","state":"open","html_url":"u11"}]`,
+			expected: &domain.OpenPR{Number: 11, Title: "New", State: domain.PRStateOpen, URL: "u11"},
+		},
+		{
+			name:     "merged pull request",
+			body:     `[{"number":9,"title":"Closed","state":"closed","merged_at":null,"html_url":"u9"},{"number":10,"title":"Done","state":"closed","merged_at":"2026-01-01T00:00:00Z","html_url":"u10"}]`,
+			expected: &domain.OpenPR{Number: 10, Title: "Done", State: domain.PRStateMerged, URL: "u10"},
+		},
+		{name: "closed without merging", body: `[{"number":9,"title":"Closed","state":"closed","merged_at":null}]`},
+		{name: "no pull requests", body: `[]`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			finder := newTestPullRequestFinder(t, domain.AuthHostGitHub, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/repos/acme/api/pulls", r.URL.Path)
+				assert.Equal(t, "acme:feature/login", r.URL.Query().Get("head"))
+				assert.Equal(t, "all", r.URL.Query().Get("state"))
+				_, _ = w.Write([]byte(tc.body))
+			})
+
+			pr, err := finder.FindPullRequest(context.Background(), "acme", "api", "feature/login")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, pr)
+		})
+	}
+}
+
+func TestPullRequestFinder_GitLabMergeRequest(t *testing.T) {
+	finder := newTestPullRequestFinder(t, domain.AuthHostGitLab, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects/group%2Fsub%2Ftool/merge_requests", r.URL.EscapedPath())
+		assert.Equal(t, "feature", r.URL.Query().Get("source_branch"))
+		assert.Equal(t, "glpat_stored", r.Header.Get("PRIVATE-TOKEN"))
+		_, _ = w.Write([]byte(`[{"iid":3,"title":"Old","state":"merged","web_url":"u3"},{"iid":5,"title":"Draft: new","state":"opened","draft":true,"web_url":"u5"}]`))
+	})
+	tokens := mocks.NewMockTokenStore()
+	tokens.On("Get", domain.AuthHostGitLab).Return(&domain.StoredToken{Host: domain.AuthHostGitLab, Token: "glpat_stored"}, nil)
+	finder.tokens = tokens
+
+	pr, err := finder.FindPullRequest(context.Background(), "group/sub", "tool", "feature")
+	require.NoError(t, err)
+	assert.Equal(t, &domain.OpenPR{Number: 5, Title: "Draft: new", State: domain.PRStateDraft, URL: "u5"}, pr)
+}
+
+func TestPullRequestFinder_CachesPerBranch(t *testing.T) {
+	var requests atomic.Int32
+	finder := newTestPullRequestFinder(t, domain.AuthHostGitHub, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Query().Get("head") == "acme:empty" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"number":1,"title":"One","state":"open","html_url":"u1"}]`))
+	})
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	finder.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	first, err := finder.FindPullRequest(ctx, "acme", "api", "feature")
+	require.NoError(t, err)
+	_, err = finder.FindPullRequest(ctx, "acme", "api", "empty")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), requests.Load())
+
+	// Cached results, including "no pull request", are reused by a new finder on the same file
+	reopened := &pullRequestFinder{client: finder.client, host: finder.host, baseURL: finder.baseURL, cachePath: finder.cachePath, now: finder.now}
+	cached, err := reopened.FindPullRequest(ctx, "acme", "api", "feature")
+	require.NoError(t, err)
+	assert.Equal(t, first, cached)
+	none, err := reopened.FindPullRequest(ctx, "acme", "api", "empty")
+	require.NoError(t, err)
+	assert.Nil(t, none)
+	assert.Equal(t, int32(2), requests.Load())
+
+	now = now.Add(PullRequestCacheTTL)
+	_, err = reopened.FindPullRequest(ctx, "acme", "api", "feature")
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), requests.Load(), "expired entries are fetched again")
+}
+
+func TestPullRequestFinder_Errors(t *testing.T) {
+	t.Run("rejected token", func(t *testing.T) {
+		finder := newTestPullRequestFinder(t, domain.AuthHostGitHub, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+		_, err := finder.FindPullRequest(context.Background(), "acme", "api", "feature")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "twiggit auth add")
+	})
+
+	t.Run("malformed response", func(t *testing.T) {
+		finder := newTestPullRequestFinder(t, domain.AuthHostGitHub, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"message":"not a list"}`))
+		})
+		_, err := finder.FindPullRequest(context.Background(), "acme", "api", "feature")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse")
+	})
+
+	t.Run("unsupported host", func(t *testing.T) {
+		finder := NewPullRequestFinder("bitbucket.org", nil, "")
+		_, err := finder.FindPullRequest(context.Background(), "acme", "api", "feature")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported host")
+	})
+}
+
+func TestDefaultPullRequestCachePath(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", "/tmp/cache-home")
+	assert.Equal(t, filepath.Join("/tmp/cache-home", "twiggit", PullRequestCacheFileName), DefaultPullRequestCachePath())
+}
@@ -0,0 +1,241 @@
+package infrastructure
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"twiggit/internal/domain"
+)
+
+// configMeta documents every configuration key, keyed by its dotted TOML path. Tables
+// ("git", "shell.wrapper") are documented too. It is the single source of truth for the
+// sample written by WriteSampleConfig; a test fails when a key of domain.Config is missing.
+var configMeta = map[string]string{
+	"projects_dir":                "Directory containing your project repositories (~ and $VARS are expanded)",
+	"worktrees_dir":               "Directory where worktrees are created as <worktrees_dir>/<project>/<branch>",
+	"default_source_branch":       "Branch new worktrees are created from when create --source is not given",
+	"branch_description_template": "Go template for the description of new branches ({{.Branch}}, {{.Project}}, {{.JiraKey}});\nempty leaves new branches undescribed (create --set-description overrides it)",
+	"cd_on_create":                "Change into new worktrees after create when run through the shell wrapper (create --no-cd skips it)",
+	"default_sort":                "Order of list output when --sort is not given: branch, project, path or age;\nempty keeps git's order (set with 'twiggit worktrees sort')",
+	"max_behind_commits":          "Refuse to create worktrees starting this many commits behind default_source_branch\n(0 = no limit; create --fail-if-behind overrides it)",
+
+	"context_detection":                       "Detection of the project or worktree you are in",
+	"context_detection.cache_ttl":             "How long detection results are cached",
+	"context_detection.git_operation_timeout": "Timeout for git operations during detection",
+	"context_detection.enable_git_validation": "Check that detected directories are valid git repositories",
+
+	"git":                            "Git operations",
+	"git.cli_timeout":                "Timeout for git commands, in seconds",
+	"git.cache_enabled":              "Cache results of git operations",
+	"git.gpg_sign_commits":           "Sign commits in new worktrees (same as create --gpg-sign)",
+	"git.gpg_signing_key":            "Signing key for new worktrees; empty uses the global user.signingkey",
+	"git.use_local_branch_if_exists": "Check out an existing local branch in create even when --source or a preset asked for another source (same as create --use-local-branch)",
+	"git.skip_network_check":         "Skip the connectivity check before remote operations (same as --no-network-check)",
+	"git.default_merge_strategy":     "How git pull integrates changes in new worktrees: merge, squash or rebase\n(create --squash-on-merge forces squash)",
+
+	"services":                       "Internal service behavior",
+	"services.cache_enabled":         "Cache service results",
+	"services.cache_ttl":             "How long service results are cached (Go duration, e.g. 5m0s)",
+	"services.concurrent_operations": "Run independent operations concurrently",
+	"services.max_concurrent":        "Maximum number of concurrent operations",
+
+	"validation":                        "Safety checks",
+	"validation.strict_branch_names":    "Reject branch names git would accept but that are awkward to type",
+	"validation.require_clean_worktree": "Refuse to delete worktrees with uncommitted changes unless --force is given",
+	"validation.allow_force_delete":     "Allow --force to delete worktrees with uncommitted changes",
+	"validation.protected_branches":     "Branches that delete and prune never remove; globs such as release/* are allowed",
+	"validation.max_delete_default":     "Maximum number of worktrees prune deletes at once (0 = no limit)",
+
+	"navigation":                    "Suggestions and history for cd and other navigation",
+	"navigation.enable_suggestions": "Suggest similar names when a project or worktree is not found",
+	"navigation.max_suggestions":    "Maximum number of suggestions shown",
+	"navigation.fuzzy_matching":     "Match names fuzzily instead of by prefix",
+	"navigation.history_size":       "Number of visited worktrees kept for 'twiggit history' and 'twiggit back' (0 = no history)",
+
+	"shell":              "Shell integration",
+	"shell.enabled":      "Enable shell integration features",
+	"shell.timeout":      "Timeout for shell operations, in seconds",
+	"shell.hook_timeout": "Timeout for each .twiggit.toml hook command, in seconds",
+
+	"shell.wrapper":                "Shell wrapper installed by 'twiggit init --install'",
+	"shell.wrapper.enabled":        "Enable the shell wrapper",
+	"shell.wrapper.auto_detect":    "Detect the shell from $SHELL",
+	"shell.wrapper.default_shell":  "Shell used when detection fails: bash, zsh or fish",
+	"shell.wrapper.backup_enabled": "Back up shell config files before installing the wrapper",
+	"shell.wrapper.backup_dir":     "Directory for those backups",
+
+	"completion":                  "Shell completion",
+	"completion.timeout":          "Time limit for computing suggestions; slow git operations give no suggestions",
+	"completion.exclude_branches": "Glob patterns of branches never suggested",
+	"completion.exclude_projects": "Glob patterns of projects never suggested",
+
+	"theme":                      "Terminal output styling",
+	"theme.age_color_young_days": "Worktrees active within this many days are shown as recent (list --color-by-age)",
+	"theme.age_color_old_days":   "Worktrees inactive for more than this many days are shown as old",
+	"theme.age_color_stale_days": "Worktrees inactive for more than this many days are shown dimmed",
+
+	"presets": "Settings of create --preset <name>, one [presets.<name>] table each with the keys below;\n" +
+		"feature, hotfix, release and experiment work without a table",
+	"presets.*.source_branch":       "Branch to start from (empty = default_source_branch; create --source overrides it)",
+	"presets.*.naming_template":     "Go template for the branch name ({{.Name}}, {{.Preset}}; empty = \"<preset>-{{.Name}}\")",
+	"presets.*.protected_on_delete": "Lock new worktrees so delete and prune leave them alone",
+	"presets.*.hooks":               "Commands run in new worktrees after the post-create hook of .twiggit.toml",
+	"presets.*.auto_fetch":          "Fetch the source branch from origin and start from origin/<source_branch>",
+}
+
+// WriteSampleConfig writes a configuration file at path listing every key with its default
+// value and a comment from configMeta. Parent directories are created; an existing file is replaced.
+func WriteSampleConfig(path string) error {
+	content, err := buildSampleConfig(domain.DefaultConfig())
+	if err != nil {
+		return domain.NewConfigError(path, "failed to build sample configuration", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil { // #nosec G301 -- standard directory perms (rwxr-xr-x)
+		return domain.NewConfigError(path, "failed to create directory "+filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil { // #nosec G306 -- config file is not sensitive
+		return domain.NewConfigError(path, "failed to write sample config file", err)
+	}
+	return nil
+}
+
+// buildSampleConfig renders defaults as commented TOML. Home-relative paths are written
+// with ~ so the sample does not depend on the machine it was generated on.
+func buildSampleConfig(defaults *domain.Config) (string, error) {
+	var b strings.Builder
+	b.WriteString("# twiggit configuration\n")
+	b.WriteString("# Generated by 'twiggit config init --sample'. Every key is set to its default;\n")
+	b.WriteString("# delete the ones you do not change.\n")
+
+	home, _ := os.UserHomeDir()
+	if err := writeSampleTable(&b, "", reflect.ValueOf(*defaults), home); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// writeSampleTable writes the scalar keys of a struct, then each nested struct as a [table];
+// TOML requires a table's own keys before its sub-tables
+func writeSampleTable(b *strings.Builder, prefix string, v reflect.Value, home string) error {
+	t := v.Type()
+	for i := range t.NumField() {
+		key, ok := sampleConfigKey(t.Field(i))
+		if !ok || v.Field(i).Kind() == reflect.Struct || v.Field(i).Kind() == reflect.Map {
+			continue
+		}
+		fullKey := prefix + key
+
+		value, err := sampleConfigValue(v.Field(i), home)
+		if err != nil {
+			return fmt.Errorf("%s: %w", fullKey, err)
+		}
+		b.WriteString("\n")
+		if err := writeSampleComment(b, fullKey); err != nil {
+			return err
+		}
+		fmt.Fprintf(b, "%s = %s\n", key, value)
+	}
+
+	for i := range t.NumField() {
+		key, ok := sampleConfigKey(t.Field(i))
+		if !ok || v.Field(i).Kind() != reflect.Struct {
+			continue
+		}
+		fullKey := prefix + key
+
+		b.WriteString("\n")
+		if err := writeSampleComment(b, fullKey); err != nil {
+			return err
+		}
+		fmt.Fprintf(b, "[%s]\n", fullKey)
+		if err := writeSampleTable(b, fullKey+".", v.Field(i), home); err != nil {
+			return err
+		}
+	}
+
+	// Maps of tables have no defaults; only their description and that of their keys is written
+	for i := range t.NumField() {
+		key, ok := sampleConfigKey(t.Field(i))
+		if !ok || v.Field(i).Kind() != reflect.Map {
+			continue
+		}
+		fullKey := prefix + key
+
+		b.WriteString("\n")
+		if err := writeSampleComment(b, fullKey); err != nil {
+			return err
+		}
+		elem := t.Field(i).Type.Elem()
+		for j := range elem.NumField() {
+			fieldKey, ok := sampleConfigKey(elem.Field(j))
+			if !ok {
+				continue
+			}
+			comment, ok := configMeta[fullKey+".*."+fieldKey]
+			if !ok {
+				return fmt.Errorf("configuration key %s.*.%s has no description in configMeta", fullKey, fieldKey)
+			}
+			fmt.Fprintf(b, "#   %s: %s\n", fieldKey, comment)
+		}
+	}
+	return nil
+}
+
+// sampleConfigKey returns the TOML key of a struct field, false for untagged fields
+func sampleConfigKey(field reflect.StructField) (string, bool) {
+	key, _, _ := strings.Cut(field.Tag.Get("toml"), ",")
+	return key, key != "" && key != "-"
+}
+
+// writeSampleComment writes the configMeta entry of key as # lines
+func writeSampleComment(b *strings.Builder, key string) error {
+	comment, ok := configMeta[key]
+	if !ok {
+		return fmt.Errorf("configuration key %s has no description in configMeta", key)
+	}
+	for _, line := range strings.Split(comment, "\n") {
+		b.WriteString("# " + line + "\n")
+	}
+	return nil
+}
+
+// sampleConfigValue renders a default value as a TOML literal
+func sampleConfigValue(v reflect.Value, home string) (string, error) {
+	if d, ok := v.Interface().(time.Duration); ok {
+		return strconv.Quote(d.String()), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return strconv.Quote(tildePath(v.String(), home)), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Slice:
+		items := make([]string, v.Len())
+		for i := range v.Len() {
+			item, err := sampleConfigValue(v.Index(i), home)
+			if err != nil {
+				return "", err
+			}
+			items[i] = item
+		}
+		return "[" + strings.Join(items, ", ") + "]", nil
+	default:
+		return "", fmt.Errorf("unsupported type %s", v.Type())
+	}
+}
+
+// tildePath writes a path under home with ~, so generated files do not depend on the machine
+func tildePath(s, home string) string {
+	if home != "" && (s == home || strings.HasPrefix(s, home+string(filepath.Separator))) {
+		return "~" + filepath.ToSlash(strings.TrimPrefix(s, home))
+	}
+	return s
+}
@@ -0,0 +1,78 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+// configKeys lists the dotted TOML path of every field and table of a config struct; the
+// fields of map values are listed under <map>.*.
+func configKeys(prefix string, t reflect.Type) []string {
+	var keys []string
+	for i := range t.NumField() {
+		key, ok := sampleConfigKey(t.Field(i))
+		if !ok {
+			continue
+		}
+		keys = append(keys, prefix+key)
+		switch field := t.Field(i).Type; field.Kind() {
+		case reflect.Struct:
+			keys = append(keys, configKeys(prefix+key+".", field)...)
+		case reflect.Map:
+			keys = append(keys, configKeys(prefix+key+".*.", field.Elem())...)
+		}
+	}
+	return keys
+}
+
+func TestConfigMeta_DocumentsEveryKey(t *testing.T) {
+	keys := configKeys("", reflect.TypeOf(domain.Config{}))
+	for _, key := range keys {
+		assert.NotEmpty(t, configMeta[key], "configMeta has no description for %s", key)
+	}
+	for key := range configMeta {
+		assert.Contains(t, keys, key, "configMeta documents %s, which is not a configuration key", key)
+	}
+}
+
+func TestWriteSampleConfig_LoadsAsDefaults(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	path := filepath.Join(configHome, "twiggit", "config.toml")
+
+	require.NoError(t, WriteSampleConfig(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	sample := string(data)
+	assert.Contains(t, sample, "# Timeout for git commands, in seconds\ncli_timeout = 30\n")
+	assert.Contains(t, sample, "\n[shell.wrapper]\n")
+	assert.Contains(t, sample, `projects_dir = "~/Projects"`)
+	assert.Less(t, strings.Index(sample, "hook_timeout ="), strings.Index(sample, "[shell.wrapper]"), "table keys come before sub-tables")
+
+	loaded, err := NewConfigManager().Load()
+	require.NoError(t, err, "the sample must be valid TOML accepted by ConfigManager.Load")
+
+	expected := domain.DefaultConfig()
+	normalizeConfigPaths(expected)
+	assert.Equal(t, expected, loaded)
+}
+
+func TestWriteSampleConfig_ReplacesExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte("projects_dir = \"/old\"\n"), 0644))
+
+	require.NoError(t, WriteSampleConfig(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "/old")
+}
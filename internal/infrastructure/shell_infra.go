@@ -205,6 +205,8 @@ type shellTemplateConfig struct {
 	andOperator   string
 	funcDef       string
 	funcEnd       string
+	sessionCmd    string // Runs the twiggit binary with TWIGGIT_SESSION_ID set to the shell's PID
+	exportCdEnv   string // Exports TWIGGIT_CD_ON_CREATE=1 for the rest of the function
 }
 
 // getShellTemplateConfig returns shell-specific template configuration
@@ -224,6 +226,8 @@ func getShellTemplateConfig(shellType domain.ShellType) shellTemplateConfig {
 			andOperator:   "]] || [[",
 			funcDef:       "twiggit() {",
 			funcEnd:       "}",
+			sessionCmd:    "TWIGGIT_SESSION_ID=$$ command twiggit",
+			exportCdEnv:   "local -x TWIGGIT_CD_ON_CREATE=1",
 		}
 	case domain.ShellFish:
 		return shellTemplateConfig{
@@ -239,6 +243,8 @@ func getShellTemplateConfig(shellType domain.ShellType) shellTemplateConfig {
 			andOperator:   "or",
 			funcDef:       "function twiggit",
 			funcEnd:       "end",
+			sessionCmd:    "env TWIGGIT_SESSION_ID=$fish_pid twiggit",
+			exportCdEnv:   "set -lx TWIGGIT_CD_ON_CREATE 1",
 		}
 	default:
 		return shellTemplateConfig{
@@ -254,6 +260,8 @@ func getShellTemplateConfig(shellType domain.ShellType) shellTemplateConfig {
 			andOperator:   "]] || [[",
 			funcDef:       "twiggit() {",
 			funcEnd:       "}",
+			sessionCmd:    "TWIGGIT_SESSION_ID=$$ command twiggit",
+			exportCdEnv:   "local -x TWIGGIT_CD_ON_CREATE=1",
 		}
 	}
 }
@@ -266,22 +274,31 @@ func (s *shellInfrastructure) wrapperTemplate(shellType domain.ShellType) string
 # Twiggit ` + string(shellType) + ` wrapper - Generated on {{TIMESTAMP}}
 ` + config.funcDef + `
 ` + config.caseBegin + `
-    cd)
-        # Handle cd command with directory change
+    cd|switch|back)
+        # Handle cd, switch and back commands with directory change
         target_dir=$(command twiggit ` + config.argsVar + `)
         if [ $? -eq 0 ] && [ -n "$target_dir" ]; then
             builtin cd "$target_dir"
         fi
         ` + config.elif + `
     create)
-        # Handle create command with -C flag
-	` + config.ifSyntax + ` " ` + config.argsVar + ` " == *" -C "* ` + config.andOperator + ` " ` + config.argsVar + ` " == *" --cd "* ` + config.thenSyntax + `
+        # Handle create command: --ephemeral evals the cleanup trap, --no-cd stays put,
+        # otherwise change into the path twiggit prints when cd_on_create is enabled
+        ` + config.exportCdEnv + `
+	` + config.ifSyntax + ` " ` + config.argsVar + ` " == *" --ephemeral "* ` + config.thenSyntax + `
+			ephemeral_setup=$(` + config.sessionCmd + ` ` + config.argsVar + `)
+			if [ $? -eq 0 ] && [ -n "$ephemeral_setup" ]; then
+				eval "$ephemeral_setup"
+			fi
+		` + config.elseSyntax + `
+	` + config.ifSyntax + ` " ` + config.argsVar + ` " == *" --no-cd "* ` + config.thenSyntax + `
+			command twiggit ` + config.argsVar + `
+		` + config.elseSyntax + `
 			target_dir=$(command twiggit ` + config.argsVar + `)
 			if [ $? -eq 0 ] && [ -n "$target_dir" ]; then
 				builtin cd "$target_dir"
 			fi
-		` + config.elseSyntax + `
-			command twiggit ` + config.argsVar + `
+		` + config.fiSyntax + `
 		` + config.fiSyntax + `
 		` + config.elif + `
 	delete)
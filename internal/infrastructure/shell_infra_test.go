@@ -136,6 +136,38 @@ func TestShellInfrastructure_GenerateWrapper_FishSyntaxValidation(t *testing.T)
 	assert.Positive(t, indentCount, "fish wrapper should contain properly indented if statements")
 }
 
+func TestShellInfrastructure_GenerateWrapper_EphemeralSession(t *testing.T) {
+	service := NewShellInfrastructure()
+
+	bashWrapper, err := service.GenerateWrapper(domain.ShellBash)
+	require.NoError(t, err)
+	assert.Contains(t, bashWrapper, `*" --ephemeral "*`)
+	assert.Contains(t, bashWrapper, `TWIGGIT_SESSION_ID=$$ command twiggit "$@"`)
+	assert.Contains(t, bashWrapper, `eval "$ephemeral_setup"`)
+
+	fishWrapper, err := service.GenerateWrapper(domain.ShellFish)
+	require.NoError(t, err)
+	assert.Contains(t, fishWrapper, "env TWIGGIT_SESSION_ID=$fish_pid twiggit $argv")
+}
+
+func TestShellInfrastructure_GenerateWrapper_CdOnCreate(t *testing.T) {
+	service := NewShellInfrastructure()
+
+	bashWrapper, err := service.GenerateWrapper(domain.ShellBash)
+	require.NoError(t, err)
+	assert.Contains(t, bashWrapper, "local -x TWIGGIT_CD_ON_CREATE=1")
+	assert.Contains(t, bashWrapper, `*" --no-cd "*`)
+
+	zshWrapper, err := service.GenerateWrapper(domain.ShellZsh)
+	require.NoError(t, err)
+	assert.Contains(t, zshWrapper, "local -x TWIGGIT_CD_ON_CREATE=1")
+
+	fishWrapper, err := service.GenerateWrapper(domain.ShellFish)
+	require.NoError(t, err)
+	assert.Contains(t, fishWrapper, "set -lx TWIGGIT_CD_ON_CREATE 1")
+	assert.Contains(t, fishWrapper, `*" --no-cd "*`)
+}
+
 func TestShellInfrastructure_DetectConfigFile(t *testing.T) {
 	tests := []struct {
 		name        string
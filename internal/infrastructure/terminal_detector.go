@@ -0,0 +1,62 @@
+package infrastructure
+
+import (
+	"os"
+	"runtime"
+
+	"golang.org/x/term"
+
+	"twiggit/internal/application"
+)
+
+var _ application.TerminalDetector = (*terminalDetector)(nil)
+
+// ciEnvVars are set by CI services; any of them with a value other than false or 0 means CI
+var ciEnvVars = []string{"CI", "GITHUB_ACTIONS", "TRAVIS", "CIRCLECI"}
+
+type terminalDetector struct{}
+
+// NewTerminalDetector creates a TerminalDetector reading the process environment
+func NewTerminalDetector() application.TerminalDetector {
+	return &terminalDetector{}
+}
+
+// IsTerminal reports whether fd is a terminal
+func (d *terminalDetector) IsTerminal(fd uintptr) bool {
+	return term.IsTerminal(int(fd)) // #nosec G115 -- file descriptors fit in an int
+}
+
+// SupportsColor follows https://no-color.org: a non-empty NO_COLOR disables color. Otherwise
+// COLORTERM or a TERM other than dumb enables it. Windows consoles do not set TERM.
+func (d *terminalDetector) SupportsColor() bool {
+	if os.Getenv("NO_COLOR") != "" || d.IsCI() {
+		return false
+	}
+	if os.Getenv("COLORTERM") != "" {
+		return true
+	}
+	switch os.Getenv("TERM") {
+	case "dumb":
+		return false
+	case "":
+		return runtime.GOOS == "windows"
+	}
+	return true
+}
+
+// GetSize returns the size of the terminal on stdout; it fails when stdout is not a terminal
+func (d *terminalDetector) GetSize() (int, int, error) {
+	return term.GetSize(int(os.Stdout.Fd())) // #nosec G115 -- file descriptors fit in an int
+}
+
+// IsCI reports whether one of ciEnvVars is set
+func (d *terminalDetector) IsCI() bool {
+	for _, name := range ciEnvVars {
+		switch os.Getenv(name) {
+		case "", "false", "0":
+			continue
+		}
+		return true
+	}
+	return false
+}
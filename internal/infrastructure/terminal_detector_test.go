@@ -0,0 +1,78 @@
+package infrastructure
+
+import (
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// clearTerminalEnv unsets every variable the detector reads
+func clearTerminalEnv(t *testing.T) {
+	t.Helper()
+	for _, name := range append([]string{"NO_COLOR", "TERM", "COLORTERM"}, ciEnvVars...) {
+		t.Setenv(name, "")
+	}
+}
+
+func TestTerminalDetector_IsCI(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected bool
+	}{
+		{name: "no CI variables", expected: false},
+		{name: "CI", env: map[string]string{"CI": "true"}, expected: true},
+		{name: "GitHub Actions", env: map[string]string{"GITHUB_ACTIONS": "true"}, expected: true},
+		{name: "Travis", env: map[string]string{"TRAVIS": "true"}, expected: true},
+		{name: "CircleCI", env: map[string]string{"CIRCLECI": "1"}, expected: true},
+		{name: "CI disabled", env: map[string]string{"CI": "false"}, expected: false},
+		{name: "CI zero", env: map[string]string{"CI": "0"}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearTerminalEnv(t)
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			assert.Equal(t, tt.expected, NewTerminalDetector().IsCI())
+		})
+	}
+}
+
+func TestTerminalDetector_SupportsColor(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected bool
+	}{
+		{name: "color terminal", env: map[string]string{"TERM": "xterm-256color"}, expected: true},
+		{name: "COLORTERM without TERM", env: map[string]string{"COLORTERM": "truecolor"}, expected: true},
+		{name: "dumb terminal", env: map[string]string{"TERM": "dumb"}, expected: false},
+		{name: "NO_COLOR", env: map[string]string{"TERM": "xterm-256color", "NO_COLOR": "1"}, expected: false},
+		{name: "empty NO_COLOR is ignored", env: map[string]string{"TERM": "xterm", "NO_COLOR": ""}, expected: true},
+		{name: "CI", env: map[string]string{"TERM": "xterm-256color", "COLORTERM": "truecolor", "CI": "true"}, expected: false},
+		{name: "no TERM", expected: runtime.GOOS == "windows"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearTerminalEnv(t)
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			assert.Equal(t, tt.expected, NewTerminalDetector().SupportsColor())
+		})
+	}
+}
+
+func TestTerminalDetector_IsTerminal(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "out")
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+
+	assert.False(t, NewTerminalDetector().IsTerminal(f.Fd()), "a regular file is not a terminal")
+}
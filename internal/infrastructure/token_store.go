@@ -0,0 +1,221 @@
+package infrastructure
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/nacl/secretbox"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.TokenStore = (*tokenStore)(nil)
+
+// TokensFileName is the file holding stored API tokens, next to config.toml
+const TokensFileName = "tokens.json"
+
+// secretboxNonceSize is the nonce length secretbox requires
+const secretboxNonceSize = 24
+
+// tokensFile is the on-disk format of the tokens file
+type tokensFile struct {
+	Tokens []tokenRecord `json:"tokens"`
+}
+
+// tokenRecord is a single stored token; Token is base64(nonce || secretbox)
+type tokenRecord struct {
+	Host     string    `json:"host"`
+	Username string    `json:"username"`
+	Token    string    `json:"token"`
+	AddedAt  time.Time `json:"added_at"`
+}
+
+// DefaultTokenStorePath returns the tokens file next to the configuration file
+// ($XDG_CONFIG_HOME/twiggit/tokens.json, defaulting to ~/.config/twiggit/tokens.json)
+func DefaultTokenStorePath() string {
+	home, _ := os.UserHomeDir()
+	return filepath.Join(filepath.Dir(resolveConfigPath(os.Getenv("XDG_CONFIG_HOME"), home)), TokensFileName)
+}
+
+type tokenStore struct {
+	path string
+	key  [32]byte
+	mu   sync.Mutex
+}
+
+// NewTokenStore creates a TokenStore backed by the JSON file at path (mode 0600).
+// Tokens are sealed with secretbox using a key derived from $USER and the hostname;
+// this keeps them out of plain sight in the file, not away from the same user on the same machine.
+func NewTokenStore(path string) application.TokenStore {
+	hostname, _ := os.Hostname()
+	return &tokenStore{path: path, key: deriveTokenKey(os.Getenv("USER"), hostname)}
+}
+
+// deriveTokenKey derives the secretbox key from the user and machine names
+func deriveTokenKey(user, hostname string) [32]byte {
+	return sha256.Sum256([]byte(user + hostname))
+}
+
+// Save stores the token, replacing any token already stored for its host
+func (s *tokenStore) Save(token domain.StoredToken) error {
+	if token.Host == "" {
+		return domain.NewValidationError("SaveToken", "host", "", "host cannot be empty")
+	}
+	if token.Token == "" {
+		return domain.NewValidationError("SaveToken", "token", "", "token cannot be empty")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := s.readFile()
+	if err != nil {
+		return err
+	}
+
+	sealed, err := s.seal(token.Token)
+	if err != nil {
+		return err
+	}
+	record := tokenRecord{Host: token.Host, Username: token.Username, Token: sealed, AddedAt: token.AddedAt}
+
+	file.Tokens = slices.DeleteFunc(file.Tokens, func(r tokenRecord) bool { return r.Host == token.Host })
+	file.Tokens = append(file.Tokens, record)
+	slices.SortFunc(file.Tokens, func(a, b tokenRecord) int { return strings.Compare(a.Host, b.Host) })
+	return s.writeFile(file)
+}
+
+// Get returns the token stored for host, or nil when there is none
+func (s *tokenStore) Get(host string) (*domain.StoredToken, error) {
+	tokens, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	for i := range tokens {
+		if tokens[i].Host == host {
+			return &tokens[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// List returns every stored token, ordered by host
+func (s *tokenStore) List() ([]domain.StoredToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := s.readFile()
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]domain.StoredToken, 0, len(file.Tokens))
+	for _, record := range file.Tokens {
+		token, err := s.open(record.Token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt token for %s: %w", record.Host, err)
+		}
+		tokens = append(tokens, domain.StoredToken{
+			Host:     record.Host,
+			Username: record.Username,
+			Token:    token,
+			AddedAt:  record.AddedAt,
+		})
+	}
+	return tokens, nil
+}
+
+// Remove deletes the token stored for host
+func (s *tokenStore) Remove(host string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := s.readFile()
+	if err != nil {
+		return err
+	}
+
+	remaining := slices.DeleteFunc(slices.Clone(file.Tokens), func(r tokenRecord) bool { return r.Host == host })
+	if len(remaining) == len(file.Tokens) {
+		return domain.NewValidationError("RemoveToken", "host", host, "no token stored for this host")
+	}
+	file.Tokens = remaining
+	return s.writeFile(file)
+}
+
+// seal encrypts the token and encodes nonce and ciphertext as base64
+func (s *tokenStore) seal(token string) (string, error) {
+	var nonce [secretboxNonceSize]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := secretbox.Seal(nonce[:], []byte(token), &nonce, &s.key)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// open decodes and decrypts a token sealed by seal
+func (s *tokenStore) open(encoded string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("invalid encoding: %w", err)
+	}
+	if len(sealed) < secretboxNonceSize {
+		return "", errors.New("sealed token is too short")
+	}
+
+	var nonce [secretboxNonceSize]byte
+	copy(nonce[:], sealed[:secretboxNonceSize])
+	token, ok := secretbox.Open(nil, sealed[secretboxNonceSize:], &nonce, &s.key)
+	if !ok {
+		return "", errors.New("token was stored by another user or machine")
+	}
+	return string(token), nil
+}
+
+// readFile reads the tokens file; a missing file holds no tokens
+func (s *tokenStore) readFile() (*tokensFile, error) {
+	data, err := os.ReadFile(s.path) // #nosec G304 -- path is the configured tokens file
+	if errors.Is(err, os.ErrNotExist) {
+		return &tokensFile{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", s.path, err)
+	}
+
+	var file tokensFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", s.path, err)
+	}
+	return &file, nil
+}
+
+// writeFile writes the tokens file with owner-only permissions
+func (s *tokenStore) writeFile(file *tokensFile) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(s.path), err)
+	}
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode tokens: %w", err)
+	}
+	if err := os.WriteFile(s.path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", s.path, err)
+	}
+	// WriteFile keeps the mode of an existing file
+	if err := os.Chmod(s.path, 0600); err != nil {
+		return fmt.Errorf("failed to restrict permissions of %s: %w", s.path, err)
+	}
+	return nil
+}
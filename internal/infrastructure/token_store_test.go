@@ -0,0 +1,86 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+func TestTokenStore_SaveListRemove(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "twiggit", TokensFileName)
+	store := NewTokenStore(path)
+	addedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tokens, err := store.List()
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	require.NoError(t, store.Save(domain.StoredToken{Host: domain.AuthHostGitLab, Username: "jane", Token: "glpat-secret-one", AddedAt: addedAt}))
+	require.NoError(t, store.Save(domain.StoredToken{Host: domain.AuthHostGitHub, Username: "octo", Token: "ghp_secret_one", AddedAt: addedAt}))
+	require.NoError(t, store.Save(domain.StoredToken{Host: domain.AuthHostGitHub, Username: "octocat", Token: "ghp_secret_two", AddedAt: addedAt}))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "ghp_secret")
+	assert.NotContains(t, string(data), "glpat-secret")
+
+	tokens, err = store.List()
+	require.NoError(t, err)
+	assert.Equal(t, []domain.StoredToken{
+		{Host: domain.AuthHostGitHub, Username: "octocat", Token: "ghp_secret_two", AddedAt: addedAt},
+		{Host: domain.AuthHostGitLab, Username: "jane", Token: "glpat-secret-one", AddedAt: addedAt},
+	}, tokens)
+
+	token, err := store.Get(domain.AuthHostGitLab)
+	require.NoError(t, err)
+	require.NotNil(t, token)
+	assert.Equal(t, "glpat-secret-one", token.Token)
+
+	require.NoError(t, store.Remove(domain.AuthHostGitLab))
+	token, err = store.Get(domain.AuthHostGitLab)
+	require.NoError(t, err)
+	assert.Nil(t, token)
+
+	err = store.Remove(domain.AuthHostGitLab)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no token stored for this host")
+}
+
+func TestTokenStore_TightensExistingPermissions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), TokensFileName)
+	require.NoError(t, os.WriteFile(path, []byte(`{"tokens":[]}`), 0644))
+
+	require.NoError(t, NewTokenStore(path).Save(domain.StoredToken{Host: domain.AuthHostGitHub, Token: "ghp_x"}))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestTokenStore_WrongKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), TokensFileName)
+	owner := &tokenStore{path: path, key: deriveTokenKey("alice", "laptop")}
+	require.NoError(t, owner.Save(domain.StoredToken{Host: domain.AuthHostGitHub, Token: "ghp_x"}))
+
+	other := &tokenStore{path: path, key: deriveTokenKey("bob", "laptop")}
+	_, err := other.List()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "stored by another user or machine")
+}
+
+func TestTokenStore_Validation(t *testing.T) {
+	store := NewTokenStore(filepath.Join(t.TempDir(), TokensFileName))
+
+	require.Error(t, store.Save(domain.StoredToken{Token: "ghp_x"}))
+	require.Error(t, store.Save(domain.StoredToken{Host: domain.AuthHostGitHub}))
+}
@@ -0,0 +1,97 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.TokenValidator = (*tokenValidator)(nil)
+
+// DefaultTokenValidationTimeout bounds the /user request
+const DefaultTokenValidationTimeout = 10 * time.Second
+
+// defaultAPIBaseURLs maps each supported host to its REST API root
+var defaultAPIBaseURLs = map[string]string{
+	domain.AuthHostGitHub: "https://api.github.com",
+	domain.AuthHostGitLab: "https://gitlab.com/api/v4",
+}
+
+// apiUser holds the identity fields of GitHub (login) and GitLab (username) /user responses
+type apiUser struct {
+	Login    string `json:"login"`
+	Username string `json:"username"`
+}
+
+type tokenValidator struct {
+	client   *http.Client
+	baseURLs map[string]string
+}
+
+// NewTokenValidator creates a TokenValidator calling the GitHub and GitLab REST APIs.
+// An optional timeout overrides DefaultTokenValidationTimeout.
+func NewTokenValidator(timeout ...time.Duration) application.TokenValidator {
+	t := DefaultTokenValidationTimeout
+	if len(timeout) > 0 {
+		t = timeout[0]
+	}
+	return &tokenValidator{client: &http.Client{Timeout: t}, baseURLs: defaultAPIBaseURLs}
+}
+
+// Validate calls the host's /user endpoint and returns the username the token authenticates as
+func (v *tokenValidator) Validate(ctx context.Context, host, token string) (string, error) {
+	baseURL, ok := v.baseURLs[host]
+	if !ok {
+		return "", domain.NewValidationError("ValidateToken", "host", host, "unsupported host; use github or gitlab")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/user", nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build request for %s: %w", host, err)
+	}
+	setAPIAuthHeaders(req, host, token)
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to reach %s: %w", host, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return "", domain.NewValidationError("ValidateToken", "token", domain.MaskToken(token), fmt.Sprintf("%s rejected the token (%s)", host, resp.Status))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected response from %s: %s", host, resp.Status)
+	}
+
+	var user apiUser
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return "", fmt.Errorf("failed to parse %s user response: %w", host, err)
+	}
+	if user.Login != "" {
+		return user.Login, nil
+	}
+	if user.Username != "" {
+		return user.Username, nil
+	}
+	return "", fmt.Errorf("%s user response has no username", host)
+}
+
+// setAPIAuthHeaders authenticates req with token the way host expects; an empty token sends no credentials
+func setAPIAuthHeaders(req *http.Request, host, token string) {
+	if host == domain.AuthHostGitLab {
+		if token != "" {
+			req.Header.Set("PRIVATE-TOKEN", token)
+		}
+		return
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+}
@@ -0,0 +1,76 @@
+package infrastructure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+func newTestTokenValidator(t *testing.T, handler http.HandlerFunc) *tokenValidator {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return &tokenValidator{
+		client: &http.Client{Timeout: time.Second},
+		baseURLs: map[string]string{
+			domain.AuthHostGitHub: server.URL,
+			domain.AuthHostGitLab: server.URL + "/api/v4",
+		},
+	}
+}
+
+func TestTokenValidator_Validate(t *testing.T) {
+	validator := newTestTokenValidator(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/user":
+			if r.Header.Get("Authorization") != "Bearer ghp_valid" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"login":"octocat","id":1}`))
+		case "/api/v4/user":
+			if r.Header.Get("PRIVATE-TOKEN") != "glpat-valid" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"username":"jane","id":2}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	username, err := validator.Validate(context.Background(), domain.AuthHostGitHub, "ghp_valid")
+	require.NoError(t, err)
+	assert.Equal(t, "octocat", username)
+
+	username, err = validator.Validate(context.Background(), domain.AuthHostGitLab, "glpat-valid")
+	require.NoError(t, err)
+	assert.Equal(t, "jane", username)
+
+	_, err = validator.Validate(context.Background(), domain.AuthHostGitHub, "ghp_revoked_token_value")
+	var validationErr *domain.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Contains(t, err.Error(), "rejected the token")
+	assert.NotContains(t, err.Error(), "ghp_revoked_token_value", "the full token is never reported")
+
+	_, err = validator.Validate(context.Background(), "bitbucket.org", "x")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported host")
+}
+
+func TestTokenValidator_UnexpectedResponse(t *testing.T) {
+	validator := newTestTokenValidator(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, err := validator.Validate(context.Background(), domain.AuthHostGitHub, "ghp_valid")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unexpected response from github.com")
+}
@@ -0,0 +1,156 @@
+package infrastructure
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.WorktreeArchiver = (*worktreeArchiver)(nil)
+
+// archiveModTime is the modification time of every archive entry, so that exporting the
+// same files twice gives byte-identical archives
+var archiveModTime = time.Unix(0, 0)
+
+type worktreeArchiver struct{}
+
+// NewWorktreeArchiver creates a WorktreeArchiver
+func NewWorktreeArchiver() application.WorktreeArchiver {
+	return &worktreeArchiver{}
+}
+
+// Archive writes the manifest and then the worktree's entries in lexical order. Entries
+// carry no owner and a fixed modification time; files keep only their executable bit.
+func (a *worktreeArchiver) Archive(w io.Writer, worktreePath string, manifest *domain.ExportManifest) (*domain.ExportResult, error) {
+	info, err := os.Stat(worktreePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read worktree %s: %w", worktreePath, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("worktree %s is not a directory", worktreePath)
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	if err := writeArchiveManifest(tw, manifest); err != nil {
+		return nil, err
+	}
+
+	result := &domain.ExportResult{}
+	fsys := os.DirFS(worktreePath)
+	err = fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if path == "." {
+			return nil
+		}
+		if d.Name() == ".git" {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		// The manifest written above takes this name at the root
+		if path == domain.ExportManifestName {
+			return nil
+		}
+		return writeArchiveEntry(tw, fsys, worktreePath, path, d, result)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive %s: %w", worktreePath, err)
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish archive: %w", err)
+	}
+	return result, nil
+}
+
+// writeArchiveManifest writes the manifest as indented JSON at the archive root
+func writeArchiveManifest(tw *tar.Writer, manifest *domain.ExportManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	data = append(data, '\n')
+
+	header := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     domain.ExportManifestName,
+		Mode:     0644,
+		Size:     int64(len(data)),
+		ModTime:  archiveModTime,
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
+
+// writeArchiveEntry writes one directory, regular file or symlink; other file types are skipped
+func writeArchiveEntry(tw *tar.Writer, fsys fs.FS, root, path string, d fs.DirEntry, result *domain.ExportResult) error {
+	header := &tar.Header{Name: path, ModTime: archiveModTime}
+
+	switch {
+	case d.IsDir():
+		header.Typeflag = tar.TypeDir
+		header.Name += "/"
+		header.Mode = 0755
+		return tw.WriteHeader(header)
+
+	case d.Type()&fs.ModeSymlink != 0:
+		target, err := os.Readlink(filepath.Join(root, filepath.FromSlash(path)))
+		if err != nil {
+			return err
+		}
+		header.Typeflag = tar.TypeSymlink
+		header.Linkname = filepath.ToSlash(target)
+		header.Mode = 0777
+		result.Symlinks++
+		return tw.WriteHeader(header)
+
+	case d.Type().IsRegular():
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		header.Typeflag = tar.TypeReg
+		header.Size = info.Size()
+		header.Mode = 0644
+		if info.Mode().Perm()&0111 != 0 {
+			header.Mode = 0755
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		file, err := fsys.Open(path)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = file.Close() }()
+		if _, err := io.CopyN(tw, file, header.Size); err != nil {
+			return fmt.Errorf("%s changed while archiving: %w", path, err)
+		}
+		result.Files++
+		return nil
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package infrastructure
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+// setupArchiveWorktree creates a worktree with a nested file, a script, a symlink and git metadata
+func setupArchiveWorktree(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "src", "lib"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "README.md"), []byte("readme\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "src", "lib", "util.go"), []byte("package lib\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "build.sh"), []byte("#!/bin/sh\n"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".git"), []byte("gitdir: /repo/.git/worktrees/feature\n"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "vendor", "dep", ".git"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "vendor", "dep", ".git", "HEAD"), []byte("ref: refs/heads/main\n"), 0644))
+	if runtime.GOOS != "windows" {
+		require.NoError(t, os.Symlink("README.md", filepath.Join(root, "LINK.md")))
+	}
+	return root
+}
+
+// readArchive returns the archive's headers and regular file contents by name
+func readArchive(t *testing.T, data []byte) ([]*tar.Header, map[string]string) {
+	t.Helper()
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	var headers []*tar.Header
+	contents := make(map[string]string)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		headers = append(headers, header)
+		if header.Typeflag == tar.TypeReg {
+			content, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			contents[header.Name] = string(content)
+		}
+	}
+	return headers, contents
+}
+
+func TestWorktreeArchiver_Archive(t *testing.T) {
+	root := setupArchiveWorktree(t)
+	manifest := &domain.ExportManifest{Project: "proj", Branch: "feature", Commit: "abc123"}
+
+	var buf bytes.Buffer
+	result, err := NewWorktreeArchiver().Archive(&buf, root, manifest)
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Files)
+
+	headers, contents := readArchive(t, buf.Bytes())
+	require.NotEmpty(t, headers)
+	assert.Equal(t, domain.ExportManifestName, headers[0].Name)
+
+	var decoded domain.ExportManifest
+	require.NoError(t, json.Unmarshal([]byte(contents[domain.ExportManifestName]), &decoded))
+	assert.Equal(t, *manifest, decoded)
+
+	assert.Equal(t, "package lib\n", contents["src/lib/util.go"])
+	assert.Contains(t, contents, "README.md")
+	for _, header := range headers {
+		assert.NotContains(t, header.Name, ".git", "git metadata must not be archived")
+		assert.Equal(t, int64(0), header.ModTime.Unix())
+	}
+
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, 1, result.Symlinks)
+		for _, header := range headers {
+			switch header.Name {
+			case "LINK.md":
+				assert.Equal(t, byte(tar.TypeSymlink), header.Typeflag)
+				assert.Equal(t, "README.md", header.Linkname)
+			case "build.sh":
+				assert.Equal(t, int64(0755), header.Mode)
+			case "README.md":
+				assert.Equal(t, int64(0644), header.Mode)
+			}
+		}
+	}
+}
+
+func TestWorktreeArchiver_Deterministic(t *testing.T) {
+	root := setupArchiveWorktree(t)
+	manifest := &domain.ExportManifest{Project: "proj", Branch: "feature", Commit: "abc123"}
+	archiver := NewWorktreeArchiver()
+
+	var first, second bytes.Buffer
+	_, err := archiver.Archive(&first, root, manifest)
+	require.NoError(t, err)
+	touched := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, os.Chtimes(filepath.Join(root, "README.md"), touched, touched))
+	_, err = archiver.Archive(&second, root, manifest)
+	require.NoError(t, err)
+
+	assert.Equal(t, first.Bytes(), second.Bytes())
+}
+
+func TestWorktreeArchiver_MissingWorktree(t *testing.T) {
+	_, err := NewWorktreeArchiver().Archive(io.Discard, filepath.Join(t.TempDir(), "missing"), &domain.ExportManifest{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read worktree")
+}
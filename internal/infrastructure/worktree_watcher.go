@@ -0,0 +1,167 @@
+package infrastructure
+
+import (
+	"context"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.WorktreeWatcher = (*worktreeWatcher)(nil)
+
+// DefaultWorktreeWatchDebounce is how long a project directory must stay quiet before it is rescanned
+const DefaultWorktreeWatchDebounce = 200 * time.Millisecond
+
+type worktreeWatcher struct {
+	debounce time.Duration
+}
+
+// NewWorktreeWatcher creates a WorktreeWatcher. An optional debounce overrides DefaultWorktreeWatchDebounce.
+func NewWorktreeWatcher(debounce ...time.Duration) application.WorktreeWatcher {
+	d := DefaultWorktreeWatchDebounce
+	if len(debounce) > 0 {
+		d = debounce[0]
+	}
+	return &worktreeWatcher{debounce: d}
+}
+
+// WatchWorktrees watches workspacePath, its project directories and their worktree directories
+// (for the .git file git writes after creating one). A change rescans only the project it
+// happened in, with FindWorktreeDirectories, and the difference from the last scan is sent.
+func (w *worktreeWatcher) WatchWorktrees(ctx context.Context, workspacePath string, events chan<- domain.WatchEvent) error {
+	defer close(events)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return domain.NewWorktreeServiceError(workspacePath, "", "WatchWorktrees", "failed to create file watcher", err)
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(workspacePath); err != nil {
+		return domain.NewWorktreeServiceError(workspacePath, "", "WatchWorktrees", "failed to watch workspace", err)
+	}
+
+	entries, err := os.ReadDir(workspacePath)
+	if err != nil {
+		return domain.NewWorktreeServiceError(workspacePath, "", "WatchWorktrees", "failed to read workspace", err)
+	}
+	known := make(map[string]map[string]*domain.WorktreeInfo)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			known[entry.Name()] = watchProject(watcher, filepath.Join(workspacePath, entry.Name()))
+		}
+	}
+
+	timer := time.NewTimer(w.debounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	pending := make(map[string]bool)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if project := affectedProject(workspacePath, event); project != "" {
+				pending[project] = true
+				timer.Reset(w.debounce)
+			}
+		case <-timer.C:
+			for _, project := range slices.Sorted(maps.Keys(pending)) {
+				current := watchProject(watcher, filepath.Join(workspacePath, project))
+				if !sendWorktreeChanges(ctx, events, project, known[project], current) {
+					return nil
+				}
+				known[project] = current
+			}
+			clear(pending)
+		case _, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+		}
+	}
+}
+
+// affectedProject returns the project whose worktrees an event may have changed: a project or
+// worktree directory created, removed or renamed, or a worktree's .git file appearing
+func affectedProject(workspacePath string, event fsnotify.Event) string {
+	if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Remove) && !event.Has(fsnotify.Rename) {
+		return ""
+	}
+	rel, err := filepath.Rel(workspacePath, event.Name)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return ""
+	}
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	switch {
+	case len(parts) <= 2:
+		return parts[0]
+	case len(parts) == 3 && parts[2] == ".git":
+		return parts[0]
+	}
+	return ""
+}
+
+// watchProject scans a project directory for worktrees and watches it and its subdirectories.
+// Best-effort: a directory removed again before it is added is simply missed.
+func watchProject(watcher *fsnotify.Watcher, projectPath string) map[string]*domain.WorktreeInfo {
+	_ = watcher.Add(projectPath)
+	if entries, err := os.ReadDir(projectPath); err == nil {
+		for _, entry := range entries {
+			if entry.IsDir() {
+				_ = watcher.Add(filepath.Join(projectPath, entry.Name()))
+			}
+		}
+	}
+
+	worktrees := make(map[string]*domain.WorktreeInfo)
+	paths, err := FindWorktreeDirectories(projectPath)
+	if err != nil {
+		return worktrees
+	}
+	for _, path := range paths {
+		info := &domain.WorktreeInfo{Path: path}
+		if gitDir := ResolveWorktreeGitDir(path); gitDir != "" {
+			info.Branch = readHeadBranch(gitDir)
+		}
+		worktrees[path] = info
+	}
+	return worktrees
+}
+
+// sendWorktreeChanges sends a removed event for each worktree only in before and a created event
+// for each only in after, in path order. It returns false when ctx ended while sending.
+func sendWorktreeChanges(ctx context.Context, events chan<- domain.WatchEvent, project string, before, after map[string]*domain.WorktreeInfo) bool {
+	var changes []domain.WatchEvent
+	for _, path := range slices.Sorted(maps.Keys(before)) {
+		if _, ok := after[path]; !ok {
+			changes = append(changes, domain.WatchEvent{Type: domain.WatchEventRemoved, Project: project, Worktree: before[path]})
+		}
+	}
+	for _, path := range slices.Sorted(maps.Keys(after)) {
+		if _, ok := before[path]; !ok {
+			changes = append(changes, domain.WatchEvent{Type: domain.WatchEventCreated, Project: project, Worktree: after[path]})
+		}
+	}
+
+	for _, change := range changes {
+		select {
+		case events <- change:
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,126 @@
+package infrastructure
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"twiggit/internal/domain"
+)
+
+// makeLinkedWorktree creates a worktree directory whose .git file points at a git directory on branch
+func makeLinkedWorktree(t *testing.T, path, branch string) {
+	t.Helper()
+	gitDir := filepath.Join(t.TempDir(), "worktrees", filepath.Base(path))
+	require.NoError(t, os.MkdirAll(gitDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(gitDir, "HEAD"), []byte("ref: refs/heads/"+branch+"\n"), 0644))
+	require.NoError(t, os.MkdirAll(path, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(path, ".git"), []byte("gitdir: "+gitDir+"\n"), 0644))
+}
+
+// startWorktreeWatcher runs WatchWorktrees in the background and returns its events channel
+func startWorktreeWatcher(t *testing.T, workspace string) (<-chan domain.WatchEvent, context.CancelFunc) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan domain.WatchEvent, 10)
+	done := make(chan error, 1)
+
+	go func() {
+		done <- NewWorktreeWatcher(50*time.Millisecond).WatchWorktrees(ctx, workspace, events)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Error("WatchWorktrees did not return after cancellation")
+		}
+	})
+
+	// Give the watcher time to register its directories
+	time.Sleep(100 * time.Millisecond)
+	return events, cancel
+}
+
+func waitWatchEvent(t *testing.T, events <-chan domain.WatchEvent) domain.WatchEvent {
+	t.Helper()
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "events channel closed")
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("no watch event")
+		return domain.WatchEvent{}
+	}
+}
+
+func TestWorktreeWatcher_CreatedAndRemoved(t *testing.T) {
+	workspace := t.TempDir()
+	makeLinkedWorktree(t, filepath.Join(workspace, "proj", "existing"), "existing")
+
+	events, _ := startWorktreeWatcher(t, workspace)
+
+	created := filepath.Join(workspace, "proj", "feature")
+	makeLinkedWorktree(t, created, "feature-x")
+	event := waitWatchEvent(t, events)
+	assert.Equal(t, domain.WatchEventCreated, event.Type)
+	assert.Equal(t, "proj", event.Project)
+	assert.Equal(t, created, event.Worktree.Path)
+	assert.Equal(t, "feature-x", event.Worktree.Branch)
+
+	require.NoError(t, os.RemoveAll(created))
+	event = waitWatchEvent(t, events)
+	assert.Equal(t, domain.WatchEventRemoved, event.Type)
+	assert.Equal(t, created, event.Worktree.Path)
+}
+
+func TestWorktreeWatcher_NewProject(t *testing.T) {
+	workspace := t.TempDir()
+	events, _ := startWorktreeWatcher(t, workspace)
+
+	created := filepath.Join(workspace, "other", "main")
+	makeLinkedWorktree(t, created, "main")
+	event := waitWatchEvent(t, events)
+	assert.Equal(t, domain.WatchEventCreated, event.Type)
+	assert.Equal(t, "other", event.Project)
+	assert.Equal(t, created, event.Worktree.Path)
+}
+
+func TestWorktreeWatcher_IgnoresPlainDirectories(t *testing.T) {
+	workspace := t.TempDir()
+	events, _ := startWorktreeWatcher(t, workspace)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(workspace, "proj", "notes"), 0755))
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event %+v", event)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func TestWorktreeWatcher_ClosesEventsOnCancel(t *testing.T) {
+	events, cancel := startWorktreeWatcher(t, t.TempDir())
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("events channel was not closed")
+	}
+}
+
+func TestWorktreeWatcher_MissingWorkspace(t *testing.T) {
+	events := make(chan domain.WatchEvent)
+	err := NewWorktreeWatcher().WatchWorktrees(context.Background(), filepath.Join(t.TempDir(), "missing"), events)
+	require.Error(t, err)
+
+	_, ok := <-events
+	assert.False(t, ok)
+}
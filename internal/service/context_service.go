@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -40,13 +41,23 @@ func (cs *contextService) GetCurrentContext() (*domain.Context, error) {
 	return ctx, nil
 }
 
-// DetectContextFromPath detects context from specified path
-func (cs *contextService) DetectContextFromPath(path string) (*domain.Context, error) {
-	ctx, err := cs.detector.DetectContext(path)
+// GetContextForPath detects context from path without reading or changing the working directory,
+// so it is safe to call from several goroutines
+func (cs *contextService) GetContextForPath(ctx context.Context, path string) (*domain.Context, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	detected, err := cs.detector.DetectContext(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to detect context from path %s: %w", path, err)
 	}
-	return ctx, nil
+	return detected, nil
+}
+
+// DetectContextFromPath detects context from specified path
+func (cs *contextService) DetectContextFromPath(path string) (*domain.Context, error) {
+	return cs.GetContextForPath(context.Background(), path)
 }
 
 // ResolveIdentifier resolves identifier based on current context
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -149,6 +150,67 @@ func TestContextService_DetectContextFromPath(t *testing.T) {
 	}
 }
 
+func TestContextService_GetContextForPath(t *testing.T) {
+	tests := []struct {
+		name            string
+		path            string
+		canceled        bool
+		setupMock       func(*mocks.MockContextDetector)
+		expectedContext *domain.Context
+		expectedError   string
+	}{
+		{
+			name: "detects the given path",
+			path: "/test/path",
+			setupMock: func(detector *mocks.MockContextDetector) {
+				detector.On("DetectContext", "/test/path").Return(fixtures.NewWorktreeContext(), nil)
+			},
+			expectedContext: fixtures.NewWorktreeContext(),
+		},
+		{
+			name: "detection fails",
+			path: "/invalid/path",
+			setupMock: func(detector *mocks.MockContextDetector) {
+				detector.On("DetectContext", "/invalid/path").Return(nil, errors.New("directory does not exist"))
+			},
+			expectedError: "failed to detect context from path /invalid/path",
+		},
+		{
+			name:          "canceled context skips detection",
+			path:          "/test/path",
+			canceled:      true,
+			setupMock:     func(*mocks.MockContextDetector) {},
+			expectedError: context.Canceled.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detector := mocks.NewMockContextDetector()
+			service := NewContextService(detector, mocks.NewMockContextResolver(), fixtures.NewTestConfig())
+			tt.setupMock(detector)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.canceled {
+				cancel()
+			}
+			defer cancel()
+
+			got, err := service.GetContextForPath(ctx, tt.path)
+
+			detector.AssertExpectations(t)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedContext, got)
+		})
+	}
+}
+
 func TestContextService_ResolveIdentifier(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"sync"
 
 	"twiggit/internal/application"
 	"twiggit/internal/domain"
@@ -13,21 +16,32 @@ var _ application.NavigationService = (*navigationService)(nil)
 
 // navigationService implements NavigationService interface
 type navigationService struct {
-	projectService application.ProjectService
-	contextService application.ContextService
-	config         *domain.Config
+	projectService  application.ProjectService
+	contextService  application.ContextService
+	config          *domain.Config
+	projectSettings application.ProjectSettingsStore
+	historyStore    application.NavigationHistoryStore
+
+	mu      sync.Mutex
+	history []string // Visited worktree paths, newest first, at most navigation.history_size
 }
 
-// NewNavigationService creates a new NavigationService instance
+// NewNavigationService creates a new NavigationService instance.
+// projectSettings may be nil, in which case projects always resolve to their root.
+// historyStore may be nil, in which case the history only lasts as long as the service.
 func NewNavigationService(
 	projectService application.ProjectService,
 	contextService application.ContextService,
 	config *domain.Config,
+	projectSettings application.ProjectSettingsStore,
+	historyStore application.NavigationHistoryStore,
 ) application.NavigationService {
 	return &navigationService{
-		projectService: projectService,
-		contextService: contextService,
-		config:         config,
+		projectService:  projectService,
+		contextService:  contextService,
+		config:          config,
+		projectSettings: projectSettings,
+		historyStore:    historyStore,
 	}
 }
 
@@ -125,3 +139,108 @@ func (s *navigationService) filterSuggestionsForProject(suggestions []*domain.Re
 
 	return filtered
 }
+
+// NavigateToProject resolves a project to the worktree of its default_worktree branch, or to the project root
+func (s *navigationService) NavigateToProject(ctx context.Context, projectName string) (*domain.ResolutionResult, error) {
+	project, err := s.projectService.DiscoverProject(ctx, projectName, nil)
+	if err != nil {
+		return nil, domain.NewNavigationServiceError(projectName, "", "NavigateToProject", "project not found", err)
+	}
+
+	root := &domain.ResolutionResult{
+		ResolvedPath: project.Path,
+		Type:         domain.PathTypeProject,
+		ProjectName:  project.Name,
+		Explanation:  fmt.Sprintf("Resolved '%s' to project directory", project.Name),
+	}
+	if s.projectSettings == nil {
+		return root, nil
+	}
+
+	settings, err := s.projectSettings.Load(project.GitRepoPath)
+	if err != nil {
+		root.Warning = fmt.Sprintf("ignoring default_worktree: %v", err)
+		return root, nil
+	}
+	if settings.DefaultWorktree == "" {
+		return root, nil
+	}
+
+	for _, wt := range project.Worktrees {
+		if wt.Branch != settings.DefaultWorktree || wt.IsDetached {
+			continue
+		}
+		if _, err := os.Stat(wt.Path); err != nil {
+			break
+		}
+		return &domain.ResolutionResult{
+			ResolvedPath: wt.Path,
+			Type:         domain.PathTypeWorktree,
+			ProjectName:  project.Name,
+			BranchName:   wt.Branch,
+			Explanation:  fmt.Sprintf("Resolved '%s' to its default worktree '%s'", project.Name, wt.Branch),
+		}, nil
+	}
+
+	root.Warning = fmt.Sprintf("default worktree '%s' of %s no longer exists; using the main branch (see twiggit worktrees unset-default)",
+		settings.DefaultWorktree, project.Name)
+	return root, nil
+}
+
+// RecordVisit adds path at the front of the history and saves it. The stored history is
+// reloaded first so that visits recorded by other shells are kept.
+func (s *navigationService) RecordVisit(path string) error {
+	if path == "" {
+		return domain.NewValidationError("RecordVisit", "path", "", "cannot be empty")
+	}
+	size := s.config.Navigation.HistorySize
+	if size <= 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.reloadHistory()
+	if len(s.history) > 0 && s.history[0] == path {
+		return nil
+	}
+	s.history = append([]string{path}, s.history...)
+	if len(s.history) > size {
+		s.history = s.history[:size]
+	}
+
+	if s.historyStore == nil {
+		return nil
+	}
+	if err := s.historyStore.Save(s.history); err != nil {
+		return domain.NewNavigationServiceError(path, "", "RecordVisit", "failed to save navigation history", err)
+	}
+	return nil
+}
+
+// GetHistory returns the visited worktree paths, newest first. A history that cannot be
+// read is treated as empty.
+func (s *navigationService) GetHistory() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.reloadHistory()
+	return slices.Clone(s.history)
+}
+
+// reloadHistory replaces the in-memory history with the stored one, trimmed to
+// navigation.history_size; it is kept as is when there is no store or it cannot be read
+func (s *navigationService) reloadHistory() {
+	if s.historyStore == nil {
+		return
+	}
+	paths, err := s.historyStore.Load()
+	if err != nil {
+		return
+	}
+	if size := s.config.Navigation.HistorySize; len(paths) > size {
+		paths = paths[:max(size, 0)]
+	}
+	s.history = paths
+}
@@ -68,7 +68,7 @@ func TestNavigationService(t *testing.T) {
 				contextService.AssertExpectations(t)
 			})
 
-			service := NewNavigationService(projectService, contextService, config)
+			service := NewNavigationService(projectService, contextService, config, nil, nil)
 			result, err := service.ResolvePath(context.Background(), tc.request)
 
 			if tc.expectError {
@@ -87,7 +87,7 @@ func TestNavigationService_ValidatePath(t *testing.T) {
 	config := domain.DefaultConfig()
 	projectService := mocks.NewMockProjectService()
 	contextService := mocks.NewMockContextService()
-	service := NewNavigationService(projectService, contextService, config)
+	service := NewNavigationService(projectService, contextService, config, nil, nil)
 
 	tests := []struct {
 		name         string
@@ -175,7 +175,7 @@ func TestNavigationService_GetNavigationSuggestions(t *testing.T) {
 				},
 			}, nil).Maybe()
 
-			service := NewNavigationService(projectService, contextService, config)
+			service := NewNavigationService(projectService, contextService, config, nil, nil)
 			result, err := service.GetNavigationSuggestions(context.Background(), tc.context, tc.partial)
 
 			if tc.expectError {
@@ -188,3 +188,144 @@ func TestNavigationService_GetNavigationSuggestions(t *testing.T) {
 		})
 	}
 }
+
+func TestNavigationService_NavigateToProject(t *testing.T) {
+	worktreePath := t.TempDir()
+	project := &domain.ProjectInfo{
+		Name:        "test-project",
+		Path:        "/projects/test-project",
+		GitRepoPath: "/projects/test-project",
+		Worktrees: []*domain.WorktreeInfo{
+			{Path: "/projects/test-project", Branch: "main"},
+			{Path: worktreePath, Branch: "develop"},
+			{Path: "/worktrees/test-project/gone", Branch: "gone"},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		settings      *domain.ProjectSettings
+		loadErr       error
+		noStore       bool
+		expectPath    string
+		expectType    domain.PathType
+		expectWarning string
+	}{
+		{name: "default worktree", settings: &domain.ProjectSettings{DefaultWorktree: "develop"}, expectPath: worktreePath, expectType: domain.PathTypeWorktree},
+		{name: "no default", settings: &domain.ProjectSettings{}, expectPath: project.Path, expectType: domain.PathTypeProject},
+		{name: "no store", noStore: true, expectPath: project.Path, expectType: domain.PathTypeProject},
+		{
+			name:          "default branch without worktree falls back to main",
+			settings:      &domain.ProjectSettings{DefaultWorktree: "deleted"},
+			expectPath:    project.Path,
+			expectType:    domain.PathTypeProject,
+			expectWarning: "default worktree 'deleted' of test-project no longer exists",
+		},
+		{
+			name:          "default worktree directory removed falls back to main",
+			settings:      &domain.ProjectSettings{DefaultWorktree: "gone"},
+			expectPath:    project.Path,
+			expectType:    domain.PathTypeProject,
+			expectWarning: "default worktree 'gone' of test-project no longer exists; using the main branch",
+		},
+		{
+			name:          "unreadable settings fall back to main",
+			loadErr:       assert.AnError,
+			expectPath:    project.Path,
+			expectType:    domain.PathTypeProject,
+			expectWarning: "ignoring default_worktree",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			projectService := mocks.NewMockProjectService()
+			projectService.On("DiscoverProject", mock.Anything, "test-project", (*domain.Context)(nil)).Return(project, nil)
+
+			var store *mocks.MockProjectSettingsStore
+			service := NewNavigationService(projectService, mocks.NewMockContextService(), domain.DefaultConfig(), nil, nil)
+			if !tc.noStore {
+				store = mocks.NewMockProjectSettingsStore()
+				store.On("Load", project.GitRepoPath).Return(tc.settings, tc.loadErr)
+				service = NewNavigationService(projectService, mocks.NewMockContextService(), domain.DefaultConfig(), store, nil)
+			}
+
+			result, err := service.NavigateToProject(context.Background(), "test-project")
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectPath, result.ResolvedPath)
+			assert.Equal(t, tc.expectType, result.Type)
+			assert.Equal(t, "test-project", result.ProjectName)
+			if tc.expectWarning == "" {
+				assert.Empty(t, result.Warning)
+			} else {
+				assert.Contains(t, result.Warning, tc.expectWarning)
+			}
+		})
+	}
+}
+
+func TestNavigationService_NavigateToProject_UnknownProject(t *testing.T) {
+	projectService := mocks.NewMockProjectService()
+	projectService.On("DiscoverProject", mock.Anything, "missing", (*domain.Context)(nil)).Return(nil, assert.AnError)
+	service := NewNavigationService(projectService, mocks.NewMockContextService(), domain.DefaultConfig(), mocks.NewMockProjectSettingsStore(), nil)
+
+	_, err := service.NavigateToProject(context.Background(), "missing")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "project not found")
+}
+
+func TestNavigationService_RecordVisit(t *testing.T) {
+	config := domain.DefaultConfig()
+	config.Navigation.HistorySize = 3
+	service := NewNavigationService(mocks.NewMockProjectService(), mocks.NewMockContextService(), config, nil, nil)
+
+	for _, path := range []string{"/wt/a", "/wt/b", "/wt/b", "/wt/c", "/wt/d"} {
+		require.NoError(t, service.RecordVisit(path))
+	}
+
+	assert.Equal(t, []string{"/wt/d", "/wt/c", "/wt/b"}, service.GetHistory())
+}
+
+func TestNavigationService_RecordVisit_Store(t *testing.T) {
+	store := mocks.NewMockNavigationHistoryStore()
+	store.On("Load").Return([]string{"/wt/b", "/wt/a"}, nil)
+	store.On("Save", []string{"/wt/c", "/wt/b", "/wt/a"}).Return(nil).Once()
+	service := NewNavigationService(mocks.NewMockProjectService(), mocks.NewMockContextService(), domain.DefaultConfig(), nil, store)
+
+	require.NoError(t, service.RecordVisit("/wt/c"))
+	store.AssertExpectations(t)
+}
+
+func TestNavigationService_RecordVisit_SaveFails(t *testing.T) {
+	store := mocks.NewMockNavigationHistoryStore()
+	store.On("Load").Return(nil, nil)
+	store.On("Save", []string{"/wt/a"}).Return(assert.AnError)
+	service := NewNavigationService(mocks.NewMockProjectService(), mocks.NewMockContextService(), domain.DefaultConfig(), nil, store)
+
+	err := service.RecordVisit("/wt/a")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to save navigation history")
+}
+
+func TestNavigationService_RecordVisit_Disabled(t *testing.T) {
+	config := domain.DefaultConfig()
+	config.Navigation.HistorySize = 0
+	store := mocks.NewMockNavigationHistoryStore()
+	service := NewNavigationService(mocks.NewMockProjectService(), mocks.NewMockContextService(), config, nil, store)
+
+	require.NoError(t, service.RecordVisit("/wt/a"))
+	store.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestNavigationService_GetHistory_TrimsStoredHistory(t *testing.T) {
+	config := domain.DefaultConfig()
+	config.Navigation.HistorySize = 2
+	store := mocks.NewMockNavigationHistoryStore()
+	store.On("Load").Return([]string{"/wt/c", "/wt/b", "/wt/a"}, nil)
+	service := NewNavigationService(mocks.NewMockProjectService(), mocks.NewMockContextService(), config, nil, store)
+
+	assert.Equal(t, []string{"/wt/c", "/wt/b"}, service.GetHistory())
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"twiggit/internal/application"
 	"twiggit/internal/domain"
@@ -18,6 +19,10 @@ type projectService struct {
 	gitService     application.GitClient
 	contextService application.ContextService
 	config         *domain.Config
+
+	// worktreeOwners caches resolved worktree path -> owning project path
+	worktreeOwners map[string]string
+	ownersMu       sync.RWMutex
 }
 
 // NewProjectService creates a new ProjectService instance
@@ -30,6 +35,7 @@ func NewProjectService(
 		gitService:     gitService,
 		contextService: contextService,
 		config:         config,
+		worktreeOwners: make(map[string]string),
 	}
 }
 
@@ -170,8 +176,201 @@ func (s *projectService) GetProjectInfo(ctx context.Context, projectPath string)
 	}, nil
 }
 
+// FindProjectByWorktreePath determines which project owns the given worktree path.
+// A project matches when the path lives under the project's worktree directory,
+// or when the worktree's gitdir resolves to the project's main repository.
+func (s *projectService) FindProjectByWorktreePath(ctx context.Context, worktreePath string) (*domain.ProjectInfo, error) {
+	if worktreePath == "" {
+		return nil, domain.NewValidationError("FindProjectByWorktreePath", "worktreePath", "", "worktree path cannot be empty")
+	}
+
+	resolvedPath := worktreeOwnerKey(worktreePath)
+	if projectPath, ok := s.cachedWorktreeOwner(resolvedPath); ok {
+		return s.GetProjectInfo(ctx, projectPath)
+	}
+
+	summaries, err := s.ListProjectSummaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mainRepoPath := infrastructure.FindMainRepoFromGitFile(resolvedPath)
+	if mainRepoPath == "" {
+		mainRepoPath = infrastructure.FindMainRepoByTraversal(resolvedPath)
+	}
+
+	for _, summary := range summaries {
+		if !s.worktreeBelongsToProject(resolvedPath, mainRepoPath, summary) {
+			continue
+		}
+
+		s.ownersMu.Lock()
+		s.worktreeOwners[resolvedPath] = summary.Path
+		s.ownersMu.Unlock()
+
+		return s.GetProjectInfo(ctx, summary.Path)
+	}
+
+	return nil, domain.NewProjectServiceError("", worktreePath, "FindProjectByWorktreePath", "no project owns this worktree", nil)
+}
+
+// ForgetWorktreeOwner drops the cached owner of a worktree, so a new worktree at the same path
+// is looked up again
+func (s *projectService) ForgetWorktreeOwner(worktreePath string) {
+	s.ownersMu.Lock()
+	delete(s.worktreeOwners, worktreeOwnerKey(worktreePath))
+	s.ownersMu.Unlock()
+}
+
 // Private helper methods
 
+// AnalyzeObjectStats collects object statistics per project, ordered by descending footprint.
+// Projects that cannot be analyzed are returned with Err set.
+func (s *projectService) AnalyzeObjectStats(ctx context.Context, projects []*domain.ProjectSummary) []domain.ProjectObjectStats {
+	results := make([]domain.ProjectObjectStats, 0, len(projects))
+	for _, project := range projects {
+		result := domain.ProjectObjectStats{ProjectName: project.Name, GitRepoPath: project.GitRepoPath}
+		stats, err := s.gitService.GetObjectStats(ctx, project.GitRepoPath)
+		if err != nil {
+			result.Err = domain.NewProjectServiceError(project.Name, project.GitRepoPath, "AnalyzeObjectStats", "failed to get object stats", err)
+		} else {
+			result.Stats = stats
+		}
+		results = append(results, result)
+	}
+
+	domain.SortByFootprint(results)
+	return results
+}
+
+// GarbageCollect runs git gc in the project's repository
+func (s *projectService) GarbageCollect(ctx context.Context, project *domain.ProjectSummary) error {
+	if err := s.gitService.GarbageCollect(ctx, project.GitRepoPath); err != nil {
+		return domain.NewProjectServiceError(project.Name, project.GitRepoPath, "GarbageCollect", "failed to collect garbage", err)
+	}
+	return nil
+}
+
+// RenameProject renames the project's directory and its worktree directory, then runs git worktree
+// repair so the repository and its worktrees find each other again. Symlinks in the projects and
+// worktrees directories that point into the old locations are retargeted.
+func (s *projectService) RenameProject(ctx context.Context, oldName, newName string) error {
+	// Both names are joined to the configured directories; neither may leave them
+	for _, name := range []string{oldName, newName} {
+		if validation := domain.ValidateProjectDirectoryName(name); validation.IsError() {
+			return validation.Error
+		}
+	}
+
+	oldPath := filepath.Join(s.config.ProjectsDirectory, oldName)
+	if err := s.ValidateProject(ctx, oldPath); err != nil {
+		return domain.NewProjectServiceError(oldName, oldPath, "RenameProject", "project not found", err)
+	}
+
+	newPath := filepath.Join(s.config.ProjectsDirectory, newName)
+	oldWorkspace := filepath.Join(s.config.WorktreesDirectory, oldName)
+	newWorkspace := filepath.Join(s.config.WorktreesDirectory, newName)
+	for _, path := range []string{newPath, newWorkspace} {
+		if _, err := os.Lstat(path); err == nil {
+			return domain.NewProjectServiceError(newName, path, "RenameProject", "a project with this name already exists", domain.ErrProjectNameConflict)
+		}
+	}
+
+	worktrees, err := s.gitService.ListWorktrees(ctx, oldPath)
+	if err != nil {
+		return domain.NewProjectServiceError(oldName, oldPath, "RenameProject", "failed to list worktrees", err)
+	}
+
+	// Work out where each linked worktree ends up before anything moves
+	movedWorktrees := make([]string, 0, len(worktrees))
+	for _, wt := range worktrees {
+		if samePath(wt.Path, oldPath) {
+			continue
+		}
+		if relocated, ok := infrastructure.RelocatePath(wt.Path, oldWorkspace, newWorkspace); ok {
+			movedWorktrees = append(movedWorktrees, relocated)
+		} else {
+			movedWorktrees = append(movedWorktrees, wt.Path)
+		}
+	}
+
+	if err := os.Rename(oldPath, newPath); err != nil {
+		return domain.NewProjectServiceError(oldName, oldPath, "RenameProject", "failed to rename project directory", err)
+	}
+	if _, err := os.Lstat(oldWorkspace); err == nil {
+		if err := os.Rename(oldWorkspace, newWorkspace); err != nil {
+			_ = os.Rename(newPath, oldPath)
+			return domain.NewProjectServiceError(oldName, oldWorkspace, "RenameProject", "failed to rename worktree directory", err)
+		}
+	}
+
+	s.ownersMu.Lock()
+	s.worktreeOwners = make(map[string]string)
+	s.ownersMu.Unlock()
+
+	if len(movedWorktrees) > 0 {
+		if err := s.gitService.RepairWorktrees(ctx, newPath, movedWorktrees); err != nil {
+			return domain.NewProjectServiceError(newName, newPath, "RenameProject",
+				"directories renamed but worktree links could not be repaired (run 'git worktree repair' in the project)", err)
+		}
+	}
+
+	for _, rename := range [][2]string{{oldPath, newPath}, {oldWorkspace, newWorkspace}} {
+		for _, dir := range []string{s.config.ProjectsDirectory, s.config.WorktreesDirectory} {
+			if _, err := infrastructure.RetargetSymlinks(dir, rename[0], rename[1]); err != nil {
+				return domain.NewProjectServiceError(newName, newPath, "RenameProject", "failed to update symlinks", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *projectService) cachedWorktreeOwner(worktreePath string) (string, bool) {
+	s.ownersMu.RLock()
+	defer s.ownersMu.RUnlock()
+	projectPath, ok := s.worktreeOwners[worktreePath]
+	return projectPath, ok
+}
+
+// worktreeOwnerKey resolves symlinks in a worktree path for the owner cache. A worktree that
+// is already gone is resolved through its parent directory so it maps to the same key.
+func worktreeOwnerKey(worktreePath string) string {
+	if resolved, err := filepath.EvalSymlinks(worktreePath); err == nil {
+		return resolved
+	}
+	if parent, err := filepath.EvalSymlinks(filepath.Dir(worktreePath)); err == nil {
+		return filepath.Join(parent, filepath.Base(worktreePath))
+	}
+	return filepath.Clean(worktreePath)
+}
+
+func (s *projectService) worktreeBelongsToProject(worktreePath, mainRepoPath string, summary *domain.ProjectSummary) bool {
+	if s.config != nil && s.config.WorktreesDirectory != "" {
+		workspace := filepath.Join(s.config.WorktreesDirectory, summary.Name)
+		if under, err := infrastructure.IsPathUnder(workspace, worktreePath); err == nil && under {
+			return true
+		}
+	}
+
+	if mainRepoPath == "" {
+		return false
+	}
+
+	return samePath(mainRepoPath, summary.GitRepoPath) || samePath(mainRepoPath, summary.Path)
+}
+
+// samePath compares two paths after resolving symlinks
+func samePath(a, b string) bool {
+	if resolved, err := filepath.EvalSymlinks(a); err == nil {
+		a = resolved
+	}
+	if resolved, err := filepath.EvalSymlinks(b); err == nil {
+		b = resolved
+	}
+	return filepath.Clean(a) == filepath.Clean(b)
+}
+
 func (s *projectService) discoverProjectByName(ctx context.Context, projectName string, currentContext *domain.Context) (*domain.ProjectInfo, error) {
 	// If in project context and project name matches, use context path
 	if currentContext != nil && currentContext.Type == domain.ContextProject {
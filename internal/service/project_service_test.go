@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -521,3 +522,252 @@ func TestProjectService_FindMainRepoFromWorktree(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectService_FindProjectByWorktreePath(t *testing.T) {
+	setupLayout := func(t *testing.T) (*domain.Config, string, string) {
+		t.Helper()
+		tempDir := t.TempDir()
+
+		config := domain.DefaultConfig()
+		config.ProjectsDirectory = filepath.Join(tempDir, "projects")
+		config.WorktreesDirectory = filepath.Join(tempDir, "worktrees")
+
+		mainRepo := filepath.Join(config.ProjectsDirectory, "myproj")
+		require.NoError(t, os.MkdirAll(filepath.Join(mainRepo, ".git", "worktrees"), 0755))
+
+		return config, tempDir, mainRepo
+	}
+
+	writeWorktree := func(t *testing.T, worktreePath, mainRepo string) {
+		t.Helper()
+		name := filepath.Base(worktreePath)
+		require.NoError(t, os.MkdirAll(worktreePath, 0755))
+		require.NoError(t, os.MkdirAll(filepath.Join(mainRepo, ".git", "worktrees", name), 0755))
+		gitFile := fmt.Sprintf("gitdir: %s\n", filepath.Join(mainRepo, ".git", "worktrees", name))
+		require.NoError(t, os.WriteFile(filepath.Join(worktreePath, ".git"), []byte(gitFile), 0644))
+	}
+
+	t.Run("worktree under project workspace", func(t *testing.T) {
+		config, _, mainRepo := setupLayout(t)
+		worktreePath := filepath.Join(config.WorktreesDirectory, "myproj", "feature")
+		writeWorktree(t, worktreePath, mainRepo)
+
+		gitService := mocks.NewMockGitService()
+		configureGitMock(gitService)
+		service := NewProjectService(gitService, nil, config)
+
+		result, err := service.FindProjectByWorktreePath(context.Background(), worktreePath)
+		require.NoError(t, err)
+		assert.Equal(t, "myproj", result.Name)
+		assert.Equal(t, mainRepo, result.GitRepoPath)
+	})
+
+	t.Run("symlinked worktree outside workspace", func(t *testing.T) {
+		config, tempDir, mainRepo := setupLayout(t)
+		worktreePath := filepath.Join(config.WorktreesDirectory, "myproj", "feature")
+		writeWorktree(t, worktreePath, mainRepo)
+
+		linkPath := filepath.Join(tempDir, "elsewhere", "feature-link")
+		require.NoError(t, os.MkdirAll(filepath.Dir(linkPath), 0755))
+		require.NoError(t, os.Symlink(worktreePath, linkPath))
+
+		gitService := mocks.NewMockGitService()
+		configureGitMock(gitService)
+		service := NewProjectService(gitService, nil, config)
+
+		result, err := service.FindProjectByWorktreePath(context.Background(), linkPath)
+		require.NoError(t, err)
+		assert.Equal(t, "myproj", result.Name)
+	})
+
+	t.Run("non-standard layout resolved through gitdir", func(t *testing.T) {
+		config, tempDir, mainRepo := setupLayout(t)
+		worktreePath := filepath.Join(tempDir, "custom", "hotfix")
+		writeWorktree(t, worktreePath, mainRepo)
+
+		gitService := mocks.NewMockGitService()
+		configureGitMock(gitService)
+		service := NewProjectService(gitService, nil, config)
+
+		result, err := service.FindProjectByWorktreePath(context.Background(), worktreePath)
+		require.NoError(t, err)
+		assert.Equal(t, "myproj", result.Name)
+
+		cached, ok := service.(*projectService).cachedWorktreeOwner(worktreePath)
+		assert.True(t, ok)
+		assert.Equal(t, mainRepo, cached)
+	})
+
+	t.Run("removed worktree is forgotten", func(t *testing.T) {
+		config, _, mainRepo := setupLayout(t)
+		worktreePath := filepath.Join(config.WorktreesDirectory, "myproj", "feature")
+		writeWorktree(t, worktreePath, mainRepo)
+
+		gitService := mocks.NewMockGitService()
+		configureGitMock(gitService)
+		service := NewProjectService(gitService, nil, config)
+
+		_, err := service.FindProjectByWorktreePath(context.Background(), worktreePath)
+		require.NoError(t, err)
+		_, ok := service.(*projectService).cachedWorktreeOwner(worktreePath)
+		require.True(t, ok)
+
+		require.NoError(t, os.RemoveAll(worktreePath))
+		service.ForgetWorktreeOwner(worktreePath)
+
+		_, ok = service.(*projectService).cachedWorktreeOwner(worktreePath)
+		assert.False(t, ok)
+	})
+
+	t.Run("unknown worktree", func(t *testing.T) {
+		config, tempDir, _ := setupLayout(t)
+		orphan := filepath.Join(tempDir, "orphan")
+		require.NoError(t, os.MkdirAll(orphan, 0755))
+
+		gitService := mocks.NewMockGitService()
+		configureGitMock(gitService)
+		service := NewProjectService(gitService, nil, config)
+
+		_, err := service.FindProjectByWorktreePath(context.Background(), orphan)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no project owns this worktree")
+	})
+
+	t.Run("empty path", func(t *testing.T) {
+		service := NewProjectService(mocks.NewMockGitService(), nil, domain.DefaultConfig())
+
+		_, err := service.FindProjectByWorktreePath(context.Background(), "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "worktree path cannot be empty")
+	})
+}
+
+func TestProjectService_AnalyzeObjectStats(t *testing.T) {
+	gitService := mocks.NewMockGitService()
+	service := NewProjectService(gitService, mocks.NewMockContextService(), domain.DefaultConfig())
+
+	gitService.MockCLIClient.On("GetObjectStats", mock.Anything, "/repos/small").
+		Return(&domain.ObjectStats{SizePack: 1024}, nil)
+	gitService.MockCLIClient.On("GetObjectStats", mock.Anything, "/repos/broken").
+		Return(nil, errors.New("not a git repository"))
+	gitService.MockCLIClient.On("GetObjectStats", mock.Anything, "/repos/large").
+		Return(&domain.ObjectStats{SizePack: 4096, SizeGarbage: 2048}, nil)
+
+	results := service.AnalyzeObjectStats(context.Background(), []*domain.ProjectSummary{
+		{Name: "small", GitRepoPath: "/repos/small"},
+		{Name: "broken", GitRepoPath: "/repos/broken"},
+		{Name: "large", GitRepoPath: "/repos/large"},
+	})
+
+	require.Len(t, results, 3)
+	assert.Equal(t, "large", results[0].ProjectName)
+	assert.Equal(t, "small", results[1].ProjectName)
+	assert.Equal(t, "broken", results[2].ProjectName)
+	require.Error(t, results[2].Err)
+	assert.Contains(t, results[2].Err.Error(), "failed to get object stats")
+	assert.Nil(t, results[2].Stats)
+}
+
+func TestProjectService_GarbageCollect(t *testing.T) {
+	gitService := mocks.NewMockGitService()
+	service := NewProjectService(gitService, mocks.NewMockContextService(), domain.DefaultConfig())
+
+	gitService.MockCLIClient.On("GarbageCollect", mock.Anything, "/repos/ok").Return(nil).Once()
+	require.NoError(t, service.GarbageCollect(context.Background(), &domain.ProjectSummary{Name: "ok", GitRepoPath: "/repos/ok"}))
+
+	gitService.MockCLIClient.On("GarbageCollect", mock.Anything, "/repos/locked").Return(errors.New("gc.pid exists")).Once()
+	err := service.GarbageCollect(context.Background(), &domain.ProjectSummary{Name: "locked", GitRepoPath: "/repos/locked"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to collect garbage")
+}
+
+func TestProjectService_RenameProject(t *testing.T) {
+	setup := func(t *testing.T) (*domain.Config, *mocks.MockGitService) {
+		t.Helper()
+		root := t.TempDir()
+		config := domain.DefaultConfig()
+		config.ProjectsDirectory = filepath.Join(root, "Projects")
+		config.WorktreesDirectory = filepath.Join(root, "Worktrees")
+		require.NoError(t, os.MkdirAll(filepath.Join(config.ProjectsDirectory, "old-name", ".git"), 0755))
+		require.NoError(t, os.MkdirAll(filepath.Join(config.WorktreesDirectory, "old-name", "feature"), 0755))
+		return config, mocks.NewMockGitService()
+	}
+
+	t.Run("renames directories and repairs worktrees", func(t *testing.T) {
+		config, gitService := setup(t)
+		oldPath := filepath.Join(config.ProjectsDirectory, "old-name")
+		newPath := filepath.Join(config.ProjectsDirectory, "new-name")
+		require.NoError(t, os.Symlink(oldPath, filepath.Join(config.ProjectsDirectory, "alias")))
+
+		gitService.MockGoGitClient.On("ValidateRepository", oldPath).Return(nil)
+		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, oldPath).Return([]domain.WorktreeInfo{
+			{Path: oldPath, Branch: "main"},
+			{Path: filepath.Join(config.WorktreesDirectory, "old-name", "feature"), Branch: "feature"},
+			{Path: "/elsewhere/hotfix", Branch: "hotfix"},
+		}, nil)
+		gitService.MockCLIClient.On("RepairWorktrees", mock.Anything, newPath, []string{
+			filepath.Join(config.WorktreesDirectory, "new-name", "feature"),
+			"/elsewhere/hotfix",
+		}).Return(nil)
+
+		service := NewProjectService(gitService, mocks.NewMockContextService(), config)
+		require.NoError(t, service.RenameProject(context.Background(), "old-name", "new-name"))
+
+		assert.DirExists(t, newPath)
+		assert.NoDirExists(t, oldPath)
+		assert.DirExists(t, filepath.Join(config.WorktreesDirectory, "new-name", "feature"))
+		assert.NoDirExists(t, filepath.Join(config.WorktreesDirectory, "old-name"))
+		target, err := os.Readlink(filepath.Join(config.ProjectsDirectory, "alias"))
+		require.NoError(t, err)
+		assert.Equal(t, newPath, target)
+		gitService.MockCLIClient.AssertExpectations(t)
+	})
+
+	t.Run("name already in use", func(t *testing.T) {
+		config, gitService := setup(t)
+		require.NoError(t, os.MkdirAll(filepath.Join(config.WorktreesDirectory, "taken"), 0755))
+		gitService.MockGoGitClient.On("ValidateRepository", mock.AnythingOfType("string")).Return(nil)
+
+		service := NewProjectService(gitService, mocks.NewMockContextService(), config)
+		err := service.RenameProject(context.Background(), "old-name", "taken")
+
+		require.ErrorIs(t, err, domain.ErrProjectNameConflict)
+		assert.DirExists(t, filepath.Join(config.ProjectsDirectory, "old-name"))
+	})
+
+	t.Run("invalid new name", func(t *testing.T) {
+		config, gitService := setup(t)
+
+		service := NewProjectService(gitService, mocks.NewMockContextService(), config)
+		err := service.RenameProject(context.Background(), "old-name", "team/new-name")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "path separator or reserved character")
+	})
+
+	t.Run("old name outside the projects directory", func(t *testing.T) {
+		config, gitService := setup(t)
+		outside := filepath.Join(filepath.Dir(config.ProjectsDirectory), "other")
+		require.NoError(t, os.MkdirAll(filepath.Join(outside, ".git"), 0755))
+
+		service := NewProjectService(gitService, mocks.NewMockContextService(), config)
+		err := service.RenameProject(context.Background(), "../other", "new-name")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "path separator or reserved character")
+		assert.DirExists(t, outside)
+		assert.NoDirExists(t, filepath.Join(config.ProjectsDirectory, "new-name"))
+		gitService.MockGoGitClient.AssertNotCalled(t, "ValidateRepository", mock.Anything)
+	})
+
+	t.Run("unknown project", func(t *testing.T) {
+		config, gitService := setup(t)
+		gitService.MockGoGitClient.On("ValidateRepository", mock.AnythingOfType("string")).Return(errors.New("not a git repository"))
+
+		service := NewProjectService(gitService, mocks.NewMockContextService(), config)
+		err := service.RenameProject(context.Background(), "missing", "new-name")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "project not found")
+	})
+}
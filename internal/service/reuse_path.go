@@ -0,0 +1,85 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"twiggit/internal/domain"
+)
+
+// checkReusablePath accepts an existing directory that is empty or holds only a .git entry
+// git worktree add can replace: a file (a stale worktree link) or a repository without objects.
+// Anything else wraps domain.ErrTargetNotEmpty.
+func checkReusablePath(path string) error {
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("directory %s to reuse does not exist", path)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot read directory %s: %w", path, err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s to reuse is not a directory", path)
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return fmt.Errorf("cannot read directory %s: %w", path, err)
+	}
+	for _, entry := range entries {
+		if entry.Name() != ".git" {
+			return fmt.Errorf("%w: %s contains %s", domain.ErrTargetNotEmpty, path, entry.Name())
+		}
+	}
+	if len(entries) == 0 || !entries[0].IsDir() {
+		return nil
+	}
+
+	hasObjects, err := gitDirHasObjects(filepath.Join(path, ".git"))
+	if err != nil {
+		return fmt.Errorf("cannot read %s: %w", filepath.Join(path, ".git"), err)
+	}
+	if hasObjects {
+		return fmt.Errorf("%w: %s holds a git repository with commits or objects", domain.ErrTargetNotEmpty, path)
+	}
+	return nil
+}
+
+// gitDirHasObjects reports whether a .git directory stores any object, loose or packed.
+// A freshly initialized repository only has the empty objects/info and objects/pack directories.
+func gitDirHasObjects(gitDir string) (bool, error) {
+	found := false
+	err := filepath.WalkDir(filepath.Join(gitDir, "objects"), func(_ string, entry fs.DirEntry, err error) error {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			found = true
+			return filepath.SkipAll
+		}
+		return nil
+	})
+	return found, err
+}
+
+// clearReusedPath removes the .git entry checkReusablePath accepted, right before git
+// worktree add, which requires an empty directory. The check runs again since the
+// directory may have changed since the request was validated.
+func clearReusedPath(req *domain.CreateWorktreeRequest, path string) error {
+	if !req.ReuseExistingPath {
+		return nil
+	}
+	if err := checkReusablePath(path); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(filepath.Join(path, ".git")); err != nil {
+		return fmt.Errorf("failed to remove the unused .git of %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"twiggit/internal/domain"
+)
+
+// worktreeDetailsTimeout caps the lookups of one worktree's details, so a slow repository
+// cannot hold up list --verbose
+const worktreeDetailsTimeout = 5 * time.Second
+
+// GetWorktreeDetails looks up the tracking branch, stash count and last commit of a worktree
+// concurrently. Each lookup fails on its own and keeps its error in the details.
+func (s *worktreeService) GetWorktreeDetails(ctx context.Context, worktree *domain.WorktreeInfo) *domain.WorktreeDetails {
+	ctx, cancel := context.WithTimeout(ctx, worktreeDetailsTimeout)
+	defer cancel()
+
+	// Each goroutine fills its own fields
+	details := &domain.WorktreeDetails{}
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		details.UpstreamErr = detailsError(ctx, s.lookupTracking(ctx, worktree, details))
+	}()
+	go func() {
+		defer wg.Done()
+		details.StashErr = detailsError(ctx, s.lookupStashCount(ctx, worktree, details))
+	}()
+	go func() {
+		defer wg.Done()
+		details.ActivityErr = detailsError(ctx, s.lookupLastActivity(ctx, worktree, details))
+	}()
+	wg.Wait()
+
+	return details
+}
+
+// lookupTracking sets the upstream of the worktree's branch and the commits they do not share
+func (s *worktreeService) lookupTracking(ctx context.Context, worktree *domain.WorktreeInfo, details *domain.WorktreeDetails) error {
+	if worktree.IsDetached || worktree.Branch == "" {
+		return nil
+	}
+	upstream, err := s.gitService.GetUpstreamBranch(ctx, worktree.Path, worktree.Branch)
+	if err != nil || upstream == "" {
+		return err
+	}
+	relationship, err := s.gitService.GetBranchRelationship(ctx, worktree.Path, worktree.Branch, upstream)
+	if err != nil {
+		return err
+	}
+	details.Upstream = upstream
+	details.AheadCount = relationship.AheadCount
+	details.BehindCount = relationship.BehindCount
+	return nil
+}
+
+// lookupStashCount counts the stash entries made on the worktree's branch; worktrees share the
+// repository's stash, so entries of other branches are left out
+func (s *worktreeService) lookupStashCount(ctx context.Context, worktree *domain.WorktreeInfo, details *domain.WorktreeDetails) error {
+	entries, err := s.gitService.GetStashList(ctx, worktree.Path)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.OnBranch(worktree.Branch) {
+			details.StashCount++
+		}
+	}
+	return nil
+}
+
+// lookupLastActivity sets the author and subject of the worktree's HEAD commit
+func (s *worktreeService) lookupLastActivity(ctx context.Context, worktree *domain.WorktreeInfo, details *domain.WorktreeDetails) error {
+	commit, err := s.gitService.GetLastActivity(ctx, worktree.Path)
+	if err != nil {
+		return err
+	}
+	details.LastAuthor = commit.Author
+	details.LastMessage = commit.Message
+	return nil
+}
+
+// detailsError reports a lookup cut off by the deadline as the context's error, so callers
+// can tell a timeout from a git failure
+func detailsError(ctx context.Context, err error) error {
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -23,6 +26,8 @@ type worktreeService struct {
 	projectService application.ProjectService
 	config         *domain.Config
 	hookRunner     application.HookRunner
+	processManager application.ProcessManager
+	getenv         func(string) string
 	// mutex protects result modifications during prune operations
 	mu sync.Mutex
 }
@@ -33,12 +38,15 @@ func NewWorktreeService(
 	projectService application.ProjectService,
 	config *domain.Config,
 	hookRunner application.HookRunner,
+	processManager application.ProcessManager,
 ) application.WorktreeService {
 	return &worktreeService{
 		gitService:     gitService,
 		projectService: projectService,
 		config:         config,
 		hookRunner:     hookRunner,
+		processManager: processManager,
+		getenv:         os.Getenv,
 	}
 }
 
@@ -57,22 +65,131 @@ func (s *worktreeService) CreateWorktree(ctx context.Context, req *domain.Create
 
 	// Calculate worktree path
 	worktreePath := s.calculateWorktreePath(project.Name, req.BranchName)
-
-	// Check if worktree already exists
-	if _, err := os.Stat(worktreePath); err == nil {
+	if req.ReuseExistingPath {
+		worktreePath = req.WorktreePath
+		if err := checkReusablePath(worktreePath); err != nil {
+			return nil, domain.NewWorktreeServiceError(worktreePath, req.BranchName, "CreateWorktree", err.Error(), err)
+		}
+	} else if _, err := os.Stat(worktreePath); err == nil {
+		// Check if worktree already exists
 		return nil, domain.NewConflictError("worktree", req.BranchName, "CreateWorktree", "worktree already exists at "+worktreePath, nil)
 	}
 
-	// Ensure parent directories exist
+	// Ensure parent directories exist; with CheckoutOnConflict the failure is handled with the other creation failures
 	parentDir := filepath.Dir(worktreePath)
+	var createErr error
 	if err := os.MkdirAll(parentDir, 0755); err != nil { // #nosec G301 -- standard directory perms (rwxr-xr-x)
-		return nil, fmt.Errorf("failed to create worktree parent directory: %w", err)
+		if !req.CheckoutOnConflict {
+			return nil, fmt.Errorf("failed to create worktree parent directory: %w", err)
+		}
+		createErr = domain.NewWorktreeServiceError(worktreePath, req.BranchName, "CreateWorktree", "failed to create worktree parent directory: "+err.Error(), err)
+	}
+
+	// Worktree-only: register the worktree but leave the working tree empty.
+	// Hooks are skipped since there are no files to set up yet.
+	if req.WorktreeOnly {
+		autoStashPath, err := s.autoStash(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if err := clearReusedPath(req, worktreePath); err != nil {
+			return nil, domain.NewWorktreeServiceError(worktreePath, req.BranchName, "CreateWorktree", err.Error()+autoStashNote(autoStashPath), err)
+		}
+		if err := s.gitService.InitBareWorktree(ctx, project.GitRepoPath, worktreePath); err != nil {
+			return nil, domain.NewWorktreeServiceError(worktreePath, req.BranchName, "CreateWorktree", "failed to create worktree"+autoStashNote(autoStashPath), err)
+		}
+		return &domain.CreateWorktreeResult{
+			Worktree: &domain.WorktreeInfo{
+				Path:       worktreePath,
+				Branch:     "(detached)",
+				IsDetached: true,
+			},
+			AutoStashPath: autoStashPath,
+		}, nil
+	}
+
+	// An existing local branch is checked out as is. When a source branch was asked for it would be
+	// silently ignored, so that needs UseLocalBranch
+	branchExists, err := s.gitService.BranchExists(ctx, project.GitRepoPath, req.BranchName)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(worktreePath, req.BranchName, "CreateWorktree", "failed to check branch existence", err)
+	}
+	if branchExists && req.FromWorktree != "" {
+		return nil, domain.NewConflictError("branch", req.BranchName, "CreateWorktree",
+			"branch already exists locally; --from-worktree needs a new branch", nil)
+	}
+	if branchExists && req.SourceRef != "" {
+		return nil, domain.NewConflictError("branch", req.BranchName, "CreateWorktree",
+			"branch already exists locally; --from needs a new branch", nil)
+	}
+	if branchExists && req.ExplicitSource && !req.UseLocalBranch {
+		return nil, domain.NewConflictError("branch", req.BranchName, "CreateWorktree",
+			"branch already exists locally, so source "+req.SourceBranch+" would be ignored; use --use-local-branch to check it out as is", nil)
+	}
+
+	if req.FromStash != nil {
+		if err := s.checkStashExists(ctx, project.GitRepoPath, *req.FromStash); err != nil {
+			return nil, err
+		}
 	}
 
-	// Create worktree using CLI client
-	err = s.gitService.CreateWorktree(ctx, project.GitRepoPath, req.BranchName, req.SourceBranch, worktreePath)
+	sourceRef := req.SourceBranch
+	if req.FromWorktree != "" {
+		sourceRef, err = s.resolveForkCommit(ctx, project, req)
+		if err != nil {
+			return nil, err
+		}
+	} else if req.SourceRef != "" {
+		sourceRef = s.resolveSourceRef(ctx, project, req.SourceRef)
+	}
+
+	// The worktree starts at the existing branch when it is checked out, else at the source
+	startRef, startName := sourceRef, req.SourceBranch
+	if branchExists {
+		startRef, startName = req.BranchName, req.BranchName
+	} else if req.FromWorktree != "" {
+		startName = req.FromWorktree
+	} else if req.SourceRef != "" {
+		startName = req.SourceRef
+	}
+	if err := s.checkBehindMain(ctx, project, req, worktreePath, startRef, startName); err != nil {
+		return nil, err
+	}
+
+	// Stash last, once everything that can refuse the request has run
+	autoStashPath, err := s.autoStash(ctx, req)
 	if err != nil {
-		return nil, domain.NewWorktreeServiceError(worktreePath, req.BranchName, "CreateWorktree", "failed to create worktree", err)
+		return nil, err
+	}
+
+	// Create worktree using CLI client; an existing branch is checked out as is
+	if createErr == nil {
+		if err := clearReusedPath(req, worktreePath); err != nil {
+			createErr = domain.NewWorktreeServiceError(worktreePath, req.BranchName, "CreateWorktree", err.Error()+autoStashNote(autoStashPath), err)
+		}
+	}
+	if createErr == nil {
+		if err := s.gitService.CreateWorktree(ctx, project.GitRepoPath, req.BranchName, sourceRef, worktreePath); err != nil {
+			createErr = domain.NewWorktreeServiceError(worktreePath, req.BranchName, "CreateWorktree", "failed to create worktree"+autoStashNote(autoStashPath), err)
+		}
+	}
+	var checkoutFallback error
+	if createErr != nil {
+		if !req.CheckoutOnConflict {
+			return nil, createErr
+		}
+		startPoint := sourceRef
+		if branchExists {
+			startPoint = ""
+		}
+		if err := s.checkoutInCurrentWorktree(ctx, project, req, startPoint); err != nil {
+			var serviceErr *domain.WorktreeServiceError
+			if errors.As(createErr, &serviceErr) {
+				serviceErr.Message += "; checking out the branch in the current worktree also failed: " + err.Error()
+			}
+			return nil, createErr
+		}
+		checkoutFallback, worktreePath = createErr, req.Context.Path
 	}
 
 	// Run post-create hooks
@@ -80,9 +197,30 @@ func (s *worktreeService) CreateWorktree(ctx context.Context, req *domain.Create
 		Path:   worktreePath,
 		Branch: req.BranchName,
 	}
+	// Only an existing branch can carry a description
+	if branchExists {
+		s.populateDescription(ctx, project.GitRepoPath, worktreeInfo)
+	}
+	if req.FromWorktree != "" {
+		note := "Forked from " + req.FromWorktree
+		if err := s.gitService.SetBranchDescription(ctx, project.GitRepoPath, req.BranchName, note); err != nil {
+			return nil, domain.NewWorktreeServiceError(worktreePath, req.BranchName, "CreateWorktree", "worktree created but failed to record fork in branch description", err)
+		}
+		worktreeInfo.Description = note
+	}
+
+	// Hooks run after the stash is applied so they see the moved changes
+	var stashConflicts []domain.ConflictFile
+	if req.FromStash != nil {
+		stashConflicts, err = s.applyStash(ctx, project, req, worktreePath)
+		if err != nil {
+			return nil, err
+		}
+	}
 
+	// Post-create hooks set up new worktrees, not the current one the fallback reused
 	var hookResult *domain.HookResult
-	if s.hookRunner != nil {
+	if s.hookRunner != nil && checkoutFallback == nil {
 		hookReq := &application.HookRunRequest{
 			HookType:       domain.HookPostCreate,
 			WorktreePath:   worktreePath,
@@ -91,16 +229,107 @@ func (s *worktreeService) CreateWorktree(ctx context.Context, req *domain.Create
 			SourceBranch:   req.SourceBranch,
 			MainRepoPath:   project.GitRepoPath,
 			ConfigFilePath: filepath.Join(project.GitRepoPath, ".twiggit.toml"),
+			ExtraCommands:  req.PostCreateCommands,
 		}
 		hookResult, _ = s.hookRunner.Run(ctx, hookReq)
 	}
 
 	return &domain.CreateWorktreeResult{
-		Worktree:   worktreeInfo,
-		HookResult: hookResult,
+		Worktree:       worktreeInfo,
+		HookResult:     hookResult,
+		StashConflicts: stashConflicts,
+		AutoStashPath:  autoStashPath,
+
+		CheckoutFallback: checkoutFallback,
 	}, nil
 }
 
+// checkoutInCurrentWorktree is the CheckoutOnConflict fallback: it checks out req.BranchName
+// (created at startPoint when not empty) in the Context worktree, which must belong to the
+// project and have no uncommitted changes
+func (s *worktreeService) checkoutInCurrentWorktree(ctx context.Context, project *domain.ProjectInfo, req *domain.CreateWorktreeRequest, startPoint string) error {
+	path := req.Context.Path
+	if req.Context.ProjectName != project.Name {
+		return fmt.Errorf("the current directory is not a worktree of %s", project.Name)
+	}
+
+	status, err := s.gitService.GetRepositoryStatus(ctx, path)
+	if err != nil {
+		return fmt.Errorf("failed to check %s for changes: %w", path, err)
+	}
+	if !status.IsClean {
+		return fmt.Errorf("%s has uncommitted changes", path)
+	}
+	return s.gitService.CheckoutBranch(ctx, path, req.BranchName, startPoint)
+}
+
+// resolveSourceRef returns the commit ref points at, resolved with go-git. Revisions go-git
+// cannot resolve are passed to git unchanged, which creates the branch with the CLI's own
+// rev-parse rules (git worktree add -b <branch> <path> <ref>) and reports a missing ref.
+func (s *worktreeService) resolveSourceRef(ctx context.Context, project *domain.ProjectInfo, ref string) string {
+	hash, err := s.gitService.ResolveRevision(ctx, project.GitRepoPath, ref)
+	if err != nil {
+		return ref
+	}
+	return hash
+}
+
+// checkBehindMain refuses a start point that is at least req.MaxBehindCommits (default
+// max_behind_commits) commits behind the default source branch; a limit of 0 disables the check
+func (s *worktreeService) checkBehindMain(ctx context.Context, project *domain.ProjectInfo, req *domain.CreateWorktreeRequest, worktreePath, startRef, startName string) error {
+	limit := s.config.MaxBehindCommits
+	if req.MaxBehindCommits != nil {
+		limit = *req.MaxBehindCommits
+	}
+	if limit <= 0 {
+		return nil
+	}
+
+	mainBranch := s.config.DefaultSourceBranch
+	if mainBranch == "" {
+		mainBranch = "main"
+	}
+	relationship, err := s.gitService.GetBranchRelationship(ctx, project.GitRepoPath, startRef, mainBranch)
+	if err != nil {
+		return domain.NewWorktreeServiceError(worktreePath, req.BranchName, "CreateWorktree", "failed to compare "+startName+" with "+mainBranch, err)
+	}
+	if relationship.BehindCount < limit {
+		return nil
+	}
+
+	return domain.NewWorktreeServiceError(worktreePath, req.BranchName, "CreateWorktree", fmt.Sprintf(
+		"%s is %d commit(s) behind %s (limit %d); update it first with 'git rebase origin/%s' or 'git merge %s'",
+		startName, relationship.BehindCount, mainBranch, limit, mainBranch, mainBranch), domain.ErrBranchTooFarBehind)
+}
+
+// resolveForkCommit returns the HEAD commit of the worktree checked out on req.FromWorktree,
+// refusing a worktree with uncommitted changes unless req.Force is set
+func (s *worktreeService) resolveForkCommit(ctx context.Context, project *domain.ProjectInfo, req *domain.CreateWorktreeRequest) (string, error) {
+	worktrees, err := s.gitService.ListWorktrees(ctx, project.GitRepoPath)
+	if err != nil {
+		return "", domain.NewWorktreeServiceError(project.GitRepoPath, req.FromWorktree, "CreateWorktree", "failed to list worktrees", err)
+	}
+
+	for _, wt := range worktrees {
+		if wt.Branch != req.FromWorktree || wt.IsBare {
+			continue
+		}
+		if !req.Force {
+			status, err := s.gitService.GetRepositoryStatus(ctx, wt.Path)
+			if err != nil {
+				return "", domain.NewWorktreeServiceError(wt.Path, req.FromWorktree, "CreateWorktree", "failed to check worktree status", err)
+			}
+			if !status.IsClean {
+				return "", domain.NewConflictError("worktree", req.FromWorktree, "CreateWorktree",
+					"worktree has uncommitted changes that would not be copied; commit them or use --force", nil)
+			}
+		}
+		return wt.Commit, nil
+	}
+
+	return "", domain.NewValidationError("CreateWorktreeRequest", "FromWorktree", req.FromWorktree, "no worktree is checked out on this branch")
+}
+
 // DeleteWorktree deletes an existing worktree
 func (s *worktreeService) DeleteWorktree(ctx context.Context, req *domain.DeleteWorktreeRequest) error {
 	// Validate request
@@ -119,50 +348,49 @@ func (s *worktreeService) DeleteWorktree(ctx context.Context, req *domain.Delete
 		return domain.NewWorktreeServiceError(req.WorktreePath, "", "DeleteWorktree", "failed to find project for worktree", err)
 	}
 
+	// Look up the branch while the worktree is still registered
+	var branch string
+	if req.DeleteBranch {
+		wt, err := s.GetWorktreeByPath(ctx, project.GitRepoPath, req.WorktreePath)
+		if err != nil {
+			return domain.NewWorktreeServiceError(req.WorktreePath, "", "DeleteWorktree", "failed to find worktree branch", err)
+		}
+		if !wt.IsDetached {
+			branch = wt.Branch
+		}
+	}
+
+	// Stop background processes before their working directory disappears
+	if err := s.stopWorktreeProcesses(ctx, req.WorktreePath); err != nil {
+		return domain.NewWorktreeServiceError(req.WorktreePath, "", "DeleteWorktree", "failed to stop worktree processes", err)
+	}
+
 	// Delete worktree using CLI client
 	err = s.gitService.DeleteWorktree(ctx, project.GitRepoPath, req.WorktreePath, req.Force)
 	if err != nil {
 		return domain.NewWorktreeServiceError(req.WorktreePath, "", "DeleteWorktree", "failed to delete worktree", err)
 	}
+	s.projectService.ForgetWorktreeOwner(req.WorktreePath)
+
+	if branch != "" {
+		_ = s.gitService.PruneWorktrees(ctx, project.GitRepoPath)
+		if err := s.gitService.DeleteBranch(ctx, project.GitRepoPath, branch); err != nil {
+			return domain.NewWorktreeServiceError(req.WorktreePath, branch, "DeleteWorktree", "worktree deleted but branch deletion failed", err)
+		}
+	}
 
 	return nil
 }
 
 // ListWorktrees lists all worktrees for a project
 func (s *worktreeService) ListWorktrees(ctx context.Context, req *domain.ListWorktreesRequest) ([]*domain.WorktreeInfo, error) {
-	var projects []*domain.ProjectInfo
-	var err error
-
-	// Handle ListAllProjects case
-	if req.ListAllProjects {
-		projects, err = s.listAllProjects(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to list all projects: %w", err)
-		}
-	} else if req.Context != nil && (req.Context.Type == domain.ContextProject || req.Context.Type == domain.ContextWorktree) {
-		// If we're in a project context, use the current path directly
-		project, err := s.projectService.GetProjectInfo(ctx, req.Context.Path)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get project info from context: %w", err)
-		}
-		projects = []*domain.ProjectInfo{project}
-	} else {
-		// Resolve project by name
-		projectName := req.ProjectName
-		if projectName == "" && req.Context != nil {
-			projectName = req.Context.ProjectName
-		}
-
-		if projectName == "" {
-			return nil, domain.NewValidationError("ListWorktreesRequest", "projectName", "", "project name required when not provided in context")
-		}
+	if err := validateBranchFilter(req.BranchFilter); err != nil {
+		return nil, err
+	}
 
-		// Get project info
-		project, err := s.projectService.DiscoverProject(ctx, projectName, req.Context)
-		if err != nil {
-			return nil, fmt.Errorf("failed to resolve project: %w", err)
-		}
-		projects = []*domain.ProjectInfo{project}
+	projects, err := s.resolveListProjects(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
 	// Aggregate worktrees from all projects
@@ -186,14 +414,189 @@ func (s *worktreeService) ListWorktrees(ctx context.Context, req *domain.ListWor
 		}
 
 		// Convert to pointers and add to result
+		byBranch := domain.FilterByBranch(req.BranchFilter)
 		for i := range worktrees {
+			if !byBranch(&worktrees[i]) {
+				continue
+			}
+			worktrees[i].Project = project.Name
+			if req.IncludeLastUpdated || req.OnlyMine {
+				s.populateHeadCommit(ctx, project.GitRepoPath, &worktrees[i])
+			}
+			if req.IncludeDescriptions {
+				s.populateDescription(ctx, project.GitRepoPath, &worktrees[i])
+			}
 			allWorktrees = append(allWorktrees, &worktrees[i])
 		}
 	}
 
+	if req.OnlyMine {
+		email, err := s.currentUserEmail(ctx)
+		if err != nil {
+			return nil, err
+		}
+		allWorktrees = domain.FilterWorktrees(allWorktrees, domain.FilterByAuthor(email))
+	}
+
+	if req.SinceCommit != "" {
+		return FilterWorktreesBySinceCommit(ctx, allWorktrees, req.SinceCommit, s.gitService)
+	}
+
 	return allWorktrees, nil
 }
 
+// resolveListProjects returns the projects a list request covers: all projects,
+// the project of the current context, or the project named in the request
+func (s *worktreeService) resolveListProjects(ctx context.Context, req *domain.ListWorktreesRequest) ([]*domain.ProjectInfo, error) {
+	// Handle ListAllProjects case
+	if req.ListAllProjects {
+		projects, err := s.listAllProjects(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list all projects: %w", err)
+		}
+		return projects, nil
+	}
+
+	if req.Context != nil && (req.Context.Type == domain.ContextProject || req.Context.Type == domain.ContextWorktree) {
+		// If we're in a project context, use the current path directly
+		project, err := s.projectService.GetProjectInfo(ctx, req.Context.Path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project info from context: %w", err)
+		}
+		return []*domain.ProjectInfo{project}, nil
+	}
+
+	// Resolve project by name
+	projectName := req.ProjectName
+	if projectName == "" && req.Context != nil {
+		projectName = req.Context.ProjectName
+	}
+
+	if projectName == "" {
+		return nil, domain.NewValidationError("ListWorktreesRequest", "projectName", "", "project name required when not provided in context")
+	}
+
+	// Get project info
+	project, err := s.projectService.DiscoverProject(ctx, projectName, req.Context)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve project: %w", err)
+	}
+	return []*domain.ProjectInfo{project}, nil
+}
+
+// ListRemoteCandidates lists remote-tracking branches of the requested projects that
+// have no local worktree, newest commit first. It never creates anything.
+func (s *worktreeService) ListRemoteCandidates(ctx context.Context, req *domain.ListWorktreesRequest) ([]*domain.RemoteWorktreeCandidate, error) {
+	if err := validateBranchFilter(req.BranchFilter); err != nil {
+		return nil, err
+	}
+
+	projects, err := s.resolveListProjects(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*domain.RemoteWorktreeCandidate
+	for _, project := range projects {
+		worktrees, err := s.gitService.ListWorktrees(ctx, project.GitRepoPath)
+		if err != nil {
+			return nil, domain.NewWorktreeServiceError(project.GitRepoPath, "", "ListRemoteCandidates", "failed to list worktrees", err)
+		}
+		checkedOut := make(map[string]bool, len(worktrees))
+		for _, wt := range worktrees {
+			if wt.Branch != "" {
+				checkedOut[wt.Branch] = true
+			}
+		}
+
+		branches, err := s.gitService.GetRemoteBranches(ctx, project.GitRepoPath)
+		if err != nil {
+			return nil, domain.NewWorktreeServiceError(project.GitRepoPath, "", "ListRemoteCandidates", "failed to list remote branches", err)
+		}
+		for _, branch := range branches {
+			if checkedOut[branch.Name] || !matchesBranchFilter(req.BranchFilter, branch.Name) {
+				continue
+			}
+			candidates = append(candidates, &domain.RemoteWorktreeCandidate{
+				Project:    project.Name,
+				Branch:     branch.Name,
+				Remote:     branch.Remote,
+				Commit:     branch.Commit,
+				Author:     branch.Author,
+				CommitTime: branch.Date,
+			})
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b *domain.RemoteWorktreeCandidate) int {
+		return b.CommitTime.Compare(a.CommitTime)
+	})
+	return candidates, nil
+}
+
+// validateBranchFilter rejects malformed list --filter globs
+func validateBranchFilter(pattern string) error {
+	if pattern == "" {
+		return nil
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return domain.NewValidationError("ListWorktreesRequest", "BranchFilter", pattern, "invalid filter pattern")
+	}
+	return nil
+}
+
+// matchesBranchFilter reports whether branch matches the glob; an empty pattern matches everything
+func matchesBranchFilter(pattern, branch string) bool {
+	return domain.FilterByBranch(pattern)(&domain.WorktreeInfo{Branch: branch})
+}
+
+// FilterMerged keeps worktrees whose tip is already in mainBranch, i.e. whose merge base with
+// mainBranch is their own HEAD commit. Git commands run in each worktree; worktrees whose
+// merge base cannot be computed are dropped.
+func FilterMerged(ctx context.Context, mainBranch string, gitClient application.GitClient) domain.WorktreeFilter {
+	return func(wt *domain.WorktreeInfo) bool {
+		tip := wt.Branch
+		if wt.IsDetached || tip == "" {
+			tip = wt.Commit
+		}
+		mergeBase, err := gitClient.GetMergeBase(ctx, wt.Path, tip, mainBranch)
+		return err == nil && mergeBase != "" && mergeBase == wt.Commit
+	}
+}
+
+// FilterWorktreesBySinceCommit keeps the worktrees whose HEAD has commits after ref,
+// setting AheadCount. A worktree is dropped when its merge base with ref is its own tip.
+// Git commands run in each worktree, so worktrees from several projects can be mixed.
+func FilterWorktreesBySinceCommit(ctx context.Context, worktrees []*domain.WorktreeInfo, ref string, gitClient application.GitClient) ([]*domain.WorktreeInfo, error) {
+	var filtered []*domain.WorktreeInfo
+	for _, wt := range worktrees {
+		tip := wt.Branch
+		if wt.IsDetached || tip == "" {
+			tip = wt.Commit
+		}
+
+		mergeBase, err := gitClient.GetMergeBase(ctx, wt.Path, tip, ref)
+		if err != nil {
+			return nil, domain.NewWorktreeServiceError(wt.Path, wt.Branch, "FilterWorktreesBySinceCommit", "failed to compare with "+ref, err)
+		}
+		if mergeBase != "" && mergeBase == wt.Commit {
+			continue
+		}
+
+		commits, err := gitClient.LogBetween(ctx, wt.Path, ref, tip)
+		if err != nil {
+			return nil, domain.NewWorktreeServiceError(wt.Path, wt.Branch, "FilterWorktreesBySinceCommit", "failed to count commits after "+ref, err)
+		}
+		if len(commits) == 0 {
+			continue
+		}
+
+		wt.AheadCount = len(commits)
+		filtered = append(filtered, wt)
+	}
+	return filtered, nil
+}
+
 // listAllProjects retrieves all available projects from the projects directory
 func (s *worktreeService) listAllProjects(ctx context.Context) ([]*domain.ProjectInfo, error) {
 	summaries, err := s.projectService.ListProjectSummaries(ctx)
@@ -266,6 +669,12 @@ func (s *worktreeService) GetWorktreeStatus(ctx context.Context, worktreePath st
 		branchStatus = "behind"
 	}
 
+	// Conflicts only exist in a dirty worktree; listing them is best-effort
+	var conflicts []domain.ConflictFile
+	if !repoStatus.IsClean {
+		conflicts, _ = s.gitService.GetConflictingFiles(ctx, worktreePath)
+	}
+
 	return &domain.WorktreeStatus{
 		WorktreeInfo:          worktreeInfo,
 		RepositoryStatus:      &repoStatus,
@@ -273,9 +682,79 @@ func (s *worktreeService) GetWorktreeStatus(ctx context.Context, worktreePath st
 		IsClean:               repoStatus.IsClean,
 		HasUncommittedChanges: !repoStatus.IsClean,
 		BranchStatus:          branchStatus,
+		ConflictFiles:         conflicts,
 	}, nil
 }
 
+// GetProjectSummary counts dirty and conflicted linked worktrees of the project.
+// The main worktree is excluded as in ListWorktrees; a worktree whose status cannot
+// be read is counted in Errors rather than failing the summary.
+func (s *worktreeService) GetProjectSummary(ctx context.Context, projectPath string) (*domain.ProjectStatusSummary, error) {
+	if projectPath == "" {
+		return nil, domain.NewValidationError("GetProjectSummary", "projectPath", "", "project path cannot be empty")
+	}
+
+	worktrees, err := s.gitService.ListWorktrees(ctx, projectPath)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(projectPath, "", "GetProjectSummary", "failed to list worktrees", err)
+	}
+
+	summary := &domain.ProjectStatusSummary{ProjectPath: projectPath}
+	for _, wt := range worktrees {
+		if wt.IsBare || wt.Path == projectPath {
+			continue
+		}
+		summary.Worktrees++
+
+		status, err := s.GetWorktreeStatus(ctx, wt.Path)
+		if err != nil {
+			summary.Errors++
+			continue
+		}
+		if status.HasUncommittedChanges {
+			summary.Dirty++
+		}
+		if len(status.ConflictFiles) > 0 {
+			summary.Conflicts++
+		}
+	}
+
+	return summary, nil
+}
+
+// DiscoverWorktreesWithFilter lists the linked worktrees of the project, applies filter to
+// the basic worktree info and fetches status only for the worktrees it keeps. The main
+// worktree is excluded as in ListWorktrees; a nil filter keeps every worktree.
+func (s *worktreeService) DiscoverWorktreesWithFilter(ctx context.Context, projectPath string, filter domain.WorktreeFilter) ([]*domain.WorktreeStatus, error) {
+	if projectPath == "" {
+		return nil, domain.NewValidationError("DiscoverWorktreesWithFilter", "projectPath", "", "project path cannot be empty")
+	}
+
+	worktrees, err := s.gitService.ListWorktrees(ctx, projectPath)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(projectPath, "", "DiscoverWorktreesWithFilter", "failed to list worktrees", err)
+	}
+
+	var statuses []*domain.WorktreeStatus
+	for i := range worktrees {
+		wt := &worktrees[i]
+		if wt.IsBare || wt.Path == projectPath {
+			continue
+		}
+		if filter != nil && !filter(wt) {
+			continue
+		}
+
+		status, err := s.GetWorktreeStatus(ctx, wt.Path)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
 // ValidateWorktree validates that a worktree is properly configured
 func (s *worktreeService) ValidateWorktree(ctx context.Context, worktreePath string) error {
 	if worktreePath == "" {
@@ -335,20 +814,145 @@ func (s *worktreeService) validateCreateRequest(req *domain.CreateWorktreeReques
 			WithSuggestions([]string{"Specify a project name (e.g., my-project/feature-branch)", "Run from within a project directory"})
 	}
 
-	return nil
-}
-
-func (s *worktreeService) validateDeleteRequest(req *domain.DeleteWorktreeRequest) error {
-	if req.WorktreePath == "" {
-		return domain.NewValidationError("DeleteWorktreeRequest", "WorktreePath", "", "worktree path cannot be empty")
+	if req.FromStash != nil {
+		if *req.FromStash < 0 {
+			return domain.NewValidationError("CreateWorktreeRequest", "FromStash", strconv.Itoa(*req.FromStash), "stash index cannot be negative")
+		}
+		if req.WorktreeOnly {
+			return domain.NewValidationError("CreateWorktreeRequest", "FromStash", strconv.Itoa(*req.FromStash), "a stash cannot be applied to a worktree without checked out files")
+		}
+	} else if req.DropStash {
+		return domain.NewValidationError("CreateWorktreeRequest", "DropStash", "true", "dropping a stash requires a stash to apply")
 	}
 
-	return nil
-}
+	if req.SourceRef != "" {
+		if req.FromWorktree != "" {
+			return domain.NewValidationError("CreateWorktreeRequest", "SourceRef", req.SourceRef, "a source ref cannot be combined with FromWorktree")
+		}
+		if req.WorktreeOnly {
+			return domain.NewValidationError("CreateWorktreeRequest", "SourceRef", req.SourceRef, "a source ref needs a branch to create; nothing is checked out with WorktreeOnly")
+		}
+	}
 
-func (s *worktreeService) calculateWorktreePath(projectName, branchName string) string {
-	safeProjectName := filepath.Base(projectName)
-	safeProjectName = filepath.Clean(safeProjectName)
+	if req.MaxBehindCommits != nil && *req.MaxBehindCommits < 0 {
+		return domain.NewValidationError("CreateWorktreeRequest", "MaxBehindCommits", strconv.Itoa(*req.MaxBehindCommits), "the behind limit cannot be negative")
+	}
+
+	if req.WorktreePath != "" && !req.ReuseExistingPath {
+		return domain.NewValidationError("CreateWorktreeRequest", "WorktreePath", req.WorktreePath, "a target path is only supported when reusing an existing directory")
+	}
+	if req.ReuseExistingPath && req.WorktreePath == "" {
+		return domain.NewValidationError("CreateWorktreeRequest", "WorktreePath", "", "the directory to reuse is required")
+	}
+	if req.ReuseExistingPath && !filepath.IsAbs(req.WorktreePath) {
+		return domain.NewValidationError("CreateWorktreeRequest", "WorktreePath", req.WorktreePath, "the reused directory must be an absolute path")
+	}
+
+	if req.CheckoutOnConflict {
+		switch {
+		case req.WorktreeOnly:
+			return domain.NewValidationError("CreateWorktreeRequest", "CheckoutOnConflict", "true", "checkout-on-conflict cannot fall back for a worktree without checked out files")
+		case req.FromStash != nil:
+			return domain.NewValidationError("CreateWorktreeRequest", "CheckoutOnConflict", "true", "checkout-on-conflict cannot be combined with a stash to apply")
+		case req.Context.Type != domain.ContextProject && req.Context.Type != domain.ContextWorktree:
+			return domain.NewValidationError("CreateWorktreeRequest", "CheckoutOnConflict", "true", "checkout-on-conflict needs to run inside a project or worktree to fall back to").
+				WithSuggestions([]string{"Change into the worktree that should check out the branch if creation fails"})
+		}
+	}
+
+	if req.AutoStash {
+		if req.FromStash != nil {
+			return domain.NewValidationError("CreateWorktreeRequest", "AutoStash", "true", "auto-stash cannot be combined with a stash to apply: it would shift the stash indexes")
+		}
+		if req.Context.Type != domain.ContextProject && req.Context.Type != domain.ContextWorktree {
+			return domain.NewValidationError("CreateWorktreeRequest", "AutoStash", "true", "auto-stash needs to run inside a project or worktree").
+				WithSuggestions([]string{"Change into the worktree whose changes should be stashed"})
+		}
+	}
+
+	return nil
+}
+
+// checkStashExists fails when the repository has no stash@{index}
+func (s *worktreeService) checkStashExists(ctx context.Context, repoPath string, index int) error {
+	entries, err := s.gitService.GetStashList(ctx, repoPath)
+	if err != nil {
+		return domain.NewWorktreeServiceError(repoPath, "", "CreateWorktree", "failed to list stashes", err)
+	}
+	for _, entry := range entries {
+		if entry.Index == index {
+			return nil
+		}
+	}
+	return domain.NewValidationError("CreateWorktreeRequest", "FromStash", strconv.Itoa(index),
+		fmt.Sprintf("%s does not exist (%d stash entries)", domain.StashRef(index), len(entries))).
+		WithSuggestions([]string{"Run 'git stash list' to see the available entries"})
+}
+
+// autoStash stashes the uncommitted changes of the context worktree for req.AutoStash and
+// returns its path, or "" when nothing was stashed
+func (s *worktreeService) autoStash(ctx context.Context, req *domain.CreateWorktreeRequest) (string, error) {
+	if !req.AutoStash {
+		return "", nil
+	}
+	path := req.Context.Path
+
+	status, err := s.gitService.GetRepositoryStatus(ctx, path)
+	if err != nil {
+		return "", domain.NewWorktreeServiceError(path, req.BranchName, "CreateWorktree", "failed to check the current worktree for changes", err)
+	}
+	if status.IsClean {
+		return "", nil
+	}
+
+	if err := s.gitService.CreateStash(ctx, path, "auto-stash before creating "+req.BranchName); err != nil {
+		return "", domain.NewWorktreeServiceError(path, req.BranchName, "CreateWorktree", "failed to stash the changes of the current worktree; nothing was created", err)
+	}
+	return path, nil
+}
+
+// autoStashNote tells where auto-stashed changes went when creation fails after stashing
+func autoStashNote(autoStashPath string) string {
+	if autoStashPath == "" {
+		return ""
+	}
+	return " (the changes of " + autoStashPath + " are in " + domain.StashRef(0) + ")"
+}
+
+// applyStash applies req.FromStash to the new worktree and drops it when asked. Conflicts are
+// not an error: the worktree is left unmerged, the stash is kept and the files are returned.
+func (s *worktreeService) applyStash(ctx context.Context, project *domain.ProjectInfo, req *domain.CreateWorktreeRequest, worktreePath string) ([]domain.ConflictFile, error) {
+	index := *req.FromStash
+	ref := domain.StashRef(index)
+
+	err := s.gitService.ApplyStash(ctx, project.GitRepoPath, worktreePath, index)
+	if errors.Is(err, domain.ErrStashConflict) {
+		conflicts, _ := s.gitService.GetConflictingFiles(ctx, worktreePath)
+		return conflicts, nil
+	}
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(worktreePath, req.BranchName, "CreateWorktree", "worktree created but failed to apply "+ref, err)
+	}
+
+	if req.DropStash {
+		if err := s.gitService.DropStash(ctx, project.GitRepoPath, index); err != nil {
+			return nil, domain.NewWorktreeServiceError(worktreePath, req.BranchName, "CreateWorktree", ref+" applied but could not be dropped", err)
+		}
+	}
+	return nil, nil
+}
+
+func (s *worktreeService) validateDeleteRequest(req *domain.DeleteWorktreeRequest) error {
+	if req.WorktreePath == "" {
+		return domain.NewValidationError("DeleteWorktreeRequest", "WorktreePath", "", "worktree path cannot be empty")
+	}
+
+	return nil
+}
+
+func (s *worktreeService) calculateWorktreePath(projectName, branchName string) string {
+	safeProjectName := filepath.Base(projectName)
+	safeProjectName = filepath.Clean(safeProjectName)
 
 	safeBranchName := filepath.Base(branchName)
 	safeBranchName = filepath.Clean(safeBranchName)
@@ -363,6 +967,10 @@ func (s *worktreeService) findProjectByWorktree(ctx context.Context, worktreePat
 		return info, nil
 	}
 
+	if info, err := s.projectService.FindProjectByWorktreePath(ctx, worktreePath); err == nil && info != nil {
+		return info, nil
+	}
+
 	return s.findProjectByListing(ctx, worktreePath)
 }
 
@@ -479,8 +1087,18 @@ func (s *worktreeService) PruneMergedWorktrees(ctx context.Context, req *domain.
 		singleWorktreeTarget = parts[1]
 	}
 
+	cwd, _ := os.Getwd()
+	var candidates []pruneCandidate
 	for _, project := range projects {
-		s.pruneProjectWorktrees(ctx, req, project, result, singleWorktreeTarget)
+		candidates = append(candidates, s.collectPruneCandidates(ctx, req, project, result, singleWorktreeTarget, cwd)...)
+	}
+
+	if err := s.checkMaxDelete(req, len(candidates)); err != nil {
+		return nil, err
+	}
+
+	for _, candidate := range candidates {
+		s.pruneCandidateWorktree(ctx, req, candidate, result)
 	}
 
 	if len(result.DeletedWorktrees) == 1 && req.SpecificWorktree != "" {
@@ -498,17 +1116,39 @@ func (s *worktreeService) validatePruneRequest(req *domain.PruneWorktreesRequest
 	if req.SpecificWorktree != "" && req.AllProjects {
 		return domain.NewValidationError("PruneWorktreesRequest", "AllProjects", "true", "cannot use --all with specific worktree")
 	}
+	for _, pattern := range req.AdditionalProtectedPatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return domain.NewValidationError("PruneWorktreesRequest", "AdditionalProtectedPatterns", pattern, "invalid protect pattern")
+		}
+	}
+	if req.OlderThan < 0 {
+		return domain.NewValidationError("PruneWorktreesRequest", "OlderThan", req.OlderThan.String(), "duration cannot be negative")
+	}
+	if req.MaxDelete != nil && *req.MaxDelete < 0 {
+		return domain.NewValidationError("PruneWorktreesRequest", "MaxDelete", strconv.Itoa(*req.MaxDelete), "limit cannot be negative")
+	}
+	if req.ConfirmIndividually && req.Confirm == nil {
+		return domain.NewValidationError("PruneWorktreesRequest", "Confirm", "nil", "confirmation function is required with ConfirmIndividually")
+	}
 	return nil
 }
 
-func (s *worktreeService) pruneProjectWorktrees(ctx context.Context, req *domain.PruneWorktreesRequest, project *domain.ProjectInfo, result *domain.PruneWorktreesResult, singleWorktreeTarget string) {
+// pruneCandidate is a worktree that passed the filters applied before the merge check
+type pruneCandidate struct {
+	project *domain.ProjectInfo
+	wt      domain.WorktreeInfo
+	result  *domain.PruneWorktreeResult
+}
+
+// collectPruneCandidates lists the project's worktrees, records filtered-out ones as skipped
+// and returns the remaining candidates
+func (s *worktreeService) collectPruneCandidates(ctx context.Context, req *domain.PruneWorktreesRequest, project *domain.ProjectInfo, result *domain.PruneWorktreesResult, singleWorktreeTarget, cwd string) []pruneCandidate {
 	worktrees, err := s.gitService.ListWorktrees(ctx, project.GitRepoPath)
 	if err != nil {
-		return
+		return nil
 	}
 
-	cwd, _ := os.Getwd()
-
+	var candidates []pruneCandidate
 	for _, wt := range worktrees {
 		if wt.Path == project.GitRepoPath {
 			continue
@@ -526,17 +1166,51 @@ func (s *worktreeService) pruneProjectWorktrees(ctx context.Context, req *domain
 			BranchDeleted: false,
 		}
 
-		if skip := s.checkWorktreeSkip(ctx, wt, project, cwd, req); skip != nil {
+		if skip := s.checkCandidateSkip(ctx, wt, project, cwd, req); skip != nil {
 			s.mu.Lock()
 			s.addSkippedResult(result, pruneResult, skip)
 			s.mu.Unlock()
 			continue
 		}
 
+		candidates = append(candidates, pruneCandidate{project: project, wt: wt, result: pruneResult})
+	}
+	return candidates
+}
+
+// checkMaxDelete aborts the prune when there are more candidates than the configured limit.
+// The request's MaxDelete takes precedence over the max_delete_default config key.
+func (s *worktreeService) checkMaxDelete(req *domain.PruneWorktreesRequest, candidates int) error {
+	limit := req.MaxDelete
+	if limit == nil && s.config != nil && s.config.Validation.MaxDeleteDefault > 0 {
+		limit = &s.config.Validation.MaxDeleteDefault
+	}
+	if limit == nil || candidates <= *limit {
+		return nil
+	}
+
+	return domain.NewValidationError("PruneWorktreesRequest", "MaxDelete", strconv.Itoa(*limit),
+		fmt.Sprintf("%d worktrees are candidates for pruning, exceeding the limit of %d; nothing was deleted", candidates, *limit)).
+		WithSuggestions([]string{"Narrow the selection (project/branch, --older-than) or raise --max-delete"})
+}
+
+// pruneCandidateWorktree runs the merge and cleanliness checks for a candidate and deletes it,
+// asking first when ConfirmIndividually is set
+func (s *worktreeService) pruneCandidateWorktree(ctx context.Context, req *domain.PruneWorktreesRequest, candidate pruneCandidate, result *domain.PruneWorktreesResult) {
+	skip := s.checkMergeSkip(ctx, candidate.wt, candidate.project, req)
+	if skip == nil && req.ConfirmIndividually && !req.Confirm(candidate.result) {
+		skip = &worktreeSkipResult{reason: "not confirmed", category: "skipped"}
+	}
+	if skip != nil {
 		s.mu.Lock()
-		s.deleteWorktreeAndBranch(ctx, project, wt, req, pruneResult, result)
+		s.addSkippedResult(result, candidate.result, skip)
 		s.mu.Unlock()
+		return
 	}
+
+	s.mu.Lock()
+	s.deleteWorktreeAndBranch(ctx, candidate.project, candidate.wt, req, candidate.result, result)
+	s.mu.Unlock()
 }
 
 type worktreeSkipResult struct {
@@ -545,15 +1219,28 @@ type worktreeSkipResult struct {
 	category string
 }
 
-func (s *worktreeService) checkWorktreeSkip(ctx context.Context, wt domain.WorktreeInfo, project *domain.ProjectInfo, cwd string, req *domain.PruneWorktreesRequest) *worktreeSkipResult {
+// checkCandidateSkip applies the filters that run before the merge check
+func (s *worktreeService) checkCandidateSkip(ctx context.Context, wt domain.WorktreeInfo, project *domain.ProjectInfo, cwd string, req *domain.PruneWorktreesRequest) *worktreeSkipResult {
 	if cwd != "" && (strings.HasPrefix(cwd, wt.Path+string(filepath.Separator)) || cwd == wt.Path) {
 		return &worktreeSkipResult{reason: "cannot prune current worktree", category: "current"}
 	}
 
-	if s.isProtectedBranch(wt.Branch) {
+	if s.config.IsProtectedBranch(wt.Branch) || matchesProtectPattern(wt.Branch, req.AdditionalProtectedPatterns) {
 		return &worktreeSkipResult{reason: "protected branch", category: "protected"}
 	}
 
+	if req.OlderThan > 0 {
+		s.populateHeadCommit(ctx, project.GitRepoPath, &wt)
+		if !domain.FilterByAge(&req.OlderThan, nil)(&wt) {
+			return &worktreeSkipResult{reason: "updated within " + req.OlderThan.String(), category: "skipped"}
+		}
+	}
+
+	return nil
+}
+
+// checkMergeSkip checks merge status, uncommitted changes and dry-run for a candidate
+func (s *worktreeService) checkMergeSkip(ctx context.Context, wt domain.WorktreeInfo, project *domain.ProjectInfo, req *domain.PruneWorktreesRequest) *worktreeSkipResult {
 	isMerged, err := s.gitService.IsBranchMerged(ctx, project.GitRepoPath, wt.Branch)
 	if err != nil {
 		return &worktreeSkipResult{reason: "failed to check merge status", err: err, category: "skipped"}
@@ -595,7 +1282,10 @@ func (s *worktreeService) addSkippedResult(result *domain.PruneWorktreesResult,
 }
 
 func (s *worktreeService) deleteWorktreeAndBranch(ctx context.Context, project *domain.ProjectInfo, wt domain.WorktreeInfo, req *domain.PruneWorktreesRequest, pruneResult *domain.PruneWorktreeResult, result *domain.PruneWorktreesResult) {
-	err := s.gitService.DeleteWorktree(ctx, project.GitRepoPath, wt.Path, req.Force)
+	err := s.stopWorktreeProcesses(ctx, wt.Path)
+	if err == nil {
+		err = s.gitService.DeleteWorktree(ctx, project.GitRepoPath, wt.Path, req.Force)
+	}
 	if err != nil {
 		pruneResult.Error = err
 		result.SkippedWorktrees = append(result.SkippedWorktrees, pruneResult)
@@ -603,6 +1293,8 @@ func (s *worktreeService) deleteWorktreeAndBranch(ctx context.Context, project *
 		return
 	}
 
+	s.projectService.ForgetWorktreeOwner(wt.Path)
+
 	pruneResult.Deleted = true
 	result.DeletedWorktrees = append(result.DeletedWorktrees, pruneResult)
 	result.TotalDeleted++
@@ -619,10 +1311,68 @@ func (s *worktreeService) deleteWorktreeAndBranch(ctx context.Context, project *
 	}
 }
 
-func (s *worktreeService) isProtectedBranch(branchName string) bool {
-	protectedBranches := s.config.Validation.ProtectedBranches
-	for _, protected := range protectedBranches {
-		if branchName == protected {
+// stopWorktreeProcesses kills background processes tracked for the worktree. Only cancellation
+// is returned: a process that cannot be stopped must not keep the worktree from being deleted.
+func (s *worktreeService) stopWorktreeProcesses(ctx context.Context, worktreePath string) error {
+	if s.processManager == nil {
+		return nil
+	}
+	if _, err := s.processManager.Kill(ctx, worktreePath); err != nil && ctx.Err() != nil {
+		return err
+	}
+	return nil
+}
+
+// populateHeadCommit sets LastUpdated and the commit author from the worktree's HEAD commit.
+// Failures leave them zero; IsStale treats a zero LastUpdated as not stale.
+func (s *worktreeService) populateHeadCommit(ctx context.Context, repoPath string, wt *domain.WorktreeInfo) {
+	if wt.Commit == "" || !wt.LastUpdated.IsZero() {
+		return
+	}
+	commit, err := s.gitService.GetCommitInfo(ctx, repoPath, wt.Commit)
+	if err != nil || commit == nil {
+		return
+	}
+	wt.LastUpdated = commit.Date
+	wt.CommitAuthorName = commit.Author
+	wt.CommitAuthorEmail = commit.Email
+}
+
+// currentUserEmail returns the global git user.email, falling back to $GIT_AUTHOR_EMAIL
+func (s *worktreeService) currentUserEmail(ctx context.Context) (string, error) {
+	email, err := s.gitService.GetGlobalConfig(ctx, "user.email")
+	if err != nil {
+		return "", domain.NewWorktreeServiceError("", "", "ListWorktrees", "failed to read user.email", err)
+	}
+	if email == "" {
+		email = strings.TrimSpace(s.getenv("GIT_AUTHOR_EMAIL"))
+	}
+	if email == "" {
+		return "", domain.NewValidationError("ListWorktreesRequest", "OnlyMine", "",
+			"cannot determine your email: set git config --global user.email or GIT_AUTHOR_EMAIL")
+	}
+	return email, nil
+}
+
+// populateDescription sets Description from the branch description.
+// Detached worktrees and failures leave it empty.
+func (s *worktreeService) populateDescription(ctx context.Context, repoPath string, wt *domain.WorktreeInfo) {
+	if wt.IsDetached || wt.Branch == "" {
+		return
+	}
+	description, err := s.gitService.GetBranchDescription(ctx, repoPath, wt.Branch)
+	if err != nil {
+		return
+	}
+	wt.Description = description
+}
+
+// matchesProtectPattern reports whether a branch matches any of the given glob
+// patterns, compared case-insensitively with path.Match semantics
+func matchesProtectPattern(branchName string, patterns []string) bool {
+	lowerBranch := strings.ToLower(branchName)
+	for _, pattern := range patterns {
+		if matched, err := path.Match(strings.ToLower(pattern), lowerBranch); err == nil && matched {
 			return true
 		}
 	}
@@ -645,6 +1395,547 @@ func (s *worktreeService) IsBranchMerged(ctx context.Context, worktreePath strin
 	return merged, nil
 }
 
+func (s *worktreeService) LogBetween(ctx context.Context, repoPath, fromRef, toRef string) ([]domain.CommitInfo, error) {
+	commits, err := s.gitService.LogBetween(ctx, repoPath, fromRef, toRef)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(repoPath, toRef, "LogBetween", "failed to list commits", err)
+	}
+	return commits, nil
+}
+
+func (s *worktreeService) GetWorktreeTimeline(ctx context.Context, worktreePath string, limit int) (*domain.WorktreeTimeline, error) {
+	if worktreePath == "" {
+		return nil, domain.NewValidationError("GetWorktreeTimeline", "worktreePath", "", "worktree path cannot be empty")
+	}
+	if limit <= 0 {
+		return nil, domain.NewValidationError("GetWorktreeTimeline", "limit", strconv.Itoa(limit), "limit must be positive")
+	}
+
+	repoStatus, err := s.gitService.GetRepositoryStatus(ctx, worktreePath)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(worktreePath, "", "GetWorktreeTimeline", "failed to get repository status", err)
+	}
+
+	commits, err := s.gitService.GetCommitHistory(ctx, worktreePath, limit)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(worktreePath, repoStatus.Branch, "GetWorktreeTimeline", "failed to read commit history", err)
+	}
+
+	timeline := &domain.WorktreeTimeline{
+		Path:    worktreePath,
+		Branch:  repoStatus.Branch,
+		Dirty:   !repoStatus.IsClean,
+		Commits: make([]domain.TimelineEntry, 0, len(commits)),
+	}
+	for _, commit := range commits {
+		stat, err := s.gitService.GetCommitStat(ctx, worktreePath, commit.Hash)
+		if err != nil {
+			return nil, domain.NewWorktreeServiceError(worktreePath, repoStatus.Branch, "GetWorktreeTimeline", "failed to read stats of commit "+commit.ShortHash, err)
+		}
+		timeline.Commits = append(timeline.Commits, domain.TimelineEntry{CommitInfo: commit, StatusSnapshot: *stat})
+	}
+
+	return timeline, nil
+}
+
+func (s *worktreeService) CompareBranches(ctx context.Context, repoPath, base, target string) (*domain.WorktreeComparison, error) {
+	comparison, err := s.gitService.CompareWorktrees(ctx, repoPath, base, target)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(repoPath, target, "CompareBranches", "failed to compare branches", err)
+	}
+
+	comparison.OnlyInBase, err = s.gitService.LogBetween(ctx, repoPath, target, base)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(repoPath, base, "CompareBranches", "failed to list commits unique to base", err)
+	}
+
+	comparison.OnlyInTarget, err = s.gitService.LogBetween(ctx, repoPath, base, target)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(repoPath, target, "CompareBranches", "failed to list commits unique to target", err)
+	}
+
+	return comparison, nil
+}
+
+func (s *worktreeService) CopyBranchConfig(ctx context.Context, repoPath, srcBranch, dstBranch string) error {
+	if err := s.gitService.CopyBranchConfig(ctx, repoPath, srcBranch, dstBranch); err != nil {
+		return domain.NewWorktreeServiceError(repoPath, dstBranch, "CopyBranchConfig", "failed to copy branch config from "+srcBranch, err)
+	}
+	return nil
+}
+
+func (s *worktreeService) GetBranchDescription(ctx context.Context, repoPath, branch string) (string, error) {
+	description, err := s.gitService.GetBranchDescription(ctx, repoPath, branch)
+	if err != nil {
+		return "", domain.NewWorktreeServiceError(repoPath, branch, "GetBranchDescription", "failed to read branch description", err)
+	}
+	return description, nil
+}
+
+func (s *worktreeService) SetBranchDescription(ctx context.Context, repoPath, branch, description string) error {
+	if err := s.gitService.SetBranchDescription(ctx, repoPath, branch, description); err != nil {
+		return domain.NewWorktreeServiceError(repoPath, branch, "SetBranchDescription", "failed to set branch description", err)
+	}
+	return nil
+}
+
+func (s *worktreeService) VerifyWorktrees(ctx context.Context, project *domain.ProjectInfo) (*domain.WorktreeVerification, error) {
+	worktrees, err := s.gitService.ListWorktrees(ctx, project.GitRepoPath)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(project.GitRepoPath, "", "VerifyWorktrees", "failed to list worktrees", err)
+	}
+
+	worktreesDir := filepath.Join(s.config.WorktreesDirectory, project.Name)
+	discovered, err := infrastructure.FindWorktreeDirectories(worktreesDir)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(worktreesDir, "", "VerifyWorktrees", "failed to discover worktrees", err)
+	}
+
+	known := make(map[string]string, len(worktrees))
+	for _, wt := range worktrees {
+		if wt.IsBare || canonicalPath(wt.Path) == canonicalPath(project.GitRepoPath) {
+			continue
+		}
+		known[canonicalPath(wt.Path)] = wt.Path
+	}
+
+	verification := &domain.WorktreeVerification{ProjectName: project.Name, WorktreesDir: worktreesDir}
+	for _, path := range discovered {
+		key := canonicalPath(path)
+		if _, ok := known[key]; ok {
+			delete(known, key)
+			continue
+		}
+		verification.DiscoveredOnly = append(verification.DiscoveredOnly, path)
+	}
+	for _, path := range known {
+		verification.GitOnly = append(verification.GitOnly, path)
+	}
+	slices.Sort(verification.GitOnly)
+	slices.Sort(verification.DiscoveredOnly)
+
+	return verification, nil
+}
+
+func (s *worktreeService) GetWorktreeHealth(ctx context.Context, worktreePath string) (*domain.WorktreeHealth, error) {
+	if worktreePath == "" {
+		return nil, domain.NewValidationError("GetWorktreeHealth", "worktreePath", "", "worktree path cannot be empty")
+	}
+	health := &domain.WorktreeHealth{WorktreePath: worktreePath}
+	addIssue := func(issue string) {
+		health.Issues = append(health.Issues, domain.HealthIssue{WorktreePath: worktreePath, Issue: issue})
+	}
+
+	// Later checks need the directory and its git metadata
+	info, err := os.Stat(worktreePath)
+	switch {
+	case os.IsNotExist(err):
+		addIssue("directory is missing (git worktree prune removes the registration)")
+		return health, nil
+	case err != nil:
+		addIssue("cannot access directory: " + err.Error())
+		return health, nil
+	case !info.IsDir():
+		addIssue("path is not a directory")
+		return health, nil
+	}
+	if _, err := os.Lstat(filepath.Join(worktreePath, ".git")); err != nil {
+		addIssue("no .git file: the worktree is disconnected from its repository (git worktree repair)")
+		return health, nil
+	}
+	if err := s.gitService.ValidateRepository(worktreePath); err != nil {
+		addIssue("not a valid git repository: " + err.Error())
+		return health, nil
+	}
+
+	status, err := s.gitService.GetRepositoryStatus(ctx, worktreePath)
+	if err != nil {
+		addIssue("cannot read git status: " + err.Error())
+	} else {
+		health.Branch = status.Branch
+	}
+
+	conflicts, err := s.gitService.GetConflictingFiles(ctx, worktreePath)
+	switch {
+	case err != nil:
+		addIssue("cannot check for conflicts: " + err.Error())
+	case len(conflicts) > 0:
+		addIssue(fmt.Sprintf("%d file(s) with unresolved conflicts", len(conflicts)))
+	}
+	return health, nil
+}
+
+func (s *worktreeService) ExportGitConfig(ctx context.Context, project *domain.ProjectInfo) ([]*domain.WorktreeGitConfig, error) {
+	worktrees, err := s.gitService.ListWorktrees(ctx, project.GitRepoPath)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(project.GitRepoPath, "", "ExportGitConfig", "failed to list worktrees", err)
+	}
+
+	configs := make([]*domain.WorktreeGitConfig, 0, len(worktrees))
+	for _, wt := range worktrees {
+		if wt.IsBare {
+			continue
+		}
+
+		config, err := s.gitService.GetAllLocalConfig(ctx, wt.Path)
+		if err != nil {
+			return nil, domain.NewWorktreeServiceError(wt.Path, wt.Branch, "ExportGitConfig", "failed to read local config", err)
+		}
+
+		gitDir := infrastructure.ResolveWorktreeGitDir(wt.Path)
+		if gitDir == "" {
+			gitDir = filepath.Join(wt.Path, ".git")
+		}
+
+		configs = append(configs, &domain.WorktreeGitConfig{
+			Path:   wt.Path,
+			Branch: wt.Branch,
+			GitDir: gitDir,
+			Config: config,
+		})
+	}
+	return configs, nil
+}
+
+// canonicalPath resolves symlinks where possible so equivalent paths compare equal
+func canonicalPath(path string) string {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	return filepath.Clean(path)
+}
+
+func (s *worktreeService) GetConflictingFiles(ctx context.Context, worktreePath string) ([]domain.ConflictFile, error) {
+	conflicts, err := s.gitService.GetConflictingFiles(ctx, worktreePath)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(worktreePath, "", "GetConflictingFiles", "failed to list conflicting files", err)
+	}
+	return conflicts, nil
+}
+
+func (s *worktreeService) EnableCommitSigning(ctx context.Context, worktreePath string) error {
+	signingKey := s.config.Git.GPGSigningKey
+	if signingKey == "" {
+		key, err := s.gitService.GetGlobalConfig(ctx, "user.signingkey")
+		if err != nil {
+			return domain.NewWorktreeServiceError(worktreePath, "", "EnableCommitSigning", "failed to read signing key", err)
+		}
+		signingKey = key
+	}
+
+	if err := s.gitService.SetConfig(ctx, worktreePath, "commit.gpgsign", "true"); err != nil {
+		return domain.NewWorktreeServiceError(worktreePath, "", "EnableCommitSigning", "failed to enable commit signing", err)
+	}
+
+	// Without a key git signs with the committer identity
+	if signingKey != "" {
+		if err := s.gitService.SetConfig(ctx, worktreePath, "user.signingkey", signingKey); err != nil {
+			return domain.NewWorktreeServiceError(worktreePath, "", "EnableCommitSigning", "failed to set signing key", err)
+		}
+	}
+	return nil
+}
+
+func (s *worktreeService) SetMergeStrategy(ctx context.Context, worktreePath, branch string, strategy domain.MergeStrategy) error {
+	key, value, ok := strategy.BranchConfig(branch)
+	if !ok {
+		return nil
+	}
+	if err := s.gitService.SetConfig(ctx, worktreePath, key, value); err != nil {
+		return domain.NewWorktreeServiceError(worktreePath, branch, "SetMergeStrategy", "failed to set "+string(strategy)+" merge strategy", err)
+	}
+	return nil
+}
+
+// ValidateHooks returns the hooks directory of the repository and the hooks git cannot run
+func (s *worktreeService) ValidateHooks(ctx context.Context, repoPath string) (string, []domain.HookIssue, error) {
+	dir, err := s.gitService.GetHooksDir(ctx, repoPath)
+	if err != nil {
+		return "", nil, domain.NewWorktreeServiceError(repoPath, "", "ValidateHooks", "failed to locate hooks directory", err)
+	}
+	return dir, s.gitService.ValidateHooks(ctx, repoPath), nil
+}
+
+// DeleteRemoteBranch deletes branch on remote; domain.ErrRemoteBranchNotFound stays matchable
+func (s *worktreeService) DeleteRemoteBranch(ctx context.Context, repoPath, remote, branch string) error {
+	if err := s.gitService.DeleteRemoteBranch(ctx, repoPath, remote, branch); err != nil {
+		return domain.NewWorktreeServiceError(repoPath, branch, "DeleteRemoteBranch", "failed to delete "+remote+"/"+branch, err)
+	}
+	return nil
+}
+
+// FetchTagsOnly refreshes the tags of remote without fetching branches
+func (s *worktreeService) FetchTagsOnly(ctx context.Context, repoPath, remote string) error {
+	if err := s.gitService.FetchTagsOnly(ctx, repoPath, remote); err != nil {
+		return domain.NewWorktreeServiceError(repoPath, "", "FetchTagsOnly", "failed to fetch tags from "+remote, err)
+	}
+	return nil
+}
+
+// FetchAllRemotes refreshes the remote-tracking branches of every remote
+func (s *worktreeService) FetchAllRemotes(ctx context.Context, repoPath string) error {
+	if err := s.gitService.FetchAllRemotes(ctx, repoPath); err != nil {
+		return domain.NewWorktreeServiceError(repoPath, "", "FetchAllRemotes", "failed to fetch remotes", err)
+	}
+	return nil
+}
+
+// SyncWorktree pulls the upstream of a worktree's branch unless the worktree has uncommitted
+// changes or a detached HEAD. Every problem is reported in the result rather than returned.
+func (s *worktreeService) SyncWorktree(ctx context.Context, worktree *domain.WorktreeInfo, rebase bool) *domain.SyncResult {
+	result := &domain.SyncResult{Worktree: worktree}
+	if err := ctx.Err(); err != nil {
+		result.Outcome, result.Err = domain.SyncCancelled, err
+		return result
+	}
+
+	if worktree.IsDetached {
+		result.Outcome = domain.SyncSkipped
+		result.Err = domain.NewWorktreeServiceError(worktree.Path, "", "SyncWorktree", "HEAD is detached", nil)
+		return result
+	}
+
+	status, err := s.gitService.GetRepositoryStatus(ctx, worktree.Path)
+	if err != nil {
+		result.Outcome = domain.SyncFailed
+		result.Err = domain.NewWorktreeServiceError(worktree.Path, worktree.Branch, "SyncWorktree", "failed to get repository status", err)
+		return result
+	}
+	if !status.IsClean {
+		result.Outcome = domain.SyncSkipped
+		result.Err = domain.NewWorktreeServiceError(worktree.Path, worktree.Branch, "SyncWorktree", "worktree has uncommitted changes", nil)
+		return result
+	}
+
+	commits, err := s.gitService.Pull(ctx, worktree.Path, rebase)
+	switch {
+	case ctx.Err() != nil:
+		result.Outcome, result.Err = domain.SyncInterrupted, err
+	case errors.Is(err, domain.ErrConflict):
+		result.Outcome, result.Err = domain.SyncConflict, err
+	case err != nil:
+		result.Outcome = domain.SyncFailed
+		result.Err = domain.NewWorktreeServiceError(worktree.Path, worktree.Branch, "SyncWorktree", "failed to pull", err)
+	case commits == 0:
+		result.Outcome = domain.SyncUpToDate
+	default:
+		result.Outcome, result.Commits = domain.SyncUpdated, commits
+	}
+	return result
+}
+
+// CheckRepositoryIntegrity runs git fsck on the repository
+func (s *worktreeService) CheckRepositoryIntegrity(ctx context.Context, repoPath string) error {
+	if err := s.gitService.Fsck(ctx, repoPath); err != nil {
+		return domain.NewWorktreeServiceError(repoPath, "", "CheckRepositoryIntegrity", "repository objects are corrupt or missing", err)
+	}
+	return nil
+}
+
+// FetchBranch refreshes <remote>/<branch> without touching the local branch
+func (s *worktreeService) FetchBranch(ctx context.Context, repoPath, remote, branch string) error {
+	if err := s.gitService.FetchBranch(ctx, repoPath, remote, branch); err != nil {
+		return domain.NewWorktreeServiceError(repoPath, branch, "FetchBranch", "failed to fetch "+branch+" from "+remote, err)
+	}
+	return nil
+}
+
+// PruneTags removes local tags that were deleted on the remote
+func (s *worktreeService) PruneTags(ctx context.Context, repoPath string) error {
+	if err := s.gitService.PruneTags(ctx, repoPath); err != nil {
+		return domain.NewWorktreeServiceError(repoPath, "", "PruneTags", "failed to prune tags", err)
+	}
+	return nil
+}
+
+// RenameWorktree renames the branch of a linked worktree with git branch -m, then moves the
+// worktree to <worktrees_dir>/<project>/<new branch> with git worktree move, which updates
+// the links between the worktree and the repository. Branch config (upstream, description)
+// moves with the branch. The branch is renamed back when the move fails.
+func (s *worktreeService) RenameWorktree(ctx context.Context, req *domain.RenameWorktreeRequest) (*domain.RenameWorktreeResult, error) {
+	if req.WorktreePath == "" {
+		return nil, domain.NewValidationError("RenameWorktreeRequest", "WorktreePath", "", "worktree path cannot be empty")
+	}
+	if result := domain.ValidateBranchName(req.NewBranch); result.IsError() {
+		return nil, result.Error
+	}
+
+	project, err := s.findProjectByWorktree(ctx, req.WorktreePath)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(req.WorktreePath, req.NewBranch, "RenameWorktree", "failed to find project for worktree", err)
+	}
+	if samePath(req.WorktreePath, project.Path) || samePath(req.WorktreePath, project.GitRepoPath) {
+		return nil, domain.NewValidationError("RenameWorktreeRequest", "WorktreePath", req.WorktreePath, "cannot rename the main worktree of a project")
+	}
+
+	wt, err := s.GetWorktreeByPath(ctx, project.GitRepoPath, req.WorktreePath)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(req.WorktreePath, req.NewBranch, "RenameWorktree", "failed to find worktree", err)
+	}
+	if wt.IsDetached {
+		return nil, domain.NewValidationError("RenameWorktreeRequest", "WorktreePath", req.WorktreePath, "worktree has no branch checked out (detached HEAD)")
+	}
+	if result := domain.ValidateBranchName(wt.Branch); result.IsError() {
+		return nil, result.Error
+	}
+	if wt.Branch == req.NewBranch {
+		return nil, domain.NewValidationError("RenameWorktreeRequest", "NewBranch", req.NewBranch, "worktree is already on this branch")
+	}
+
+	exists, err := s.gitService.BranchExists(ctx, project.GitRepoPath, req.NewBranch)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(req.WorktreePath, req.NewBranch, "RenameWorktree", "failed to check branch", err)
+	}
+	if exists {
+		return nil, domain.NewConflictError("branch", req.NewBranch, "RenameWorktree", "branch already exists", nil)
+	}
+
+	newPath := s.calculateWorktreePath(project.Name, req.NewBranch)
+	if _, err := os.Lstat(newPath); err == nil {
+		return nil, domain.NewConflictError("worktree", req.NewBranch, "RenameWorktree", "path already exists: "+newPath, nil)
+	}
+
+	if !req.Force {
+		status, err := s.gitService.GetRepositoryStatus(ctx, req.WorktreePath)
+		if err != nil {
+			return nil, domain.NewWorktreeServiceError(req.WorktreePath, wt.Branch, "RenameWorktree", "failed to check worktree status", err)
+		}
+		if !status.IsClean {
+			return nil, domain.NewWorktreeServiceError(req.WorktreePath, wt.Branch, "RenameWorktree", "worktree has uncommitted changes (use force to rename anyway)", nil)
+		}
+	}
+
+	if err := s.gitService.RenameBranch(ctx, project.GitRepoPath, wt.Branch, req.NewBranch); err != nil {
+		return nil, domain.NewWorktreeServiceError(req.WorktreePath, wt.Branch, "RenameWorktree", "failed to rename branch", err)
+	}
+	if err := s.gitService.MoveWorktree(ctx, project.GitRepoPath, req.WorktreePath, newPath); err != nil {
+		if undoErr := s.gitService.RenameBranch(ctx, project.GitRepoPath, req.NewBranch, wt.Branch); undoErr != nil {
+			return nil, domain.NewWorktreeServiceError(req.WorktreePath, req.NewBranch, "RenameWorktree",
+				"failed to move worktree, and the branch could not be renamed back to "+wt.Branch, err)
+		}
+		return nil, domain.NewWorktreeServiceError(req.WorktreePath, wt.Branch, "RenameWorktree", "failed to move worktree; the branch was not renamed", err)
+	}
+
+	// Symlinks next to the worktree (e.g. a "current" link) follow the move
+	if _, err := infrastructure.RetargetSymlinks(filepath.Dir(newPath), req.WorktreePath, newPath); err != nil {
+		return nil, domain.NewWorktreeServiceError(newPath, req.NewBranch, "RenameWorktree", "worktree renamed but symlinks to it could not be updated", err)
+	}
+
+	return &domain.RenameWorktreeResult{
+		OldBranch: wt.Branch,
+		NewBranch: req.NewBranch,
+		OldPath:   req.WorktreePath,
+		NewPath:   newPath,
+	}, nil
+}
+
+// CloneAndCreate clones the repository into the projects directory and creates its first worktree
+func (s *worktreeService) CloneAndCreate(ctx context.Context, req *domain.CloneCreateRequest) (*domain.CloneCreateResult, error) {
+	if err := domain.ValidateCloneURL(req.RemoteURL); err != nil {
+		return nil, err
+	}
+	if result := domain.ValidateBranchName(req.BranchName); result.IsError() {
+		return nil, result.Error
+	}
+	projectName := req.ProjectName
+	if projectName == "" {
+		projectName = domain.ProjectNameFromURL(req.RemoteURL)
+	}
+	if result := domain.ValidateProjectName(projectName); result.IsError() {
+		return nil, result.Error
+	}
+
+	projectPath := filepath.Join(s.config.ProjectsDirectory, projectName)
+	if _, err := os.Lstat(projectPath); err == nil {
+		return nil, domain.NewConflictError("project", projectName, "CloneAndCreate", "path already exists: "+projectPath, nil)
+	}
+	if err := s.gitService.Clone(ctx, req.RemoteURL, projectPath, nil); err != nil {
+		return nil, domain.NewWorktreeServiceError(projectPath, req.BranchName, "CloneAndCreate", "failed to clone "+req.RemoteURL, err)
+	}
+
+	// The clone is kept when the worktree fails: the user can retry with create
+	status, err := s.gitService.GetRepositoryStatus(ctx, projectPath)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(projectPath, req.BranchName, "CloneAndCreate", "cloned, but failed to read the default branch", err)
+	}
+	created, err := s.CreateWorktree(ctx, &domain.CreateWorktreeRequest{
+		ProjectName:  projectName,
+		BranchName:   req.BranchName,
+		SourceBranch: status.Branch,
+		Context:      &domain.Context{Type: domain.ContextOutsideGit},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cloned %s to %s, but failed to create the worktree: %w", req.RemoteURL, projectPath, err)
+	}
+
+	return &domain.CloneCreateResult{
+		ProjectName:   projectName,
+		ProjectPath:   projectPath,
+		DefaultBranch: status.Branch,
+		Created:       created,
+	}, nil
+}
+
+// ListStashes lists the stash entries of a worktree, newest first
+func (s *worktreeService) ListStashes(ctx context.Context, worktreePath string) ([]domain.StashEntry, error) {
+	entries, err := s.gitService.GetStashList(ctx, worktreePath)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(worktreePath, "", "ListStashes", "failed to list stashes", err)
+	}
+	return entries, nil
+}
+
+// PushStash stashes the uncommitted changes of a worktree, untracked files included
+func (s *worktreeService) PushStash(ctx context.Context, worktreePath, message string) error {
+	if err := s.gitService.CreateStash(ctx, worktreePath, message); err != nil {
+		return domain.NewWorktreeServiceError(worktreePath, "", "PushStash", "failed to stash changes", err)
+	}
+	return nil
+}
+
+// PopStash applies stash@{index} to a worktree and drops it unless applying it left conflicts
+func (s *worktreeService) PopStash(ctx context.Context, worktreePath string, index int) ([]domain.ConflictFile, error) {
+	ref := domain.StashRef(index)
+
+	err := s.gitService.ApplyStash(ctx, worktreePath, worktreePath, index)
+	if errors.Is(err, domain.ErrStashConflict) {
+		conflicts, _ := s.gitService.GetConflictingFiles(ctx, worktreePath)
+		return conflicts, nil
+	}
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(worktreePath, "", "PopStash", "failed to apply "+ref, err)
+	}
+
+	if err := s.gitService.DropStash(ctx, worktreePath, index); err != nil {
+		return nil, domain.NewWorktreeServiceError(worktreePath, "", "PopStash", ref+" applied but could not be dropped", err)
+	}
+	return nil, nil
+}
+
+// DropStash removes stash@{index}
+func (s *worktreeService) DropStash(ctx context.Context, worktreePath string, index int) error {
+	if err := s.gitService.DropStash(ctx, worktreePath, index); err != nil {
+		return domain.NewWorktreeServiceError(worktreePath, "", "DropStash", "failed to drop "+domain.StashRef(index), err)
+	}
+	return nil
+}
+
+// LockWorktree locks the worktree with an optional reason
+func (s *worktreeService) LockWorktree(ctx context.Context, repoPath, worktreePath, reason string) error {
+	if err := s.gitService.LockWorktree(ctx, repoPath, worktreePath, reason); err != nil {
+		return domain.NewWorktreeServiceError(worktreePath, "", "LockWorktree", "failed to lock worktree", err)
+	}
+	return nil
+}
+
+// UnlockWorktree unlocks the worktree
+func (s *worktreeService) UnlockWorktree(ctx context.Context, repoPath, worktreePath string) error {
+	if err := s.gitService.UnlockWorktree(ctx, repoPath, worktreePath); err != nil {
+		return domain.NewWorktreeServiceError(worktreePath, "", "UnlockWorktree", "failed to unlock worktree", err)
+	}
+	return nil
+}
+
 func (s *worktreeService) GetWorktreeByPath(ctx context.Context, projectPath, worktreePath string) (*domain.WorktreeInfo, error) {
 	worktrees, err := s.gitService.ListWorktrees(ctx, projectPath)
 	if err != nil {
@@ -3,8 +3,13 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -33,6 +38,9 @@ func configureWorktreeServiceMocks(gitService *mocks.MockGitService, projectServ
 
 	projectService.On("ValidateProject", mock.Anything, mock.AnythingOfType("string")).Return(nil).Maybe()
 
+	projectService.On("FindProjectByWorktreePath", mock.Anything, mock.AnythingOfType("string")).Return(nil, errors.New("not found")).Maybe()
+	projectService.On("ForgetWorktreeOwner", mock.AnythingOfType("string")).Maybe()
+
 	worktrees := []domain.WorktreeInfo{
 		{
 			Path:   "/path/to/worktree",
@@ -50,6 +58,7 @@ func configureWorktreeServiceMocks(gitService *mocks.MockGitService, projectServ
 	gitService.MockCLIClient.On("DeleteBranch", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil).Maybe()
 	gitService.MockGoGitClient.On("ValidateRepository", mock.AnythingOfType("string")).Return(nil).Maybe()
 	gitService.MockGoGitClient.On("BranchExists", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(false, nil).Maybe()
+	gitService.MockCLIClient.On("GetBranchDescription", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return("", nil).Maybe()
 
 	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, mock.AnythingOfType("string")).Return(domain.RepositoryStatus{
 		IsClean:   true,
@@ -81,7 +90,7 @@ func setupWorktreeService() (application.WorktreeService, *mocks.MockGitService,
 		},
 	}
 	configureWorktreeServiceMocks(gitService, projectService, testProject)
-	service := NewWorktreeService(gitService, projectService, config, nil)
+	service := NewWorktreeService(gitService, projectService, config, nil, nil)
 
 	return service, gitService, projectService, config
 }
@@ -154,617 +163,2893 @@ func TestWorktreeService_CreateWorktree(t *testing.T) {
 	}
 }
 
-func TestWorktreeService_DeleteWorktree(t *testing.T) {
-	service, _, _, _ := setupWorktreeService()
+func TestWorktreeService_CreateWorktree_WorktreeOnly(t *testing.T) {
+	service, gitService, _, config := setupWorktreeService()
+	config.WorktreesDirectory = t.TempDir()
+	expectedPath := filepath.Join(config.WorktreesDirectory, "test-project", "scratch")
 
-	tests := []struct {
-		name         string
-		request      *domain.DeleteWorktreeRequest
-		expectError  bool
-		errorMessage string
-	}{
-		{
-			name: "valid worktree deletion",
-			request: &domain.DeleteWorktreeRequest{
-				WorktreePath: "/path/to/worktree",
-				Force:        false,
-				Context: &domain.Context{
-					Type: domain.ContextWorktree,
-				},
-			},
-			expectError: false,
-		},
-		{
-			name: "empty worktree path",
-			request: &domain.DeleteWorktreeRequest{
-				WorktreePath: "",
-				Force:        false,
-				Context: &domain.Context{
-					Type: domain.ContextOutsideGit,
-				},
-			},
-			expectError:  true,
-			errorMessage: "worktree path cannot be empty",
-		},
-	}
+	gitService.MockCLIClient.On("InitBareWorktree", mock.Anything, "/path/to/project/.git", expectedPath).Return(nil)
 
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			err := service.DeleteWorktree(context.Background(), tc.request)
+	result, err := service.CreateWorktree(context.Background(), &domain.CreateWorktreeRequest{
+		ProjectName:  "test-project",
+		BranchName:   "scratch",
+		SourceBranch: "main",
+		Context:      &domain.Context{Type: domain.ContextProject, ProjectName: "test-project"},
+		WorktreeOnly: true,
+	})
 
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.errorMessage)
-			} else {
-				require.NoError(t, err)
-			}
-		})
-	}
+	require.NoError(t, err)
+	assert.Equal(t, expectedPath, result.Worktree.Path)
+	assert.Equal(t, "(detached)", result.Worktree.Branch)
+	assert.Empty(t, result.Worktree.Commit)
+	assert.True(t, result.Worktree.IsDetached)
+	assert.Nil(t, result.HookResult)
+	gitService.MockCLIClient.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
-func TestWorktreeService_DeleteWorktree_Idempotent(t *testing.T) {
-	t.Run("non-existent worktree should succeed", func(t *testing.T) {
-		service, _, _, _ := setupWorktreeService()
-		request := &domain.DeleteWorktreeRequest{
-			WorktreePath: "/non/existent/worktree",
-			Force:        false,
-			Context: &domain.Context{
-				Type: domain.ContextOutsideGit,
-			},
+func TestWorktreeService_CreateWorktree_ExistingLocalBranch(t *testing.T) {
+	setup := func(t *testing.T) (application.WorktreeService, *mocks.MockGitService, string) {
+		t.Helper()
+		gitService := mocks.NewMockGitService()
+		projectService := mocks.NewMockProjectService()
+		gitService.MockGoGitClient.On("BranchExists", mock.Anything, "/path/to/project/.git", "existing").Return(true, nil)
+		gitService.MockCLIClient.On("GetBranchDescription", mock.Anything, "/path/to/project/.git", "existing").Return("Payment retries", nil).Maybe()
+		configureWorktreeServiceMocks(gitService, projectService, &domain.ProjectInfo{
+			Name: "test-project", Path: "/path/to/project", GitRepoPath: "/path/to/project/.git",
+		})
+		config := domain.DefaultConfig()
+		config.WorktreesDirectory = t.TempDir()
+		return NewWorktreeService(gitService, projectService, config, nil, nil), gitService,
+			filepath.Join(config.WorktreesDirectory, "test-project", "existing")
+	}
+	request := func(explicitSource, useLocal bool) *domain.CreateWorktreeRequest {
+		return &domain.CreateWorktreeRequest{
+			ProjectName:    "test-project",
+			BranchName:     "existing",
+			SourceBranch:   "main",
+			Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project"},
+			ExplicitSource: explicitSource,
+			UseLocalBranch: useLocal,
 		}
+	}
 
-		err := service.DeleteWorktree(context.Background(), request)
-		assert.NoError(t, err, "DeleteWorktree should be idempotent and succeed for non-existent worktrees")
+	t.Run("checked out by default", func(t *testing.T) {
+		service, gitService, expectedPath := setup(t)
+
+		result, err := service.CreateWorktree(context.Background(), request(false, false))
+
+		require.NoError(t, err)
+		assert.Equal(t, "existing", result.Worktree.Branch)
+		gitService.MockCLIClient.AssertCalled(t, "CreateWorktree", mock.Anything, "/path/to/project/.git", "existing", "main", expectedPath)
 	})
-}
 
-func TestWorktreeService_ListWorktrees(t *testing.T) {
-	service, _, _, _ := setupWorktreeService()
+	t.Run("explicit source refused without use local branch", func(t *testing.T) {
+		service, gitService, _ := setup(t)
 
-	tests := []struct {
-		name        string
-		request     *domain.ListWorktreesRequest
-		expectError bool
-	}{
-		{
-			name: "valid worktree listing",
-			request: &domain.ListWorktreesRequest{
-				ProjectName: "test-project",
-				Context: &domain.Context{
-					Type:        domain.ContextProject,
-					ProjectName: "test-project",
-				},
-				IncludeMain: true,
-			},
-			expectError: false,
-		},
-	}
+		_, err := service.CreateWorktree(context.Background(), request(true, false))
 
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			result, err := service.ListWorktrees(context.Background(), tc.request)
+		require.Error(t, err)
+		var conflictErr *domain.ConflictError
+		require.ErrorAs(t, err, &conflictErr)
+		assert.Contains(t, err.Error(), "source main would be ignored")
+		assert.Contains(t, err.Error(), "--use-local-branch")
+		gitService.MockCLIClient.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
 
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Nil(t, result)
-			} else {
-				require.NoError(t, err)
-				assert.NotNil(t, result)
-			}
+	t.Run("explicit source checked out with use local branch", func(t *testing.T) {
+		service, gitService, expectedPath := setup(t)
+
+		result, err := service.CreateWorktree(context.Background(), request(true, true))
+
+		require.NoError(t, err)
+		assert.Equal(t, "existing", result.Worktree.Branch)
+		assert.Equal(t, "Payment retries", result.Worktree.Description)
+		gitService.MockCLIClient.AssertCalled(t, "CreateWorktree", mock.Anything, "/path/to/project/.git", "existing", "main", expectedPath)
+	})
+}
+
+func TestWorktreeService_CreateWorktree_FromWorktree(t *testing.T) {
+	setup := func(t *testing.T, clean bool) (application.WorktreeService, *mocks.MockGitService, string) {
+		t.Helper()
+		gitService := mocks.NewMockGitService()
+		projectService := mocks.NewMockProjectService()
+		gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/path/to/worktree").Return(domain.RepositoryStatus{IsClean: clean}, nil)
+		gitService.MockGoGitClient.On("BranchExists", mock.Anything, "/path/to/project/.git", "existing").Return(true, nil)
+		gitService.MockCLIClient.On("SetBranchDescription", mock.Anything, "/path/to/project/.git", "feature-alt", "Forked from feature-branch").Return(nil)
+		configureWorktreeServiceMocks(gitService, projectService, &domain.ProjectInfo{
+			Name: "test-project", Path: "/path/to/project", GitRepoPath: "/path/to/project/.git",
 		})
+		config := domain.DefaultConfig()
+		config.WorktreesDirectory = t.TempDir()
+		return NewWorktreeService(gitService, projectService, config, nil, nil), gitService,
+			filepath.Join(config.WorktreesDirectory, "test-project", "feature-alt")
+	}
+	request := func(branch, from string, force bool) *domain.CreateWorktreeRequest {
+		return &domain.CreateWorktreeRequest{
+			ProjectName:  "test-project",
+			BranchName:   branch,
+			SourceBranch: from,
+			Context:      &domain.Context{Type: domain.ContextProject, ProjectName: "test-project"},
+			Force:        force,
+			FromWorktree: from,
+		}
 	}
-}
 
-func TestWorktreeService_GetWorktreeStatus(t *testing.T) {
-	service, _, _, _ := setupWorktreeService()
+	t.Run("forks at the worktree HEAD commit", func(t *testing.T) {
+		service, gitService, expectedPath := setup(t, true)
 
-	tests := []struct {
-		name         string
-		worktreePath string
-		expectError  bool
-		errorMessage string
-	}{
-		{
-			name:         "valid worktree status",
-			worktreePath: "/path/to/worktree",
-			expectError:  false,
-		},
-		{
-			name:         "empty worktree path",
-			worktreePath: "",
-			expectError:  true,
-			errorMessage: "worktree path cannot be empty",
-		},
-	}
+		result, err := service.CreateWorktree(context.Background(), request("feature-alt", "feature-branch", false))
 
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			result, err := service.GetWorktreeStatus(context.Background(), tc.worktreePath)
+		require.NoError(t, err)
+		assert.Equal(t, "Forked from feature-branch", result.Worktree.Description)
+		gitService.MockCLIClient.AssertCalled(t, "CreateWorktree", mock.Anything, "/path/to/project/.git", "feature-alt", "abc123", expectedPath)
+		gitService.MockCLIClient.AssertCalled(t, "SetBranchDescription", mock.Anything, "/path/to/project/.git", "feature-alt", "Forked from feature-branch")
+	})
 
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.errorMessage)
-				assert.Nil(t, result)
-			} else {
-				require.NoError(t, err)
-				assert.NotNil(t, result)
-			}
-		})
-	}
-}
+	t.Run("dirty worktree refused without force", func(t *testing.T) {
+		service, gitService, _ := setup(t, false)
 
-func TestWorktreeService_ValidateWorktree(t *testing.T) {
-	service, _, _, _ := setupWorktreeService()
+		_, err := service.CreateWorktree(context.Background(), request("feature-alt", "feature-branch", false))
 
-	tests := []struct {
-		name         string
-		worktreePath string
-		expectError  bool
-		errorMessage string
-	}{
-		{
-			name:         "valid worktree validation",
-			worktreePath: "/path/to/worktree",
-			expectError:  false,
-		},
-		{
-			name:         "empty worktree path",
-			worktreePath: "",
-			expectError:  true,
-			errorMessage: "worktree path cannot be empty",
-		},
-	}
+		require.Error(t, err)
+		var conflictErr *domain.ConflictError
+		require.ErrorAs(t, err, &conflictErr)
+		assert.Contains(t, err.Error(), "--force")
+		gitService.MockCLIClient.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
 
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			err := service.ValidateWorktree(context.Background(), tc.worktreePath)
+	t.Run("dirty worktree forked with force", func(t *testing.T) {
+		service, gitService, expectedPath := setup(t, false)
 
-			if tc.expectError {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tc.errorMessage)
-			} else {
-				require.NoError(t, err)
-			}
-		})
-	}
-}
+		_, err := service.CreateWorktree(context.Background(), request("feature-alt", "feature-branch", true))
 
-func TestWorktreeService_PruneMergedWorktrees_DryRun(t *testing.T) {
-	service, gitService, _, _ := setupWorktreeService()
+		require.NoError(t, err)
+		gitService.MockCLIClient.AssertCalled(t, "CreateWorktree", mock.Anything, "/path/to/project/.git", "feature-alt", "abc123", expectedPath)
+		gitService.MockGoGitClient.AssertNotCalled(t, "GetRepositoryStatus", mock.Anything, "/path/to/worktree")
+	})
 
-	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(true, nil)
+	t.Run("branch without a worktree", func(t *testing.T) {
+		service, _, _ := setup(t, true)
 
-	req := &domain.PruneWorktreesRequest{
-		Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
-		DryRun:         true,
-		Force:          false,
-		DeleteBranches: false,
-	}
+		_, err := service.CreateWorktree(context.Background(), request("feature-alt", "nowhere", false))
 
-	result, err := service.PruneMergedWorktrees(context.Background(), req)
-	require.NoError(t, err)
-	assert.NotNil(t, result)
-	assert.Equal(t, 0, result.TotalDeleted)
-}
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no worktree is checked out on this branch")
+	})
 
-func TestWorktreeService_PruneMergedWorktrees_ProtectedBranch(t *testing.T) {
-	service, gitService, _, _ := setupWorktreeService()
+	t.Run("existing target branch", func(t *testing.T) {
+		service, _, _ := setup(t, true)
 
-	gitService.MockCLIClient.ExpectedCalls = nil
-	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
-		{Path: "/path/to/worktree-main", Branch: "main", Commit: "abc123"},
-	}, nil).Once()
-	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(true, nil).Maybe()
+		_, err := service.CreateWorktree(context.Background(), request("existing", "feature-branch", false))
 
-	req := &domain.PruneWorktreesRequest{
-		Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
-		DryRun:         false,
-		Force:          true,
-		DeleteBranches: true,
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--from-worktree needs a new branch")
+	})
+}
+
+func TestWorktreeService_CreateWorktree_SourceRef(t *testing.T) {
+	setup := func(t *testing.T) (application.WorktreeService, *mocks.MockGitService, string) {
+		t.Helper()
+		gitService := mocks.NewMockGitService()
+		projectService := mocks.NewMockProjectService()
+		gitService.MockGoGitClient.On("BranchExists", mock.Anything, "/path/to/project/.git", "existing").Return(true, nil)
+		gitService.MockGoGitClient.On("ResolveRevision", mock.Anything, "/path/to/project/.git", "v1.2.0").Return("0123456789abcdef0123456789abcdef01234567", nil)
+		gitService.MockGoGitClient.On("ResolveRevision", mock.Anything, "/path/to/project/.git", "v1.2.0^{}").
+			Return("", domain.NewGitRepositoryError("/path/to/project/.git", "failed to resolve revision v1.2.0^{}", nil))
+		configureWorktreeServiceMocks(gitService, projectService, &domain.ProjectInfo{
+			Name: "test-project", Path: "/path/to/project", GitRepoPath: "/path/to/project/.git",
+		})
+		config := domain.DefaultConfig()
+		config.WorktreesDirectory = t.TempDir()
+		return NewWorktreeService(gitService, projectService, config, nil, nil), gitService,
+			filepath.Join(config.WorktreesDirectory, "test-project", "release")
+	}
+	request := func(branch, ref string) *domain.CreateWorktreeRequest {
+		return &domain.CreateWorktreeRequest{
+			ProjectName:  "test-project",
+			BranchName:   branch,
+			SourceBranch: ref,
+			SourceRef:    ref,
+			Context:      &domain.Context{Type: domain.ContextProject, ProjectName: "test-project"},
+		}
 	}
 
-	result, err := service.PruneMergedWorktrees(context.Background(), req)
-	require.NoError(t, err)
-	assert.NotNil(t, result)
-	assert.Equal(t, 0, result.TotalDeleted)
-	assert.Len(t, result.ProtectedSkipped, 1)
-}
+	t.Run("branches at the resolved commit", func(t *testing.T) {
+		service, gitService, expectedPath := setup(t)
 
-func TestWorktreeService_PruneMergedWorktrees_UnmergedBranch(t *testing.T) {
-	service, gitService, _, _ := setupWorktreeService()
+		_, err := service.CreateWorktree(context.Background(), request("release", "v1.2.0"))
 
-	gitService.MockCLIClient.ExpectedCalls = nil
-	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
-		{Path: "/path/to/worktree-feature", Branch: "feature-unmerged", Commit: "abc123"},
-	}, nil)
-	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-unmerged").Return(false, nil)
+		require.NoError(t, err)
+		gitService.MockCLIClient.AssertCalled(t, "CreateWorktree", mock.Anything, "/path/to/project/.git", "release", "0123456789abcdef0123456789abcdef01234567", expectedPath)
+	})
 
-	req := &domain.PruneWorktreesRequest{
-		Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
-		DryRun:         false,
-		Force:          false,
-		DeleteBranches: false,
-	}
+	t.Run("unresolved ref is passed to git", func(t *testing.T) {
+		service, gitService, expectedPath := setup(t)
 
-	result, err := service.PruneMergedWorktrees(context.Background(), req)
-	require.NoError(t, err)
-	assert.NotNil(t, result)
-	assert.Equal(t, 0, result.TotalDeleted)
-	assert.Len(t, result.UnmergedSkipped, 1)
-}
+		_, err := service.CreateWorktree(context.Background(), request("release", "v1.2.0^{}"))
 
-func TestWorktreeService_PruneMergedWorktrees_InvalidRequest(t *testing.T) {
-	service, _, _, _ := setupWorktreeService()
+		require.NoError(t, err)
+		gitService.MockCLIClient.AssertCalled(t, "CreateWorktree", mock.Anything, "/path/to/project/.git", "release", "v1.2.0^{}", expectedPath)
+	})
 
-	req := &domain.PruneWorktreesRequest{
-		AllProjects:      true,
-		SpecificWorktree: "project/branch",
-	}
+	t.Run("existing target branch", func(t *testing.T) {
+		service, _, _ := setup(t)
 
-	result, err := service.PruneMergedWorktrees(context.Background(), req)
-	require.Error(t, err)
-	assert.Contains(t, err.Error(), "cannot use --all with specific worktree")
-	assert.Nil(t, result)
-}
+		_, err := service.CreateWorktree(context.Background(), request("existing", "v1.2.0"))
 
-func TestWorktreeService_PruneMergedWorktrees_ForceFlag(t *testing.T) {
-	service, gitService, _, _ := setupWorktreeService()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--from needs a new branch")
+	})
 
-	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
-		{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
-	}, nil).Once()
-	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
-	gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), true).Return(nil).Once()
+	t.Run("invalid branch name", func(t *testing.T) {
+		service, gitService, _ := setup(t)
 
-	req := &domain.PruneWorktreesRequest{
-		Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
-		DryRun:         false,
-		Force:          true,
-		DeleteBranches: false,
-	}
+		_, err := service.CreateWorktree(context.Background(), request(".hidden", "v1.2.0"))
 
-	result, err := service.PruneMergedWorktrees(context.Background(), req)
-	require.NoError(t, err)
-	assert.NotNil(t, result)
-	assert.Equal(t, 1, result.TotalDeleted)
-}
+		require.Error(t, err)
+		gitService.MockGoGitClient.AssertNotCalled(t, "ResolveRevision", mock.Anything, mock.Anything, mock.Anything)
+	})
 
-func TestWorktreeService_PruneMergedWorktrees_SingleWorktree(t *testing.T) {
-	service, gitService, _, _ := setupWorktreeService()
+	t.Run("rejected with worktree only", func(t *testing.T) {
+		service, _, _ := setup(t)
+		req := request("release", "v1.2.0")
+		req.WorktreeOnly = true
 
-	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
-		{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
-	}, nil).Once()
-	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
-	gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil).Once()
+		_, err := service.CreateWorktree(context.Background(), req)
 
-	req := &domain.PruneWorktreesRequest{
-		Context:          &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
-		DryRun:           false,
-		Force:            false,
-		DeleteBranches:   false,
-		SpecificWorktree: "test-project/feature-branch",
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "nothing is checked out with WorktreeOnly")
+	})
+}
+
+func TestWorktreeService_CloneAndCreate(t *testing.T) {
+	setup := func(t *testing.T, cloneErr error) (application.WorktreeService, *mocks.MockGitService, *domain.Config) {
+		t.Helper()
+		gitService := mocks.NewMockGitService()
+		projectService := mocks.NewMockProjectService()
+		config := domain.DefaultConfig()
+		config.ProjectsDirectory = t.TempDir()
+		config.WorktreesDirectory = t.TempDir()
+		gitService.MockGoGitClient.On("Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(cloneErr)
+		gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, mock.Anything).Return(domain.RepositoryStatus{Branch: "develop", IsClean: true}, nil)
+		configureWorktreeServiceMocks(gitService, projectService, &domain.ProjectInfo{
+			Name: "api", Path: filepath.Join(config.ProjectsDirectory, "api"), GitRepoPath: filepath.Join(config.ProjectsDirectory, "api"),
+		})
+		return NewWorktreeService(gitService, projectService, config, nil, nil), gitService, config
 	}
 
-	result, err := service.PruneMergedWorktrees(context.Background(), req)
-	require.NoError(t, err)
-	assert.NotNil(t, result)
-	assert.Equal(t, 1, result.TotalDeleted)
-}
+	t.Run("clones then creates the worktree from the default branch", func(t *testing.T) {
+		service, gitService, config := setup(t, nil)
+		projectPath := filepath.Join(config.ProjectsDirectory, "api")
 
-func TestWorktreeService_PruneMergedWorktrees_NavigationPath(t *testing.T) {
-	t.Run("sets navigation path when single worktree deleted with specific worktree and project exists", func(t *testing.T) {
-		service, gitService, _, config := setupWorktreeService()
+		result, err := service.CloneAndCreate(context.Background(), &domain.CloneCreateRequest{
+			RemoteURL: "git@github.com:me/api.git", BranchName: "feature",
+		})
 
-		gitService.MockCLIClient.ExpectedCalls = nil
-		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
-			{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
-		}, nil).Once()
-		gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
-		gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil).Once()
+		require.NoError(t, err)
+		assert.Equal(t, "api", result.ProjectName)
+		assert.Equal(t, projectPath, result.ProjectPath)
+		assert.Equal(t, "develop", result.DefaultBranch)
+		assert.Equal(t, "feature", result.Created.Worktree.Branch)
+		gitService.MockGoGitClient.AssertCalled(t, "Clone", mock.Anything, "git@github.com:me/api.git", projectPath, mock.Anything)
+		gitService.MockCLIClient.AssertCalled(t, "CreateWorktree", mock.Anything, projectPath, "feature", "develop",
+			filepath.Join(config.WorktreesDirectory, "api", "feature"))
+	})
 
-		tempDir := t.TempDir()
-		projectDir := tempDir + "/test-project"
-		require.NoError(t, os.MkdirAll(projectDir, 0755))
+	t.Run("project name overrides the URL", func(t *testing.T) {
+		service, gitService, config := setup(t, nil)
 
-		originalProjectsDir := config.ProjectsDirectory
-		config.ProjectsDirectory = tempDir
-		defer func() { config.ProjectsDirectory = originalProjectsDir }()
+		result, err := service.CloneAndCreate(context.Background(), &domain.CloneCreateRequest{
+			RemoteURL: "https://github.com/me/api.git", ProjectName: "api-spike", BranchName: "spike",
+		})
 
-		req := &domain.PruneWorktreesRequest{
-			Context:          &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
-			DryRun:           false,
-			Force:            false,
-			DeleteBranches:   false,
-			SpecificWorktree: "test-project/feature-branch",
+		require.NoError(t, err)
+		assert.Equal(t, "api-spike", result.ProjectName)
+		gitService.MockGoGitClient.AssertCalled(t, "Clone", mock.Anything, "https://github.com/me/api.git", filepath.Join(config.ProjectsDirectory, "api-spike"), mock.Anything)
+	})
+
+	t.Run("invalid input is rejected before cloning", func(t *testing.T) {
+		for name, req := range map[string]*domain.CloneCreateRequest{
+			"file scheme":  {RemoteURL: "file:///srv/api.git", BranchName: "feature"},
+			"local path":   {RemoteURL: "/srv/api.git", BranchName: "feature"},
+			"branch name":  {RemoteURL: "https://github.com/me/api.git", BranchName: "-bad"},
+			"project name": {RemoteURL: "https://github.com/me/api.git", ProjectName: "../api", BranchName: "feature"},
+		} {
+			service, gitService, _ := setup(t, nil)
+
+			_, err := service.CloneAndCreate(context.Background(), req)
+
+			require.Error(t, err, name)
+			var validationErr *domain.ValidationError
+			require.ErrorAs(t, err, &validationErr, name)
+			gitService.MockGoGitClient.AssertNotCalled(t, "Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 		}
+	})
 
-		result, err := service.PruneMergedWorktrees(context.Background(), req)
-		require.NoError(t, err)
-		assert.NotNil(t, result)
-		assert.Equal(t, 1, result.TotalDeleted)
-		assert.NotEmpty(t, result.NavigationPath)
-		assert.Contains(t, result.NavigationPath, "test-project")
+	t.Run("existing project directory", func(t *testing.T) {
+		service, gitService, config := setup(t, nil)
+		require.NoError(t, os.MkdirAll(filepath.Join(config.ProjectsDirectory, "api"), 0755))
+
+		_, err := service.CloneAndCreate(context.Background(), &domain.CloneCreateRequest{
+			RemoteURL: "https://github.com/me/api.git", BranchName: "feature",
+		})
+
+		var conflictErr *domain.ConflictError
+		require.ErrorAs(t, err, &conflictErr)
+		gitService.MockGoGitClient.AssertNotCalled(t, "Clone", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	})
 
-	t.Run("does not set navigation path when project path does not exist", func(t *testing.T) {
-		service, gitService, _, config := setupWorktreeService()
+	t.Run("clone failure", func(t *testing.T) {
+		service, gitService, _ := setup(t, errors.New("authentication required"))
 
-		gitService.MockCLIClient.ExpectedCalls = nil
-		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
-			{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
-		}, nil).Once()
-		gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
-		gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil).Once()
+		_, err := service.CloneAndCreate(context.Background(), &domain.CloneCreateRequest{
+			RemoteURL: "https://github.com/me/api.git", BranchName: "feature",
+		})
 
-		config.ProjectsDirectory = "/nonexistent/path"
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to clone https://github.com/me/api.git")
+		gitService.MockCLIClient.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
 
-		req := &domain.PruneWorktreesRequest{
-			Context:          &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
-			DryRun:           false,
-			Force:            false,
-			DeleteBranches:   false,
-			SpecificWorktree: "test-project/feature-branch",
+func TestWorktreeService_CreateWorktree_FromStash(t *testing.T) {
+	setup := func(t *testing.T, applyErr error) (application.WorktreeService, *mocks.MockGitService, string) {
+		t.Helper()
+		gitService := mocks.NewMockGitService()
+		projectService := mocks.NewMockProjectService()
+		gitService.MockCLIClient.On("GetStashList", mock.Anything, "/path/to/project/.git").Return([]domain.StashEntry{
+			{Index: 0, Message: "WIP on main: abc123 fix"},
+			{Index: 1, Message: "On main: spike"},
+		}, nil)
+		gitService.MockCLIClient.On("ApplyStash", mock.Anything, "/path/to/project/.git", mock.Anything, 1).Return(applyErr)
+		gitService.MockCLIClient.On("DropStash", mock.Anything, "/path/to/project/.git", 1).Return(nil)
+		gitService.MockCLIClient.On("GetConflictingFiles", mock.Anything, mock.Anything).Return([]domain.ConflictFile{
+			{Path: "a.txt", OurStatus: "modified", TheirStatus: "modified"},
+		}, nil)
+		configureWorktreeServiceMocks(gitService, projectService, &domain.ProjectInfo{
+			Name: "test-project", Path: "/path/to/project", GitRepoPath: "/path/to/project/.git",
+		})
+		config := domain.DefaultConfig()
+		config.WorktreesDirectory = t.TempDir()
+		return NewWorktreeService(gitService, projectService, config, nil, nil), gitService,
+			filepath.Join(config.WorktreesDirectory, "test-project", "spike")
+	}
+	request := func(index int, drop bool) *domain.CreateWorktreeRequest {
+		return &domain.CreateWorktreeRequest{
+			ProjectName:  "test-project",
+			BranchName:   "spike",
+			SourceBranch: "main",
+			Context:      &domain.Context{Type: domain.ContextProject, ProjectName: "test-project"},
+			FromStash:    &index,
+			DropStash:    drop,
 		}
+	}
+
+	t.Run("applies and drops the stash", func(t *testing.T) {
+		service, gitService, expectedPath := setup(t, nil)
+
+		result, err := service.CreateWorktree(context.Background(), request(1, true))
 
-		result, err := service.PruneMergedWorktrees(context.Background(), req)
 		require.NoError(t, err)
-		assert.NotNil(t, result)
-		assert.Equal(t, 1, result.TotalDeleted)
-		assert.Empty(t, result.NavigationPath)
+		assert.Empty(t, result.StashConflicts)
+		gitService.MockCLIClient.AssertCalled(t, "ApplyStash", mock.Anything, "/path/to/project/.git", expectedPath, 1)
+		gitService.MockCLIClient.AssertCalled(t, "DropStash", mock.Anything, "/path/to/project/.git", 1)
 	})
 
-	t.Run("does not set navigation path without specific worktree", func(t *testing.T) {
-		service, gitService, _, _ := setupWorktreeService()
-
-		gitService.MockCLIClient.ExpectedCalls = nil
-		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
-			{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
-		}, nil).Once()
-		gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
-		gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil).Once()
+	t.Run("keeps the stash without drop", func(t *testing.T) {
+		service, gitService, _ := setup(t, nil)
 
-		req := &domain.PruneWorktreesRequest{
-			Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
-			DryRun:         false,
-			Force:          false,
-			DeleteBranches: false,
-		}
+		_, err := service.CreateWorktree(context.Background(), request(1, false))
 
-		result, err := service.PruneMergedWorktrees(context.Background(), req)
 		require.NoError(t, err)
-		assert.NotNil(t, result)
-		assert.Equal(t, 1, result.TotalDeleted)
-		assert.Empty(t, result.NavigationPath)
+		gitService.MockCLIClient.AssertNotCalled(t, "DropStash", mock.Anything, mock.Anything, mock.Anything)
 	})
 
-	t.Run("does not set navigation path with multiple deletions", func(t *testing.T) {
-		service, gitService, _, _ := setupWorktreeService()
-
-		gitService.MockCLIClient.ExpectedCalls = nil
-		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
-			{Path: "/path/to/worktree-feature1", Branch: "feature-1", Commit: "abc123"},
-			{Path: "/path/to/worktree-feature2", Branch: "feature-2", Commit: "def456"},
-		}, nil).Once()
-		gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(true, nil)
-		gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil)
+	t.Run("conflicts are reported and the stash kept", func(t *testing.T) {
+		service, gitService, expectedPath := setup(t, domain.NewGitWorktreeError("", "", "applying stash@{1} left conflicts", domain.ErrStashConflict))
 
-		req := &domain.PruneWorktreesRequest{
-			Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
-			DryRun:         false,
-			Force:          false,
-			DeleteBranches: false,
-		}
+		result, err := service.CreateWorktree(context.Background(), request(1, true))
 
-		result, err := service.PruneMergedWorktrees(context.Background(), req)
 		require.NoError(t, err)
-		assert.NotNil(t, result)
-		assert.Equal(t, 2, result.TotalDeleted)
-		assert.Empty(t, result.NavigationPath)
+		assert.Equal(t, []domain.ConflictFile{{Path: "a.txt", OurStatus: "modified", TheirStatus: "modified"}}, result.StashConflicts)
+		gitService.MockCLIClient.AssertCalled(t, "GetConflictingFiles", mock.Anything, expectedPath)
+		gitService.MockCLIClient.AssertNotCalled(t, "DropStash", mock.Anything, mock.Anything, mock.Anything)
 	})
-}
 
-func TestWorktreeService_PruneMergedWorktrees_DeleteBranches(t *testing.T) {
-	t.Run("deletes branch when DeleteBranches is true", func(t *testing.T) {
-		service, gitService, _, _ := setupWorktreeService()
+	t.Run("apply failure", func(t *testing.T) {
+		service, _, _ := setup(t, errors.New("local changes would be overwritten"))
 
-		gitService.MockCLIClient.ExpectedCalls = nil
-		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
-			{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
-		}, nil).Once()
-		gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
-		gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil).Once()
-		gitService.MockCLIClient.On("PruneWorktrees", mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
-		gitService.MockCLIClient.On("DeleteBranch", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(nil).Once()
+		_, err := service.CreateWorktree(context.Background(), request(1, false))
 
-		req := &domain.PruneWorktreesRequest{
-			Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
-			DryRun:         false,
-			Force:          false,
-			DeleteBranches: true,
-		}
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "worktree created but failed to apply stash@{1}")
+	})
 
-		result, err := service.PruneMergedWorktrees(context.Background(), req)
-		require.NoError(t, err)
-		assert.NotNil(t, result)
-		assert.Equal(t, 1, result.TotalDeleted)
-		assert.Equal(t, 1, result.TotalBranchesDeleted)
-		assert.Len(t, result.DeletedWorktrees, 1)
-		assert.True(t, result.DeletedWorktrees[0].BranchDeleted)
+	t.Run("missing stash entry", func(t *testing.T) {
+		service, gitService, _ := setup(t, nil)
+
+		_, err := service.CreateWorktree(context.Background(), request(5, false))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "stash@{5} does not exist")
+		gitService.MockCLIClient.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	})
 
-	t.Run("continues when branch deletion fails", func(t *testing.T) {
-		service, gitService, _, _ := setupWorktreeService()
+	t.Run("invalid combinations", func(t *testing.T) {
+		service, _, _ := setup(t, nil)
 
-		gitService.MockCLIClient.ExpectedCalls = nil
-		gitService.MockGoGitClient.ExpectedCalls = nil
-		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
-			{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
-		}, nil).Once()
-		gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
-		gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil).Once()
-		gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/path/to/worktree-feature").Return(domain.RepositoryStatus{IsClean: true}, nil).Once()
-		gitService.MockCLIClient.On("PruneWorktrees", mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
-		gitService.MockCLIClient.On("DeleteBranch", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(errors.New("branch in use")).Once()
+		_, err := service.CreateWorktree(context.Background(), request(-1, false))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "stash index cannot be negative")
 
-		req := &domain.PruneWorktreesRequest{
-			Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
-			DryRun:         false,
-			Force:          false,
-			DeleteBranches: true,
-		}
+		req := request(0, false)
+		req.WorktreeOnly = true
+		_, err = service.CreateWorktree(context.Background(), req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "without checked out files")
 
-		result, err := service.PruneMergedWorktrees(context.Background(), req)
-		require.NoError(t, err)
-		assert.NotNil(t, result)
-		assert.Equal(t, 1, result.TotalDeleted)
-		assert.Equal(t, 0, result.TotalBranchesDeleted)
-		assert.Len(t, result.DeletedWorktrees, 1)
-		assert.False(t, result.DeletedWorktrees[0].BranchDeleted)
-		require.Error(t, result.DeletedWorktrees[0].Error)
-		assert.Contains(t, result.DeletedWorktrees[0].Error.Error(), "branch deletion failed")
+		req = request(0, true)
+		req.FromStash = nil
+		_, err = service.CreateWorktree(context.Background(), req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "requires a stash to apply")
 	})
 }
 
-func TestWorktreeService_PruneMergedWorktrees_ForceBypassesUncommittedCheck(t *testing.T) {
-	service, gitService, _, _ := setupWorktreeService()
+func TestWorktreeService_CreateWorktree_AutoStash(t *testing.T) {
+	setup := func(t *testing.T, clean bool, stashErr, createErr error) (application.WorktreeService, *mocks.MockGitService) {
+		t.Helper()
+		gitService := mocks.NewMockGitService()
+		projectService := mocks.NewMockProjectService()
+		gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/path/to/worktree").Return(domain.RepositoryStatus{IsClean: clean}, nil)
+		gitService.MockCLIClient.On("CreateStash", mock.Anything, "/path/to/worktree", "auto-stash before creating spike").Return(stashErr)
+		if createErr != nil {
+			gitService.MockCLIClient.On("CreateWorktree", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(createErr)
+		}
+		configureWorktreeServiceMocks(gitService, projectService, &domain.ProjectInfo{
+			Name: "test-project", Path: "/path/to/project", GitRepoPath: "/path/to/project/.git",
+		})
+		config := domain.DefaultConfig()
+		config.WorktreesDirectory = t.TempDir()
+		return NewWorktreeService(gitService, projectService, config, nil, nil), gitService
+	}
+	request := func() *domain.CreateWorktreeRequest {
+		return &domain.CreateWorktreeRequest{
+			ProjectName:  "test-project",
+			BranchName:   "spike",
+			SourceBranch: "main",
+			Context:      &domain.Context{Type: domain.ContextWorktree, ProjectName: "test-project", BranchName: "feature-branch", Path: "/path/to/worktree"},
+			AutoStash:    true,
+		}
+	}
 
-	gitService.MockCLIClient.ExpectedCalls = nil
-	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
-		{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
-	}, nil).Once()
-	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
-	gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), true).Return(nil).Once()
-	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/path/to/worktree-feature").Return(domain.RepositoryStatus{
-		IsClean:  false,
-		Modified: []string{"file.txt"},
-	}, nil).Maybe()
+	t.Run("stashes a dirty worktree before creating", func(t *testing.T) {
+		service, gitService := setup(t, false, nil, nil)
 
-	req := &domain.PruneWorktreesRequest{
-		Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
-		DryRun:         false,
-		Force:          true,
-		DeleteBranches: false,
-	}
+		result, err := service.CreateWorktree(context.Background(), request())
 
-	result, err := service.PruneMergedWorktrees(context.Background(), req)
-	require.NoError(t, err)
-	assert.NotNil(t, result)
-	assert.Equal(t, 1, result.TotalDeleted)
-}
+		require.NoError(t, err)
+		assert.Equal(t, "/path/to/worktree", result.AutoStashPath)
+		gitService.MockCLIClient.AssertCalled(t, "CreateStash", mock.Anything, "/path/to/worktree", "auto-stash before creating spike")
+		gitService.MockCLIClient.AssertCalled(t, "CreateWorktree", mock.Anything, mock.Anything, "spike", "main", mock.Anything)
+	})
 
-func TestWorktreeService_PruneMergedWorktrees_SkipsUncommittedWithoutForce(t *testing.T) {
-	service, gitService, _, _ := setupWorktreeService()
+	t.Run("clean worktree is not stashed", func(t *testing.T) {
+		service, gitService := setup(t, true, nil, nil)
 
-	gitService.MockCLIClient.ExpectedCalls = nil
-	gitService.MockGoGitClient.ExpectedCalls = nil
-	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
-		{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
-	}, nil).Once()
-	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
-	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/path/to/worktree-feature").Return(domain.RepositoryStatus{
-		IsClean:  false,
-		Modified: []string{"file.txt"},
-	}, nil).Once()
+		result, err := service.CreateWorktree(context.Background(), request())
 
-	req := &domain.PruneWorktreesRequest{
-		Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
-		DryRun:         false,
-		Force:          false,
-		DeleteBranches: false,
-	}
+		require.NoError(t, err)
+		assert.Empty(t, result.AutoStashPath)
+		gitService.MockCLIClient.AssertNotCalled(t, "CreateStash", mock.Anything, mock.Anything, mock.Anything)
+	})
 
-	result, err := service.PruneMergedWorktrees(context.Background(), req)
-	require.NoError(t, err)
-	assert.NotNil(t, result)
-	assert.Equal(t, 0, result.TotalDeleted)
-	assert.Equal(t, 1, result.TotalSkipped)
-	assert.Len(t, result.SkippedWorktrees, 1)
-	assert.Contains(t, result.SkippedWorktrees[0].SkipReason, "uncommitted changes")
-}
+	t.Run("stash failure creates nothing", func(t *testing.T) {
+		service, gitService := setup(t, false, errors.New("no local changes to stash"), nil)
 
-func TestWorktreeService_PruneMergedWorktrees_AllProjects(t *testing.T) {
-	service, gitService, projectService, _ := setupWorktreeService()
+		_, err := service.CreateWorktree(context.Background(), request())
 
-	gitService.MockCLIClient.ExpectedCalls = nil
-	projectService.ExpectedCalls = nil
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "nothing was created")
+		gitService.MockCLIClient.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("creation failure points to the stash", func(t *testing.T) {
+		service, _ := setup(t, false, nil, errors.New("invalid reference"))
+
+		_, err := service.CreateWorktree(context.Background(), request())
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the changes of /path/to/worktree are in stash@{0}")
+	})
+
+	t.Run("invalid combinations", func(t *testing.T) {
+		service, gitService := setup(t, false, nil, nil)
+
+		req := request()
+		index := 0
+		req.FromStash = &index
+		_, err := service.CreateWorktree(context.Background(), req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "shift the stash indexes")
+
+		req = request()
+		req.Context = &domain.Context{Type: domain.ContextOutsideGit}
+		_, err = service.CreateWorktree(context.Background(), req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "inside a project or worktree")
+		gitService.MockCLIClient.AssertNotCalled(t, "CreateStash", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestWorktreeService_CreateWorktree_CheckoutOnConflict(t *testing.T) {
+	type setupOptions struct {
+		clean       bool
+		createErr   error
+		checkoutErr error
+		branchFound bool
+	}
+	setup := func(t *testing.T, opts setupOptions) (application.WorktreeService, *mocks.MockGitService) {
+		t.Helper()
+		gitService := mocks.NewMockGitService()
+		projectService := mocks.NewMockProjectService()
+		gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/path/to/worktree").Return(domain.RepositoryStatus{IsClean: opts.clean}, nil)
+		gitService.MockGoGitClient.On("BranchExists", mock.Anything, mock.Anything, "spike").Return(opts.branchFound, nil)
+		gitService.MockCLIClient.On("CheckoutBranch", mock.Anything, "/path/to/worktree", "spike", mock.Anything).Return(opts.checkoutErr)
+		if opts.createErr != nil {
+			gitService.MockCLIClient.On("CreateWorktree", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(opts.createErr)
+		}
+		configureWorktreeServiceMocks(gitService, projectService, &domain.ProjectInfo{
+			Name: "test-project", Path: "/path/to/project", GitRepoPath: "/path/to/project/.git",
+		})
+		config := domain.DefaultConfig()
+		config.WorktreesDirectory = t.TempDir()
+		return NewWorktreeService(gitService, projectService, config, nil, nil), gitService
+	}
+	request := func() *domain.CreateWorktreeRequest {
+		return &domain.CreateWorktreeRequest{
+			ProjectName:        "test-project",
+			BranchName:         "spike",
+			SourceBranch:       "main",
+			Context:            &domain.Context{Type: domain.ContextWorktree, ProjectName: "test-project", BranchName: "feature-branch", Path: "/path/to/worktree"},
+			CheckoutOnConflict: true,
+			UseLocalBranch:     true,
+		}
+	}
+
+	t.Run("created worktree needs no fallback", func(t *testing.T) {
+		service, gitService := setup(t, setupOptions{clean: true})
+
+		result, err := service.CreateWorktree(context.Background(), request())
+
+		require.NoError(t, err)
+		require.NoError(t, result.CheckoutFallback)
+		assert.NotEqual(t, "/path/to/worktree", result.Worktree.Path)
+		gitService.MockCLIClient.AssertNotCalled(t, "CheckoutBranch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("creation failure checks out a new branch in the current worktree", func(t *testing.T) {
+		service, gitService := setup(t, setupOptions{clean: true, createErr: errors.New("permission denied")})
+
+		result, err := service.CreateWorktree(context.Background(), request())
+
+		require.NoError(t, err)
+		require.Error(t, result.CheckoutFallback)
+		assert.Contains(t, result.CheckoutFallback.Error(), "failed to create worktree")
+		assert.Equal(t, "/path/to/worktree", result.Worktree.Path)
+		assert.Equal(t, "spike", result.Worktree.Branch)
+		gitService.MockCLIClient.AssertCalled(t, "CheckoutBranch", mock.Anything, "/path/to/worktree", "spike", "main")
+	})
+
+	t.Run("existing branch is checked out as is", func(t *testing.T) {
+		service, gitService := setup(t, setupOptions{clean: true, createErr: errors.New("no space left on device"), branchFound: true})
+		gitService.MockCLIClient.On("GetBranchDescription", mock.Anything, mock.Anything, "spike").Return("", nil).Maybe()
+
+		result, err := service.CreateWorktree(context.Background(), request())
+
+		require.NoError(t, err)
+		require.Error(t, result.CheckoutFallback)
+		gitService.MockCLIClient.AssertCalled(t, "CheckoutBranch", mock.Anything, "/path/to/worktree", "spike", "")
+	})
+
+	t.Run("dirty current worktree returns the creation error", func(t *testing.T) {
+		service, gitService := setup(t, setupOptions{clean: false, createErr: errors.New("permission denied")})
+
+		_, err := service.CreateWorktree(context.Background(), request())
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to create worktree")
+		assert.Contains(t, err.Error(), "/path/to/worktree has uncommitted changes")
+		gitService.MockCLIClient.AssertNotCalled(t, "CheckoutBranch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("failed checkout returns the creation error", func(t *testing.T) {
+		service, _ := setup(t, setupOptions{clean: true, createErr: errors.New("permission denied"), checkoutErr: errors.New("branch is checked out elsewhere")})
+
+		_, err := service.CreateWorktree(context.Background(), request())
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to create worktree")
+		assert.Contains(t, err.Error(), "also failed: branch is checked out elsewhere")
+	})
+
+	t.Run("worktree of another project is not reused", func(t *testing.T) {
+		service, gitService := setup(t, setupOptions{clean: true, createErr: errors.New("permission denied")})
+		req := request()
+		req.Context.ProjectName = "other-project"
+
+		_, err := service.CreateWorktree(context.Background(), req)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not a worktree of test-project")
+		gitService.MockCLIClient.AssertNotCalled(t, "CheckoutBranch", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid combinations", func(t *testing.T) {
+		service, _ := setup(t, setupOptions{clean: true})
+
+		req := request()
+		req.WorktreeOnly = true
+		_, err := service.CreateWorktree(context.Background(), req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "without checked out files")
+
+		req = request()
+		index := 0
+		req.FromStash = &index
+		_, err = service.CreateWorktree(context.Background(), req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot be combined with a stash")
+
+		req = request()
+		req.Context = &domain.Context{Type: domain.ContextOutsideGit}
+		_, err = service.CreateWorktree(context.Background(), req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "inside a project or worktree")
+	})
+}
+
+func TestWorktreeService_CreateWorktree_MaxBehindCommits(t *testing.T) {
+	intPtr := func(n int) *int { return &n }
+
+	tests := []struct {
+		name         string
+		configLimit  int
+		requestLimit *int
+		branchExists bool
+		behind       int
+		expectError  string
+		expectRef    string
+	}{
+		{name: "one under the limit", requestLimit: intPtr(10), behind: 9, expectRef: "develop"},
+		{name: "exactly at the limit", requestLimit: intPtr(10), behind: 10, expectError: "develop is 10 commit(s) behind main (limit 10)"},
+		{name: "one over the limit", requestLimit: intPtr(10), behind: 11, expectError: "develop is 11 commit(s) behind main (limit 10)"},
+		{name: "config default applies", configLimit: 5, behind: 5, expectError: "develop is 5 commit(s) behind main (limit 5)"},
+		{name: "request overrides config", configLimit: 5, requestLimit: intPtr(20), behind: 5, expectRef: "develop"},
+		{name: "zero disables the config default", configLimit: 5, requestLimit: intPtr(0), behind: 50},
+		{name: "existing branch is compared", requestLimit: intPtr(3), branchExists: true, behind: 3, expectError: "feature is 3 commit(s) behind main"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gitService := mocks.NewMockGitService()
+			projectService := mocks.NewMockProjectService()
+			gitService.MockGoGitClient.On("BranchExists", mock.Anything, mock.Anything, "feature").Return(tt.branchExists, nil)
+			gitService.MockCLIClient.On("GetBranchRelationship", mock.Anything, "/path/to/project/.git", mock.Anything, "main").
+				Return(&domain.BranchRelationship{BehindCount: tt.behind}, nil)
+			configureWorktreeServiceMocks(gitService, projectService, &domain.ProjectInfo{
+				Name: "test-project", Path: "/path/to/project", GitRepoPath: "/path/to/project/.git",
+			})
+			config := domain.DefaultConfig()
+			config.WorktreesDirectory = t.TempDir()
+			config.MaxBehindCommits = tt.configLimit
+			service := NewWorktreeService(gitService, projectService, config, nil, nil)
+
+			_, err := service.CreateWorktree(context.Background(), &domain.CreateWorktreeRequest{
+				ProjectName:      "test-project",
+				BranchName:       "feature",
+				SourceBranch:     "develop",
+				Context:          &domain.Context{Type: domain.ContextProject, ProjectName: "test-project"},
+				UseLocalBranch:   true,
+				MaxBehindCommits: tt.requestLimit,
+			})
+
+			if tt.expectError != "" {
+				require.Error(t, err)
+				require.ErrorIs(t, err, domain.ErrBranchTooFarBehind)
+				assert.Contains(t, err.Error(), tt.expectError)
+				assert.Contains(t, err.Error(), "'git rebase origin/main' or 'git merge main'")
+				gitService.MockCLIClient.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			if tt.expectRef != "" {
+				gitService.MockCLIClient.AssertCalled(t, "GetBranchRelationship", mock.Anything, "/path/to/project/.git", tt.expectRef, "main")
+			} else {
+				gitService.MockCLIClient.AssertNotCalled(t, "GetBranchRelationship", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+
+	t.Run("negative limit is invalid", func(t *testing.T) {
+		service, _, _, _ := setupWorktreeService()
+
+		_, err := service.CreateWorktree(context.Background(), &domain.CreateWorktreeRequest{
+			ProjectName:      "test-project",
+			BranchName:       "feature",
+			SourceBranch:     "main",
+			Context:          &domain.Context{Type: domain.ContextProject, ProjectName: "test-project"},
+			MaxBehindCommits: intPtr(-1),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the behind limit cannot be negative")
+	})
+}
+
+func TestWorktreeService_CreateWorktree_ReuseExistingPath(t *testing.T) {
+	request := func(path string) *domain.CreateWorktreeRequest {
+		return &domain.CreateWorktreeRequest{
+			ProjectName:       "test-project",
+			BranchName:        "feature",
+			SourceBranch:      "main",
+			Context:           &domain.Context{Type: domain.ContextProject, ProjectName: "test-project"},
+			WorktreePath:      path,
+			ReuseExistingPath: true,
+		}
+	}
+	writeFile := func(t *testing.T, path string) {
+		t.Helper()
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte("x"), 0644))
+	}
+
+	tests := []struct {
+		name        string
+		prepare     func(t *testing.T, dir string)
+		expectError string
+		notEmpty    bool
+	}{
+		{
+			name:    "empty directory",
+			prepare: func(*testing.T, string) {},
+		},
+		{
+			name:    "stale .git link",
+			prepare: func(t *testing.T, dir string) { writeFile(t, filepath.Join(dir, ".git")) },
+		},
+		{
+			name: "freshly initialized .git directory",
+			prepare: func(t *testing.T, dir string) {
+				require.NoError(t, os.MkdirAll(filepath.Join(dir, ".git", "objects", "pack"), 0755))
+				writeFile(t, filepath.Join(dir, ".git", "HEAD"))
+			},
+		},
+		{
+			name: ".git directory with objects",
+			prepare: func(t *testing.T, dir string) {
+				writeFile(t, filepath.Join(dir, ".git", "objects", "ab", "cdef"))
+			},
+			expectError: "holds a git repository with commits or objects",
+			notEmpty:    true,
+		},
+		{
+			name: "directory with files",
+			prepare: func(t *testing.T, dir string) {
+				require.NoError(t, os.Mkdir(filepath.Join(dir, ".git"), 0755))
+				writeFile(t, filepath.Join(dir, "README.md"))
+			},
+			expectError: "contains README.md",
+			notEmpty:    true,
+		},
+		{
+			name:        "missing directory",
+			prepare:     func(t *testing.T, dir string) { require.NoError(t, os.Remove(dir)) },
+			expectError: "does not exist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, gitService, _, config := setupWorktreeService()
+			config.WorktreesDirectory = t.TempDir()
+			dir := filepath.Join(t.TempDir(), "feature")
+			require.NoError(t, os.Mkdir(dir, 0755))
+			tt.prepare(t, dir)
+
+			result, err := service.CreateWorktree(context.Background(), request(dir))
+
+			if tt.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectError)
+				assert.Equal(t, tt.notEmpty, errors.Is(err, domain.ErrTargetNotEmpty))
+				gitService.MockCLIClient.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, dir, result.Worktree.Path)
+			assert.NoFileExists(t, filepath.Join(dir, ".git"))
+			assert.NoDirExists(t, filepath.Join(dir, ".git"))
+			gitService.MockCLIClient.AssertCalled(t, "CreateWorktree", mock.Anything, "/path/to/project/.git", "feature", "main", dir)
+		})
+	}
+
+	t.Run("invalid requests", func(t *testing.T) {
+		service, _, _, _ := setupWorktreeService()
+
+		_, err := service.CreateWorktree(context.Background(), request("relative/dir"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be an absolute path")
+
+		req := request("")
+		_, err = service.CreateWorktree(context.Background(), req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the directory to reuse is required")
+
+		req = request(t.TempDir())
+		req.ReuseExistingPath = false
+		_, err = service.CreateWorktree(context.Background(), req)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "only supported when reusing an existing directory")
+	})
+}
+
+func TestWorktreeService_DeleteWorktree(t *testing.T) {
+	service, _, projectService, _ := setupWorktreeService()
+
+	tests := []struct {
+		name         string
+		request      *domain.DeleteWorktreeRequest
+		expectError  bool
+		errorMessage string
+	}{
+		{
+			name: "valid worktree deletion",
+			request: &domain.DeleteWorktreeRequest{
+				WorktreePath: "/path/to/worktree",
+				Force:        false,
+				Context: &domain.Context{
+					Type: domain.ContextWorktree,
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "empty worktree path",
+			request: &domain.DeleteWorktreeRequest{
+				WorktreePath: "",
+				Force:        false,
+				Context: &domain.Context{
+					Type: domain.ContextOutsideGit,
+				},
+			},
+			expectError:  true,
+			errorMessage: "worktree path cannot be empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := service.DeleteWorktree(context.Background(), tc.request)
+
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// The deleted worktree's cached owner is dropped
+	projectService.AssertCalled(t, "ForgetWorktreeOwner", "/path/to/worktree")
+}
+
+func TestWorktreeService_DeleteWorktree_Idempotent(t *testing.T) {
+	t.Run("non-existent worktree should succeed", func(t *testing.T) {
+		service, _, _, _ := setupWorktreeService()
+		request := &domain.DeleteWorktreeRequest{
+			WorktreePath: "/non/existent/worktree",
+			Force:        false,
+			Context: &domain.Context{
+				Type: domain.ContextOutsideGit,
+			},
+		}
+
+		err := service.DeleteWorktree(context.Background(), request)
+		assert.NoError(t, err, "DeleteWorktree should be idempotent and succeed for non-existent worktrees")
+	})
+}
+
+func TestWorktreeService_ListWorktrees(t *testing.T) {
+	service, _, _, _ := setupWorktreeService()
+
+	tests := []struct {
+		name        string
+		request     *domain.ListWorktreesRequest
+		expectError bool
+	}{
+		{
+			name: "valid worktree listing",
+			request: &domain.ListWorktreesRequest{
+				ProjectName: "test-project",
+				Context: &domain.Context{
+					Type:        domain.ContextProject,
+					ProjectName: "test-project",
+				},
+				IncludeMain: true,
+			},
+			expectError: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := service.ListWorktrees(context.Background(), tc.request)
+
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+		})
+	}
+}
+
+func TestWorktreeService_ListWorktrees_IncludeLastUpdated(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	updated := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+		{Path: "/path/to/worktree", Branch: "feature", Commit: "abc123"},
+	}, nil).Once()
+	gitService.MockGoGitClient.On("GetCommitInfo", mock.Anything, mock.Anything, "abc123").
+		Return(&domain.CommitInfo{Date: updated}, nil).Once()
+
+	result, err := service.ListWorktrees(context.Background(), &domain.ListWorktreesRequest{
+		Context:            &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		IncludeLastUpdated: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, updated, result[0].LastUpdated)
+	assert.Equal(t, "test-project", result[0].Project)
+}
+
+func TestWorktreeService_ListWorktrees_IncludeDescriptions(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+		{Path: "/path/to/feature", Branch: "feature", Commit: "abc123"},
+		{Path: "/path/to/plain", Branch: "plain", Commit: "def456"},
+		{Path: "/path/to/detached", Commit: "789abc", IsDetached: true},
+	}, nil).Once()
+	gitService.MockCLIClient.On("GetBranchDescription", mock.Anything, mock.Anything, "feature").Return("Payment retries", nil).Once()
+	gitService.MockCLIClient.On("GetBranchDescription", mock.Anything, mock.Anything, "plain").Return("", errors.New("bad config")).Once()
+
+	result, err := service.ListWorktrees(context.Background(), &domain.ListWorktreesRequest{
+		Context:             &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		IncludeDescriptions: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	assert.Equal(t, "Payment retries", result[0].Description)
+	assert.Empty(t, result[1].Description, "read failures leave the description empty")
+	assert.Empty(t, result[2].Description)
+	gitService.MockCLIClient.AssertExpectations(t)
+}
+
+func TestWorktreeService_ListWorktrees_OnlyMine(t *testing.T) {
+	tests := []struct {
+		name          string
+		globalEmail   string
+		envEmail      string
+		expectError   bool
+		errorMessage  string
+		expectedPaths []string
+	}{
+		{
+			name:          "matches git config user.email case-insensitively",
+			globalEmail:   "Me@Example.com",
+			expectedPaths: []string{"/path/to/mine"},
+		},
+		{
+			name:          "falls back to GIT_AUTHOR_EMAIL",
+			envEmail:      "other@example.com",
+			expectedPaths: []string{"/path/to/theirs"},
+		},
+		{
+			name:         "no email configured",
+			expectError:  true,
+			errorMessage: "cannot determine your email",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			service, gitService, _, _ := setupWorktreeService()
+			service.(*worktreeService).getenv = func(key string) string {
+				if key == "GIT_AUTHOR_EMAIL" {
+					return tc.envEmail
+				}
+				return ""
+			}
+
+			gitService.MockCLIClient.ExpectedCalls = nil
+			gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+				{Path: "/path/to/mine", Branch: "mine", Commit: "abc123"},
+				{Path: "/path/to/theirs", Branch: "theirs", Commit: "def456"},
+			}, nil).Once()
+			gitService.MockCLIClient.On("GetGlobalConfig", mock.Anything, "user.email").Return(tc.globalEmail, nil).Once()
+			gitService.MockGoGitClient.On("GetCommitInfo", mock.Anything, mock.Anything, "abc123").
+				Return(&domain.CommitInfo{Author: "Me", Email: "me@example.com"}, nil)
+			gitService.MockGoGitClient.On("GetCommitInfo", mock.Anything, mock.Anything, "def456").
+				Return(&domain.CommitInfo{Author: "Other", Email: "other@example.com"}, nil)
+
+			result, err := service.ListWorktrees(context.Background(), &domain.ListWorktreesRequest{
+				Context:  &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+				OnlyMine: true,
+			})
+
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMessage)
+				return
+			}
+			require.NoError(t, err)
+			paths := make([]string, 0, len(result))
+			for _, wt := range result {
+				paths = append(paths, wt.Path)
+			}
+			assert.Equal(t, tc.expectedPaths, paths)
+			assert.NotEmpty(t, result[0].CommitAuthorName)
+		})
+	}
+}
+
+func TestWorktreeService_ListWorktrees_BranchFilter(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+		{Path: "/path/to/feature-a", Branch: "feature/a"},
+		{Path: "/path/to/fix", Branch: "fix/b"},
+	}, nil)
+
+	result, err := service.ListWorktrees(context.Background(), &domain.ListWorktreesRequest{
+		Context:      &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		BranchFilter: "feature/*",
+	})
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "feature/a", result[0].Branch)
+
+	_, err = service.ListWorktrees(context.Background(), &domain.ListWorktreesRequest{
+		Context:      &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		BranchFilter: "[",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid filter pattern")
+}
+
+func TestWorktreeService_ListRemoteCandidates(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	remoteBranches := []domain.BranchInfo{
+		{Name: "main", Remote: "origin/main", Commit: "aaa", Date: now},
+		{Name: "feature/old", Remote: "origin/feature/old", Commit: "bbb", Author: "Ann", Date: now.Add(-48 * time.Hour)},
+		{Name: "feature/new", Remote: "origin/feature/new", Commit: "ccc", Author: "Bob", Date: now.Add(-time.Hour)},
+		{Name: "fix/typo", Remote: "origin/fix/typo", Commit: "ddd", Date: now.Add(-24 * time.Hour)},
+		{Name: "feature-branch", Remote: "origin/feature-branch", Commit: "eee", Date: now},
+	}
+
+	testCases := []struct {
+		name           string
+		filter         string
+		expectedRemote []string
+	}{
+		{
+			name:           "skips branches with a worktree, newest first",
+			expectedRemote: []string{"origin/feature/new", "origin/fix/typo", "origin/feature/old"},
+		},
+		{
+			name:           "narrowed by filter",
+			filter:         "feature/*",
+			expectedRemote: []string{"origin/feature/new", "origin/feature/old"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service, gitService, _, _ := setupWorktreeService()
+			gitService.MockCLIClient.ExpectedCalls = nil
+			gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/path/to/project/.git").Return([]domain.WorktreeInfo{
+				{Path: "/path/to/project/.git", Branch: "main"},
+				{Path: "/path/to/worktree", Branch: "feature-branch"},
+			}, nil)
+			gitService.MockGoGitClient.On("GetRemoteBranches", mock.Anything, "/path/to/project/.git").Return(remoteBranches, nil)
+
+			candidates, err := service.ListRemoteCandidates(context.Background(), &domain.ListWorktreesRequest{
+				ListAllProjects: true,
+				BranchFilter:    tc.filter,
+			})
+			require.NoError(t, err)
+
+			remotes := make([]string, 0, len(candidates))
+			for _, candidate := range candidates {
+				assert.Equal(t, "test-project", candidate.Project)
+				remotes = append(remotes, candidate.Remote)
+			}
+			assert.Equal(t, tc.expectedRemote, remotes)
+			gitService.MockCLIClient.AssertNotCalled(t, "CreateWorktree", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("remote branch listing fails", func(t *testing.T) {
+		service, gitService, _, _ := setupWorktreeService()
+		gitService.MockGoGitClient.On("GetRemoteBranches", mock.Anything, mock.Anything).Return(nil, errors.New("corrupt refs"))
+
+		_, err := service.ListRemoteCandidates(context.Background(), &domain.ListWorktreesRequest{ListAllProjects: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to list remote branches")
+	})
+}
+
+func TestWorktreeService_GetWorktreeStatus(t *testing.T) {
+	service, _, _, _ := setupWorktreeService()
+
+	tests := []struct {
+		name         string
+		worktreePath string
+		expectError  bool
+		errorMessage string
+	}{
+		{
+			name:         "valid worktree status",
+			worktreePath: "/path/to/worktree",
+			expectError:  false,
+		},
+		{
+			name:         "empty worktree path",
+			worktreePath: "",
+			expectError:  true,
+			errorMessage: "worktree path cannot be empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := service.GetWorktreeStatus(context.Background(), tc.worktreePath)
+
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMessage)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+		})
+	}
+}
+
+func TestWorktreeService_ValidateWorktree(t *testing.T) {
+	service, _, _, _ := setupWorktreeService()
+
+	tests := []struct {
+		name         string
+		worktreePath string
+		expectError  bool
+		errorMessage string
+	}{
+		{
+			name:         "valid worktree validation",
+			worktreePath: "/path/to/worktree",
+			expectError:  false,
+		},
+		{
+			name:         "empty worktree path",
+			worktreePath: "",
+			expectError:  true,
+			errorMessage: "worktree path cannot be empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := service.ValidateWorktree(context.Background(), tc.worktreePath)
+
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errorMessage)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWorktreeService_PruneMergedWorktrees_DryRun(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(true, nil)
+
+	req := &domain.PruneWorktreesRequest{
+		Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		DryRun:         true,
+		Force:          false,
+		DeleteBranches: false,
+	}
+
+	result, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, 0, result.TotalDeleted)
+}
+
+func TestWorktreeService_PruneMergedWorktrees_ProtectedBranch(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+		{Path: "/path/to/worktree-main", Branch: "main", Commit: "abc123"},
+	}, nil).Once()
+	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(true, nil).Maybe()
+
+	req := &domain.PruneWorktreesRequest{
+		Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		DryRun:         false,
+		Force:          true,
+		DeleteBranches: true,
+	}
+
+	result, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, 0, result.TotalDeleted)
+	assert.Len(t, result.ProtectedSkipped, 1)
+}
+
+func TestWorktreeService_PruneMergedWorktrees_ProtectedBranchGlob(t *testing.T) {
+	service, gitService, _, config := setupWorktreeService()
+	config.Validation.ProtectedBranches = []string{"main", "release/*"}
+
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+		{Path: "/path/to/worktree-release", Branch: "release/2.1", Commit: "abc123"},
+	}, nil).Once()
+
+	req := &domain.PruneWorktreesRequest{
+		Context: &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		Force:   true,
+	}
+
+	result, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.TotalDeleted)
+	require.Len(t, result.ProtectedSkipped, 1)
+	assert.Equal(t, "release/2.1", result.ProtectedSkipped[0].BranchName)
+}
+
+func TestWorktreeService_PruneMergedWorktrees_ProtectPattern(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+		{Path: "/path/to/worktree-release", Branch: "release/1.0", Commit: "abc123"},
+		{Path: "/path/to/worktree-hotfix", Branch: "Hotfix-42", Commit: "def456"},
+	}, nil).Once()
+
+	req := &domain.PruneWorktreesRequest{
+		Context:                     &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		Force:                       true,
+		AdditionalProtectedPatterns: []string{"release/*", "hotfix-*"},
+	}
+
+	result, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.TotalDeleted)
+	require.Len(t, result.ProtectedSkipped, 2)
+	assert.Equal(t, "release/1.0", result.ProtectedSkipped[0].BranchName)
+	gitService.MockCLIClient.AssertNotCalled(t, "IsBranchMerged", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWorktreeService_PruneMergedWorktrees_InvalidProtectPattern(t *testing.T) {
+	service, _, _, _ := setupWorktreeService()
+
+	req := &domain.PruneWorktreesRequest{
+		Context:                     &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		AdditionalProtectedPatterns: []string{"release/["},
+	}
+
+	_, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid protect pattern")
+}
+
+func TestWorktreeService_PruneMergedWorktrees_OlderThan(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+		{Path: "/path/to/worktree-old", Branch: "feature-old", Commit: "old123"},
+		{Path: "/path/to/worktree-new", Branch: "feature-new", Commit: "new456"},
+		{Path: "/path/to/worktree-unknown", Branch: "feature-unknown", Commit: "unk789"},
+	}, nil).Once()
+	gitService.MockGoGitClient.On("GetCommitInfo", mock.Anything, mock.Anything, "old123").
+		Return(&domain.CommitInfo{Date: time.Now().Add(-60 * 24 * time.Hour)}, nil)
+	gitService.MockGoGitClient.On("GetCommitInfo", mock.Anything, mock.Anything, "new456").
+		Return(&domain.CommitInfo{Date: time.Now().Add(-time.Hour)}, nil)
+	gitService.MockGoGitClient.On("GetCommitInfo", mock.Anything, mock.Anything, "unk789").
+		Return(nil, errors.New("object not found"))
+	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.Anything, "feature-old").Return(true, nil)
+
+	req := &domain.PruneWorktreesRequest{
+		Context:   &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		DryRun:    true,
+		OlderThan: 30 * 24 * time.Hour,
+	}
+
+	result, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, result.SkippedWorktrees, 3)
+	assert.Contains(t, result.SkippedWorktrees[0].SkipReason, "updated within")
+	assert.Contains(t, result.SkippedWorktrees[1].SkipReason, "updated within")
+	assert.Equal(t, "feature-old", result.SkippedWorktrees[2].BranchName)
+	assert.Equal(t, "dry run", result.SkippedWorktrees[2].SkipReason)
+	gitService.MockCLIClient.AssertNotCalled(t, "IsBranchMerged", mock.Anything, mock.Anything, "feature-new")
+}
+
+func TestWorktreeService_PruneMergedWorktrees_NegativeOlderThan(t *testing.T) {
+	service, _, _, _ := setupWorktreeService()
+
+	req := &domain.PruneWorktreesRequest{
+		Context:   &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		OlderThan: -time.Hour,
+	}
+
+	_, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "duration cannot be negative")
+}
+
+func TestWorktreeService_PruneMergedWorktrees_MaxDelete(t *testing.T) {
+	sixWorktrees := make([]domain.WorktreeInfo, 0, 6)
+	for i := 1; i <= 6; i++ {
+		sixWorktrees = append(sixWorktrees, domain.WorktreeInfo{
+			Path:   fmt.Sprintf("/path/to/worktree-%d", i),
+			Branch: fmt.Sprintf("feature-%d", i),
+		})
+	}
+	limit := func(n int) *int { return &n }
+
+	testCases := []struct {
+		name          string
+		maxDelete     *int
+		configDefault int
+		expectError   bool
+	}{
+		{name: "above request limit", maxDelete: limit(5), expectError: true},
+		{name: "at request limit", maxDelete: limit(6), expectError: false},
+		{name: "above config default", configDefault: 5, expectError: true},
+		{name: "request overrides config default", maxDelete: limit(10), configDefault: 5, expectError: false},
+		{name: "no limit", expectError: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service, gitService, _, config := setupWorktreeService()
+			config.Validation.MaxDeleteDefault = tc.configDefault
+
+			gitService.MockCLIClient.ExpectedCalls = nil
+			gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return(sixWorktrees, nil).Once()
+			gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+
+			req := &domain.PruneWorktreesRequest{
+				Context:   &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+				DryRun:    true,
+				MaxDelete: tc.maxDelete,
+			}
+
+			result, err := service.PruneMergedWorktrees(context.Background(), req)
+			if tc.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "6 worktrees are candidates for pruning")
+				assert.Nil(t, result)
+				gitService.MockCLIClient.AssertNotCalled(t, "IsBranchMerged", mock.Anything, mock.Anything, mock.Anything)
+				gitService.MockCLIClient.AssertNotCalled(t, "DeleteWorktree", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, result.SkippedWorktrees, 6)
+		})
+	}
+}
+
+func TestWorktreeService_PruneMergedWorktrees_NegativeMaxDelete(t *testing.T) {
+	service, _, _, _ := setupWorktreeService()
+	negative := -1
+
+	req := &domain.PruneWorktreesRequest{
+		Context:   &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		MaxDelete: &negative,
+	}
+
+	_, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "limit cannot be negative")
+}
+
+func TestWorktreeService_PruneMergedWorktrees_UnmergedBranch(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+		{Path: "/path/to/worktree-feature", Branch: "feature-unmerged", Commit: "abc123"},
+	}, nil)
+	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-unmerged").Return(false, nil)
+
+	req := &domain.PruneWorktreesRequest{
+		Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		DryRun:         false,
+		Force:          false,
+		DeleteBranches: false,
+	}
+
+	result, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, 0, result.TotalDeleted)
+	assert.Len(t, result.UnmergedSkipped, 1)
+}
+
+func TestWorktreeService_PruneMergedWorktrees_InvalidRequest(t *testing.T) {
+	service, _, _, _ := setupWorktreeService()
+
+	req := &domain.PruneWorktreesRequest{
+		AllProjects:      true,
+		SpecificWorktree: "project/branch",
+	}
+
+	result, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot use --all with specific worktree")
+	assert.Nil(t, result)
+}
+
+func TestWorktreeService_PruneMergedWorktrees_ForceFlag(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+		{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
+	}, nil).Once()
+	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
+	gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), true).Return(nil).Once()
+
+	req := &domain.PruneWorktreesRequest{
+		Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		DryRun:         false,
+		Force:          true,
+		DeleteBranches: false,
+	}
+
+	result, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, 1, result.TotalDeleted)
+}
+
+func TestWorktreeService_PruneMergedWorktrees_ConfirmIndividually(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+		{Path: "/path/to/worktree-a", Branch: "feature-a", Commit: "abc123"},
+		{Path: "/path/to/worktree-b", Branch: "feature-b", Commit: "def456"},
+		{Path: "/path/to/worktree-c", Branch: "feature-c", Commit: "ghi789"},
+	}, nil).Once()
+	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.Anything, "feature-c").Return(false, nil)
+	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.Anything, "/path/to/worktree-a", true).Return(nil).Once()
+
+	var asked []string
+	req := &domain.PruneWorktreesRequest{
+		Context:             &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		Force:               true,
+		ConfirmIndividually: true,
+		Confirm: func(wt *domain.PruneWorktreeResult) bool {
+			asked = append(asked, wt.BranchName)
+			return wt.BranchName == "feature-a"
+		},
+	}
+
+	result, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature-a", "feature-b"}, asked, "unmerged worktrees are not offered")
+	assert.Equal(t, 1, result.TotalDeleted)
+	require.Len(t, result.SkippedWorktrees, 1)
+	assert.Equal(t, "feature-b", result.SkippedWorktrees[0].BranchName)
+	assert.Equal(t, "not confirmed", result.SkippedWorktrees[0].SkipReason)
+	gitService.MockCLIClient.AssertNotCalled(t, "DeleteWorktree", mock.Anything, mock.Anything, "/path/to/worktree-b", mock.Anything)
+}
+
+func TestWorktreeService_PruneMergedWorktrees_ConfirmIndividuallyRequiresConfirm(t *testing.T) {
+	service, _, _, _ := setupWorktreeService()
+
+	req := &domain.PruneWorktreesRequest{
+		Context:             &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		ConfirmIndividually: true,
+	}
+
+	_, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "confirmation function is required")
+}
+
+func TestWorktreeService_PruneMergedWorktrees_SingleWorktree(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+		{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
+	}, nil).Once()
+	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
+	gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil).Once()
+
+	req := &domain.PruneWorktreesRequest{
+		Context:          &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		DryRun:           false,
+		Force:            false,
+		DeleteBranches:   false,
+		SpecificWorktree: "test-project/feature-branch",
+	}
+
+	result, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, 1, result.TotalDeleted)
+}
+
+func TestWorktreeService_PruneMergedWorktrees_NavigationPath(t *testing.T) {
+	t.Run("sets navigation path when single worktree deleted with specific worktree and project exists", func(t *testing.T) {
+		service, gitService, _, config := setupWorktreeService()
+
+		gitService.MockCLIClient.ExpectedCalls = nil
+		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+			{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
+		}, nil).Once()
+		gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
+		gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil).Once()
+
+		tempDir := t.TempDir()
+		projectDir := tempDir + "/test-project"
+		require.NoError(t, os.MkdirAll(projectDir, 0755))
+
+		originalProjectsDir := config.ProjectsDirectory
+		config.ProjectsDirectory = tempDir
+		defer func() { config.ProjectsDirectory = originalProjectsDir }()
+
+		req := &domain.PruneWorktreesRequest{
+			Context:          &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+			DryRun:           false,
+			Force:            false,
+			DeleteBranches:   false,
+			SpecificWorktree: "test-project/feature-branch",
+		}
+
+		result, err := service.PruneMergedWorktrees(context.Background(), req)
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, 1, result.TotalDeleted)
+		assert.NotEmpty(t, result.NavigationPath)
+		assert.Contains(t, result.NavigationPath, "test-project")
+	})
+
+	t.Run("does not set navigation path when project path does not exist", func(t *testing.T) {
+		service, gitService, _, config := setupWorktreeService()
+
+		gitService.MockCLIClient.ExpectedCalls = nil
+		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+			{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
+		}, nil).Once()
+		gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
+		gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil).Once()
+
+		config.ProjectsDirectory = "/nonexistent/path"
+
+		req := &domain.PruneWorktreesRequest{
+			Context:          &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+			DryRun:           false,
+			Force:            false,
+			DeleteBranches:   false,
+			SpecificWorktree: "test-project/feature-branch",
+		}
+
+		result, err := service.PruneMergedWorktrees(context.Background(), req)
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, 1, result.TotalDeleted)
+		assert.Empty(t, result.NavigationPath)
+	})
+
+	t.Run("does not set navigation path without specific worktree", func(t *testing.T) {
+		service, gitService, _, _ := setupWorktreeService()
+
+		gitService.MockCLIClient.ExpectedCalls = nil
+		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+			{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
+		}, nil).Once()
+		gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
+		gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil).Once()
+
+		req := &domain.PruneWorktreesRequest{
+			Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+			DryRun:         false,
+			Force:          false,
+			DeleteBranches: false,
+		}
+
+		result, err := service.PruneMergedWorktrees(context.Background(), req)
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, 1, result.TotalDeleted)
+		assert.Empty(t, result.NavigationPath)
+	})
+
+	t.Run("does not set navigation path with multiple deletions", func(t *testing.T) {
+		service, gitService, _, _ := setupWorktreeService()
+
+		gitService.MockCLIClient.ExpectedCalls = nil
+		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+			{Path: "/path/to/worktree-feature1", Branch: "feature-1", Commit: "abc123"},
+			{Path: "/path/to/worktree-feature2", Branch: "feature-2", Commit: "def456"},
+		}, nil).Once()
+		gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(true, nil)
+		gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil)
+
+		req := &domain.PruneWorktreesRequest{
+			Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+			DryRun:         false,
+			Force:          false,
+			DeleteBranches: false,
+		}
+
+		result, err := service.PruneMergedWorktrees(context.Background(), req)
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, 2, result.TotalDeleted)
+		assert.Empty(t, result.NavigationPath)
+	})
+}
+
+func TestWorktreeService_PruneMergedWorktrees_DeleteBranches(t *testing.T) {
+	t.Run("deletes branch when DeleteBranches is true", func(t *testing.T) {
+		service, gitService, _, _ := setupWorktreeService()
+
+		gitService.MockCLIClient.ExpectedCalls = nil
+		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+			{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
+		}, nil).Once()
+		gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
+		gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil).Once()
+		gitService.MockCLIClient.On("PruneWorktrees", mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
+		gitService.MockCLIClient.On("DeleteBranch", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(nil).Once()
+
+		req := &domain.PruneWorktreesRequest{
+			Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+			DryRun:         false,
+			Force:          false,
+			DeleteBranches: true,
+		}
+
+		result, err := service.PruneMergedWorktrees(context.Background(), req)
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, 1, result.TotalDeleted)
+		assert.Equal(t, 1, result.TotalBranchesDeleted)
+		assert.Len(t, result.DeletedWorktrees, 1)
+		assert.True(t, result.DeletedWorktrees[0].BranchDeleted)
+	})
+
+	t.Run("continues when branch deletion fails", func(t *testing.T) {
+		service, gitService, _, _ := setupWorktreeService()
+
+		gitService.MockCLIClient.ExpectedCalls = nil
+		gitService.MockGoGitClient.ExpectedCalls = nil
+		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+			{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
+		}, nil).Once()
+		gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
+		gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil).Once()
+		gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/path/to/worktree-feature").Return(domain.RepositoryStatus{IsClean: true}, nil).Once()
+		gitService.MockCLIClient.On("PruneWorktrees", mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
+		gitService.MockCLIClient.On("DeleteBranch", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(errors.New("branch in use")).Once()
+
+		req := &domain.PruneWorktreesRequest{
+			Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+			DryRun:         false,
+			Force:          false,
+			DeleteBranches: true,
+		}
+
+		result, err := service.PruneMergedWorktrees(context.Background(), req)
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, 1, result.TotalDeleted)
+		assert.Equal(t, 0, result.TotalBranchesDeleted)
+		assert.Len(t, result.DeletedWorktrees, 1)
+		assert.False(t, result.DeletedWorktrees[0].BranchDeleted)
+		require.Error(t, result.DeletedWorktrees[0].Error)
+		assert.Contains(t, result.DeletedWorktrees[0].Error.Error(), "branch deletion failed")
+	})
+}
+
+func TestWorktreeService_PruneMergedWorktrees_ForceBypassesUncommittedCheck(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+		{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
+	}, nil).Once()
+	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
+	gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), true).Return(nil).Once()
+	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/path/to/worktree-feature").Return(domain.RepositoryStatus{
+		IsClean:  false,
+		Modified: []string{"file.txt"},
+	}, nil).Maybe()
+
+	req := &domain.PruneWorktreesRequest{
+		Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		DryRun:         false,
+		Force:          true,
+		DeleteBranches: false,
+	}
+
+	result, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, 1, result.TotalDeleted)
+}
+
+func TestWorktreeService_PruneMergedWorktrees_SkipsUncommittedWithoutForce(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockGoGitClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+		{Path: "/path/to/worktree-feature", Branch: "feature-branch", Commit: "abc123"},
+	}, nil).Once()
+	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-branch").Return(true, nil).Once()
+	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/path/to/worktree-feature").Return(domain.RepositoryStatus{
+		IsClean:  false,
+		Modified: []string{"file.txt"},
+	}, nil).Once()
+
+	req := &domain.PruneWorktreesRequest{
+		Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		DryRun:         false,
+		Force:          false,
+		DeleteBranches: false,
+	}
+
+	result, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, 0, result.TotalDeleted)
+	assert.Equal(t, 1, result.TotalSkipped)
+	assert.Len(t, result.SkippedWorktrees, 1)
+	assert.Contains(t, result.SkippedWorktrees[0].SkipReason, "uncommitted changes")
+}
+
+func TestWorktreeService_PruneMergedWorktrees_AllProjects(t *testing.T) {
+	service, gitService, projectService, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.ExpectedCalls = nil
+	projectService.ExpectedCalls = nil
 
 	project1 := &domain.ProjectInfo{
 		Name:        "project1",
 		Path:        "/path/to/project1",
 		GitRepoPath: "/path/to/project1/.git",
 	}
-	project2 := &domain.ProjectInfo{
-		Name:        "project2",
-		Path:        "/path/to/project2",
-		GitRepoPath: "/path/to/project2/.git",
+	project2 := &domain.ProjectInfo{
+		Name:        "project2",
+		Path:        "/path/to/project2",
+		GitRepoPath: "/path/to/project2/.git",
+	}
+
+	summaries := []*domain.ProjectSummary{
+		{Name: project1.Name, Path: project1.Path, GitRepoPath: project1.GitRepoPath},
+		{Name: project2.Name, Path: project2.Path, GitRepoPath: project2.GitRepoPath},
+	}
+	projectService.On("ListProjectSummaries", mock.Anything).Return(summaries, nil).Once()
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/path/to/project1/.git").Return([]domain.WorktreeInfo{
+		{Path: "/path/to/wt1", Branch: "feature-1", Commit: "abc123"},
+	}, nil).Once()
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/path/to/project2/.git").Return([]domain.WorktreeInfo{
+		{Path: "/path/to/wt2", Branch: "feature-2", Commit: "def456"},
+	}, nil).Once()
+	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(true, nil)
+	gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil)
+	projectService.On("ForgetWorktreeOwner", "/path/to/wt1").Once()
+	projectService.On("ForgetWorktreeOwner", "/path/to/wt2").Once()
+
+	req := &domain.PruneWorktreesRequest{
+		Context:     &domain.Context{Type: domain.ContextOutsideGit},
+		AllProjects: true,
+		DryRun:      false,
+		Force:       false,
+	}
+
+	result, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, 2, result.TotalDeleted)
+	projectService.AssertExpectations(t)
+}
+
+func TestWorktreeService_PruneMergedWorktrees_CurrentWorktreeSkipped(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	tempDir := t.TempDir()
+	worktreeCurrentPath := tempDir + "/worktree-current"
+	worktreeOtherPath := tempDir + "/worktree-other"
+
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
+		{Path: worktreeCurrentPath, Branch: "feature-current", Commit: "abc123"},
+		{Path: worktreeOtherPath, Branch: "feature-other", Commit: "def456"},
+	}, nil).Once()
+	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-current").Return(true, nil).Maybe()
+	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-other").Return(true, nil).Maybe()
+	gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), worktreeOtherPath, mock.AnythingOfType("bool")).Return(nil).Maybe()
+
+	originalWd, err := os.Getwd()
+	require.NoError(t, err)
+	defer os.Chdir(originalWd)
+
+	err = os.MkdirAll(worktreeCurrentPath, 0755)
+	require.NoError(t, err)
+	err = os.Chdir(worktreeCurrentPath)
+	require.NoError(t, err)
+
+	req := &domain.PruneWorktreesRequest{
+		Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
+		DryRun:         false,
+		Force:          true,
+		DeleteBranches: false,
+	}
+
+	result, err := service.PruneMergedWorktrees(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, 1, result.TotalDeleted, "should delete non-current worktree")
+	assert.Equal(t, 1, result.TotalSkipped, "should skip current worktree")
+	assert.Len(t, result.CurrentWorktreeSkipped, 1)
+	assert.Equal(t, "feature-current", result.CurrentWorktreeSkipped[0].BranchName)
+	assert.Contains(t, result.CurrentWorktreeSkipped[0].SkipReason, "cannot prune current worktree")
+}
+
+func TestWorktreeService_LogBetween(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.On("LogBetween", mock.Anything, "/repo", "main", "feature").
+		Return([]domain.CommitInfo{{Hash: "abc"}}, nil).Once()
+	gitService.MockCLIClient.On("LogBetween", mock.Anything, "/repo", "main", "missing").
+		Return(nil, errors.New("unknown revision")).Once()
+
+	commits, err := service.LogBetween(context.Background(), "/repo", "main", "feature")
+	require.NoError(t, err)
+	assert.Len(t, commits, 1)
+
+	_, err = service.LogBetween(context.Background(), "/repo", "main", "missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to list commits")
+}
+
+func TestWorktreeService_CompareBranches(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockCLIClient.On("CompareWorktrees", mock.Anything, "/repo", "feature", "main").
+		Return(&domain.WorktreeComparison{Base: "feature", Target: "main", FilesChanged: 1}, nil).Once()
+	gitService.MockCLIClient.On("LogBetween", mock.Anything, "/repo", "main", "feature").
+		Return([]domain.CommitInfo{{Hash: "f1"}, {Hash: "f2"}}, nil).Once()
+	gitService.MockCLIClient.On("LogBetween", mock.Anything, "/repo", "feature", "main").
+		Return([]domain.CommitInfo{{Hash: "m1"}}, nil).Once()
+
+	comparison, err := service.CompareBranches(context.Background(), "/repo", "feature", "main")
+	require.NoError(t, err)
+	assert.Len(t, comparison.OnlyInBase, 2)
+	assert.Len(t, comparison.OnlyInTarget, 1)
+
+	gitService.MockCLIClient.On("CompareWorktrees", mock.Anything, "/repo", "feature", "missing").
+		Return(nil, errors.New("bad revision")).Once()
+	_, err = service.CompareBranches(context.Background(), "/repo", "feature", "missing")
+	require.Error(t, err)
+}
+
+func TestWorktreeService_GetWorktreeTimeline(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+	gitService.MockGoGitClient.ExpectedCalls = nil
+	gitService.MockCLIClient.ExpectedCalls = nil
+
+	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/wt/feature").
+		Return(domain.RepositoryStatus{Branch: "feature", IsClean: false}, nil)
+	gitService.MockCLIClient.On("GetCommitHistory", mock.Anything, "/wt/feature", 2).
+		Return([]domain.CommitInfo{{Hash: "c2", ShortHash: "c2", Message: "Merge main"}, {Hash: "c1", ShortHash: "c1", Message: "Add login"}}, nil).Once()
+	gitService.MockCLIClient.On("GetCommitStat", mock.Anything, "/wt/feature", "c2").
+		Return(&domain.StatusSnapshot{}, nil).Once()
+	gitService.MockCLIClient.On("GetCommitStat", mock.Anything, "/wt/feature", "c1").
+		Return(&domain.StatusSnapshot{FilesChanged: 2, Insertions: 10, Deletions: 1}, nil).Once()
+
+	timeline, err := service.GetWorktreeTimeline(context.Background(), "/wt/feature", 2)
+	require.NoError(t, err)
+	assert.Equal(t, "feature", timeline.Branch)
+	assert.True(t, timeline.Dirty)
+	require.Len(t, timeline.Commits, 2)
+	assert.Equal(t, "Merge main", timeline.Commits[0].Message)
+	assert.True(t, timeline.Commits[0].Clean())
+	assert.Equal(t, 2, timeline.Commits[1].FilesChanged)
+
+	gitService.MockCLIClient.On("GetCommitHistory", mock.Anything, "/wt/feature", 5).
+		Return([]domain.CommitInfo{{Hash: "c3", ShortHash: "c3"}}, nil).Once()
+	gitService.MockCLIClient.On("GetCommitStat", mock.Anything, "/wt/feature", "c3").
+		Return(nil, errors.New("bad object")).Once()
+	_, err = service.GetWorktreeTimeline(context.Background(), "/wt/feature", 5)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read stats of commit c3")
+
+	_, err = service.GetWorktreeTimeline(context.Background(), "/wt/feature", 0)
+	var validationErr *domain.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+
+	_, err = service.GetWorktreeTimeline(context.Background(), "", 10)
+	require.ErrorAs(t, err, &validationErr)
+}
+
+func TestWorktreeService_CopyBranchConfig(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	gitService.MockGoGitClient.On("CopyBranchConfig", mock.Anything, "/repo", "main", "feature").Return(nil).Once()
+	require.NoError(t, service.CopyBranchConfig(context.Background(), "/repo", "main", "feature"))
+
+	gitService.MockGoGitClient.On("CopyBranchConfig", mock.Anything, "/repo", "main", "broken").Return(errors.New("locked")).Once()
+	err := service.CopyBranchConfig(context.Background(), "/repo", "main", "broken")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to copy branch config")
+}
+
+func TestWorktreeService_BranchDescription(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+	gitService.MockCLIClient.ExpectedCalls = nil
+
+	gitService.MockCLIClient.On("SetBranchDescription", mock.Anything, "/repo", "feature", "Payment retries").Return(nil).Once()
+	require.NoError(t, service.SetBranchDescription(context.Background(), "/repo", "feature", "Payment retries"))
+
+	gitService.MockCLIClient.On("GetBranchDescription", mock.Anything, "/repo", "feature").Return("Payment retries", nil).Once()
+	description, err := service.GetBranchDescription(context.Background(), "/repo", "feature")
+	require.NoError(t, err)
+	assert.Equal(t, "Payment retries", description)
+
+	gitService.MockCLIClient.On("SetBranchDescription", mock.Anything, "/repo", "broken", "x").Return(errors.New("locked")).Once()
+	err = service.SetBranchDescription(context.Background(), "/repo", "broken", "x")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to set branch description")
+
+	gitService.MockCLIClient.On("GetBranchDescription", mock.Anything, "/repo", "broken").Return("", errors.New("bad config")).Once()
+	_, err = service.GetBranchDescription(context.Background(), "/repo", "broken")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read branch description")
+}
+
+func TestWorktreeService_VerifyWorktrees(t *testing.T) {
+	service, gitService, _, config := setupWorktreeService()
+	config.WorktreesDirectory = t.TempDir()
+
+	projectDir := filepath.Join(config.WorktreesDirectory, "proj")
+	for _, branch := range []string{"tracked", "orphaned"} {
+		dir := filepath.Join(projectDir, branch)
+		require.NoError(t, os.MkdirAll(dir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ".git"), []byte("gitdir: /repo/.git/worktrees/"+branch), 0644))
+	}
+
+	project := &domain.ProjectInfo{Name: "proj", GitRepoPath: "/repo"}
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/repo").Return([]domain.WorktreeInfo{
+		{Path: "/repo", Branch: "main"},
+		{Path: filepath.Join(projectDir, "tracked"), Branch: "tracked"},
+		{Path: "/elsewhere/manual", Branch: "manual"},
+	}, nil).Once()
+
+	verification, err := service.VerifyWorktrees(context.Background(), project)
+	require.NoError(t, err)
+	assert.True(t, verification.HasDiscrepancies())
+	assert.Equal(t, []string{"/elsewhere/manual"}, verification.GitOnly)
+	assert.Equal(t, []string{filepath.Join(projectDir, "orphaned")}, verification.DiscoveredOnly)
+
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/repo").Return(nil, errors.New("not a repository")).Once()
+	_, err = service.VerifyWorktrees(context.Background(), project)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to list worktrees")
+}
+
+func TestWorktreeService_VerifyWorktrees_Consistent(t *testing.T) {
+	service, gitService, _, config := setupWorktreeService()
+	config.WorktreesDirectory = t.TempDir()
+
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/repo").Return([]domain.WorktreeInfo{
+		{Path: "/repo", Branch: "main"},
+	}, nil).Once()
+
+	verification, err := service.VerifyWorktrees(context.Background(), &domain.ProjectInfo{Name: "proj", GitRepoPath: "/repo"})
+	require.NoError(t, err)
+	assert.False(t, verification.HasDiscrepancies())
+}
+
+func TestWorktreeService_GetWorktreeHealth(t *testing.T) {
+	connected := func(t *testing.T) string {
+		t.Helper()
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ".git"), []byte("gitdir: /repo/.git/worktrees/feature\n"), 0644))
+		return dir
+	}
+
+	testCases := []struct {
+		name         string
+		path         func(t *testing.T) string
+		validateErr  error
+		statusErr    error
+		conflicts    []domain.ConflictFile
+		expectBranch string
+		expectIssues []string
+	}{
+		{name: "healthy", path: connected, expectBranch: "feature"},
+		{name: "missing directory", path: func(t *testing.T) string { return filepath.Join(t.TempDir(), "gone") }, expectIssues: []string{"directory is missing"}},
+		{name: "no .git file", path: func(t *testing.T) string { return t.TempDir() }, expectIssues: []string{"no .git file"}},
+		{name: "broken repository", path: connected, validateErr: errors.New("gitdir not found"), expectIssues: []string{"not a valid git repository: gitdir not found"}},
+		{
+			name:         "conflicts and unreadable status",
+			path:         connected,
+			statusErr:    errors.New("index corrupt"),
+			conflicts:    []domain.ConflictFile{{Path: "a.go"}, {Path: "b.go"}},
+			expectIssues: []string{"cannot read git status: index corrupt", "2 file(s) with unresolved conflicts"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gitService := mocks.NewMockGitService()
+			gitService.MockGoGitClient.On("ValidateRepository", mock.Anything).Return(tc.validateErr).Maybe()
+			gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, mock.Anything).Return(domain.RepositoryStatus{Branch: "feature"}, tc.statusErr).Maybe()
+			gitService.MockCLIClient.On("GetConflictingFiles", mock.Anything, mock.Anything).Return(tc.conflicts, nil).Maybe()
+			service := NewWorktreeService(gitService, mocks.NewMockProjectService(), domain.DefaultConfig(), nil, nil)
+			path := tc.path(t)
+
+			health, err := service.GetWorktreeHealth(context.Background(), path)
+
+			require.NoError(t, err)
+			assert.Equal(t, path, health.WorktreePath)
+			assert.Equal(t, tc.expectBranch, health.Branch)
+			require.Len(t, health.Issues, len(tc.expectIssues))
+			for i, issue := range tc.expectIssues {
+				assert.Equal(t, path, health.Issues[i].WorktreePath)
+				assert.Contains(t, health.Issues[i].Issue, issue)
+			}
+		})
+	}
+
+	_, err := NewWorktreeService(mocks.NewMockGitService(), mocks.NewMockProjectService(), domain.DefaultConfig(), nil, nil).
+		GetWorktreeHealth(context.Background(), "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "worktree path cannot be empty")
+}
+
+func TestWorktreeService_ExportGitConfig(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+	linked := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(linked, ".git"), []byte("gitdir: /repo/.git/worktrees/feature\n"), 0644))
+
+	project := &domain.ProjectInfo{Name: "proj", GitRepoPath: "/repo"}
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/repo").Return([]domain.WorktreeInfo{
+		{Path: "/repo.git", IsBare: true},
+		{Path: "/repo", Branch: "main"},
+		{Path: linked, Branch: "feature"},
+	}, nil).Once()
+	gitService.MockCLIClient.On("GetAllLocalConfig", mock.Anything, "/repo").Return(map[string]string{"core.bare": "false"}, nil).Once()
+	gitService.MockCLIClient.On("GetAllLocalConfig", mock.Anything, linked).Return(map[string]string{"user.signingkey": "ABC"}, nil).Once()
+
+	configs, err := service.ExportGitConfig(context.Background(), project)
+	require.NoError(t, err)
+	require.Len(t, configs, 2)
+	assert.Equal(t, &domain.WorktreeGitConfig{Path: "/repo", Branch: "main", GitDir: "/repo/.git", Config: map[string]string{"core.bare": "false"}}, configs[0])
+	assert.Equal(t, &domain.WorktreeGitConfig{Path: linked, Branch: "feature", GitDir: "/repo/.git/worktrees/feature", Config: map[string]string{"user.signingkey": "ABC"}}, configs[1])
+
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/repo").Return([]domain.WorktreeInfo{{Path: "/repo", Branch: "main"}}, nil).Once()
+	gitService.MockCLIClient.On("GetAllLocalConfig", mock.Anything, "/repo").Return(nil, errors.New("bad config line")).Once()
+	_, err = service.ExportGitConfig(context.Background(), project)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read local config")
+}
+
+func TestWorktreeService_GetConflictingFiles(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+	conflicts := []domain.ConflictFile{{Path: "main.go", OurStatus: "modified", TheirStatus: "modified"}}
+
+	gitService.MockCLIClient.On("GetConflictingFiles", mock.Anything, "/wt/feature").Return(conflicts, nil).Once()
+	result, err := service.GetConflictingFiles(context.Background(), "/wt/feature")
+	require.NoError(t, err)
+	assert.Equal(t, conflicts, result)
+
+	gitService.MockCLIClient.On("GetConflictingFiles", mock.Anything, "/wt/broken").Return(nil, errors.New("not a repository")).Once()
+	_, err = service.GetConflictingFiles(context.Background(), "/wt/broken")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to list conflicting files")
+}
+
+func TestWorktreeService_GetWorktreeStatus_Conflicts(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+
+	calls := gitService.MockGoGitClient.ExpectedCalls[:0]
+	for _, call := range gitService.MockGoGitClient.ExpectedCalls {
+		if call.Method != "GetRepositoryStatus" {
+			calls = append(calls, call)
+		}
+	}
+	gitService.MockGoGitClient.ExpectedCalls = calls
+	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/path/to/worktree").
+		Return(domain.RepositoryStatus{IsClean: false, Modified: []string{"main.go"}}, nil)
+	conflicts := []domain.ConflictFile{{Path: "main.go", OurStatus: "modified", TheirStatus: "modified"}}
+	gitService.MockCLIClient.On("GetConflictingFiles", mock.Anything, "/path/to/worktree").Return(conflicts, nil).Once()
+
+	status, err := service.GetWorktreeStatus(context.Background(), "/path/to/worktree")
+	require.NoError(t, err)
+	assert.Equal(t, conflicts, status.ConflictFiles)
+}
+
+func TestWorktreeService_DeleteRemoteBranch(t *testing.T) {
+	t.Run("deletes branch", func(t *testing.T) {
+		service, gitService, _, _ := setupWorktreeService()
+		gitService.MockCLIClient.ExpectedCalls = nil
+		gitService.MockCLIClient.On("DeleteRemoteBranch", mock.Anything, "/repo", "origin", "feature").Return(nil).Once()
+
+		require.NoError(t, service.DeleteRemoteBranch(context.Background(), "/repo", "origin", "feature"))
+		gitService.MockCLIClient.AssertExpectations(t)
+	})
+
+	t.Run("missing branch stays matchable", func(t *testing.T) {
+		service, gitService, _, _ := setupWorktreeService()
+		gitService.MockCLIClient.ExpectedCalls = nil
+		gitService.MockCLIClient.On("DeleteRemoteBranch", mock.Anything, "/repo", "origin", "feature").
+			Return(domain.NewGitWorktreeError("/repo", "feature", "branch not found on origin", domain.ErrRemoteBranchNotFound))
+
+		err := service.DeleteRemoteBranch(context.Background(), "/repo", "origin", "feature")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to delete origin/feature")
+		assert.ErrorIs(t, err, domain.ErrRemoteBranchNotFound)
+	})
+}
+
+func TestWorktreeService_PopStash(t *testing.T) {
+	conflicts := []domain.ConflictFile{{Path: "main.go", OurStatus: "modified", TheirStatus: "modified"}}
+
+	tests := []struct {
+		name            string
+		applyErr        error
+		expectDrop      bool
+		expectConflicts []domain.ConflictFile
+		expectError     string
+	}{
+		{name: "applies and drops", expectDrop: true},
+		{
+			name:            "conflicts keep the entry",
+			applyErr:        domain.NewGitWorktreeError("/wt", "", "applying stash@{1} left conflicts", domain.ErrStashConflict),
+			expectConflicts: conflicts,
+		},
+		{
+			name:        "apply failure",
+			applyErr:    domain.NewGitWorktreeError("/wt", "", "stash@{1} does not exist", nil),
+			expectError: "failed to apply stash@{1}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, gitService, _, _ := setupWorktreeService()
+			gitService.MockCLIClient.ExpectedCalls = nil
+			gitService.MockCLIClient.On("ApplyStash", mock.Anything, "/wt", "/wt", 1).Return(tt.applyErr).Once()
+			gitService.MockCLIClient.On("GetConflictingFiles", mock.Anything, "/wt").Return(conflicts, nil)
+			gitService.MockCLIClient.On("DropStash", mock.Anything, "/wt", 1).Return(nil)
+
+			result, err := service.PopStash(context.Background(), "/wt", 1)
+
+			if tt.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectConflicts, result)
+			if tt.expectDrop {
+				gitService.MockCLIClient.AssertCalled(t, "DropStash", mock.Anything, "/wt", 1)
+			} else {
+				gitService.MockCLIClient.AssertNotCalled(t, "DropStash", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
 	}
+}
 
-	summaries := []*domain.ProjectSummary{
-		{Name: project1.Name, Path: project1.Path, GitRepoPath: project1.GitRepoPath},
-		{Name: project2.Name, Path: project2.Path, GitRepoPath: project2.GitRepoPath},
+func TestWorktreeService_FetchAllRemotes(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("FetchAllRemotes", mock.Anything, "/repo").
+		Return(domain.NewGitWorktreeError("/repo", "", "git fetch --all failed: no remote", nil)).Once()
+
+	err := service.FetchAllRemotes(context.Background(), "/repo")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to fetch remotes")
+	gitService.MockCLIClient.AssertExpectations(t)
+}
+
+func TestWorktreeService_SyncWorktree(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		name          string
+		ctx           context.Context
+		worktree      *domain.WorktreeInfo
+		clean         bool
+		commits       int
+		pullErr       error
+		cancelOnPull  bool
+		expectOutcome domain.SyncOutcome
+		expectPull    bool
+	}{
+		{name: "updated", clean: true, commits: 2, expectOutcome: domain.SyncUpdated, expectPull: true},
+		{name: "up to date", clean: true, expectOutcome: domain.SyncUpToDate, expectPull: true},
+		{name: "dirty worktree is skipped", clean: false, expectOutcome: domain.SyncSkipped},
+		{
+			name:          "detached HEAD is skipped",
+			worktree:      &domain.WorktreeInfo{Path: "/wt", IsDetached: true},
+			expectOutcome: domain.SyncSkipped,
+		},
+		{
+			name:          "conflict",
+			clean:         true,
+			pullErr:       domain.NewGitWorktreeError("/wt", "", "pull conflicts with local commits", domain.ErrConflict),
+			expectOutcome: domain.SyncConflict,
+			expectPull:    true,
+		},
+		{
+			name:          "failed pull",
+			clean:         true,
+			pullErr:       domain.NewGitWorktreeError("/wt", "", "git pull failed: no upstream", nil),
+			expectOutcome: domain.SyncFailed,
+			expectPull:    true,
+		},
+		{name: "cancelled before starting", ctx: cancelled, expectOutcome: domain.SyncCancelled},
+		{
+			name:          "interrupted during pull",
+			clean:         true,
+			cancelOnPull:  true,
+			pullErr:       domain.NewGitWorktreeError("/wt", "", "pull interrupted", context.Canceled),
+			expectOutcome: domain.SyncInterrupted,
+			expectPull:    true,
+		},
 	}
-	projectService.On("ListProjectSummaries", mock.Anything).Return(summaries, nil).Once()
-	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/path/to/project1/.git").Return([]domain.WorktreeInfo{
-		{Path: "/path/to/wt1", Branch: "feature-1", Commit: "abc123"},
-	}, nil).Once()
-	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/path/to/project2/.git").Return([]domain.WorktreeInfo{
-		{Path: "/path/to/wt2", Branch: "feature-2", Commit: "def456"},
-	}, nil).Once()
-	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(true, nil)
-	gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), false).Return(nil)
 
-	req := &domain.PruneWorktreesRequest{
-		Context:     &domain.Context{Type: domain.ContextOutsideGit},
-		AllProjects: true,
-		DryRun:      false,
-		Force:       false,
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service, gitService, _, _ := setupWorktreeService()
+			gitService.MockGoGitClient.ExpectedCalls = nil
+			gitService.MockCLIClient.ExpectedCalls = nil
+			gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/wt").Return(domain.RepositoryStatus{IsClean: tc.clean}, nil)
+			ctx := tc.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			ctx, cancelPull := context.WithCancel(ctx)
+			defer cancelPull()
+			gitService.MockCLIClient.On("Pull", mock.Anything, "/wt", true).Run(func(mock.Arguments) {
+				if tc.cancelOnPull {
+					cancelPull()
+				}
+			}).Return(tc.commits, tc.pullErr)
+			worktree := tc.worktree
+			if worktree == nil {
+				worktree = &domain.WorktreeInfo{Path: "/wt", Branch: "feature"}
+			}
+
+			result := service.SyncWorktree(ctx, worktree, true)
+			assert.Equal(t, tc.expectOutcome, result.Outcome)
+			assert.Same(t, worktree, result.Worktree)
+			if tc.expectOutcome == domain.SyncUpdated {
+				assert.Equal(t, tc.commits, result.Commits)
+				assert.NoError(t, result.Err)
+			}
+			if tc.expectOutcome == domain.SyncConflict {
+				assert.ErrorIs(t, result.Err, domain.ErrConflict)
+			}
+			if tc.expectOutcome == domain.SyncCancelled || tc.expectOutcome == domain.SyncInterrupted {
+				assert.ErrorIs(t, result.Err, context.Canceled)
+			}
+			if tc.expectPull {
+				gitService.MockCLIClient.AssertCalled(t, "Pull", mock.Anything, "/wt", true)
+			} else {
+				gitService.MockCLIClient.AssertNotCalled(t, "Pull", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
 	}
+}
 
-	result, err := service.PruneMergedWorktrees(context.Background(), req)
-	require.NoError(t, err)
-	assert.NotNil(t, result)
-	assert.Equal(t, 2, result.TotalDeleted)
+func TestWorktreeService_CheckRepositoryIntegrity(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("Fsck", mock.Anything, "/repo").Return(nil).Once()
+	gitService.MockCLIClient.On("Fsck", mock.Anything, "/broken").
+		Return(domain.NewGitRepositoryError("/broken", "git fsck failed: missing blob", nil)).Once()
+
+	require.NoError(t, service.CheckRepositoryIntegrity(context.Background(), "/repo"))
+
+	err := service.CheckRepositoryIntegrity(context.Background(), "/broken")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "repository objects are corrupt or missing")
+	var gitErr *domain.GitRepositoryError
+	require.ErrorAs(t, err, &gitErr)
+	assert.Contains(t, gitErr.Message, "missing blob")
+	gitService.MockCLIClient.AssertExpectations(t)
 }
 
-func TestWorktreeService_PruneMergedWorktrees_CurrentWorktreeSkipped(t *testing.T) {
+func TestWorktreeService_FetchTagsOnly(t *testing.T) {
 	service, gitService, _, _ := setupWorktreeService()
+	gitService.MockCLIClient.ExpectedCalls = nil
+	gitService.MockCLIClient.On("FetchTagsOnly", mock.Anything, "/repo", "origin").Return(nil).Once()
+	gitService.MockCLIClient.On("PruneTags", mock.Anything, "/repo").
+		Return(domain.NewGitWorktreeError("/repo", "", "git fetch --prune-tags failed: no remote", nil)).Once()
 
-	tempDir := t.TempDir()
-	worktreeCurrentPath := tempDir + "/worktree-current"
-	worktreeOtherPath := tempDir + "/worktree-other"
+	require.NoError(t, service.FetchTagsOnly(context.Background(), "/repo", "origin"))
+
+	err := service.PruneTags(context.Background(), "/repo")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to prune tags")
+	gitService.MockCLIClient.AssertExpectations(t)
+}
 
+func TestWorktreeService_LockWorktree(t *testing.T) {
+	service, gitService, _, _ := setupWorktreeService()
 	gitService.MockCLIClient.ExpectedCalls = nil
-	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, mock.AnythingOfType("string")).Return([]domain.WorktreeInfo{
-		{Path: worktreeCurrentPath, Branch: "feature-current", Commit: "abc123"},
-		{Path: worktreeOtherPath, Branch: "feature-other", Commit: "def456"},
-	}, nil).Once()
-	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-current").Return(true, nil).Maybe()
-	gitService.MockCLIClient.On("IsBranchMerged", mock.Anything, mock.AnythingOfType("string"), "feature-other").Return(true, nil).Maybe()
-	gitService.MockCLIClient.On("DeleteWorktree", mock.Anything, mock.AnythingOfType("string"), worktreeOtherPath, mock.AnythingOfType("bool")).Return(nil).Maybe()
+	gitService.MockCLIClient.On("LockWorktree", mock.Anything, "/repo", "/wt/feature", "maintenance").Return(nil).Once()
+	gitService.MockCLIClient.On("UnlockWorktree", mock.Anything, "/repo", "/wt/feature").
+		Return(domain.NewGitWorktreeError("/wt/feature", "", "git worktree unlock failed: not locked", nil)).Once()
+
+	require.NoError(t, service.LockWorktree(context.Background(), "/repo", "/wt/feature", "maintenance"))
+
+	err := service.UnlockWorktree(context.Background(), "/repo", "/wt/feature")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to unlock worktree")
+	gitService.MockCLIClient.AssertExpectations(t)
+}
+
+func TestWorktreeService_SetMergeStrategy(t *testing.T) {
+	testCases := []struct {
+		strategy domain.MergeStrategy
+		key      string
+		value    string
+	}{
+		{strategy: domain.MergeStrategyMerge},
+		{strategy: domain.MergeStrategySquash, key: "branch.feature.mergeOptions", value: "--squash"},
+		{strategy: domain.MergeStrategyRebase, key: "branch.feature.rebase", value: "true"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.strategy), func(t *testing.T) {
+			service, gitService, _, _ := setupWorktreeService()
+			gitService.MockCLIClient.ExpectedCalls = nil
+			if tc.key != "" {
+				gitService.MockCLIClient.On("SetConfig", mock.Anything, "/wt/feature", tc.key, tc.value).Return(nil).Once()
+			}
+
+			require.NoError(t, service.SetMergeStrategy(context.Background(), "/wt/feature", "feature", tc.strategy))
+			gitService.MockCLIClient.AssertExpectations(t)
+			if tc.key == "" {
+				gitService.MockCLIClient.AssertNotCalled(t, "SetConfig", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+
+	t.Run("set failure", func(t *testing.T) {
+		service, gitService, _, _ := setupWorktreeService()
+		gitService.MockCLIClient.ExpectedCalls = nil
+		gitService.MockCLIClient.On("SetConfig", mock.Anything, "/wt/feature", "branch.feature.mergeOptions", "--squash").Return(errors.New("locked"))
+
+		err := service.SetMergeStrategy(context.Background(), "/wt/feature", "feature", domain.MergeStrategySquash)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to set squash merge strategy")
+	})
+}
+
+func TestWorktreeService_EnableCommitSigning(t *testing.T) {
+	testCases := []struct {
+		name        string
+		configKey   string
+		globalKey   string
+		globalErr   error
+		setErr      error
+		expectKey   string
+		expectError string
+	}{
+		{name: "configured key wins", configKey: "CFG123", expectKey: "CFG123"},
+		{name: "falls back to global signing key", globalKey: "GLOBAL456", expectKey: "GLOBAL456"},
+		{name: "no key signs with committer identity"},
+		{name: "global config failure", globalErr: errors.New("bad config"), expectError: "failed to read signing key"},
+		{name: "set failure", configKey: "CFG123", setErr: errors.New("locked"), expectError: "failed to enable commit signing"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			service, gitService, _, config := setupWorktreeService()
+			config.Git.GPGSigningKey = tc.configKey
+
+			gitService.MockCLIClient.On("GetGlobalConfig", mock.Anything, "user.signingkey").Return(tc.globalKey, tc.globalErr)
+			gitService.MockCLIClient.On("SetConfig", mock.Anything, "/wt/feature", "commit.gpgsign", "true").Return(tc.setErr)
+			if tc.expectKey != "" {
+				gitService.MockCLIClient.On("SetConfig", mock.Anything, "/wt/feature", "user.signingkey", tc.expectKey).Return(nil).Once()
+			}
+
+			err := service.EnableCommitSigning(context.Background(), "/wt/feature")
+			if tc.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectError)
+				return
+			}
+			require.NoError(t, err)
+			if tc.configKey != "" {
+				gitService.MockCLIClient.AssertNotCalled(t, "GetGlobalConfig", mock.Anything, mock.Anything)
+			}
+			if tc.expectKey == "" {
+				gitService.MockCLIClient.AssertNotCalled(t, "SetConfig", mock.Anything, mock.Anything, "user.signingkey", mock.Anything)
+			} else {
+				gitService.MockCLIClient.AssertCalled(t, "SetConfig", mock.Anything, "/wt/feature", "user.signingkey", tc.expectKey)
+			}
+		})
+	}
+}
+
+func TestWorktreeService_DeleteWorktree_StopsProcesses(t *testing.T) {
+	newService := func(processes *mocks.MockProcessManager) (application.WorktreeService, *mocks.MockGitService) {
+		gitService := mocks.NewMockGitService()
+		projectService := mocks.NewMockProjectService()
+		testProject := &domain.ProjectInfo{
+			Name:        "test-project",
+			Path:        "/path/to/project",
+			GitRepoPath: "/path/to/project/.git",
+			Worktrees:   []*domain.WorktreeInfo{{Path: "/path/to/worktree", Branch: "feature-branch"}},
+		}
+		configureWorktreeServiceMocks(gitService, projectService, testProject)
+		return NewWorktreeService(gitService, projectService, domain.DefaultConfig(), nil, processes), gitService
+	}
+	request := &domain.DeleteWorktreeRequest{
+		WorktreePath: "/path/to/worktree",
+		Context:      &domain.Context{Type: domain.ContextWorktree},
+	}
+
+	t.Run("kills processes before deleting", func(t *testing.T) {
+		processes := mocks.NewMockProcessManager()
+		processes.On("Kill", mock.Anything, "/path/to/worktree").Return([]domain.ManagedProcess{{PID: 42}}, nil).Once()
+		service, gitService := newService(processes)
+
+		require.NoError(t, service.DeleteWorktree(context.Background(), request))
+		processes.AssertExpectations(t)
+		gitService.MockCLIClient.AssertCalled(t, "DeleteWorktree", mock.Anything, "/path/to/project/.git", "/path/to/worktree", false)
+	})
+
+	t.Run("kill failure does not block deletion", func(t *testing.T) {
+		processes := mocks.NewMockProcessManager()
+		processes.On("Kill", mock.Anything, "/path/to/worktree").Return([]domain.ManagedProcess{}, errors.New("failed to send SIGTERM to process 42: operation not permitted")).Once()
+		service, gitService := newService(processes)
+
+		require.NoError(t, service.DeleteWorktree(context.Background(), request))
+		gitService.MockCLIClient.AssertCalled(t, "DeleteWorktree", mock.Anything, "/path/to/project/.git", "/path/to/worktree", false)
+	})
+
+	t.Run("cancellation aborts deletion", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		processes := mocks.NewMockProcessManager()
+		processes.On("Kill", mock.Anything, "/path/to/worktree").Return(nil, context.Canceled).Once()
+		service, gitService := newService(processes)
+
+		err := service.DeleteWorktree(ctx, request)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to stop worktree processes")
+		gitService.MockCLIClient.AssertNotCalled(t, "DeleteWorktree", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestWorktreeService_DeleteWorktree_DeleteBranch(t *testing.T) {
+	request := &domain.DeleteWorktreeRequest{
+		WorktreePath: "/path/to/worktree",
+		DeleteBranch: true,
+		Context:      &domain.Context{Type: domain.ContextWorktree},
+	}
+
+	t.Run("deletes the branch after the worktree", func(t *testing.T) {
+		service, gitService, _, _ := setupWorktreeService()
+
+		require.NoError(t, service.DeleteWorktree(context.Background(), request))
+		gitService.MockCLIClient.AssertCalled(t, "DeleteWorktree", mock.Anything, "/path/to/project/.git", "/path/to/worktree", false)
+		gitService.MockCLIClient.AssertCalled(t, "DeleteBranch", mock.Anything, "/path/to/project/.git", "feature-branch")
+	})
+
+	t.Run("branch failure is reported after deletion", func(t *testing.T) {
+		service, gitService, _, _ := setupWorktreeService()
+		gitService.MockCLIClient.ExpectedCalls = slices.DeleteFunc(gitService.MockCLIClient.ExpectedCalls, func(call *mock.Call) bool {
+			return call.Method == "DeleteBranch"
+		})
+		gitService.MockCLIClient.On("DeleteBranch", mock.Anything, "/path/to/project/.git", "feature-branch").Return(errors.New("checked out elsewhere"))
+
+		err := service.DeleteWorktree(context.Background(), request)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "worktree deleted but branch deletion failed")
+	})
+
+	t.Run("branch is kept without the flag", func(t *testing.T) {
+		service, gitService, _, _ := setupWorktreeService()
+
+		require.NoError(t, service.DeleteWorktree(context.Background(), &domain.DeleteWorktreeRequest{
+			WorktreePath: "/path/to/worktree",
+			Context:      &domain.Context{Type: domain.ContextWorktree},
+		}))
+		gitService.MockCLIClient.AssertNotCalled(t, "DeleteBranch", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestFilterWorktreesBySinceCommit(t *testing.T) {
+	released := &domain.WorktreeInfo{Path: "/wt/released", Branch: "released", Commit: "aaa"}
+	active := &domain.WorktreeInfo{Path: "/wt/active", Branch: "active", Commit: "bbb"}
+	detached := &domain.WorktreeInfo{Path: "/wt/detached", Branch: "(detached)", Commit: "ccc", IsDetached: true}
+	unrelated := &domain.WorktreeInfo{Path: "/wt/unrelated", Branch: "unrelated", Commit: "ddd"}
+
+	gitService := mocks.NewMockGitService()
+	gitService.MockCLIClient.On("GetMergeBase", mock.Anything, "/wt/released", "released", "v1.2.3").Return("aaa", nil)
+	gitService.MockCLIClient.On("GetMergeBase", mock.Anything, "/wt/active", "active", "v1.2.3").Return("base", nil)
+	gitService.MockCLIClient.On("LogBetween", mock.Anything, "/wt/active", "v1.2.3", "active").
+		Return([]domain.CommitInfo{{Hash: "bbb"}, {Hash: "b2"}}, nil)
+	gitService.MockCLIClient.On("GetMergeBase", mock.Anything, "/wt/detached", "ccc", "v1.2.3").Return("base", nil)
+	gitService.MockCLIClient.On("LogBetween", mock.Anything, "/wt/detached", "v1.2.3", "ccc").
+		Return([]domain.CommitInfo{{Hash: "ccc"}}, nil)
+	gitService.MockCLIClient.On("GetMergeBase", mock.Anything, "/wt/unrelated", "unrelated", "v1.2.3").Return("", nil)
+	gitService.MockCLIClient.On("LogBetween", mock.Anything, "/wt/unrelated", "v1.2.3", "unrelated").
+		Return([]domain.CommitInfo{{Hash: "ddd"}}, nil)
+
+	result, err := FilterWorktreesBySinceCommit(context.Background(),
+		[]*domain.WorktreeInfo{released, active, detached, unrelated}, "v1.2.3", gitService)
 
-	originalWd, err := os.Getwd()
 	require.NoError(t, err)
-	defer os.Chdir(originalWd)
+	require.Len(t, result, 3)
+	assert.Equal(t, []*domain.WorktreeInfo{active, detached, unrelated}, result)
+	assert.Equal(t, 2, active.AheadCount)
+	assert.Equal(t, 1, detached.AheadCount)
+	assert.Equal(t, 0, released.AheadCount)
+	gitService.MockCLIClient.AssertNotCalled(t, "LogBetween", mock.Anything, "/wt/released", mock.Anything, mock.Anything)
+}
 
-	err = os.MkdirAll(worktreeCurrentPath, 0755)
+func TestFilterWorktreesBySinceCommit_UnknownRef(t *testing.T) {
+	gitService := mocks.NewMockGitService()
+	gitService.MockCLIClient.On("GetMergeBase", mock.Anything, "/wt/feature", "feature", "v9").
+		Return("", errors.New("Not a valid object name v9"))
+
+	_, err := FilterWorktreesBySinceCommit(context.Background(),
+		[]*domain.WorktreeInfo{{Path: "/wt/feature", Branch: "feature"}}, "v9", gitService)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to compare with v9")
+}
+
+func TestFilterMerged(t *testing.T) {
+	merged := &domain.WorktreeInfo{Path: "/wt/merged", Branch: "merged", Commit: "aaa"}
+	active := &domain.WorktreeInfo{Path: "/wt/active", Branch: "active", Commit: "bbb"}
+	detached := &domain.WorktreeInfo{Path: "/wt/detached", Commit: "ccc", IsDetached: true}
+	broken := &domain.WorktreeInfo{Path: "/wt/broken", Branch: "broken", Commit: "ddd"}
+
+	gitService := mocks.NewMockGitService()
+	gitService.MockCLIClient.On("GetMergeBase", mock.Anything, "/wt/merged", "merged", "main").Return("aaa", nil)
+	gitService.MockCLIClient.On("GetMergeBase", mock.Anything, "/wt/active", "active", "main").Return("base", nil)
+	gitService.MockCLIClient.On("GetMergeBase", mock.Anything, "/wt/detached", "ccc", "main").Return("ccc", nil)
+	gitService.MockCLIClient.On("GetMergeBase", mock.Anything, "/wt/broken", "broken", "main").Return("", errors.New("bad ref"))
+
+	result := domain.FilterWorktrees([]*domain.WorktreeInfo{merged, active, detached, broken},
+		FilterMerged(context.Background(), "main", gitService))
+
+	assert.Equal(t, []*domain.WorktreeInfo{merged, detached}, result)
+}
+
+func TestWorktreeService_GetProjectSummary(t *testing.T) {
+	gitService := mocks.NewMockGitService()
+	projectService := mocks.NewMockProjectService()
+	project := &domain.ProjectInfo{Name: "proj", Path: "/repo", GitRepoPath: "/repo"}
+
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/repo").Return([]domain.WorktreeInfo{
+		{Path: "/repo", Branch: "main"},
+		{Path: "/wt/proj/dirty", Branch: "dirty"},
+		{Path: "/wt/proj/broken", Branch: "broken"},
+		{Path: "/wt/proj/conflicted", Branch: "conflicted"},
+		{Path: "/wt/proj/clean", Branch: "clean"},
+	}, nil)
+	gitService.MockGoGitClient.On("ValidateRepository", mock.AnythingOfType("string")).Return(nil)
+	projectService.On("FindProjectByWorktreePath", mock.Anything, mock.AnythingOfType("string")).Return(project, nil)
+
+	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/wt/proj/dirty").
+		Return(domain.RepositoryStatus{Modified: []string{"a.go"}}, nil)
+	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/wt/proj/broken").
+		Return(domain.RepositoryStatus{}, errors.New("index corrupt"))
+	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/wt/proj/conflicted").
+		Return(domain.RepositoryStatus{Modified: []string{"b.go"}}, nil)
+	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/wt/proj/clean").
+		Return(domain.RepositoryStatus{IsClean: true}, nil)
+	gitService.MockCLIClient.On("GetConflictingFiles", mock.Anything, "/wt/proj/dirty").Return([]domain.ConflictFile{}, nil)
+	gitService.MockCLIClient.On("GetConflictingFiles", mock.Anything, "/wt/proj/conflicted").
+		Return([]domain.ConflictFile{{Path: "b.go", OurStatus: "modified", TheirStatus: "modified"}}, nil)
+
+	service := NewWorktreeService(gitService, projectService, &domain.Config{}, nil, nil)
+
+	summary, err := service.GetProjectSummary(context.Background(), "/repo")
 	require.NoError(t, err)
-	err = os.Chdir(worktreeCurrentPath)
+	assert.Equal(t, &domain.ProjectStatusSummary{
+		ProjectPath: "/repo",
+		Worktrees:   4,
+		Dirty:       2,
+		Conflicts:   1,
+		Errors:      1,
+	}, summary)
+
+	t.Run("empty project path", func(t *testing.T) {
+		_, err := service.GetProjectSummary(context.Background(), "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "project path cannot be empty")
+	})
+
+	t.Run("list failure", func(t *testing.T) {
+		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/missing").Return(nil, errors.New("not a repository"))
+		_, err := service.GetProjectSummary(context.Background(), "/missing")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to list worktrees")
+	})
+}
+
+func TestWorktreeService_GetWorktreeStats(t *testing.T) {
+	gitService := mocks.NewMockGitService()
+	projectService := mocks.NewMockProjectService()
+	project := &domain.ProjectInfo{Name: "proj", Path: "/repo", GitRepoPath: "/repo"}
+	oldest := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	newest := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/repo").Return([]domain.WorktreeInfo{
+		{Path: "/repo", Branch: "main", Commit: "c0"},
+		{Path: "/wt/proj/ahead", Branch: "ahead", Commit: "c1"},
+		{Path: "/wt/proj/behind", Branch: "behind", Commit: "c2"},
+		{Path: "/wt/proj/dirty", Branch: "dirty", Commit: "c3"},
+		{Path: "/wt/proj/broken", Branch: "broken", Commit: "c4"},
+		{Path: "/wt/proj/detached", IsDetached: true},
+	}, nil)
+	gitService.MockGoGitClient.On("ValidateRepository", mock.AnythingOfType("string")).Return(nil)
+	projectService.On("FindProjectByWorktreePath", mock.Anything, mock.AnythingOfType("string")).Return(project, nil)
+
+	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/wt/proj/broken").
+		Return(domain.RepositoryStatus{}, errors.New("index corrupt"))
+	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/wt/proj/dirty").
+		Return(domain.RepositoryStatus{Modified: []string{"a.go"}}, nil)
+	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, mock.Anything).
+		Return(domain.RepositoryStatus{IsClean: true}, nil)
+	gitService.MockCLIClient.On("GetConflictingFiles", mock.Anything, mock.Anything).Return([]domain.ConflictFile{}, nil)
+
+	gitService.MockCLIClient.On("GetUpstreamBranch", mock.Anything, "/wt/proj/dirty", "dirty").Return("", nil)
+	gitService.MockCLIClient.On("GetUpstreamBranch", mock.Anything, mock.Anything, mock.Anything).
+		Return("origin/x", nil)
+	gitService.MockCLIClient.On("GetBranchRelationship", mock.Anything, "/wt/proj/ahead", "ahead", "origin/x").
+		Return(&domain.BranchRelationship{AheadCount: 2}, nil)
+	gitService.MockCLIClient.On("GetBranchRelationship", mock.Anything, "/wt/proj/behind", "behind", "origin/x").
+		Return(&domain.BranchRelationship{BehindCount: 1}, nil)
+
+	gitService.MockGoGitClient.On("GetCommitInfo", mock.Anything, "/repo", "c1").Return(&domain.CommitInfo{Date: newest}, nil)
+	gitService.MockGoGitClient.On("GetCommitInfo", mock.Anything, "/repo", "c2").Return(&domain.CommitInfo{Date: oldest}, nil)
+	gitService.MockGoGitClient.On("GetCommitInfo", mock.Anything, "/repo", "c3").Return(nil, errors.New("missing object"))
+
+	service := NewWorktreeService(gitService, projectService, &domain.Config{}, nil, nil)
+
+	stats, err := service.GetWorktreeStats(context.Background(), "/repo")
 	require.NoError(t, err)
+	assert.Equal(t, &domain.WorktreeStats{
+		ProjectPath:  "/repo",
+		Total:        5,
+		Clean:        3,
+		Dirty:        1,
+		Ahead:        1,
+		Behind:       1,
+		Errors:       1,
+		OldestCommit: oldest,
+		NewestCommit: newest,
+	}, stats)
+
+	t.Run("empty project path", func(t *testing.T) {
+		_, err := service.GetWorktreeStats(context.Background(), "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "project path cannot be empty")
+	})
 
-	req := &domain.PruneWorktreesRequest{
-		Context:        &domain.Context{Type: domain.ContextProject, ProjectName: "test-project", Path: "/path/to/project"},
-		DryRun:         false,
-		Force:          true,
-		DeleteBranches: false,
-	}
+	t.Run("list failure", func(t *testing.T) {
+		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/missing").Return(nil, errors.New("not a repository"))
+		_, err := service.GetWorktreeStats(context.Background(), "/missing")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to list worktrees")
+	})
+}
 
-	result, err := service.PruneMergedWorktrees(context.Background(), req)
+func TestWorktreeService_DiscoverWorktreesWithFilter(t *testing.T) {
+	gitService := mocks.NewMockGitService()
+	projectService := mocks.NewMockProjectService()
+	project := &domain.ProjectInfo{Name: "proj", Path: "/repo", GitRepoPath: "/repo"}
+
+	gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/repo").Return([]domain.WorktreeInfo{
+		{Path: "/repo", Branch: "main"},
+		{Path: "/wt/proj/feature-a", Branch: "feature/a"},
+		{Path: "/wt/proj/fix", Branch: "fix"},
+		{Path: "/wt/proj/feature-b", Branch: "feature/b", IsDetached: true},
+	}, nil)
+	gitService.MockGoGitClient.On("ValidateRepository", mock.AnythingOfType("string")).Return(nil)
+	projectService.On("FindProjectByWorktreePath", mock.Anything, mock.AnythingOfType("string")).Return(project, nil)
+	gitService.MockGoGitClient.On("GetRepositoryStatus", mock.Anything, "/wt/proj/feature-a").
+		Return(domain.RepositoryStatus{IsClean: true, Branch: "feature/a"}, nil)
+
+	service := NewWorktreeService(gitService, projectService, &domain.Config{}, nil, nil)
+
+	filter := domain.CombineFilters(
+		func(wt *domain.WorktreeInfo) bool { return strings.HasPrefix(wt.Branch, "feature/") },
+		func(wt *domain.WorktreeInfo) bool { return !wt.IsDetached },
+	)
+	statuses, err := service.DiscoverWorktreesWithFilter(context.Background(), "/repo", filter)
 	require.NoError(t, err)
-	assert.NotNil(t, result)
-	assert.Equal(t, 1, result.TotalDeleted, "should delete non-current worktree")
-	assert.Equal(t, 1, result.TotalSkipped, "should skip current worktree")
-	assert.Len(t, result.CurrentWorktreeSkipped, 1)
-	assert.Equal(t, "feature-current", result.CurrentWorktreeSkipped[0].BranchName)
-	assert.Contains(t, result.CurrentWorktreeSkipped[0].SkipReason, "cannot prune current worktree")
+	require.Len(t, statuses, 1)
+	assert.Equal(t, "/wt/proj/feature-a", statuses[0].WorktreeInfo.Path)
+	assert.True(t, statuses[0].IsClean)
+	gitService.MockGoGitClient.AssertNumberOfCalls(t, "GetRepositoryStatus", 1)
+
+	t.Run("empty project path", func(t *testing.T) {
+		_, err := service.DiscoverWorktreesWithFilter(context.Background(), "", nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "project path cannot be empty")
+	})
+
+	t.Run("list failure", func(t *testing.T) {
+		gitService.MockCLIClient.On("ListWorktrees", mock.Anything, "/missing").Return(nil, errors.New("not a repository"))
+		_, err := service.DiscoverWorktreesWithFilter(context.Background(), "/missing", nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to list worktrees")
+	})
+}
+
+func TestWorktreeService_ValidateHooks(t *testing.T) {
+	t.Run("returns hooks directory and issues", func(t *testing.T) {
+		service, gitService, _, _ := setupWorktreeService()
+		issues := []domain.HookIssue{{HookName: "pre-commit", Issue: "not executable (run chmod +x /repo/.git/hooks/pre-commit)"}}
+		gitService.MockCLIClient.On("GetHooksDir", mock.Anything, "/repo").Return("/repo/.git/hooks", nil)
+		gitService.MockCLIClient.On("ValidateHooks", mock.Anything, "/repo").Return(issues)
+
+		dir, result, err := service.ValidateHooks(context.Background(), "/repo")
+		require.NoError(t, err)
+		assert.Equal(t, "/repo/.git/hooks", dir)
+		assert.Equal(t, issues, result)
+	})
+
+	t.Run("hooks directory lookup failure", func(t *testing.T) {
+		service, gitService, _, _ := setupWorktreeService()
+		gitService.MockCLIClient.On("GetHooksDir", mock.Anything, "/repo").Return("", errors.New("not a git repository"))
+
+		_, _, err := service.ValidateHooks(context.Background(), "/repo")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to locate hooks directory")
+		gitService.MockCLIClient.AssertNotCalled(t, "ValidateHooks", mock.Anything, mock.Anything)
+	})
+}
+
+func TestWorktreeService_GetWorktreeDetails(t *testing.T) {
+	worktree := &domain.WorktreeInfo{Path: "/wt/feature", Branch: "feature"}
+	stashes := []domain.StashEntry{
+		{Index: 0, Message: "WIP on feature: abc1234 Add login"},
+		{Index: 1, Message: "On main: auto-stash"},
+		{Index: 2, Message: "On feature: before rebase"},
+	}
+	commit := &domain.CommitInfo{Author: "Jane Doe", Message: "Fix login redirect"}
+
+	tests := []struct {
+		name     string
+		worktree *domain.WorktreeInfo
+		setup    func(*mocks.MockGitService)
+		expected *domain.WorktreeDetails
+	}{
+		{
+			name:     "tracking branch, stashes and last commit",
+			worktree: worktree,
+			setup: func(g *mocks.MockGitService) {
+				g.MockCLIClient.On("GetUpstreamBranch", mock.Anything, "/wt/feature", "feature").Return("origin/feature", nil)
+				g.MockCLIClient.On("GetBranchRelationship", mock.Anything, "/wt/feature", "feature", "origin/feature").
+					Return(&domain.BranchRelationship{AheadCount: 2, BehindCount: 1}, nil)
+				g.MockCLIClient.On("GetStashList", mock.Anything, "/wt/feature").Return(stashes, nil)
+				g.MockCLIClient.On("GetLastActivity", mock.Anything, "/wt/feature").Return(commit, nil)
+			},
+			expected: &domain.WorktreeDetails{
+				Upstream: "origin/feature", AheadCount: 2, BehindCount: 1, StashCount: 2,
+				LastAuthor: "Jane Doe", LastMessage: "Fix login redirect",
+			},
+		},
+		{
+			name:     "no upstream",
+			worktree: worktree,
+			setup: func(g *mocks.MockGitService) {
+				g.MockCLIClient.On("GetUpstreamBranch", mock.Anything, "/wt/feature", "feature").Return("", nil)
+				g.MockCLIClient.On("GetStashList", mock.Anything, "/wt/feature").Return(nil, nil)
+				g.MockCLIClient.On("GetLastActivity", mock.Anything, "/wt/feature").Return(commit, nil)
+			},
+			expected: &domain.WorktreeDetails{LastAuthor: "Jane Doe", LastMessage: "Fix login redirect"},
+		},
+		{
+			name:     "detached worktree has no upstream or stashes",
+			worktree: &domain.WorktreeInfo{Path: "/wt/feature", IsDetached: true},
+			setup: func(g *mocks.MockGitService) {
+				g.MockCLIClient.On("GetStashList", mock.Anything, "/wt/feature").Return(stashes, nil)
+				g.MockCLIClient.On("GetLastActivity", mock.Anything, "/wt/feature").Return(commit, nil)
+			},
+			expected: &domain.WorktreeDetails{LastAuthor: "Jane Doe", LastMessage: "Fix login redirect"},
+		},
+		{
+			name:     "failed lookups keep their errors",
+			worktree: worktree,
+			setup: func(g *mocks.MockGitService) {
+				g.MockCLIClient.On("GetUpstreamBranch", mock.Anything, "/wt/feature", "feature").Return("origin/feature", nil)
+				g.MockCLIClient.On("GetBranchRelationship", mock.Anything, "/wt/feature", "feature", "origin/feature").Return(nil, assert.AnError)
+				g.MockCLIClient.On("GetStashList", mock.Anything, "/wt/feature").Return(nil, assert.AnError)
+				g.MockCLIClient.On("GetLastActivity", mock.Anything, "/wt/feature").Return(commit, nil)
+			},
+			expected: &domain.WorktreeDetails{
+				UpstreamErr: assert.AnError, StashErr: assert.AnError,
+				LastAuthor: "Jane Doe", LastMessage: "Fix login redirect",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gitService := mocks.NewMockGitService()
+			tt.setup(gitService)
+			service := NewWorktreeService(gitService, mocks.NewMockProjectService(), domain.DefaultConfig(), nil, nil)
+
+			details := service.GetWorktreeDetails(context.Background(), tt.worktree)
+
+			assert.Equal(t, tt.expected, details)
+			gitService.MockCLIClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWorktreeService_GetWorktreeDetails_Timeout(t *testing.T) {
+	gitService := mocks.NewMockGitService()
+	gitService.MockCLIClient.On("GetUpstreamBranch", mock.Anything, "/wt/feature", "feature").Return("", nil)
+	gitService.MockCLIClient.On("GetStashList", mock.Anything, "/wt/feature").Return(nil, nil)
+	// A hung git process: the lookup only returns once the deadline cancels it
+	gitService.MockCLIClient.On("GetLastActivity", mock.Anything, "/wt/feature").
+		Run(func(args mock.Arguments) { <-args.Get(0).(context.Context).Done() }).
+		Return(nil, errors.New("signal: killed"))
+	service := NewWorktreeService(gitService, mocks.NewMockProjectService(), domain.DefaultConfig(), nil, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	details := service.GetWorktreeDetails(ctx, &domain.WorktreeInfo{Path: "/wt/feature", Branch: "feature"})
+
+	require.ErrorIs(t, details.ActivityErr, context.DeadlineExceeded)
+	assert.NoError(t, details.UpstreamErr)
+	assert.NoError(t, details.StashErr)
+	assert.Empty(t, details.LastAuthor)
 }
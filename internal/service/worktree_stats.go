@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"twiggit/internal/domain"
+)
+
+// defaultStatsConcurrency caps the worktrees read at once when services.max_concurrent is unset
+const defaultStatsConcurrency = 4
+
+// GetWorktreeStats reads the status of the project's linked worktrees concurrently and adds
+// them up. The main worktree is excluded as in GetProjectSummary. A worktree whose status
+// cannot be read is counted in Errors; upstream and commit time lookups are best-effort.
+func (s *worktreeService) GetWorktreeStats(ctx context.Context, projectPath string) (*domain.WorktreeStats, error) {
+	if projectPath == "" {
+		return nil, domain.NewValidationError("GetWorktreeStats", "projectPath", "", "project path cannot be empty")
+	}
+
+	worktrees, err := s.gitService.ListWorktrees(ctx, projectPath)
+	if err != nil {
+		return nil, domain.NewWorktreeServiceError(projectPath, "", "GetWorktreeStats", "failed to list worktrees", err)
+	}
+
+	concurrency := defaultStatsConcurrency
+	if s.config != nil && s.config.Services.MaxConcurrent > 0 {
+		concurrency = s.config.Services.MaxConcurrent
+	}
+
+	stats := &domain.WorktreeStats{ProjectPath: projectPath}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+	for _, worktree := range worktrees {
+		if worktree.IsBare || worktree.Path == projectPath {
+			continue
+		}
+		wt := &worktree
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			status, statusErr := s.GetWorktreeStatus(ctx, wt.Path)
+			var tracking domain.WorktreeDetails
+			if statusErr == nil {
+				_ = s.lookupTracking(ctx, wt, &tracking)
+				s.populateHeadCommit(ctx, projectPath, wt)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			stats.Total++
+			if statusErr != nil {
+				stats.Errors++
+				return
+			}
+			if status.HasUncommittedChanges {
+				stats.Dirty++
+			} else {
+				stats.Clean++
+			}
+			if tracking.AheadCount > 0 {
+				stats.Ahead++
+			}
+			if tracking.BehindCount > 0 {
+				stats.Behind++
+			}
+			stats.AddCommitTime(wt.LastUpdated)
+		}()
+	}
+	wg.Wait()
+
+	return stats, nil
+}
@@ -1,12 +1,15 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"runtime/debug"
 	"time"
 
 	"twiggit/cmd"
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
 	"twiggit/internal/infrastructure"
 	"twiggit/internal/service"
 )
@@ -24,13 +27,12 @@ func main() {
 		}
 	}()
 
-	// Initialize and load configuration
+	// Initialize and load configuration. A broken config file only fails the commands that
+	// need it: doctor reports it and config init --force replaces it, running on the defaults.
 	configManager := infrastructure.NewConfigManager()
-	config, err := configManager.Load()
-	if err != nil {
-		// Use functional error handling instead of panic
-		cmd.HandleCLIError(err)
-		os.Exit(1)
+	config, configErr := configManager.Load()
+	if configErr != nil {
+		config = domain.DefaultConfig()
 	}
 
 	// Initialize infrastructure services in dependency order
@@ -43,26 +45,69 @@ func main() {
 	gitClient := infrastructure.NewCompositeGitClient(goGitClient, cliClient)
 
 	contextDetector := infrastructure.NewContextDetector(config)
-	contextResolver := infrastructure.NewContextResolver(config, gitClient)
+	// The resolver finds worktree owners through projectService, which is built from it below
+	var projectService application.ProjectService
+	contextResolver := infrastructure.NewContextResolver(config, gitClient,
+		infrastructure.WithWorktreeOwnerFinder(infrastructure.WorktreeOwnerFinderFunc(
+			func(ctx context.Context, worktreePath string) (*domain.ProjectInfo, error) {
+				return projectService.FindProjectByWorktreePath(ctx, worktreePath)
+			})))
 
 	// Initialize application services (contextService first as others depend on it)
 	contextService := service.NewContextService(contextDetector, contextResolver, config)
-	projectService := service.NewProjectService(gitClient, contextService, config)
-	navigationService := service.NewNavigationService(projectService, contextService, config)
-	hookRunner := infrastructure.NewHookRunner(commandExecutor, config.Shell.HookTimeout)
-	worktreeService := service.NewWorktreeService(gitClient, projectService, config, hookRunner)
+	projectService = service.NewProjectService(gitClient, contextService, config)
+	projectSettings := infrastructure.NewProjectSettingsStore()
+	navigationService := service.NewNavigationService(projectService, contextService, config, projectSettings,
+		infrastructure.NewNavigationHistoryStore(infrastructure.DefaultNavigationHistoryPath()))
+	processManager := infrastructure.NewProcessManager(infrastructure.DefaultPIDDir())
+	hookRunner := infrastructure.NewHookRunner(commandExecutor, processManager, config.Shell.HookTimeout)
+	worktreeService := service.NewWorktreeService(gitClient, projectService, config, hookRunner, processManager)
 	shellInfra := infrastructure.NewShellInfrastructure()
 	shellService := service.NewShellService(shellInfra, config)
 
 	// Create command configuration
+	tokenStore := infrastructure.NewTokenStore(infrastructure.DefaultTokenStorePath())
+	ciWatchers := map[string]application.CIWatcher{
+		domain.AuthHostGitHub: infrastructure.NewCIWatcher(domain.AuthHostGitHub, tokenStore),
+		domain.AuthHostGitLab: infrastructure.NewCIWatcher(domain.AuthHostGitLab, tokenStore),
+	}
+
+	prCachePath := infrastructure.DefaultPullRequestCachePath()
+	pullRequestFinders := map[string]application.PullRequestFinder{
+		domain.AuthHostGitHub: infrastructure.NewPullRequestFinder(domain.AuthHostGitHub, tokenStore, prCachePath),
+		domain.AuthHostGitLab: infrastructure.NewPullRequestFinder(domain.AuthHostGitLab, tokenStore, prCachePath),
+	}
+
 	commandConfig := &cmd.CommandConfig{
-		Config: config,
+		Config:    config,
+		ConfigErr: configErr,
+		Terminal:  infrastructure.NewTerminalDetector(),
 		Services: &cmd.ServiceContainer{
-			ContextService:    contextService,
-			ProjectService:    projectService,
-			NavigationService: navigationService,
-			WorktreeService:   worktreeService,
-			ShellService:      shellService,
+			ContextService:     contextService,
+			ProjectService:     projectService,
+			NavigationService:  navigationService,
+			WorktreeService:    worktreeService,
+			ShellService:       shellService,
+			Initializer:        infrastructure.NewInitializer(),
+			LinkRegistry:       infrastructure.NewLinkRegistry(gitClient),
+			ProcessManager:     processManager,
+			EditorLauncher:     infrastructure.NewEditorLauncher(),
+			EnvInheritor:       infrastructure.NewEnvInheritor(),
+			CommandRunner:      infrastructure.NewCommandRunner(commandExecutor, infrastructure.DefaultCommandRunTimeout),
+			EphemeralRegistry:  infrastructure.NewEphemeralRegistry(infrastructure.DefaultEphemeralDir()),
+			TokenStore:         tokenStore,
+			TokenValidator:     infrastructure.NewTokenValidator(),
+			CIWatchers:         ciWatchers,
+			PullRequestFinders: pullRequestFinders,
+			HookRunner:         hookRunner,
+			ChangeWatcher:      infrastructure.NewChangeWatcher(),
+			NetworkChecker:     infrastructure.NewNetworkChecker(),
+			ConfigManager:      configManager,
+			HookCopier:         infrastructure.NewHookCopier(gitClient),
+			WorktreeArchiver:   infrastructure.NewWorktreeArchiver(),
+			ProjectSettings:    projectSettings,
+			CompletionCache: infrastructure.NewCompletionCache(infrastructure.DefaultCompletionCachePath(),
+				infrastructure.DefaultCompletionCacheTTL),
 		},
 	}
 
@@ -37,7 +37,7 @@ worktrees_dir = /tmp/worktrees
 	binaryPath := buildTestBinary(t)
 
 	// Execute with invalid config
-	cmd := exec.Command(binaryPath, "--help")
+	cmd := exec.Command(binaryPath, "list")
 	cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+tempDir)
 
 	var stdout, stderr bytes.Buffer
@@ -53,6 +53,17 @@ worktrees_dir = /tmp/worktrees
 	if errors.As(err, &exitErr) {
 		assert.NotEqual(t, 0, exitErr.ExitCode(), "Exit code should be non-zero for config error")
 	}
+	assert.Contains(t, stderr.String(), configPath)
+
+	// doctor still runs and reports the broken file itself
+	doctor := exec.Command(binaryPath, "doctor")
+	doctor.Env = cmd.Env
+	doctor.Dir = tempDir
+	stdout.Reset()
+	doctor.Stdout = &stdout
+	doctor.Stderr = &bytes.Buffer{}
+	require.Error(t, doctor.Run(), "doctor should report the config error")
+	assert.Contains(t, stdout.String(), "ERROR   config: ")
 }
 
 // TestMainConfigLoadFailure_MissingDirectory tests config load when directory cannot be created
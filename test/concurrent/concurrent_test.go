@@ -4,6 +4,7 @@
 package concurrent
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -18,6 +19,7 @@ import (
 
 	"twiggit/internal/domain"
 	"twiggit/internal/infrastructure"
+	"twiggit/internal/service"
 )
 
 // ConcurrentTestSuite provides concurrent operation testing
@@ -400,3 +402,64 @@ func (s *ConcurrentTestSuite) TestConcurrentPruneWhileList() {
 		assert.NoError(s.T(), err, "Concurrent prune/list should not race")
 	}
 }
+
+// TestConcurrentGetContextForPath detects the contexts of several paths from 10 goroutines at
+// once; run with -race to check detection shares no unsynchronized state and never changes
+// the working directory
+func (s *ConcurrentTestSuite) TestConcurrentGetContextForPath() {
+	// Context injected through the environment would win over the paths
+	for _, name := range []string{"TWIGGIT_CONTEXT", "GITHUB_REPOSITORY", "GITHUB_REF_NAME", "BITBUCKET_REPO_SLUG", "BITBUCKET_BRANCH", "CI_PROJECT_NAME", "CI_BRANCH_NAME"} {
+		s.T().Setenv(name, "")
+	}
+
+	projectPath := s.createTestProject("ctx-project")
+	worktreePath := filepath.Join(s.config.WorktreesDirectory, "ctx-project", "feature")
+	cmd := exec.Command("git", "worktree", "add", "-b", "feature", worktreePath)
+	cmd.Dir = projectPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		s.T().Fatalf("Failed to create worktree: %v\nOutput: %s", err, string(output))
+	}
+
+	contextService := service.NewContextService(
+		infrastructure.NewContextDetector(s.config), infrastructure.NewContextResolver(s.config, nil), s.config)
+	expected := []struct {
+		path       string
+		ctxType    domain.ContextType
+		branchName string
+	}{
+		{path: projectPath, ctxType: domain.ContextProject},
+		{path: worktreePath, ctxType: domain.ContextWorktree, branchName: "feature"},
+	}
+
+	wd, err := os.Getwd()
+	require.NoError(s.T(), err)
+
+	var wg sync.WaitGroup
+	errChan := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			want := expected[i%len(expected)]
+			got, err := contextService.GetContextForPath(context.Background(), want.path)
+			if err != nil {
+				errChan <- fmt.Errorf("detection of %s failed: %w", want.path, err)
+				return
+			}
+			if got.Type != want.ctxType || got.ProjectName != "ctx-project" || got.BranchName != want.branchName {
+				errChan <- fmt.Errorf("%s: got %s context %s/%s", want.path, got.Type, got.ProjectName, got.BranchName)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	close(errChan)
+
+	for err := range errChan {
+		assert.NoError(s.T(), err, "Concurrent context detection failed")
+	}
+	after, err := os.Getwd()
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), wd, after, "context detection must not change the working directory")
+}
@@ -7,7 +7,9 @@ package e2e
 
 import (
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -182,6 +184,78 @@ var _ = Describe("create command", func() {
 		Expect(output).To(ContainSubstring(branchName), "Should include branch name")
 	})
 
+	It("persists --set-description in the repository git config", func() {
+		fixture.SetupSingleProject("test-project")
+		projectPath := fixture.GetProjectPath("test-project")
+
+		testID := fixture.GetTestID()
+		branchName := testID.BranchName("feature-described")
+
+		session := ctxHelper.FromProjectDir("test-project", "create", branchName, "--set-description", "Implementing OAuth2 login flow")
+		cli.ShouldSucceed(session)
+		cli.ShouldOutput(session, "Description: Implementing OAuth2 login flow")
+
+		if session.ExitCode() != 0 {
+			GinkgoT().Log(fixture.Inspect())
+		}
+
+		Expect(readBranchDescription(projectPath, branchName)).To(Equal("Implementing OAuth2 login flow"))
+
+		session = ctxHelper.FromProjectDir("test-project", "list", "--descriptions")
+		cli.ShouldSucceed(session)
+		cli.ShouldOutput(session, branchName+" -> ")
+		cli.ShouldOutput(session, " - Implementing OAuth2 login flow")
+	})
+
+	It("forks a worktree's HEAD with --from-worktree", func() {
+		fixture.SetupSingleProject("test-project")
+		projectPath := fixture.GetProjectPath("test-project")
+
+		testID := fixture.GetTestID()
+		original := testID.BranchName("feature-original")
+		fork := testID.BranchName("feature-fork")
+
+		session := ctxHelper.FromProjectDir("test-project", "create", original)
+		cli.ShouldSucceed(session)
+
+		originalPath := filepath.Join(fixture.GetConfigHelper().GetWorktreesDir(), "test-project", original)
+		Expect(os.WriteFile(filepath.Join(originalPath, "experiment.txt"), []byte("wip\n"), 0644)).To(Succeed())
+
+		session = ctxHelper.FromProjectDir("test-project", "create", fork, "--from-worktree", original)
+		cli.ShouldFailWithExit(session, 1)
+		cli.ShouldErrorOutput(session, "uncommitted changes")
+
+		runGit(originalPath, "add", "experiment.txt")
+		runGit(originalPath, "commit", "-m", "Experiment")
+
+		session = ctxHelper.FromProjectDir("test-project", "create", fork, "--from-worktree", original)
+		cli.ShouldSucceed(session)
+		cli.ShouldOutput(session, "Description: Forked from "+original)
+
+		if session.ExitCode() != 0 {
+			GinkgoT().Log(fixture.Inspect())
+		}
+
+		forkPath := filepath.Join(fixture.GetConfigHelper().GetWorktreesDir(), "test-project", fork)
+		Expect(filepath.Join(forkPath, "experiment.txt")).To(BeARegularFile())
+		Expect(readBranchDescription(projectPath, fork)).To(Equal("Forked from " + original))
+	})
+
+	It("describes new branches from branch_description_template", func() {
+		fixture.GetConfigHelper().WithCustomConfig(`branch_description_template = "{{.JiraKey}} in {{.Project}}"`).Build()
+		fixture.SetupSingleProject("test-project")
+		projectPath := fixture.GetProjectPath("test-project")
+
+		session := ctxHelper.FromProjectDir("test-project", "create", "proj-77-oauth")
+		cli.ShouldSucceed(session)
+
+		if session.ExitCode() != 0 {
+			GinkgoT().Log(fixture.Inspect())
+		}
+
+		Expect(readBranchDescription(projectPath, "proj-77-oauth")).To(Equal("PROJ-77 in test-project"))
+	})
+
 	It("executes post-create hooks when .twiggit.toml exists", func() {
 		fixture.SetupSingleProject("test-project")
 		projectPath := fixture.GetProjectPath("test-project")
@@ -296,3 +370,20 @@ commands = ["exit 1"]
 		Expect(stdout).NotTo(ContainSubstring("Created worktree"), "Success message should be suppressed")
 	})
 })
+
+// runGit runs a git command in dir and fails the spec when it fails
+func runGit(dir string, args ...string) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	Expect(err).NotTo(HaveOccurred(), string(out))
+}
+
+// readBranchDescription reads branch.<branch>.description straight from git
+func readBranchDescription(repoPath, branch string) string {
+	cmd := exec.Command("git", "config", "--get", "branch."+branch+".description")
+	cmd.Dir = repoPath
+	out, err := cmd.Output()
+	Expect(err).NotTo(HaveOccurred())
+	return strings.TrimSpace(string(out))
+}
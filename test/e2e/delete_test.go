@@ -62,6 +62,21 @@ var _ = Describe("delete command", func() {
 		Expect(worktree2Path).NotTo(BeADirectory())
 	})
 
+	It("removes links to the deleted worktree from other worktrees", func() {
+		result := fixture.CreateWorktreeSetup("test")
+
+		worktreesDir := filepath.Join(fixture.GetConfigHelper().GetWorktreesDir(), "test")
+		linksFile := filepath.Join(worktreesDir, result.Feature2Branch, ".twiggit-links")
+		links := `{"depends_on": ["` + result.Feature1Branch + `"]}`
+		Expect(os.WriteFile(linksFile, []byte(links), 0644)).To(Succeed())
+
+		session := ctxHelper.FromProjectDir("test", "delete", result.Feature1Branch)
+		cli.ShouldSucceed(session)
+		cli.ShouldErrorContain(session, "Removed stale link: "+result.Feature2Branch+" -> "+result.Feature1Branch)
+
+		Expect(linksFile).NotTo(BeAnExistingFile())
+	})
+
 	It("deletes with --force flag despite uncommitted changes", func() {
 		result := fixture.CreateWorktreeSetup("test")
 
@@ -78,6 +78,9 @@ func (cli *TwiggitCLI) WithWorktreesDir(worktreesDir string) *TwiggitCLI {
 	return cli.WithEnvironment("TWIGGIT_WORKTREES_DIR", worktreesDir)
 }
 
+// nonCIEnv clears the variables that make twiggit skip interactive prompts under CI
+var nonCIEnv = []string{"CI=", "GITHUB_ACTIONS=", "TRAVIS=", "CIRCLECI="}
+
 // Run executes the twiggit CLI with the given arguments
 func (cli *TwiggitCLI) Run(args ...string) *gexec.Session {
 	command := exec.Command(cli.binaryPath, args...)
@@ -124,6 +127,8 @@ func (cli *TwiggitCLI) RunWithStdin(stdin io.Reader, args ...string) *gexec.Sess
 	for key, value := range cli.env {
 		env = append(env, fmt.Sprintf("%s=%s", key, value))
 	}
+	// Prompts answered on stdin are turned off in CI
+	env = append(env, nonCIEnv...)
 	command.Env = env
 
 	session, err := gexec.Start(command, nil, nil)
@@ -142,6 +147,8 @@ func (cli *TwiggitCLI) RunWithStdinAndDir(dir string, stdin io.Reader, args ...s
 	for key, value := range cli.env {
 		env = append(env, fmt.Sprintf("%s=%s", key, value))
 	}
+	// Prompts answered on stdin are turned off in CI
+	env = append(env, nonCIEnv...)
 	command.Env = env
 
 	session, err := gexec.Start(command, nil, nil)
@@ -170,7 +170,7 @@ var _ = Describe("list command", func() {
 
 		session := ctxHelper.FromProjectDir("empty-project", "list", "--output", "json")
 		cli.ShouldSucceed(session)
-		cli.ShouldContain(session, `{"worktrees":[]}`)
+		cli.ShouldContain(session, `{"worktrees":[],"total":0}`)
 	})
 
 	It("fails with invalid output format", func() {
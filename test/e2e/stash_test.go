@@ -0,0 +1,85 @@
+//go:build e2e
+// +build e2e
+
+// Package e2e provides end-to-end tests for twiggit stash commands.
+// Tests validate that stashes follow the worktree of the current directory.
+package e2e
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"twiggit/test/e2e/fixtures"
+	"twiggit/test/e2e/helpers"
+)
+
+var _ = Describe("stash command", func() {
+	var fixture *fixtures.E2ETestFixture
+	var cli *helpers.TwiggitCLI
+	var ctxHelper *fixtures.ContextHelper
+
+	BeforeEach(func() {
+		fixture = fixtures.NewE2ETestFixture()
+		cli = helpers.NewTwiggitCLI()
+		cli = cli.WithConfigDir(fixture.Build())
+		ctxHelper = fixtures.NewContextHelper(fixture, cli)
+	})
+
+	AfterEach(func() {
+		if CurrentSpecReport().Failed() {
+			GinkgoT().Log(fixture.Inspect())
+		}
+		fixture.Cleanup()
+	})
+
+	It("moves changes from one worktree to another", func() {
+		result := fixture.CreateWorktreeSetup("test")
+		worktreesDir := filepath.Join(fixture.GetConfigHelper().GetWorktreesDir(), "test")
+		sourceFile := filepath.Join(worktreesDir, result.Feature1Branch, "wip.txt")
+		targetFile := filepath.Join(worktreesDir, result.Feature2Branch, "wip.txt")
+		Expect(os.WriteFile(sourceFile, []byte("wip\n"), 0644)).To(Succeed())
+
+		session := ctxHelper.FromWorktreeDir("test", result.Feature1Branch, "stash", "push", "-m", "half-done")
+		cli.ShouldSucceed(session)
+		cli.ShouldOutput(session, "stash@{0}")
+		Expect(sourceFile).NotTo(BeAnExistingFile())
+
+		session = ctxHelper.FromWorktreeDir("test", result.Feature2Branch, "stash", "list")
+		cli.ShouldSucceed(session)
+		cli.ShouldOutput(session, result.Feature1Branch)
+		cli.ShouldOutput(session, "half-done")
+
+		session = ctxHelper.FromWorktreeDir("test", result.Feature2Branch, "stash", "pop")
+		cli.ShouldSucceed(session)
+		cli.ShouldOutput(session, "Applied and dropped stash@{0}")
+		Expect(targetFile).To(BeAnExistingFile())
+	})
+
+	It("drops an entry", func() {
+		result := fixture.CreateWorktreeSetup("test")
+		worktreePath := filepath.Join(fixture.GetConfigHelper().GetWorktreesDir(), "test", result.Feature1Branch)
+		Expect(os.WriteFile(filepath.Join(worktreePath, "wip.txt"), []byte("wip\n"), 0644)).To(Succeed())
+
+		session := ctxHelper.FromWorktreeDir("test", result.Feature1Branch, "stash", "push")
+		cli.ShouldSucceed(session)
+
+		session = ctxHelper.FromWorktreeDir("test", result.Feature1Branch, "stash", "drop", "stash@{0}")
+		cli.ShouldSucceed(session)
+		cli.ShouldOutput(session, "Dropped stash@{0}")
+
+		session = ctxHelper.FromWorktreeDir("test", result.Feature1Branch, "stash", "list")
+		cli.ShouldSucceed(session)
+		cli.ShouldOutput(session, "No stash entries")
+	})
+
+	It("fails outside a project", func() {
+		fixture.SetupSingleProject("test-project")
+
+		session := ctxHelper.FromOutsideGit("stash", "list")
+		cli.ShouldFailWithExit(session, 5) // ExitCodeValidation
+		cli.ShouldErrorOutput(session, "not inside a project or worktree")
+	})
+})
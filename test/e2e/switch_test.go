@@ -0,0 +1,51 @@
+//go:build e2e
+// +build e2e
+
+// Package e2e provides end-to-end tests for twiggit switch command.
+// Tests validate partial-name matching across projects.
+package e2e
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+
+	"twiggit/test/e2e/fixtures"
+	"twiggit/test/e2e/helpers"
+)
+
+var _ = Describe("switch command", func() {
+	var fixture *fixtures.E2ETestFixture
+	var cli *helpers.TwiggitCLI
+	var ctxHelper *fixtures.ContextHelper
+
+	BeforeEach(func() {
+		fixture = fixtures.NewE2ETestFixture()
+		cli = helpers.NewTwiggitCLI()
+		cli = cli.WithConfigDir(fixture.Build())
+		ctxHelper = fixtures.NewContextHelper(fixture, cli)
+	})
+
+	AfterEach(func() {
+		if CurrentSpecReport().Failed() {
+			GinkgoT().Log(fixture.Inspect())
+		}
+		fixture.Cleanup()
+	})
+
+	It("switches to the only worktree containing the target", func() {
+		result := fixture.CreateWorktreeSetup("test")
+
+		session := ctxHelper.FromOutsideGit("switch", result.Feature2Branch[:len("feature-2")])
+		cli.ShouldSucceed(session)
+		cli.ShouldOutput(session, filepath.Join(fixture.GetConfigHelper().GetWorktreesDir(), "test", result.Feature2Branch))
+	})
+
+	It("fails on several matches with --no-interactive", func() {
+		result := fixture.CreateWorktreeSetup("test")
+
+		session := ctxHelper.FromOutsideGit("switch", "feature", "--no-interactive")
+		cli.ShouldFailWithExit(session, 5) // ExitCodeValidation
+		cli.ShouldErrorOutput(session, "test/"+result.Feature1Branch)
+	})
+})
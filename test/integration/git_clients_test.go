@@ -5,12 +5,15 @@ package integration
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"twiggit/internal/domain"
 	"twiggit/internal/infrastructure"
 
 	"github.com/stretchr/testify/assert"
@@ -123,6 +126,205 @@ func TestGitOperations_Integration(t *testing.T) {
 		assert.True(t, os.IsNotExist(err))
 	})
 
+	t.Run("CLIClient_SetConfig_WorktreeScoped", func(t *testing.T) {
+		cliClient := infrastructure.NewCLIClient(executor, 30)
+
+		worktreePath := filepath.Join(tempDir, "signed-worktree")
+		require.NoError(t, cliClient.CreateWorktree(context.Background(), repoPath, "signed", "main", worktreePath))
+		t.Cleanup(func() {
+			_ = cliClient.DeleteWorktree(context.Background(), repoPath, worktreePath, true)
+		})
+
+		require.NoError(t, cliClient.SetConfig(context.Background(), worktreePath, "commit.gpgsign", "true"))
+		require.NoError(t, cliClient.SetConfig(context.Background(), worktreePath, "user.signingkey", "ABCD1234"))
+
+		result, err := executor.Execute(context.Background(), worktreePath, "git", "config", "--get", "user.signingkey")
+		require.NoError(t, err)
+		assert.Equal(t, "ABCD1234", strings.TrimSpace(result.Stdout))
+
+		result, err = executor.Execute(context.Background(), repoPath, "git", "config", "--get", "commit.gpgsign")
+		require.Error(t, err, "main repository must not inherit worktree config")
+		assert.Equal(t, 1, result.ExitCode)
+	})
+
+	t.Run("CLIClient_GetAllLocalConfig_WorktreeScoped", func(t *testing.T) {
+		ctx := context.Background()
+		cliClient := infrastructure.NewCLIClient(executor, 30)
+
+		worktreePath := filepath.Join(tempDir, "exported-worktree")
+		require.NoError(t, cliClient.CreateWorktree(ctx, repoPath, "exported", "main", worktreePath))
+		t.Cleanup(func() {
+			_ = cliClient.DeleteWorktree(ctx, repoPath, worktreePath, true)
+		})
+
+		_, err := executor.Execute(ctx, repoPath, "git", "config", "alias.lg", "log --format=%h=%s")
+		require.NoError(t, err)
+		require.NoError(t, cliClient.SetConfig(ctx, worktreePath, "merge.conflictStyle", "diff3"))
+
+		config, err := cliClient.GetAllLocalConfig(ctx, worktreePath)
+		require.NoError(t, err)
+		assert.Equal(t, "log --format=%h=%s", config["alias.lg"])
+		assert.Equal(t, "diff3", config["merge.conflictstyle"], "worktree-scoped config is included")
+	})
+
+	t.Run("CLIClient_CommitHistoryAndStat", func(t *testing.T) {
+		ctx := context.Background()
+		cliClient := infrastructure.NewCLIClient(executor, 30)
+
+		worktreePath := filepath.Join(tempDir, "timeline-worktree")
+		require.NoError(t, cliClient.CreateWorktree(ctx, repoPath, "timeline", "main", worktreePath))
+		t.Cleanup(func() {
+			_ = cliClient.DeleteWorktree(ctx, repoPath, worktreePath, true)
+		})
+
+		require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "notes.txt"), []byte("one\ntwo\n"), 0644))
+		_, err := executor.Execute(ctx, worktreePath, "git", "add", "notes.txt")
+		require.NoError(t, err)
+		_, err = executor.Execute(ctx, worktreePath, "git", "commit", "-m", "Add notes")
+		require.NoError(t, err)
+		_, err = executor.Execute(ctx, worktreePath, "git", "commit", "--allow-empty", "-m", "Empty checkpoint")
+		require.NoError(t, err)
+
+		commits, err := cliClient.GetCommitHistory(ctx, worktreePath, 2)
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+		assert.Equal(t, "Empty checkpoint", commits[0].Message)
+		assert.Equal(t, "Add notes", commits[1].Message)
+
+		stat, err := cliClient.GetCommitStat(ctx, worktreePath, commits[0].Hash)
+		require.NoError(t, err)
+		assert.True(t, stat.Clean())
+
+		stat, err = cliClient.GetCommitStat(ctx, worktreePath, commits[1].Hash)
+		require.NoError(t, err)
+		assert.Equal(t, &domain.StatusSnapshot{FilesChanged: 1, Insertions: 2}, stat)
+	})
+
+	t.Run("CLIClient_BranchDescription_RoundTrip", func(t *testing.T) {
+		cliClient := infrastructure.NewCLIClient(executor, 30)
+		ctx := context.Background()
+
+		description, err := cliClient.GetBranchDescription(ctx, repoPath, "main")
+		require.NoError(t, err)
+		assert.Empty(t, description)
+
+		require.NoError(t, cliClient.SetBranchDescription(ctx, repoPath, "main", "Release line"))
+		description, err = cliClient.GetBranchDescription(ctx, repoPath, "main")
+		require.NoError(t, err)
+		assert.Equal(t, "Release line", description)
+
+		require.NoError(t, cliClient.SetBranchDescription(ctx, repoPath, "main", ""))
+		description, err = cliClient.GetBranchDescription(ctx, repoPath, "main")
+		require.NoError(t, err)
+		assert.Empty(t, description)
+
+		require.NoError(t, cliClient.SetBranchDescription(ctx, repoPath, "main", ""), "unsetting twice is a no-op")
+	})
+
+	t.Run("GoGitClient_GetRemoteBranches_FromBareRemote", func(t *testing.T) {
+		ctx := context.Background()
+		barePath := filepath.Join(tempDir, "remote.git")
+		clonePath := filepath.Join(tempDir, "remote-clone")
+
+		_, err := executor.Execute(ctx, tempDir, "git", "clone", "--bare", repoPath, barePath)
+		require.NoError(t, err)
+		_, err = executor.Execute(ctx, barePath, "git", "branch", "feature/remote-only", "main")
+		require.NoError(t, err)
+		_, err = executor.Execute(ctx, tempDir, "git", "clone", barePath, clonePath)
+		require.NoError(t, err)
+
+		gitService := infrastructure.NewCompositeGitClient(infrastructure.NewGoGitClient(true), infrastructure.NewCLIClient(executor, 30))
+		branches, err := gitService.GetRemoteBranches(ctx, clonePath)
+		require.NoError(t, err)
+
+		remotes := make(map[string]string, len(branches))
+		for _, branch := range branches {
+			remotes[branch.Remote] = branch.Name
+			assert.Equal(t, "Test User", branch.Author)
+			assert.False(t, branch.Date.IsZero())
+		}
+		assert.Equal(t, "main", remotes["origin/main"])
+		assert.Equal(t, "feature/remote-only", remotes["origin/feature/remote-only"])
+		assert.NotContains(t, remotes, "origin/HEAD", "symbolic refs are skipped")
+	})
+
+	t.Run("CLIClient_DeleteRemoteBranch_FromBareRemote", func(t *testing.T) {
+		ctx := context.Background()
+		barePath := filepath.Join(tempDir, "prune-remote.git")
+		clonePath := filepath.Join(tempDir, "prune-remote-clone")
+
+		_, err := executor.Execute(ctx, tempDir, "git", "clone", "--bare", repoPath, barePath)
+		require.NoError(t, err)
+		_, err = executor.Execute(ctx, barePath, "git", "branch", "feature/merged", "main")
+		require.NoError(t, err)
+		_, err = executor.Execute(ctx, tempDir, "git", "clone", barePath, clonePath)
+		require.NoError(t, err)
+
+		cliClient := infrastructure.NewCLIClient(executor, 30)
+		require.NoError(t, cliClient.DeleteRemoteBranch(ctx, clonePath, "origin", "feature/merged"))
+
+		result, err := executor.Execute(ctx, barePath, "git", "branch", "--list", "feature/merged")
+		require.NoError(t, err)
+		assert.Empty(t, strings.TrimSpace(result.Stdout), "branch is gone from the bare remote")
+
+		err = cliClient.DeleteRemoteBranch(ctx, clonePath, "origin", "feature/merged")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, domain.ErrRemoteBranchNotFound)
+	})
+
+	t.Run("CLIClient_InitBareWorktree", func(t *testing.T) {
+		cliClient := infrastructure.NewCLIClient(executor, 30)
+
+		worktreePath := filepath.Join(tempDir, "bare-worktree")
+		require.NoError(t, cliClient.InitBareWorktree(context.Background(), repoPath, worktreePath))
+		t.Cleanup(func() {
+			_ = cliClient.DeleteWorktree(context.Background(), repoPath, worktreePath, true)
+		})
+
+		entries, err := os.ReadDir(worktreePath)
+		require.NoError(t, err)
+		require.Len(t, entries, 1, "only the .git file should exist")
+		assert.Equal(t, ".git", entries[0].Name())
+
+		worktrees, err := cliClient.ListWorktrees(context.Background(), repoPath)
+		require.NoError(t, err)
+		found := false
+		for _, wt := range worktrees {
+			if filepath.Base(wt.Path) == "bare-worktree" {
+				found = true
+				assert.True(t, wt.IsDetached)
+			}
+		}
+		assert.True(t, found, "worktree should be registered")
+
+		require.Error(t, cliClient.InitBareWorktree(context.Background(), repoPath, worktreePath))
+	})
+
+	t.Run("CLIClient_RepairWorktrees", func(t *testing.T) {
+		cliClient := infrastructure.NewCLIClient(executor, 30)
+
+		worktreePath := filepath.Join(tempDir, "repair-before")
+		movedPath := filepath.Join(tempDir, "repair-after")
+		require.NoError(t, cliClient.CreateWorktree(context.Background(), repoPath, "repair-branch", "main", worktreePath))
+		t.Cleanup(func() {
+			_ = cliClient.DeleteWorktree(context.Background(), repoPath, movedPath, true)
+		})
+
+		require.NoError(t, os.Rename(worktreePath, movedPath))
+		require.NoError(t, cliClient.RepairWorktrees(context.Background(), repoPath, []string{movedPath}))
+
+		worktrees, err := cliClient.ListWorktrees(context.Background(), repoPath)
+		require.NoError(t, err)
+		found := false
+		for _, wt := range worktrees {
+			if filepath.Base(wt.Path) == "repair-after" {
+				found = true
+				assert.Equal(t, "repair-branch", wt.Branch)
+			}
+		}
+		assert.True(t, found, "moved worktree should be registered at its new path")
+	})
+
 	t.Run("GitService_DeterministicRouting", func(t *testing.T) {
 		goGitClient := infrastructure.NewGoGitClient(true)
 		cliClient := infrastructure.NewCLIClient(executor, 30)
@@ -143,6 +345,147 @@ func TestGitOperations_Integration(t *testing.T) {
 		err = gitService.DeleteWorktree(context.Background(), repoPath, worktreePath, false)
 		require.NoError(t, err)
 	})
+
+	t.Run("CLIClient_CreateStash", func(t *testing.T) {
+		ctx := context.Background()
+		gitService := infrastructure.NewCompositeGitClient(infrastructure.NewGoGitClient(true), infrastructure.NewCLIClient(executor, 30))
+
+		worktreePath := filepath.Join(tempDir, "auto-stash")
+		require.NoError(t, gitService.CreateWorktree(ctx, repoPath, "auto-stash-branch", "main", worktreePath))
+		require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "test.txt"), []byte("changed"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "new.txt"), []byte("untracked"), 0644))
+
+		status, err := gitService.GetRepositoryStatus(ctx, worktreePath)
+		require.NoError(t, err)
+		require.False(t, status.IsClean)
+
+		require.NoError(t, gitService.CreateStash(ctx, worktreePath, "auto-stash before creating feature"))
+
+		// Worktrees share the stash, so the entry is listed from the main repository too
+		entries, err := gitService.GetStashList(ctx, repoPath)
+		require.NoError(t, err)
+		require.NotEmpty(t, entries)
+		assert.Equal(t, 0, entries[0].Index)
+		assert.Contains(t, entries[0].Message, "auto-stash before creating feature")
+
+		status, err = gitService.GetRepositoryStatus(ctx, worktreePath)
+		require.NoError(t, err)
+		assert.True(t, status.IsClean, "tracked and untracked changes should be stashed")
+
+		err = gitService.CreateStash(ctx, worktreePath, "nothing")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no local changes to stash")
+	})
+
+	t.Run("CLIClient_CheckoutBranch", func(t *testing.T) {
+		ctx := context.Background()
+		gitService := infrastructure.NewCompositeGitClient(infrastructure.NewGoGitClient(true), infrastructure.NewCLIClient(executor, 30))
+
+		worktreePath := filepath.Join(tempDir, "checkout-fallback")
+		require.NoError(t, gitService.CreateWorktree(ctx, repoPath, "checkout-fallback-branch", "main", worktreePath))
+
+		// go-git cannot read the branch of a linked worktree, so ask git worktree list
+		branchOf := func() string {
+			worktrees, err := gitService.ListWorktrees(ctx, repoPath)
+			require.NoError(t, err)
+			for _, wt := range worktrees {
+				if filepath.Base(wt.Path) == filepath.Base(worktreePath) {
+					return wt.Branch
+				}
+			}
+			return ""
+		}
+
+		// A new branch is created at the start point, then an existing one is checked out as is
+		require.NoError(t, gitService.CheckoutBranch(ctx, worktreePath, "checkout-fallback-new", "main"))
+		assert.Equal(t, "checkout-fallback-new", branchOf())
+
+		require.NoError(t, gitService.CheckoutBranch(ctx, worktreePath, "checkout-fallback-branch", ""))
+		assert.Equal(t, "checkout-fallback-branch", branchOf())
+
+		// main is checked out in the main worktree
+		err = gitService.CheckoutBranch(ctx, worktreePath, "main", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to check out branch")
+	})
+
+	t.Run("CLIClient_GetWorktreeList", func(t *testing.T) {
+		ctx := context.Background()
+		gitService := infrastructure.NewCompositeGitClient(infrastructure.NewGoGitClient(true), infrastructure.NewCLIClient(executor, 30))
+
+		// Worktrees created with plain git outside the worktrees directory are listed as well
+		outsidePath := filepath.Join(t.TempDir(), "outside")
+		cmd := exec.Command("git", "worktree", "add", "--detach", outsidePath, "main")
+		cmd.Dir = repoPath
+		output, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(output))
+
+		entries, err := gitService.GetWorktreeList(ctx, repoPath)
+		require.NoError(t, err)
+		require.NotEmpty(t, entries)
+		assert.Equal(t, "main", entries[0].Branch)
+		assert.NotEmpty(t, entries[0].HEAD)
+
+		var outside *domain.GitWorktreeEntry
+		for i := range entries {
+			if filepath.Base(entries[i].Path) == "outside" {
+				outside = &entries[i]
+			}
+		}
+		require.NotNil(t, outside)
+		assert.True(t, outside.IsDetached)
+		assert.Empty(t, outside.Branch)
+		assert.Equal(t, entries[0].HEAD, outside.HEAD)
+	})
+
+	t.Run("CLIClient_GetBranchRelationship", func(t *testing.T) {
+		ctx := context.Background()
+		gitService := infrastructure.NewCompositeGitClient(infrastructure.NewGoGitClient(true), infrastructure.NewCLIClient(executor, 30))
+
+		_, err := executor.Execute(ctx, repoPath, "git", "branch", "behind-base", "main")
+		require.NoError(t, err)
+		for i := range 3 {
+			_, err = executor.Execute(ctx, repoPath, "git", "commit", "--allow-empty", "-m", fmt.Sprintf("main %d", i))
+			require.NoError(t, err)
+		}
+
+		relationship, err := gitService.GetBranchRelationship(ctx, repoPath, "behind-base", "main")
+		require.NoError(t, err)
+		assert.Equal(t, 3, relationship.BehindCount)
+		assert.Equal(t, 0, relationship.AheadCount)
+
+		relationship, err = gitService.GetBranchRelationship(ctx, repoPath, "main", "behind-base")
+		require.NoError(t, err)
+		assert.Equal(t, 0, relationship.BehindCount)
+		assert.Equal(t, 3, relationship.AheadCount)
+
+		_, err = gitService.GetBranchRelationship(ctx, repoPath, "no-such-branch", "main")
+		require.Error(t, err)
+	})
+
+	t.Run("CLIClient_GetUpstreamBranchAndLastActivity", func(t *testing.T) {
+		ctx := context.Background()
+		gitService := infrastructure.NewCompositeGitClient(infrastructure.NewGoGitClient(true), infrastructure.NewCLIClient(executor, 30))
+
+		_, err := executor.Execute(ctx, repoPath, "git", "branch", "untracked-branch", "main")
+		require.NoError(t, err)
+		upstream, err := gitService.GetUpstreamBranch(ctx, repoPath, "untracked-branch")
+		require.NoError(t, err)
+		assert.Empty(t, upstream)
+
+		_, err = executor.Execute(ctx, repoPath, "git", "branch", "--set-upstream-to", "main", "untracked-branch")
+		require.NoError(t, err)
+		upstream, err = gitService.GetUpstreamBranch(ctx, repoPath, "untracked-branch")
+		require.NoError(t, err)
+		assert.Equal(t, "main", upstream)
+
+		_, err = executor.Execute(ctx, repoPath, "git", "commit", "--allow-empty", "-m", "Latest activity")
+		require.NoError(t, err)
+		commit, err := gitService.GetLastActivity(ctx, repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, "Latest activity", commit.Message)
+		assert.NotEmpty(t, commit.Author)
+	})
 }
 
 func TestGitOperations_ErrorHandling(t *testing.T) {
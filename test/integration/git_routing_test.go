@@ -138,4 +138,38 @@ func TestDeterministicRouting_Integration(t *testing.T) {
 
 		assert.ErrorIs(t, err, assert.AnError)
 	})
+
+	t.Run("CapabilityMap_CLIOnlyMatchesGoGit", func(t *testing.T) {
+		goGitClient := infrastructure.NewGoGitClient(true)
+		cliClient := infrastructure.NewCLIClient(executor, 30)
+		goGitService := infrastructure.NewCompositeGitClient(goGitClient, cliClient)
+		cliService := infrastructure.NewCompositeGitClient(goGitClient, cliClient, infrastructure.WithCapabilityMap(infrastructure.CapabilityMap{
+			infrastructure.OpBranchExists:        infrastructure.CLIOnly,
+			infrastructure.OpResolveRevision:     infrastructure.CLIOnly,
+			infrastructure.OpGetRepositoryStatus: infrastructure.CLIOnly,
+		}))
+		ctx := context.Background()
+
+		require.NoError(t, os.WriteFile(testFile, []byte("changed content"), 0644))
+		t.Cleanup(func() { _, _ = executor.Execute(ctx, repoPath, "git", "checkout", "--", "test.txt") })
+
+		want, err := goGitService.GetRepositoryStatus(ctx, repoPath)
+		require.NoError(t, err)
+		got, err := cliService.GetRepositoryStatus(ctx, repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+
+		wantHash, err := goGitService.ResolveRevision(ctx, repoPath, "main")
+		require.NoError(t, err)
+		gotHash, err := cliService.ResolveRevision(ctx, repoPath, "main")
+		require.NoError(t, err)
+		assert.Equal(t, wantHash, gotHash)
+
+		exists, err := cliService.BranchExists(ctx, repoPath, "main")
+		require.NoError(t, err)
+		assert.True(t, exists)
+		exists, err = cliService.BranchExists(ctx, repoPath, "no-such-branch")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
 }
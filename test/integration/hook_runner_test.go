@@ -32,7 +32,7 @@ func (s *HookRunnerIntegrationSuite) SetupTest() {
 	s.configDir = s.T().TempDir()
 
 	executor := infrastructure.NewDefaultCommandExecutor(30 * time.Second)
-	s.runner = infrastructure.NewHookRunner(executor)
+	s.runner = infrastructure.NewHookRunner(executor, nil)
 }
 
 func (s *HookRunnerIntegrationSuite) TestRun_RealConfigFile_ExecutesEchoCommand() {
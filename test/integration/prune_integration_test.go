@@ -77,7 +77,9 @@ func (s *PruneIntegrationTestSuite) createWorktreeService(repoPath string) appli
 		GitRepoPath: projectInfo.GitRepoPath,
 	}}, nil)
 	mockProjectService.On("ValidateProject", context.Background(), repoPath).Return(nil)
-	return service.NewWorktreeService(s.gitService, mockProjectService, config, nil)
+	mockProjectService.On("FindProjectByWorktreePath", context.Background(), mock.Anything).Return(projectInfo, nil).Maybe()
+	mockProjectService.On("ForgetWorktreeOwner", mock.Anything).Maybe()
+	return service.NewWorktreeService(s.gitService, mockProjectService, config, nil, nil)
 }
 
 func (s *PruneIntegrationTestSuite) TestDeleteBranch_NonExistentBranch() {
@@ -511,7 +513,9 @@ func (s *PruneIntegrationTestSuite) TestNavigationOutput_SingleWorktreePrune() {
 		GitRepoPath: projectInfo.GitRepoPath,
 	}}, nil)
 	mockProjectService.On("ValidateProject", context.Background(), repoPath).Return(nil)
-	worktreeService := service.NewWorktreeService(s.gitService, mockProjectService, config, nil)
+	mockProjectService.On("FindProjectByWorktreePath", context.Background(), mock.Anything).Return(projectInfo, nil).Maybe()
+	mockProjectService.On("ForgetWorktreeOwner", mock.Anything).Maybe()
+	worktreeService := service.NewWorktreeService(s.gitService, mockProjectService, config, nil, nil)
 
 	req := &domain.PruneWorktreesRequest{
 		SpecificWorktree: "test-repo/feature-nav",
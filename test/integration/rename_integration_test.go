@@ -0,0 +1,171 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+	"twiggit/internal/infrastructure"
+	"twiggit/internal/service"
+	"twiggit/test/mocks"
+)
+
+type RenameIntegrationTestSuite struct {
+	suite.Suite
+	executor     *infrastructure.DefaultCommandExecutor
+	cliClient    application.CLIClient
+	gitService   application.GitClient
+	repoPath     string
+	worktreesDir string
+	service      application.WorktreeService
+}
+
+func TestRenameIntegrationSuite(t *testing.T) {
+	suite.Run(t, new(RenameIntegrationTestSuite))
+}
+
+func (s *RenameIntegrationTestSuite) SetupTest() {
+	if testing.Short() {
+		s.T().Skip("Skipping integration tests in short mode")
+	}
+	s.executor = infrastructure.NewDefaultCommandExecutor(30 * time.Second)
+	s.cliClient = infrastructure.NewCLIClient(s.executor, 30)
+	s.gitService = infrastructure.NewCompositeGitClient(infrastructure.NewGoGitClient(true), s.cliClient)
+
+	tempDir := s.T().TempDir()
+	config := domain.DefaultConfig()
+	config.ProjectsDirectory = filepath.Join(tempDir, "projects")
+	config.WorktreesDirectory = filepath.Join(tempDir, "worktrees")
+	s.worktreesDir = filepath.Join(config.WorktreesDirectory, "test-project")
+	s.repoPath = filepath.Join(config.ProjectsDirectory, "test-project")
+	s.Require().NoError(os.MkdirAll(s.repoPath, 0755))
+	s.Require().NoError(os.MkdirAll(s.worktreesDir, 0755))
+
+	s.git(s.repoPath, "init")
+	s.git(s.repoPath, "config", "user.name", "Test User")
+	s.git(s.repoPath, "config", "user.email", "test@example.com")
+	s.Require().NoError(os.WriteFile(filepath.Join(s.repoPath, "README.md"), []byte("# test\n"), 0644))
+	s.git(s.repoPath, "add", "README.md")
+	s.git(s.repoPath, "commit", "-m", "Initial commit")
+	s.git(s.repoPath, "branch", "-M", "main")
+
+	projectInfo := &domain.ProjectInfo{Name: "test-project", Path: s.repoPath, GitRepoPath: s.repoPath}
+	projectService := mocks.NewMockProjectService()
+	projectService.On("GetProjectInfo", mock.Anything, s.repoPath).Return(projectInfo, nil)
+	projectService.On("FindProjectByWorktreePath", mock.Anything, s.repoPath).Return(projectInfo, nil).Maybe()
+	s.service = service.NewWorktreeService(s.gitService, projectService, config, nil, nil)
+}
+
+// git runs a git command in dir and returns its trimmed stdout
+func (s *RenameIntegrationTestSuite) git(dir string, args ...string) string {
+	s.T().Helper()
+	result, err := s.executor.Execute(context.Background(), dir, "git", args...)
+	s.Require().NoError(err)
+	s.Require().Equal(0, result.ExitCode, "git %v: %s", args, result.Stderr)
+	return strings.TrimSpace(result.Stdout)
+}
+
+// createWorktree adds a worktree for a new branch at the path twiggit gives that branch
+func (s *RenameIntegrationTestSuite) createWorktree(branch string) string {
+	s.T().Helper()
+	path := filepath.Join(s.worktreesDir, branch)
+	s.Require().NoError(s.cliClient.CreateWorktree(context.Background(), s.repoPath, branch, "main", path))
+	return path
+}
+
+func (s *RenameIntegrationTestSuite) TestRenameWorktree_MovesBranchAndDirectory() {
+	oldPath := s.createWorktree("feature-old")
+	s.git(s.repoPath, "config", "branch.feature-old.description", "Login flow")
+
+	result, err := s.service.RenameWorktree(context.Background(), &domain.RenameWorktreeRequest{
+		WorktreePath: oldPath,
+		NewBranch:    "feature-new",
+	})
+	s.Require().NoError(err)
+
+	newPath := filepath.Join(s.worktreesDir, "feature-new")
+	s.Equal(&domain.RenameWorktreeResult{OldBranch: "feature-old", NewBranch: "feature-new", OldPath: oldPath, NewPath: newPath}, result)
+	s.NoDirExists(oldPath)
+	s.DirExists(newPath)
+	s.Equal("feature-new", s.git(newPath, "rev-parse", "--abbrev-ref", "HEAD"))
+	s.Empty(s.git(s.repoPath, "branch", "--list", "feature-old"))
+	s.Equal("Login flow", s.git(s.repoPath, "config", "branch.feature-new.description"))
+	s.Contains(s.git(s.repoPath, "worktree", "list", "--porcelain"), "worktree "+newPath)
+}
+
+func (s *RenameIntegrationTestSuite) TestRenameWorktree_UncommittedChanges() {
+	oldPath := s.createWorktree("feature-dirty")
+	s.Require().NoError(os.WriteFile(filepath.Join(oldPath, "wip.txt"), []byte("wip\n"), 0644))
+
+	_, err := s.service.RenameWorktree(context.Background(), &domain.RenameWorktreeRequest{
+		WorktreePath: oldPath,
+		NewBranch:    "feature-renamed",
+	})
+	s.Require().Error(err)
+	s.Contains(err.Error(), "uncommitted changes")
+	s.DirExists(oldPath)
+	s.Equal("feature-dirty", s.git(oldPath, "rev-parse", "--abbrev-ref", "HEAD"))
+
+	_, err = s.service.RenameWorktree(context.Background(), &domain.RenameWorktreeRequest{
+		WorktreePath: oldPath,
+		NewBranch:    "feature-renamed",
+		Force:        true,
+	})
+	s.Require().NoError(err)
+	s.FileExists(filepath.Join(s.worktreesDir, "feature-renamed", "wip.txt"))
+}
+
+func (s *RenameIntegrationTestSuite) TestRenameWorktree_ExistingBranch() {
+	oldPath := s.createWorktree("feature-a")
+	s.createWorktree("feature-b")
+
+	_, err := s.service.RenameWorktree(context.Background(), &domain.RenameWorktreeRequest{
+		WorktreePath: oldPath,
+		NewBranch:    "feature-b",
+	})
+	s.Require().Error(err)
+	s.Contains(err.Error(), "branch already exists")
+	s.Equal("feature-a", s.git(oldPath, "rev-parse", "--abbrev-ref", "HEAD"))
+}
+
+func (s *RenameIntegrationTestSuite) TestRenameWorktree_MoveFailureRestoresBranch() {
+	oldPath := s.createWorktree("feature-locked")
+	s.git(s.repoPath, "worktree", "lock", oldPath)
+
+	_, err := s.service.RenameWorktree(context.Background(), &domain.RenameWorktreeRequest{
+		WorktreePath: oldPath,
+		NewBranch:    "feature-moved",
+	})
+	s.Require().Error(err)
+	s.Contains(err.Error(), "the branch was not renamed")
+	s.DirExists(oldPath)
+	s.Equal("feature-locked", s.git(oldPath, "rev-parse", "--abbrev-ref", "HEAD"))
+}
+
+func (s *RenameIntegrationTestSuite) TestRenameWorktree_InvalidNames() {
+	oldPath := s.createWorktree("feature-x")
+
+	_, err := s.service.RenameWorktree(context.Background(), &domain.RenameWorktreeRequest{
+		WorktreePath: oldPath,
+		NewBranch:    "bad name",
+	})
+	s.Require().Error(err)
+	s.Contains(err.Error(), "branch name format is invalid")
+
+	_, err = s.service.RenameWorktree(context.Background(), &domain.RenameWorktreeRequest{
+		WorktreePath: s.repoPath,
+		NewBranch:    "feature-y",
+	})
+	s.Require().Error(err)
+	s.Contains(err.Error(), "cannot rename the main worktree")
+}
@@ -2,7 +2,10 @@ package mocks
 
 import (
 	"context"
+	"io"
+	"time"
 
+	"twiggit/internal/application"
 	"twiggit/internal/domain"
 
 	"github.com/stretchr/testify/mock"
@@ -51,6 +54,42 @@ func (m *MockWorktreeService) GetWorktreeStatus(ctx context.Context, worktreePat
 	return args.Get(0).(*domain.WorktreeStatus), args.Error(1)
 }
 
+// GetWorktreeDetails mocks looking up the list --verbose details of a worktree
+func (m *MockWorktreeService) GetWorktreeDetails(ctx context.Context, worktree *domain.WorktreeInfo) *domain.WorktreeDetails {
+	args := m.Called(ctx, worktree)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*domain.WorktreeDetails)
+}
+
+// GetProjectSummary mocks summarizing a project's worktree statuses
+func (m *MockWorktreeService) GetProjectSummary(ctx context.Context, projectPath string) (*domain.ProjectStatusSummary, error) {
+	args := m.Called(ctx, projectPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ProjectStatusSummary), args.Error(1)
+}
+
+// ListRemoteCandidates mocks listing remote branches without a local worktree
+func (m *MockWorktreeService) ListRemoteCandidates(ctx context.Context, req *domain.ListWorktreesRequest) ([]*domain.RemoteWorktreeCandidate, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.RemoteWorktreeCandidate), args.Error(1)
+}
+
+// DiscoverWorktreesWithFilter mocks discovering filtered worktree statuses
+func (m *MockWorktreeService) DiscoverWorktreesWithFilter(ctx context.Context, projectPath string, filter domain.WorktreeFilter) ([]*domain.WorktreeStatus, error) {
+	args := m.Called(ctx, projectPath, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.WorktreeStatus), args.Error(1)
+}
+
 // ValidateWorktree mocks validating a worktree
 func (m *MockWorktreeService) ValidateWorktree(ctx context.Context, worktreePath string) error {
 	args := m.Called(ctx, worktreePath)
@@ -87,6 +126,222 @@ func (m *MockWorktreeService) GetWorktreeByPath(ctx context.Context, projectPath
 	return args.Get(0).(*domain.WorktreeInfo), args.Error(1)
 }
 
+// LogBetween mocks listing commits between two refs
+func (m *MockWorktreeService) LogBetween(ctx context.Context, repoPath, fromRef, toRef string) ([]domain.CommitInfo, error) {
+	args := m.Called(ctx, repoPath, fromRef, toRef)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.CommitInfo), args.Error(1)
+}
+
+// GetWorktreeTimeline mocks reading the commit timeline of a worktree
+func (m *MockWorktreeService) GetWorktreeTimeline(ctx context.Context, worktreePath string, limit int) (*domain.WorktreeTimeline, error) {
+	args := m.Called(ctx, worktreePath, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WorktreeTimeline), args.Error(1)
+}
+
+// CompareBranches mocks comparing two branches
+func (m *MockWorktreeService) CompareBranches(ctx context.Context, repoPath, base, target string) (*domain.WorktreeComparison, error) {
+	args := m.Called(ctx, repoPath, base, target)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WorktreeComparison), args.Error(1)
+}
+
+// CopyBranchConfig mocks copying branch-specific config
+func (m *MockWorktreeService) CopyBranchConfig(ctx context.Context, repoPath, srcBranch, dstBranch string) error {
+	args := m.Called(ctx, repoPath, srcBranch, dstBranch)
+	return args.Error(0)
+}
+
+// GetBranchDescription mocks reading a branch description
+func (m *MockWorktreeService) GetBranchDescription(ctx context.Context, repoPath, branch string) (string, error) {
+	args := m.Called(ctx, repoPath, branch)
+	return args.String(0), args.Error(1)
+}
+
+// SetBranchDescription mocks writing a branch description
+func (m *MockWorktreeService) SetBranchDescription(ctx context.Context, repoPath, branch, description string) error {
+	args := m.Called(ctx, repoPath, branch, description)
+	return args.Error(0)
+}
+
+// VerifyWorktrees mocks comparing git's worktree list with discovered worktrees
+func (m *MockWorktreeService) VerifyWorktrees(ctx context.Context, project *domain.ProjectInfo) (*domain.WorktreeVerification, error) {
+	args := m.Called(ctx, project)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WorktreeVerification), args.Error(1)
+}
+
+// GetWorktreeHealth mocks checking the health of a worktree
+func (m *MockWorktreeService) GetWorktreeHealth(ctx context.Context, worktreePath string) (*domain.WorktreeHealth, error) {
+	args := m.Called(ctx, worktreePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WorktreeHealth), args.Error(1)
+}
+
+// ExportGitConfig mocks reading the local git config of a project's worktrees
+func (m *MockWorktreeService) ExportGitConfig(ctx context.Context, project *domain.ProjectInfo) ([]*domain.WorktreeGitConfig, error) {
+	args := m.Called(ctx, project)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.WorktreeGitConfig), args.Error(1)
+}
+
+// GetConflictingFiles mocks listing files with merge conflicts
+func (m *MockWorktreeService) GetConflictingFiles(ctx context.Context, worktreePath string) ([]domain.ConflictFile, error) {
+	args := m.Called(ctx, worktreePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ConflictFile), args.Error(1)
+}
+
+// EnableCommitSigning mocks enabling GPG commit signing in a worktree
+func (m *MockWorktreeService) EnableCommitSigning(ctx context.Context, worktreePath string) error {
+	args := m.Called(ctx, worktreePath)
+	return args.Error(0)
+}
+
+// ValidateHooks mocks checking a repository's hooks
+func (m *MockWorktreeService) ValidateHooks(ctx context.Context, repoPath string) (string, []domain.HookIssue, error) {
+	args := m.Called(ctx, repoPath)
+	if args.Get(1) == nil {
+		return args.String(0), nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).([]domain.HookIssue), args.Error(2)
+}
+
+// DeleteRemoteBranch mocks deleting a branch on a remote
+func (m *MockWorktreeService) DeleteRemoteBranch(ctx context.Context, repoPath, remote, branch string) error {
+	args := m.Called(ctx, repoPath, remote, branch)
+	return args.Error(0)
+}
+
+// FetchTagsOnly mocks fetching the tags of a remote
+func (m *MockWorktreeService) FetchTagsOnly(ctx context.Context, repoPath, remote string) error {
+	args := m.Called(ctx, repoPath, remote)
+	return args.Error(0)
+}
+
+// RenameWorktree mocks renaming a worktree's branch and directory
+func (m *MockWorktreeService) RenameWorktree(ctx context.Context, req *domain.RenameWorktreeRequest) (*domain.RenameWorktreeResult, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.RenameWorktreeResult), args.Error(1)
+}
+
+// CloneAndCreate mocks cloning a repository and creating its first worktree
+func (m *MockWorktreeService) CloneAndCreate(ctx context.Context, req *domain.CloneCreateRequest) (*domain.CloneCreateResult, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CloneCreateResult), args.Error(1)
+}
+
+// ListStashes mocks listing the stash entries of a worktree
+func (m *MockWorktreeService) ListStashes(ctx context.Context, worktreePath string) ([]domain.StashEntry, error) {
+	args := m.Called(ctx, worktreePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.StashEntry), args.Error(1)
+}
+
+// PushStash mocks stashing the changes of a worktree
+func (m *MockWorktreeService) PushStash(ctx context.Context, worktreePath, message string) error {
+	args := m.Called(ctx, worktreePath, message)
+	return args.Error(0)
+}
+
+// PopStash mocks applying and dropping a stash entry
+func (m *MockWorktreeService) PopStash(ctx context.Context, worktreePath string, index int) ([]domain.ConflictFile, error) {
+	args := m.Called(ctx, worktreePath, index)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ConflictFile), args.Error(1)
+}
+
+// DropStash mocks dropping a stash entry
+func (m *MockWorktreeService) DropStash(ctx context.Context, worktreePath string, index int) error {
+	args := m.Called(ctx, worktreePath, index)
+	return args.Error(0)
+}
+
+// FetchAllRemotes mocks fetching every remote
+func (m *MockWorktreeService) FetchAllRemotes(ctx context.Context, repoPath string) error {
+	args := m.Called(ctx, repoPath)
+	return args.Error(0)
+}
+
+// GetWorktreeStats mocks aggregating the worktree status of a project
+func (m *MockWorktreeService) GetWorktreeStats(ctx context.Context, projectPath string) (*domain.WorktreeStats, error) {
+	args := m.Called(ctx, projectPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WorktreeStats), args.Error(1)
+}
+
+// SyncWorktree mocks pulling the upstream of a worktree's branch
+func (m *MockWorktreeService) SyncWorktree(ctx context.Context, worktree *domain.WorktreeInfo, rebase bool) *domain.SyncResult {
+	args := m.Called(ctx, worktree, rebase)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*domain.SyncResult)
+}
+
+// CheckRepositoryIntegrity mocks running git fsck on a repository
+func (m *MockWorktreeService) CheckRepositoryIntegrity(ctx context.Context, repoPath string) error {
+	args := m.Called(ctx, repoPath)
+	return args.Error(0)
+}
+
+// FetchBranch mocks fetching one branch of a remote
+func (m *MockWorktreeService) FetchBranch(ctx context.Context, repoPath, remote, branch string) error {
+	args := m.Called(ctx, repoPath, remote, branch)
+	return args.Error(0)
+}
+
+// PruneTags mocks removing tags deleted on the remote
+func (m *MockWorktreeService) PruneTags(ctx context.Context, repoPath string) error {
+	args := m.Called(ctx, repoPath)
+	return args.Error(0)
+}
+
+// LockWorktree mocks locking a worktree
+func (m *MockWorktreeService) LockWorktree(ctx context.Context, repoPath, worktreePath, reason string) error {
+	args := m.Called(ctx, repoPath, worktreePath, reason)
+	return args.Error(0)
+}
+
+// UnlockWorktree mocks unlocking a worktree
+func (m *MockWorktreeService) UnlockWorktree(ctx context.Context, repoPath, worktreePath string) error {
+	args := m.Called(ctx, repoPath, worktreePath)
+	return args.Error(0)
+}
+
+// SetMergeStrategy mocks configuring the pull strategy of a worktree's branch
+func (m *MockWorktreeService) SetMergeStrategy(ctx context.Context, worktreePath, branch string, strategy domain.MergeStrategy) error {
+	args := m.Called(ctx, worktreePath, branch, strategy)
+	return args.Error(0)
+}
+
 // MockProjectService is a mock implementation of application.ProjectService
 type MockProjectService struct {
 	mock.Mock
@@ -97,6 +352,27 @@ func NewMockProjectService() *MockProjectService {
 	return &MockProjectService{}
 }
 
+// AnalyzeObjectStats mocks collecting object statistics per project
+func (m *MockProjectService) AnalyzeObjectStats(ctx context.Context, projects []*domain.ProjectSummary) []domain.ProjectObjectStats {
+	args := m.Called(ctx, projects)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]domain.ProjectObjectStats)
+}
+
+// GarbageCollect mocks running git gc for a project
+func (m *MockProjectService) GarbageCollect(ctx context.Context, project *domain.ProjectSummary) error {
+	args := m.Called(ctx, project)
+	return args.Error(0)
+}
+
+// RenameProject mocks renaming a project
+func (m *MockProjectService) RenameProject(ctx context.Context, oldName, newName string) error {
+	args := m.Called(ctx, oldName, newName)
+	return args.Error(0)
+}
+
 // DiscoverProject mocks discovering a project
 func (m *MockProjectService) DiscoverProject(ctx context.Context, projectName string, context *domain.Context) (*domain.ProjectInfo, error) {
 	args := m.Called(ctx, projectName, context)
@@ -139,6 +415,20 @@ func (m *MockProjectService) GetProjectInfo(ctx context.Context, projectPath str
 	return args.Get(0).(*domain.ProjectInfo), args.Error(1)
 }
 
+// FindProjectByWorktreePath mocks finding the project that owns a worktree
+func (m *MockProjectService) FindProjectByWorktreePath(ctx context.Context, worktreePath string) (*domain.ProjectInfo, error) {
+	args := m.Called(ctx, worktreePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ProjectInfo), args.Error(1)
+}
+
+// ForgetWorktreeOwner mocks dropping a cached worktree owner
+func (m *MockProjectService) ForgetWorktreeOwner(worktreePath string) {
+	m.Called(worktreePath)
+}
+
 // MockNavigationService is a mock implementation of application.NavigationService
 type MockNavigationService struct {
 	mock.Mock
@@ -173,6 +463,30 @@ func (m *MockNavigationService) GetNavigationSuggestions(ctx context.Context, co
 	return args.Get(0).([]*domain.ResolutionSuggestion), args.Error(1)
 }
 
+// NavigateToProject mocks resolving a project to its default worktree
+func (m *MockNavigationService) NavigateToProject(ctx context.Context, projectName string) (*domain.ResolutionResult, error) {
+	args := m.Called(ctx, projectName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ResolutionResult), args.Error(1)
+}
+
+// RecordVisit mocks adding a path to the navigation history
+func (m *MockNavigationService) RecordVisit(path string) error {
+	args := m.Called(path)
+	return args.Error(0)
+}
+
+// GetHistory mocks reading the navigation history
+func (m *MockNavigationService) GetHistory() []string {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]string)
+}
+
 // MockContextService is a mock implementation of application.ContextService
 type MockContextService struct {
 	mock.Mock
@@ -201,6 +515,15 @@ func (m *MockContextService) DetectContextFromPath(path string) (*domain.Context
 	return args.Get(0).(*domain.Context), args.Error(1)
 }
 
+// GetContextForPath mocks detecting context from an explicit path
+func (m *MockContextService) GetContextForPath(ctx context.Context, path string) (*domain.Context, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Context), args.Error(1)
+}
+
 // ResolveIdentifier mocks resolving an identifier
 func (m *MockContextService) ResolveIdentifier(identifier string) (*domain.ResolutionResult, error) {
 	args := m.Called(identifier)
@@ -273,3 +596,438 @@ func (m *MockShellService) GenerateWrapper(ctx context.Context, req *domain.Gene
 	}
 	return args.Get(0).(*domain.GenerateWrapperResult), args.Error(1)
 }
+
+// MockInitializer is a mock implementation of application.Initializer
+type MockInitializer struct {
+	mock.Mock
+}
+
+// NewMockInitializer creates a new MockInitializer
+func NewMockInitializer() *MockInitializer {
+	return &MockInitializer{}
+}
+
+// Initialize mocks initializing a workspace
+func (m *MockInitializer) Initialize(ctx context.Context, opts domain.InitOptions) error {
+	args := m.Called(ctx, opts)
+	return args.Error(0)
+}
+
+// ConfigPath mocks returning the config path
+func (m *MockInitializer) ConfigPath() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+// MockLinkRegistry is a mock implementation of application.LinkRegistry
+type MockLinkRegistry struct {
+	mock.Mock
+}
+
+// NewMockLinkRegistry creates a new MockLinkRegistry
+func NewMockLinkRegistry() *MockLinkRegistry {
+	return &MockLinkRegistry{}
+}
+
+// AddLink mocks recording a worktree dependency
+func (m *MockLinkRegistry) AddLink(worktreePath, dependencyBranch string) error {
+	args := m.Called(worktreePath, dependencyBranch)
+	return args.Error(0)
+}
+
+// GetLinks mocks reading worktree dependencies
+func (m *MockLinkRegistry) GetLinks(worktreePath string) ([]string, error) {
+	args := m.Called(worktreePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// CleanStaleLinks mocks removing links to branches without a worktree
+func (m *MockLinkRegistry) CleanStaleLinks(ctx context.Context, projectPath string) ([]string, error) {
+	args := m.Called(ctx, projectPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// MockProcessManager is a mock implementation of application.ProcessManager
+type MockProcessManager struct {
+	mock.Mock
+}
+
+// NewMockProcessManager creates a new MockProcessManager
+func NewMockProcessManager() *MockProcessManager {
+	return &MockProcessManager{}
+}
+
+// Start mocks starting a background process for a worktree
+func (m *MockProcessManager) Start(worktreePath, command string, env []string) (*domain.ManagedProcess, error) {
+	args := m.Called(worktreePath, command, env)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ManagedProcess), args.Error(1)
+}
+
+// List mocks listing running managed processes
+func (m *MockProcessManager) List() ([]domain.ManagedProcess, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ManagedProcess), args.Error(1)
+}
+
+// Kill mocks terminating a worktree's processes
+func (m *MockProcessManager) Kill(ctx context.Context, worktreePath string) ([]domain.ManagedProcess, error) {
+	args := m.Called(ctx, worktreePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ManagedProcess), args.Error(1)
+}
+
+// MockEditorLauncher is a mock implementation of application.EditorLauncher
+type MockEditorLauncher struct {
+	mock.Mock
+}
+
+// NewMockEditorLauncher creates a new MockEditorLauncher
+func NewMockEditorLauncher() *MockEditorLauncher {
+	return &MockEditorLauncher{}
+}
+
+// Open mocks opening files in an editor
+func (m *MockEditorLauncher) Open(ctx context.Context, dir, editor string, files []string) error {
+	args := m.Called(ctx, dir, editor, files)
+	return args.Error(0)
+}
+
+// MockEnvInheritor is a mock implementation of application.EnvInheritor
+type MockEnvInheritor struct {
+	mock.Mock
+}
+
+// NewMockEnvInheritor creates a new MockEnvInheritor
+func NewMockEnvInheritor() *MockEnvInheritor {
+	return &MockEnvInheritor{}
+}
+
+// Inherit mocks writing environment variables to a worktree's .env file
+func (m *MockEnvInheritor) Inherit(vars []string, worktreePath string) error {
+	args := m.Called(vars, worktreePath)
+	return args.Error(0)
+}
+
+// MockCommandRunner is a mock implementation of application.CommandRunner
+type MockCommandRunner struct {
+	mock.Mock
+}
+
+// NewMockCommandRunner creates a new MockCommandRunner
+func NewMockCommandRunner() *MockCommandRunner {
+	return &MockCommandRunner{}
+}
+
+// Run mocks running a shell command
+func (m *MockCommandRunner) Run(ctx context.Context, dir, command string) (*domain.CommandRunResult, error) {
+	args := m.Called(ctx, dir, command)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CommandRunResult), args.Error(1)
+}
+
+// MockEphemeralRegistry is a mock implementation of application.EphemeralRegistry
+type MockEphemeralRegistry struct {
+	mock.Mock
+}
+
+// NewMockEphemeralRegistry creates a new MockEphemeralRegistry
+func NewMockEphemeralRegistry() *MockEphemeralRegistry {
+	return &MockEphemeralRegistry{}
+}
+
+// Register mocks registering a worktree for session cleanup
+func (m *MockEphemeralRegistry) Register(worktreePath, sessionID string) error {
+	args := m.Called(worktreePath, sessionID)
+	return args.Error(0)
+}
+
+// GetRegistered mocks reading a session's registered worktrees
+func (m *MockEphemeralRegistry) GetRegistered(sessionID string) ([]string, error) {
+	args := m.Called(sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// Unregister mocks removing a worktree registration
+func (m *MockEphemeralRegistry) Unregister(worktreePath, sessionID string) error {
+	args := m.Called(worktreePath, sessionID)
+	return args.Error(0)
+}
+
+// ListSessions mocks listing sessions with registered worktrees
+func (m *MockEphemeralRegistry) ListSessions() ([]domain.EphemeralSession, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.EphemeralSession), args.Error(1)
+}
+
+// MockTokenStore is a mock implementation of application.TokenStore
+type MockTokenStore struct {
+	mock.Mock
+}
+
+// NewMockTokenStore creates a new MockTokenStore
+func NewMockTokenStore() *MockTokenStore {
+	return &MockTokenStore{}
+}
+
+// Save mocks storing a token
+func (m *MockTokenStore) Save(token domain.StoredToken) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+// Get mocks reading the token stored for a host
+func (m *MockTokenStore) Get(host string) (*domain.StoredToken, error) {
+	args := m.Called(host)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.StoredToken), args.Error(1)
+}
+
+// List mocks listing stored tokens
+func (m *MockTokenStore) List() ([]domain.StoredToken, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.StoredToken), args.Error(1)
+}
+
+// Remove mocks removing the token stored for a host
+func (m *MockTokenStore) Remove(host string) error {
+	args := m.Called(host)
+	return args.Error(0)
+}
+
+// MockTokenValidator is a mock implementation of application.TokenValidator
+type MockTokenValidator struct {
+	mock.Mock
+}
+
+// NewMockTokenValidator creates a new MockTokenValidator
+func NewMockTokenValidator() *MockTokenValidator {
+	return &MockTokenValidator{}
+}
+
+// Validate mocks validating a token against its host
+func (m *MockTokenValidator) Validate(ctx context.Context, host, token string) (string, error) {
+	args := m.Called(ctx, host, token)
+	return args.String(0), args.Error(1)
+}
+
+// MockCIWatcher is a mock implementation of application.CIWatcher
+type MockCIWatcher struct {
+	mock.Mock
+}
+
+// NewMockCIWatcher creates a new MockCIWatcher
+func NewMockCIWatcher() *MockCIWatcher {
+	return &MockCIWatcher{}
+}
+
+// Watch mocks polling the CI status of a commit
+func (m *MockCIWatcher) Watch(ctx context.Context, owner, repo, sha string, interval time.Duration) (<-chan domain.CIStatus, error) {
+	args := m.Called(ctx, owner, repo, sha, interval)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(<-chan domain.CIStatus), args.Error(1)
+}
+
+// MockChangeWatcher is a mock implementation of application.ChangeWatcher
+type MockChangeWatcher struct {
+	mock.Mock
+}
+
+// NewMockChangeWatcher creates a new MockChangeWatcher
+func NewMockChangeWatcher() *MockChangeWatcher {
+	return &MockChangeWatcher{}
+}
+
+// Watch mocks watching a worktree; configure Run to call report with canned runs
+func (m *MockChangeWatcher) Watch(ctx context.Context, req *application.HookRunRequest, hookRunner application.HookRunner, report func(domain.HookRun)) error {
+	args := m.Called(ctx, req, hookRunner, report)
+	return args.Error(0)
+}
+
+// MockHookRunner is a mock implementation of application.HookRunner
+type MockHookRunner struct {
+	mock.Mock
+}
+
+// NewMockHookRunner creates a new MockHookRunner
+func NewMockHookRunner() *MockHookRunner {
+	return &MockHookRunner{}
+}
+
+// Run mocks running the hooks of a worktree
+func (m *MockHookRunner) Run(ctx context.Context, req *application.HookRunRequest) (*domain.HookResult, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.HookResult), args.Error(1)
+}
+
+// LoadConfig mocks reading the hooks of a .twiggit.toml file
+func (m *MockHookRunner) LoadConfig(configFilePath string) (*domain.HookConfig, error) {
+	args := m.Called(configFilePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.HookConfig), args.Error(1)
+}
+
+// MockProjectSettingsStore is a mock implementation of application.ProjectSettingsStore
+type MockProjectSettingsStore struct {
+	mock.Mock
+}
+
+// NewMockProjectSettingsStore creates a new MockProjectSettingsStore
+func NewMockProjectSettingsStore() *MockProjectSettingsStore {
+	return &MockProjectSettingsStore{}
+}
+
+// Load mocks reading the project settings of a .twiggit.toml file
+func (m *MockProjectSettingsStore) Load(repoPath string) (*domain.ProjectSettings, error) {
+	args := m.Called(repoPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ProjectSettings), args.Error(1)
+}
+
+// SetDefaultWorktree mocks writing default_worktree to a .twiggit.toml file
+func (m *MockProjectSettingsStore) SetDefaultWorktree(repoPath, branch string) error {
+	args := m.Called(repoPath, branch)
+	return args.Error(0)
+}
+
+// MockNavigationHistoryStore is a mock implementation of application.NavigationHistoryStore
+type MockNavigationHistoryStore struct {
+	mock.Mock
+}
+
+// NewMockNavigationHistoryStore creates a new MockNavigationHistoryStore
+func NewMockNavigationHistoryStore() *MockNavigationHistoryStore {
+	return &MockNavigationHistoryStore{}
+}
+
+// Load mocks reading the stored navigation history
+func (m *MockNavigationHistoryStore) Load() ([]string, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// Save mocks replacing the stored navigation history
+func (m *MockNavigationHistoryStore) Save(paths []string) error {
+	args := m.Called(paths)
+	return args.Error(0)
+}
+
+// MockCompletionCache is a mock implementation of application.CompletionCache
+type MockCompletionCache struct {
+	mock.Mock
+}
+
+// NewMockCompletionCache creates a new MockCompletionCache
+func NewMockCompletionCache() *MockCompletionCache {
+	return &MockCompletionCache{}
+}
+
+// Load mocks reading cached completion values
+func (m *MockCompletionCache) Load() ([]string, bool) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Bool(1)
+	}
+	return args.Get(0).([]string), args.Bool(1)
+}
+
+// Save mocks replacing cached completion values
+func (m *MockCompletionCache) Save(values []string) error {
+	args := m.Called(values)
+	return args.Error(0)
+}
+
+// MockPullRequestFinder is a mock implementation of application.PullRequestFinder
+type MockPullRequestFinder struct {
+	mock.Mock
+}
+
+// NewMockPullRequestFinder creates a new MockPullRequestFinder
+func NewMockPullRequestFinder() *MockPullRequestFinder {
+	return &MockPullRequestFinder{}
+}
+
+// FindPullRequest mocks looking up the pull request of a branch
+func (m *MockPullRequestFinder) FindPullRequest(ctx context.Context, owner, repo, branch string) (*domain.OpenPR, error) {
+	args := m.Called(ctx, owner, repo, branch)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.OpenPR), args.Error(1)
+}
+
+// MockHookCopier is a mock implementation of application.HookCopier
+type MockHookCopier struct {
+	mock.Mock
+}
+
+// NewMockHookCopier creates a new MockHookCopier
+func NewMockHookCopier() *MockHookCopier {
+	return &MockHookCopier{}
+}
+
+// Copy mocks copying git hooks between projects
+func (m *MockHookCopier) Copy(srcProjectPath, dstProjectPath string, hooks []string, overwrite bool) (*domain.CopyHooksResult, error) {
+	args := m.Called(srcProjectPath, dstProjectPath, hooks, overwrite)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CopyHooksResult), args.Error(1)
+}
+
+// MockWorktreeArchiver is a mock implementation of application.WorktreeArchiver
+type MockWorktreeArchiver struct {
+	mock.Mock
+}
+
+// NewMockWorktreeArchiver creates a new MockWorktreeArchiver
+func NewMockWorktreeArchiver() *MockWorktreeArchiver {
+	return &MockWorktreeArchiver{}
+}
+
+// Archive mocks archiving a worktree
+func (m *MockWorktreeArchiver) Archive(w io.Writer, worktreePath string, manifest *domain.ExportManifest) (*domain.ExportResult, error) {
+	args := m.Called(w, worktreePath, manifest)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ExportResult), args.Error(1)
+}
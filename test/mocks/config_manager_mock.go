@@ -0,0 +1,53 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.ConfigManager = (*MockConfigManager)(nil)
+
+// MockConfigManager is a mock implementation of application.ConfigManager
+type MockConfigManager struct {
+	mock.Mock
+}
+
+// NewMockConfigManager creates a new MockConfigManager
+func NewMockConfigManager() *MockConfigManager {
+	return &MockConfigManager{}
+}
+
+// Load mocks loading the configuration
+func (m *MockConfigManager) Load() (*domain.Config, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Config), args.Error(1)
+}
+
+// GetConfig mocks returning the loaded configuration
+func (m *MockConfigManager) GetConfig() *domain.Config {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*domain.Config)
+}
+
+// LoadForProject mocks loading the configuration of a project
+func (m *MockConfigManager) LoadForProject(repoPath string) (*domain.Config, error) {
+	args := m.Called(repoPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Config), args.Error(1)
+}
+
+// SetValue mocks setting a key in the config file
+func (m *MockConfigManager) SetValue(key, value string) error {
+	args := m.Called(key, value)
+	return args.Error(0)
+}
@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"io"
 
 	"twiggit/internal/application"
 	"twiggit/internal/domain"
@@ -76,6 +77,15 @@ func (m *MockGoGitClient) ListRemotes(ctx context.Context, repoPath string) ([]d
 	return args.Get(0).([]domain.RemoteInfo), args.Error(1)
 }
 
+// GetRemoteBranches mocks listing remote-tracking branches
+func (m *MockGoGitClient) GetRemoteBranches(ctx context.Context, repoPath string) ([]domain.BranchInfo, error) {
+	args := m.Called(ctx, repoPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.BranchInfo), args.Error(1)
+}
+
 // GetCommitInfo mocks getting commit information
 func (m *MockGoGitClient) GetCommitInfo(ctx context.Context, repoPath, commitHash string) (*domain.CommitInfo, error) {
 	args := m.Called(ctx, repoPath, commitHash)
@@ -85,6 +95,36 @@ func (m *MockGoGitClient) GetCommitInfo(ctx context.Context, repoPath, commitHas
 	return args.Get(0).(*domain.CommitInfo), args.Error(1)
 }
 
+// ResolveRevision mocks resolving a revision to a commit hash
+func (m *MockGoGitClient) ResolveRevision(ctx context.Context, repoPath, revision string) (string, error) {
+	args := m.Called(ctx, repoPath, revision)
+	return args.String(0), args.Error(1)
+}
+
+// CopyBranchConfig mocks copying branch-specific config
+func (m *MockGoGitClient) CopyBranchConfig(ctx context.Context, repoPath, srcBranch, dstBranch string) error {
+	args := m.Called(ctx, repoPath, srcBranch, dstBranch)
+	return args.Error(0)
+}
+
+// FetchRemote mocks fetching a remote with go-git
+func (m *MockGoGitClient) FetchRemote(ctx context.Context, repoPath, remoteName string, progress io.Writer) error {
+	args := m.Called(ctx, repoPath, remoteName, progress)
+	return args.Error(0)
+}
+
+// Clone mocks cloning a repository with go-git
+func (m *MockGoGitClient) Clone(ctx context.Context, remoteURL, path string, progress io.Writer) error {
+	args := m.Called(ctx, remoteURL, path, progress)
+	return args.Error(0)
+}
+
+// CherryPick mocks cherry-picking commits with go-git
+func (m *MockGoGitClient) CherryPick(ctx context.Context, repoPath string, commits []string) error {
+	args := m.Called(ctx, repoPath, commits)
+	return args.Error(0)
+}
+
 var _ application.CLIClient = (*MockCLIClient)(nil)
 
 // MockCLIClient implements application.CLIClient for testing
@@ -103,6 +143,12 @@ func (m *MockCLIClient) CreateWorktree(ctx context.Context, repoPath, branchName
 	return args.Error(0)
 }
 
+// InitBareWorktree mocks registering a worktree without checkout
+func (m *MockCLIClient) InitBareWorktree(ctx context.Context, repoPath, targetPath string) error {
+	args := m.Called(ctx, repoPath, targetPath)
+	return args.Error(0)
+}
+
 // DeleteWorktree mocks deleting a worktree
 func (m *MockCLIClient) DeleteWorktree(ctx context.Context, repoPath, worktreePath string, force bool) error {
 	args := m.Called(ctx, repoPath, worktreePath, force)
@@ -118,24 +164,300 @@ func (m *MockCLIClient) ListWorktrees(ctx context.Context, repoPath string) ([]d
 	return args.Get(0).([]domain.WorktreeInfo), args.Error(1)
 }
 
+// GetWorktreeList mocks listing the porcelain worktree records of a repository
+func (m *MockCLIClient) GetWorktreeList(ctx context.Context, repoPath string) ([]domain.GitWorktreeEntry, error) {
+	args := m.Called(ctx, repoPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.GitWorktreeEntry), args.Error(1)
+}
+
 // PruneWorktrees mocks pruning worktrees in a repository
 func (m *MockCLIClient) PruneWorktrees(ctx context.Context, repoPath string) error {
 	args := m.Called(ctx, repoPath)
 	return args.Error(0)
 }
 
+// RepairWorktrees mocks repairing moved worktrees
+func (m *MockCLIClient) RepairWorktrees(ctx context.Context, repoPath string, worktreePaths []string) error {
+	args := m.Called(ctx, repoPath, worktreePaths)
+	return args.Error(0)
+}
+
+// MoveWorktree mocks moving a linked worktree
+func (m *MockCLIClient) MoveWorktree(ctx context.Context, repoPath, worktreePath, newPath string) error {
+	args := m.Called(ctx, repoPath, worktreePath, newPath)
+	return args.Error(0)
+}
+
+// RenameBranch mocks renaming a branch
+func (m *MockCLIClient) RenameBranch(ctx context.Context, repoPath, oldName, newName string) error {
+	args := m.Called(ctx, repoPath, oldName, newName)
+	return args.Error(0)
+}
+
 // IsBranchMerged mocks checking if a branch is merged
 func (m *MockCLIClient) IsBranchMerged(ctx context.Context, repoPath, branchName string) (bool, error) {
 	args := m.Called(ctx, repoPath, branchName)
 	return args.Bool(0), args.Error(1)
 }
 
+// LockWorktree mocks locking a worktree
+func (m *MockCLIClient) LockWorktree(ctx context.Context, repoPath, worktreePath, reason string) error {
+	args := m.Called(ctx, repoPath, worktreePath, reason)
+	return args.Error(0)
+}
+
+// UnlockWorktree mocks unlocking a worktree
+func (m *MockCLIClient) UnlockWorktree(ctx context.Context, repoPath, worktreePath string) error {
+	args := m.Called(ctx, repoPath, worktreePath)
+	return args.Error(0)
+}
+
+// DeleteRemoteBranch mocks deleting a branch on a remote
+func (m *MockCLIClient) DeleteRemoteBranch(ctx context.Context, repoPath, remote, branchName string) error {
+	args := m.Called(ctx, repoPath, remote, branchName)
+	return args.Error(0)
+}
+
+// GetStashList mocks listing stash entries
+func (m *MockCLIClient) GetStashList(ctx context.Context, repoPath string) ([]domain.StashEntry, error) {
+	args := m.Called(ctx, repoPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.StashEntry), args.Error(1)
+}
+
+// ApplyStash mocks applying a stash entry to a worktree
+func (m *MockCLIClient) ApplyStash(ctx context.Context, srcRepoPath, dstWorktreePath string, stashIndex int) error {
+	args := m.Called(ctx, srcRepoPath, dstWorktreePath, stashIndex)
+	return args.Error(0)
+}
+
+// DropStash mocks removing a stash entry
+func (m *MockCLIClient) DropStash(ctx context.Context, repoPath string, stashIndex int) error {
+	args := m.Called(ctx, repoPath, stashIndex)
+	return args.Error(0)
+}
+
+// CreateStash mocks stashing the changes of a worktree
+func (m *MockCLIClient) CreateStash(ctx context.Context, worktreePath, message string) error {
+	args := m.Called(ctx, worktreePath, message)
+	return args.Error(0)
+}
+
+// CheckoutBranch mocks checking out a branch in a worktree
+func (m *MockCLIClient) CheckoutBranch(ctx context.Context, worktreePath, branchName, startPoint string) error {
+	args := m.Called(ctx, worktreePath, branchName, startPoint)
+	return args.Error(0)
+}
+
+// FetchTagsOnly mocks fetching the tags of a remote
+func (m *MockCLIClient) FetchTagsOnly(ctx context.Context, repoPath, remote string) error {
+	args := m.Called(ctx, repoPath, remote)
+	return args.Error(0)
+}
+
+// FetchAllRemotes mocks fetching every remote
+func (m *MockCLIClient) FetchAllRemotes(ctx context.Context, repoPath string) error {
+	args := m.Called(ctx, repoPath)
+	return args.Error(0)
+}
+
+// Pull mocks pulling the upstream of a worktree's branch
+func (m *MockCLIClient) Pull(ctx context.Context, worktreePath string, rebase bool) (int, error) {
+	args := m.Called(ctx, worktreePath, rebase)
+	return args.Int(0), args.Error(1)
+}
+
+// BranchExists mocks checking a branch with the CLI
+func (m *MockCLIClient) BranchExists(ctx context.Context, repoPath, branchName string) (bool, error) {
+	args := m.Called(ctx, repoPath, branchName)
+	return args.Bool(0), args.Error(1)
+}
+
+// ResolveRevision mocks resolving a revision with the CLI
+func (m *MockCLIClient) ResolveRevision(ctx context.Context, repoPath, revision string) (string, error) {
+	args := m.Called(ctx, repoPath, revision)
+	return args.String(0), args.Error(1)
+}
+
+// GetRepositoryStatus mocks reading the repository status with the CLI
+func (m *MockCLIClient) GetRepositoryStatus(ctx context.Context, repoPath string) (domain.RepositoryStatus, error) {
+	args := m.Called(ctx, repoPath)
+	return args.Get(0).(domain.RepositoryStatus), args.Error(1)
+}
+
+// Fsck mocks checking the repository's objects
+func (m *MockCLIClient) Fsck(ctx context.Context, repoPath string) error {
+	args := m.Called(ctx, repoPath)
+	return args.Error(0)
+}
+
+// FetchBranch mocks fetching one branch of a remote
+func (m *MockCLIClient) FetchBranch(ctx context.Context, repoPath, remote, branch string) error {
+	args := m.Called(ctx, repoPath, remote, branch)
+	return args.Error(0)
+}
+
+// PruneTags mocks removing tags deleted on the remote
+func (m *MockCLIClient) PruneTags(ctx context.Context, repoPath string) error {
+	args := m.Called(ctx, repoPath)
+	return args.Error(0)
+}
+
 // DeleteBranch mocks deleting a branch
 func (m *MockCLIClient) DeleteBranch(ctx context.Context, repoPath, branchName string) error {
 	args := m.Called(ctx, repoPath, branchName)
 	return args.Error(0)
 }
 
+// LogBetween mocks listing commits between two refs
+func (m *MockCLIClient) LogBetween(ctx context.Context, repoPath, fromRef, toRef string) ([]domain.CommitInfo, error) {
+	args := m.Called(ctx, repoPath, fromRef, toRef)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.CommitInfo), args.Error(1)
+}
+
+// GetCommitHistory mocks listing recent commits
+func (m *MockCLIClient) GetCommitHistory(ctx context.Context, repoPath string, limit int) ([]domain.CommitInfo, error) {
+	args := m.Called(ctx, repoPath, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.CommitInfo), args.Error(1)
+}
+
+// GetLastActivity mocks reading the HEAD commit of a worktree
+func (m *MockCLIClient) GetLastActivity(ctx context.Context, worktreePath string) (*domain.CommitInfo, error) {
+	args := m.Called(ctx, worktreePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CommitInfo), args.Error(1)
+}
+
+// GetCommitStat mocks summarizing the changes of a commit
+func (m *MockCLIClient) GetCommitStat(ctx context.Context, repoPath, commitHash string) (*domain.StatusSnapshot, error) {
+	args := m.Called(ctx, repoPath, commitHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.StatusSnapshot), args.Error(1)
+}
+
+// GetMergeBase mocks finding the common ancestor of two refs
+func (m *MockCLIClient) GetMergeBase(ctx context.Context, repoPath, branch, ref string) (string, error) {
+	args := m.Called(ctx, repoPath, branch, ref)
+	return args.String(0), args.Error(1)
+}
+
+// GetUpstreamBranch mocks resolving the tracking branch of a branch
+func (m *MockCLIClient) GetUpstreamBranch(ctx context.Context, repoPath, branch string) (string, error) {
+	args := m.Called(ctx, repoPath, branch)
+	return args.String(0), args.Error(1)
+}
+
+// GetBranchRelationship mocks counting the commits two refs do not share
+func (m *MockCLIClient) GetBranchRelationship(ctx context.Context, repoPath, branch, base string) (*domain.BranchRelationship, error) {
+	args := m.Called(ctx, repoPath, branch, base)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BranchRelationship), args.Error(1)
+}
+
+// CompareWorktrees mocks comparing two branches
+func (m *MockCLIClient) CompareWorktrees(ctx context.Context, repoPath, branch1, branch2 string) (*domain.WorktreeComparison, error) {
+	args := m.Called(ctx, repoPath, branch1, branch2)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WorktreeComparison), args.Error(1)
+}
+
+// GetConflictingFiles mocks listing unmerged paths
+func (m *MockCLIClient) GetConflictingFiles(ctx context.Context, worktreePath string) ([]domain.ConflictFile, error) {
+	args := m.Called(ctx, worktreePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ConflictFile), args.Error(1)
+}
+
+// GetObjectStats mocks reporting repository object statistics
+func (m *MockCLIClient) GetObjectStats(ctx context.Context, repoPath string) (*domain.ObjectStats, error) {
+	args := m.Called(ctx, repoPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ObjectStats), args.Error(1)
+}
+
+// GarbageCollect mocks running git gc
+func (m *MockCLIClient) GarbageCollect(ctx context.Context, repoPath string) error {
+	args := m.Called(ctx, repoPath)
+	return args.Error(0)
+}
+
+// SetConfig mocks setting a worktree-scoped config value
+func (m *MockCLIClient) SetConfig(ctx context.Context, worktreePath, key, value string) error {
+	args := m.Called(ctx, worktreePath, key, value)
+	return args.Error(0)
+}
+
+// GetConfig mocks reading a config value visible in a repository
+func (m *MockCLIClient) GetConfig(ctx context.Context, repoPath, key string) (string, error) {
+	args := m.Called(ctx, repoPath, key)
+	return args.String(0), args.Error(1)
+}
+
+// GetHooksDir mocks locating the hooks directory
+func (m *MockCLIClient) GetHooksDir(ctx context.Context, repoPath string) (string, error) {
+	args := m.Called(ctx, repoPath)
+	return args.String(0), args.Error(1)
+}
+
+// ValidateHooks mocks checking a repository's hooks
+func (m *MockCLIClient) ValidateHooks(ctx context.Context, repoPath string) []domain.HookIssue {
+	args := m.Called(ctx, repoPath)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]domain.HookIssue)
+}
+
+// GetGlobalConfig mocks reading a global config value
+func (m *MockCLIClient) GetGlobalConfig(ctx context.Context, key string) (string, error) {
+	args := m.Called(ctx, key)
+	return args.String(0), args.Error(1)
+}
+
+// GetAllLocalConfig mocks reading a worktree's local config
+func (m *MockCLIClient) GetAllLocalConfig(ctx context.Context, worktreePath string) (map[string]string, error) {
+	args := m.Called(ctx, worktreePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
+// GetBranchDescription mocks reading a branch description
+func (m *MockCLIClient) GetBranchDescription(ctx context.Context, repoPath, branch string) (string, error) {
+	args := m.Called(ctx, repoPath, branch)
+	return args.String(0), args.Error(1)
+}
+
+// SetBranchDescription mocks writing a branch description
+func (m *MockCLIClient) SetBranchDescription(ctx context.Context, repoPath, branch, description string) error {
+	args := m.Called(ctx, repoPath, branch, description)
+	return args.Error(0)
+}
+
 var _ application.GitClient = (*MockGitService)(nil)
 
 // MockGitService implements application.GitClient for testing
@@ -151,3 +473,18 @@ func NewMockGitService() *MockGitService {
 		MockCLIClient:   NewMockCLIClient(),
 	}
 }
+
+// BranchExists mocks checking a branch, routed to the go-git mock as in CompositeGitClient
+func (m *MockGitService) BranchExists(ctx context.Context, repoPath, branchName string) (bool, error) {
+	return m.MockGoGitClient.BranchExists(ctx, repoPath, branchName)
+}
+
+// ResolveRevision mocks resolving a revision, routed to the go-git mock as in CompositeGitClient
+func (m *MockGitService) ResolveRevision(ctx context.Context, repoPath, revision string) (string, error) {
+	return m.MockGoGitClient.ResolveRevision(ctx, repoPath, revision)
+}
+
+// GetRepositoryStatus mocks reading the repository status, routed to the go-git mock as in CompositeGitClient
+func (m *MockGitService) GetRepositoryStatus(ctx context.Context, repoPath string) (domain.RepositoryStatus, error) {
+	return m.MockGoGitClient.GetRepositoryStatus(ctx, repoPath)
+}
@@ -27,3 +27,21 @@ func (m *MockContextDetector) DetectContext(dir string) (*domain.Context, error)
 	}
 	return args.Get(0).(*domain.Context), args.Error(1)
 }
+
+// DetectFromEnvironment provides a mock function
+func (m *MockContextDetector) DetectFromEnvironment() (*domain.Context, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Context), args.Error(1)
+}
+
+// DetectFromGitConfig provides a mock function with given fields: path
+func (m *MockContextDetector) DetectFromGitConfig(path string) (*domain.Context, error) {
+	args := m.Called(path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Context), args.Error(1)
+}
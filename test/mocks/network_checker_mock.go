@@ -0,0 +1,40 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"twiggit/internal/application"
+	"twiggit/internal/domain"
+)
+
+var _ application.NetworkChecker = (*MockNetworkChecker)(nil)
+
+// MockNetworkChecker is a mock implementation of application.NetworkChecker
+type MockNetworkChecker struct {
+	mock.Mock
+}
+
+// NewMockNetworkChecker creates a new MockNetworkChecker
+func NewMockNetworkChecker() *MockNetworkChecker {
+	return &MockNetworkChecker{}
+}
+
+// NewReachableNetworkChecker creates a MockNetworkChecker that reports every host as reachable
+func NewReachableNetworkChecker() *MockNetworkChecker {
+	m := &MockNetworkChecker{}
+	m.On("IsReachable", mock.Anything).Return(true, nil)
+	return m
+}
+
+// NewUnreachableNetworkChecker creates a MockNetworkChecker that reports every host as unreachable
+func NewUnreachableNetworkChecker() *MockNetworkChecker {
+	m := &MockNetworkChecker{}
+	m.On("IsReachable", mock.Anything).Return(false, domain.NewNetworkUnreachableError("mock host", nil))
+	return m
+}
+
+// IsReachable mocks a connectivity check
+func (m *MockNetworkChecker) IsReachable(host string) (bool, error) {
+	args := m.Called(host)
+	return args.Bool(0), args.Error(1)
+}
@@ -0,0 +1,65 @@
+package mocks
+
+import (
+	"errors"
+
+	"github.com/stretchr/testify/mock"
+
+	"twiggit/internal/application"
+)
+
+var _ application.TerminalDetector = (*MockTerminalDetector)(nil)
+
+// MockTerminalDetector is a mock implementation of application.TerminalDetector
+type MockTerminalDetector struct {
+	mock.Mock
+}
+
+// NewMockTerminalDetector creates a new MockTerminalDetector
+func NewMockTerminalDetector() *MockTerminalDetector {
+	return &MockTerminalDetector{}
+}
+
+// NewInteractiveTerminalDetector creates a MockTerminalDetector for a color terminal outside CI
+func NewInteractiveTerminalDetector() *MockTerminalDetector {
+	m := &MockTerminalDetector{}
+	m.On("IsTerminal", mock.Anything).Return(true)
+	m.On("SupportsColor").Return(true)
+	m.On("GetSize").Return(80, 24, nil)
+	m.On("IsCI").Return(false)
+	return m
+}
+
+// NewCITerminalDetector creates a MockTerminalDetector for a CI run without a terminal
+func NewCITerminalDetector() *MockTerminalDetector {
+	m := &MockTerminalDetector{}
+	m.On("IsTerminal", mock.Anything).Return(false)
+	m.On("SupportsColor").Return(false)
+	m.On("GetSize").Return(0, 0, errors.New("not a terminal"))
+	m.On("IsCI").Return(true)
+	return m
+}
+
+// IsTerminal mocks a terminal check of a file descriptor
+func (m *MockTerminalDetector) IsTerminal(fd uintptr) bool {
+	args := m.Called(fd)
+	return args.Bool(0)
+}
+
+// SupportsColor mocks the color support check
+func (m *MockTerminalDetector) SupportsColor() bool {
+	args := m.Called()
+	return args.Bool(0)
+}
+
+// GetSize mocks reading the terminal size
+func (m *MockTerminalDetector) GetSize() (int, int, error) {
+	args := m.Called()
+	return args.Int(0), args.Int(1), args.Error(2)
+}
+
+// IsCI mocks the CI check
+func (m *MockTerminalDetector) IsCI() bool {
+	args := m.Called()
+	return args.Bool(0)
+}